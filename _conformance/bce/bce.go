@@ -0,0 +1,82 @@
+package main
+
+func sum(s []int) int {
+	n := 0
+	for i := range s {
+		n += s[i]
+	}
+	return n
+}
+
+func sumDown(s []int) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n += s[i] * i
+	}
+	return n
+}
+
+func count(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == 'a' {
+			n++
+		}
+	}
+	return n
+}
+
+func fill(a *[8]int) {
+	for i := range a {
+		a[i] = i * i
+	}
+}
+
+func guarded(s []int, i int) int {
+	if i < len(s) {
+		return s[i] // may be negative: still checked
+	}
+	return -1
+}
+
+// wrap indexes a by an int8, which wraps around to -128 after 127: the check
+// is kept.
+func wrap(a *[100]int) (n int) {
+	defer func() {
+		if recover() != nil {
+			println("wrap: recovered after", n)
+		}
+	}()
+	for i := int8(0); ; i++ {
+		if i < 100 {
+			a[i]++
+			n++
+		}
+	}
+}
+
+func try(f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			println("recovered:", r.(error).Error())
+		}
+	}()
+	f()
+}
+
+func main() {
+	s := []int{1, 2, 3, 4, 5}
+	println(sum(s), sumDown(s), count("banana"))
+	var a [8]int
+	fill(&a)
+	println(a[0], a[3], a[7])
+	var b [100]int
+	wrap(&b)
+	println(guarded(s, 2), guarded(s, 9))
+	try(func() { println(guarded(s, -1)) })
+	try(func() {
+		for i := 0; i <= len(s); i++ {
+			println(s[i])
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+var sink []byte
+
+func main() {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < 100; i++ {
+		sink = make([]byte, 100)
+	}
+	runtime.ReadMemStats(&after)
+	fmt.Println("mallocs:", after.Mallocs-before.Mallocs >= 100)
+	fmt.Println("total alloc:", after.TotalAlloc-before.TotalAlloc >= 100*100)
+
+	fmt.Println("allocs per run:", testing.AllocsPerRun(100, func() {
+		sink = make([]byte, 64)
+	}))
+
+	r := testing.Benchmark(func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sink = make([]byte, 64)
+		}
+	})
+	fmt.Println("allocs/op:", r.AllocsPerOp(), r.AllocedBytesPerOp() >= 64)
+	fmt.Println("timed:", r.N > 0, r.T > 0)
+	fmt.Println("memstring:", strings.Contains(r.MemString(), "1 allocs/op"))
+
+	r = testing.Benchmark(func(b *testing.B) {
+		b.StopTimer()
+		time.Sleep(time.Millisecond)
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			sink = sink[:0]
+		}
+	})
+	fmt.Println("no allocs:", r.AllocsPerOp(), r.N > 1)
+
+	r = testing.Benchmark(func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				_ = make([]byte, 8)
+			}
+		})
+	})
+	fmt.Println("parallel:", r.N > 0)
+}
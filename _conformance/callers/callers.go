@@ -0,0 +1,47 @@
+package main
+
+import (
+	"path/filepath"
+	"runtime"
+)
+
+//go:noinline
+func where() (string, int) {
+	_, file, line, ok := runtime.Caller(1)
+	if !ok {
+		return "?", 0
+	}
+	return filepath.Base(file), line
+}
+
+//go:noinline
+func stack() {
+	pc := make([]uintptr, 16)
+	n := runtime.Callers(1, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		println(frame.Function, filepath.Base(frame.File), frame.Line)
+		if !more || frame.Function == "main.main" {
+			break
+		}
+	}
+}
+
+//go:noinline
+func outer() {
+	stack()
+}
+
+func main() {
+	file, line := where()
+	println("caller:", file, line)
+
+	pc, _, line, ok := runtime.Caller(0)
+	println("self:", ok, line, runtime.FuncForPC(pc).Name())
+
+	outer()
+
+	var zero [0]uintptr
+	println("empty:", runtime.Callers(0, zero[:]))
+}
@@ -0,0 +1,30 @@
+package main
+
+/*
+#cgo CFLAGS: -DFACTOR=3
+#cgo LDFLAGS: -lm
+#include <stdio.h>
+#include <stdlib.h>
+#include <math.h>
+
+static int twice(int x) { return x * 2 * FACTOR / 3; }
+typedef struct { int a; double b; } pair;
+static double sum(pair p) { return p.a + p.b; }
+extern int goCallback(int);
+static int callGo(int x) { return goCallback(x) + 1; }
+static void hello(void) { puts("hello from C"); fflush(stdout); }
+*/
+import "C"
+import "unsafe"
+
+//export goCallback
+func goCallback(x C.int) C.int { return x * 10 }
+
+func main() {
+	cs := C.CString("hello")
+	defer C.free(unsafe.Pointer(cs))
+	C.hello()
+	println(C.twice(21), C.sqrt(16), C.GoString(cs))
+	p := C.pair{a: 1, b: 2.5}
+	println(C.sum(p), C.callGo(4))
+}
@@ -0,0 +1,74 @@
+package main
+
+/*
+#cgo LDFLAGS: -lpthread
+#include <pthread.h>
+
+extern int goSum(int);
+extern int goRecover(int);
+
+static void *worker(void *arg) {
+	int n = *(int *)arg;
+	*(int *)arg = goSum(n) + goRecover(n);
+	return NULL;
+}
+
+// spawn calls Go on a thread not created by Go.
+static int spawn(int n) {
+	pthread_t t;
+	if (pthread_create(&t, NULL, worker, &n) != 0) {
+		return -1;
+	}
+	pthread_join(t, NULL);
+	return n;
+}
+
+static int callRecover(int n) { return goRecover(n); }
+*/
+import "C"
+
+import (
+	"runtime"
+	"strconv"
+)
+
+//export goSum
+func goSum(n C.int) C.int {
+	var s []string
+	for i := 0; i < int(n); i++ {
+		s = append(s, strconv.Itoa(i))
+	}
+	runtime.GC()
+	sum := 0
+	for _, v := range s {
+		i, _ := strconv.Atoi(v)
+		sum += i
+	}
+	return C.int(sum)
+}
+
+//export goRecover
+func goRecover(n C.int) (ret C.int) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = -1
+		}
+	}()
+	if n > 0 {
+		panic("callback")
+	}
+	return n
+}
+
+func main() {
+	for i := 0; i < 3; i++ {
+		println(C.spawn(100))
+	}
+	ch := make(chan C.int)
+	go func() { ch <- C.spawn(10) }()
+	println(<-ch)
+
+	defer func() { println("recovered in main:", recover() != nil) }()
+	println(C.callRecover(1))
+	panic("main")
+}
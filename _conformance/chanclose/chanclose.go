@@ -0,0 +1,56 @@
+package main
+
+func try(name string, f func()) {
+	defer func() {
+		switch e := recover().(type) {
+		case error:
+			println(name+":", e.Error())
+		case string:
+			println(name+":", e)
+		}
+	}()
+	f()
+}
+
+func main() {
+	c := make(chan int, 3)
+	c <- 1
+	c <- 2
+	println("len:", len(c), "cap:", cap(c))
+	close(c)
+	for i := 0; i < 3; i++ {
+		v, ok := <-c
+		println("recv:", v, ok)
+	}
+
+	var nilc chan int
+	println("nil len:", len(nilc), "cap:", cap(nilc))
+	select {
+	case v := <-nilc:
+		println("unexpected", v)
+	default:
+		println("nil: not ready")
+	}
+
+	u := make(chan string)
+	close(u)
+	s, ok := <-u
+	println("unbuffered:", s == "", ok)
+
+	try("send", func() { c <- 3 })
+	try("close", func() { close(c) })
+	try("close nil", func() { close(nilc) })
+
+	// a blocked sender panics when the channel is closed
+	b := make(chan int, 1)
+	b <- 1
+	done := make(chan bool)
+	go func() {
+		defer func() {
+			done <- recover() != nil
+		}()
+		b <- 2
+	}()
+	close(b)
+	println("blocked send panics:", <-done)
+}
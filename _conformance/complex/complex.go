@@ -0,0 +1,46 @@
+package main
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+)
+
+type cplx complex64
+
+func str(c complex128) string {
+	return strconv.FormatComplex(c, 'g', -1, 128)
+}
+
+func str64(c complex64) string {
+	return strconv.FormatComplex(complex128(c), 'g', -1, 64)
+}
+
+func main() {
+	a, b := 1+2i, 3+4i
+	var zero complex128
+	println("mul:", str(a*b))
+	println("quo:", str(a/b))
+	println("quo zero:", str(a/zero), str(-a/zero), str(zero/zero))
+
+	inf := math.Inf(1)
+	nan := math.NaN()
+	println("inf quo:", str(complex(inf, 1)/b), str(b/complex(inf, nan)))
+	println("nan quo:", str(complex(nan, nan)/b), cmplx.IsNaN(a/complex(nan, 0)))
+
+	var x, y complex64 = 1 + 2i, 3 + 4i
+	println("complex64:", str64(x*y), str64(x/y), str64(x/0))
+	var t cplx = cplx(x)
+	t /= cplx(y)
+	println("named:", str64(complex64(t)), real(t) == real(x/y))
+
+	println("conv:", str(complex128(x)), str64(complex64(b)))
+
+	const c = complex(3, 4)
+	const r, i = real(c), imag(c)
+	var arr [int(r)]int
+	println("const:", len(arr), int(i), int(real(c*c)), int(imag(c/2)))
+
+	println("cmplx:", int(cmplx.Abs(b)), str(cmplx.Conj(b)), str(cmplx.Sqrt(-4)))
+	println("cmplx inf:", cmplx.IsInf(cmplx.Inf()), cmplx.IsInf(a/zero))
+}
@@ -0,0 +1,26 @@
+package main
+
+const (
+	huge    = 1 << 200
+	divisor = 1 << 190
+	third   = 1.0 / 3
+)
+
+func main() {
+	println("huge:", huge/divisor, huge>>195, (huge+1)%1000)
+	println("third:", third*3 == 1, int(third*3e9))
+
+	var f32 float32 = 1 + 1.0/(1<<24) + 1.0/(1<<60)
+	println("float32:", f32 > 1, f32 == 1+1.0/(1<<23))
+
+	var f64 float64 = 1 + 1.0/(1<<53) + 1.0/(1<<100)
+	println("float64:", f64 > 1)
+
+	const big = 1<<64 - 1
+	var u uint64 = big
+	var i8 int8 = -(1 << 7)
+	println("ints:", u == 1<<64-1, u>>63, i8)
+
+	const c = complex(1.0/3, huge/divisor)
+	println("complex:", int(real(c)*3), int(imag(c)))
+}
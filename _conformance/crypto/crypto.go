@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// results computes digests and AEAD outputs of lengths which cross the
+// blocks processed at a time, one per line.
+func results() string {
+	var b strings.Builder
+	msg := make([]byte, 1000)
+	for i := range msg {
+		msg[i] = byte(i * 7)
+	}
+	for _, n := range []int{0, 3, 55, 56, 64, 200, 1000} {
+		sum := sha256.Sum256(msg[:n])
+		b.WriteString(hex.EncodeToString(sum[:]) + "\n")
+	}
+	for _, size := range []int{16, 24, 32} {
+		block, err := aes.NewCipher(msg[:size])
+		if err != nil {
+			panic(err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			panic(err)
+		}
+		for _, n := range []int{0, 17, 64, 999} {
+			ct := aead.Seal(nil, msg[100:112], msg[:n], msg[500:520])
+			pt, err := aead.Open(nil, msg[100:112], ct, msg[500:520])
+			if err != nil || !bytes.Equal(pt, msg[:n]) {
+				panic("open failed")
+			}
+			ct[0] ^= 1
+			if _, err := aead.Open(nil, msg[100:112], ct, msg[500:520]); err == nil {
+				panic("tampered message opened")
+			}
+			ct[0] ^= 1
+			b.WriteString(hex.EncodeToString(ct[len(ct)-16:]) + "\n")
+		}
+	}
+	return b.String()
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "child" {
+		os.Stdout.WriteString(results())
+		return
+	}
+
+	sum := sha256.Sum256([]byte("abc"))
+	println(hex.EncodeToString(sum[:]))
+
+	// Test Case 2 of the GCM specification
+	block, _ := aes.NewCipher(make([]byte, 16))
+	aead, _ := cipher.NewGCM(block)
+	println(hex.EncodeToString(aead.Seal(nil, make([]byte, 12), make([]byte, 16), nil)))
+
+	// the portable code gives the same results as the instructions
+	cmd := exec.Command(os.Args[0], "child")
+	cmd.Env = append(os.Environ(), "GODEBUG=cpu.all=off")
+	out, err := cmd.Output()
+	if err != nil {
+		println("error:", err.Error())
+	}
+	println(string(out) == results())
+}
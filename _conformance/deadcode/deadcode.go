@@ -0,0 +1,45 @@
+package main
+
+type Shape interface {
+	Area() int
+	Name() string
+}
+
+type Namer interface {
+	Name() string
+}
+
+type Rect struct{ W, H int }
+
+func (r Rect) Area() int      { return r.W * r.H }
+func (r Rect) Name() string   { return "rect" }
+func (r *Rect) Scale(k int)   { r.W *= k; r.H *= k }
+func (r Rect) perimeter() int { return 2 * (r.W + r.H) }
+
+type Circle struct{ R int }
+
+func (c Circle) Area() int    { return 3 * c.R * c.R }
+func (c Circle) Name() string { return "circle" }
+
+type sizer interface{ perimeter() int }
+
+func main() {
+	shapes := []any{Rect{2, 3}, &Rect{1, 1}, Circle{2}}
+	for _, s := range shapes {
+		// Area is never called: the assertion still succeeds
+		_, isShape := s.(Shape)
+		n := s.(Namer)
+		println(n.Name(), isShape)
+	}
+
+	var x any = Rect{4, 5}
+	if sz, ok := x.(sizer); ok {
+		println("perimeter:", sz.perimeter())
+	}
+
+	r := &Rect{1, 2}
+	r.Scale(3)
+	scale := r.Scale
+	scale(2)
+	println("scaled:", r.W, r.H)
+}
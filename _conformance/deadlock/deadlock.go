@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+var children = map[string]func(){
+	// main waits for a goroutine which waits for main
+	"chan": func() {
+		ch := make(chan int)
+		done := make(chan bool)
+		go func() {
+			ch <- <-ch
+			done <- true
+		}()
+		<-done
+	},
+	// the only goroutine which could unlock exits
+	"mutex": func() {
+		var mu sync.Mutex
+		mu.Lock()
+		go func() {}()
+		mu.Lock()
+	},
+	"select": func() {
+		select {}
+	},
+	// a pending timer wakes main
+	"timer": func() {
+		<-time.After(100 * time.Millisecond)
+		println("timer fired")
+	},
+	// a sleeping goroutine wakes main
+	"sleep": func() {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			wg.Done()
+		}()
+		wg.Wait()
+		println("woken")
+	},
+}
+
+// run runs a child, and prints how it ended.
+func run(name string) {
+	out, err := exec.Command(os.Args[0], name).CombinedOutput()
+	code := 0
+	if e, ok := err.(*exec.ExitError); ok {
+		code = e.ExitCode()
+	}
+	s := string(out)
+	dead := strings.HasPrefix(s, "fatal error: all goroutines are asleep - deadlock!\n\ngoroutine 1 [")
+	println(name, code, dead, strings.Contains(s, "main.main"))
+	if !dead && code == 0 {
+		print(s)
+	}
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		children[os.Args[1]]()
+		return
+	}
+	for _, name := range []string{"chan", "mutex", "select", "timer", "sleep"} {
+		run(name)
+	}
+}
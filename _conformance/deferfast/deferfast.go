@@ -0,0 +1,99 @@
+package main
+
+import "sync"
+
+var mu sync.Mutex
+
+var count int
+
+func locked() {
+	mu.Lock()
+	defer mu.Unlock()
+	count++
+}
+
+// many defers more nodes than fit in the arena of the frame: the rest are on
+// the heap, and all run in LIFO order.
+func many(n int) (ret []int) {
+	for i := 0; i < n; i++ {
+		defer func(i int) {
+			ret = append(ret, i)
+		}(i)
+	}
+	return nil
+}
+
+type big struct {
+	a [64]int
+}
+
+// large defers calls with arguments larger than the arena.
+func large() (sum int) {
+	for i := 0; i < 3; i++ {
+		var b big
+		b.a[63] = i + 1
+		defer func(b big) {
+			sum = sum*10 + b.a[63]
+		}(b)
+	}
+	return 0
+}
+
+func recurse(n int) (ret int) {
+	for i := 0; i < 2; i++ {
+		defer func() {
+			ret++
+		}()
+	}
+	if n > 0 {
+		return recurse(n - 1)
+	}
+	return 0
+}
+
+// panics panics in a deferred call in a loop: the others still run.
+func panics(n int) (ret int, err interface{}) {
+	defer func() {
+		err = recover()
+	}()
+	for i := 0; i < n; i++ {
+		defer func(i int) {
+			ret += i
+			if i == n/2 {
+				panic("panic in defer")
+			}
+		}(i)
+	}
+	return 0, nil
+}
+
+func main() {
+	for i := 0; i < 1000; i++ {
+		locked()
+	}
+	println(count)
+
+	r := many(100)
+	println(len(r), r[0], r[99])
+	println(large())
+	println(recurse(10))
+	ret, err := panics(40)
+	println(ret, err.(string))
+
+	// every thread has its own list of frames with defers
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				locked()
+				if len(many(50)) != 50 {
+					panic("bad defers")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	println(count)
+}
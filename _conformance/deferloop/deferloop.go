@@ -0,0 +1,52 @@
+package main
+
+func loop(n int) {
+	defer println("loop: first")
+	for i := 0; i < n; i++ {
+		defer println("loop:", i)
+		if i%2 == 0 {
+			defer func(i int) {
+				println("loop: even", i)
+			}(i)
+		}
+	}
+	defer println("loop: last")
+}
+
+func sum(n int) (ret int) {
+	for i := 1; i <= n; i++ {
+		defer func(i int) {
+			ret += i
+		}(i)
+	}
+	return 0
+}
+
+func nested() {
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			defer println("nested:", i, j)
+		}
+	}
+}
+
+func recovered(n int) (ret int) {
+	defer func() {
+		if e := recover(); e != nil {
+			println("recovered:", e.(string), ret)
+		}
+	}()
+	for i := 0; i < n; i++ {
+		defer func() {
+			ret++
+		}()
+	}
+	panic("loop panic")
+}
+
+func main() {
+	loop(4)
+	println(sum(10))
+	nested()
+	println(recovered(3))
+}
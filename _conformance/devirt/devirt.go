@@ -0,0 +1,79 @@
+package main
+
+type Animal interface {
+	Sound() string
+	Legs() int
+}
+
+type Named interface {
+	Animal
+	Name() string
+}
+
+type dog struct{ name string }
+
+func (d dog) Sound() string { return "woof" }
+func (d dog) Legs() int     { return 4 }
+func (d dog) Name() string  { return d.name }
+
+type bird struct{ n int }
+
+func (b *bird) Sound() string { b.n++; return "tweet" }
+func (b *bird) Legs() int     { return 2 }
+
+type Box[T any] struct{ v T }
+
+func (b Box[T]) Sound() string { return "box" }
+func (b Box[T]) Legs() int     { return 0 }
+
+type wrapped struct{ dog }
+
+func describe(a Animal) {
+	switch a.(type) {
+	case dog:
+		println("dog:", a.Sound(), a.Legs())
+	case *bird:
+		println("bird:", a.Sound(), a.Legs())
+	default:
+		println("other:", a.Sound(), a.Legs())
+	}
+	if n, ok := a.(Named); ok {
+		println("named:", n.Name())
+	}
+}
+
+func main() {
+	var a Animal = dog{"rex"}
+	println(a.Sound(), a.Legs())
+
+	b := &bird{}
+	var c Animal = b
+	println(c.Sound(), c.Sound(), b.n)
+
+	var n Named = dog{"fido"}
+	var a2 Animal = n
+	println(a2.Sound(), n.Name())
+
+	var g Animal = Box[int]{1}
+	println(g.Sound(), g.Legs())
+
+	var w Animal = wrapped{dog{"max"}}
+	println(w.Sound())
+
+	defer func() {
+		println("recovered:", recover() != nil)
+	}()
+	describe(a)
+	describe(c)
+	describe(g)
+	describe(w)
+	go a.Sound()
+	defer c.Legs()
+
+	var nilBird *bird
+	var d Animal = nilBird
+	println(d.Legs())
+	var nilDog *dog
+	var e Animal = nilDog
+	println(e.Legs())
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed hello.txt
+var hello string
+
+//go:embed hello.txt
+var helloBytes []byte
+
+//go:embed static
+var static embed.FS
+
+//go:embed all:static hello.txt
+var all embed.FS
+
+func walk(fsys embed.FS) {
+	fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			panic(err)
+		}
+		if d.IsDir() {
+			println("dir", path)
+		} else {
+			data, _ := fsys.ReadFile(path)
+			print("file ", path, ": ", string(data))
+		}
+		return nil
+	})
+}
+
+func main() {
+	print(hello)
+	helloBytes[0] = 'j'
+	print(string(helloBytes))
+	walk(static)
+	walk(all)
+}
@@ -0,0 +1,66 @@
+package main
+
+type point struct{ x, y int }
+
+func (p *point) add(q *point) { p.x += q.x; p.y += q.y }
+
+func norm1(p *point) int {
+	if p.x < 0 {
+		p.x = -p.x
+	}
+	if p.y < 0 {
+		p.y = -p.y
+	}
+	return p.x + p.y
+}
+
+func depth(p *point, n int) int {
+	if n == 0 {
+		return p.x
+	}
+	p.x++
+	return depth(p, n-1)
+}
+
+func keep(p *point) *point { return p }
+
+var saved []*point
+
+func save(p *point) { saved = append(saved, p) }
+
+func main() {
+	// non-escaping values, allocated again in each iteration
+	sum := 0
+	for i := 0; i < 4; i++ {
+		p := &point{i, -i}
+		p.add(&point{1, 1})
+		sum += norm1(p)
+		arr := make([]int, 3)
+		arr[i%3] = i
+		sum += arr[0] + arr[1] + arr[2]
+	}
+	println(sum)
+
+	// recursion with a non-escaping argument
+	println(depth(&point{}, 10))
+
+	// escaping values must stay distinct
+	var ps []*point
+	for i := 0; i < 3; i++ {
+		ps = append(ps, keep(&point{i, i}))
+		save(&point{i * 10, 0})
+	}
+	for _, p := range ps {
+		println(p.x, p.y)
+	}
+	for _, p := range saved {
+		println(p.x)
+	}
+
+	// a variable whose address is taken by a closure
+	n := 0
+	inc := func() { n++ }
+	inc()
+	inc()
+	println(n)
+}
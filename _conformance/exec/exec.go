@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+func main() {
+	out, err := exec.Command("echo", "hello", "world").Output()
+	fmt.Printf("%q %v\n", out, err)
+
+	cmd := exec.Command("cat")
+	cmd.Stdin = strings.NewReader("piped through cat\n")
+	out, err = cmd.Output()
+	fmt.Printf("%q %v\n", out, err)
+
+	cmd = exec.Command("sh", "-c", "echo $0 $PWD >&2", "sh")
+	cmd.Dir = "/"
+	out, err = cmd.CombinedOutput()
+	fmt.Printf("%q %v\n", out, err)
+
+	err = exec.Command("sh", "-c", "exit 3").Run()
+	var ee *exec.ExitError
+	if errors.As(err, &ee) {
+		fmt.Println(ee, ee.ExitCode(), ee.Exited())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = exec.CommandContext(ctx, "sleep", "10").Run()
+	fmt.Println(err, time.Since(start) < 5*time.Second)
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		panic(err)
+	}
+	cmd = exec.Command("sh", "-c", "echo from fd 3 >&3", "sh")
+	cmd.ExtraFiles = []*os.File{w}
+	if err := cmd.Start(); err != nil {
+		panic(err)
+	}
+	w.Close()
+	buf := make([]byte, 64)
+	n, _ := r.Read(buf)
+	r.Close()
+	fmt.Printf("%q %v\n", buf[:n], cmd.Wait())
+
+	_, err = exec.Command("/nonexistent/command").Output()
+	fmt.Println(err)
+}
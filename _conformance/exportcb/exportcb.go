@@ -0,0 +1,39 @@
+package main
+
+/*
+extern void goGreet(int);
+
+static void visit(int n) {
+	for (int i = 0; i < n; i++) {
+		goGreet(i);
+	}
+}
+*/
+import "C"
+
+type Greeter interface {
+	Greet(i int) string
+}
+
+type English struct{}
+
+func (English) Greet(i int) string { return "hello" }
+
+type French struct{}
+
+func (French) Greet(i int) string { return "bonjour" }
+
+var greeters []Greeter
+
+// goGreet makes the only calls of Greet: the method is live only if the
+// callback, called from C, is part of the analysis.
+//
+//export goGreet
+func goGreet(i C.int) {
+	println(i, greeters[int(i)%len(greeters)].Greet(int(i)))
+}
+
+func main() {
+	greeters = append(greeters, English{}, French{})
+	C.visit(3)
+}
@@ -0,0 +1,22 @@
+package main
+
+import "math"
+
+//go:noinline
+func f64(x float64) float64 { return x }
+
+//go:noinline
+func f32(x float32) float32 { return x }
+
+func main() {
+	println("int8:", int8(f64(127.9)), int8(f64(128.5)), int8(f64(-129.5)), int8(f64(300)))
+	println("uint8:", uint8(f64(255.5)), uint8(f64(256)), uint8(f64(-1)), uint8(f32(257)))
+	println("int16:", int16(f64(40000)), uint16(f64(-2)))
+	println("int32:", int32(f32(-2147483648)), int32(f64(2147483648)), uint32(f64(-1.5)), uint32(f32(5294967295)))
+	println("int64:", int64(f64(-1e18)), int64(f32(1<<40)), int64(f64(-0.9)))
+	println("uint64:", uint64(f64(1e19)), uint64(f64(1<<63)), uint64(f64(-1)), uint64(f32(1<<63+1<<62)))
+	println("trunc:", int(f64(2.9)), int(f64(-2.9)), uint(f64(3.99)))
+
+	nan := math.NaN()
+	println("nan:", int8(f64(nan)), uint16(f32(float32(nan))))
+}
@@ -0,0 +1,53 @@
+package main
+
+import "unsafe"
+
+type Pair[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+func (p *Pair[K, V]) Swap(k K, v V) (K, V) {
+	ok, ov := p.Key, p.Val
+	p.Key, p.Val = k, v
+	return ok, ov
+}
+
+func size[T any]() uintptr {
+	var x T
+	return unsafe.Sizeof(x)
+}
+
+func apply[T any](xs []T, f func(T) T) []T {
+	for i, x := range xs {
+		xs[i] = f(x)
+	}
+	return xs
+}
+
+func small() uintptr {
+	type T struct{ a int8 }
+	return size[T]()
+}
+
+func large() uintptr {
+	type T struct{ a, b, c int64 }
+	return size[T]()
+}
+
+func main() {
+	println("sizes:", small(), large(), size[int16]())
+
+	p := &Pair[string, int]{"a", 1}
+	k, v := p.Swap("b", 2)
+	println("swap:", k, v, p.Key, p.Val)
+
+	q := &Pair[int, string]{1, "x"}
+	k2, v2 := q.Swap(2, "y")
+	println("swap:", k2, v2, q.Key, q.Val)
+
+	xs := apply([]int{1, 2, 3}, func(x int) int { return x * 10 })
+	println("apply:", xs[0], xs[1], xs[2])
+	ss := apply([]string{"a", "b"}, func(s string) string { return s + s })
+	println("apply:", ss[0], ss[1])
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+var sink []byte
+
+// child allocates and collects a while, for the traces of the runtime.
+func child() {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 1000; j++ {
+			sink = make([]byte, 1<<10)
+		}
+		runtime.GC()
+		time.Sleep(30 * time.Millisecond)
+	}
+}
+
+// trace runs the child with GODEBUG set to env, and reports whether its
+// stderr has a line starting with prefix.
+func trace(env, prefix string) bool {
+	cmd := exec.Command(os.Args[0], "child")
+	cmd.Env = append(os.Environ(), "GODEBUG="+env)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		println("error:", err.Error())
+		return false
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "child" {
+		child()
+		return
+	}
+	println(trace("gctrace=1", "gc 1 @"))
+	println(trace("schedtrace=10", "SCHED "))
+	println(trace("gctrace=1,gctrace=0", "gc "))
+	println(trace("", "gc "))
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+func main() {
+	var stop, spinning atomic.Bool
+	done := make(chan bool)
+	go func() {
+		spinning.Store(true)
+		for !stop.Load() { // no calls: must be preempted
+		}
+		done <- true
+	}()
+	for !spinning.Load() {
+		runtime.Gosched()
+	}
+	for i := 0; i < 100; i++ {
+		runtime.Gosched()
+	}
+	stop.Store(true)
+	println("stopped:", <-done)
+}
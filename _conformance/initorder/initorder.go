@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"strings"
+)
+
+var trace []string
+
+func record(name string, v int) int {
+	trace = append(trace, name)
+	return v
+}
+
+// a depends on b and c, which are declared after it: they are initialized
+// first, b before c as c depends on b.
+var a = record("a", b+c)
+
+var c = record("c", b*10)
+
+var b = record("b", 1)
+
+var d, e = pair()
+
+func pair() (int, int) {
+	return record("d,e", a), 2
+}
+
+// errNotFound is initialized by the errors package, imported before main.
+var errNotFound = errors.New("not found")
+
+var upper = strings.ToUpper("x")
+
+func init() {
+	trace = append(trace, "init1")
+}
+
+func init() {
+	trace = append(trace, "init2")
+}
+
+func main() {
+	println(strings.Join(trace, " "))
+	println(a, b, c, d, e)
+	println(errNotFound.Error(), upper)
+}
@@ -0,0 +1,52 @@
+package main
+
+import "fmt"
+
+type Shape interface {
+	Area() int
+	Name() string
+}
+
+type rect struct{ w, h int }
+
+func (r rect) Area() int      { return r.w * r.h }
+func (r rect) Name() string   { return "rect" }
+func (r *rect) Scale(n int)   { r.w *= n; r.h *= n }
+func (r rect) String() string { return fmt.Sprintf("rect(%d, %d)", r.w, r.h) }
+
+type square int
+
+func (s square) Area() int    { return int(s * s) }
+func (s square) Name() string { return "square" }
+
+// converted during the package initialization
+var initial Shape = square(3)
+
+var shapes []Shape
+
+func init() {
+	shapes = append(shapes, rect{2, 3}, initial)
+}
+
+func total(ss ...Shape) (n int) {
+	for _, s := range ss {
+		n += s.Area()
+	}
+	return
+}
+
+func main() {
+	r := &rect{1, 2}
+	for i := 0; i < 3; i++ {
+		shapes = append(shapes, square(i), r)
+	}
+	r.Scale(2)
+	for _, s := range shapes {
+		println(s.Name(), s.Area())
+	}
+	println(total(shapes...))
+	var st fmt.Stringer = rect{4, 5}
+	fmt.Println(st, r)
+	_, ok := initial.(fmt.Stringer)
+	println(ok)
+}
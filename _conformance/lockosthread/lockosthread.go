@@ -0,0 +1,56 @@
+package main
+
+/*
+static __thread int state;
+
+static void setState(int v) { state = v; }
+static int getState(void) { return state; }
+*/
+import "C"
+
+import "runtime"
+
+func init() {
+	runtime.LockOSThread() // main runs on the startup thread
+}
+
+func run(f func()) {
+	done := make(chan bool)
+	go func() {
+		f()
+		done <- true
+	}()
+	<-done
+}
+
+func main() {
+	// per-thread state of C stays with a locked goroutine
+	run(func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		C.setState(1)
+		runtime.Gosched()
+		println(C.getState())
+	})
+
+	// a thread is terminated when its goroutine exits locked
+	run(func() {
+		runtime.LockOSThread()
+		C.setState(2)
+	})
+	dirty := 0
+	for i := 0; i < 20; i++ {
+		run(func() {
+			if C.getState() == 2 {
+				dirty++
+			}
+		})
+	}
+	println(dirty)
+
+	// unmatched unlocks are no-ops
+	runtime.UnlockOSThread()
+	runtime.UnlockOSThread()
+	runtime.LockOSThread()
+	println("ok")
+}
@@ -0,0 +1,31 @@
+//go:build go1.22
+
+// Per-iteration loop variables need no support of the compiler: go/ssa
+// already allocates a new variable for each iteration of loops of Go 1.22
+// packages, and copies it to the next one. This checks it.
+package main
+
+func main() {
+	var fns []func() int
+	for i := 0; i < 3; i++ {
+		fns = append(fns, func() int { return i })
+	}
+	for _, v := range []int{10, 20} {
+		fns = append(fns, func() int { return v })
+	}
+	for i := range 2 {
+		fns = append(fns, func() int { return i * 100 })
+	}
+	for _, fn := range fns {
+		println(fn())
+	}
+
+	var ptrs []*int
+	for i := 0; i < 3; i++ {
+		ptrs = append(ptrs, &i)
+		i++ // updates the copy of this iteration, which is copied to the next one
+	}
+	for _, p := range ptrs {
+		println(*p)
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+)
+
+type Point struct{ X, Y int }
+
+func (p Point) Add(q Point) Point { return Point{p.X + q.X, p.Y + q.Y} }
+func (p *Point) Scale(k int)      { p.X *= k; p.Y *= k }
+func (p Point) String() string    { return "(" + itoa(p.X) + "," + itoa(p.Y) + ")" }
+
+type Stringer interface{ String() string }
+
+func itoa(n int) string {
+	if n < 0 {
+		return "-" + itoa(-n)
+	}
+	if n < 10 {
+		return string(rune('0' + n))
+	}
+	return itoa(n/10) + string(rune('0'+n%10))
+}
+
+func divmod(a, b int) (int, int) { return a / b, a % b }
+
+func join(sep string, parts ...string) string { return strings.Join(parts, sep) }
+
+func call() {
+	out := reflect.ValueOf(divmod).Call([]reflect.Value{reflect.ValueOf(17), reflect.ValueOf(5)})
+	println("divmod:", len(out), out[0].Int(), out[1].Int())
+
+	base := 100
+	add := func(x int, f float64, s string) (string, float64) { return s + itoa(x+base), f * 2 }
+	out = reflect.ValueOf(add).Call([]reflect.Value{reflect.ValueOf(1), reflect.ValueOf(1.25), reflect.ValueOf("n=")})
+	println("closure:", out[0].String(), out[1].Float())
+
+	out = reflect.ValueOf(strings.ToUpper).Call([]reflect.Value{reflect.ValueOf("abc")})
+	println("ToUpper:", out[0].String())
+
+	jv := reflect.ValueOf(join)
+	out = jv.Call([]reflect.Value{reflect.ValueOf("-"), reflect.ValueOf("a"), reflect.ValueOf("b")})
+	println("variadic:", out[0].String())
+	out = jv.CallSlice([]reflect.Value{reflect.ValueOf("+"), reflect.ValueOf([]string{"x", "y", "z"})})
+	println("CallSlice:", out[0].String())
+}
+
+func methods() {
+	p := Point{1, 2}
+	v := reflect.ValueOf(p)
+	out := v.MethodByName("Add").Call([]reflect.Value{reflect.ValueOf(Point{10, 20})})
+	println("Add:", out[0].Interface().(Point).String())
+
+	pv := reflect.ValueOf(&p)
+	pv.MethodByName("Scale").Call([]reflect.Value{reflect.ValueOf(3)})
+	println("Scale:", p.X, p.Y)
+
+	f := v.MethodByName("String").Interface().(func() string)
+	println("method value:", f())
+
+	m, _ := reflect.TypeOf(p).MethodByName("Add")
+	out = m.Func.Call([]reflect.Value{v, reflect.ValueOf(Point{5, 5})})
+	println("method func:", out[0].Interface().(Point).String())
+	m = reflect.TypeOf(&p).Method(1)
+	m.Func.Call([]reflect.Value{pv, reflect.ValueOf(2)})
+	println(m.Name+" func:", p.X, p.Y)
+
+	var s Stringer = Point{7, 8}
+	iv := reflect.ValueOf(&s).Elem()
+	println("interface method:", iv.Method(0).Call(nil)[0].String())
+}
+
+func makeFunc() {
+	swap := func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{in[1], in[0]}
+	}
+	var intSwap func(int, int) (int, int)
+	fn := reflect.ValueOf(&intSwap).Elem()
+	fn.Set(reflect.MakeFunc(fn.Type(), swap))
+	a, b := intSwap(1, 2)
+	println("intSwap:", a, b)
+
+	var strSwap func(string, string) (string, string)
+	fn = reflect.ValueOf(&strSwap).Elem()
+	fn.Set(reflect.MakeFunc(fn.Type(), swap))
+	x, y := strSwap("left", "right")
+	println("strSwap:", x, y)
+
+	sum := reflect.MakeFunc(reflect.TypeOf(func(...int) int { return 0 }), func(in []reflect.Value) []reflect.Value {
+		n := 0
+		for i := 0; i < in[0].Len(); i++ {
+			n += int(in[0].Index(i).Int())
+		}
+		return []reflect.Value{reflect.ValueOf(n)}
+	})
+	println("sum:", sum.Interface().(func(...int) int)(1, 2, 3, 4))
+	println("call made:", sum.Call([]reflect.Value{reflect.ValueOf(5), reflect.ValueOf(6)})[0].Int())
+
+	var cb func(Point) string
+	reflect.ValueOf(&cb).Elem().Set(reflect.MakeFunc(reflect.TypeOf(cb), func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf("got " + in[0].Interface().(Point).String())}
+	}))
+	println("struct arg:", cb(Point{3, 4}))
+}
+
+func main() {
+	call()
+	methods()
+	makeFunc()
+}
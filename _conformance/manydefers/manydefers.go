@@ -0,0 +1,90 @@
+package main
+
+// f has more defer statements than a function has defer bits.
+func f(n int) {
+	defer print(" 0")
+	defer print(" 1")
+	defer print(" 2")
+	defer print(" 3")
+	defer print(" 4")
+	defer print(" 5")
+	defer print(" 6")
+	defer print(" 7")
+	defer print(" 8")
+	defer print(" 9")
+	defer print(" 10")
+	defer print(" 11")
+	defer print(" 12")
+	defer print(" 13")
+	defer print(" 14")
+	defer print(" 15")
+	defer print(" 16")
+	defer print(" 17")
+	defer print(" 18")
+	defer print(" 19")
+	defer print(" 20")
+	defer print(" 21")
+	defer print(" 22")
+	defer print(" 23")
+	defer print(" 24")
+	defer print(" 25")
+	defer print(" 26")
+	defer print(" 27")
+	defer print(" 28")
+	defer print(" 29")
+	defer print(" 30")
+	defer print(" 31")
+	defer print(" 32")
+	defer print(" 33")
+	defer print(" 34")
+	defer print(" 35")
+	defer print(" 36")
+	defer print(" 37")
+	defer print(" 38")
+	defer print(" 39")
+	defer print(" 40")
+	defer print(" 41")
+	defer print(" 42")
+	defer print(" 43")
+	defer print(" 44")
+	defer print(" 45")
+	defer print(" 46")
+	defer print(" 47")
+	defer print(" 48")
+	defer print(" 49")
+	defer print(" 50")
+	defer print(" 51")
+	defer print(" 52")
+	defer print(" 53")
+	defer print(" 54")
+	defer print(" 55")
+	defer print(" 56")
+	defer print(" 57")
+	defer print(" 58")
+	defer print(" 59")
+	defer print(" 60")
+	defer print(" 61")
+	defer print(" 62")
+	defer print(" 63")
+	defer print(" 64")
+	defer print(" 65")
+	defer print(" 66")
+	defer print(" 67")
+	defer print(" 68")
+	defer print(" 69")
+	if n > 0 {
+		panic(n)
+	}
+}
+
+func main() {
+	f(0)
+	println()
+	func() {
+		defer func() {
+			println()
+			println("recovered:", recover().(int))
+		}()
+		f(1)
+	}()
+}
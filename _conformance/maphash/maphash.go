@@ -0,0 +1,66 @@
+package main
+
+import (
+	"hash/maphash"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+type point struct {
+	x, y int
+	name string
+}
+
+// child fills maps with keys of all the sizes the hash handles differently,
+// and checks every key is found.
+func child() {
+	ok := true
+	for _, n := range []int{1, 4, 8, 15, 16, 17, 32, 33, 64, 65, 128, 129, 1000} {
+		m := make(map[string]int)
+		for i := 0; i < 1000; i++ {
+			m[strings.Repeat("k", n-1)+strconv.Itoa(i)] = i
+		}
+		for i := 0; i < 1000; i++ {
+			if m[strings.Repeat("k", n-1)+strconv.Itoa(i)] != i {
+				ok = false
+			}
+		}
+		ok = ok && len(m) == 1000
+	}
+	m := make(map[point]int)
+	for i := 0; i < 1000; i++ {
+		m[point{i, -i, "p"}] = i
+	}
+	ok = ok && len(m) == 1000 && m[point{7, -7, "p"}] == 7
+	println(ok)
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "child" {
+		child()
+		return
+	}
+
+	seed := maphash.MakeSeed()
+	var h maphash.Hash
+	h.SetSeed(seed)
+	h.WriteString("hello, ")
+	h.WriteString("world")
+	println(h.Sum64() == maphash.String(seed, "hello, world"))
+	println(maphash.Bytes(seed, []byte("hello, world")) == maphash.String(seed, "hello, world"))
+	println(maphash.String(seed, "a") != maphash.String(maphash.MakeSeed(), "a"))
+	println(maphash.Comparable(seed, point{1, 2, "p"}) == maphash.Comparable(seed, point{1, 2, "p"}))
+	println(maphash.Comparable(seed, point{1, 2, "p"}) != maphash.Comparable(seed, point{2, 1, "p"}))
+
+	// the AES hash, and the fallback
+	for _, env := range []string{"", "cpu.aes=off"} {
+		cmd := exec.Command(os.Args[0], "child")
+		cmd.Env = append(os.Environ(), "GODEBUG="+env)
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			println("error:", err.Error())
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package main
+
+// orders reports whether ranging over m many times starts with different keys,
+// and visits all of its keys each time.
+func orders(m map[int]int) (varies, complete bool) {
+	first := make(map[int]bool)
+	complete = true
+	for i := 0; i < 100; i++ {
+		n, start := 0, -1
+		for k := range m {
+			if start < 0 {
+				start = k
+			}
+			n++
+		}
+		first[start] = true
+		complete = complete && n == len(m)
+	}
+	return len(first) > 1, complete
+}
+
+func main() {
+	small := make(map[int]int)
+	for i := 0; i < 8; i++ {
+		small[i] = i
+	}
+	println(orders(small))
+
+	large := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		large[i] = i
+	}
+	println(orders(large))
+
+	delete(large, 0)
+	println(orders(large))
+}
@@ -0,0 +1,89 @@
+package main
+
+import "math"
+
+type Stringer interface{ String() string }
+
+type name string
+
+func (n name) String() string { return string(n) }
+
+type point struct{ x, y int }
+
+func (p point) String() string { return "point" }
+
+type blank struct {
+	a int
+	_ int
+}
+
+func nanKeys() {
+	nan := math.NaN()
+	m := make(map[float64]int)
+	m[nan] = 1
+	m[nan] = 2
+	m[math.Copysign(0, -1)] = 3
+	m[0] = 4
+	_, ok := m[nan]
+	println("nan:", len(m), ok, m[0])
+
+	sum := 0
+	for k, v := range m {
+		if k != k {
+			sum += v
+		}
+	}
+	println("nan sum:", sum)
+	delete(m, nan)
+	println("nan delete:", len(m))
+
+	c := map[complex128]bool{complex(nan, 0): true, complex(0, nan): true}
+	println("complex nan:", len(c))
+}
+
+func interfaceKeys() {
+	m := make(map[any]int)
+	m[1] = 1
+	m[int64(1)] = 2
+	m["1"] = 3
+	m[point{1, 2}] = 4
+	m[[2]string{"a", "b"}] = 5
+	m[nil] = 6
+	m[math.NaN()] = 7
+	m[math.NaN()] = 8
+	println("any:", len(m), m[1], m[int64(1)], m["1"], m[point{1, 2}], m[[2]string{"a", "b"}], m[nil])
+
+	var s1, s2 Stringer = name("a"), name("a")
+	var s3 Stringer = point{1, 2}
+	n := map[Stringer]int{s1: 1}
+	n[s2]++
+	n[s3] = 3
+	println("Stringer:", len(n), n[name("a")], n[point{1, 2}])
+
+	var x Stringer = name("b")
+	n[x] = 4
+	var y any = x
+	println("converted:", n[y.(Stringer)])
+}
+
+func blankFields() {
+	m := map[blank]int{{a: 1}: 1}
+	m[blank{a: 1}]++
+	println("blank:", len(m), m[blank{a: 1}])
+}
+
+func unhashable() {
+	defer func() {
+		e := recover().(error)
+		println("recovered:", e.Error())
+	}()
+	m := make(map[any]int)
+	m[[]int{1}] = 1
+}
+
+func main() {
+	nanKeys()
+	interfaceKeys()
+	blankFields()
+	unhashable()
+}
@@ -0,0 +1,23 @@
+package main
+
+import "runtime/debug"
+
+func depth(n int) int {
+	var buf [64]byte
+	buf[n%64] = byte(n)
+	if n == 0 {
+		return 0
+	}
+	return depth(n-1) + int(buf[n%64]&1)
+}
+
+func main() {
+	old := debug.SetMaxStack(1 << 20)
+	println("default:", old)
+	done := make(chan int)
+	go func() {
+		done <- depth(1000)
+	}()
+	println("depth:", <-done)
+	println("restored:", debug.SetMaxStack(old))
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+var sink [][]byte
+
+func main() {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < 1000; i++ {
+		sink = append(sink, make([]byte, 1024))
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	println("forced:", after.NumForcedGC-before.NumForcedGC)
+	println("collected:", after.NumGC > before.NumGC)
+	println("total alloc grew:", after.TotalAlloc-before.TotalAlloc >= 1000*1024)
+	println("heap alloc:", after.HeapAlloc >= 1000*1024, after.HeapAlloc <= after.HeapSys)
+	println("enabled:", after.EnableGC)
+
+	println("gc percent:", debug.SetGCPercent(-1))
+	runtime.ReadMemStats(&before)
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	println("forced while disabled:", after.NumForcedGC-before.NumForcedGC, after.NumGC > before.NumGC)
+	println("gc percent:", debug.SetGCPercent(100))
+
+	println("memory limit:", debug.SetMemoryLimit(-1) == 1<<63-1)
+	println("memory limit:", debug.SetMemoryLimit(64<<20) == 1<<63-1, debug.SetMemoryLimit(-1))
+	debug.SetMemoryLimit(1<<63 - 1)
+	sink = nil
+	debug.FreeOSMemory()
+}
@@ -0,0 +1,52 @@
+package main
+
+type counter struct{ n int }
+
+func (c *counter) inc() int {
+	c.n++
+	return c.n
+}
+
+type point struct{ x, y int }
+
+func (p point) sum() int { return p.x + p.y }
+
+type summer interface{ sum() int }
+
+func call(f func() int) int { return f() }
+
+// bound returns a method value which outlives its frame.
+func bound(c *counter) func() int { return c.inc }
+
+func main() {
+	c := &counter{}
+	f := c.inc // pointer receiver: the receiver is the context
+	f()
+	println("ptr:", f(), call(c.inc), c.n)
+
+	p := point{1, 2}
+	g := p.sum // the receiver is copied when the method value is evaluated
+	p.x = 10
+	println("value:", g(), call(p.sum))
+
+	var s summer = p
+	h := s.sum
+	s = point{5, 5}
+	println("iface:", h(), call(s.sum))
+
+	var fs []func() int
+	for i := 0; i < 3; i++ {
+		q := point{i, i}
+		fs = append(fs, q.sum, bound(&counter{i * 10}))
+	}
+	for _, f := range fs {
+		print(f(), " ")
+	}
+	println()
+
+	n := 0
+	add := func(d int) { n += d } // a single captured variable
+	add(2)
+	add(3)
+	println("closure:", n)
+}
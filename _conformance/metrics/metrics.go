@@ -0,0 +1,69 @@
+package main
+
+import (
+	"expvar"
+	"runtime"
+	"runtime/debug"
+	"runtime/metrics"
+	"strings"
+	"sync"
+)
+
+func read(name string) metrics.Value {
+	s := []metrics.Sample{{Name: name}}
+	metrics.Read(s)
+	return s[0].Value
+}
+
+func main() {
+	descs := metrics.All()
+	samples := make([]metrics.Sample, len(descs))
+	for i, d := range descs {
+		samples[i].Name = d.Name
+	}
+	metrics.Read(samples)
+	kinds := true
+	for i, s := range samples {
+		kinds = kinds && s.Value.Kind() == descs[i].Kind
+	}
+	println("kinds:", len(samples) > 0, kinds)
+	println("unknown:", read("/no/such:metric").Kind() == metrics.KindBad)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	mu.Lock()
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			mu.Unlock()
+		}()
+	}
+	println("goroutines:", read("/sched/goroutines:goroutines").Uint64() >= 5, runtime.NumGoroutine() >= 5)
+	mu.Unlock()
+	wg.Wait()
+	println("gomaxprocs:", read("/sched/gomaxprocs:threads").Uint64() == uint64(runtime.GOMAXPROCS(0)))
+
+	before := read("/gc/cycles/total:gc-cycles").Uint64()
+	runtime.GC()
+	println("gc cycles:", read("/gc/cycles/total:gc-cycles").Uint64() > before, read("/gc/cycles/forced:gc-cycles").Uint64() > 0)
+	println("heap:", read("/memory/classes/heap/objects:bytes").Uint64() > 0, read("/memory/classes/total:bytes").Uint64() > 0)
+
+	h := read("/sched/pauses/total/gc:seconds").Float64Histogram()
+	var pauses uint64
+	for _, n := range h.Counts {
+		pauses += n
+	}
+	println("pauses:", len(h.Buckets) == len(h.Counts)+1, pauses > 0)
+	lat := read("/sched/latencies:seconds").Float64Histogram()
+	println("latencies:", len(lat.Buckets) == len(lat.Counts)+1)
+
+	var stats debug.GCStats
+	debug.ReadGCStats(&stats)
+	println("gc stats:", stats.NumGC > 0, len(stats.Pause) > 0)
+	n, _ := runtime.ThreadCreateProfile(nil)
+	println("threads:", n > 0)
+
+	println("expvar:", expvar.Get("memstats") != nil, strings.Contains(expvar.Get("cmdline").String(), "metrics"))
+}
@@ -0,0 +1,53 @@
+//go:build go1.21
+
+package main
+
+import "math"
+
+type celsius float32
+
+func main() {
+	x, y, z := 3, -1, 2
+	println("int:", min(x, y, z), max(x, y, z), min(x, 10), max(x, 10))
+
+	var u uint8 = 200
+	println("uint8:", min(u, 7), max(u, 7))
+
+	a, b := "banana", "apple"
+	println("string:", min(a, b), max(a, b, "cherry"))
+
+	nan := math.NaN()
+	f := 1.5
+	println("nan:", math.IsNaN(min(f, nan)), math.IsNaN(max(nan, f)), math.IsNaN(min(f, 2, nan)))
+
+	negz := math.Copysign(0, -1)
+	println("zero:", math.Signbit(min(0.0, negz)), math.Signbit(min(negz, 0.0)),
+		math.Signbit(max(0.0, negz)), math.Signbit(max(negz, 0.0)))
+
+	inf := math.Inf(1)
+	println("inf:", min(f, inf) == f, max(f, inf) == inf, min(-inf, f) == -inf)
+
+	var c celsius = 36.6
+	println("named:", min(c, 40) == c, max(c, 40) == 40)
+
+	const k = min(1, 2.5, 'a') // untyped constants stay untyped
+	println("const:", k == 1, max(1<<40, 3) == 1<<40)
+
+	s := []int{1, 2, 3}
+	clear(s[1:])
+	println("slice:", len(s), s[0], s[1], s[2])
+
+	ps := []*int{&x, &y}
+	clear(ps)
+	println("ptrs:", ps[0] == nil, ps[1] == nil)
+
+	m := map[float64]string{1: "a", 2: "b", nan: "nan"}
+	clear(m)
+	println("map:", len(m))
+	m[3] = "c"
+	println("map:", len(m), m[3])
+
+	var nm map[string]int
+	clear(nm)
+	println("nil map:", len(nm))
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+func main() {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	defer ln.Close()
+
+	// echo server
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+
+	// many concurrent connections
+	const n = 100
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	echoed := 0
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conn, err := net.Dial("tcp", ln.Addr().String())
+			if err != nil {
+				panic(err)
+			}
+			defer conn.Close()
+			msg := []byte("hello")
+			conn.Write(msg)
+			buf := make([]byte, len(msg))
+			if _, err := io.ReadFull(conn, buf); err == nil && string(buf) == "hello" {
+				mu.Lock()
+				echoed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	println("echoed:", echoed)
+
+	// read deadlines
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		panic(err)
+	}
+	conn.SetReadDeadline(time.Now().Add(20 * time.Millisecond))
+	_, err = conn.Read(make([]byte, 1))
+	println("timeout:", errors.Is(err, os.ErrDeadlineExceeded))
+
+	// closing unblocks readers
+	done := make(chan error)
+	conn.SetReadDeadline(time.Time{})
+	go func() {
+		_, err := conn.Read(make([]byte, 1))
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	conn.Close()
+	println("closed:", errors.Is(<-done, net.ErrClosed))
+}
@@ -0,0 +1,30 @@
+package main
+
+func isNilMap(m map[string]int) bool {
+	return m == nil
+}
+
+func isNilFunc(f func() int) bool {
+	return f == nil
+}
+
+func main() {
+	var m map[string]int
+	println(len(m), m["a"], isNilMap(m), isNilMap(map[string]int{}))
+
+	v, ok := m["a"]
+	println(v, ok)
+	for k, v := range m {
+		println(k, v)
+	}
+	delete(m, "a")
+
+	n := 1
+	println(isNilFunc(nil), isNilFunc(func() int { return n }))
+
+	defer func() {
+		r := recover()
+		println(r.(error).Error())
+	}()
+	m["a"] = 1
+}
@@ -0,0 +1,34 @@
+package main
+
+type List struct {
+	next *List
+	v    int
+}
+
+func (l *List) Len() int {
+	if l == nil {
+		return 0
+	}
+	return 1 + l.next.Len()
+}
+
+type Lener interface {
+	Len() int
+}
+
+func main() {
+	var l *List
+	println(l.Len())
+
+	l = &List{v: 1, next: &List{v: 2}}
+	println(l.Len())
+
+	var i Lener = (*List)(nil)
+	println(i != nil, i.Len())
+
+	f := (*List)(nil).Len
+	println(f())
+
+	g := (*List).Len
+	println(g(nil), g(l))
+}
@@ -0,0 +1,29 @@
+package main
+
+type T struct{ n int }
+
+func (t T) Get() int { return t.n }
+
+type Getter interface{ Get() int }
+
+func call(g Getter) (n int, err string) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = r.(error).Error()
+		}
+	}()
+	return g.Get(), ""
+}
+
+func main() {
+	println(call(&T{7}))
+	println(call(T{8}))
+	var p *T
+	println(call(p))
+	get := (*T).Get
+	println(get(&T{9}))
+	defer func() {
+		println("recovered:", recover().(error).Error())
+	}()
+	println(get(p))
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+	"time"
+)
+
+var sink [][]byte
+
+//go:noinline
+func allocate() {
+	for i := 0; i < 1000; i++ {
+		sink = append(sink, make([]byte, 4096))
+	}
+}
+
+//go:noinline
+func spin(d time.Duration) int {
+	n := 0
+	for start := time.Now(); time.Since(start) < d; {
+		for i := 0; i < 1000; i++ {
+			n += i * i
+		}
+	}
+	return n
+}
+
+func main() {
+	runtime.MemProfileRate = 1
+	allocate()
+	runtime.GC()
+
+	var recs []runtime.MemProfileRecord
+	n, ok := runtime.MemProfile(nil, true)
+	for !ok {
+		recs = make([]runtime.MemProfileRecord, n+50)
+		n, ok = runtime.MemProfile(recs, true)
+	}
+	var allocBytes, inuseBytes int64
+	for _, r := range recs[:n] {
+		allocBytes += r.AllocBytes
+		inuseBytes += r.InUseBytes()
+		if len(r.Stack()) == 0 {
+			println("empty stack")
+		}
+	}
+	println("allocated:", allocBytes >= 1000*4096)
+	println("in use:", inuseBytes >= 1000*4096)
+
+	var heap bytes.Buffer
+	println("heap profile:", pprof.WriteHeapProfile(&heap) == nil, heap.Len() > 0)
+	println("profiles:", pprof.Lookup("heap") != nil, pprof.Lookup("allocs") != nil)
+	for _, name := range []string{"goroutine", "threadcreate", "block", "mutex"} {
+		var buf bytes.Buffer
+		err := pprof.Lookup(name).WriteTo(&buf, 1)
+		header := name + " profile: total "
+		switch name {
+		case "block":
+			header = "--- contention:\ncycles/second="
+		case "mutex":
+			header = "--- mutex:\ncycles/second="
+		}
+		println(name+":", err == nil, strings.HasPrefix(buf.String(), header))
+	}
+
+	ctx := pprof.WithLabels(context.Background(), pprof.Labels("a", "1", "b", "2"))
+	pprof.Do(ctx, pprof.Labels("a", "3"), func(ctx context.Context) {
+		pprof.SetGoroutineLabels(ctx)
+		a, _ := pprof.Label(ctx, "a")
+		b, _ := pprof.Label(ctx, "b")
+		_, ok := pprof.Label(ctx, "c")
+		n := 0
+		pprof.ForLabels(ctx, func(key, value string) bool {
+			n++
+			return true
+		})
+		println("labels:", a, b, ok, n)
+	})
+
+	var cpu bytes.Buffer
+	println("start:", pprof.StartCPUProfile(&cpu) == nil)
+	println("start again:", pprof.StartCPUProfile(&cpu) != nil)
+	spin(200 * time.Millisecond)
+	pprof.StopCPUProfile()
+	println("cpu profile:", cpu.Len() > 0)
+	pprof.StopCPUProfile()
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// The accesses below are all ordered, by channels, locks or atomics: built
+// with -race, the program must run without any report.
+
+type box struct {
+	n    int
+	name string
+}
+
+func channels() int {
+	ch := make(chan *box)
+	done := make(chan bool)
+	go func() {
+		b := <-ch
+		b.n++ // received from the sender, which doesn't touch it anymore
+		done <- true
+	}()
+	b := &box{n: 1, name: "chan"}
+	ch <- b
+	<-done
+	return b.n
+}
+
+func buffered() int {
+	ch := make(chan int, 4)
+	data := make([]int, 4)
+	go func() {
+		for i := range data {
+			data[i] = i * i
+			ch <- i
+		}
+		close(ch)
+	}()
+	sum := 0
+	for i := range ch {
+		sum += data[i]
+	}
+	return sum
+}
+
+func mutex() int {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	counts := map[int]int{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				mu.Lock()
+				counts[i%2]++
+				mu.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return counts[0] + counts[1]
+}
+
+func rwmutex() string {
+	var mu sync.RWMutex
+	var wg sync.WaitGroup
+	name := ""
+	mu.Lock()
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.RLock()
+			_ = len(name)
+			mu.RUnlock()
+		}()
+	}
+	name = "rwmutex"
+	mu.Unlock()
+	wg.Wait()
+	return name
+}
+
+func atomics() int {
+	var ready atomic.Bool
+	var data int
+	go func() {
+		data = 42
+		ready.Store(true)
+	}()
+	for !ready.Load() {
+	}
+	return data
+}
+
+func once() int {
+	var o sync.Once
+	var wg sync.WaitGroup
+	v := 0
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o.Do(func() { v = 7 })
+			_ = v
+		}()
+	}
+	wg.Wait()
+	return v
+}
+
+func main() {
+	println("channels:", channels())
+	println("buffered:", buffered())
+	println("mutex:", mutex())
+	println("rwmutex:", rwmutex())
+	println("atomics:", atomics())
+	println("once:", once())
+}
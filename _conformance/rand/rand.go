@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"math/big"
+	"math/rand"
+	randv2 "math/rand/v2"
+	"os"
+	"os/exec"
+	"strconv"
+)
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "child" {
+		os.Stdout.WriteString(strconv.FormatUint(randv2.Uint64(), 16) + " " + strconv.FormatInt(rand.Int63(), 16))
+		return
+	}
+
+	// the ChaCha8 source is the same as in Go, past a reseed
+	var seed [32]byte
+	copy(seed[:], "chacha8rand example seed 32 byt")
+	r := randv2.NewChaCha8(seed)
+	for i := 0; i < 200; i++ {
+		r.Uint64()
+	}
+	println(strconv.FormatUint(r.Uint64(), 16))
+	state, _ := r.MarshalBinary()
+	r2 := randv2.NewChaCha8([32]byte{})
+	r2.UnmarshalBinary(state)
+	println(r.Uint64() == r2.Uint64())
+
+	// the global sources are seeded differently from run to run
+	run := func() []byte {
+		out, err := exec.Command(os.Args[0], "child").Output()
+		if err != nil {
+			println("error:", err.Error())
+		}
+		return out
+	}
+	println(!bytes.Equal(run(), run()))
+
+	a, b := make([]byte, 1000), make([]byte, 1000)
+	n, err := crand.Read(a)
+	crand.Read(b)
+	println(n, err == nil, !bytes.Equal(a, b))
+	println(len(crand.Text()))
+	i, err := crand.Int(crand.Reader, big.NewInt(1000))
+	println(err == nil && i.Sign() >= 0 && i.Int64() < 1000)
+	p, err := crand.Prime(crand.Reader, 64)
+	println(err == nil && p.BitLen() == 64 && p.ProbablyPrime(20))
+}
@@ -0,0 +1,99 @@
+//go:build go1.23
+
+package main
+
+func count(n int) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		defer println("count done")
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func seq(n int) func(yield func(int) bool) {
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				println("seq stopped at", i)
+				return
+			}
+		}
+	}
+}
+
+func pairs(n int) func(yield func(int, int) bool) {
+	return func(yield func(int, int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i, i*i) {
+				return
+			}
+		}
+	}
+}
+
+func find(n, x int) (idx int) {
+	for i, v := range pairs(n) {
+		if v == x {
+			return i
+		}
+	}
+	return -1
+}
+
+func nested() int {
+	sum := 0
+outer:
+	for i := range seq(4) {
+		for j := range seq(4) {
+			if j > i {
+				continue outer
+			}
+			if i == 3 {
+				break outer
+			}
+			sum += i * j
+		}
+	}
+	return sum
+}
+
+func recovered() (s string) {
+	defer func() {
+		if r := recover(); r != nil {
+			s = r.(string)
+		}
+	}()
+	for i := range seq(5) {
+		defer println("body defer", i)
+		if i == 2 {
+			panic("boom")
+		}
+	}
+	return "no"
+}
+
+func deferred() {
+	defer println("deferred done")
+	for i := range count(3) {
+		defer println("deferred", i)
+		if i == 1 {
+			return
+		}
+	}
+}
+
+func main() {
+	deferred()
+	println(find(10, 49), find(3, 49))
+	println(nested())
+	println(recovered())
+	for i := range seq(3) {
+		if i == 1 {
+			continue
+		}
+		println("main", i)
+	}
+}
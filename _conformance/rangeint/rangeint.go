@@ -0,0 +1,51 @@
+//go:build go1.22
+
+// Range over integers needs no support of the compiler: go/ssa lowers it to
+// a counting loop before llgo sees it. This checks the lowering.
+package main
+
+type myInt int8
+
+func sum(n int) int {
+	s := 0
+	for i := range n {
+		s += i
+	}
+	return s
+}
+
+func usum(n uint8) (s uint) {
+	for i := range n {
+		s += uint(i)
+	}
+	return
+}
+
+func count(n int64) (c int) {
+	for range n {
+		c++
+	}
+	return
+}
+
+func main() {
+	println(sum(10), sum(0), sum(-5))
+	println(usum(255), usum(0))
+	println(count(3), count(-1))
+	var m myInt = 3
+	for i := range m {
+		println("m", i)
+	}
+	for i := range 3 {
+		defer println("defer", i)
+	}
+	const big = 1 << 40
+	n := 0
+	for i := range big {
+		if i == 5 {
+			break
+		}
+		n++
+	}
+	println(n)
+}
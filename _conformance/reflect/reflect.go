@@ -0,0 +1,110 @@
+package main
+
+import "reflect"
+
+type Point struct {
+	X, Y int    `json:"x"`
+	Name string `json:"name,omitempty" xml:"n"`
+	tag  int8
+}
+
+func (p Point) Sum() int       { return p.X + p.Y }
+func (p *Point) Move(dx int)   { p.X += dx }
+func (p Point) String() string { return p.Name }
+
+type Inner struct{ Depth int }
+
+type Outer struct {
+	*Inner
+	Title string
+}
+
+type Celsius float64
+
+type Stringer interface {
+	String() string
+}
+
+func types() {
+	t := reflect.TypeOf(Point{})
+	println(t.String(), t.Name(), t.PkgPath(), t.Kind().String(), t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		println(f.Name, f.Type.String(), f.Offset, f.PkgPath, f.Tag.Get("json"), f.Tag.Get("xml"))
+	}
+	if f, ok := t.FieldByName("Name"); ok {
+		v, ok := f.Tag.Lookup("json")
+		println("FieldByName:", f.Name, f.Index[0], v, ok)
+	}
+
+	println("methods:", t.NumMethod(), reflect.PointerTo(t).NumMethod())
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		println(m.Name, m.Type.String())
+	}
+	if m, ok := reflect.PointerTo(t).MethodByName("Move"); ok {
+		println("MethodByName:", m.Name, m.Index, m.Type.NumIn())
+	}
+
+	st := reflect.TypeOf((*Stringer)(nil)).Elem()
+	println(st.String(), st.Kind().String(), st.NumMethod())
+	println("implements:", t.Implements(st), reflect.TypeOf(Outer{}).Implements(st))
+
+	ot := reflect.TypeOf(Outer{})
+	if f, ok := ot.FieldByName("Depth"); ok {
+		println("promoted:", f.Name, len(f.Index), f.Index[0], f.Index[1])
+	}
+	println(reflect.TypeOf(&Point{}).String(), reflect.TypeOf(&Point{}).Name() == "")
+	println(reflect.TypeOf([]map[string]*Point{}).String())
+	println(reflect.TypeOf([3]int{}).String(), reflect.TypeOf(struct{ A int }{}).String())
+	println(reflect.TypeOf(func(int, ...string) error { return nil }).String())
+	println(reflect.TypeOf(Celsius(0)).ConvertibleTo(reflect.TypeOf(0)))
+}
+
+func values() {
+	p := Point{X: 1, Y: 2, Name: "p", tag: -3}
+	v := reflect.ValueOf(p)
+	println(v.Field(0).Int(), v.Field(1).Int(), v.Field(2).String(), v.Field(3).Int())
+	println(v.Field(3).CanInterface(), v.Field(0).Interface().(int))
+	println(v.NumMethod(), v.IsZero(), reflect.ValueOf(Point{}).IsZero())
+
+	pv := reflect.New(reflect.TypeOf(Point{}))
+	e := pv.Elem()
+	e.Field(0).SetInt(10)
+	e.FieldByName("Name").SetString("new")
+	e.Field(1).Set(reflect.ValueOf(20))
+	q := pv.Interface().(*Point)
+	println(q.X, q.Y, q.Name, e.CanSet(), e.Field(3).CanSet())
+
+	c := reflect.ValueOf(Celsius(36.6))
+	println(c.Float() == 36.6, c.Convert(reflect.TypeOf(0)).Int())
+	println(reflect.ValueOf(int8(-5)).Int(), reflect.ValueOf(uint16(65535)).Uint(), reflect.ValueOf(true).Bool())
+	println(reflect.ValueOf("hi").Convert(reflect.TypeOf([]byte(nil))).Len())
+
+	m := reflect.MakeMap(reflect.TypeOf(map[string]int{}))
+	m.SetMapIndex(reflect.ValueOf("a"), reflect.ValueOf(1))
+	m.SetMapIndex(reflect.ValueOf("b"), reflect.ValueOf(2))
+	println(m.Len(), m.MapIndex(reflect.ValueOf("b")).Int(), m.MapIndex(reflect.ValueOf("c")).IsValid())
+	m.SetMapIndex(reflect.ValueOf("a"), reflect.Value{})
+	iter := m.MapRange()
+	for iter.Next() {
+		println("range:", iter.Key().String(), iter.Value().Int())
+	}
+
+	s := reflect.MakeSlice(reflect.TypeOf([]int{}), 2, 4)
+	s.Index(1).SetInt(7)
+	s = reflect.Append(s, reflect.ValueOf(8))
+	s = reflect.AppendSlice(s, reflect.ValueOf([]int{9}))
+	println(s.Len(), s.Cap(), s.Index(1).Int(), s.Index(3).Int(), s.Slice(1, 3).Len())
+
+	var sv Stringer = p
+	iv := reflect.ValueOf(&sv).Elem()
+	println(iv.Kind().String(), iv.Elem().Type().String(), iv.NumMethod())
+	iv.Set(reflect.ValueOf(Point{Name: "set"}))
+	println(sv.String())
+}
+
+func main() {
+	types()
+	values()
+}
@@ -0,0 +1,170 @@
+// recover returns nil for panic(nil), as it does in Go before 1.21.
+//
+//go:debug panicnil=1
+package main
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+func mustRecover(x any) {
+	e := recover()
+	if e == nil {
+		println("missing recover")
+		return
+	}
+	if e != x {
+		println("wrong value")
+		return
+	}
+	println("recovered", e.(int))
+}
+
+func withoutPanic() {
+	defer func() {
+		println("no panic:", recover() == nil)
+	}()
+}
+
+func repanic() {
+	defer mustRecover(2)
+	defer func() {
+		panic(2)
+	}()
+	panic(1)
+}
+
+func remaining() {
+	defer mustRecover(3)
+	defer println("remaining: after")
+	defer func() {
+		panic(3)
+	}()
+	defer println("remaining: before")
+	println("remaining")
+}
+
+func recursive(n int) {
+	defer func() {
+		if n > 0 {
+			recursive(n - 1)
+		}
+		if e := recover(); e != nil {
+			println("recursive", n, e.(int))
+		}
+	}()
+	panic(n)
+}
+
+func once() (ret int) {
+	defer func() {
+		recover()
+	}()
+	defer func() {
+		ret++
+		panic("again")
+	}()
+	panic("first")
+}
+
+func twice() {
+	defer mustRecover(4)
+	func() {
+		defer func() {
+			println("twice", recover().(int))
+			panic(4)
+		}()
+		panic(5)
+	}()
+}
+
+type celsius float64
+
+func (c celsius) String() string { return fmt.Sprint(float64(c), "C") }
+
+// values checks that recover returns the panicked value itself, not its
+// string, for errors and Stringers too.
+func values() {
+	for _, v := range []any{io.EOF, celsius(20), fmt.Errorf("wrapped: %w", io.EOF)} {
+		func() {
+			defer func() {
+				e := recover()
+				err, isErr := e.(error)
+				println("recovered", e == v, isErr, isErr && err.Error() == fmt.Sprint(v))
+			}()
+			panic(v)
+		}()
+	}
+}
+
+var (
+	sink  any
+	zero  int
+	slice []int
+	dict  map[string]int
+	ptr   *celsius
+	face  any = "str"
+	uncmp any = slice
+)
+
+// runtimeErrors checks that recover returns runtime.Error values for run-time
+// panics: their messages differ in details, so only a part of each is
+// compared.
+func runtimeErrors() {
+	for _, c := range []struct {
+		f    func()
+		want string
+	}{
+		{func() { sink = slice[zero] }, "index out of range"},
+		{func() { sink = slice[1:zero] }, "slice bounds out of range"},
+		{func() { dict["a"] = 1 }, "assignment to entry in nil map"},
+		{func() { sink = *ptr }, "nil pointer dereference"},
+		{func() { sink = 1 / zero }, "integer divide by zero"},
+		{func() { sink = 1 >> (zero - 1) }, "negative shift amount"},
+		{func() { sink = face.(int) }, "string, not int"},
+		{func() { sink = face.(fmt.Stringer) }, "missing method String"},
+		{func() { sink = uncmp == uncmp }, "comparing uncomparable type []int"},
+	} {
+		func() {
+			defer func() {
+				e := recover()
+				err, isRT := e.(runtime.Error)
+				println("runtime error", isRT, isRT && strings.Contains(err.Error(), c.want))
+			}()
+			c.f()
+		}()
+	}
+}
+
+// nilValues checks that recover returns nil for panics with nil (see the
+// go:debug directive), and that nil interfaces of other types stay typed.
+func nilValues() {
+	var err error
+	var p *celsius
+	for _, v := range []any{nil, err, p} {
+		func() {
+			defer func() {
+				e := recover()
+				_, isPtr := e.(*celsius)
+				println("nil value", e == nil, isPtr)
+			}()
+			panic(v)
+		}()
+	}
+}
+
+func main() {
+	withoutPanic()
+	repanic()
+	remaining()
+	recursive(2)
+	println("once", once())
+	twice()
+	values()
+	runtimeErrors()
+	nilValues()
+	println("done")
+}
@@ -0,0 +1,31 @@
+package main
+
+//go:noinline
+func count[T any](n T) T { return n }
+
+func main() {
+	var x int8 = -100
+	var u uint8 = 200
+	var w int64 = -1 << 40
+	var v uint32 = 0xdeadbeef
+
+	for _, n := range []uint{0, 1, 7, 8, 9, 31, 32, 63, 64, 65, 200, 1 << 40} {
+		println(n, ":", x<<n, x>>n, u<<n, u>>n, w<<n, w>>n, v<<n, v>>n)
+	}
+
+	// counts wider than the shifted value
+	println("wide:", u<<count(uint64(256)), u>>count(uint64(256+1)), x>>count(uint64(1<<32)))
+	println("wide:", v<<count(uint64(1<<32+1)), v>>count(int64(1<<33)), w>>count(uint64(1<<63)))
+
+	// counts narrower than the shifted value
+	println("narrow:", w<<count(uint8(200)), w>>count(uint8(130)), w>>count(int8(63)), v>>count(uint16(40000)))
+
+	// constant counts
+	println("const:", x<<7, x>>7, u<<7, u>>7, w<<63, w>>63, v<<31, v>>31)
+
+	defer func() {
+		println("recovered:", recover() != nil)
+	}()
+	n := count(-1)
+	println(x << n)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+type T struct {
+	x int
+}
+
+//go:noinline
+func load(p *T) int {
+	return p.x
+}
+
+func deref() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	load(nil)
+	return nil
+}
+
+func main() {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGUSR1)
+	syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+	fmt.Println("received", <-c)
+	signal.Stop(c)
+
+	signal.Ignore(syscall.SIGUSR2)
+	fmt.Println("ignored", signal.Ignored(syscall.SIGUSR2))
+	syscall.Kill(syscall.Getpid(), syscall.SIGUSR2)
+	signal.Reset(syscall.SIGUSR2)
+	fmt.Println("ignored", signal.Ignored(syscall.SIGUSR2))
+
+	fmt.Println(deref())
+	fmt.Println(deref())
+}
@@ -0,0 +1,23 @@
+package main
+
+func kind(s string) int {
+	switch s {
+	case "int", "int8", "int16":
+		return 1
+	case "int32", "int64":
+		return 2
+	case "uint", "uint8":
+		return 3
+	case "string":
+		return 4
+	case "":
+		return 5
+	}
+	return 0
+}
+
+func main() {
+	for _, s := range []string{"int", "int8", "int16", "int32", "int64", "uint", "uint8", "string", "", "in", "int6", "uint16", "strinG"} {
+		println(s, kind(s))
+	}
+}
@@ -0,0 +1,153 @@
+//go:build go1.21
+
+package main
+
+import (
+	"maps"
+	"math"
+)
+
+type big struct {
+	id  int
+	pad [40]int64
+}
+
+// grow checks the entries of a map through its growth from a single group to
+// a large table, with deletions leaving tombstones behind.
+func grow() bool {
+	m := make(map[int]int)
+	for i := 0; i < 10000; i++ {
+		m[i] = i * 2
+		if i%3 == 0 {
+			delete(m, i/2)
+		}
+	}
+	n := 0
+	for k, v := range m {
+		if v != k*2 {
+			return false
+		}
+		n++
+	}
+	return n == len(m)
+}
+
+// churn inserts and deletes keys in a table kept at the same size.
+func churn() bool {
+	m := make(map[int]int, 100)
+	for i := 0; i < 100000; i++ {
+		m[i] = i
+		delete(m, i-50)
+	}
+	for k, v := range m {
+		if k != v || k < 100000-51 {
+			return false
+		}
+	}
+	return len(m) == 50
+}
+
+// deleteWhileRanging deletes the keys not reached yet, and adds new ones,
+// which growth moves to another table: deleted keys must not be produced,
+// and no key twice.
+func deleteWhileRanging() bool {
+	m := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		m[i] = true
+	}
+	seen := make(map[int]bool)
+	deleted := make(map[int]bool)
+	for k := range m {
+		if seen[k] || deleted[k] {
+			return false
+		}
+		seen[k] = true
+		for d := range m {
+			if !seen[d] && d < 100 {
+				delete(m, d)
+				deleted[d] = true
+				break
+			}
+		}
+		m[1000+k] = true
+	}
+	return len(seen) >= 50
+}
+
+// clearWhileRanging clears the map on the first iteration: nothing else is
+// produced.
+func clearWhileRanging() int {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	n := 0
+	for range m {
+		clear(m)
+		n++
+	}
+	return n
+}
+
+func nanKeys() (int, int) {
+	m := make(map[float64]int)
+	for i := 0; i < 20; i++ {
+		m[math.NaN()] = i
+	}
+	m[0] = 1
+	_, ok := m[math.NaN()]
+	n := 0
+	for k := range m {
+		if k != k {
+			n++
+		}
+	}
+	if ok {
+		n = -1
+	}
+	return len(m), n
+}
+
+func zeroKey() bool {
+	m := map[float64]int{math.Copysign(0, -1): 1}
+	m[0] = 2
+	for k, v := range m {
+		return !math.Signbit(k) && v == 2
+	}
+	return false
+}
+
+func bigEntries() bool {
+	m := make(map[big]big)
+	for i := 0; i < 100; i++ {
+		m[big{id: i}] = big{id: -i}
+	}
+	for i := 0; i < 100; i += 2 {
+		delete(m, big{id: i})
+	}
+	for k, v := range m {
+		if k.id%2 == 0 || v.id != -k.id {
+			return false
+		}
+	}
+	return len(m) == 50 && m[big{id: 51}].id == -51 && m[big{id: 50}].id == 0
+}
+
+func clone() bool {
+	m := make(map[int]*int)
+	for i := 0; i < 30; i++ {
+		v := i
+		m[i] = &v
+	}
+	c := maps.Clone(m)
+	delete(c, 0)
+	c[100] = nil
+	return len(m) == 30 && len(c) == 30 && *m[29] == 29 && c[29] == m[29]
+}
+
+func main() {
+	println(grow(), churn())
+	println(deleteWhileRanging())
+	println(clearWhileRanging())
+	println(nanKeys())
+	println(zeroKey())
+	println(bigEntries())
+	println(clone())
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"runtime"
+	"sync"
+)
+
+type buffer struct{ b []byte }
+
+func main() {
+	news := 0
+	pool := sync.Pool{New: func() any {
+		news++
+		return &buffer{b: make([]byte, 0, 64)}
+	}}
+
+	b := pool.Get().(*buffer)
+	b.b = append(b.b, "hello"...)
+	pool.Put(b)
+	b2 := pool.Get().(*buffer)
+	println("reused:", b2 == b, string(b2.b), news)
+	pool.Put(b2)
+
+	// the first collection moves the caches to the victim caches, the
+	// second one drops them
+	runtime.GC()
+	println("victim:", pool.Get().(*buffer) == b, news)
+	pool.Put(b)
+	runtime.GC()
+	runtime.GC()
+	println("dropped:", pool.Get().(*buffer) != b, news)
+
+	var empty sync.Pool
+	empty.Put(nil)
+	println("empty:", empty.Get() == nil)
+
+	// concurrent use
+	var wg sync.WaitGroup
+	var bufs sync.Pool
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				x, _ := bufs.Get().(*buffer)
+				if x == nil {
+					x = new(buffer)
+				}
+				x.b = append(x.b[:0], byte(j))
+				bufs.Put(x)
+			}
+		}()
+	}
+	wg.Wait()
+	println("concurrent: done")
+}
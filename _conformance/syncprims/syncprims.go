@@ -0,0 +1,125 @@
+package main
+
+import "sync"
+
+type counter struct {
+	mu sync.Mutex
+	n  int
+}
+
+func main() {
+	// Mutex under contention
+	var c counter
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				c.mu.Lock()
+				c.n++
+				c.mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	println("mutex:", c.n)
+
+	// RWMutex: readers share, writers exclude
+	var rw sync.RWMutex
+	total := 0
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				rw.Lock()
+				total++
+				rw.Unlock()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				rw.RLock()
+				_ = total
+				rw.RUnlock()
+			}
+		}()
+	}
+	wg.Wait()
+	println("rwmutex:", total, rw.TryLock(), rw.TryRLock())
+	rw.Unlock()
+	println("tryrlock:", rw.TryRLock(), rw.TryRLock(), rw.TryLock())
+
+	// Cond with any Locker
+	var mu sync.Mutex
+	cond := sync.NewCond(&mu)
+	ready := 0
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mu.Lock()
+			ready++
+			cond.Broadcast()
+			mu.Unlock()
+		}()
+	}
+	mu.Lock()
+	for ready < 4 {
+		cond.Wait()
+	}
+	mu.Unlock()
+	wg.Wait()
+	println("cond:", ready)
+
+	rcond := sync.NewCond(rw.RLocker())
+	rw.RUnlock()
+	rw.RUnlock()
+	done := false
+	go func() {
+		rw.Lock()
+		done = true
+		rw.Unlock()
+		rcond.Signal()
+	}()
+	rcond.L.Lock()
+	for !done {
+		rcond.Wait()
+	}
+	rcond.L.Unlock()
+	println("rlocker cond:", done)
+
+	// Once
+	var once sync.Once
+	calls := 0
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			once.Do(func() { calls++ })
+		}()
+	}
+	wg.Wait()
+	println("once:", calls)
+
+	// a WaitGroup waited by several goroutines
+	var start, end sync.WaitGroup
+	start.Add(1)
+	for i := 0; i < 3; i++ {
+		end.Add(1)
+		go func() {
+			start.Wait()
+			end.Done()
+		}()
+	}
+	start.Done()
+	end.Wait()
+	println("waitgroup: done")
+
+	defer func() {
+		println("recovered:", recover().(string))
+	}()
+	end.Done()
+}
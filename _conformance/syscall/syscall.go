@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+func main() {
+	pid, _, errno := syscall.RawSyscall(syscall.SYS_GETPID, 0, 0, 0)
+	fmt.Println("getpid", int(pid) == syscall.Getpid(), errno == 0)
+
+	path := []byte("/nonexistent\x00")
+	_, _, errno = syscall.Syscall(syscall.SYS_CHDIR, uintptr(unsafe.Pointer(&path[0])), 0, 0)
+	fmt.Println("chdir", errno == syscall.ENOENT)
+
+	buf := []byte("hello\n")
+	n, _, errno := syscall.Syscall(syscall.SYS_WRITE, 1, uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	fmt.Println("write", n, errno == 0)
+
+	_, _, errno = syscall.Syscall(syscall.SYS_CLOSE, ^uintptr(0), 0, 0)
+	fmt.Println("close", errno == syscall.EBADF, errno)
+
+	size := syscall.Getpagesize()
+	fmt.Println("pagesize", size > 0 && size&(size-1) == 0)
+
+	fmt.Println(syscall.SIGINT, syscall.SIGTERM, syscall.Signal(100))
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSubtests(t *testing.T) {
+	for _, name := range []string{"a", "b", "c"} {
+		name := name
+		ok := t.Run(name, func(t *testing.T) {
+			if name == "b" {
+				t.Skip("skipping", name)
+			}
+			fmt.Println("subtest", t.Name())
+		})
+		fmt.Println(name, ok, t.Skipped())
+	}
+}
+
+// TestParallel runs parallel subtests, which only start when the function
+// of their parent returns.
+func TestParallel(t *testing.T) {
+	var mu sync.Mutex
+	var ran []string
+	t.Run("group", func(t *testing.T) {
+		for _, name := range []string{"x", "y", "z"} {
+			name := name
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				mu.Lock()
+				ran = append(ran, name)
+				mu.Unlock()
+			})
+		}
+	})
+	sort.Strings(ran)
+	if strings.Join(ran, "") != "xyz" {
+		t.Fatal("parallel subtests:", ran)
+	}
+}
+
+// TestGoexit checks that runtime.Goexit runs the deferred calls of the
+// goroutine, where recover returns nil, and ends it.
+func TestGoexit(t *testing.T) {
+	var order []string
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		defer func() {
+			order = append(order, fmt.Sprint("recover:", recover()))
+		}()
+		func() {
+			defer func() { order = append(order, "inner") }()
+			runtime.Goexit()
+		}()
+		order = append(order, "unreachable")
+	}()
+	<-done
+	if got := strings.Join(order, " "); got != "inner recover:<nil>" {
+		t.Fatal("goexit:", got)
+	}
+}
+
+func TestTempDir(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.txt", "a.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "sub", "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, fmt.Sprint(e.Name(), ":", e.IsDir()))
+	}
+	if got := strings.Join(names, " "); got != "a.txt:false b.txt:false sub:true" {
+		t.Fatal("entries:", got)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+	if err != nil || string(data) != "a.txt" {
+		t.Fatal("read:", string(data), err)
+	}
+	t.Cleanup(func() {
+		if _, err := os.Stat(dir); err != nil {
+			panic(err)
+		}
+	})
+}
+
+func ExampleHello() {
+	fmt.Println("hello")
+	fmt.Println("world")
+	// Output:
+	// hello
+	// world
+}
+
+func main() {
+	testing.Main(func(pat, str string) (bool, error) { return true, nil },
+		[]testing.InternalTest{
+			{Name: "TestSubtests", F: TestSubtests},
+			{Name: "TestParallel", F: TestParallel},
+			{Name: "TestGoexit", F: TestGoexit},
+			{Name: "TestTempDir", F: TestTempDir},
+		},
+		nil,
+		[]testing.InternalExample{
+			{Name: "ExampleHello", F: ExampleHello, Output: "hello\nworld\n"},
+		})
+}
@@ -0,0 +1,40 @@
+package main
+
+import "time"
+
+func main() {
+	start := time.Now()
+	time.Sleep(20 * time.Millisecond)
+	println("slept:", time.Since(start) >= 20*time.Millisecond)
+
+	<-time.After(10 * time.Millisecond)
+	println("after: fired")
+
+	t := time.NewTimer(time.Hour)
+	println("stop:", t.Stop(), t.Stop())
+	t.Reset(5 * time.Millisecond)
+	<-t.C
+	println("reset: fired")
+
+	done := make(chan string)
+	time.AfterFunc(5*time.Millisecond, func() { done <- "called" })
+	println("afterfunc:", <-done)
+
+	// timers fire in the order of their deadlines
+	order := make(chan int, 3)
+	for _, i := range []int{3, 1, 2} {
+		i := i
+		time.AfterFunc(time.Duration(i)*10*time.Millisecond, func() { order <- i })
+	}
+	println("order:", <-order, <-order, <-order)
+
+	tk := time.NewTicker(5 * time.Millisecond)
+	n := 0
+	for range tk.C {
+		if n++; n == 3 {
+			break
+		}
+	}
+	tk.Stop()
+	println("ticks:", n)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"runtime/trace"
+	"sync"
+	"time"
+)
+
+func pingPong(n int) {
+	ping, pong := make(chan int), make(chan int)
+	go func() {
+		for v := range ping {
+			pong <- v + 1
+		}
+		close(pong)
+	}()
+	for i := 0; i < n; i++ {
+		ping <- i
+		<-pong
+	}
+	close(ping)
+}
+
+func contend() {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				mu.Lock()
+				time.Sleep(10 * time.Microsecond)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func main() {
+	var buf bytes.Buffer
+	println("enabled:", trace.IsEnabled())
+	println("start:", trace.Start(&buf) == nil)
+	println("start again:", trace.Start(&buf) != nil)
+	println("enabled:", trace.IsEnabled())
+
+	ctx, task := trace.NewTask(context.Background(), "work")
+	trace.WithRegion(ctx, "pingPong", func() {
+		pingPong(100)
+	})
+	r := trace.StartRegion(ctx, "contend")
+	contend()
+	r.End()
+	trace.Log(ctx, "result", "done")
+	runtime.GC()
+	time.Sleep(time.Millisecond)
+	task.End()
+
+	trace.Stop()
+	println("enabled:", trace.IsEnabled())
+	println("trace:", bytes.HasPrefix(buf.Bytes(), []byte("go 1.")), buf.Len() > 1000)
+
+	buf.Reset()
+	println("restart:", trace.Start(&buf) == nil)
+	trace.Stop()
+	println("trace:", buf.Len() > 0)
+}
@@ -0,0 +1,34 @@
+package main
+
+type T struct {
+	n int
+}
+
+func (t *T) Error() string {
+	if t == nil {
+		return "<nil T>"
+	}
+	return "T"
+}
+
+func get() *T {
+	return nil
+}
+
+func main() {
+	var p *T
+	var err error = p
+	println(p == nil, err != nil)
+	println(err.Error())
+
+	var a any = p
+	println(a != nil, a == (*T)(nil), a == err)
+
+	var e2 error = get()
+	println(e2 == nil, e2 != nil)
+
+	var e3 error
+	println(e3 == nil)
+	e3 = &T{1}
+	println(e3 == nil, e3.Error())
+}
@@ -0,0 +1,55 @@
+package main
+
+import "unsafe"
+
+func try(name string, f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if err, ok := r.(interface{ RuntimeError() }); ok {
+				println(name+":", err.(error).Error())
+			} else {
+				println(name + ": panic")
+			}
+		}
+	}()
+	f()
+	println(name + ": ok")
+}
+
+func main() {
+	arr := [4]int32{10, 20, 30, 40}
+	s := unsafe.Slice(&arr[1], 2)
+	println("slice:", len(s), cap(s), s[0], s[1])
+	println("slicedata:", unsafe.SliceData(s) == &arr[1], *unsafe.SliceData(s))
+
+	var n8 uint8 = 3
+	s = unsafe.Slice(&arr[0], n8)
+	println("uint8 len:", len(s), s[2])
+
+	bs := []byte("hello, world")
+	str := unsafe.String(&bs[0], 5)
+	println("string:", str, len(str))
+	p := unsafe.StringData(str)
+	println("stringdata:", p == &bs[0], *p)
+
+	q := unsafe.Add(unsafe.Pointer(&arr[0]), 8)
+	println("add:", *(*int32)(q))
+	var off uint8 = 200
+	base := unsafe.Pointer(&bs[0])
+	println("add uint8:", uintptr(unsafe.Add(base, off))-uintptr(base))
+	var neg int8 = -4
+	println("add int8:", *(*int32)(unsafe.Add(q, neg)))
+
+	var nilp *int32
+	println("nil zero:", unsafe.Slice(nilp, 0) == nil, unsafe.String(nil, 0) == "")
+
+	var zero int = 0
+	neglen := zero - 1
+	try("slice neg", func() { _ = unsafe.Slice(&arr[0], neglen) })
+	try("slice nil", func() { _ = unsafe.Slice(nilp, 1) })
+	try("string neg", func() { _ = unsafe.String(&bs[0], neglen) })
+	try("string nil", func() { _ = unsafe.String(nil, 2) })
+	var huge uint64 = 1 << 62
+	try("slice huge", func() { _ = unsafe.Slice(&arr[0], huge) })
+	try("slice ok", func() { _ = unsafe.Slice(&arr[0], 4) })
+}
@@ -0,0 +1,34 @@
+//go:build go1.24
+
+package main
+
+import (
+	"runtime"
+	"weak"
+)
+
+type T struct{ n int }
+
+type Closer interface{ Close() }
+
+func (t *T) Close() {}
+
+func main() {
+	x := &T{1}
+	w1 := weak.Make(x)
+	w2 := weak.Make(x)
+	println("equal:", w1 == w2, w1 == weak.Make(&T{2}))
+	println("value:", w1.Value() == x, w1.Value().n)
+
+	var zero weak.Pointer[T]
+	println("nil:", zero.Value() == nil, weak.Make[T](nil) == zero)
+
+	runtime.SetFinalizer(x, func(*T) {})
+	runtime.SetFinalizer(x, nil)
+	runtime.SetFinalizer(x, func(any) {})
+	runtime.SetFinalizer(x, nil)
+	runtime.SetFinalizer(x, func(Closer) error { return nil })
+	runtime.SetFinalizer(x, nil)
+	println("finalizers: set")
+	println("alive:", w2.Value() == x)
+}
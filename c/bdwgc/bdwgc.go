@@ -34,12 +34,24 @@ func Init()
 //go:linkname Malloc C.GC_malloc
 func Malloc(size uintptr) c.Pointer
 
+//go:linkname MallocAtomic C.GC_malloc_atomic
+func MallocAtomic(size uintptr) c.Pointer
+
+//go:linkname MallocUncollectable C.GC_malloc_uncollectable
+func MallocUncollectable(size uintptr) c.Pointer
+
 //go:linkname Realloc C.GC_realloc
 func Realloc(ptr c.Pointer, size uintptr) c.Pointer
 
 //go:linkname Free C.GC_free
 func Free(ptr c.Pointer)
 
+//go:linkname Base C.GC_base
+func Base(ptr c.Pointer) c.Pointer
+
+//go:linkname Size C.GC_size
+func Size(ptr c.Pointer) uintptr
+
 // -----------------------------------------------------------------------------
 
 //go:linkname RegisterFinalizer C.GC_register_finalizer
@@ -66,6 +78,43 @@ func RegisterFinalizerUnreachable(
 	fn func(c.Pointer, c.Pointer), cd c.Pointer,
 	oldFn *func(c.Pointer, c.Pointer), oldCd *c.Pointer)
 
+//go:linkname SetFinalizeOnDemand C.GC_set_finalize_on_demand
+func SetFinalizeOnDemand(v c.Int)
+
+//go:linkname SetFinalizerNotifier C.GC_set_finalizer_notifier
+func SetFinalizerNotifier(fn func())
+
+//go:linkname InvokeFinalizers C.GC_invoke_finalizers
+func InvokeFinalizers() c.Int
+
+// -----------------------------------------------------------------------------
+
+//go:linkname GeneralRegisterDisappearingLink C.GC_general_register_disappearing_link
+func GeneralRegisterDisappearingLink(link *c.Pointer, obj c.Pointer) c.Int
+
+//go:linkname UnregisterDisappearingLink C.GC_unregister_disappearing_link
+func UnregisterDisappearingLink(link *c.Pointer) c.Int
+
+//go:linkname CallWithAllocLock C.GC_call_with_alloc_lock
+func CallWithAllocLock(fn func(c.Pointer) c.Pointer, cd c.Pointer) c.Pointer
+
+// -----------------------------------------------------------------------------
+
+// PushOtherRootsProc pushes roots unknown to the collector when it marks,
+// with the world stopped (see PushAll).
+//
+// llgo:type C
+type PushOtherRootsProc func()
+
+//go:linkname SetPushOtherRoots C.GC_set_push_other_roots
+func SetPushOtherRoots(fn PushOtherRootsProc)
+
+//go:linkname GetPushOtherRoots C.GC_get_push_other_roots
+func GetPushOtherRoots() PushOtherRootsProc
+
+//go:linkname PushAll C.GC_push_all
+func PushAll(bottom, top c.Pointer)
+
 // -----------------------------------------------------------------------------
 
 //go:linkname Enable C.GC_enable
@@ -83,6 +132,76 @@ func Gcollect()
 //go:linkname GetMemoryUse C.GC_get_memory_use
 func GetMemoryUse() uintptr
 
+//go:linkname GcollectAndUnmap C.GC_gcollect_and_unmap
+func GcollectAndUnmap()
+
+//go:linkname GetGcNo C.GC_get_gc_no
+func GetGcNo() uintptr
+
+// -----------------------------------------------------------------------------
+
+//go:linkname SetFreeSpaceDivisor C.GC_set_free_space_divisor
+func SetFreeSpaceDivisor(n uintptr)
+
+//go:linkname GetFreeSpaceDivisor C.GC_get_free_space_divisor
+func GetFreeSpaceDivisor() uintptr
+
+//go:linkname SetMaxHeapSize C.GC_set_max_heap_size
+func SetMaxHeapSize(n uintptr)
+
+//go:linkname SetOomFn C.GC_set_oom_fn
+func SetOomFn(fn func(size uintptr) c.Pointer)
+
+//go:linkname GetHeapSize C.GC_get_heap_size
+func GetHeapSize() uintptr
+
+//go:linkname GetHeapUsageSafe C.GC_get_heap_usage_safe
+func GetHeapUsageSafe(heapSize, freeBytes, unmappedBytes, bytesSinceGC, totalBytes *uintptr)
+
+// ProfStats are the statistics of the collector (struct GC_prof_stats_s).
+type ProfStats struct {
+	HeapsizeFull           uintptr
+	FreeBytesFull          uintptr
+	UnmappedBytes          uintptr
+	BytesAllocdSinceGC     uintptr
+	AllocdBytesBeforeGC    uintptr
+	NonGCBytes             uintptr
+	GCNo                   uintptr
+	MarkersM1              uintptr
+	BytesReclaimedSinceGC  uintptr
+	ReclaimedBytesBeforeGC uintptr
+	ExplFreedBytesSinceGC  uintptr
+	ObtainedFromOSBytes    uintptr
+}
+
+// GetProfStatsUnsafe is GC_get_prof_stats without the allocation lock, for
+// callers holding it, like the collection event callback.
+//
+//go:linkname GetProfStatsUnsafe C.GC_get_prof_stats_unsafe
+func GetProfStatsUnsafe(stats *ProfStats, size uintptr) uintptr
+
+// -----------------------------------------------------------------------------
+
+type EventType c.Int
+
+const (
+	EVENT_START EventType = iota
+	EVENT_MARK_START
+	EVENT_MARK_END
+	EVENT_RECLAIM_START
+	EVENT_RECLAIM_END
+	EVENT_END
+	EVENT_PRE_STOP_WORLD
+	EVENT_POST_STOP_WORLD
+	EVENT_PRE_START_WORLD
+	EVENT_POST_START_WORLD
+	EVENT_THREAD_SUSPENDED
+	EVENT_THREAD_UNSUSPENDED
+)
+
+//go:linkname SetOnCollectionEvent C.GC_set_on_collection_event
+func SetOnCollectionEvent(fn func(ev EventType))
+
 // -----------------------------------------------------------------------------
 
 //go:linkname EnableIncremental C.GC_enable_incremental
@@ -101,3 +220,27 @@ func StartIncrementalCollection()
 func CollectALittle()
 
 // -----------------------------------------------------------------------------
+
+// StackBase is the base of the stack of a thread (struct GC_stack_base).
+type StackBase struct {
+	MemBase c.Pointer
+}
+
+const (
+	SUCCESS   = 0
+	DUPLICATE = 1 // the thread is registered already
+)
+
+//go:linkname AllowRegisterThreads C.GC_allow_register_threads
+func AllowRegisterThreads()
+
+//go:linkname GetStackBase C.GC_get_stack_base
+func GetStackBase(sb *StackBase) c.Int
+
+//go:linkname RegisterMyThread C.GC_register_my_thread
+func RegisterMyThread(sb *StackBase) c.Int
+
+//go:linkname UnregisterMyThread C.GC_unregister_my_thread
+func UnregisterMyThread() c.Int
+
+// -----------------------------------------------------------------------------
@@ -67,7 +67,8 @@ type (
 )
 
 type (
-	StatT = syscall.Stat_t
+	StatT  = syscall.Stat_t
+	Dirent = syscall.Dirent
 )
 
 //go:linkname Errno errno
@@ -234,6 +235,24 @@ func Isatty(fd c.Int) c.Int
 
 // -----------------------------------------------------------------------------
 
+// DIR is a directory stream.
+type DIR struct {
+	Unused [0]byte
+}
+
+//go:linkname Opendir C.opendir
+func Opendir(name *c.Char) *DIR
+
+// Readdir returns the next entry of dir, or nil at its end or on error.
+//
+//go:linkname Readdir C.readdir
+func Readdir(dir *DIR) *Dirent
+
+//go:linkname Closedir C.closedir
+func Closedir(dir *DIR) c.Int
+
+// -----------------------------------------------------------------------------
+
 // Execl(const char *path, const char *arg0, ..., /*, (char *)0, */)
 //
 // Execl requires the full path of the program to be provided.
@@ -58,6 +58,11 @@ func UMax[T valtype](ptr *T, v T) T { return v }
 // llgo:link UMin llgo.atomicUMin
 func UMin[T valtype](ptr *T, v T) T { return v }
 
+// Fence is a sequentially consistent memory barrier.
+//
+// llgo:link Fence llgo.atomicFence
+func Fence() {}
+
 // llgo:link Load llgo.atomicLoad
 func Load[T valtype](ptr *T) T { return *ptr }
 
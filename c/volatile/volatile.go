@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package volatile provides volatile memory accesses, e.g. for memory-mapped
+// I/O. Volatile accesses are never removed, merged or reordered with other
+// volatile accesses by the compiler.
+package volatile
+
+const (
+	LLGoPackage = "decl"
+)
+
+// llgo:link Load llgo.volatileLoad
+func Load[T any](ptr *T) T { return *ptr }
+
+// llgo:link Store llgo.volatileStore
+func Store[T any](ptr *T, v T) {}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/goplus/llgo/xtool/clang/ast"
+	"github.com/goplus/llgo/xtool/clang/cconst"
+)
+
+var (
+	pkgName = flag.String("pkg", "", "package name of the generated file (default: name of the header)")
+	prefix  = flag.String("prefix", "", "only convert constants with this name prefix")
+	outFile = flag.String("o", "", "output file (default: stdout)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: llcconst [-pkg name] [-prefix PREFIX] [-o file] header.h [clang flags ...]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() < 1 {
+		usage()
+		return
+	}
+	header, cflags := flag.Arg(0), flag.Args()[1:]
+	name := *pkgName
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(header), filepath.Ext(header))
+	}
+
+	predefs := macros("-", cflags)
+	defined := make(map[string]bool)
+	for _, line := range strings.Split(string(predefs), "\n") {
+		if fields := strings.Fields(line); len(fields) > 1 && fields[0] == "#define" {
+			defined[fields[1]] = true
+		}
+	}
+	filter := func(name string) bool {
+		if *prefix != "" {
+			return strings.HasPrefix(name, *prefix)
+		}
+		return !defined[name] && !strings.HasPrefix(name, "_")
+	}
+
+	pkg := cconst.NewPackage()
+	pkg.AddEnums(astdump(header, cflags), filter)
+	check(pkg.AddMacros(bytes.NewReader(macros(header, cflags)), filter))
+
+	out := os.Stdout
+	if *outFile != "" {
+		f, err := os.Create(*outFile)
+		check(err)
+		defer f.Close()
+		out = f
+	}
+	check(pkg.WriteFile(out, name))
+}
+
+// macros returns all macros defined after preprocessing file.
+func macros(file string, cflags []string) []byte {
+	args := append([]string{"-E", "-dM"}, cflags...)
+	args = append(args, "-x", "c", file)
+	return clang(args, file == "-")
+}
+
+// astdump returns the JSON AST of header.
+func astdump(header string, cflags []string) *ast.Node {
+	args := append([]string{"-fsyntax-only", "-Xclang", "-ast-dump=json"}, cflags...)
+	args = append(args, "-x", "c", header)
+	var doc ast.Node
+	check(json.Unmarshal(clang(args, false), &doc))
+	return &doc
+}
+
+func clang(args []string, emptyIn bool) []byte {
+	var out bytes.Buffer
+	cmd := exec.Command("clang", args...)
+	if emptyIn {
+		cmd.Stdin = strings.NewReader("")
+	}
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	check(cmd.Run())
+	return out.Bytes()
+}
+
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// llsysgen generates a per-target sys package (struct layouts, constants and
+// syscall numbers) from system headers, e.g.
+//
+//	llsysgen -goos linux -goarch arm64 -o c/sys -- --sysroot=/path/to/sysroot
+//
+// writes c/sys/zsys_linux_arm64.go.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/goplus/llgo/xtool/clang/ast"
+	"github.com/goplus/llgo/xtool/clang/cconst"
+	"github.com/goplus/llgo/xtool/clang/layout"
+)
+
+var (
+	goos    = flag.String("goos", runtime.GOOS, "target operating system")
+	goarch  = flag.String("goarch", runtime.GOARCH, "target architecture")
+	outDir  = flag.String("o", ".", "output directory")
+	pkgName = flag.String("pkg", "sys", "package name")
+	verbose = flag.Bool("v", false, "print clang commands")
+)
+
+var triples = map[string]string{
+	"linux/amd64":   "x86_64-unknown-linux-gnu",
+	"linux/386":     "i386-unknown-linux-gnu",
+	"linux/arm64":   "aarch64-unknown-linux-gnu",
+	"linux/arm":     "armv7-unknown-linux-gnueabihf",
+	"linux/riscv64": "riscv64-unknown-linux-gnu",
+	"darwin/amd64":  "x86_64-apple-darwin",
+	"darwin/arm64":  "arm64-apple-darwin",
+}
+
+var headers = []string{
+	"dirent.h",
+	"errno.h",
+	"fcntl.h",
+	"netinet/in.h",
+	"poll.h",
+	"signal.h",
+	"sys/mman.h",
+	"sys/resource.h",
+	"sys/socket.h",
+	"sys/stat.h",
+	"sys/syscall.h",
+	"sys/time.h",
+	"sys/types.h",
+	"time.h",
+	"unistd.h",
+}
+
+var records = []string{
+	"struct timespec",
+	"struct timeval",
+	"struct stat",
+	"struct rlimit",
+	"struct dirent",
+	"struct pollfd",
+	"struct sockaddr",
+	"struct sockaddr_in",
+	"struct sockaddr_in6",
+	"struct sigaction",
+}
+
+// prefixes of the constants to convert.
+var prefixes = []string{
+	"AF_", "AT_", "CLOCK_", "E", "FD_", "F_", "MAP_", "O_", "POLL", "PROT_",
+	"RLIMIT_", "SA_", "SEEK_", "SIG", "SOCK_", "SOL_", "S_I", "SYS_", "__NR_",
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: llsysgen [-goos os] [-goarch arch] [-o dir] [-pkg name] [-- clang flags ...]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	target := *goos + "/" + *goarch
+	triple, ok := triples[target]
+	if !ok {
+		fmt.Fprintln(os.Stderr, "llsysgen: unsupported target", target)
+		os.Exit(1)
+	}
+	cflags := append([]string{"--target=" + triple}, flag.Args()...)
+	ptrSize := int64(8)
+	switch *goarch {
+	case "386", "arm":
+		ptrSize = 4
+	}
+
+	dir, err := os.MkdirTemp("", "llsysgen")
+	check(err)
+	defer os.RemoveAll(dir)
+
+	var src bytes.Buffer
+	for _, h := range headers {
+		fmt.Fprintf(&src, "#include <%s>\n", h)
+	}
+	cFile := filepath.Join(dir, "sys.c")
+	check(os.WriteFile(cFile, src.Bytes(), 0644))
+	for i, rec := range records {
+		fmt.Fprintf(&src, "unsigned long llgoSizeof%d = sizeof(%s);\n", i, rec)
+	}
+	cxxFile := filepath.Join(dir, "sys.cpp")
+	check(os.WriteFile(cxxFile, src.Bytes(), 0644))
+
+	// constants
+	filter := func(name string) bool {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				return prefix != "E" || isUpper(name)
+			}
+		}
+		return false
+	}
+	consts := cconst.NewPackage()
+	var doc ast.Node
+	check(json.Unmarshal(clang(cflags, "-fsyntax-only", "-Xclang", "-ast-dump=json", "-x", "c", cFile), &doc))
+	consts.AddEnums(&doc, filter)
+	check(consts.AddMacros(bytes.NewReader(clang(cflags, "-E", "-dM", "-x", "c", cFile)), filter))
+
+	// struct layouts
+	dump := clang(cflags, "-fsyntax-only", "-Xclang", "-fdump-record-layouts",
+		"-Xclang", "-fdump-record-layouts-canonical", "-x", "c++", cxxFile)
+	recs, err := layout.Parse(bytes.NewReader(dump))
+	check(err)
+	byName := make(map[string]*layout.Record, len(recs))
+	for _, rec := range recs {
+		byName[rec.Name] = rec
+	}
+	conv := &layout.Converter{PtrSize: ptrSize, Names: make(map[string]string)}
+	for _, name := range records {
+		if _, ok := byName[name]; ok {
+			conv.Names[name] = layout.GoName(name)
+		}
+	}
+
+	var b bytes.Buffer
+	for _, name := range records {
+		if rec, ok := byName[name]; ok {
+			b.WriteString(conv.Struct(conv.Names[name], rec, byName))
+			b.WriteByte('\n')
+		} else {
+			fmt.Fprintln(os.Stderr, "llsysgen: no layout of", name)
+		}
+	}
+	consts.WriteDecls(&b)
+	decls := b.Bytes()
+	b = bytes.Buffer{}
+	fmt.Fprintf(&b, "// Code generated by llsysgen; DO NOT EDIT.\n\npackage %s\n\n", *pkgName)
+	if bytes.Contains(decls, []byte("c.")) {
+		b.WriteString("import \"github.com/goplus/llgo/c\"\n\n")
+	}
+	b.Write(decls)
+	out, err := format.Source(b.Bytes())
+	check(err)
+	check(os.MkdirAll(*outDir, 0755))
+	check(os.WriteFile(filepath.Join(*outDir, "zsys_"+*goos+"_"+*goarch+".go"), out, 0644))
+}
+
+func isUpper(name string) bool {
+	return strings.ToUpper(name) == name
+}
+
+func clang(cflags []string, args ...string) []byte {
+	var out bytes.Buffer
+	args = append(append([]string(nil), cflags...), args...)
+	cmd := exec.Command("clang", args...)
+	if *verbose {
+		fmt.Fprintln(os.Stderr, "clang", cmd.Args[1:])
+	}
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	check(cmd.Run())
+	return out.Bytes()
+}
+
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
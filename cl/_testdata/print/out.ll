@@ -1288,7 +1288,7 @@ _llgo_24:                                         ; preds = %_llgo_22
   br label %_llgo_12
 
 _llgo_25:                                         ; preds = %_llgo_27
-  %52 = fptosi double %71 to i64
+  %52 = call i64 @llvm.fptosi.sat.i64.f64(double %71)
   %53 = add i64 %72, 2
   %54 = add i64 %52, 48
   %55 = trunc i64 %54 to i8
@@ -1761,4 +1761,9 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
 
+; Function Attrs: nocallback nofree nosync nounwind speculatable willreturn memory(none)
+declare i64 @llvm.fptosi.sat.i64.f64(double) #0
+
 declare %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr, i64, i64, i64, i64, i64)
+
+attributes #0 = { nocallback nofree nosync nounwind speculatable willreturn memory(none) }
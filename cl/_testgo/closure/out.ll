@@ -43,21 +43,18 @@ _llgo_0:
   %9 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 1
   store ptr null, ptr %9, align 8
   %10 = load { ptr, ptr }, ptr %7, align 8
-  %11 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %12 = getelementptr inbounds { ptr }, ptr %11, i32 0, i32 0
-  store ptr %2, ptr %12, align 8
-  %13 = alloca { ptr, ptr }, align 8
-  %14 = getelementptr inbounds { ptr, ptr }, ptr %13, i32 0, i32 0
-  store ptr @"main.main$2", ptr %14, align 8
-  %15 = getelementptr inbounds { ptr, ptr }, ptr %13, i32 0, i32 1
-  store ptr %11, ptr %15, align 8
-  %16 = load { ptr, ptr }, ptr %13, align 8
-  %17 = extractvalue { ptr, ptr } %10, 1
-  %18 = extractvalue { ptr, ptr } %10, 0
-  call void %18(ptr %17, i64 100)
-  %19 = extractvalue { ptr, ptr } %16, 1
-  %20 = extractvalue { ptr, ptr } %16, 0
-  call void %20(ptr %19, i64 200)
+  %11 = alloca { ptr, ptr }, align 8
+  %12 = getelementptr inbounds { ptr, ptr }, ptr %11, i32 0, i32 0
+  store ptr @"main.main$2", ptr %12, align 8
+  %13 = getelementptr inbounds { ptr, ptr }, ptr %11, i32 0, i32 1
+  store ptr %2, ptr %13, align 8
+  %14 = load { ptr, ptr }, ptr %11, align 8
+  %15 = extractvalue { ptr, ptr } %10, 1
+  %16 = extractvalue { ptr, ptr } %10, 0
+  call void %16(ptr %15, i64 100)
+  %17 = extractvalue { ptr, ptr } %14, 1
+  %18 = extractvalue { ptr, ptr } %14, 0
+  call void %18(ptr %17, i64 200)
   ret i32 0
 }
 
@@ -78,20 +75,18 @@ _llgo_0:
 
 define void @"main.main$2"(ptr %0, i64 %1) {
 _llgo_0:
-  %2 = load { ptr }, ptr %0, align 8
-  %3 = extractvalue { ptr } %2, 0
-  %4 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %3, align 8
-  %5 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 0
-  store ptr @2, ptr %6, align 8
-  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 1
-  store i64 7, ptr %7, align 4
-  %8 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %5, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %8)
+  %2 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %0, align 8
+  %3 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 0
+  store ptr @2, ptr %4, align 8
+  %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 1
+  store i64 7, ptr %5, align 4
+  %6 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %3, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %6)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %1)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %4)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %2)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret void
 }
@@ -106,8 +101,6 @@ _llgo_0:
   ret void
 }
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
-
 declare void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String")
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
@@ -84,20 +84,18 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
 define void @"main.init#1"() {
 _llgo_0:
   %0 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 8)
-  %1 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %2 = getelementptr inbounds { ptr }, ptr %1, i32 0, i32 0
-  store ptr %0, ptr %2, align 8
-  %3 = alloca { ptr, ptr }, align 8
-  %4 = getelementptr inbounds { ptr, ptr }, ptr %3, i32 0, i32 0
-  store ptr @"main.init#1$2", ptr %4, align 8
-  %5 = getelementptr inbounds { ptr, ptr }, ptr %3, i32 0, i32 1
-  store ptr %1, ptr %5, align 8
-  %6 = load { ptr, ptr }, ptr %3, align 8
-  call void @main.assert(i1 true)
+  %1 = alloca { ptr, ptr }, align 8
+  %2 = getelementptr inbounds { ptr, ptr }, ptr %1, i32 0, i32 0
+  store ptr @"main.init#1$2", ptr %2, align 8
+  %3 = getelementptr inbounds { ptr, ptr }, ptr %1, i32 0, i32 1
+  store ptr %0, ptr %3, align 8
+  %4 = load { ptr, ptr }, ptr %1, align 8
   call void @main.assert(i1 true)
   call void @main.assert(i1 true)
-  %7 = extractvalue { ptr, ptr } %6, 0
   call void @main.assert(i1 true)
+  %5 = extractvalue { ptr, ptr } %4, 0
+  %6 = icmp ne ptr %5, null
+  call void @main.assert(i1 %6)
   call void @main.assert(i1 true)
   ret void
 }
@@ -110,10 +108,8 @@ _llgo_0:
 
 define void @"main.init#1$2"(ptr %0) {
 _llgo_0:
-  %1 = load { ptr }, ptr %0, align 8
-  %2 = extractvalue { ptr } %1, 0
-  %3 = load i64, ptr %2, align 4
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %3)
+  %1 = load i64, ptr %0, align 4
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %1)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret void
 }
@@ -322,35 +318,38 @@ _llgo_0:
 
 define void @"main.init#4"() {
 _llgo_0:
-  %0 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 24)
-  %1 = getelementptr inbounds i64, ptr %0, i64 0
-  store i64 1, ptr %1, align 4
-  %2 = getelementptr inbounds i64, ptr %0, i64 1
-  store i64 2, ptr %2, align 4
-  %3 = getelementptr inbounds i64, ptr %0, i64 2
-  store i64 3, ptr %3, align 4
-  %4 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %4, i32 0, i32 0
-  store ptr %0, ptr %5, align 8
-  %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %4, i32 0, i32 1
-  store i64 3, ptr %6, align 4
-  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %4, i32 0, i32 2
+  %0 = alloca [3 x i64], align 8
+  %1 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %0, i64 24)
+  %2 = getelementptr inbounds i64, ptr %1, i64 0
+  store i64 1, ptr %2, align 4
+  %3 = getelementptr inbounds i64, ptr %1, i64 1
+  store i64 2, ptr %3, align 4
+  %4 = getelementptr inbounds i64, ptr %1, i64 2
+  store i64 3, ptr %4, align 4
+  %5 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %5, i32 0, i32 0
+  store ptr %1, ptr %6, align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %5, i32 0, i32 1
   store i64 3, ptr %7, align 4
-  %8 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %4, align 8
-  %9 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 16)
-  %10 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %9, i64 8, i64 2, i64 0, i64 2, i64 2)
-  %11 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 16)
-  %12 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %11, i64 8, i64 2, i64 0, i64 0, i64 2)
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %5, i32 0, i32 2
+  store i64 3, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %5, align 8
+  %10 = alloca [2 x i64], align 8
+  %11 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %10, i64 16)
+  %12 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %11, i64 8, i64 2, i64 0, i64 2, i64 2)
+  %13 = alloca [2 x i64], align 8
+  %14 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %13, i64 16)
+  %15 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %14, i64 8, i64 2, i64 0, i64 0, i64 2)
   call void @main.assert(i1 true)
-  %13 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %8, 0
-  %14 = icmp ne ptr %13, null
-  call void @main.assert(i1 %14)
-  %15 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %10, 0
-  %16 = icmp ne ptr %15, null
-  call void @main.assert(i1 %16)
-  %17 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
-  %18 = icmp ne ptr %17, null
-  call void @main.assert(i1 %18)
+  %16 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %9, 0
+  %17 = icmp ne ptr %16, null
+  call void @main.assert(i1 %17)
+  %18 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
+  %19 = icmp ne ptr %18, null
+  call void @main.assert(i1 %19)
+  %20 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %15, 0
+  %21 = icmp ne ptr %20, null
+  call void @main.assert(i1 %21)
   call void @main.assert(i1 true)
   ret void
 }
@@ -537,7 +536,8 @@ define void @"main.init#7"() {
 _llgo_0:
   %0 = load ptr, ptr @"map[_llgo_int]_llgo_string", align 8
   %1 = call ptr @"github.com/goplus/llgo/internal/runtime.MakeMap"(ptr %0, i64 0)
-  call void @main.assert(i1 true)
+  %2 = icmp ne ptr %1, null
+  call void @main.assert(i1 %2)
   call void @main.assert(i1 true)
   ret void
 }
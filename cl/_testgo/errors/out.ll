@@ -19,6 +19,7 @@ source_filename = "main"
 @_llgo_string = linkonce global ptr null, align 8
 @3 = private unnamed_addr constant [11 x i8] c"errorString", align 1
 @"*_llgo_main.errorString" = global ptr null, align 8
+@"main.itab$YYlqK2rl9kDO_RaJgVxNyvHoshlule1bVD5jPBTXCXM" = global ptr null, align 8
 @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU" = linkonce global ptr null, align 8
 @__llgo_argc = global i32 0, align 4
 @__llgo_argv = global ptr null, align 8
@@ -30,15 +31,14 @@ _llgo_0:
   %2 = getelementptr inbounds %main.errorString, ptr %1, i32 0, i32 0
   store %"github.com/goplus/llgo/internal/runtime.String" %0, ptr %2, align 8
   %3 = load ptr, ptr @"*_llgo_main.errorString", align 8
-  %4 = load ptr, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
-  %5 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %4, ptr %3)
-  %6 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %6, i32 0, i32 0
-  store ptr %5, ptr %7, align 8
-  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %6, i32 0, i32 1
-  store ptr %1, ptr %8, align 8
-  %9 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %6, align 8
-  ret %"github.com/goplus/llgo/internal/runtime.iface" %9
+  %4 = load ptr, ptr @"main.itab$YYlqK2rl9kDO_RaJgVxNyvHoshlule1bVD5jPBTXCXM", align 8
+  %5 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %5, i32 0, i32 0
+  store ptr %4, ptr %6, align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %5, i32 0, i32 1
+  store ptr %1, ptr %7, align 8
+  %8 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %5, align 8
+  ret %"github.com/goplus/llgo/internal/runtime.iface" %8
 }
 
 define %"github.com/goplus/llgo/internal/runtime.String" @"main.(*errorString).Error"(ptr %0) {
@@ -80,7 +80,7 @@ _llgo_0:
   %7 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %6)
   %8 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %6, 0
   %9 = getelementptr ptr, ptr %8, i64 3
-  %10 = load ptr, ptr %9, align 8
+  %10 = load ptr, ptr %9, align 8, !nonnull !0
   %11 = alloca { ptr, ptr }, align 8
   %12 = getelementptr inbounds { ptr, ptr }, ptr %11, i32 0, i32 0
   store ptr %10, ptr %12, align 8
@@ -177,7 +177,7 @@ _llgo_3:                                          ; preds = %_llgo_2
   %45 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %42, i32 0, i32 2
   store i64 1, ptr %45, align 4
   %46 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %42, align 8
-  %47 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %39, %"github.com/goplus/llgo/internal/runtime.Slice" %46, i1 false)
+  %47 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %39, %"github.com/goplus/llgo/internal/runtime.Slice" %46, i1 false, ptr @"__llgo_call._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", ptr @"__llgo_makefunc._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %47)
   store ptr %47, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
   br label %_llgo_4
@@ -267,6 +267,10 @@ _llgo_5:                                          ; preds = %_llgo_4
   br label %_llgo_6
 
 _llgo_6:                                          ; preds = %_llgo_5, %_llgo_4
+  %98 = load ptr, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
+  %99 = load ptr, ptr @"*_llgo_main.errorString", align 8
+  %100 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %98, ptr %99)
+  store ptr %100, ptr @"main.itab$YYlqK2rl9kDO_RaJgVxNyvHoshlule1bVD5jPBTXCXM", align 8
   ret void
 }
 
@@ -282,7 +286,31 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", ptr, %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice")
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1)
+declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1, ptr, ptr)
+
+define linkonce void @"__llgo_call._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call %"github.com/goplus/llgo/internal/runtime.String" %6(ptr %5)
+  %8 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %7, ptr %8, align 8
+  ret void
+}
+
+define linkonce %"github.com/goplus/llgo/internal/runtime.String" @"__llgo_makefunc._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.String" }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %3, i32 0, i32 0
+  %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  ret %"github.com/goplus/llgo/internal/runtime.String" %7
+}
 
 declare void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr)
 
@@ -301,3 +329,5 @@ declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
 declare ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface")
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String")
+
+!0 = !{}
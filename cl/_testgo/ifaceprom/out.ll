@@ -1,6 +1,8 @@
 ; ModuleID = 'main'
 source_filename = "main"
 
+%"github.com/goplus/llgo/internal/runtime.G" = type { ptr, ptr, ptr, ptr, %"github.com/goplus/llgo/internal/runtime.procState", i1 }
+%"github.com/goplus/llgo/internal/runtime.procState" = type { i64, i64, i1 }
 %main.S = type { %"github.com/goplus/llgo/internal/runtime.iface" }
 %"github.com/goplus/llgo/internal/runtime.iface" = type { ptr, ptr }
 %"github.com/goplus/llgo/internal/runtime.String" = type { ptr, i64 }
@@ -12,24 +14,26 @@ source_filename = "main"
 %"github.com/goplus/llgo/internal/abi.StructField" = type { %"github.com/goplus/llgo/internal/runtime.String", ptr, i64, %"github.com/goplus/llgo/internal/runtime.String", i1 }
 
 @"main.init$guard" = global i1 false, align 1
+@__llgo_g = linkonce hidden thread_local global %"github.com/goplus/llgo/internal/runtime.G" zeroinitializer, align 8
 @0 = private unnamed_addr constant [3 x i8] c"two", align 1
+@1 = private unnamed_addr constant [9 x i8] c"main.impl", align 1
+@2 = private unnamed_addr constant [3 x i8] c"one", align 1
 @__llgo_argc = global i32 0, align 4
 @__llgo_argv = global ptr null, align 8
 @_llgo_main.impl = global ptr null, align 8
 @"_llgo_struct$n1H8J_3prDN3firMwPxBLVTkE5hJ9Di-AqNvaC9jczw" = linkonce global ptr null, align 8
-@1 = private unnamed_addr constant [4 x i8] c"main", align 1
-@2 = private unnamed_addr constant [3 x i8] c"one", align 1
-@3 = private unnamed_addr constant [8 x i8] c"main.one", align 1
+@3 = private unnamed_addr constant [4 x i8] c"main", align 1
+@4 = private unnamed_addr constant [8 x i8] c"main.one", align 1
 @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA" = linkonce global ptr null, align 8
 @_llgo_int = linkonce global ptr null, align 8
-@4 = private unnamed_addr constant [8 x i8] c"main.two", align 1
+@5 = private unnamed_addr constant [8 x i8] c"main.two", align 1
 @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to" = linkonce global ptr null, align 8
 @_llgo_string = linkonce global ptr null, align 8
-@5 = private unnamed_addr constant [4 x i8] c"impl", align 1
+@6 = private unnamed_addr constant [4 x i8] c"impl", align 1
+@"main.itab$9q2LfNx3I-8P6LB9k0w6NBtHjC4Rc_RDn3zabYQRu4c" = global ptr null, align 8
 @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA" = global ptr null, align 8
 @_llgo_main.I = linkonce global ptr null, align 8
-@6 = private unnamed_addr constant [6 x i8] c"main.I", align 1
-@7 = private unnamed_addr constant [21 x i8] c"type assertion failed", align 1
+@7 = private unnamed_addr constant [6 x i8] c"main.I", align 1
 @8 = private unnamed_addr constant [4 x i8] c"pass", align 1
 
 define i64 @main.S.one(%main.S %0) {
@@ -42,17 +46,22 @@ _llgo_0:
   %5 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %4)
   %6 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %4, 0
   %7 = getelementptr ptr, ptr %6, i64 3
-  %8 = load ptr, ptr %7, align 8
+  %8 = load ptr, ptr %7, align 8, !nonnull !0
   %9 = alloca { ptr, ptr }, align 8
   %10 = getelementptr inbounds { ptr, ptr }, ptr %9, i32 0, i32 0
   store ptr %8, ptr %10, align 8
   %11 = getelementptr inbounds { ptr, ptr }, ptr %9, i32 0, i32 1
   store ptr %5, ptr %11, align 8
   %12 = load { ptr, ptr }, ptr %9, align 8
-  %13 = extractvalue { ptr, ptr } %12, 1
-  %14 = extractvalue { ptr, ptr } %12, 0
-  %15 = call i64 %14(ptr %13)
-  ret i64 %15
+  %13 = extractvalue { ptr, ptr } %12, 0
+  %14 = load ptr, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %15 = icmp eq ptr %14, @main.S.one
+  %16 = select i1 %15, ptr %13, ptr %14
+  store ptr %16, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %17 = extractvalue { ptr, ptr } %12, 1
+  %18 = extractvalue { ptr, ptr } %12, 0
+  %19 = call i64 %18(ptr %17)
+  ret i64 %19
 }
 
 define %"github.com/goplus/llgo/internal/runtime.String" @main.S.two(%main.S %0) {
@@ -65,17 +74,22 @@ _llgo_0:
   %5 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %4)
   %6 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %4, 0
   %7 = getelementptr ptr, ptr %6, i64 4
-  %8 = load ptr, ptr %7, align 8
+  %8 = load ptr, ptr %7, align 8, !nonnull !0
   %9 = alloca { ptr, ptr }, align 8
   %10 = getelementptr inbounds { ptr, ptr }, ptr %9, i32 0, i32 0
   store ptr %8, ptr %10, align 8
   %11 = getelementptr inbounds { ptr, ptr }, ptr %9, i32 0, i32 1
   store ptr %5, ptr %11, align 8
   %12 = load { ptr, ptr }, ptr %9, align 8
-  %13 = extractvalue { ptr, ptr } %12, 1
-  %14 = extractvalue { ptr, ptr } %12, 0
-  %15 = call %"github.com/goplus/llgo/internal/runtime.String" %14(ptr %13)
-  ret %"github.com/goplus/llgo/internal/runtime.String" %15
+  %13 = extractvalue { ptr, ptr } %12, 0
+  %14 = load ptr, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %15 = icmp eq ptr %14, @main.S.two
+  %16 = select i1 %15, ptr %13, ptr %14
+  store ptr %16, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %17 = extractvalue { ptr, ptr } %12, 1
+  %18 = extractvalue { ptr, ptr } %12, 0
+  %19 = call %"github.com/goplus/llgo/internal/runtime.String" %18(ptr %17)
+  ret %"github.com/goplus/llgo/internal/runtime.String" %19
 }
 
 define i64 @"main.(*S).one"(ptr %0) {
@@ -85,17 +99,22 @@ _llgo_0:
   %3 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %2)
   %4 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %2, 0
   %5 = getelementptr ptr, ptr %4, i64 3
-  %6 = load ptr, ptr %5, align 8
+  %6 = load ptr, ptr %5, align 8, !nonnull !0
   %7 = alloca { ptr, ptr }, align 8
   %8 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 0
   store ptr %6, ptr %8, align 8
   %9 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 1
   store ptr %3, ptr %9, align 8
   %10 = load { ptr, ptr }, ptr %7, align 8
-  %11 = extractvalue { ptr, ptr } %10, 1
-  %12 = extractvalue { ptr, ptr } %10, 0
-  %13 = call i64 %12(ptr %11)
-  ret i64 %13
+  %11 = extractvalue { ptr, ptr } %10, 0
+  %12 = load ptr, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %13 = icmp eq ptr %12, @"main.(*S).one"
+  %14 = select i1 %13, ptr %11, ptr %12
+  store ptr %14, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %15 = extractvalue { ptr, ptr } %10, 1
+  %16 = extractvalue { ptr, ptr } %10, 0
+  %17 = call i64 %16(ptr %15)
+  ret i64 %17
 }
 
 define %"github.com/goplus/llgo/internal/runtime.String" @"main.(*S).two"(ptr %0) {
@@ -105,17 +124,22 @@ _llgo_0:
   %3 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %2)
   %4 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %2, 0
   %5 = getelementptr ptr, ptr %4, i64 4
-  %6 = load ptr, ptr %5, align 8
+  %6 = load ptr, ptr %5, align 8, !nonnull !0
   %7 = alloca { ptr, ptr }, align 8
   %8 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 0
   store ptr %6, ptr %8, align 8
   %9 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 1
   store ptr %3, ptr %9, align 8
   %10 = load { ptr, ptr }, ptr %7, align 8
-  %11 = extractvalue { ptr, ptr } %10, 1
-  %12 = extractvalue { ptr, ptr } %10, 0
-  %13 = call %"github.com/goplus/llgo/internal/runtime.String" %12(ptr %11)
-  ret %"github.com/goplus/llgo/internal/runtime.String" %13
+  %11 = extractvalue { ptr, ptr } %10, 0
+  %12 = load ptr, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %13 = icmp eq ptr %12, @"main.(*S).two"
+  %14 = select i1 %13, ptr %11, ptr %12
+  store ptr %14, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %15 = extractvalue { ptr, ptr } %10, 1
+  %16 = extractvalue { ptr, ptr } %10, 0
+  %17 = call %"github.com/goplus/llgo/internal/runtime.String" %16(ptr %15)
+  ret %"github.com/goplus/llgo/internal/runtime.String" %17
 }
 
 define i64 @main.impl.one(%main.impl %0) {
@@ -136,16 +160,44 @@ _llgo_0:
 
 define i64 @"main.(*impl).one"(ptr %0) {
 _llgo_0:
-  %1 = load %main.impl, ptr %0, align 1
-  %2 = call i64 @main.impl.one(%main.impl %1)
-  ret i64 %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @1, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 9, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @2, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 3, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load %main.impl, ptr %0, align 1
+  %11 = call i64 @main.impl.one(%main.impl %10)
+  ret i64 %11
 }
 
 define %"github.com/goplus/llgo/internal/runtime.String" @"main.(*impl).two"(ptr %0) {
 _llgo_0:
-  %1 = load %main.impl, ptr %0, align 1
-  %2 = call %"github.com/goplus/llgo/internal/runtime.String" @main.impl.two(%main.impl %1)
-  ret %"github.com/goplus/llgo/internal/runtime.String" %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @1, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 9, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @0, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 3, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load %main.impl, ptr %0, align 1
+  %11 = call %"github.com/goplus/llgo/internal/runtime.String" @main.impl.two(%main.impl %10)
+  ret %"github.com/goplus/llgo/internal/runtime.String" %11
 }
 
 define void @main.init() {
@@ -164,445 +216,384 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
 
 define i32 @main(i32 %0, ptr %1) {
 _llgo_0:
+  %2 = alloca { %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %4 = alloca { %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %5 = alloca { %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
   store i32 %0, ptr @__llgo_argc, align 4
   store ptr %1, ptr @__llgo_argv, align 8
   call void @"github.com/goplus/llgo/internal/runtime.init"()
   call void @main.init()
-  %2 = alloca %main.S, align 8
-  %3 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %2, i64 16)
-  %4 = getelementptr inbounds %main.S, ptr %3, i32 0, i32 0
-  %5 = load ptr, ptr @_llgo_main.impl, align 8
-  %6 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
-  store %main.impl zeroinitializer, ptr %6, align 1
-  %7 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
-  %8 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %7, ptr %5)
-  %9 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %10 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %9, i32 0, i32 0
-  store ptr %8, ptr %10, align 8
-  %11 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %9, i32 0, i32 1
-  store ptr %6, ptr %11, align 8
-  %12 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %9, align 8
-  store %"github.com/goplus/llgo/internal/runtime.iface" %12, ptr %4, align 8
-  %13 = getelementptr inbounds %main.S, ptr %3, i32 0, i32 0
-  %14 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %13, align 8
-  %15 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %14)
-  %16 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %14, 0
-  %17 = getelementptr ptr, ptr %16, i64 3
-  %18 = load ptr, ptr %17, align 8
-  %19 = alloca { ptr, ptr }, align 8
-  %20 = getelementptr inbounds { ptr, ptr }, ptr %19, i32 0, i32 0
-  store ptr %18, ptr %20, align 8
-  %21 = getelementptr inbounds { ptr, ptr }, ptr %19, i32 0, i32 1
-  store ptr %15, ptr %21, align 8
-  %22 = load { ptr, ptr }, ptr %19, align 8
-  %23 = extractvalue { ptr, ptr } %22, 1
-  %24 = extractvalue { ptr, ptr } %22, 0
-  %25 = call i64 %24(ptr %23)
-  %26 = icmp ne i64 %25, 1
-  br i1 %26, label %_llgo_1, label %_llgo_2
+  %6 = alloca %main.S, align 8
+  %7 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %6, i64 16)
+  %8 = getelementptr inbounds %main.S, ptr %7, i32 0, i32 0
+  %9 = load ptr, ptr @_llgo_main.impl, align 8
+  %10 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
+  store %main.impl zeroinitializer, ptr %10, align 1
+  %11 = load ptr, ptr @"main.itab$9q2LfNx3I-8P6LB9k0w6NBtHjC4Rc_RDn3zabYQRu4c", align 8
+  %12 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %13 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, i32 0, i32 0
+  store ptr %11, ptr %13, align 8
+  %14 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, i32 0, i32 1
+  store ptr %10, ptr %14, align 8
+  %15 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, align 8
+  store %"github.com/goplus/llgo/internal/runtime.iface" %15, ptr %8, align 8
+  %16 = getelementptr inbounds %main.S, ptr %7, i32 0, i32 0
+  %17 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %16, align 8
+  %18 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %17)
+  %19 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %17, 0
+  %20 = getelementptr ptr, ptr %19, i64 3
+  %21 = load ptr, ptr %20, align 8, !nonnull !0
+  %22 = alloca { ptr, ptr }, align 8
+  %23 = getelementptr inbounds { ptr, ptr }, ptr %22, i32 0, i32 0
+  store ptr %21, ptr %23, align 8
+  %24 = getelementptr inbounds { ptr, ptr }, ptr %22, i32 0, i32 1
+  store ptr %18, ptr %24, align 8
+  %25 = load { ptr, ptr }, ptr %22, align 8
+  %26 = extractvalue { ptr, ptr } %25, 1
+  %27 = extractvalue { ptr, ptr } %25, 0
+  %28 = call i64 %27(ptr %26)
+  %29 = icmp ne i64 %28, 1
+  br i1 %29, label %_llgo_1, label %_llgo_2
 
 _llgo_1:                                          ; preds = %_llgo_0
-  %27 = load ptr, ptr @_llgo_int, align 8
-  %28 = inttoptr i64 %25 to ptr
-  %29 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %30 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %29, i32 0, i32 0
-  store ptr %27, ptr %30, align 8
-  %31 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %29, i32 0, i32 1
-  store ptr %28, ptr %31, align 8
-  %32 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %29, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %32)
+  %30 = load ptr, ptr @_llgo_int, align 8
+  %31 = inttoptr i64 %28 to ptr
+  %32 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %33 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %32, i32 0, i32 0
+  store ptr %30, ptr %33, align 8
+  %34 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %32, i32 0, i32 1
+  store ptr %31, ptr %34, align 8
+  %35 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %32, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %35)
   unreachable
 
 _llgo_2:                                          ; preds = %_llgo_0
-  %33 = load %main.S, ptr %3, align 8
-  %34 = extractvalue %main.S %33, 0
-  %35 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %34)
-  %36 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %34, 0
-  %37 = getelementptr ptr, ptr %36, i64 3
-  %38 = load ptr, ptr %37, align 8
-  %39 = alloca { ptr, ptr }, align 8
-  %40 = getelementptr inbounds { ptr, ptr }, ptr %39, i32 0, i32 0
-  store ptr %38, ptr %40, align 8
-  %41 = getelementptr inbounds { ptr, ptr }, ptr %39, i32 0, i32 1
-  store ptr %35, ptr %41, align 8
-  %42 = load { ptr, ptr }, ptr %39, align 8
-  %43 = extractvalue { ptr, ptr } %42, 1
-  %44 = extractvalue { ptr, ptr } %42, 0
-  %45 = call i64 %44(ptr %43)
-  %46 = icmp ne i64 %45, 1
-  br i1 %46, label %_llgo_3, label %_llgo_4
+  %36 = load %main.S, ptr %7, align 8
+  %37 = extractvalue %main.S %36, 0
+  %38 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %37)
+  %39 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %37, 0
+  %40 = getelementptr ptr, ptr %39, i64 3
+  %41 = load ptr, ptr %40, align 8, !nonnull !0
+  %42 = alloca { ptr, ptr }, align 8
+  %43 = getelementptr inbounds { ptr, ptr }, ptr %42, i32 0, i32 0
+  store ptr %41, ptr %43, align 8
+  %44 = getelementptr inbounds { ptr, ptr }, ptr %42, i32 0, i32 1
+  store ptr %38, ptr %44, align 8
+  %45 = load { ptr, ptr }, ptr %42, align 8
+  %46 = extractvalue { ptr, ptr } %45, 1
+  %47 = extractvalue { ptr, ptr } %45, 0
+  %48 = call i64 %47(ptr %46)
+  %49 = icmp ne i64 %48, 1
+  br i1 %49, label %_llgo_3, label %_llgo_4
 
 _llgo_3:                                          ; preds = %_llgo_2
-  %47 = load ptr, ptr @_llgo_int, align 8
-  %48 = inttoptr i64 %45 to ptr
-  %49 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %50 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %49, i32 0, i32 0
-  store ptr %47, ptr %50, align 8
-  %51 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %49, i32 0, i32 1
-  store ptr %48, ptr %51, align 8
-  %52 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %49, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %52)
+  %50 = load ptr, ptr @_llgo_int, align 8
+  %51 = inttoptr i64 %48 to ptr
+  %52 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %53 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %52, i32 0, i32 0
+  store ptr %50, ptr %53, align 8
+  %54 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %52, i32 0, i32 1
+  store ptr %51, ptr %54, align 8
+  %55 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %52, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %55)
   unreachable
 
 _llgo_4:                                          ; preds = %_llgo_2
-  %53 = getelementptr inbounds %main.S, ptr %3, i32 0, i32 0
-  %54 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %53, align 8
-  %55 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %54)
-  %56 = load ptr, ptr @_llgo_main.I, align 8
-  %57 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %56, ptr %55)
-  br i1 %57, label %_llgo_17, label %_llgo_18
+  %56 = getelementptr inbounds %main.S, ptr %7, i32 0, i32 0
+  %57 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %56, align 8
+  %58 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %57)
+  %59 = load ptr, ptr @_llgo_main.I, align 8
+  %60 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %59, ptr %58)
+  br i1 %60, label %_llgo_17, label %_llgo_18
 
 _llgo_5:                                          ; preds = %_llgo_17
-  %58 = load ptr, ptr @_llgo_int, align 8
-  %59 = inttoptr i64 %166 to ptr
-  %60 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %61 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %60, i32 0, i32 0
-  store ptr %58, ptr %61, align 8
-  %62 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %60, i32 0, i32 1
-  store ptr %59, ptr %62, align 8
-  %63 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %60, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %63)
+  %61 = load ptr, ptr @_llgo_int, align 8
+  %62 = inttoptr i64 %168 to ptr
+  %63 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %64 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %63, i32 0, i32 0
+  store ptr %61, ptr %64, align 8
+  %65 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %63, i32 0, i32 1
+  store ptr %62, ptr %65, align 8
+  %66 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %63, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %66)
   unreachable
 
 _llgo_6:                                          ; preds = %_llgo_17
-  %64 = load %main.S, ptr %3, align 8
-  %65 = extractvalue %main.S %64, 0
-  %66 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %65)
-  %67 = load ptr, ptr @_llgo_main.I, align 8
-  %68 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %67, ptr %66)
-  br i1 %68, label %_llgo_19, label %_llgo_20
+  %67 = load %main.S, ptr %7, align 8
+  %68 = extractvalue %main.S %67, 0
+  %69 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %68)
+  %70 = load ptr, ptr @_llgo_main.I, align 8
+  %71 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %70, ptr %69)
+  br i1 %71, label %_llgo_19, label %_llgo_20
 
 _llgo_7:                                          ; preds = %_llgo_19
-  %69 = load ptr, ptr @_llgo_int, align 8
-  %70 = inttoptr i64 %193 to ptr
-  %71 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %72 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %71, i32 0, i32 0
-  store ptr %69, ptr %72, align 8
-  %73 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %71, i32 0, i32 1
-  store ptr %70, ptr %73, align 8
-  %74 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %71, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %74)
+  %72 = load ptr, ptr @_llgo_int, align 8
+  %73 = inttoptr i64 %184 to ptr
+  %74 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %75 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %74, i32 0, i32 0
+  store ptr %72, ptr %75, align 8
+  %76 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %74, i32 0, i32 1
+  store ptr %73, ptr %76, align 8
+  %77 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %74, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %77)
   unreachable
 
 _llgo_8:                                          ; preds = %_llgo_19
-  %75 = getelementptr inbounds %main.S, ptr %3, i32 0, i32 0
-  %76 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %75, align 8
-  %77 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %76)
-  %78 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %76, 0
-  %79 = getelementptr ptr, ptr %78, i64 4
-  %80 = load ptr, ptr %79, align 8
-  %81 = alloca { ptr, ptr }, align 8
-  %82 = getelementptr inbounds { ptr, ptr }, ptr %81, i32 0, i32 0
-  store ptr %80, ptr %82, align 8
-  %83 = getelementptr inbounds { ptr, ptr }, ptr %81, i32 0, i32 1
-  store ptr %77, ptr %83, align 8
-  %84 = load { ptr, ptr }, ptr %81, align 8
-  %85 = extractvalue { ptr, ptr } %84, 1
-  %86 = extractvalue { ptr, ptr } %84, 0
-  %87 = call %"github.com/goplus/llgo/internal/runtime.String" %86(ptr %85)
-  %88 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %89 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %88, i32 0, i32 0
-  store ptr @0, ptr %89, align 8
-  %90 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %88, i32 0, i32 1
-  store i64 3, ptr %90, align 4
-  %91 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %88, align 8
-  %92 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %87, %"github.com/goplus/llgo/internal/runtime.String" %91)
-  %93 = xor i1 %92, true
-  br i1 %93, label %_llgo_9, label %_llgo_10
+  %78 = getelementptr inbounds %main.S, ptr %7, i32 0, i32 0
+  %79 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %78, align 8
+  %80 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %79)
+  %81 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %79, 0
+  %82 = getelementptr ptr, ptr %81, i64 4
+  %83 = load ptr, ptr %82, align 8, !nonnull !0
+  %84 = alloca { ptr, ptr }, align 8
+  %85 = getelementptr inbounds { ptr, ptr }, ptr %84, i32 0, i32 0
+  store ptr %83, ptr %85, align 8
+  %86 = getelementptr inbounds { ptr, ptr }, ptr %84, i32 0, i32 1
+  store ptr %80, ptr %86, align 8
+  %87 = load { ptr, ptr }, ptr %84, align 8
+  %88 = extractvalue { ptr, ptr } %87, 1
+  %89 = extractvalue { ptr, ptr } %87, 0
+  %90 = call %"github.com/goplus/llgo/internal/runtime.String" %89(ptr %88)
+  %91 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %92 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %91, i32 0, i32 0
+  store ptr @0, ptr %92, align 8
+  %93 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %91, i32 0, i32 1
+  store i64 3, ptr %93, align 4
+  %94 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %91, align 8
+  %95 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %90, %"github.com/goplus/llgo/internal/runtime.String" %94)
+  %96 = xor i1 %95, true
+  br i1 %96, label %_llgo_9, label %_llgo_10
 
 _llgo_9:                                          ; preds = %_llgo_8
-  %94 = load ptr, ptr @_llgo_string, align 8
-  %95 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %87, ptr %95, align 8
-  %96 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %97 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, i32 0, i32 0
-  store ptr %94, ptr %97, align 8
-  %98 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, i32 0, i32 1
-  store ptr %95, ptr %98, align 8
-  %99 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %99)
+  %97 = load ptr, ptr @_llgo_string, align 8
+  %98 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.String" %90, ptr %98, align 8
+  %99 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %100 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %99, i32 0, i32 0
+  store ptr %97, ptr %100, align 8
+  %101 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %99, i32 0, i32 1
+  store ptr %98, ptr %101, align 8
+  %102 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %99, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %102)
   unreachable
 
 _llgo_10:                                         ; preds = %_llgo_8
-  %100 = load %main.S, ptr %3, align 8
-  %101 = extractvalue %main.S %100, 0
-  %102 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %101)
-  %103 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %101, 0
-  %104 = getelementptr ptr, ptr %103, i64 4
-  %105 = load ptr, ptr %104, align 8
-  %106 = alloca { ptr, ptr }, align 8
-  %107 = getelementptr inbounds { ptr, ptr }, ptr %106, i32 0, i32 0
-  store ptr %105, ptr %107, align 8
-  %108 = getelementptr inbounds { ptr, ptr }, ptr %106, i32 0, i32 1
-  store ptr %102, ptr %108, align 8
-  %109 = load { ptr, ptr }, ptr %106, align 8
-  %110 = extractvalue { ptr, ptr } %109, 1
-  %111 = extractvalue { ptr, ptr } %109, 0
-  %112 = call %"github.com/goplus/llgo/internal/runtime.String" %111(ptr %110)
-  %113 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %114 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %113, i32 0, i32 0
-  store ptr @0, ptr %114, align 8
-  %115 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %113, i32 0, i32 1
-  store i64 3, ptr %115, align 4
-  %116 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %113, align 8
-  %117 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %112, %"github.com/goplus/llgo/internal/runtime.String" %116)
-  %118 = xor i1 %117, true
-  br i1 %118, label %_llgo_11, label %_llgo_12
+  %103 = load %main.S, ptr %7, align 8
+  %104 = extractvalue %main.S %103, 0
+  %105 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %104)
+  %106 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %104, 0
+  %107 = getelementptr ptr, ptr %106, i64 4
+  %108 = load ptr, ptr %107, align 8, !nonnull !0
+  %109 = alloca { ptr, ptr }, align 8
+  %110 = getelementptr inbounds { ptr, ptr }, ptr %109, i32 0, i32 0
+  store ptr %108, ptr %110, align 8
+  %111 = getelementptr inbounds { ptr, ptr }, ptr %109, i32 0, i32 1
+  store ptr %105, ptr %111, align 8
+  %112 = load { ptr, ptr }, ptr %109, align 8
+  %113 = extractvalue { ptr, ptr } %112, 1
+  %114 = extractvalue { ptr, ptr } %112, 0
+  %115 = call %"github.com/goplus/llgo/internal/runtime.String" %114(ptr %113)
+  %116 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %117 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %116, i32 0, i32 0
+  store ptr @0, ptr %117, align 8
+  %118 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %116, i32 0, i32 1
+  store i64 3, ptr %118, align 4
+  %119 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %116, align 8
+  %120 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %115, %"github.com/goplus/llgo/internal/runtime.String" %119)
+  %121 = xor i1 %120, true
+  br i1 %121, label %_llgo_11, label %_llgo_12
 
 _llgo_11:                                         ; preds = %_llgo_10
-  %119 = load ptr, ptr @_llgo_string, align 8
-  %120 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %112, ptr %120, align 8
-  %121 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %122 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %121, i32 0, i32 0
-  store ptr %119, ptr %122, align 8
-  %123 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %121, i32 0, i32 1
-  store ptr %120, ptr %123, align 8
-  %124 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %121, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %124)
+  %122 = load ptr, ptr @_llgo_string, align 8
+  %123 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.String" %115, ptr %123, align 8
+  %124 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %125 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %124, i32 0, i32 0
+  store ptr %122, ptr %125, align 8
+  %126 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %124, i32 0, i32 1
+  store ptr %123, ptr %126, align 8
+  %127 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %124, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %127)
   unreachable
 
 _llgo_12:                                         ; preds = %_llgo_10
-  %125 = getelementptr inbounds %main.S, ptr %3, i32 0, i32 0
-  %126 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %125, align 8
-  %127 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %126)
-  %128 = load ptr, ptr @_llgo_main.I, align 8
-  %129 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %128, ptr %127)
-  br i1 %129, label %_llgo_21, label %_llgo_22
+  %128 = getelementptr inbounds %main.S, ptr %7, i32 0, i32 0
+  %129 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %128, align 8
+  %130 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %129)
+  %131 = load ptr, ptr @_llgo_main.I, align 8
+  %132 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %131, ptr %130)
+  br i1 %132, label %_llgo_21, label %_llgo_22
 
 _llgo_13:                                         ; preds = %_llgo_21
-  %130 = load ptr, ptr @_llgo_string, align 8
-  %131 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %220, ptr %131, align 8
-  %132 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %133 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %132, i32 0, i32 0
-  store ptr %130, ptr %133, align 8
-  %134 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %132, i32 0, i32 1
-  store ptr %131, ptr %134, align 8
-  %135 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %132, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %135)
+  %133 = load ptr, ptr @_llgo_string, align 8
+  %134 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.String" %200, ptr %134, align 8
+  %135 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %136 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %135, i32 0, i32 0
+  store ptr %133, ptr %136, align 8
+  %137 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %135, i32 0, i32 1
+  store ptr %134, ptr %137, align 8
+  %138 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %135, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %138)
   unreachable
 
 _llgo_14:                                         ; preds = %_llgo_21
-  %136 = load %main.S, ptr %3, align 8
-  %137 = extractvalue %main.S %136, 0
-  %138 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %137)
-  %139 = load ptr, ptr @_llgo_main.I, align 8
-  %140 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %139, ptr %138)
-  br i1 %140, label %_llgo_23, label %_llgo_24
+  %139 = load %main.S, ptr %7, align 8
+  %140 = extractvalue %main.S %139, 0
+  %141 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %140)
+  %142 = load ptr, ptr @_llgo_main.I, align 8
+  %143 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %142, ptr %141)
+  br i1 %143, label %_llgo_23, label %_llgo_24
 
 _llgo_15:                                         ; preds = %_llgo_23
-  %141 = load ptr, ptr @_llgo_string, align 8
-  %142 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %252, ptr %142, align 8
-  %143 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %144 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %143, i32 0, i32 0
-  store ptr %141, ptr %144, align 8
-  %145 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %143, i32 0, i32 1
-  store ptr %142, ptr %145, align 8
-  %146 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %143, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %146)
+  %144 = load ptr, ptr @_llgo_string, align 8
+  %145 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.String" %221, ptr %145, align 8
+  %146 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %147 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %146, i32 0, i32 0
+  store ptr %144, ptr %147, align 8
+  %148 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %146, i32 0, i32 1
+  store ptr %145, ptr %148, align 8
+  %149 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %146, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %149)
   unreachable
 
 _llgo_16:                                         ; preds = %_llgo_23
-  %147 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %148 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %147, i32 0, i32 0
-  store ptr @8, ptr %148, align 8
-  %149 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %147, i32 0, i32 1
-  store i64 4, ptr %149, align 4
-  %150 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %147, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %150)
+  %150 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %151 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %150, i32 0, i32 0
+  store ptr @8, ptr %151, align 8
+  %152 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %150, i32 0, i32 1
+  store i64 4, ptr %152, align 4
+  %153 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %150, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %153)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret i32 0
 
 _llgo_17:                                         ; preds = %_llgo_4
-  %151 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %54, 1
-  %152 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
-  %153 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %152, ptr %55)
-  %154 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %155 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %154, i32 0, i32 0
-  store ptr %153, ptr %155, align 8
-  %156 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %154, i32 0, i32 1
-  store ptr %151, ptr %156, align 8
-  %157 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %154, align 8
-  %158 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %159 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %158, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.iface" %54, ptr %159, align 8
-  %160 = alloca { ptr, ptr }, align 8
-  %161 = getelementptr inbounds { ptr, ptr }, ptr %160, i32 0, i32 0
-  store ptr @"main.one$bound", ptr %161, align 8
-  %162 = getelementptr inbounds { ptr, ptr }, ptr %160, i32 0, i32 1
-  store ptr %158, ptr %162, align 8
-  %163 = load { ptr, ptr }, ptr %160, align 8
-  %164 = extractvalue { ptr, ptr } %163, 1
-  %165 = extractvalue { ptr, ptr } %163, 0
-  %166 = call i64 %165(ptr %164)
-  %167 = icmp ne i64 %166, 1
-  br i1 %167, label %_llgo_5, label %_llgo_6
+  %154 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %57, 1
+  %155 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
+  %156 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %155, ptr %58)
+  %157 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %158 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %157, i32 0, i32 0
+  store ptr %156, ptr %158, align 8
+  %159 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %157, i32 0, i32 1
+  store ptr %154, ptr %159, align 8
+  %160 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %157, align 8
+  %161 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %2, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %57, ptr %161, align 8
+  %162 = alloca { ptr, ptr }, align 8
+  %163 = getelementptr inbounds { ptr, ptr }, ptr %162, i32 0, i32 0
+  store ptr @"main.one$bound", ptr %163, align 8
+  %164 = getelementptr inbounds { ptr, ptr }, ptr %162, i32 0, i32 1
+  store ptr %2, ptr %164, align 8
+  %165 = load { ptr, ptr }, ptr %162, align 8
+  %166 = extractvalue { ptr, ptr } %165, 1
+  %167 = extractvalue { ptr, ptr } %165, 0
+  %168 = call i64 %167(ptr %166)
+  %169 = icmp ne i64 %168, 1
+  br i1 %169, label %_llgo_5, label %_llgo_6
 
 _llgo_18:                                         ; preds = %_llgo_4
-  %168 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %169 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %168, i32 0, i32 0
-  store ptr @7, ptr %169, align 8
-  %170 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %168, i32 0, i32 1
-  store i64 21, ptr %170, align 4
-  %171 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %168, align 8
-  %172 = load ptr, ptr @_llgo_string, align 8
-  %173 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %171, ptr %173, align 8
-  %174 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %175 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %174, i32 0, i32 0
-  store ptr %172, ptr %175, align 8
-  %176 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %174, i32 0, i32 1
-  store ptr %173, ptr %176, align 8
-  %177 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %174, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %177)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %58, ptr %59)
   unreachable
 
 _llgo_19:                                         ; preds = %_llgo_6
-  %178 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %65, 1
-  %179 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
-  %180 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %179, ptr %66)
-  %181 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %182 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %181, i32 0, i32 0
-  store ptr %180, ptr %182, align 8
-  %183 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %181, i32 0, i32 1
-  store ptr %178, ptr %183, align 8
-  %184 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %181, align 8
-  %185 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %186 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %185, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.iface" %65, ptr %186, align 8
-  %187 = alloca { ptr, ptr }, align 8
-  %188 = getelementptr inbounds { ptr, ptr }, ptr %187, i32 0, i32 0
-  store ptr @"main.one$bound", ptr %188, align 8
-  %189 = getelementptr inbounds { ptr, ptr }, ptr %187, i32 0, i32 1
-  store ptr %185, ptr %189, align 8
-  %190 = load { ptr, ptr }, ptr %187, align 8
-  %191 = extractvalue { ptr, ptr } %190, 1
-  %192 = extractvalue { ptr, ptr } %190, 0
-  %193 = call i64 %192(ptr %191)
-  %194 = icmp ne i64 %193, 1
-  br i1 %194, label %_llgo_7, label %_llgo_8
+  %170 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %68, 1
+  %171 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
+  %172 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %171, ptr %69)
+  %173 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %174 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %173, i32 0, i32 0
+  store ptr %172, ptr %174, align 8
+  %175 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %173, i32 0, i32 1
+  store ptr %170, ptr %175, align 8
+  %176 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %173, align 8
+  %177 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %68, ptr %177, align 8
+  %178 = alloca { ptr, ptr }, align 8
+  %179 = getelementptr inbounds { ptr, ptr }, ptr %178, i32 0, i32 0
+  store ptr @"main.one$bound", ptr %179, align 8
+  %180 = getelementptr inbounds { ptr, ptr }, ptr %178, i32 0, i32 1
+  store ptr %3, ptr %180, align 8
+  %181 = load { ptr, ptr }, ptr %178, align 8
+  %182 = extractvalue { ptr, ptr } %181, 1
+  %183 = extractvalue { ptr, ptr } %181, 0
+  %184 = call i64 %183(ptr %182)
+  %185 = icmp ne i64 %184, 1
+  br i1 %185, label %_llgo_7, label %_llgo_8
 
 _llgo_20:                                         ; preds = %_llgo_6
-  %195 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %196 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %195, i32 0, i32 0
-  store ptr @7, ptr %196, align 8
-  %197 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %195, i32 0, i32 1
-  store i64 21, ptr %197, align 4
-  %198 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %195, align 8
-  %199 = load ptr, ptr @_llgo_string, align 8
-  %200 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %198, ptr %200, align 8
-  %201 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %202 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %201, i32 0, i32 0
-  store ptr %199, ptr %202, align 8
-  %203 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %201, i32 0, i32 1
-  store ptr %200, ptr %203, align 8
-  %204 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %201, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %204)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %69, ptr %70)
   unreachable
 
 _llgo_21:                                         ; preds = %_llgo_12
-  %205 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %126, 1
-  %206 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
-  %207 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %206, ptr %127)
-  %208 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %209 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %208, i32 0, i32 0
-  store ptr %207, ptr %209, align 8
-  %210 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %208, i32 0, i32 1
-  store ptr %205, ptr %210, align 8
-  %211 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %208, align 8
-  %212 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %213 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %212, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.iface" %126, ptr %213, align 8
-  %214 = alloca { ptr, ptr }, align 8
-  %215 = getelementptr inbounds { ptr, ptr }, ptr %214, i32 0, i32 0
-  store ptr @"main.two$bound", ptr %215, align 8
-  %216 = getelementptr inbounds { ptr, ptr }, ptr %214, i32 0, i32 1
-  store ptr %212, ptr %216, align 8
-  %217 = load { ptr, ptr }, ptr %214, align 8
-  %218 = extractvalue { ptr, ptr } %217, 1
-  %219 = extractvalue { ptr, ptr } %217, 0
-  %220 = call %"github.com/goplus/llgo/internal/runtime.String" %219(ptr %218)
-  %221 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %222 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %221, i32 0, i32 0
-  store ptr @0, ptr %222, align 8
-  %223 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %221, i32 0, i32 1
-  store i64 3, ptr %223, align 4
-  %224 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %221, align 8
-  %225 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %220, %"github.com/goplus/llgo/internal/runtime.String" %224)
-  %226 = xor i1 %225, true
-  br i1 %226, label %_llgo_13, label %_llgo_14
+  %186 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %129, 1
+  %187 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
+  %188 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %187, ptr %130)
+  %189 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %190 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %189, i32 0, i32 0
+  store ptr %188, ptr %190, align 8
+  %191 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %189, i32 0, i32 1
+  store ptr %186, ptr %191, align 8
+  %192 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %189, align 8
+  %193 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %4, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %129, ptr %193, align 8
+  %194 = alloca { ptr, ptr }, align 8
+  %195 = getelementptr inbounds { ptr, ptr }, ptr %194, i32 0, i32 0
+  store ptr @"main.two$bound", ptr %195, align 8
+  %196 = getelementptr inbounds { ptr, ptr }, ptr %194, i32 0, i32 1
+  store ptr %4, ptr %196, align 8
+  %197 = load { ptr, ptr }, ptr %194, align 8
+  %198 = extractvalue { ptr, ptr } %197, 1
+  %199 = extractvalue { ptr, ptr } %197, 0
+  %200 = call %"github.com/goplus/llgo/internal/runtime.String" %199(ptr %198)
+  %201 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %202 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %201, i32 0, i32 0
+  store ptr @0, ptr %202, align 8
+  %203 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %201, i32 0, i32 1
+  store i64 3, ptr %203, align 4
+  %204 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %201, align 8
+  %205 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %200, %"github.com/goplus/llgo/internal/runtime.String" %204)
+  %206 = xor i1 %205, true
+  br i1 %206, label %_llgo_13, label %_llgo_14
 
 _llgo_22:                                         ; preds = %_llgo_12
-  %227 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %228 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %227, i32 0, i32 0
-  store ptr @7, ptr %228, align 8
-  %229 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %227, i32 0, i32 1
-  store i64 21, ptr %229, align 4
-  %230 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %227, align 8
-  %231 = load ptr, ptr @_llgo_string, align 8
-  %232 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %230, ptr %232, align 8
-  %233 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %234 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %233, i32 0, i32 0
-  store ptr %231, ptr %234, align 8
-  %235 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %233, i32 0, i32 1
-  store ptr %232, ptr %235, align 8
-  %236 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %233, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %236)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %130, ptr %131)
   unreachable
 
 _llgo_23:                                         ; preds = %_llgo_14
-  %237 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %137, 1
-  %238 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
-  %239 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %238, ptr %138)
-  %240 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %241 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %240, i32 0, i32 0
-  store ptr %239, ptr %241, align 8
-  %242 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %240, i32 0, i32 1
-  store ptr %237, ptr %242, align 8
-  %243 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %240, align 8
-  %244 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %245 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %244, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.iface" %137, ptr %245, align 8
-  %246 = alloca { ptr, ptr }, align 8
-  %247 = getelementptr inbounds { ptr, ptr }, ptr %246, i32 0, i32 0
-  store ptr @"main.two$bound", ptr %247, align 8
-  %248 = getelementptr inbounds { ptr, ptr }, ptr %246, i32 0, i32 1
-  store ptr %244, ptr %248, align 8
-  %249 = load { ptr, ptr }, ptr %246, align 8
-  %250 = extractvalue { ptr, ptr } %249, 1
-  %251 = extractvalue { ptr, ptr } %249, 0
-  %252 = call %"github.com/goplus/llgo/internal/runtime.String" %251(ptr %250)
-  %253 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %254 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %253, i32 0, i32 0
-  store ptr @0, ptr %254, align 8
-  %255 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %253, i32 0, i32 1
-  store i64 3, ptr %255, align 4
-  %256 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %253, align 8
-  %257 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %252, %"github.com/goplus/llgo/internal/runtime.String" %256)
-  %258 = xor i1 %257, true
-  br i1 %258, label %_llgo_15, label %_llgo_16
+  %207 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %140, 1
+  %208 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
+  %209 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %208, ptr %141)
+  %210 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %211 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %210, i32 0, i32 0
+  store ptr %209, ptr %211, align 8
+  %212 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %210, i32 0, i32 1
+  store ptr %207, ptr %212, align 8
+  %213 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %210, align 8
+  %214 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %5, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %140, ptr %214, align 8
+  %215 = alloca { ptr, ptr }, align 8
+  %216 = getelementptr inbounds { ptr, ptr }, ptr %215, i32 0, i32 0
+  store ptr @"main.two$bound", ptr %216, align 8
+  %217 = getelementptr inbounds { ptr, ptr }, ptr %215, i32 0, i32 1
+  store ptr %5, ptr %217, align 8
+  %218 = load { ptr, ptr }, ptr %215, align 8
+  %219 = extractvalue { ptr, ptr } %218, 1
+  %220 = extractvalue { ptr, ptr } %218, 0
+  %221 = call %"github.com/goplus/llgo/internal/runtime.String" %220(ptr %219)
+  %222 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %223 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %222, i32 0, i32 0
+  store ptr @0, ptr %223, align 8
+  %224 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %222, i32 0, i32 1
+  store i64 3, ptr %224, align 4
+  %225 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %222, align 8
+  %226 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %221, %"github.com/goplus/llgo/internal/runtime.String" %225)
+  %227 = xor i1 %226, true
+  br i1 %227, label %_llgo_15, label %_llgo_16
 
 _llgo_24:                                         ; preds = %_llgo_14
-  %259 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %260 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %259, i32 0, i32 0
-  store ptr @7, ptr %260, align 8
-  %261 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %259, i32 0, i32 1
-  store i64 21, ptr %261, align 4
-  %262 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %259, align 8
-  %263 = load ptr, ptr @_llgo_string, align 8
-  %264 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %262, ptr %264, align 8
-  %265 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %266 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %265, i32 0, i32 0
-  store ptr %263, ptr %266, align 8
-  %267 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %265, i32 0, i32 1
-  store ptr %264, ptr %267, align 8
-  %268 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %265, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %268)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %141, ptr %142)
   unreachable
 }
 
@@ -610,6 +601,8 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr, i64)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface")
 
+declare void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String")
+
 declare void @"github.com/goplus/llgo/internal/runtime.init"()
 
 define void @"main.init$after"() {
@@ -623,7 +616,7 @@ _llgo_0:
 _llgo_1:                                          ; preds = %_llgo_0
   %3 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 0
-  store ptr @1, ptr %4, align 8
+  store ptr @3, ptr %4, align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 1
   store i64 4, ptr %5, align 4
   %6 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %3, align 8
@@ -650,7 +643,7 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
   %18 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %15, align 8
   %19 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %20 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %19, i32 0, i32 0
-  store ptr @3, ptr %20, align 8
+  store ptr @4, ptr %20, align 8
   %21 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %19, i32 0, i32 1
   store i64 8, ptr %21, align 4
   %22 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %19, align 8
@@ -690,7 +683,7 @@ _llgo_5:                                          ; preds = %_llgo_4
   %40 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %37, i32 0, i32 2
   store i64 1, ptr %40, align 4
   %41 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %37, align 8
-  %42 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %34, %"github.com/goplus/llgo/internal/runtime.Slice" %41, i1 false)
+  %42 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %34, %"github.com/goplus/llgo/internal/runtime.Slice" %41, i1 false, ptr @"__llgo_call._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", ptr @"__llgo_makefunc._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %42)
   store ptr %42, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
   br label %_llgo_6
@@ -725,7 +718,7 @@ _llgo_6:                                          ; preds = %_llgo_5, %_llgo_4
   %59 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %56, align 8
   %60 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %61 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %60, i32 0, i32 0
-  store ptr @4, ptr %61, align 8
+  store ptr @5, ptr %61, align 8
   %62 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %60, i32 0, i32 1
   store i64 8, ptr %62, align 4
   %63 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %60, align 8
@@ -765,7 +758,7 @@ _llgo_9:                                          ; preds = %_llgo_8
   %81 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %78, i32 0, i32 2
   store i64 1, ptr %81, align 4
   %82 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %78, align 8
-  %83 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %75, %"github.com/goplus/llgo/internal/runtime.Slice" %82, i1 false)
+  %83 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %75, %"github.com/goplus/llgo/internal/runtime.Slice" %82, i1 false, ptr @"__llgo_call._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", ptr @"__llgo_makefunc._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %83)
   store ptr %83, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
   br label %_llgo_10
@@ -820,13 +813,13 @@ _llgo_10:                                         ; preds = %_llgo_9, %_llgo_8
   %112 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %108, align 8
   %113 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %114 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %113, i32 0, i32 0
-  store ptr @1, ptr %114, align 8
+  store ptr @3, ptr %114, align 8
   %115 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %113, i32 0, i32 1
   store i64 4, ptr %115, align 4
   %116 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %113, align 8
   %117 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %118 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %117, i32 0, i32 0
-  store ptr @5, ptr %118, align 8
+  store ptr @6, ptr %118, align 8
   %119 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %117, i32 0, i32 1
   store i64 4, ptr %119, align 4
   %120 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %117, align 8
@@ -835,7 +828,7 @@ _llgo_10:                                         ; preds = %_llgo_9, %_llgo_8
   %122 = load ptr, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
   %123 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %124 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %123, i32 0, i32 0
-  store ptr @3, ptr %124, align 8
+  store ptr @4, ptr %124, align 8
   %125 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %123, i32 0, i32 1
   store i64 8, ptr %125, align 4
   %126 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %123, align 8
@@ -847,7 +840,7 @@ _llgo_10:                                         ; preds = %_llgo_9, %_llgo_8
   %130 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %127, align 8
   %131 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %132 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %131, i32 0, i32 0
-  store ptr @4, ptr %132, align 8
+  store ptr @5, ptr %132, align 8
   %133 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %131, i32 0, i32 1
   store i64 8, ptr %133, align 4
   %134 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %131, align 8
@@ -872,7 +865,7 @@ _llgo_10:                                         ; preds = %_llgo_9, %_llgo_8
   %146 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %142, align 8
   %147 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %148 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %147, i32 0, i32 0
-  store ptr @1, ptr %148, align 8
+  store ptr @3, ptr %148, align 8
   %149 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %147, i32 0, i32 1
   store i64 4, ptr %149, align 4
   %150 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %147, align 8
@@ -884,64 +877,68 @@ _llgo_10:                                         ; preds = %_llgo_9, %_llgo_8
   %154 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %151, align 8
   %155 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %150, %"github.com/goplus/llgo/internal/runtime.String" %154, %"github.com/goplus/llgo/internal/runtime.Slice" %146)
   store ptr %155, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
-  %156 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %157 = load ptr, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
-  %158 = load ptr, ptr @_llgo_main.I, align 8
-  %159 = icmp eq ptr %158, null
-  br i1 %159, label %_llgo_11, label %_llgo_12
+  %156 = load ptr, ptr @"main.iface$zZ89tENb5h_KNjvpxf1TXPfaWFYn0IZrZwyVf42lRtA", align 8
+  %157 = load ptr, ptr @_llgo_main.impl, align 8
+  %158 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %156, ptr %157)
+  store ptr %158, ptr @"main.itab$9q2LfNx3I-8P6LB9k0w6NBtHjC4Rc_RDn3zabYQRu4c", align 8
+  %159 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %160 = load ptr, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
+  %161 = load ptr, ptr @_llgo_main.I, align 8
+  %162 = icmp eq ptr %161, null
+  br i1 %162, label %_llgo_11, label %_llgo_12
 
 _llgo_11:                                         ; preds = %_llgo_10
-  %160 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %161 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %160, i32 0, i32 0
-  store ptr @3, ptr %161, align 8
-  %162 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %160, i32 0, i32 1
-  store i64 8, ptr %162, align 4
-  %163 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %160, align 8
-  %164 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %165 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %164, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %163, ptr %165, align 8
-  %166 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %164, i32 0, i32 1
-  store ptr %156, ptr %166, align 8
-  %167 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %164, align 8
-  %168 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %169 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %168, i32 0, i32 0
-  store ptr @4, ptr %169, align 8
-  %170 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %168, i32 0, i32 1
-  store i64 8, ptr %170, align 4
-  %171 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %168, align 8
-  %172 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %173 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %172, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %171, ptr %173, align 8
-  %174 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %172, i32 0, i32 1
-  store ptr %157, ptr %174, align 8
-  %175 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %172, align 8
-  %176 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 48)
-  %177 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %176, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %167, ptr %177, align 8
-  %178 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %176, i64 1
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %175, ptr %178, align 8
-  %179 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %180 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %179, i32 0, i32 0
-  store ptr %176, ptr %180, align 8
-  %181 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %179, i32 0, i32 1
-  store i64 2, ptr %181, align 4
-  %182 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %179, i32 0, i32 2
-  store i64 2, ptr %182, align 4
-  %183 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %179, align 8
-  %184 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %185 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %184, i32 0, i32 0
-  store ptr @1, ptr %185, align 8
-  %186 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %184, i32 0, i32 1
-  store i64 4, ptr %186, align 4
-  %187 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %184, align 8
-  %188 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %189 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %188, i32 0, i32 0
-  store ptr @6, ptr %189, align 8
-  %190 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %188, i32 0, i32 1
-  store i64 6, ptr %190, align 4
-  %191 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %188, align 8
-  %192 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %187, %"github.com/goplus/llgo/internal/runtime.String" %191, %"github.com/goplus/llgo/internal/runtime.Slice" %183)
-  store ptr %192, ptr @_llgo_main.I, align 8
+  %163 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %164 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %163, i32 0, i32 0
+  store ptr @4, ptr %164, align 8
+  %165 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %163, i32 0, i32 1
+  store i64 8, ptr %165, align 4
+  %166 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %163, align 8
+  %167 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %168 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %167, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %166, ptr %168, align 8
+  %169 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %167, i32 0, i32 1
+  store ptr %159, ptr %169, align 8
+  %170 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %167, align 8
+  %171 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %172 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %171, i32 0, i32 0
+  store ptr @5, ptr %172, align 8
+  %173 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %171, i32 0, i32 1
+  store i64 8, ptr %173, align 4
+  %174 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %171, align 8
+  %175 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %176 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %175, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %174, ptr %176, align 8
+  %177 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %175, i32 0, i32 1
+  store ptr %160, ptr %177, align 8
+  %178 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %175, align 8
+  %179 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 48)
+  %180 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %179, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %170, ptr %180, align 8
+  %181 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %179, i64 1
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %178, ptr %181, align 8
+  %182 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %183 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, i32 0, i32 0
+  store ptr %179, ptr %183, align 8
+  %184 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, i32 0, i32 1
+  store i64 2, ptr %184, align 4
+  %185 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, i32 0, i32 2
+  store i64 2, ptr %185, align 4
+  %186 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, align 8
+  %187 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %188 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %187, i32 0, i32 0
+  store ptr @3, ptr %188, align 8
+  %189 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %187, i32 0, i32 1
+  store i64 4, ptr %189, align 4
+  %190 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %187, align 8
+  %191 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %192 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %191, i32 0, i32 0
+  store ptr @7, ptr %192, align 8
+  %193 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %191, i32 0, i32 1
+  store i64 6, ptr %193, align 4
+  %194 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %191, align 8
+  %195 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %190, %"github.com/goplus/llgo/internal/runtime.String" %194, %"github.com/goplus/llgo/internal/runtime.Slice" %186)
+  store ptr %195, ptr @_llgo_main.I, align 8
   br label %_llgo_12
 
 _llgo_12:                                         ; preds = %_llgo_11, %_llgo_10
@@ -960,10 +957,58 @@ declare void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr, %"github.
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64)
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1)
+declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1, ptr, ptr)
+
+define linkonce void @"__llgo_call._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call i64 %6(ptr %5)
+  %8 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  store i64 %7, ptr %8, align 4
+  ret void
+}
+
+define linkonce i64 @"__llgo_makefunc._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { i64 }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  %7 = load i64, ptr %6, align 4
+  ret i64 %7
+}
 
 declare void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr)
 
+define linkonce void @"__llgo_call._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call %"github.com/goplus/llgo/internal/runtime.String" %6(ptr %5)
+  %8 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %7, ptr %8, align 8
+  ret void
+}
+
+define linkonce %"github.com/goplus/llgo/internal/runtime.String" @"__llgo_makefunc._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.String" }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %3, i32 0, i32 0
+  %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  ret %"github.com/goplus/llgo/internal/runtime.String" %7
+}
+
 declare ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.Slice")
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr, ptr)
@@ -981,17 +1026,22 @@ _llgo_0:
   %3 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %2)
   %4 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %2, 0
   %5 = getelementptr ptr, ptr %4, i64 3
-  %6 = load ptr, ptr %5, align 8
+  %6 = load ptr, ptr %5, align 8, !nonnull !0
   %7 = alloca { ptr, ptr }, align 8
   %8 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 0
   store ptr %6, ptr %8, align 8
   %9 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 1
   store ptr %3, ptr %9, align 8
   %10 = load { ptr, ptr }, ptr %7, align 8
-  %11 = extractvalue { ptr, ptr } %10, 1
-  %12 = extractvalue { ptr, ptr } %10, 0
-  %13 = call i64 %12(ptr %11)
-  ret i64 %13
+  %11 = extractvalue { ptr, ptr } %10, 0
+  %12 = load ptr, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %13 = icmp eq ptr %12, @"main.one$bound"
+  %14 = select i1 %13, ptr %11, ptr %12
+  store ptr %14, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %15 = extractvalue { ptr, ptr } %10, 1
+  %16 = extractvalue { ptr, ptr } %10, 0
+  %17 = call i64 %16(ptr %15)
+  ret i64 %17
 }
 
 declare i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String")
@@ -1003,19 +1053,28 @@ _llgo_0:
   %3 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %2)
   %4 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %2, 0
   %5 = getelementptr ptr, ptr %4, i64 4
-  %6 = load ptr, ptr %5, align 8
+  %6 = load ptr, ptr %5, align 8, !nonnull !0
   %7 = alloca { ptr, ptr }, align 8
   %8 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 0
   store ptr %6, ptr %8, align 8
   %9 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 1
   store ptr %3, ptr %9, align 8
   %10 = load { ptr, ptr }, ptr %7, align 8
-  %11 = extractvalue { ptr, ptr } %10, 1
-  %12 = extractvalue { ptr, ptr } %10, 0
-  %13 = call %"github.com/goplus/llgo/internal/runtime.String" %12(ptr %11)
-  ret %"github.com/goplus/llgo/internal/runtime.String" %13
+  %11 = extractvalue { ptr, ptr } %10, 0
+  %12 = load ptr, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %13 = icmp eq ptr %12, @"main.two$bound"
+  %14 = select i1 %13, ptr %11, ptr %12
+  store ptr %14, ptr getelementptr inbounds (%"github.com/goplus/llgo/internal/runtime.G", ptr @__llgo_g, i32 0, i32 1), align 8
+  %15 = extractvalue { ptr, ptr } %10, 1
+  %16 = extractvalue { ptr, ptr } %10, 0
+  %17 = call %"github.com/goplus/llgo/internal/runtime.String" %16(ptr %15)
+  ret %"github.com/goplus/llgo/internal/runtime.String" %17
 }
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String")
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
+
+declare void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr, ptr)
+
+!0 = !{}
@@ -127,7 +127,7 @@ _llgo_1:                                          ; preds = %_llgo_5
   %19 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %48)
   %20 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %48, 0
   %21 = getelementptr ptr, ptr %20, i64 3
-  %22 = load ptr, ptr %21, align 8
+  %22 = load ptr, ptr %21, align 8, !nonnull !0
   %23 = alloca { ptr, ptr }, align 8
   %24 = getelementptr inbounds { ptr, ptr }, ptr %23, i32 0, i32 0
   store ptr %22, ptr %24, align 8
@@ -342,7 +342,7 @@ _llgo_7:                                          ; preds = %_llgo_5
   %47 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %44, i32 0, i32 2
   store i64 0, ptr %47, align 4
   %48 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %44, align 8
-  %49 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %42, %"github.com/goplus/llgo/internal/runtime.Slice" %48, i1 false)
+  %49 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %42, %"github.com/goplus/llgo/internal/runtime.Slice" %48, i1 false, ptr @"__llgo_call._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac", ptr @"__llgo_makefunc._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %49)
   store ptr %49, ptr @"_llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac", align 8
   br label %_llgo_8
@@ -669,7 +669,27 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", ptr, %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice")
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1)
+declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1, ptr, ptr)
+
+define linkonce void @"__llgo_call._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  call void %6(ptr %5)
+  ret void
+}
+
+define linkonce void @"__llgo_makefunc._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca {}, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  ret void
+}
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.Slice")
 
@@ -686,3 +706,5 @@ declare void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/
 declare void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface")
+
+!0 = !{}
@@ -14,60 +14,75 @@ source_filename = "main"
 
 @"main.init$guard" = global i1 false, align 1
 @0 = private unnamed_addr constant [6 x i8] c"invoke", align 1
-@1 = private unnamed_addr constant [7 x i8] c"invoke1", align 1
-@2 = private unnamed_addr constant [7 x i8] c"invoke2", align 1
-@3 = private unnamed_addr constant [7 x i8] c"invoke3", align 1
-@4 = private unnamed_addr constant [7 x i8] c"invoke4", align 1
-@5 = private unnamed_addr constant [7 x i8] c"invoke5", align 1
-@6 = private unnamed_addr constant [7 x i8] c"invoke6", align 1
+@1 = private unnamed_addr constant [6 x i8] c"main.T", align 1
+@2 = private unnamed_addr constant [6 x i8] c"Invoke", align 1
+@3 = private unnamed_addr constant [7 x i8] c"invoke1", align 1
+@4 = private unnamed_addr constant [7 x i8] c"main.T1", align 1
+@5 = private unnamed_addr constant [7 x i8] c"invoke2", align 1
+@6 = private unnamed_addr constant [7 x i8] c"main.T2", align 1
+@7 = private unnamed_addr constant [7 x i8] c"invoke3", align 1
+@8 = private unnamed_addr constant [7 x i8] c"invoke4", align 1
+@9 = private unnamed_addr constant [7 x i8] c"main.T4", align 1
+@10 = private unnamed_addr constant [7 x i8] c"invoke5", align 1
+@11 = private unnamed_addr constant [7 x i8] c"main.T5", align 1
+@12 = private unnamed_addr constant [7 x i8] c"invoke6", align 1
+@13 = private unnamed_addr constant [7 x i8] c"main.T6", align 1
 @__llgo_argc = global i32 0, align 4
 @__llgo_argv = global ptr null, align 8
-@7 = private unnamed_addr constant [5 x i8] c"hello", align 1
+@14 = private unnamed_addr constant [5 x i8] c"hello", align 1
 @_llgo_main.T = linkonce global ptr null, align 8
 @"main.struct$QTufDJA9wEDzuzgkA-ZSrLqW-B6lWN8O25mTSglAoLQ" = global ptr null, align 8
-@8 = private unnamed_addr constant [1 x i8] c"s", align 1
-@9 = private unnamed_addr constant [4 x i8] c"main", align 1
-@10 = private unnamed_addr constant [6 x i8] c"Invoke", align 1
+@15 = private unnamed_addr constant [1 x i8] c"s", align 1
+@16 = private unnamed_addr constant [4 x i8] c"main", align 1
 @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA" = linkonce global ptr null, align 8
 @_llgo_int = linkonce global ptr null, align 8
-@11 = private unnamed_addr constant [6 x i8] c"Method", align 1
+@17 = private unnamed_addr constant [6 x i8] c"Method", align 1
 @"_llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac" = linkonce global ptr null, align 8
-@12 = private unnamed_addr constant [1 x i8] c"T", align 1
+@18 = private unnamed_addr constant [1 x i8] c"T", align 1
+@"_llgo_itab$ZscwW-G1cw9_gH8Pji2E8Rk0ZSe6seLOBuT35jvK7gU" = linkonce_odr global ptr null, align 8
 @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0" = linkonce global ptr null, align 8
 @"*_llgo_main.T" = linkonce global ptr null, align 8
+@"_llgo_itab$ur3NH9SHt7KQ-UAi0XVoWErRenqOXlh7nm6cSXjKocI" = linkonce_odr global ptr null, align 8
 @_llgo_main.T1 = linkonce global ptr null, align 8
-@13 = private unnamed_addr constant [2 x i8] c"T1", align 1
+@19 = private unnamed_addr constant [2 x i8] c"T1", align 1
+@"_llgo_itab$3QVB4Paq_Qf_oLYiX6Z-MLgtIc3NDDXFNwINqjracR0" = linkonce_odr global ptr null, align 8
 @"*_llgo_main.T1" = linkonce global ptr null, align 8
+@"_llgo_itab$lNEgOxcn1W18N5DKwCczXSkPuA3vyfeTONGcdnerfYs" = linkonce_odr global ptr null, align 8
 @_llgo_main.T2 = linkonce global ptr null, align 8
 @_llgo_float64 = linkonce global ptr null, align 8
-@14 = private unnamed_addr constant [2 x i8] c"T2", align 1
+@20 = private unnamed_addr constant [2 x i8] c"T2", align 1
+@"_llgo_itab$G4iT4cXB8QlV-k2GJnBfQ-H9B-qxuITJxY8VHSL8Khg" = linkonce_odr global ptr null, align 8
 @"*_llgo_main.T2" = linkonce global ptr null, align 8
+@"_llgo_itab$UH5BeN8ZGABYXEYZPawShXNq1yw1nUgxq7xdVTP6c0s" = linkonce_odr global ptr null, align 8
 @_llgo_main.T3 = linkonce global ptr null, align 8
 @_llgo_int8 = linkonce global ptr null, align 8
-@15 = private unnamed_addr constant [2 x i8] c"T3", align 1
+@21 = private unnamed_addr constant [2 x i8] c"T3", align 1
 @"*_llgo_main.T3" = linkonce global ptr null, align 8
+@"_llgo_itab$X1zkTI9Tjieftw2ur1LThx6G12Zjs-YEvWcCJEtMEyw" = linkonce_odr global ptr null, align 8
 @_llgo_main.T4 = linkonce global ptr null, align 8
 @"[1]_llgo_int" = linkonce global ptr null, align 8
-@16 = private unnamed_addr constant [2 x i8] c"T4", align 1
+@22 = private unnamed_addr constant [2 x i8] c"T4", align 1
+@"_llgo_itab$7DnRwYEQYqocnibn_0rbqe6_fq1LX0WFk69pnMIjiWE" = linkonce_odr global ptr null, align 8
 @"*_llgo_main.T4" = linkonce global ptr null, align 8
+@"_llgo_itab$9HoMpLs43trpCnevjoF7_k3tmBK2kZ0CkbejVa3WXbU" = linkonce_odr global ptr null, align 8
 @_llgo_main.T5 = linkonce global ptr null, align 8
 @"main.struct$eovYmOhZg4X0zMSsuscSshndnbbAGvB2E3cyG8E7Y4U" = global ptr null, align 8
-@17 = private unnamed_addr constant [1 x i8] c"n", align 1
-@18 = private unnamed_addr constant [2 x i8] c"T5", align 1
+@23 = private unnamed_addr constant [1 x i8] c"n", align 1
+@24 = private unnamed_addr constant [2 x i8] c"T5", align 1
+@"_llgo_itab$Rnvku_R73IHCgPy8kwNzOPAR0TOc5S5qQFkAds6zkak" = linkonce_odr global ptr null, align 8
 @"*_llgo_main.T5" = linkonce global ptr null, align 8
+@"_llgo_itab$aXT9QLpZbpydMAVM7z8HP79SgepxD6o4rkzH9CN4-Q0" = linkonce_odr global ptr null, align 8
 @_llgo_main.T6 = linkonce global ptr null, align 8
 @"main.struct$2bSfJcCYDdttnIT-JASAjsTNUZvojBt4mPXFJdH4M10" = global ptr null, align 8
-@_llgo_Pointer = linkonce global ptr null, align 8
-@19 = private unnamed_addr constant [1 x i8] c"f", align 1
-@20 = private unnamed_addr constant [4 x i8] c"data", align 1
-@21 = private unnamed_addr constant [2 x i8] c"T6", align 1
+@25 = private unnamed_addr constant [2 x i8] c"T6", align 1
+@"_llgo_itab$eVlYlqRdZNPb4aUPP1khQH3_7wWK9f1ueZKN_YrFyeQ" = linkonce_odr global ptr null, align 8
 @"*_llgo_main.T6" = linkonce global ptr null, align 8
+@"_llgo_itab$7nHI-csud8YyvyE92kzHWn4_mnf6brffcjgpvYFf6Pg" = linkonce_odr global ptr null, align 8
+@"_llgo_itab$atYyK_vn8Z3BsDUVBOBJ1mJMV69mvnUi1jlbBDQ6AiM" = linkonce_odr global ptr null, align 8
 @"_llgo_iface$jwmSdgh1zvY_TDIgLzCkvkbiyrdwl9N806DH0JGcyMI" = linkonce global ptr null, align 8
-@22 = private unnamed_addr constant [5 x i8] c"world", align 1
+@26 = private unnamed_addr constant [5 x i8] c"world", align 1
 @_llgo_main.I = linkonce global ptr null, align 8
-@23 = private unnamed_addr constant [6 x i8] c"main.I", align 1
-@24 = private unnamed_addr constant [21 x i8] c"type assertion failed", align 1
-@_llgo_string = linkonce global ptr null, align 8
+@27 = private unnamed_addr constant [6 x i8] c"main.I", align 1
 @_llgo_any = linkonce global ptr null, align 8
 
 define i64 @main.T.Invoke(%main.T %0) {
@@ -92,9 +107,23 @@ _llgo_0:
 
 define i64 @"main.(*T).Invoke"(ptr %0) {
 _llgo_0:
-  %1 = load %main.T, ptr %0, align 8
-  %2 = call i64 @main.T.Invoke(%main.T %1)
-  ret i64 %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @1, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 6, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @2, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 6, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load %main.T, ptr %0, align 8
+  %11 = call i64 @main.T.Invoke(%main.T %10)
+  ret i64 %11
 }
 
 define void @"main.(*T).Method"(ptr %0) {
@@ -106,7 +135,7 @@ define i64 @main.T1.Invoke(i64 %0) {
 _llgo_0:
   %1 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %2 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %1, i32 0, i32 0
-  store ptr @1, ptr %2, align 8
+  store ptr @3, ptr %2, align 8
   %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %1, i32 0, i32 1
   store i64 7, ptr %3, align 4
   %4 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %1, align 8
@@ -119,16 +148,30 @@ _llgo_0:
 
 define i64 @"main.(*T1).Invoke"(ptr %0) {
 _llgo_0:
-  %1 = load i64, ptr %0, align 4
-  %2 = call i64 @main.T1.Invoke(i64 %1)
-  ret i64 %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @4, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 7, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @2, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 6, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load i64, ptr %0, align 4
+  %11 = call i64 @main.T1.Invoke(i64 %10)
+  ret i64 %11
 }
 
 define i64 @main.T2.Invoke(double %0) {
 _llgo_0:
   %1 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %2 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %1, i32 0, i32 0
-  store ptr @2, ptr %2, align 8
+  store ptr @5, ptr %2, align 8
   %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %1, i32 0, i32 1
   store i64 7, ptr %3, align 4
   %4 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %1, align 8
@@ -141,9 +184,23 @@ _llgo_0:
 
 define i64 @"main.(*T2).Invoke"(ptr %0) {
 _llgo_0:
-  %1 = load double, ptr %0, align 8
-  %2 = call i64 @main.T2.Invoke(double %1)
-  ret i64 %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @6, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 7, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @2, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 6, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load double, ptr %0, align 8
+  %11 = call i64 @main.T2.Invoke(double %10)
+  ret i64 %11
 }
 
 define i64 @"main.(*T3).Invoke"(ptr %0) {
@@ -151,7 +208,7 @@ _llgo_0:
   %1 = load i8, ptr %0, align 1
   %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
-  store ptr @3, ptr %3, align 8
+  store ptr @7, ptr %3, align 8
   %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
   store i64 7, ptr %4, align 4
   %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
@@ -172,7 +229,7 @@ _llgo_0:
   %4 = load i64, ptr %3, align 4
   %5 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 0
-  store ptr @4, ptr %6, align 8
+  store ptr @8, ptr %6, align 8
   %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 1
   store i64 7, ptr %7, align 4
   %8 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %5, align 8
@@ -185,9 +242,23 @@ _llgo_0:
 
 define i64 @"main.(*T4).Invoke"(ptr %0) {
 _llgo_0:
-  %1 = load [1 x i64], ptr %0, align 4
-  %2 = call i64 @main.T4.Invoke([1 x i64] %1)
-  ret i64 %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @9, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 7, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @2, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 6, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load [1 x i64], ptr %0, align 4
+  %11 = call i64 @main.T4.Invoke([1 x i64] %10)
+  ret i64 %11
 }
 
 define i64 @main.T5.Invoke(%main.T5 %0) {
@@ -199,7 +270,7 @@ _llgo_0:
   %4 = load i64, ptr %3, align 4
   %5 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 0
-  store ptr @5, ptr %6, align 8
+  store ptr @10, ptr %6, align 8
   %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 1
   store i64 7, ptr %7, align 4
   %8 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %5, align 8
@@ -212,9 +283,23 @@ _llgo_0:
 
 define i64 @"main.(*T5).Invoke"(ptr %0) {
 _llgo_0:
-  %1 = load %main.T5, ptr %0, align 4
-  %2 = call i64 @main.T5.Invoke(%main.T5 %1)
-  ret i64 %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @11, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 7, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @2, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 6, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load %main.T5, ptr %0, align 4
+  %11 = call i64 @main.T5.Invoke(%main.T5 %10)
+  ret i64 %11
 }
 
 define i64 @main.T6.Invoke(%main.T6 %0) {
@@ -224,7 +309,7 @@ _llgo_0:
   %3 = call i64 %2(ptr %1)
   %4 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 0
-  store ptr @6, ptr %5, align 8
+  store ptr @12, ptr %5, align 8
   %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 1
   store i64 7, ptr %6, align 4
   %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %4, align 8
@@ -237,9 +322,23 @@ _llgo_0:
 
 define i64 @"main.(*T6).Invoke"(ptr %0) {
 _llgo_0:
-  %1 = load %main.T6, ptr %0, align 8
-  %2 = call i64 @main.T6.Invoke(%main.T6 %1)
-  ret i64 %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @13, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 7, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @2, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 6, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load %main.T6, ptr %0, align 8
+  %11 = call i64 @main.T6.Invoke(%main.T6 %10)
+  ret i64 %11
 }
 
 define void @main.init() {
@@ -261,7 +360,7 @@ _llgo_0:
   %1 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %0)
   %2 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %0, 0
   %3 = getelementptr ptr, ptr %2, i64 3
-  %4 = load ptr, ptr %3, align 8
+  %4 = load ptr, ptr %3, align 8, !nonnull !0
   %5 = alloca { ptr, ptr }, align 8
   %6 = getelementptr inbounds { ptr, ptr }, ptr %5, i32 0, i32 0
   store ptr %4, ptr %6, align 8
@@ -286,7 +385,7 @@ _llgo_0:
   %3 = getelementptr inbounds %main.T, ptr %2, i32 0, i32 0
   %4 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 0
-  store ptr @7, ptr %5, align 8
+  store ptr @14, ptr %5, align 8
   %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 1
   store i64 5, ptr %6, align 4
   %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %4, align 8
@@ -315,304 +414,245 @@ _llgo_0:
   %21 = load ptr, ptr @_llgo_main.T, align 8
   %22 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
   store %main.T %20, ptr %22, align 8
-  %23 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %24 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %23, ptr %21)
-  %25 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %26 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %25, i32 0, i32 0
-  store ptr %24, ptr %26, align 8
-  %27 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %25, i32 0, i32 1
-  store ptr %22, ptr %27, align 8
-  %28 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %25, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %28)
-  %29 = load ptr, ptr @"*_llgo_main.T", align 8
-  %30 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %31 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %30, ptr %29)
-  %32 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %33 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %32, i32 0, i32 0
-  store ptr %31, ptr %33, align 8
-  %34 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %32, i32 0, i32 1
-  store ptr %2, ptr %34, align 8
-  %35 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %32, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %35)
-  %36 = load i64, ptr %8, align 4
-  %37 = load ptr, ptr @_llgo_main.T1, align 8
-  %38 = inttoptr i64 %36 to ptr
-  %39 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %40 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %39, ptr %37)
-  %41 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %42 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %41, i32 0, i32 0
-  store ptr %40, ptr %42, align 8
-  %43 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %41, i32 0, i32 1
-  store ptr %38, ptr %43, align 8
-  %44 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %41, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %44)
-  %45 = load ptr, ptr @"*_llgo_main.T1", align 8
-  %46 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %47 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %46, ptr %45)
-  %48 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %49 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %48, i32 0, i32 0
-  store ptr %47, ptr %49, align 8
-  %50 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %48, i32 0, i32 1
-  store ptr %8, ptr %50, align 8
-  %51 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %48, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %51)
-  %52 = load double, ptr %9, align 8
-  %53 = load ptr, ptr @_llgo_main.T2, align 8
-  %54 = bitcast double %52 to i64
-  %55 = inttoptr i64 %54 to ptr
-  %56 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %57 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %56, ptr %53)
-  %58 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %59 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %58, i32 0, i32 0
-  store ptr %57, ptr %59, align 8
-  %60 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %58, i32 0, i32 1
-  store ptr %55, ptr %60, align 8
-  %61 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %58, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %61)
-  %62 = load ptr, ptr @"*_llgo_main.T2", align 8
-  %63 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %64 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %63, ptr %62)
+  %23 = load ptr, ptr @"_llgo_itab$ZscwW-G1cw9_gH8Pji2E8Rk0ZSe6seLOBuT35jvK7gU", align 8
+  %24 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %25 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %24, i32 0, i32 0
+  store ptr %23, ptr %25, align 8
+  %26 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %24, i32 0, i32 1
+  store ptr %22, ptr %26, align 8
+  %27 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %24, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %27)
+  %28 = load ptr, ptr @"*_llgo_main.T", align 8
+  %29 = load ptr, ptr @"_llgo_itab$ur3NH9SHt7KQ-UAi0XVoWErRenqOXlh7nm6cSXjKocI", align 8
+  %30 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %31 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %30, i32 0, i32 0
+  store ptr %29, ptr %31, align 8
+  %32 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %30, i32 0, i32 1
+  store ptr %2, ptr %32, align 8
+  %33 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %30, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %33)
+  %34 = load i64, ptr %8, align 4
+  %35 = load ptr, ptr @_llgo_main.T1, align 8
+  %36 = inttoptr i64 %34 to ptr
+  %37 = load ptr, ptr @"_llgo_itab$3QVB4Paq_Qf_oLYiX6Z-MLgtIc3NDDXFNwINqjracR0", align 8
+  %38 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %39 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %38, i32 0, i32 0
+  store ptr %37, ptr %39, align 8
+  %40 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %38, i32 0, i32 1
+  store ptr %36, ptr %40, align 8
+  %41 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %38, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %41)
+  %42 = load ptr, ptr @"*_llgo_main.T1", align 8
+  %43 = load ptr, ptr @"_llgo_itab$lNEgOxcn1W18N5DKwCczXSkPuA3vyfeTONGcdnerfYs", align 8
+  %44 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %45 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %44, i32 0, i32 0
+  store ptr %43, ptr %45, align 8
+  %46 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %44, i32 0, i32 1
+  store ptr %8, ptr %46, align 8
+  %47 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %44, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %47)
+  %48 = load double, ptr %9, align 8
+  %49 = load ptr, ptr @_llgo_main.T2, align 8
+  %50 = bitcast double %48 to i64
+  %51 = inttoptr i64 %50 to ptr
+  %52 = load ptr, ptr @"_llgo_itab$G4iT4cXB8QlV-k2GJnBfQ-H9B-qxuITJxY8VHSL8Khg", align 8
+  %53 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %54 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %53, i32 0, i32 0
+  store ptr %52, ptr %54, align 8
+  %55 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %53, i32 0, i32 1
+  store ptr %51, ptr %55, align 8
+  %56 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %53, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %56)
+  %57 = load ptr, ptr @"*_llgo_main.T2", align 8
+  %58 = load ptr, ptr @"_llgo_itab$UH5BeN8ZGABYXEYZPawShXNq1yw1nUgxq7xdVTP6c0s", align 8
+  %59 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %60 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %59, i32 0, i32 0
+  store ptr %58, ptr %60, align 8
+  %61 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %59, i32 0, i32 1
+  store ptr %9, ptr %61, align 8
+  %62 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %59, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %62)
+  %63 = load ptr, ptr @"*_llgo_main.T3", align 8
+  %64 = load ptr, ptr @"_llgo_itab$X1zkTI9Tjieftw2ur1LThx6G12Zjs-YEvWcCJEtMEyw", align 8
   %65 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
   %66 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %65, i32 0, i32 0
   store ptr %64, ptr %66, align 8
   %67 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %65, i32 0, i32 1
-  store ptr %9, ptr %67, align 8
+  store ptr %10, ptr %67, align 8
   %68 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %65, align 8
   call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %68)
-  %69 = load ptr, ptr @"*_llgo_main.T3", align 8
-  %70 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %71 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %70, ptr %69)
-  %72 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %73 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %72, i32 0, i32 0
-  store ptr %71, ptr %73, align 8
-  %74 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %72, i32 0, i32 1
-  store ptr %10, ptr %74, align 8
-  %75 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %72, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %75)
-  %76 = load [1 x i64], ptr %11, align 4
-  %77 = load ptr, ptr @_llgo_main.T4, align 8
-  %78 = extractvalue [1 x i64] %76, 0
-  %79 = inttoptr i64 %78 to ptr
-  %80 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %81 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %80, ptr %77)
-  %82 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %83 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %82, i32 0, i32 0
-  store ptr %81, ptr %83, align 8
-  %84 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %82, i32 0, i32 1
-  store ptr %79, ptr %84, align 8
-  %85 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %82, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %85)
-  %86 = load ptr, ptr @"*_llgo_main.T4", align 8
-  %87 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %88 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %87, ptr %86)
+  %69 = load [1 x i64], ptr %11, align 4
+  %70 = load ptr, ptr @_llgo_main.T4, align 8
+  %71 = extractvalue [1 x i64] %69, 0
+  %72 = inttoptr i64 %71 to ptr
+  %73 = load ptr, ptr @"_llgo_itab$7DnRwYEQYqocnibn_0rbqe6_fq1LX0WFk69pnMIjiWE", align 8
+  %74 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %75 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %74, i32 0, i32 0
+  store ptr %73, ptr %75, align 8
+  %76 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %74, i32 0, i32 1
+  store ptr %72, ptr %76, align 8
+  %77 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %74, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %77)
+  %78 = load ptr, ptr @"*_llgo_main.T4", align 8
+  %79 = load ptr, ptr @"_llgo_itab$9HoMpLs43trpCnevjoF7_k3tmBK2kZ0CkbejVa3WXbU", align 8
+  %80 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %81 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %80, i32 0, i32 0
+  store ptr %79, ptr %81, align 8
+  %82 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %80, i32 0, i32 1
+  store ptr %11, ptr %82, align 8
+  %83 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %80, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %83)
+  %84 = load %main.T5, ptr %13, align 4
+  %85 = load ptr, ptr @_llgo_main.T5, align 8
+  %86 = extractvalue %main.T5 %84, 0
+  %87 = inttoptr i64 %86 to ptr
+  %88 = load ptr, ptr @"_llgo_itab$Rnvku_R73IHCgPy8kwNzOPAR0TOc5S5qQFkAds6zkak", align 8
   %89 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
   %90 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %89, i32 0, i32 0
   store ptr %88, ptr %90, align 8
   %91 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %89, i32 0, i32 1
-  store ptr %11, ptr %91, align 8
+  store ptr %87, ptr %91, align 8
   %92 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %89, align 8
   call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %92)
-  %93 = load %main.T5, ptr %13, align 4
-  %94 = load ptr, ptr @_llgo_main.T5, align 8
-  %95 = extractvalue %main.T5 %93, 0
-  %96 = inttoptr i64 %95 to ptr
-  %97 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %98 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %97, ptr %94)
-  %99 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %100 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %99, i32 0, i32 0
-  store ptr %98, ptr %100, align 8
-  %101 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %99, i32 0, i32 1
-  store ptr %96, ptr %101, align 8
-  %102 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %99, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %102)
-  %103 = load ptr, ptr @"*_llgo_main.T5", align 8
-  %104 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %105 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %104, ptr %103)
-  %106 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %107 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %106, i32 0, i32 0
-  store ptr %105, ptr %107, align 8
-  %108 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %106, i32 0, i32 1
-  store ptr %13, ptr %108, align 8
-  %109 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %106, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %109)
-  %110 = load %main.T6, ptr %15, align 8
-  %111 = load ptr, ptr @_llgo_main.T6, align 8
-  %112 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %main.T6 %110, ptr %112, align 8
-  %113 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %114 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %113, ptr %111)
-  %115 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %116 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %115, i32 0, i32 0
-  store ptr %114, ptr %116, align 8
-  %117 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %115, i32 0, i32 1
-  store ptr %112, ptr %117, align 8
-  %118 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %115, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %118)
-  %119 = load ptr, ptr @"*_llgo_main.T6", align 8
-  %120 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %121 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %120, ptr %119)
+  %93 = load ptr, ptr @"*_llgo_main.T5", align 8
+  %94 = load ptr, ptr @"_llgo_itab$aXT9QLpZbpydMAVM7z8HP79SgepxD6o4rkzH9CN4-Q0", align 8
+  %95 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %96 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %95, i32 0, i32 0
+  store ptr %94, ptr %96, align 8
+  %97 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %95, i32 0, i32 1
+  store ptr %13, ptr %97, align 8
+  %98 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %95, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %98)
+  %99 = load %main.T6, ptr %15, align 8
+  %100 = load ptr, ptr @_llgo_main.T6, align 8
+  %101 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %main.T6 %99, ptr %101, align 8
+  %102 = load ptr, ptr @"_llgo_itab$eVlYlqRdZNPb4aUPP1khQH3_7wWK9f1ueZKN_YrFyeQ", align 8
+  %103 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %104 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %103, i32 0, i32 0
+  store ptr %102, ptr %104, align 8
+  %105 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %103, i32 0, i32 1
+  store ptr %101, ptr %105, align 8
+  %106 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %103, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %106)
+  %107 = load ptr, ptr @"*_llgo_main.T6", align 8
+  %108 = load ptr, ptr @"_llgo_itab$7nHI-csud8YyvyE92kzHWn4_mnf6brffcjgpvYFf6Pg", align 8
+  %109 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %110 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %109, i32 0, i32 0
+  store ptr %108, ptr %110, align 8
+  %111 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %109, i32 0, i32 1
+  store ptr %15, ptr %111, align 8
+  %112 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %109, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %112)
+  %113 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" zeroinitializer)
+  %114 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %115 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %114, ptr %113)
+  %116 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %117 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %116, i32 0, i32 0
+  store ptr %115, ptr %117, align 8
+  %118 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %116, i32 0, i32 1
+  store ptr null, ptr %118, align 8
+  %119 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %116, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface" %119)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface" zeroinitializer)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
+  %120 = load ptr, ptr @"*_llgo_main.T", align 8
+  %121 = load ptr, ptr @"_llgo_itab$atYyK_vn8Z3BsDUVBOBJ1mJMV69mvnUi1jlbBDQ6AiM", align 8
   %122 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
   %123 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %122, i32 0, i32 0
   store ptr %121, ptr %123, align 8
   %124 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %122, i32 0, i32 1
-  store ptr %15, ptr %124, align 8
+  store ptr %2, ptr %124, align 8
   %125 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %122, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %125)
-  %126 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" zeroinitializer)
-  %127 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %128 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %127, ptr %126)
-  %129 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %130 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %129, i32 0, i32 0
-  store ptr %128, ptr %130, align 8
-  %131 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %129, i32 0, i32 1
-  store ptr null, ptr %131, align 8
-  %132 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %129, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface" %132)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface" zeroinitializer)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %133 = load ptr, ptr @"*_llgo_main.T", align 8
-  %134 = load ptr, ptr @"_llgo_iface$jwmSdgh1zvY_TDIgLzCkvkbiyrdwl9N806DH0JGcyMI", align 8
-  %135 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %134, ptr %133)
-  %136 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %137 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %136, i32 0, i32 0
-  store ptr %135, ptr %137, align 8
-  %138 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %136, i32 0, i32 1
-  store ptr %2, ptr %138, align 8
-  %139 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %136, align 8
-  %140 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %139)
-  %141 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %139, 1
-  %142 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %143 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %142, ptr %140)
-  %144 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %145 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %144, i32 0, i32 0
-  store ptr %143, ptr %145, align 8
-  %146 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %144, i32 0, i32 1
-  store ptr %141, ptr %146, align 8
-  %147 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %144, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %147)
-  %148 = alloca %main.T, align 8
-  %149 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %148, i64 16)
-  %150 = getelementptr inbounds %main.T, ptr %149, i32 0, i32 0
-  %151 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %152 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %151, i32 0, i32 0
-  store ptr @22, ptr %152, align 8
-  %153 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %151, i32 0, i32 1
-  store i64 5, ptr %153, align 4
-  %154 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %151, align 8
-  store %"github.com/goplus/llgo/internal/runtime.String" %154, ptr %150, align 8
-  %155 = load %main.T, ptr %149, align 8
-  %156 = load ptr, ptr @_llgo_main.T, align 8
-  %157 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %main.T %155, ptr %157, align 8
-  %158 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %159 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %158, i32 0, i32 0
-  store ptr %156, ptr %159, align 8
-  %160 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %158, i32 0, i32 1
-  store ptr %157, ptr %160, align 8
-  %161 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %158, align 8
-  %162 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %161, 0
-  %163 = load ptr, ptr @_llgo_main.I, align 8
-  %164 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %163, ptr %162)
-  br i1 %164, label %_llgo_1, label %_llgo_2
+  %126 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %125)
+  %127 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %125, 1
+  %128 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %129 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %128, ptr %126)
+  %130 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %131 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %130, i32 0, i32 0
+  store ptr %129, ptr %131, align 8
+  %132 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %130, i32 0, i32 1
+  store ptr %127, ptr %132, align 8
+  %133 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %130, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %133)
+  %134 = alloca %main.T, align 8
+  %135 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %134, i64 16)
+  %136 = getelementptr inbounds %main.T, ptr %135, i32 0, i32 0
+  %137 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %138 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %137, i32 0, i32 0
+  store ptr @26, ptr %138, align 8
+  %139 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %137, i32 0, i32 1
+  store i64 5, ptr %139, align 4
+  %140 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %137, align 8
+  store %"github.com/goplus/llgo/internal/runtime.String" %140, ptr %136, align 8
+  %141 = load %main.T, ptr %135, align 8
+  %142 = load ptr, ptr @_llgo_main.T, align 8
+  %143 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %main.T %141, ptr %143, align 8
+  %144 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %145 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %144, i32 0, i32 0
+  store ptr %142, ptr %145, align 8
+  %146 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %144, i32 0, i32 1
+  store ptr %143, ptr %146, align 8
+  %147 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %144, align 8
+  %148 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %147, 0
+  %149 = load ptr, ptr @_llgo_main.I, align 8
+  %150 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %149, ptr %148)
+  br i1 %150, label %_llgo_1, label %_llgo_2
 
 _llgo_1:                                          ; preds = %_llgo_0
-  %165 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %161, 1
-  %166 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %167 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %166, ptr %162)
-  %168 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %169 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %168, i32 0, i32 0
-  store ptr %167, ptr %169, align 8
-  %170 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %168, i32 0, i32 1
-  store ptr %165, ptr %170, align 8
-  %171 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %168, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %171)
-  %172 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %161, 0
-  %173 = load ptr, ptr @_llgo_any, align 8
-  %174 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %173, ptr %172)
-  br i1 %174, label %_llgo_3, label %_llgo_4
+  %151 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %147, 1
+  %152 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %153 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %152, ptr %148)
+  %154 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %155 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %154, i32 0, i32 0
+  store ptr %153, ptr %155, align 8
+  %156 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %154, i32 0, i32 1
+  store ptr %151, ptr %156, align 8
+  %157 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %154, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %157)
+  %158 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %147, 0
+  %159 = load ptr, ptr @_llgo_any, align 8
+  %160 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %159, ptr %158)
+  br i1 %160, label %_llgo_3, label %_llgo_4
 
 _llgo_2:                                          ; preds = %_llgo_0
-  %175 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %176 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %175, i32 0, i32 0
-  store ptr @24, ptr %176, align 8
-  %177 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %175, i32 0, i32 1
-  store i64 21, ptr %177, align 4
-  %178 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %175, align 8
-  %179 = load ptr, ptr @_llgo_string, align 8
-  %180 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %178, ptr %180, align 8
-  %181 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %182 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %181, i32 0, i32 0
-  store ptr %179, ptr %182, align 8
-  %183 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %181, i32 0, i32 1
-  store ptr %180, ptr %183, align 8
-  %184 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %181, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %184)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %148, ptr %149)
   unreachable
 
 _llgo_3:                                          ; preds = %_llgo_1
-  %185 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %161, 1
-  %186 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %187 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %186, i32 0, i32 0
-  store ptr %172, ptr %187, align 8
-  %188 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %186, i32 0, i32 1
-  store ptr %185, ptr %188, align 8
-  %189 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %186, align 8
-  %190 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %189, 0
-  %191 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %192 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %191, ptr %190)
-  br i1 %192, label %_llgo_5, label %_llgo_6
+  %161 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %147, 1
+  %162 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %163 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %162, i32 0, i32 0
+  store ptr %158, ptr %163, align 8
+  %164 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %162, i32 0, i32 1
+  store ptr %161, ptr %164, align 8
+  %165 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %162, align 8
+  %166 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %165, 0
+  %167 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %168 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %167, ptr %166)
+  br i1 %168, label %_llgo_5, label %_llgo_6
 
 _llgo_4:                                          ; preds = %_llgo_1
-  %193 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %194 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %193, i32 0, i32 0
-  store ptr @24, ptr %194, align 8
-  %195 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %193, i32 0, i32 1
-  store i64 21, ptr %195, align 4
-  %196 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %193, align 8
-  %197 = load ptr, ptr @_llgo_string, align 8
-  %198 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %196, ptr %198, align 8
-  %199 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %200 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %199, i32 0, i32 0
-  store ptr %197, ptr %200, align 8
-  %201 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %199, i32 0, i32 1
-  store ptr %198, ptr %201, align 8
-  %202 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %199, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %202)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %158, ptr %159)
   unreachable
 
 _llgo_5:                                          ; preds = %_llgo_3
-  %203 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %189, 1
-  %204 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
-  %205 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %204, ptr %190)
-  %206 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %207 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %206, i32 0, i32 0
-  store ptr %205, ptr %207, align 8
-  %208 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %206, i32 0, i32 1
-  store ptr %203, ptr %208, align 8
-  %209 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %206, align 8
-  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %209)
+  %169 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %165, 1
+  %170 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %171 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %170, ptr %166)
+  %172 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %173 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %172, i32 0, i32 0
+  store ptr %171, ptr %173, align 8
+  %174 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %172, i32 0, i32 1
+  store ptr %169, ptr %174, align 8
+  %175 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %172, align 8
+  call void @main.invoke(%"github.com/goplus/llgo/internal/runtime.iface" %175)
   ret i32 0
 
 _llgo_6:                                          ; preds = %_llgo_3
-  %210 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %211 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %210, i32 0, i32 0
-  store ptr @24, ptr %211, align 8
-  %212 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %210, i32 0, i32 1
-  store i64 21, ptr %212, align 4
-  %213 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %210, align 8
-  %214 = load ptr, ptr @_llgo_string, align 8
-  %215 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %213, ptr %215, align 8
-  %216 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %217 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %216, i32 0, i32 0
-  store ptr %214, ptr %217, align 8
-  %218 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %216, i32 0, i32 1
-  store ptr %215, ptr %218, align 8
-  %219 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %216, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %219)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %166, ptr %167)
   unreachable
 }
 
@@ -627,6 +667,8 @@ declare void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
 
+declare void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String")
+
 declare void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double)
@@ -657,7 +699,7 @@ _llgo_1:                                          ; preds = %_llgo_0
 _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
   %3 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 0
-  store ptr @8, ptr %4, align 8
+  store ptr @15, ptr %4, align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 1
   store i64 1, ptr %5, align 4
   %6 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %3, align 8
@@ -671,7 +713,7 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
   %12 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %6, ptr %11, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %10, i1 false)
   %13 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %14 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %13, i32 0, i32 0
-  store ptr @9, ptr %14, align 8
+  store ptr @16, ptr %14, align 8
   %15 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %13, i32 0, i32 1
   store i64 4, ptr %15, align 4
   %16 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %13, align 8
@@ -694,7 +736,7 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
 _llgo_3:                                          ; preds = %_llgo_2
   %26 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %27 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %26, i32 0, i32 0
-  store ptr @10, ptr %27, align 8
+  store ptr @2, ptr %27, align 8
   %28 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %26, i32 0, i32 1
   store i64 6, ptr %28, align 4
   %29 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %26, align 8
@@ -740,7 +782,7 @@ _llgo_7:                                          ; preds = %_llgo_6
   %50 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %47, i32 0, i32 2
   store i64 1, ptr %50, align 4
   %51 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %47, align 8
-  %52 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %44, %"github.com/goplus/llgo/internal/runtime.Slice" %51, i1 false)
+  %52 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %44, %"github.com/goplus/llgo/internal/runtime.Slice" %51, i1 false, ptr @"__llgo_call._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", ptr @"__llgo_makefunc._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %52)
   store ptr %52, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
   br label %_llgo_8
@@ -769,7 +811,7 @@ _llgo_8:                                          ; preds = %_llgo_7, %_llgo_6
   %65 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %60, align 8
   %66 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %67 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %66, i32 0, i32 0
-  store ptr @11, ptr %67, align 8
+  store ptr @17, ptr %67, align 8
   %68 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %66, i32 0, i32 1
   store i64 6, ptr %68, align 4
   %69 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %66, align 8
@@ -796,7 +838,7 @@ _llgo_9:                                          ; preds = %_llgo_8
   %82 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %79, i32 0, i32 2
   store i64 0, ptr %82, align 4
   %83 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %79, align 8
-  %84 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %77, %"github.com/goplus/llgo/internal/runtime.Slice" %83, i1 false)
+  %84 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %77, %"github.com/goplus/llgo/internal/runtime.Slice" %83, i1 false, ptr @"__llgo_call._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac", ptr @"__llgo_makefunc._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %84)
   store ptr %84, ptr @"_llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac", align 8
   br label %_llgo_10
@@ -839,13 +881,13 @@ _llgo_10:                                         ; preds = %_llgo_9, %_llgo_8
   %106 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %102, align 8
   %107 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %108 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %107, i32 0, i32 0
-  store ptr @9, ptr %108, align 8
+  store ptr @16, ptr %108, align 8
   %109 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %107, i32 0, i32 1
   store i64 4, ptr %109, align 4
   %110 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %107, align 8
   %111 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %112 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %111, i32 0, i32 0
-  store ptr @12, ptr %112, align 8
+  store ptr @18, ptr %112, align 8
   %113 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %111, i32 0, i32 1
   store i64 1, ptr %113, align 4
   %114 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %111, align 8
@@ -855,7 +897,7 @@ _llgo_10:                                         ; preds = %_llgo_9, %_llgo_8
 _llgo_11:                                         ; preds = %_llgo_4
   %115 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %116 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %115, i32 0, i32 0
-  store ptr @10, ptr %116, align 8
+  store ptr @2, ptr %116, align 8
   %117 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %115, i32 0, i32 1
   store i64 6, ptr %117, align 4
   %118 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %115, align 8
@@ -878,7 +920,7 @@ _llgo_11:                                         ; preds = %_llgo_4
   %129 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %125, align 8
   %130 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %131 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %130, i32 0, i32 0
-  store ptr @9, ptr %131, align 8
+  store ptr @16, ptr %131, align 8
   %132 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %130, i32 0, i32 1
   store i64 4, ptr %132, align 4
   %133 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %130, align 8
@@ -893,833 +935,931 @@ _llgo_11:                                         ; preds = %_llgo_4
   br label %_llgo_12
 
 _llgo_12:                                         ; preds = %_llgo_11, %_llgo_4
-  %139 = load ptr, ptr @"*_llgo_main.T", align 8
-  %140 = icmp eq ptr %139, null
-  br i1 %140, label %_llgo_13, label %_llgo_14
+  %139 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %140 = load ptr, ptr @_llgo_main.T, align 8
+  %141 = load ptr, ptr @"_llgo_itab$ZscwW-G1cw9_gH8Pji2E8Rk0ZSe6seLOBuT35jvK7gU", align 8
+  %142 = icmp eq ptr %141, null
+  br i1 %142, label %_llgo_13, label %_llgo_14
 
 _llgo_13:                                         ; preds = %_llgo_12
-  %141 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %2)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %141)
-  store ptr %141, ptr @"*_llgo_main.T", align 8
+  %143 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %139, ptr %140)
+  store ptr %143, ptr @"_llgo_itab$ZscwW-G1cw9_gH8Pji2E8Rk0ZSe6seLOBuT35jvK7gU", align 8
   br label %_llgo_14
 
 _llgo_14:                                         ; preds = %_llgo_13, %_llgo_12
-  %142 = load ptr, ptr @_llgo_main.T1, align 8
-  %143 = icmp eq ptr %142, null
-  br i1 %143, label %_llgo_15, label %_llgo_16
+  %144 = load ptr, ptr @"*_llgo_main.T", align 8
+  %145 = icmp eq ptr %144, null
+  br i1 %145, label %_llgo_15, label %_llgo_16
 
 _llgo_15:                                         ; preds = %_llgo_14
-  %144 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 2, i64 1, i64 1)
-  store ptr %144, ptr @_llgo_main.T1, align 8
+  %146 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %2)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %146)
+  store ptr %146, ptr @"*_llgo_main.T", align 8
   br label %_llgo_16
 
 _llgo_16:                                         ; preds = %_llgo_15, %_llgo_14
-  %145 = load ptr, ptr @_llgo_int, align 8
-  br i1 %143, label %_llgo_17, label %_llgo_18
+  %147 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %148 = load ptr, ptr @"*_llgo_main.T", align 8
+  %149 = load ptr, ptr @"_llgo_itab$ur3NH9SHt7KQ-UAi0XVoWErRenqOXlh7nm6cSXjKocI", align 8
+  %150 = icmp eq ptr %149, null
+  br i1 %150, label %_llgo_17, label %_llgo_18
 
 _llgo_17:                                         ; preds = %_llgo_16
-  %146 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %147 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %146, i32 0, i32 0
-  store ptr @10, ptr %147, align 8
-  %148 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %146, i32 0, i32 1
-  store i64 6, ptr %148, align 4
-  %149 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %146, align 8
-  %150 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %151 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %152 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %151, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %149, ptr %152, align 8
-  %153 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %151, i32 0, i32 1
-  store ptr %150, ptr %153, align 8
-  %154 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %151, i32 0, i32 2
-  store ptr @"main.(*T1).Invoke", ptr %154, align 8
-  %155 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %151, i32 0, i32 3
-  store ptr @"main.(*T1).Invoke", ptr %155, align 8
-  %156 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %151, align 8
-  %157 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %158 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %157, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %149, ptr %158, align 8
-  %159 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %157, i32 0, i32 1
-  store ptr %150, ptr %159, align 8
-  %160 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %157, i32 0, i32 2
-  store ptr @"main.(*T1).Invoke", ptr %160, align 8
-  %161 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %157, i32 0, i32 3
-  store ptr @main.T1.Invoke, ptr %161, align 8
-  %162 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %157, align 8
-  %163 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %164 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %163, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %162, ptr %164, align 8
-  %165 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %166 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %165, i32 0, i32 0
-  store ptr %163, ptr %166, align 8
-  %167 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %165, i32 0, i32 1
-  store i64 1, ptr %167, align 4
-  %168 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %165, i32 0, i32 2
-  store i64 1, ptr %168, align 4
-  %169 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %165, align 8
-  %170 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %171 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %170, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %156, ptr %171, align 8
-  %172 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %173 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %172, i32 0, i32 0
-  store ptr %170, ptr %173, align 8
-  %174 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %172, i32 0, i32 1
-  store i64 1, ptr %174, align 4
-  %175 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %172, i32 0, i32 2
-  store i64 1, ptr %175, align 4
-  %176 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %172, align 8
-  %177 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %178 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %177, i32 0, i32 0
-  store ptr @9, ptr %178, align 8
-  %179 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %177, i32 0, i32 1
-  store i64 4, ptr %179, align 4
-  %180 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %177, align 8
-  %181 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %182 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %181, i32 0, i32 0
-  store ptr @13, ptr %182, align 8
-  %183 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %181, i32 0, i32 1
-  store i64 2, ptr %183, align 4
-  %184 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %181, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %144, %"github.com/goplus/llgo/internal/runtime.String" %180, %"github.com/goplus/llgo/internal/runtime.String" %184, ptr %145, %"github.com/goplus/llgo/internal/runtime.Slice" %169, %"github.com/goplus/llgo/internal/runtime.Slice" %176)
+  %151 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %147, ptr %148)
+  store ptr %151, ptr @"_llgo_itab$ur3NH9SHt7KQ-UAi0XVoWErRenqOXlh7nm6cSXjKocI", align 8
   br label %_llgo_18
 
 _llgo_18:                                         ; preds = %_llgo_17, %_llgo_16
-  %185 = load ptr, ptr @"*_llgo_main.T1", align 8
-  %186 = icmp eq ptr %185, null
-  br i1 %186, label %_llgo_19, label %_llgo_20
+  %152 = load ptr, ptr @_llgo_main.T1, align 8
+  %153 = icmp eq ptr %152, null
+  br i1 %153, label %_llgo_19, label %_llgo_20
 
 _llgo_19:                                         ; preds = %_llgo_18
-  %187 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %144)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %187)
-  store ptr %187, ptr @"*_llgo_main.T1", align 8
+  %154 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 2, i64 1, i64 1)
+  store ptr %154, ptr @_llgo_main.T1, align 8
   br label %_llgo_20
 
 _llgo_20:                                         ; preds = %_llgo_19, %_llgo_18
-  %188 = load ptr, ptr @_llgo_main.T2, align 8
-  %189 = icmp eq ptr %188, null
-  br i1 %189, label %_llgo_21, label %_llgo_22
+  %155 = load ptr, ptr @_llgo_int, align 8
+  br i1 %153, label %_llgo_21, label %_llgo_22
 
 _llgo_21:                                         ; preds = %_llgo_20
-  %190 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 14, i64 1, i64 1)
-  store ptr %190, ptr @_llgo_main.T2, align 8
+  %156 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %157 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %156, i32 0, i32 0
+  store ptr @2, ptr %157, align 8
+  %158 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %156, i32 0, i32 1
+  store i64 6, ptr %158, align 4
+  %159 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %156, align 8
+  %160 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %161 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %162 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %161, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %159, ptr %162, align 8
+  %163 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %161, i32 0, i32 1
+  store ptr %160, ptr %163, align 8
+  %164 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %161, i32 0, i32 2
+  store ptr @"main.(*T1).Invoke", ptr %164, align 8
+  %165 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %161, i32 0, i32 3
+  store ptr @"main.(*T1).Invoke", ptr %165, align 8
+  %166 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %161, align 8
+  %167 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %168 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %167, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %159, ptr %168, align 8
+  %169 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %167, i32 0, i32 1
+  store ptr %160, ptr %169, align 8
+  %170 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %167, i32 0, i32 2
+  store ptr @"main.(*T1).Invoke", ptr %170, align 8
+  %171 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %167, i32 0, i32 3
+  store ptr @main.T1.Invoke, ptr %171, align 8
+  %172 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %167, align 8
+  %173 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %174 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %173, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %172, ptr %174, align 8
+  %175 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %176 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %175, i32 0, i32 0
+  store ptr %173, ptr %176, align 8
+  %177 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %175, i32 0, i32 1
+  store i64 1, ptr %177, align 4
+  %178 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %175, i32 0, i32 2
+  store i64 1, ptr %178, align 4
+  %179 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %175, align 8
+  %180 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %181 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %180, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %166, ptr %181, align 8
+  %182 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %183 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, i32 0, i32 0
+  store ptr %180, ptr %183, align 8
+  %184 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, i32 0, i32 1
+  store i64 1, ptr %184, align 4
+  %185 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, i32 0, i32 2
+  store i64 1, ptr %185, align 4
+  %186 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %182, align 8
+  %187 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %188 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %187, i32 0, i32 0
+  store ptr @16, ptr %188, align 8
+  %189 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %187, i32 0, i32 1
+  store i64 4, ptr %189, align 4
+  %190 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %187, align 8
+  %191 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %192 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %191, i32 0, i32 0
+  store ptr @19, ptr %192, align 8
+  %193 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %191, i32 0, i32 1
+  store i64 2, ptr %193, align 4
+  %194 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %191, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %154, %"github.com/goplus/llgo/internal/runtime.String" %190, %"github.com/goplus/llgo/internal/runtime.String" %194, ptr %155, %"github.com/goplus/llgo/internal/runtime.Slice" %179, %"github.com/goplus/llgo/internal/runtime.Slice" %186)
   br label %_llgo_22
 
 _llgo_22:                                         ; preds = %_llgo_21, %_llgo_20
-  %191 = load ptr, ptr @_llgo_float64, align 8
-  %192 = icmp eq ptr %191, null
-  br i1 %192, label %_llgo_23, label %_llgo_24
+  %195 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %196 = load ptr, ptr @_llgo_main.T1, align 8
+  %197 = load ptr, ptr @"_llgo_itab$3QVB4Paq_Qf_oLYiX6Z-MLgtIc3NDDXFNwINqjracR0", align 8
+  %198 = icmp eq ptr %197, null
+  br i1 %198, label %_llgo_23, label %_llgo_24
 
 _llgo_23:                                         ; preds = %_llgo_22
-  %193 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 46)
-  store ptr %193, ptr @_llgo_float64, align 8
+  %199 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %195, ptr %196)
+  store ptr %199, ptr @"_llgo_itab$3QVB4Paq_Qf_oLYiX6Z-MLgtIc3NDDXFNwINqjracR0", align 8
   br label %_llgo_24
 
 _llgo_24:                                         ; preds = %_llgo_23, %_llgo_22
-  %194 = load ptr, ptr @_llgo_float64, align 8
-  br i1 %189, label %_llgo_25, label %_llgo_26
+  %200 = load ptr, ptr @"*_llgo_main.T1", align 8
+  %201 = icmp eq ptr %200, null
+  br i1 %201, label %_llgo_25, label %_llgo_26
 
 _llgo_25:                                         ; preds = %_llgo_24
-  %195 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %196 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %195, i32 0, i32 0
-  store ptr @10, ptr %196, align 8
-  %197 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %195, i32 0, i32 1
-  store i64 6, ptr %197, align 4
-  %198 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %195, align 8
-  %199 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %200 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %201 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %200, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %198, ptr %201, align 8
-  %202 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %200, i32 0, i32 1
-  store ptr %199, ptr %202, align 8
-  %203 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %200, i32 0, i32 2
-  store ptr @"main.(*T2).Invoke", ptr %203, align 8
-  %204 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %200, i32 0, i32 3
-  store ptr @"main.(*T2).Invoke", ptr %204, align 8
-  %205 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %200, align 8
-  %206 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %207 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %206, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %198, ptr %207, align 8
-  %208 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %206, i32 0, i32 1
-  store ptr %199, ptr %208, align 8
-  %209 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %206, i32 0, i32 2
-  store ptr @"main.(*T2).Invoke", ptr %209, align 8
-  %210 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %206, i32 0, i32 3
-  store ptr @main.T2.Invoke, ptr %210, align 8
-  %211 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %206, align 8
-  %212 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %213 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %212, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %211, ptr %213, align 8
-  %214 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %215 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %214, i32 0, i32 0
-  store ptr %212, ptr %215, align 8
-  %216 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %214, i32 0, i32 1
-  store i64 1, ptr %216, align 4
-  %217 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %214, i32 0, i32 2
-  store i64 1, ptr %217, align 4
-  %218 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %214, align 8
-  %219 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %220 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %219, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %205, ptr %220, align 8
-  %221 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %222 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %221, i32 0, i32 0
-  store ptr %219, ptr %222, align 8
-  %223 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %221, i32 0, i32 1
-  store i64 1, ptr %223, align 4
-  %224 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %221, i32 0, i32 2
-  store i64 1, ptr %224, align 4
-  %225 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %221, align 8
-  %226 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %227 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %226, i32 0, i32 0
-  store ptr @9, ptr %227, align 8
-  %228 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %226, i32 0, i32 1
-  store i64 4, ptr %228, align 4
-  %229 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %226, align 8
-  %230 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %231 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %230, i32 0, i32 0
-  store ptr @14, ptr %231, align 8
-  %232 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %230, i32 0, i32 1
-  store i64 2, ptr %232, align 4
-  %233 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %230, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %190, %"github.com/goplus/llgo/internal/runtime.String" %229, %"github.com/goplus/llgo/internal/runtime.String" %233, ptr %194, %"github.com/goplus/llgo/internal/runtime.Slice" %218, %"github.com/goplus/llgo/internal/runtime.Slice" %225)
+  %202 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %154)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %202)
+  store ptr %202, ptr @"*_llgo_main.T1", align 8
   br label %_llgo_26
 
 _llgo_26:                                         ; preds = %_llgo_25, %_llgo_24
-  %234 = load ptr, ptr @"*_llgo_main.T2", align 8
-  %235 = icmp eq ptr %234, null
-  br i1 %235, label %_llgo_27, label %_llgo_28
+  %203 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %204 = load ptr, ptr @"*_llgo_main.T1", align 8
+  %205 = load ptr, ptr @"_llgo_itab$lNEgOxcn1W18N5DKwCczXSkPuA3vyfeTONGcdnerfYs", align 8
+  %206 = icmp eq ptr %205, null
+  br i1 %206, label %_llgo_27, label %_llgo_28
 
 _llgo_27:                                         ; preds = %_llgo_26
-  %236 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %190)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %236)
-  store ptr %236, ptr @"*_llgo_main.T2", align 8
+  %207 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %203, ptr %204)
+  store ptr %207, ptr @"_llgo_itab$lNEgOxcn1W18N5DKwCczXSkPuA3vyfeTONGcdnerfYs", align 8
   br label %_llgo_28
 
 _llgo_28:                                         ; preds = %_llgo_27, %_llgo_26
-  %237 = load ptr, ptr @_llgo_main.T3, align 8
-  %238 = icmp eq ptr %237, null
-  br i1 %238, label %_llgo_29, label %_llgo_30
+  %208 = load ptr, ptr @_llgo_main.T2, align 8
+  %209 = icmp eq ptr %208, null
+  br i1 %209, label %_llgo_29, label %_llgo_30
 
 _llgo_29:                                         ; preds = %_llgo_28
-  %239 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 3, i64 0, i64 1)
-  store ptr %239, ptr @_llgo_main.T3, align 8
+  %210 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 14, i64 1, i64 1)
+  store ptr %210, ptr @_llgo_main.T2, align 8
   br label %_llgo_30
 
 _llgo_30:                                         ; preds = %_llgo_29, %_llgo_28
-  %240 = load ptr, ptr @_llgo_int8, align 8
-  %241 = icmp eq ptr %240, null
-  br i1 %241, label %_llgo_31, label %_llgo_32
+  %211 = load ptr, ptr @_llgo_float64, align 8
+  %212 = icmp eq ptr %211, null
+  br i1 %212, label %_llgo_31, label %_llgo_32
 
 _llgo_31:                                         ; preds = %_llgo_30
-  %242 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 35)
-  store ptr %242, ptr @_llgo_int8, align 8
+  %213 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 46)
+  store ptr %213, ptr @_llgo_float64, align 8
   br label %_llgo_32
 
 _llgo_32:                                         ; preds = %_llgo_31, %_llgo_30
-  %243 = load ptr, ptr @_llgo_int8, align 8
-  br i1 %238, label %_llgo_33, label %_llgo_34
+  %214 = load ptr, ptr @_llgo_float64, align 8
+  br i1 %209, label %_llgo_33, label %_llgo_34
 
 _llgo_33:                                         ; preds = %_llgo_32
-  %244 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %245 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %244, i32 0, i32 0
-  store ptr @10, ptr %245, align 8
-  %246 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %244, i32 0, i32 1
-  store i64 6, ptr %246, align 4
-  %247 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %244, align 8
-  %248 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %249 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %250 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %249, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %247, ptr %250, align 8
-  %251 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %249, i32 0, i32 1
-  store ptr %248, ptr %251, align 8
-  %252 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %249, i32 0, i32 2
-  store ptr @"main.(*T3).Invoke", ptr %252, align 8
-  %253 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %249, i32 0, i32 3
-  store ptr @"main.(*T3).Invoke", ptr %253, align 8
-  %254 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %249, align 8
-  %255 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %256 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %255, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %254, ptr %256, align 8
-  %257 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %258 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %257, i32 0, i32 0
-  store ptr %255, ptr %258, align 8
-  %259 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %257, i32 0, i32 1
-  store i64 1, ptr %259, align 4
-  %260 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %257, i32 0, i32 2
-  store i64 1, ptr %260, align 4
-  %261 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %257, align 8
-  %262 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %263 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %262, i32 0, i32 0
-  store ptr @9, ptr %263, align 8
-  %264 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %262, i32 0, i32 1
-  store i64 4, ptr %264, align 4
-  %265 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %262, align 8
-  %266 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %267 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %266, i32 0, i32 0
-  store ptr @15, ptr %267, align 8
-  %268 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %266, i32 0, i32 1
-  store i64 2, ptr %268, align 4
-  %269 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %266, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %239, %"github.com/goplus/llgo/internal/runtime.String" %265, %"github.com/goplus/llgo/internal/runtime.String" %269, ptr %243, { ptr, i64, i64 } zeroinitializer, %"github.com/goplus/llgo/internal/runtime.Slice" %261)
+  %215 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %216 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %215, i32 0, i32 0
+  store ptr @2, ptr %216, align 8
+  %217 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %215, i32 0, i32 1
+  store i64 6, ptr %217, align 4
+  %218 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %215, align 8
+  %219 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %220 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %221 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %220, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %218, ptr %221, align 8
+  %222 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %220, i32 0, i32 1
+  store ptr %219, ptr %222, align 8
+  %223 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %220, i32 0, i32 2
+  store ptr @"main.(*T2).Invoke", ptr %223, align 8
+  %224 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %220, i32 0, i32 3
+  store ptr @"main.(*T2).Invoke", ptr %224, align 8
+  %225 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %220, align 8
+  %226 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %227 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %226, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %218, ptr %227, align 8
+  %228 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %226, i32 0, i32 1
+  store ptr %219, ptr %228, align 8
+  %229 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %226, i32 0, i32 2
+  store ptr @"main.(*T2).Invoke", ptr %229, align 8
+  %230 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %226, i32 0, i32 3
+  store ptr @main.T2.Invoke, ptr %230, align 8
+  %231 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %226, align 8
+  %232 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %233 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %232, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %231, ptr %233, align 8
+  %234 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %235 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %234, i32 0, i32 0
+  store ptr %232, ptr %235, align 8
+  %236 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %234, i32 0, i32 1
+  store i64 1, ptr %236, align 4
+  %237 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %234, i32 0, i32 2
+  store i64 1, ptr %237, align 4
+  %238 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %234, align 8
+  %239 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %240 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %239, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %225, ptr %240, align 8
+  %241 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %242 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %241, i32 0, i32 0
+  store ptr %239, ptr %242, align 8
+  %243 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %241, i32 0, i32 1
+  store i64 1, ptr %243, align 4
+  %244 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %241, i32 0, i32 2
+  store i64 1, ptr %244, align 4
+  %245 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %241, align 8
+  %246 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %247 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %246, i32 0, i32 0
+  store ptr @16, ptr %247, align 8
+  %248 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %246, i32 0, i32 1
+  store i64 4, ptr %248, align 4
+  %249 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %246, align 8
+  %250 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %251 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %250, i32 0, i32 0
+  store ptr @20, ptr %251, align 8
+  %252 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %250, i32 0, i32 1
+  store i64 2, ptr %252, align 4
+  %253 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %250, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %210, %"github.com/goplus/llgo/internal/runtime.String" %249, %"github.com/goplus/llgo/internal/runtime.String" %253, ptr %214, %"github.com/goplus/llgo/internal/runtime.Slice" %238, %"github.com/goplus/llgo/internal/runtime.Slice" %245)
   br label %_llgo_34
 
 _llgo_34:                                         ; preds = %_llgo_33, %_llgo_32
-  %270 = load ptr, ptr @"*_llgo_main.T3", align 8
-  %271 = icmp eq ptr %270, null
-  br i1 %271, label %_llgo_35, label %_llgo_36
+  %254 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %255 = load ptr, ptr @_llgo_main.T2, align 8
+  %256 = load ptr, ptr @"_llgo_itab$G4iT4cXB8QlV-k2GJnBfQ-H9B-qxuITJxY8VHSL8Khg", align 8
+  %257 = icmp eq ptr %256, null
+  br i1 %257, label %_llgo_35, label %_llgo_36
 
 _llgo_35:                                         ; preds = %_llgo_34
-  %272 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %239)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %272)
-  store ptr %272, ptr @"*_llgo_main.T3", align 8
+  %258 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %254, ptr %255)
+  store ptr %258, ptr @"_llgo_itab$G4iT4cXB8QlV-k2GJnBfQ-H9B-qxuITJxY8VHSL8Khg", align 8
   br label %_llgo_36
 
 _llgo_36:                                         ; preds = %_llgo_35, %_llgo_34
-  %273 = load ptr, ptr @_llgo_main.T4, align 8
-  %274 = icmp eq ptr %273, null
-  br i1 %274, label %_llgo_37, label %_llgo_38
+  %259 = load ptr, ptr @"*_llgo_main.T2", align 8
+  %260 = icmp eq ptr %259, null
+  br i1 %260, label %_llgo_37, label %_llgo_38
 
 _llgo_37:                                         ; preds = %_llgo_36
-  %275 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 17, i64 1, i64 1)
-  store ptr %275, ptr @_llgo_main.T4, align 8
+  %261 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %210)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %261)
+  store ptr %261, ptr @"*_llgo_main.T2", align 8
   br label %_llgo_38
 
 _llgo_38:                                         ; preds = %_llgo_37, %_llgo_36
-  %276 = load ptr, ptr @_llgo_int, align 8
-  %277 = load ptr, ptr @"[1]_llgo_int", align 8
-  %278 = icmp eq ptr %277, null
-  br i1 %278, label %_llgo_39, label %_llgo_40
+  %262 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %263 = load ptr, ptr @"*_llgo_main.T2", align 8
+  %264 = load ptr, ptr @"_llgo_itab$UH5BeN8ZGABYXEYZPawShXNq1yw1nUgxq7xdVTP6c0s", align 8
+  %265 = icmp eq ptr %264, null
+  br i1 %265, label %_llgo_39, label %_llgo_40
 
 _llgo_39:                                         ; preds = %_llgo_38
-  %279 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
-  %280 = call ptr @"github.com/goplus/llgo/internal/runtime.ArrayOf"(i64 1, ptr %279)
-  store ptr %280, ptr @"[1]_llgo_int", align 8
+  %266 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %262, ptr %263)
+  store ptr %266, ptr @"_llgo_itab$UH5BeN8ZGABYXEYZPawShXNq1yw1nUgxq7xdVTP6c0s", align 8
   br label %_llgo_40
 
 _llgo_40:                                         ; preds = %_llgo_39, %_llgo_38
-  %281 = load ptr, ptr @"[1]_llgo_int", align 8
-  br i1 %274, label %_llgo_41, label %_llgo_42
+  %267 = load ptr, ptr @_llgo_main.T3, align 8
+  %268 = icmp eq ptr %267, null
+  br i1 %268, label %_llgo_41, label %_llgo_42
 
 _llgo_41:                                         ; preds = %_llgo_40
-  %282 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %283 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %282, i32 0, i32 0
-  store ptr @10, ptr %283, align 8
-  %284 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %282, i32 0, i32 1
-  store i64 6, ptr %284, align 4
-  %285 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %282, align 8
-  %286 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %287 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %288 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %287, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %285, ptr %288, align 8
-  %289 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %287, i32 0, i32 1
-  store ptr %286, ptr %289, align 8
-  %290 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %287, i32 0, i32 2
-  store ptr @"main.(*T4).Invoke", ptr %290, align 8
-  %291 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %287, i32 0, i32 3
-  store ptr @"main.(*T4).Invoke", ptr %291, align 8
-  %292 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %287, align 8
-  %293 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %294 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %293, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %285, ptr %294, align 8
-  %295 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %293, i32 0, i32 1
-  store ptr %286, ptr %295, align 8
-  %296 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %293, i32 0, i32 2
-  store ptr @"main.(*T4).Invoke", ptr %296, align 8
-  %297 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %293, i32 0, i32 3
-  store ptr @main.T4.Invoke, ptr %297, align 8
-  %298 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %293, align 8
-  %299 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %300 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %299, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %298, ptr %300, align 8
-  %301 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %302 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %301, i32 0, i32 0
-  store ptr %299, ptr %302, align 8
-  %303 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %301, i32 0, i32 1
-  store i64 1, ptr %303, align 4
-  %304 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %301, i32 0, i32 2
-  store i64 1, ptr %304, align 4
-  %305 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %301, align 8
-  %306 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %307 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %306, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %292, ptr %307, align 8
-  %308 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %309 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %308, i32 0, i32 0
-  store ptr %306, ptr %309, align 8
-  %310 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %308, i32 0, i32 1
-  store i64 1, ptr %310, align 4
-  %311 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %308, i32 0, i32 2
-  store i64 1, ptr %311, align 4
-  %312 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %308, align 8
-  %313 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %314 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %313, i32 0, i32 0
-  store ptr @9, ptr %314, align 8
-  %315 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %313, i32 0, i32 1
-  store i64 4, ptr %315, align 4
-  %316 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %313, align 8
-  %317 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %318 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %317, i32 0, i32 0
-  store ptr @16, ptr %318, align 8
-  %319 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %317, i32 0, i32 1
-  store i64 2, ptr %319, align 4
-  %320 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %317, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %275, %"github.com/goplus/llgo/internal/runtime.String" %316, %"github.com/goplus/llgo/internal/runtime.String" %320, ptr %281, %"github.com/goplus/llgo/internal/runtime.Slice" %305, %"github.com/goplus/llgo/internal/runtime.Slice" %312)
+  %269 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 3, i64 0, i64 1)
+  store ptr %269, ptr @_llgo_main.T3, align 8
   br label %_llgo_42
 
 _llgo_42:                                         ; preds = %_llgo_41, %_llgo_40
-  %321 = load ptr, ptr @"*_llgo_main.T4", align 8
-  %322 = icmp eq ptr %321, null
-  br i1 %322, label %_llgo_43, label %_llgo_44
+  %270 = load ptr, ptr @_llgo_int8, align 8
+  %271 = icmp eq ptr %270, null
+  br i1 %271, label %_llgo_43, label %_llgo_44
 
 _llgo_43:                                         ; preds = %_llgo_42
-  %323 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %275)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %323)
-  store ptr %323, ptr @"*_llgo_main.T4", align 8
+  %272 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 35)
+  store ptr %272, ptr @_llgo_int8, align 8
   br label %_llgo_44
 
 _llgo_44:                                         ; preds = %_llgo_43, %_llgo_42
-  %324 = load ptr, ptr @_llgo_main.T5, align 8
-  %325 = icmp eq ptr %324, null
-  br i1 %325, label %_llgo_45, label %_llgo_46
+  %273 = load ptr, ptr @_llgo_int8, align 8
+  br i1 %268, label %_llgo_45, label %_llgo_46
 
 _llgo_45:                                         ; preds = %_llgo_44
-  %326 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 1, i64 1)
-  store ptr %326, ptr @_llgo_main.T5, align 8
+  %274 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %275 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %274, i32 0, i32 0
+  store ptr @2, ptr %275, align 8
+  %276 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %274, i32 0, i32 1
+  store i64 6, ptr %276, align 4
+  %277 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %274, align 8
+  %278 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %279 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %280 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %279, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %277, ptr %280, align 8
+  %281 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %279, i32 0, i32 1
+  store ptr %278, ptr %281, align 8
+  %282 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %279, i32 0, i32 2
+  store ptr @"main.(*T3).Invoke", ptr %282, align 8
+  %283 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %279, i32 0, i32 3
+  store ptr @"main.(*T3).Invoke", ptr %283, align 8
+  %284 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %279, align 8
+  %285 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %286 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %285, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %284, ptr %286, align 8
+  %287 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %288 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %287, i32 0, i32 0
+  store ptr %285, ptr %288, align 8
+  %289 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %287, i32 0, i32 1
+  store i64 1, ptr %289, align 4
+  %290 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %287, i32 0, i32 2
+  store i64 1, ptr %290, align 4
+  %291 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %287, align 8
+  %292 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %293 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %292, i32 0, i32 0
+  store ptr @16, ptr %293, align 8
+  %294 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %292, i32 0, i32 1
+  store i64 4, ptr %294, align 4
+  %295 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %292, align 8
+  %296 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %297 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %296, i32 0, i32 0
+  store ptr @21, ptr %297, align 8
+  %298 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %296, i32 0, i32 1
+  store i64 2, ptr %298, align 4
+  %299 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %296, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %269, %"github.com/goplus/llgo/internal/runtime.String" %295, %"github.com/goplus/llgo/internal/runtime.String" %299, ptr %273, { ptr, i64, i64 } zeroinitializer, %"github.com/goplus/llgo/internal/runtime.Slice" %291)
   br label %_llgo_46
 
 _llgo_46:                                         ; preds = %_llgo_45, %_llgo_44
-  %327 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %328 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %327, i32 0, i32 0
-  store ptr @17, ptr %328, align 8
-  %329 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %327, i32 0, i32 1
-  store i64 1, ptr %329, align 4
-  %330 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %327, align 8
-  %331 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %332 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %331, i32 0, i32 0
-  store ptr null, ptr %332, align 8
-  %333 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %331, i32 0, i32 1
-  store i64 0, ptr %333, align 4
-  %334 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %331, align 8
-  %335 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
-  %336 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %330, ptr %335, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %334, i1 false)
-  %337 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %338 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %337, i32 0, i32 0
-  store ptr @9, ptr %338, align 8
-  %339 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %337, i32 0, i32 1
-  store i64 4, ptr %339, align 4
-  %340 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %337, align 8
-  %341 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 56)
-  %342 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %341, i64 0
-  store %"github.com/goplus/llgo/internal/abi.StructField" %336, ptr %342, align 8
-  %343 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %344 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, i32 0, i32 0
-  store ptr %341, ptr %344, align 8
-  %345 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, i32 0, i32 1
-  store i64 1, ptr %345, align 4
-  %346 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, i32 0, i32 2
-  store i64 1, ptr %346, align 4
-  %347 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, align 8
-  %348 = call ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplus/llgo/internal/runtime.String" %340, i64 8, %"github.com/goplus/llgo/internal/runtime.Slice" %347)
-  store ptr %348, ptr @"main.struct$eovYmOhZg4X0zMSsuscSshndnbbAGvB2E3cyG8E7Y4U", align 8
-  %349 = load ptr, ptr @"main.struct$eovYmOhZg4X0zMSsuscSshndnbbAGvB2E3cyG8E7Y4U", align 8
-  br i1 %325, label %_llgo_47, label %_llgo_48
+  %300 = load ptr, ptr @"*_llgo_main.T3", align 8
+  %301 = icmp eq ptr %300, null
+  br i1 %301, label %_llgo_47, label %_llgo_48
 
 _llgo_47:                                         ; preds = %_llgo_46
-  %350 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %351 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %350, i32 0, i32 0
-  store ptr @10, ptr %351, align 8
-  %352 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %350, i32 0, i32 1
-  store i64 6, ptr %352, align 4
-  %353 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %350, align 8
-  %354 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %355 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %356 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %355, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %353, ptr %356, align 8
-  %357 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %355, i32 0, i32 1
-  store ptr %354, ptr %357, align 8
-  %358 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %355, i32 0, i32 2
-  store ptr @"main.(*T5).Invoke", ptr %358, align 8
-  %359 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %355, i32 0, i32 3
-  store ptr @"main.(*T5).Invoke", ptr %359, align 8
-  %360 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %355, align 8
-  %361 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %362 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %361, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %353, ptr %362, align 8
-  %363 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %361, i32 0, i32 1
-  store ptr %354, ptr %363, align 8
-  %364 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %361, i32 0, i32 2
-  store ptr @"main.(*T5).Invoke", ptr %364, align 8
-  %365 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %361, i32 0, i32 3
-  store ptr @main.T5.Invoke, ptr %365, align 8
-  %366 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %361, align 8
-  %367 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %368 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %367, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %366, ptr %368, align 8
-  %369 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %370 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %369, i32 0, i32 0
-  store ptr %367, ptr %370, align 8
-  %371 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %369, i32 0, i32 1
-  store i64 1, ptr %371, align 4
-  %372 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %369, i32 0, i32 2
-  store i64 1, ptr %372, align 4
-  %373 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %369, align 8
-  %374 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %375 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %374, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %360, ptr %375, align 8
-  %376 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %377 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %376, i32 0, i32 0
-  store ptr %374, ptr %377, align 8
-  %378 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %376, i32 0, i32 1
-  store i64 1, ptr %378, align 4
-  %379 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %376, i32 0, i32 2
-  store i64 1, ptr %379, align 4
-  %380 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %376, align 8
-  %381 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %382 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %381, i32 0, i32 0
-  store ptr @9, ptr %382, align 8
-  %383 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %381, i32 0, i32 1
-  store i64 4, ptr %383, align 4
-  %384 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %381, align 8
-  %385 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %386 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %385, i32 0, i32 0
-  store ptr @18, ptr %386, align 8
-  %387 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %385, i32 0, i32 1
-  store i64 2, ptr %387, align 4
-  %388 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %385, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %326, %"github.com/goplus/llgo/internal/runtime.String" %384, %"github.com/goplus/llgo/internal/runtime.String" %388, ptr %349, %"github.com/goplus/llgo/internal/runtime.Slice" %373, %"github.com/goplus/llgo/internal/runtime.Slice" %380)
+  %302 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %269)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %302)
+  store ptr %302, ptr @"*_llgo_main.T3", align 8
   br label %_llgo_48
 
 _llgo_48:                                         ; preds = %_llgo_47, %_llgo_46
-  %389 = load ptr, ptr @"*_llgo_main.T5", align 8
-  %390 = icmp eq ptr %389, null
-  br i1 %390, label %_llgo_49, label %_llgo_50
+  %303 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %304 = load ptr, ptr @"*_llgo_main.T3", align 8
+  %305 = load ptr, ptr @"_llgo_itab$X1zkTI9Tjieftw2ur1LThx6G12Zjs-YEvWcCJEtMEyw", align 8
+  %306 = icmp eq ptr %305, null
+  br i1 %306, label %_llgo_49, label %_llgo_50
 
 _llgo_49:                                         ; preds = %_llgo_48
-  %391 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %326)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %391)
-  store ptr %391, ptr @"*_llgo_main.T5", align 8
+  %307 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %303, ptr %304)
+  store ptr %307, ptr @"_llgo_itab$X1zkTI9Tjieftw2ur1LThx6G12Zjs-YEvWcCJEtMEyw", align 8
   br label %_llgo_50
 
 _llgo_50:                                         ; preds = %_llgo_49, %_llgo_48
-  %392 = load ptr, ptr @_llgo_main.T6, align 8
-  %393 = icmp eq ptr %392, null
-  br i1 %393, label %_llgo_51, label %_llgo_52
+  %308 = load ptr, ptr @_llgo_main.T4, align 8
+  %309 = icmp eq ptr %308, null
+  br i1 %309, label %_llgo_51, label %_llgo_52
 
 _llgo_51:                                         ; preds = %_llgo_50
-  %394 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 1, i64 1)
-  store ptr %394, ptr @_llgo_main.T6, align 8
+  %310 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 17, i64 1, i64 1)
+  store ptr %310, ptr @_llgo_main.T4, align 8
   br label %_llgo_52
 
 _llgo_52:                                         ; preds = %_llgo_51, %_llgo_50
-  %395 = load ptr, ptr @_llgo_Pointer, align 8
-  %396 = icmp eq ptr %395, null
-  br i1 %396, label %_llgo_53, label %_llgo_54
+  %311 = load ptr, ptr @_llgo_int, align 8
+  %312 = load ptr, ptr @"[1]_llgo_int", align 8
+  %313 = icmp eq ptr %312, null
+  br i1 %313, label %_llgo_53, label %_llgo_54
 
 _llgo_53:                                         ; preds = %_llgo_52
-  %397 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 58)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %397)
-  store ptr %397, ptr @_llgo_Pointer, align 8
+  %314 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
+  %315 = call ptr @"github.com/goplus/llgo/internal/runtime.ArrayOf"(i64 1, ptr %314)
+  store ptr %315, ptr @"[1]_llgo_int", align 8
   br label %_llgo_54
 
 _llgo_54:                                         ; preds = %_llgo_53, %_llgo_52
-  %398 = load ptr, ptr @_llgo_Pointer, align 8
-  %399 = load ptr, ptr @_llgo_int, align 8
-  %400 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %401 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %400, i32 0, i32 0
-  store ptr @19, ptr %401, align 8
-  %402 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %400, i32 0, i32 1
-  store i64 1, ptr %402, align 4
-  %403 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %400, align 8
-  %404 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %405 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %404, i32 0, i32 0
-  store ptr null, ptr %405, align 8
-  %406 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %404, i32 0, i32 1
-  store i64 0, ptr %406, align 4
-  %407 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %404, align 8
-  %408 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %409 = getelementptr ptr, ptr %408, i64 0
-  store ptr %398, ptr %409, align 8
-  %410 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %411 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %410, i32 0, i32 0
-  store ptr %408, ptr %411, align 8
-  %412 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %410, i32 0, i32 1
-  store i64 1, ptr %412, align 4
-  %413 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %410, i32 0, i32 2
-  store i64 1, ptr %413, align 4
-  %414 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %410, align 8
-  %415 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %416 = getelementptr ptr, ptr %415, i64 0
-  store ptr %399, ptr %416, align 8
-  %417 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %418 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %417, i32 0, i32 0
-  store ptr %415, ptr %418, align 8
-  %419 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %417, i32 0, i32 1
-  store i64 1, ptr %419, align 4
-  %420 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %417, i32 0, i32 2
-  store i64 1, ptr %420, align 4
-  %421 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %417, align 8
-  %422 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %414, %"github.com/goplus/llgo/internal/runtime.Slice" %421, i1 false)
-  %423 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %403, ptr %422, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %407, i1 false)
-  %424 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %425 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %424, i32 0, i32 0
-  store ptr @20, ptr %425, align 8
-  %426 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %424, i32 0, i32 1
-  store i64 4, ptr %426, align 4
-  %427 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %424, align 8
-  %428 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %429 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %428, i32 0, i32 0
-  store ptr null, ptr %429, align 8
-  %430 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %428, i32 0, i32 1
-  store i64 0, ptr %430, align 4
-  %431 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %428, align 8
-  %432 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 58)
-  %433 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %427, ptr %432, i64 8, %"github.com/goplus/llgo/internal/runtime.String" %431, i1 false)
-  %434 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %435 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %434, i32 0, i32 0
-  store ptr @9, ptr %435, align 8
-  %436 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %434, i32 0, i32 1
-  store i64 4, ptr %436, align 4
-  %437 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %434, align 8
-  %438 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 112)
-  %439 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %438, i64 0
-  store %"github.com/goplus/llgo/internal/abi.StructField" %423, ptr %439, align 8
-  %440 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %438, i64 1
-  store %"github.com/goplus/llgo/internal/abi.StructField" %433, ptr %440, align 8
-  %441 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %442 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %441, i32 0, i32 0
-  store ptr %438, ptr %442, align 8
-  %443 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %441, i32 0, i32 1
-  store i64 2, ptr %443, align 4
-  %444 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %441, i32 0, i32 2
-  store i64 2, ptr %444, align 4
-  %445 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %441, align 8
-  %446 = call ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplus/llgo/internal/runtime.String" %437, i64 16, %"github.com/goplus/llgo/internal/runtime.Slice" %445)
-  store ptr %446, ptr @"main.struct$2bSfJcCYDdttnIT-JASAjsTNUZvojBt4mPXFJdH4M10", align 8
-  %447 = load ptr, ptr @"main.struct$2bSfJcCYDdttnIT-JASAjsTNUZvojBt4mPXFJdH4M10", align 8
-  br i1 %393, label %_llgo_55, label %_llgo_56
+  %316 = load ptr, ptr @"[1]_llgo_int", align 8
+  br i1 %309, label %_llgo_55, label %_llgo_56
 
 _llgo_55:                                         ; preds = %_llgo_54
-  %448 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %449 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %448, i32 0, i32 0
-  store ptr @10, ptr %449, align 8
-  %450 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %448, i32 0, i32 1
-  store i64 6, ptr %450, align 4
-  %451 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %448, align 8
-  %452 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %453 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %454 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %453, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %451, ptr %454, align 8
-  %455 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %453, i32 0, i32 1
-  store ptr %452, ptr %455, align 8
-  %456 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %453, i32 0, i32 2
-  store ptr @"main.(*T6).Invoke", ptr %456, align 8
-  %457 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %453, i32 0, i32 3
-  store ptr @"main.(*T6).Invoke", ptr %457, align 8
-  %458 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %453, align 8
-  %459 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %460 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %459, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %451, ptr %460, align 8
-  %461 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %459, i32 0, i32 1
-  store ptr %452, ptr %461, align 8
-  %462 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %459, i32 0, i32 2
-  store ptr @"main.(*T6).Invoke", ptr %462, align 8
-  %463 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %459, i32 0, i32 3
-  store ptr @main.T6.Invoke, ptr %463, align 8
-  %464 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %459, align 8
-  %465 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %466 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %465, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %464, ptr %466, align 8
-  %467 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %468 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %467, i32 0, i32 0
-  store ptr %465, ptr %468, align 8
-  %469 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %467, i32 0, i32 1
-  store i64 1, ptr %469, align 4
-  %470 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %467, i32 0, i32 2
-  store i64 1, ptr %470, align 4
-  %471 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %467, align 8
-  %472 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %473 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %472, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %458, ptr %473, align 8
-  %474 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %475 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %474, i32 0, i32 0
-  store ptr %472, ptr %475, align 8
-  %476 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %474, i32 0, i32 1
-  store i64 1, ptr %476, align 4
-  %477 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %474, i32 0, i32 2
-  store i64 1, ptr %477, align 4
-  %478 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %474, align 8
-  %479 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %480 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %479, i32 0, i32 0
-  store ptr @9, ptr %480, align 8
-  %481 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %479, i32 0, i32 1
-  store i64 4, ptr %481, align 4
-  %482 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %479, align 8
-  %483 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %484 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %483, i32 0, i32 0
-  store ptr @21, ptr %484, align 8
-  %485 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %483, i32 0, i32 1
-  store i64 2, ptr %485, align 4
-  %486 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %483, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %394, %"github.com/goplus/llgo/internal/runtime.String" %482, %"github.com/goplus/llgo/internal/runtime.String" %486, ptr %447, %"github.com/goplus/llgo/internal/runtime.Slice" %471, %"github.com/goplus/llgo/internal/runtime.Slice" %478)
+  %317 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %318 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %317, i32 0, i32 0
+  store ptr @2, ptr %318, align 8
+  %319 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %317, i32 0, i32 1
+  store i64 6, ptr %319, align 4
+  %320 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %317, align 8
+  %321 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %322 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %323 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %322, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %320, ptr %323, align 8
+  %324 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %322, i32 0, i32 1
+  store ptr %321, ptr %324, align 8
+  %325 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %322, i32 0, i32 2
+  store ptr @"main.(*T4).Invoke", ptr %325, align 8
+  %326 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %322, i32 0, i32 3
+  store ptr @"main.(*T4).Invoke", ptr %326, align 8
+  %327 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %322, align 8
+  %328 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %329 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %328, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %320, ptr %329, align 8
+  %330 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %328, i32 0, i32 1
+  store ptr %321, ptr %330, align 8
+  %331 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %328, i32 0, i32 2
+  store ptr @"main.(*T4).Invoke", ptr %331, align 8
+  %332 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %328, i32 0, i32 3
+  store ptr @main.T4.Invoke, ptr %332, align 8
+  %333 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %328, align 8
+  %334 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %335 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %334, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %333, ptr %335, align 8
+  %336 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %337 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %336, i32 0, i32 0
+  store ptr %334, ptr %337, align 8
+  %338 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %336, i32 0, i32 1
+  store i64 1, ptr %338, align 4
+  %339 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %336, i32 0, i32 2
+  store i64 1, ptr %339, align 4
+  %340 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %336, align 8
+  %341 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %342 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %341, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %327, ptr %342, align 8
+  %343 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %344 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, i32 0, i32 0
+  store ptr %341, ptr %344, align 8
+  %345 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, i32 0, i32 1
+  store i64 1, ptr %345, align 4
+  %346 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, i32 0, i32 2
+  store i64 1, ptr %346, align 4
+  %347 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %343, align 8
+  %348 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %349 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %348, i32 0, i32 0
+  store ptr @16, ptr %349, align 8
+  %350 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %348, i32 0, i32 1
+  store i64 4, ptr %350, align 4
+  %351 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %348, align 8
+  %352 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %353 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %352, i32 0, i32 0
+  store ptr @22, ptr %353, align 8
+  %354 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %352, i32 0, i32 1
+  store i64 2, ptr %354, align 4
+  %355 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %352, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %310, %"github.com/goplus/llgo/internal/runtime.String" %351, %"github.com/goplus/llgo/internal/runtime.String" %355, ptr %316, %"github.com/goplus/llgo/internal/runtime.Slice" %340, %"github.com/goplus/llgo/internal/runtime.Slice" %347)
   br label %_llgo_56
 
 _llgo_56:                                         ; preds = %_llgo_55, %_llgo_54
-  %487 = load ptr, ptr @"*_llgo_main.T6", align 8
-  %488 = icmp eq ptr %487, null
-  br i1 %488, label %_llgo_57, label %_llgo_58
+  %356 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %357 = load ptr, ptr @_llgo_main.T4, align 8
+  %358 = load ptr, ptr @"_llgo_itab$7DnRwYEQYqocnibn_0rbqe6_fq1LX0WFk69pnMIjiWE", align 8
+  %359 = icmp eq ptr %358, null
+  br i1 %359, label %_llgo_57, label %_llgo_58
 
 _llgo_57:                                         ; preds = %_llgo_56
-  %489 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %394)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %489)
-  store ptr %489, ptr @"*_llgo_main.T6", align 8
+  %360 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %356, ptr %357)
+  store ptr %360, ptr @"_llgo_itab$7DnRwYEQYqocnibn_0rbqe6_fq1LX0WFk69pnMIjiWE", align 8
   br label %_llgo_58
 
 _llgo_58:                                         ; preds = %_llgo_57, %_llgo_56
-  %490 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %491 = load ptr, ptr @"_llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac", align 8
-  %492 = load ptr, ptr @"_llgo_iface$jwmSdgh1zvY_TDIgLzCkvkbiyrdwl9N806DH0JGcyMI", align 8
-  %493 = icmp eq ptr %492, null
-  br i1 %493, label %_llgo_59, label %_llgo_60
+  %361 = load ptr, ptr @"*_llgo_main.T4", align 8
+  %362 = icmp eq ptr %361, null
+  br i1 %362, label %_llgo_59, label %_llgo_60
 
 _llgo_59:                                         ; preds = %_llgo_58
-  %494 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %495 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %494, i32 0, i32 0
-  store ptr @10, ptr %495, align 8
-  %496 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %494, i32 0, i32 1
-  store i64 6, ptr %496, align 4
-  %497 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %494, align 8
-  %498 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %499 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %498, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %497, ptr %499, align 8
-  %500 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %498, i32 0, i32 1
-  store ptr %490, ptr %500, align 8
-  %501 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %498, align 8
-  %502 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %503 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %502, i32 0, i32 0
-  store ptr @11, ptr %503, align 8
-  %504 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %502, i32 0, i32 1
-  store i64 6, ptr %504, align 4
-  %505 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %502, align 8
-  %506 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %507 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %506, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %505, ptr %507, align 8
-  %508 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %506, i32 0, i32 1
-  store ptr %491, ptr %508, align 8
-  %509 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %506, align 8
-  %510 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 48)
-  %511 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %510, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %501, ptr %511, align 8
-  %512 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %510, i64 1
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %509, ptr %512, align 8
-  %513 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %514 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %513, i32 0, i32 0
-  store ptr %510, ptr %514, align 8
-  %515 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %513, i32 0, i32 1
-  store i64 2, ptr %515, align 4
-  %516 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %513, i32 0, i32 2
-  store i64 2, ptr %516, align 4
-  %517 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %513, align 8
-  %518 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %519 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %518, i32 0, i32 0
-  store ptr @9, ptr %519, align 8
-  %520 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %518, i32 0, i32 1
-  store i64 4, ptr %520, align 4
-  %521 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %518, align 8
-  %522 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %523 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %522, i32 0, i32 0
-  store ptr null, ptr %523, align 8
-  %524 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %522, i32 0, i32 1
-  store i64 0, ptr %524, align 4
-  %525 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %522, align 8
-  %526 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %521, %"github.com/goplus/llgo/internal/runtime.String" %525, %"github.com/goplus/llgo/internal/runtime.Slice" %517)
-  store ptr %526, ptr @"_llgo_iface$jwmSdgh1zvY_TDIgLzCkvkbiyrdwl9N806DH0JGcyMI", align 8
+  %363 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %310)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %363)
+  store ptr %363, ptr @"*_llgo_main.T4", align 8
   br label %_llgo_60
 
 _llgo_60:                                         ; preds = %_llgo_59, %_llgo_58
-  %527 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %528 = load ptr, ptr @_llgo_main.I, align 8
-  %529 = icmp eq ptr %528, null
-  br i1 %529, label %_llgo_61, label %_llgo_62
+  %364 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %365 = load ptr, ptr @"*_llgo_main.T4", align 8
+  %366 = load ptr, ptr @"_llgo_itab$9HoMpLs43trpCnevjoF7_k3tmBK2kZ0CkbejVa3WXbU", align 8
+  %367 = icmp eq ptr %366, null
+  br i1 %367, label %_llgo_61, label %_llgo_62
 
 _llgo_61:                                         ; preds = %_llgo_60
-  %530 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %531 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %530, i32 0, i32 0
-  store ptr @10, ptr %531, align 8
-  %532 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %530, i32 0, i32 1
-  store i64 6, ptr %532, align 4
-  %533 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %530, align 8
-  %534 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %535 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %534, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %533, ptr %535, align 8
-  %536 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %534, i32 0, i32 1
-  store ptr %527, ptr %536, align 8
-  %537 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %534, align 8
-  %538 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
-  %539 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %538, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %537, ptr %539, align 8
-  %540 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %541 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %540, i32 0, i32 0
-  store ptr %538, ptr %541, align 8
-  %542 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %540, i32 0, i32 1
-  store i64 1, ptr %542, align 4
-  %543 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %540, i32 0, i32 2
-  store i64 1, ptr %543, align 4
-  %544 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %540, align 8
-  %545 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %546 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %545, i32 0, i32 0
-  store ptr @9, ptr %546, align 8
-  %547 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %545, i32 0, i32 1
-  store i64 4, ptr %547, align 4
-  %548 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %545, align 8
-  %549 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %550 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %549, i32 0, i32 0
-  store ptr @23, ptr %550, align 8
-  %551 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %549, i32 0, i32 1
-  store i64 6, ptr %551, align 4
-  %552 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %549, align 8
-  %553 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %548, %"github.com/goplus/llgo/internal/runtime.String" %552, %"github.com/goplus/llgo/internal/runtime.Slice" %544)
-  store ptr %553, ptr @_llgo_main.I, align 8
+  %368 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %364, ptr %365)
+  store ptr %368, ptr @"_llgo_itab$9HoMpLs43trpCnevjoF7_k3tmBK2kZ0CkbejVa3WXbU", align 8
   br label %_llgo_62
 
 _llgo_62:                                         ; preds = %_llgo_61, %_llgo_60
-  %554 = load ptr, ptr @_llgo_string, align 8
-  %555 = icmp eq ptr %554, null
-  br i1 %555, label %_llgo_63, label %_llgo_64
+  %369 = load ptr, ptr @_llgo_main.T5, align 8
+  %370 = icmp eq ptr %369, null
+  br i1 %370, label %_llgo_63, label %_llgo_64
 
 _llgo_63:                                         ; preds = %_llgo_62
-  %556 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 24)
-  store ptr %556, ptr @_llgo_string, align 8
+  %371 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 1, i64 1)
+  store ptr %371, ptr @_llgo_main.T5, align 8
   br label %_llgo_64
 
 _llgo_64:                                         ; preds = %_llgo_63, %_llgo_62
-  %557 = load ptr, ptr @_llgo_any, align 8
-  %558 = icmp eq ptr %557, null
-  br i1 %558, label %_llgo_65, label %_llgo_66
+  %372 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %373 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %372, i32 0, i32 0
+  store ptr @23, ptr %373, align 8
+  %374 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %372, i32 0, i32 1
+  store i64 1, ptr %374, align 4
+  %375 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %372, align 8
+  %376 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %377 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %376, i32 0, i32 0
+  store ptr null, ptr %377, align 8
+  %378 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %376, i32 0, i32 1
+  store i64 0, ptr %378, align 4
+  %379 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %376, align 8
+  %380 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
+  %381 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %375, ptr %380, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %379, i1 false)
+  %382 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %383 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %382, i32 0, i32 0
+  store ptr @16, ptr %383, align 8
+  %384 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %382, i32 0, i32 1
+  store i64 4, ptr %384, align 4
+  %385 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %382, align 8
+  %386 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 56)
+  %387 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %386, i64 0
+  store %"github.com/goplus/llgo/internal/abi.StructField" %381, ptr %387, align 8
+  %388 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %389 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %388, i32 0, i32 0
+  store ptr %386, ptr %389, align 8
+  %390 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %388, i32 0, i32 1
+  store i64 1, ptr %390, align 4
+  %391 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %388, i32 0, i32 2
+  store i64 1, ptr %391, align 4
+  %392 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %388, align 8
+  %393 = call ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplus/llgo/internal/runtime.String" %385, i64 8, %"github.com/goplus/llgo/internal/runtime.Slice" %392)
+  store ptr %393, ptr @"main.struct$eovYmOhZg4X0zMSsuscSshndnbbAGvB2E3cyG8E7Y4U", align 8
+  %394 = load ptr, ptr @"main.struct$eovYmOhZg4X0zMSsuscSshndnbbAGvB2E3cyG8E7Y4U", align 8
+  br i1 %370, label %_llgo_65, label %_llgo_66
 
 _llgo_65:                                         ; preds = %_llgo_64
-  %559 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
-  %560 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %561 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %560, i32 0, i32 0
-  store ptr %559, ptr %561, align 8
-  %562 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %560, i32 0, i32 1
-  store i64 0, ptr %562, align 4
-  %563 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %560, i32 0, i32 2
-  store i64 0, ptr %563, align 4
-  %564 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %560, align 8
-  %565 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %566 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %565, i32 0, i32 0
-  store ptr @9, ptr %566, align 8
-  %567 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %565, i32 0, i32 1
-  store i64 4, ptr %567, align 4
-  %568 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %565, align 8
-  %569 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %570 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %569, i32 0, i32 0
-  store ptr null, ptr %570, align 8
-  %571 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %569, i32 0, i32 1
-  store i64 0, ptr %571, align 4
-  %572 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %569, align 8
-  %573 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %568, %"github.com/goplus/llgo/internal/runtime.String" %572, %"github.com/goplus/llgo/internal/runtime.Slice" %564)
-  store ptr %573, ptr @_llgo_any, align 8
+  %395 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %396 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %395, i32 0, i32 0
+  store ptr @2, ptr %396, align 8
+  %397 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %395, i32 0, i32 1
+  store i64 6, ptr %397, align 4
+  %398 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %395, align 8
+  %399 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %400 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %401 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %400, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %398, ptr %401, align 8
+  %402 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %400, i32 0, i32 1
+  store ptr %399, ptr %402, align 8
+  %403 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %400, i32 0, i32 2
+  store ptr @"main.(*T5).Invoke", ptr %403, align 8
+  %404 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %400, i32 0, i32 3
+  store ptr @"main.(*T5).Invoke", ptr %404, align 8
+  %405 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %400, align 8
+  %406 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %407 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %406, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %398, ptr %407, align 8
+  %408 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %406, i32 0, i32 1
+  store ptr %399, ptr %408, align 8
+  %409 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %406, i32 0, i32 2
+  store ptr @"main.(*T5).Invoke", ptr %409, align 8
+  %410 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %406, i32 0, i32 3
+  store ptr @main.T5.Invoke, ptr %410, align 8
+  %411 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %406, align 8
+  %412 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %413 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %412, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %411, ptr %413, align 8
+  %414 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %415 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %414, i32 0, i32 0
+  store ptr %412, ptr %415, align 8
+  %416 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %414, i32 0, i32 1
+  store i64 1, ptr %416, align 4
+  %417 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %414, i32 0, i32 2
+  store i64 1, ptr %417, align 4
+  %418 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %414, align 8
+  %419 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %420 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %419, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %405, ptr %420, align 8
+  %421 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %422 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %421, i32 0, i32 0
+  store ptr %419, ptr %422, align 8
+  %423 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %421, i32 0, i32 1
+  store i64 1, ptr %423, align 4
+  %424 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %421, i32 0, i32 2
+  store i64 1, ptr %424, align 4
+  %425 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %421, align 8
+  %426 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %427 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %426, i32 0, i32 0
+  store ptr @16, ptr %427, align 8
+  %428 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %426, i32 0, i32 1
+  store i64 4, ptr %428, align 4
+  %429 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %426, align 8
+  %430 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %431 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %430, i32 0, i32 0
+  store ptr @24, ptr %431, align 8
+  %432 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %430, i32 0, i32 1
+  store i64 2, ptr %432, align 4
+  %433 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %430, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %371, %"github.com/goplus/llgo/internal/runtime.String" %429, %"github.com/goplus/llgo/internal/runtime.String" %433, ptr %394, %"github.com/goplus/llgo/internal/runtime.Slice" %418, %"github.com/goplus/llgo/internal/runtime.Slice" %425)
   br label %_llgo_66
 
 _llgo_66:                                         ; preds = %_llgo_65, %_llgo_64
+  %434 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %435 = load ptr, ptr @_llgo_main.T5, align 8
+  %436 = load ptr, ptr @"_llgo_itab$Rnvku_R73IHCgPy8kwNzOPAR0TOc5S5qQFkAds6zkak", align 8
+  %437 = icmp eq ptr %436, null
+  br i1 %437, label %_llgo_67, label %_llgo_68
+
+_llgo_67:                                         ; preds = %_llgo_66
+  %438 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %434, ptr %435)
+  store ptr %438, ptr @"_llgo_itab$Rnvku_R73IHCgPy8kwNzOPAR0TOc5S5qQFkAds6zkak", align 8
+  br label %_llgo_68
+
+_llgo_68:                                         ; preds = %_llgo_67, %_llgo_66
+  %439 = load ptr, ptr @"*_llgo_main.T5", align 8
+  %440 = icmp eq ptr %439, null
+  br i1 %440, label %_llgo_69, label %_llgo_70
+
+_llgo_69:                                         ; preds = %_llgo_68
+  %441 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %371)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %441)
+  store ptr %441, ptr @"*_llgo_main.T5", align 8
+  br label %_llgo_70
+
+_llgo_70:                                         ; preds = %_llgo_69, %_llgo_68
+  %442 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %443 = load ptr, ptr @"*_llgo_main.T5", align 8
+  %444 = load ptr, ptr @"_llgo_itab$aXT9QLpZbpydMAVM7z8HP79SgepxD6o4rkzH9CN4-Q0", align 8
+  %445 = icmp eq ptr %444, null
+  br i1 %445, label %_llgo_71, label %_llgo_72
+
+_llgo_71:                                         ; preds = %_llgo_70
+  %446 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %442, ptr %443)
+  store ptr %446, ptr @"_llgo_itab$aXT9QLpZbpydMAVM7z8HP79SgepxD6o4rkzH9CN4-Q0", align 8
+  br label %_llgo_72
+
+_llgo_72:                                         ; preds = %_llgo_71, %_llgo_70
+  %447 = load ptr, ptr @_llgo_main.T6, align 8
+  %448 = icmp eq ptr %447, null
+  br i1 %448, label %_llgo_73, label %_llgo_74
+
+_llgo_73:                                         ; preds = %_llgo_72
+  %449 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 19, i64 1, i64 1)
+  store ptr %449, ptr @_llgo_main.T6, align 8
+  br label %_llgo_74
+
+_llgo_74:                                         ; preds = %_llgo_73, %_llgo_72
+  %450 = load ptr, ptr @_llgo_int, align 8
+  %451 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
+  %452 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %453 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %452, i32 0, i32 0
+  store ptr %451, ptr %453, align 8
+  %454 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %452, i32 0, i32 1
+  store i64 0, ptr %454, align 4
+  %455 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %452, i32 0, i32 2
+  store i64 0, ptr %455, align 4
+  %456 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %452, align 8
+  %457 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
+  %458 = getelementptr ptr, ptr %457, i64 0
+  store ptr %450, ptr %458, align 8
+  %459 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %460 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %459, i32 0, i32 0
+  store ptr %457, ptr %460, align 8
+  %461 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %459, i32 0, i32 1
+  store i64 1, ptr %461, align 4
+  %462 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %459, i32 0, i32 2
+  store i64 1, ptr %462, align 4
+  %463 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %459, align 8
+  %464 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %456, %"github.com/goplus/llgo/internal/runtime.Slice" %463, i1 false, ptr @"__llgo_call._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", ptr @"__llgo_makefunc._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA")
+  %465 = call ptr @"github.com/goplus/llgo/internal/runtime.Closure"(ptr %464)
+  store ptr %465, ptr @"main.struct$2bSfJcCYDdttnIT-JASAjsTNUZvojBt4mPXFJdH4M10", align 8
+  %466 = load ptr, ptr @"main.struct$2bSfJcCYDdttnIT-JASAjsTNUZvojBt4mPXFJdH4M10", align 8
+  br i1 %448, label %_llgo_75, label %_llgo_76
+
+_llgo_75:                                         ; preds = %_llgo_74
+  %467 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %468 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %467, i32 0, i32 0
+  store ptr @2, ptr %468, align 8
+  %469 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %467, i32 0, i32 1
+  store i64 6, ptr %469, align 4
+  %470 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %467, align 8
+  %471 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %472 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %473 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %472, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %470, ptr %473, align 8
+  %474 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %472, i32 0, i32 1
+  store ptr %471, ptr %474, align 8
+  %475 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %472, i32 0, i32 2
+  store ptr @"main.(*T6).Invoke", ptr %475, align 8
+  %476 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %472, i32 0, i32 3
+  store ptr @"main.(*T6).Invoke", ptr %476, align 8
+  %477 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %472, align 8
+  %478 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %479 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %478, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %470, ptr %479, align 8
+  %480 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %478, i32 0, i32 1
+  store ptr %471, ptr %480, align 8
+  %481 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %478, i32 0, i32 2
+  store ptr @"main.(*T6).Invoke", ptr %481, align 8
+  %482 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %478, i32 0, i32 3
+  store ptr @main.T6.Invoke, ptr %482, align 8
+  %483 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %478, align 8
+  %484 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %485 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %484, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %483, ptr %485, align 8
+  %486 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %487 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %486, i32 0, i32 0
+  store ptr %484, ptr %487, align 8
+  %488 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %486, i32 0, i32 1
+  store i64 1, ptr %488, align 4
+  %489 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %486, i32 0, i32 2
+  store i64 1, ptr %489, align 4
+  %490 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %486, align 8
+  %491 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %492 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %491, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %477, ptr %492, align 8
+  %493 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %494 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %493, i32 0, i32 0
+  store ptr %491, ptr %494, align 8
+  %495 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %493, i32 0, i32 1
+  store i64 1, ptr %495, align 4
+  %496 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %493, i32 0, i32 2
+  store i64 1, ptr %496, align 4
+  %497 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %493, align 8
+  %498 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %499 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %498, i32 0, i32 0
+  store ptr @16, ptr %499, align 8
+  %500 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %498, i32 0, i32 1
+  store i64 4, ptr %500, align 4
+  %501 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %498, align 8
+  %502 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %503 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %502, i32 0, i32 0
+  store ptr @25, ptr %503, align 8
+  %504 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %502, i32 0, i32 1
+  store i64 2, ptr %504, align 4
+  %505 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %502, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %449, %"github.com/goplus/llgo/internal/runtime.String" %501, %"github.com/goplus/llgo/internal/runtime.String" %505, ptr %466, %"github.com/goplus/llgo/internal/runtime.Slice" %490, %"github.com/goplus/llgo/internal/runtime.Slice" %497)
+  br label %_llgo_76
+
+_llgo_76:                                         ; preds = %_llgo_75, %_llgo_74
+  %506 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %507 = load ptr, ptr @_llgo_main.T6, align 8
+  %508 = load ptr, ptr @"_llgo_itab$eVlYlqRdZNPb4aUPP1khQH3_7wWK9f1ueZKN_YrFyeQ", align 8
+  %509 = icmp eq ptr %508, null
+  br i1 %509, label %_llgo_77, label %_llgo_78
+
+_llgo_77:                                         ; preds = %_llgo_76
+  %510 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %506, ptr %507)
+  store ptr %510, ptr @"_llgo_itab$eVlYlqRdZNPb4aUPP1khQH3_7wWK9f1ueZKN_YrFyeQ", align 8
+  br label %_llgo_78
+
+_llgo_78:                                         ; preds = %_llgo_77, %_llgo_76
+  %511 = load ptr, ptr @"*_llgo_main.T6", align 8
+  %512 = icmp eq ptr %511, null
+  br i1 %512, label %_llgo_79, label %_llgo_80
+
+_llgo_79:                                         ; preds = %_llgo_78
+  %513 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %449)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %513)
+  store ptr %513, ptr @"*_llgo_main.T6", align 8
+  br label %_llgo_80
+
+_llgo_80:                                         ; preds = %_llgo_79, %_llgo_78
+  %514 = load ptr, ptr @"_llgo_iface$uRUteI7wmSy7y7ODhGzk0FdDaxGKMhVSSu6HZEv9aa0", align 8
+  %515 = load ptr, ptr @"*_llgo_main.T6", align 8
+  %516 = load ptr, ptr @"_llgo_itab$7nHI-csud8YyvyE92kzHWn4_mnf6brffcjgpvYFf6Pg", align 8
+  %517 = icmp eq ptr %516, null
+  br i1 %517, label %_llgo_81, label %_llgo_82
+
+_llgo_81:                                         ; preds = %_llgo_80
+  %518 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %514, ptr %515)
+  store ptr %518, ptr @"_llgo_itab$7nHI-csud8YyvyE92kzHWn4_mnf6brffcjgpvYFf6Pg", align 8
+  br label %_llgo_82
+
+_llgo_82:                                         ; preds = %_llgo_81, %_llgo_80
+  %519 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %520 = load ptr, ptr @"_llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac", align 8
+  %521 = load ptr, ptr @"_llgo_iface$jwmSdgh1zvY_TDIgLzCkvkbiyrdwl9N806DH0JGcyMI", align 8
+  %522 = icmp eq ptr %521, null
+  br i1 %522, label %_llgo_83, label %_llgo_84
+
+_llgo_83:                                         ; preds = %_llgo_82
+  %523 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %524 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %523, i32 0, i32 0
+  store ptr @2, ptr %524, align 8
+  %525 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %523, i32 0, i32 1
+  store i64 6, ptr %525, align 4
+  %526 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %523, align 8
+  %527 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %528 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %527, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %526, ptr %528, align 8
+  %529 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %527, i32 0, i32 1
+  store ptr %519, ptr %529, align 8
+  %530 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %527, align 8
+  %531 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %532 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %531, i32 0, i32 0
+  store ptr @17, ptr %532, align 8
+  %533 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %531, i32 0, i32 1
+  store i64 6, ptr %533, align 4
+  %534 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %531, align 8
+  %535 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %536 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %535, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %534, ptr %536, align 8
+  %537 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %535, i32 0, i32 1
+  store ptr %520, ptr %537, align 8
+  %538 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %535, align 8
+  %539 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 48)
+  %540 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %539, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %530, ptr %540, align 8
+  %541 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %539, i64 1
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %538, ptr %541, align 8
+  %542 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %543 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %542, i32 0, i32 0
+  store ptr %539, ptr %543, align 8
+  %544 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %542, i32 0, i32 1
+  store i64 2, ptr %544, align 4
+  %545 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %542, i32 0, i32 2
+  store i64 2, ptr %545, align 4
+  %546 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %542, align 8
+  %547 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %548 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %547, i32 0, i32 0
+  store ptr @16, ptr %548, align 8
+  %549 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %547, i32 0, i32 1
+  store i64 4, ptr %549, align 4
+  %550 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %547, align 8
+  %551 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %552 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %551, i32 0, i32 0
+  store ptr null, ptr %552, align 8
+  %553 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %551, i32 0, i32 1
+  store i64 0, ptr %553, align 4
+  %554 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %551, align 8
+  %555 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %550, %"github.com/goplus/llgo/internal/runtime.String" %554, %"github.com/goplus/llgo/internal/runtime.Slice" %546)
+  store ptr %555, ptr @"_llgo_iface$jwmSdgh1zvY_TDIgLzCkvkbiyrdwl9N806DH0JGcyMI", align 8
+  br label %_llgo_84
+
+_llgo_84:                                         ; preds = %_llgo_83, %_llgo_82
+  %556 = load ptr, ptr @"_llgo_iface$jwmSdgh1zvY_TDIgLzCkvkbiyrdwl9N806DH0JGcyMI", align 8
+  %557 = load ptr, ptr @"*_llgo_main.T", align 8
+  %558 = load ptr, ptr @"_llgo_itab$atYyK_vn8Z3BsDUVBOBJ1mJMV69mvnUi1jlbBDQ6AiM", align 8
+  %559 = icmp eq ptr %558, null
+  br i1 %559, label %_llgo_85, label %_llgo_86
+
+_llgo_85:                                         ; preds = %_llgo_84
+  %560 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %556, ptr %557)
+  store ptr %560, ptr @"_llgo_itab$atYyK_vn8Z3BsDUVBOBJ1mJMV69mvnUi1jlbBDQ6AiM", align 8
+  br label %_llgo_86
+
+_llgo_86:                                         ; preds = %_llgo_85, %_llgo_84
+  %561 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %562 = load ptr, ptr @_llgo_main.I, align 8
+  %563 = icmp eq ptr %562, null
+  br i1 %563, label %_llgo_87, label %_llgo_88
+
+_llgo_87:                                         ; preds = %_llgo_86
+  %564 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %565 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %564, i32 0, i32 0
+  store ptr @2, ptr %565, align 8
+  %566 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %564, i32 0, i32 1
+  store i64 6, ptr %566, align 4
+  %567 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %564, align 8
+  %568 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %569 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %568, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %567, ptr %569, align 8
+  %570 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %568, i32 0, i32 1
+  store ptr %561, ptr %570, align 8
+  %571 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %568, align 8
+  %572 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
+  %573 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %572, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %571, ptr %573, align 8
+  %574 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %575 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %574, i32 0, i32 0
+  store ptr %572, ptr %575, align 8
+  %576 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %574, i32 0, i32 1
+  store i64 1, ptr %576, align 4
+  %577 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %574, i32 0, i32 2
+  store i64 1, ptr %577, align 4
+  %578 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %574, align 8
+  %579 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %580 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %579, i32 0, i32 0
+  store ptr @16, ptr %580, align 8
+  %581 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %579, i32 0, i32 1
+  store i64 4, ptr %581, align 4
+  %582 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %579, align 8
+  %583 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %584 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %583, i32 0, i32 0
+  store ptr @27, ptr %584, align 8
+  %585 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %583, i32 0, i32 1
+  store i64 6, ptr %585, align 4
+  %586 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %583, align 8
+  %587 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %582, %"github.com/goplus/llgo/internal/runtime.String" %586, %"github.com/goplus/llgo/internal/runtime.Slice" %578)
+  store ptr %587, ptr @_llgo_main.I, align 8
+  br label %_llgo_88
+
+_llgo_88:                                         ; preds = %_llgo_87, %_llgo_86
+  %588 = load ptr, ptr @_llgo_any, align 8
+  %589 = icmp eq ptr %588, null
+  br i1 %589, label %_llgo_89, label %_llgo_90
+
+_llgo_89:                                         ; preds = %_llgo_88
+  %590 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
+  %591 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %592 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %591, i32 0, i32 0
+  store ptr %590, ptr %592, align 8
+  %593 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %591, i32 0, i32 1
+  store i64 0, ptr %593, align 4
+  %594 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %591, i32 0, i32 2
+  store i64 0, ptr %594, align 4
+  %595 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %591, align 8
+  %596 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %597 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %596, i32 0, i32 0
+  store ptr @16, ptr %597, align 8
+  %598 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %596, i32 0, i32 1
+  store i64 4, ptr %598, align 4
+  %599 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %596, align 8
+  %600 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %601 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %600, i32 0, i32 0
+  store ptr null, ptr %601, align 8
+  %602 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %600, i32 0, i32 1
+  store i64 0, ptr %602, align 4
+  %603 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %600, align 8
+  %604 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %599, %"github.com/goplus/llgo/internal/runtime.String" %603, %"github.com/goplus/llgo/internal/runtime.Slice" %595)
+  store ptr %604, ptr @_llgo_any, align 8
+  br label %_llgo_90
+
+_llgo_90:                                         ; preds = %_llgo_89, %_llgo_88
   ret void
 }
 
@@ -1735,10 +1875,54 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", ptr, %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice")
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1)
+declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1, ptr, ptr)
+
+define linkonce void @"__llgo_call._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call i64 %6(ptr %5)
+  %8 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  store i64 %7, ptr %8, align 4
+  ret void
+}
+
+define linkonce i64 @"__llgo_makefunc._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { i64 }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  %7 = load i64, ptr %6, align 4
+  ret i64 %7
+}
 
 declare void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr)
 
+define linkonce void @"__llgo_call._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  call void %6(ptr %5)
+  ret void
+}
+
+define linkonce void @"__llgo_makefunc._llgo_func$2_iS07vIlF2_rZqWB5eU0IvP_9HviM4MYZNkXZDvbac"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca {}, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  ret void
+}
+
 declare ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.Slice")
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr, ptr)
@@ -1747,10 +1931,14 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.ArrayOf"(i64, ptr)
 
+declare ptr @"github.com/goplus/llgo/internal/runtime.Closure"(ptr)
+
 declare ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface")
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface")
 
 declare i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr, ptr)
 
-declare void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface")
+declare void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr, ptr)
+
+!0 = !{}
@@ -42,9 +42,11 @@ source_filename = "main"
 @10 = private unnamed_addr constant [5 x i8] c"Close", align 1
 @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w" = linkonce global ptr null, align 8
 @11 = private unnamed_addr constant [17 x i8] c"nopCloserWriterTo", align 1
+@"main.itab$PxQpmCE1Zl44k8WmRpodOaw6MpG7ipoCcUmKYy0DnTs" = global ptr null, align 8
 @"_llgo_iface$L2Ik-AJcd0jsoBw5fQ07pQpfUM-kh78Wn2bOeak6M3I" = linkonce global ptr null, align 8
 @_llgo_main.nopCloser = global ptr null, align 8
 @12 = private unnamed_addr constant [9 x i8] c"nopCloser", align 1
+@"main.itab$tsa2aHcJJ8kb5cXnl7FCMXubKcgko6rrMsRq7vLA6q0" = global ptr null, align 8
 @_llgo_main.StringWriter = linkonce global ptr null, align 8
 @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw" = linkonce global ptr null, align 8
 @13 = private unnamed_addr constant [11 x i8] c"WriteString", align 1
@@ -78,211 +80,213 @@ source_filename = "main"
 @28 = private unnamed_addr constant [10 x i8] c"UnreadRune", align 1
 @29 = private unnamed_addr constant [12 x i8] c"stringReader", align 1
 @"*_llgo_main.stringReader" = global ptr null, align 8
+@"main.itab$_9fBinrjivE22YXwHtKlRmczfYziQ4pej4h8feUsm40" = global ptr null, align 8
 @"_llgo_iface$OFO8Us9n8ajWCabGedeuoJ-Za2zAMk4Jh0FunAcUCFE" = linkonce global ptr null, align 8
 @_llgo_main.errorString = global ptr null, align 8
 @"main.struct$QTufDJA9wEDzuzgkA-ZSrLqW-B6lWN8O25mTSglAoLQ" = global ptr null, align 8
 @30 = private unnamed_addr constant [11 x i8] c"errorString", align 1
 @"*_llgo_main.errorString" = global ptr null, align 8
+@"main.itab$YYlqK2rl9kDO_RaJgVxNyvHoshlule1bVD5jPBTXCXM" = global ptr null, align 8
 @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU" = linkonce global ptr null, align 8
-@31 = private unnamed_addr constant [21 x i8] c"type assertion failed", align 1
-@32 = private unnamed_addr constant [37 x i8] c"stringsReader.ReadAt: negative offset", align 1
-@33 = private unnamed_addr constant [34 x i8] c"stringsReader.Seek: invalid whence", align 1
-@34 = private unnamed_addr constant [37 x i8] c"stringsReader.Seek: negative position", align 1
-@35 = private unnamed_addr constant [48 x i8] c"stringsReader.UnreadByte: at beginning of string", align 1
-@36 = private unnamed_addr constant [49 x i8] c"strings.Reader.UnreadRune: at beginning of string", align 1
-@37 = private unnamed_addr constant [62 x i8] c"strings.Reader.UnreadRune: previous operation was not ReadRune", align 1
-@38 = private unnamed_addr constant [48 x i8] c"stringsReader.WriteTo: invalid WriteString count", align 1
+@31 = private unnamed_addr constant [14 x i8] c"main.nopCloser", align 1
+@32 = private unnamed_addr constant [22 x i8] c"main.nopCloserWriterTo", align 1
+@33 = private unnamed_addr constant [37 x i8] c"stringsReader.ReadAt: negative offset", align 1
+@34 = private unnamed_addr constant [34 x i8] c"stringsReader.Seek: invalid whence", align 1
+@35 = private unnamed_addr constant [37 x i8] c"stringsReader.Seek: negative position", align 1
+@36 = private unnamed_addr constant [48 x i8] c"stringsReader.UnreadByte: at beginning of string", align 1
+@37 = private unnamed_addr constant [49 x i8] c"strings.Reader.UnreadRune: at beginning of string", align 1
+@38 = private unnamed_addr constant [62 x i8] c"strings.Reader.UnreadRune: previous operation was not ReadRune", align 1
+@39 = private unnamed_addr constant [48 x i8] c"stringsReader.WriteTo: invalid WriteString count", align 1
 
 define %"github.com/goplus/llgo/internal/runtime.iface" @main.NopCloser(%"github.com/goplus/llgo/internal/runtime.iface" %0) {
 _llgo_0:
-  %1 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %0)
-  %2 = load ptr, ptr @_llgo_main.WriterTo, align 8
-  %3 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %2, ptr %1)
-  br i1 %3, label %_llgo_3, label %_llgo_4
+  %1 = alloca %main.nopCloserWriterTo, align 8
+  %2 = alloca %main.nopCloser, align 8
+  %3 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %0)
+  %4 = load ptr, ptr @_llgo_main.WriterTo, align 8
+  %5 = call i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr %4, ptr %3)
+  br i1 %5, label %_llgo_3, label %_llgo_4
 
 _llgo_1:                                          ; preds = %_llgo_5
-  %4 = alloca %main.nopCloserWriterTo, align 8
-  %5 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %4, i64 16)
-  %6 = getelementptr inbounds %main.nopCloserWriterTo, ptr %5, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.iface" %0, ptr %6, align 8
-  %7 = load %main.nopCloserWriterTo, ptr %5, align 8
-  %8 = load ptr, ptr @_llgo_main.nopCloserWriterTo, align 8
-  %9 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %main.nopCloserWriterTo %7, ptr %9, align 8
-  %10 = load ptr, ptr @"_llgo_iface$L2Ik-AJcd0jsoBw5fQ07pQpfUM-kh78Wn2bOeak6M3I", align 8
-  %11 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %10, ptr %8)
+  %6 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %1, i64 16)
+  %7 = getelementptr inbounds %main.nopCloserWriterTo, ptr %6, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %0, ptr %7, align 8
+  %8 = load %main.nopCloserWriterTo, ptr %6, align 8
+  %9 = load ptr, ptr @_llgo_main.nopCloserWriterTo, align 8
+  %10 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %main.nopCloserWriterTo %8, ptr %10, align 8
+  %11 = load ptr, ptr @"main.itab$PxQpmCE1Zl44k8WmRpodOaw6MpG7ipoCcUmKYy0DnTs", align 8
   %12 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
   %13 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, i32 0, i32 0
   store ptr %11, ptr %13, align 8
   %14 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, i32 0, i32 1
-  store ptr %9, ptr %14, align 8
+  store ptr %10, ptr %14, align 8
   %15 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, align 8
   ret %"github.com/goplus/llgo/internal/runtime.iface" %15
 
 _llgo_2:                                          ; preds = %_llgo_5
-  %16 = alloca %main.nopCloser, align 8
-  %17 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %16, i64 16)
-  %18 = getelementptr inbounds %main.nopCloser, ptr %17, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.iface" %0, ptr %18, align 8
-  %19 = load %main.nopCloser, ptr %17, align 8
-  %20 = load ptr, ptr @_llgo_main.nopCloser, align 8
-  %21 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %main.nopCloser %19, ptr %21, align 8
-  %22 = load ptr, ptr @"_llgo_iface$L2Ik-AJcd0jsoBw5fQ07pQpfUM-kh78Wn2bOeak6M3I", align 8
-  %23 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %22, ptr %20)
-  %24 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %25 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %24, i32 0, i32 0
-  store ptr %23, ptr %25, align 8
-  %26 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %24, i32 0, i32 1
-  store ptr %21, ptr %26, align 8
-  %27 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %24, align 8
-  ret %"github.com/goplus/llgo/internal/runtime.iface" %27
+  %16 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %2, i64 16)
+  %17 = getelementptr inbounds %main.nopCloser, ptr %16, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %0, ptr %17, align 8
+  %18 = load %main.nopCloser, ptr %16, align 8
+  %19 = load ptr, ptr @_llgo_main.nopCloser, align 8
+  %20 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %main.nopCloser %18, ptr %20, align 8
+  %21 = load ptr, ptr @"main.itab$tsa2aHcJJ8kb5cXnl7FCMXubKcgko6rrMsRq7vLA6q0", align 8
+  %22 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %23 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %22, i32 0, i32 0
+  store ptr %21, ptr %23, align 8
+  %24 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %22, i32 0, i32 1
+  store ptr %20, ptr %24, align 8
+  %25 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %22, align 8
+  ret %"github.com/goplus/llgo/internal/runtime.iface" %25
 
 _llgo_3:                                          ; preds = %_llgo_0
-  %28 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %0, 1
-  %29 = load ptr, ptr @"_llgo_iface$eN81k1zqixGTyagHw_4nqH4mGfwwehTOCTXUlbT9kzk", align 8
-  %30 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %29, ptr %1)
-  %31 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %32 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %31, i32 0, i32 0
-  store ptr %30, ptr %32, align 8
-  %33 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %31, i32 0, i32 1
-  store ptr %28, ptr %33, align 8
-  %34 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %31, align 8
-  %35 = alloca { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, align 8
-  %36 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %35, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.iface" %34, ptr %36, align 8
-  %37 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %35, i32 0, i32 1
-  store i1 true, ptr %37, align 1
-  %38 = load { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %35, align 8
+  %26 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %0, 1
+  %27 = load ptr, ptr @"_llgo_iface$eN81k1zqixGTyagHw_4nqH4mGfwwehTOCTXUlbT9kzk", align 8
+  %28 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %27, ptr %3)
+  %29 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %30 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %29, i32 0, i32 0
+  store ptr %28, ptr %30, align 8
+  %31 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %29, i32 0, i32 1
+  store ptr %26, ptr %31, align 8
+  %32 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %29, align 8
+  %33 = alloca { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, align 8
+  %34 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %33, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %32, ptr %34, align 8
+  %35 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %33, i32 0, i32 1
+  store i1 true, ptr %35, align 1
+  %36 = load { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %33, align 8
   br label %_llgo_5
 
 _llgo_4:                                          ; preds = %_llgo_0
-  %39 = alloca { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, align 8
-  %40 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %39, i32 0, i32 0
-  store { ptr, ptr } zeroinitializer, ptr %40, align 8
-  %41 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %39, i32 0, i32 1
-  store i1 false, ptr %41, align 1
-  %42 = load { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %39, align 8
+  %37 = alloca { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, align 8
+  %38 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %37, i32 0, i32 0
+  store { ptr, ptr } zeroinitializer, ptr %38, align 8
+  %39 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %37, i32 0, i32 1
+  store i1 false, ptr %39, align 1
+  %40 = load { %"github.com/goplus/llgo/internal/runtime.iface", i1 }, ptr %37, align 8
   br label %_llgo_5
 
 _llgo_5:                                          ; preds = %_llgo_4, %_llgo_3
-  %43 = phi { %"github.com/goplus/llgo/internal/runtime.iface", i1 } [ %38, %_llgo_3 ], [ %42, %_llgo_4 ]
-  %44 = extractvalue { %"github.com/goplus/llgo/internal/runtime.iface", i1 } %43, 0
-  %45 = extractvalue { %"github.com/goplus/llgo/internal/runtime.iface", i1 } %43, 1
-  br i1 %45, label %_llgo_1, label %_llgo_2
+  %41 = phi { %"github.com/goplus/llgo/internal/runtime.iface", i1 } [ %36, %_llgo_3 ], [ %40, %_llgo_4 ]
+  %42 = extractvalue { %"github.com/goplus/llgo/internal/runtime.iface", i1 } %41, 0
+  %43 = extractvalue { %"github.com/goplus/llgo/internal/runtime.iface", i1 } %41, 1
+  br i1 %43, label %_llgo_1, label %_llgo_2
 }
 
 define { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } @main.ReadAll(%"github.com/goplus/llgo/internal/runtime.iface" %0) {
 _llgo_0:
-  %1 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 512)
-  %2 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %1, i64 1, i64 512, i64 0, i64 0, i64 512)
+  %1 = alloca [1 x i8], align 1
+  %2 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 512)
+  %3 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %2, i64 1, i64 512, i64 0, i64 0, i64 512)
   br label %_llgo_1
 
 _llgo_1:                                          ; preds = %_llgo_6, %_llgo_3, %_llgo_0
-  %3 = phi %"github.com/goplus/llgo/internal/runtime.Slice" [ %2, %_llgo_0 ], [ %26, %_llgo_3 ], [ %75, %_llgo_6 ]
-  %4 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %3, 1
-  %5 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %3, 2
-  %6 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %3, 2
-  %7 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %3, 0
-  %8 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %7, i64 1, i64 %6, i64 %4, i64 %5, i64 %6)
-  %9 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %0)
-  %10 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %0, 0
-  %11 = getelementptr ptr, ptr %10, i64 3
-  %12 = load ptr, ptr %11, align 8
-  %13 = alloca { ptr, ptr }, align 8
-  %14 = getelementptr inbounds { ptr, ptr }, ptr %13, i32 0, i32 0
-  store ptr %12, ptr %14, align 8
-  %15 = getelementptr inbounds { ptr, ptr }, ptr %13, i32 0, i32 1
-  store ptr %9, ptr %15, align 8
-  %16 = load { ptr, ptr }, ptr %13, align 8
-  %17 = extractvalue { ptr, ptr } %16, 1
-  %18 = extractvalue { ptr, ptr } %16, 0
-  %19 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %18(ptr %17, %"github.com/goplus/llgo/internal/runtime.Slice" %8)
-  %20 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %19, 0
-  %21 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %19, 1
-  %22 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %3, 1
-  %23 = add i64 %22, %20
-  %24 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %3, 2
-  %25 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %3, 0
-  %26 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %25, i64 1, i64 %24, i64 0, i64 %23, i64 %24)
-  %27 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %21)
-  %28 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %21, 1
-  %29 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %30 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %29, i32 0, i32 0
-  store ptr %27, ptr %30, align 8
-  %31 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %29, i32 0, i32 1
+  %4 = phi %"github.com/goplus/llgo/internal/runtime.Slice" [ %3, %_llgo_0 ], [ %27, %_llgo_3 ], [ %76, %_llgo_6 ]
+  %5 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %4, 1
+  %6 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %4, 2
+  %7 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %4, 2
+  %8 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %4, 0
+  %9 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %8, i64 1, i64 %7, i64 %5, i64 %6, i64 %7)
+  %10 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %0)
+  %11 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %0, 0
+  %12 = getelementptr ptr, ptr %11, i64 3
+  %13 = load ptr, ptr %12, align 8, !nonnull !0
+  %14 = alloca { ptr, ptr }, align 8
+  %15 = getelementptr inbounds { ptr, ptr }, ptr %14, i32 0, i32 0
+  store ptr %13, ptr %15, align 8
+  %16 = getelementptr inbounds { ptr, ptr }, ptr %14, i32 0, i32 1
+  store ptr %10, ptr %16, align 8
+  %17 = load { ptr, ptr }, ptr %14, align 8
+  %18 = extractvalue { ptr, ptr } %17, 1
+  %19 = extractvalue { ptr, ptr } %17, 0
+  %20 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %19(ptr %18, %"github.com/goplus/llgo/internal/runtime.Slice" %9)
+  %21 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %20, 0
+  %22 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %20, 1
+  %23 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %4, 1
+  %24 = add i64 %23, %21
+  %25 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %4, 2
+  %26 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %4, 0
+  %27 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %26, i64 1, i64 %25, i64 0, i64 %24, i64 %25)
+  %28 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %22)
+  %29 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %22, 1
+  %30 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %31 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %30, i32 0, i32 0
   store ptr %28, ptr %31, align 8
-  %32 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %29, align 8
-  %33 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" zeroinitializer)
-  %34 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %35 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %34, i32 0, i32 0
-  store ptr %33, ptr %35, align 8
-  %36 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %34, i32 0, i32 1
-  store ptr null, ptr %36, align 8
-  %37 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %34, align 8
-  %38 = call i1 @"github.com/goplus/llgo/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/internal/runtime.eface" %32, %"github.com/goplus/llgo/internal/runtime.eface" %37)
-  %39 = xor i1 %38, true
-  br i1 %39, label %_llgo_2, label %_llgo_3
+  %32 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %30, i32 0, i32 1
+  store ptr %29, ptr %32, align 8
+  %33 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %30, align 8
+  %34 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" zeroinitializer)
+  %35 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %36 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %35, i32 0, i32 0
+  store ptr %34, ptr %36, align 8
+  %37 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %35, i32 0, i32 1
+  store ptr null, ptr %37, align 8
+  %38 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %35, align 8
+  %39 = call i1 @"github.com/goplus/llgo/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/internal/runtime.eface" %33, %"github.com/goplus/llgo/internal/runtime.eface" %38)
+  %40 = xor i1 %39, true
+  br i1 %40, label %_llgo_2, label %_llgo_3
 
 _llgo_2:                                          ; preds = %_llgo_1
-  %40 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr @main.EOF, align 8
-  %41 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %21)
-  %42 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %21, 1
-  %43 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %44 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %43, i32 0, i32 0
-  store ptr %41, ptr %44, align 8
-  %45 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %43, i32 0, i32 1
+  %41 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr @main.EOF, align 8
+  %42 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %22)
+  %43 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %22, 1
+  %44 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %45 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %44, i32 0, i32 0
   store ptr %42, ptr %45, align 8
-  %46 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %43, align 8
-  %47 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %40)
-  %48 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %40, 1
-  %49 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %50 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %49, i32 0, i32 0
-  store ptr %47, ptr %50, align 8
-  %51 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %49, i32 0, i32 1
+  %46 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %44, i32 0, i32 1
+  store ptr %43, ptr %46, align 8
+  %47 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %44, align 8
+  %48 = call ptr @"github.com/goplus/llgo/internal/runtime.IfaceType"(%"github.com/goplus/llgo/internal/runtime.iface" %41)
+  %49 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %41, 1
+  %50 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %51 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, i32 0, i32 0
   store ptr %48, ptr %51, align 8
-  %52 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %49, align 8
-  %53 = call i1 @"github.com/goplus/llgo/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/internal/runtime.eface" %46, %"github.com/goplus/llgo/internal/runtime.eface" %52)
-  br i1 %53, label %_llgo_4, label %_llgo_5
+  %52 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, i32 0, i32 1
+  store ptr %49, ptr %52, align 8
+  %53 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, align 8
+  %54 = call i1 @"github.com/goplus/llgo/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/internal/runtime.eface" %47, %"github.com/goplus/llgo/internal/runtime.eface" %53)
+  br i1 %54, label %_llgo_4, label %_llgo_5
 
 _llgo_3:                                          ; preds = %_llgo_1
-  %54 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %26, 1
-  %55 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %26, 2
-  %56 = icmp eq i64 %54, %55
-  br i1 %56, label %_llgo_6, label %_llgo_1
+  %55 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %27, 1
+  %56 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %27, 2
+  %57 = icmp eq i64 %55, %56
+  br i1 %57, label %_llgo_6, label %_llgo_1
 
 _llgo_4:                                          ; preds = %_llgo_2
   br label %_llgo_5
 
 _llgo_5:                                          ; preds = %_llgo_4, %_llgo_2
-  %57 = phi %"github.com/goplus/llgo/internal/runtime.iface" [ %21, %_llgo_2 ], [ zeroinitializer, %_llgo_4 ]
-  %58 = alloca { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
-  %59 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %58, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.Slice" %26, ptr %59, align 8
-  %60 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %58, i32 0, i32 1
-  store %"github.com/goplus/llgo/internal/runtime.iface" %57, ptr %60, align 8
-  %61 = load { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %58, align 8
-  ret { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } %61
+  %58 = phi %"github.com/goplus/llgo/internal/runtime.iface" [ %22, %_llgo_2 ], [ zeroinitializer, %_llgo_4 ]
+  %59 = alloca { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %60 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %59, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.Slice" %27, ptr %60, align 8
+  %61 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %59, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %58, ptr %61, align 8
+  %62 = load { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %59, align 8
+  ret { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } %62
 
 _llgo_6:                                          ; preds = %_llgo_3
-  %62 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 1)
-  %63 = getelementptr inbounds i8, ptr %62, i64 0
-  store i8 0, ptr %63, align 1
-  %64 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %65 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %64, i32 0, i32 0
-  store ptr %62, ptr %65, align 8
-  %66 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %64, i32 0, i32 1
-  store i64 1, ptr %66, align 4
-  %67 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %64, i32 0, i32 2
+  %63 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %1, i64 1)
+  %64 = getelementptr inbounds i8, ptr %63, i64 0
+  store i8 0, ptr %64, align 1
+  %65 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %66 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %65, i32 0, i32 0
+  store ptr %63, ptr %66, align 8
+  %67 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %65, i32 0, i32 1
   store i64 1, ptr %67, align 4
-  %68 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %64, align 8
-  %69 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %68, 0
-  %70 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %68, 1
-  %71 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.SliceAppend"(%"github.com/goplus/llgo/internal/runtime.Slice" %26, ptr %69, i64 %70, i64 1)
-  %72 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %26, 1
-  %73 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %71, 2
-  %74 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %71, 0
-  %75 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %74, i64 1, i64 %73, i64 0, i64 %72, i64 %73)
+  %68 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %65, i32 0, i32 2
+  store i64 1, ptr %68, align 4
+  %69 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %65, align 8
+  %70 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %69, 0
+  %71 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %69, 1
+  %72 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.SliceAppend"(%"github.com/goplus/llgo/internal/runtime.Slice" %27, ptr %70, i64 %71, i64 1)
+  %73 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %27, 1
+  %74 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %72, 2
+  %75 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %72, 0
+  %76 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %75, i64 1, i64 %74, i64 0, i64 %73, i64 %74)
   br label %_llgo_1
 }
 
@@ -297,7 +301,7 @@ _llgo_1:                                          ; preds = %_llgo_5
   %5 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %56)
   %6 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %56, 0
   %7 = getelementptr ptr, ptr %6, i64 3
-  %8 = load ptr, ptr %7, align 8
+  %8 = load ptr, ptr %7, align 8, !nonnull !0
   %9 = alloca { ptr, ptr }, align 8
   %10 = getelementptr inbounds { ptr, ptr }, ptr %9, i32 0, i32 0
   store ptr %8, ptr %10, align 8
@@ -322,7 +326,7 @@ _llgo_2:                                          ; preds = %_llgo_5
   %23 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %0)
   %24 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %0, 0
   %25 = getelementptr ptr, ptr %24, i64 3
-  %26 = load ptr, ptr %25, align 8
+  %26 = load ptr, ptr %25, align 8, !nonnull !0
   %27 = alloca { ptr, ptr }, align 8
   %28 = getelementptr inbounds { ptr, ptr }, ptr %27, i32 0, i32 0
   store ptr %26, ptr %28, align 8
@@ -430,21 +434,20 @@ _llgo_0:
   %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %4, align 8
   store %"github.com/goplus/llgo/internal/runtime.String" %7, ptr %3, align 8
   %8 = load ptr, ptr @"*_llgo_main.stringReader", align 8
-  %9 = load ptr, ptr @"_llgo_iface$OFO8Us9n8ajWCabGedeuoJ-Za2zAMk4Jh0FunAcUCFE", align 8
-  %10 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %9, ptr %8)
-  %11 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %12 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %11, i32 0, i32 0
-  store ptr %10, ptr %12, align 8
-  %13 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %11, i32 0, i32 1
-  store ptr %2, ptr %13, align 8
-  %14 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %11, align 8
-  %15 = call { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } @main.ReadAll(%"github.com/goplus/llgo/internal/runtime.iface" %14)
-  %16 = extractvalue { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } %15, 0
-  %17 = extractvalue { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } %15, 1
-  %18 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromBytes"(%"github.com/goplus/llgo/internal/runtime.Slice" %16)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %18)
+  %9 = load ptr, ptr @"main.itab$_9fBinrjivE22YXwHtKlRmczfYziQ4pej4h8feUsm40", align 8
+  %10 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %11 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %10, i32 0, i32 0
+  store ptr %9, ptr %11, align 8
+  %12 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %10, i32 0, i32 1
+  store ptr %2, ptr %12, align 8
+  %13 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %10, align 8
+  %14 = call { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } @main.ReadAll(%"github.com/goplus/llgo/internal/runtime.iface" %13)
+  %15 = extractvalue { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } %14, 0
+  %16 = extractvalue { %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.iface" } %14, 1
+  %17 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromBytes"(%"github.com/goplus/llgo/internal/runtime.Slice" %15)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %17)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface" %17)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface" %16)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret i32 0
 }
@@ -455,15 +458,14 @@ _llgo_0:
   %2 = getelementptr inbounds %main.errorString, ptr %1, i32 0, i32 0
   store %"github.com/goplus/llgo/internal/runtime.String" %0, ptr %2, align 8
   %3 = load ptr, ptr @"*_llgo_main.errorString", align 8
-  %4 = load ptr, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
-  %5 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %4, ptr %3)
-  %6 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
-  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %6, i32 0, i32 0
-  store ptr %5, ptr %7, align 8
-  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %6, i32 0, i32 1
-  store ptr %1, ptr %8, align 8
-  %9 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %6, align 8
-  ret %"github.com/goplus/llgo/internal/runtime.iface" %9
+  %4 = load ptr, ptr @"main.itab$YYlqK2rl9kDO_RaJgVxNyvHoshlule1bVD5jPBTXCXM", align 8
+  %5 = alloca %"github.com/goplus/llgo/internal/runtime.iface", align 8
+  %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %5, i32 0, i32 0
+  store ptr %4, ptr %6, align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.iface", ptr %5, i32 0, i32 1
+  store ptr %1, ptr %7, align 8
+  %8 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %5, align 8
+  ret %"github.com/goplus/llgo/internal/runtime.iface" %8
 }
 
 define %"github.com/goplus/llgo/internal/runtime.iface" @main.nopCloser.Close(%main.nopCloser %0) {
@@ -481,7 +483,7 @@ _llgo_0:
   %6 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %5)
   %7 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %5, 0
   %8 = getelementptr ptr, ptr %7, i64 3
-  %9 = load ptr, ptr %8, align 8
+  %9 = load ptr, ptr %8, align 8, !nonnull !0
   %10 = alloca { ptr, ptr }, align 8
   %11 = getelementptr inbounds { ptr, ptr }, ptr %10, i32 0, i32 0
   store ptr %9, ptr %11, align 8
@@ -504,9 +506,23 @@ _llgo_0:
 
 define %"github.com/goplus/llgo/internal/runtime.iface" @"main.(*nopCloser).Close"(ptr %0) {
 _llgo_0:
-  %1 = load %main.nopCloser, ptr %0, align 8
-  %2 = call %"github.com/goplus/llgo/internal/runtime.iface" @main.nopCloser.Close(%main.nopCloser %1)
-  ret %"github.com/goplus/llgo/internal/runtime.iface" %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @31, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 14, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @10, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 5, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load %main.nopCloser, ptr %0, align 8
+  %11 = call %"github.com/goplus/llgo/internal/runtime.iface" @main.nopCloser.Close(%main.nopCloser %10)
+  ret %"github.com/goplus/llgo/internal/runtime.iface" %11
 }
 
 define { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"main.(*nopCloser).Read"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
@@ -516,7 +532,7 @@ _llgo_0:
   %4 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %3)
   %5 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %3, 0
   %6 = getelementptr ptr, ptr %5, i64 3
-  %7 = load ptr, ptr %6, align 8
+  %7 = load ptr, ptr %6, align 8, !nonnull !0
   %8 = alloca { ptr, ptr }, align 8
   %9 = getelementptr inbounds { ptr, ptr }, ptr %8, i32 0, i32 0
   store ptr %7, ptr %9, align 8
@@ -552,7 +568,7 @@ _llgo_0:
   %6 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %5)
   %7 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %5, 0
   %8 = getelementptr ptr, ptr %7, i64 3
-  %9 = load ptr, ptr %8, align 8
+  %9 = load ptr, ptr %8, align 8, !nonnull !0
   %10 = alloca { ptr, ptr }, align 8
   %11 = getelementptr inbounds { ptr, ptr }, ptr %10, i32 0, i32 0
   store ptr %9, ptr %11, align 8
@@ -598,7 +614,7 @@ _llgo_1:                                          ; preds = %_llgo_0
   %16 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %15)
   %17 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %15, 0
   %18 = getelementptr ptr, ptr %17, i64 3
-  %19 = load ptr, ptr %18, align 8
+  %19 = load ptr, ptr %18, align 8, !nonnull !0
   %20 = alloca { ptr, ptr }, align 8
   %21 = getelementptr inbounds { ptr, ptr }, ptr %20, i32 0, i32 0
   store ptr %19, ptr %21, align 8
@@ -619,30 +635,29 @@ _llgo_1:                                          ; preds = %_llgo_0
   ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %32
 
 _llgo_2:                                          ; preds = %_llgo_0
-  %33 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %34 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %33, i32 0, i32 0
-  store ptr @31, ptr %34, align 8
-  %35 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %33, i32 0, i32 1
-  store i64 21, ptr %35, align 4
-  %36 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %33, align 8
-  %37 = load ptr, ptr @_llgo_string, align 8
-  %38 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %36, ptr %38, align 8
-  %39 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %40 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %39, i32 0, i32 0
-  store ptr %37, ptr %40, align 8
-  %41 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %39, i32 0, i32 1
-  store ptr %38, ptr %41, align 8
-  %42 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %39, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %42)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %6, ptr %7)
   unreachable
 }
 
 define %"github.com/goplus/llgo/internal/runtime.iface" @"main.(*nopCloserWriterTo).Close"(ptr %0) {
 _llgo_0:
-  %1 = load %main.nopCloserWriterTo, ptr %0, align 8
-  %2 = call %"github.com/goplus/llgo/internal/runtime.iface" @main.nopCloserWriterTo.Close(%main.nopCloserWriterTo %1)
-  ret %"github.com/goplus/llgo/internal/runtime.iface" %2
+  %1 = icmp eq ptr %0, null
+  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
+  store ptr @32, ptr %3, align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
+  store i64 22, ptr %4, align 4
+  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
+  %6 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 0
+  store ptr @10, ptr %7, align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %6, i32 0, i32 1
+  store i64 5, ptr %8, align 4
+  %9 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %1, %"github.com/goplus/llgo/internal/runtime.String" %5, %"github.com/goplus/llgo/internal/runtime.String" %9)
+  %10 = load %main.nopCloserWriterTo, ptr %0, align 8
+  %11 = call %"github.com/goplus/llgo/internal/runtime.iface" @main.nopCloserWriterTo.Close(%main.nopCloserWriterTo %10)
+  ret %"github.com/goplus/llgo/internal/runtime.iface" %11
 }
 
 define { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"main.(*nopCloserWriterTo).Read"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
@@ -652,7 +667,7 @@ _llgo_0:
   %4 = call ptr @"github.com/goplus/llgo/internal/runtime.IfacePtrData"(%"github.com/goplus/llgo/internal/runtime.iface" %3)
   %5 = extractvalue %"github.com/goplus/llgo/internal/runtime.iface" %3, 0
   %6 = getelementptr ptr, ptr %5, i64 3
-  %7 = load ptr, ptr %6, align 8
+  %7 = load ptr, ptr %6, align 8, !nonnull !0
   %8 = alloca { ptr, ptr }, align 8
   %9 = getelementptr inbounds { ptr, ptr }, ptr %8, i32 0, i32 0
   store ptr %7, ptr %9, align 8
@@ -675,17 +690,31 @@ _llgo_0:
 
 define { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"main.(*nopCloserWriterTo).WriteTo"(ptr %0, %"github.com/goplus/llgo/internal/runtime.iface" %1) {
 _llgo_0:
-  %2 = load %main.nopCloserWriterTo, ptr %0, align 8
-  %3 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @main.nopCloserWriterTo.WriteTo(%main.nopCloserWriterTo %2, %"github.com/goplus/llgo/internal/runtime.iface" %1)
-  %4 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %3, 0
-  %5 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %3, 1
-  %6 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
-  %7 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %6, i32 0, i32 0
-  store i64 %4, ptr %7, align 4
-  %8 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %6, i32 0, i32 1
-  store %"github.com/goplus/llgo/internal/runtime.iface" %5, ptr %8, align 8
-  %9 = load { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %6, align 8
-  ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %9
+  %2 = icmp eq ptr %0, null
+  %3 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 0
+  store ptr @32, ptr %4, align 8
+  %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %3, i32 0, i32 1
+  store i64 22, ptr %5, align 4
+  %6 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %3, align 8
+  %7 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %7, i32 0, i32 0
+  store ptr @5, ptr %8, align 8
+  %9 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %7, i32 0, i32 1
+  store i64 7, ptr %9, align 4
+  %10 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %7, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1 %2, %"github.com/goplus/llgo/internal/runtime.String" %6, %"github.com/goplus/llgo/internal/runtime.String" %10)
+  %11 = load %main.nopCloserWriterTo, ptr %0, align 8
+  %12 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @main.nopCloserWriterTo.WriteTo(%main.nopCloserWriterTo %11, %"github.com/goplus/llgo/internal/runtime.iface" %1)
+  %13 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %12, 0
+  %14 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %12, 1
+  %15 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %16 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %15, i32 0, i32 0
+  store i64 %13, ptr %16, align 4
+  %17 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %15, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %14, ptr %17, align 8
+  %18 = load { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %15, align 8
+  ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %18
 }
 
 define i64 @"main.(*stringReader).Len"(ptr %0) {
@@ -765,7 +794,7 @@ _llgo_0:
 _llgo_1:                                          ; preds = %_llgo_0
   %4 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 0
-  store ptr @32, ptr %5, align 8
+  store ptr @33, ptr %5, align 8
   %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 1
   store i64 37, ptr %6, align 4
   %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %4, align 8
@@ -997,7 +1026,7 @@ _llgo_6:                                          ; preds = %_llgo_4
 _llgo_7:                                          ; preds = %_llgo_6
   %16 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %17 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %16, i32 0, i32 0
-  store ptr @33, ptr %17, align 8
+  store ptr @34, ptr %17, align 8
   %18 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %16, i32 0, i32 1
   store i64 34, ptr %18, align 4
   %19 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %16, align 8
@@ -1013,7 +1042,7 @@ _llgo_7:                                          ; preds = %_llgo_6
 _llgo_8:                                          ; preds = %_llgo_1
   %25 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %26 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %25, i32 0, i32 0
-  store ptr @34, ptr %26, align 8
+  store ptr @35, ptr %26, align 8
   %27 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %25, i32 0, i32 1
   store i64 37, ptr %27, align 4
   %28 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %25, align 8
@@ -1056,7 +1085,7 @@ _llgo_0:
 _llgo_1:                                          ; preds = %_llgo_0
   %4 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 0
-  store ptr @35, ptr %5, align 8
+  store ptr @36, ptr %5, align 8
   %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 1
   store i64 48, ptr %6, align 4
   %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %4, align 8
@@ -1084,7 +1113,7 @@ _llgo_0:
 _llgo_1:                                          ; preds = %_llgo_0
   %4 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 0
-  store ptr @36, ptr %5, align 8
+  store ptr @37, ptr %5, align 8
   %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 1
   store i64 49, ptr %6, align 4
   %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %4, align 8
@@ -1100,7 +1129,7 @@ _llgo_2:                                          ; preds = %_llgo_0
 _llgo_3:                                          ; preds = %_llgo_2
   %12 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %13 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %12, i32 0, i32 0
-  store ptr @37, ptr %13, align 8
+  store ptr @38, ptr %13, align 8
   %14 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %12, i32 0, i32 1
   store i64 62, ptr %14, align 4
   %15 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %12, align 8
@@ -1155,7 +1184,7 @@ _llgo_2:                                          ; preds = %_llgo_0
 _llgo_3:                                          ; preds = %_llgo_2
   %24 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %25 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %24, i32 0, i32 0
-  store ptr @38, ptr %25, align 8
+  store ptr @39, ptr %25, align 8
   %26 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %24, i32 0, i32 1
   store i64 48, ptr %26, align 4
   %27 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %24, align 8
@@ -1278,7 +1307,7 @@ _llgo_7:                                          ; preds = %_llgo_6
   %26 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %23, i32 0, i32 2
   store i64 1, ptr %26, align 4
   %27 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %23, align 8
-  %28 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %20, %"github.com/goplus/llgo/internal/runtime.Slice" %27, i1 false)
+  %28 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %20, %"github.com/goplus/llgo/internal/runtime.Slice" %27, i1 false, ptr @"__llgo_call._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", ptr @"__llgo_makefunc._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %28)
   store ptr %28, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
   br label %_llgo_8
@@ -1360,7 +1389,7 @@ _llgo_11:                                         ; preds = %_llgo_10
   %72 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %69, i32 0, i32 2
   store i64 2, ptr %72, align 4
   %73 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %69, align 8
-  %74 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %65, %"github.com/goplus/llgo/internal/runtime.Slice" %73, i1 false)
+  %74 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %65, %"github.com/goplus/llgo/internal/runtime.Slice" %73, i1 false, ptr @"__llgo_call._llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", ptr @"__llgo_makefunc._llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %74)
   store ptr %74, ptr @"_llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", align 8
   br label %_llgo_12
@@ -1454,7 +1483,7 @@ _llgo_17:                                         ; preds = %_llgo_16
   %123 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %120, i32 0, i32 2
   store i64 2, ptr %123, align 4
   %124 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %120, align 8
-  %125 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %116, %"github.com/goplus/llgo/internal/runtime.Slice" %124, i1 false)
+  %125 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %116, %"github.com/goplus/llgo/internal/runtime.Slice" %124, i1 false, ptr @"__llgo_call._llgo_func$MrYxYl10p_I07B55pBsGw9la9zbzU2vGDPLWrT714Uk", ptr @"__llgo_makefunc._llgo_func$MrYxYl10p_I07B55pBsGw9la9zbzU2vGDPLWrT714Uk")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %125)
   store ptr %125, ptr @"_llgo_func$MrYxYl10p_I07B55pBsGw9la9zbzU2vGDPLWrT714Uk", align 8
   br label %_llgo_18
@@ -1664,7 +1693,7 @@ _llgo_25:                                         ; preds = %_llgo_24
   %248 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %245, i32 0, i32 2
   store i64 1, ptr %248, align 4
   %249 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %245, align 8
-  %250 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %242, %"github.com/goplus/llgo/internal/runtime.Slice" %249, i1 false)
+  %250 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %242, %"github.com/goplus/llgo/internal/runtime.Slice" %249, i1 false, ptr @"__llgo_call._llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", ptr @"__llgo_makefunc._llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w")
   call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %250)
   store ptr %250, ptr @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", align 8
   br label %_llgo_26
@@ -1849,926 +1878,942 @@ _llgo_27:                                         ; preds = %_llgo_26
   br label %_llgo_28
 
 _llgo_28:                                         ; preds = %_llgo_27, %_llgo_26
-  %361 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 2, i64 2)
-  store ptr %361, ptr @_llgo_main.nopCloser, align 8
-  %362 = load ptr, ptr @"_llgo_struct$_3ow4zXXILqvC0WDqDRNq5DPhjE1DInJgN924VHWc2Y", align 8
-  %363 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %364 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %363, i32 0, i32 0
-  store ptr @10, ptr %364, align 8
-  %365 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %363, i32 0, i32 1
-  store i64 5, ptr %365, align 4
-  %366 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %363, align 8
-  %367 = load ptr, ptr @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", align 8
-  %368 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %369 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %368, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %366, ptr %369, align 8
-  %370 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %368, i32 0, i32 1
-  store ptr %367, ptr %370, align 8
-  %371 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %368, i32 0, i32 2
-  store ptr @"main.(*nopCloser).Close", ptr %371, align 8
-  %372 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %368, i32 0, i32 3
-  store ptr @"main.(*nopCloser).Close", ptr %372, align 8
-  %373 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %368, align 8
-  %374 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %375 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %374, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %366, ptr %375, align 8
-  %376 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %374, i32 0, i32 1
-  store ptr %367, ptr %376, align 8
-  %377 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %374, i32 0, i32 2
-  store ptr @"main.(*nopCloser).Close", ptr %377, align 8
-  %378 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %374, i32 0, i32 3
-  store ptr @main.nopCloser.Close, ptr %378, align 8
-  %379 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %374, align 8
-  %380 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %381 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %380, i32 0, i32 0
-  store ptr @8, ptr %381, align 8
-  %382 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %380, i32 0, i32 1
-  store i64 4, ptr %382, align 4
-  %383 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %380, align 8
-  %384 = load ptr, ptr @"_llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", align 8
-  %385 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %386 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %385, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %383, ptr %386, align 8
-  %387 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %385, i32 0, i32 1
-  store ptr %384, ptr %387, align 8
-  %388 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %385, i32 0, i32 2
-  store ptr @"main.(*nopCloser).Read", ptr %388, align 8
-  %389 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %385, i32 0, i32 3
-  store ptr @"main.(*nopCloser).Read", ptr %389, align 8
-  %390 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %385, align 8
-  %391 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %392 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %391, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %383, ptr %392, align 8
-  %393 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %391, i32 0, i32 1
-  store ptr %384, ptr %393, align 8
-  %394 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %391, i32 0, i32 2
-  store ptr @"main.(*nopCloser).Read", ptr %394, align 8
-  %395 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %391, i32 0, i32 3
-  store ptr @main.nopCloser.Read, ptr %395, align 8
-  %396 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %391, align 8
-  %397 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 80)
-  %398 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %397, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %379, ptr %398, align 8
-  %399 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %397, i64 1
-  store %"github.com/goplus/llgo/internal/abi.Method" %396, ptr %399, align 8
-  %400 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %401 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %400, i32 0, i32 0
-  store ptr %397, ptr %401, align 8
-  %402 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %400, i32 0, i32 1
-  store i64 2, ptr %402, align 4
-  %403 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %400, i32 0, i32 2
-  store i64 2, ptr %403, align 4
-  %404 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %400, align 8
-  %405 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 80)
-  %406 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %405, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %373, ptr %406, align 8
-  %407 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %405, i64 1
-  store %"github.com/goplus/llgo/internal/abi.Method" %390, ptr %407, align 8
-  %408 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %409 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %408, i32 0, i32 0
-  store ptr %405, ptr %409, align 8
-  %410 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %408, i32 0, i32 1
-  store i64 2, ptr %410, align 4
-  %411 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %408, i32 0, i32 2
-  store i64 2, ptr %411, align 4
-  %412 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %408, align 8
-  %413 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %414 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %413, i32 0, i32 0
-  store ptr @1, ptr %414, align 8
-  %415 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %413, i32 0, i32 1
-  store i64 4, ptr %415, align 4
-  %416 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %413, align 8
-  %417 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %418 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %417, i32 0, i32 0
-  store ptr @12, ptr %418, align 8
-  %419 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %417, i32 0, i32 1
-  store i64 9, ptr %419, align 4
-  %420 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %417, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %361, %"github.com/goplus/llgo/internal/runtime.String" %416, %"github.com/goplus/llgo/internal/runtime.String" %420, ptr %362, %"github.com/goplus/llgo/internal/runtime.Slice" %404, %"github.com/goplus/llgo/internal/runtime.Slice" %412)
-  %421 = load ptr, ptr @_llgo_string, align 8
-  %422 = load ptr, ptr @_llgo_int, align 8
-  %423 = load ptr, ptr @_llgo_error, align 8
-  %424 = load ptr, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
-  %425 = icmp eq ptr %424, null
-  br i1 %425, label %_llgo_29, label %_llgo_30
+  %361 = load ptr, ptr @"_llgo_iface$L2Ik-AJcd0jsoBw5fQ07pQpfUM-kh78Wn2bOeak6M3I", align 8
+  %362 = load ptr, ptr @_llgo_main.nopCloserWriterTo, align 8
+  %363 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %361, ptr %362)
+  store ptr %363, ptr @"main.itab$PxQpmCE1Zl44k8WmRpodOaw6MpG7ipoCcUmKYy0DnTs", align 8
+  %364 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 2, i64 2)
+  store ptr %364, ptr @_llgo_main.nopCloser, align 8
+  %365 = load ptr, ptr @"_llgo_struct$_3ow4zXXILqvC0WDqDRNq5DPhjE1DInJgN924VHWc2Y", align 8
+  %366 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %367 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %366, i32 0, i32 0
+  store ptr @10, ptr %367, align 8
+  %368 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %366, i32 0, i32 1
+  store i64 5, ptr %368, align 4
+  %369 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %366, align 8
+  %370 = load ptr, ptr @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", align 8
+  %371 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %372 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %371, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %369, ptr %372, align 8
+  %373 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %371, i32 0, i32 1
+  store ptr %370, ptr %373, align 8
+  %374 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %371, i32 0, i32 2
+  store ptr @"main.(*nopCloser).Close", ptr %374, align 8
+  %375 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %371, i32 0, i32 3
+  store ptr @"main.(*nopCloser).Close", ptr %375, align 8
+  %376 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %371, align 8
+  %377 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %378 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %377, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %369, ptr %378, align 8
+  %379 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %377, i32 0, i32 1
+  store ptr %370, ptr %379, align 8
+  %380 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %377, i32 0, i32 2
+  store ptr @"main.(*nopCloser).Close", ptr %380, align 8
+  %381 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %377, i32 0, i32 3
+  store ptr @main.nopCloser.Close, ptr %381, align 8
+  %382 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %377, align 8
+  %383 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %384 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %383, i32 0, i32 0
+  store ptr @8, ptr %384, align 8
+  %385 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %383, i32 0, i32 1
+  store i64 4, ptr %385, align 4
+  %386 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %383, align 8
+  %387 = load ptr, ptr @"_llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", align 8
+  %388 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %389 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %388, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %386, ptr %389, align 8
+  %390 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %388, i32 0, i32 1
+  store ptr %387, ptr %390, align 8
+  %391 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %388, i32 0, i32 2
+  store ptr @"main.(*nopCloser).Read", ptr %391, align 8
+  %392 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %388, i32 0, i32 3
+  store ptr @"main.(*nopCloser).Read", ptr %392, align 8
+  %393 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %388, align 8
+  %394 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %395 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %394, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %386, ptr %395, align 8
+  %396 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %394, i32 0, i32 1
+  store ptr %387, ptr %396, align 8
+  %397 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %394, i32 0, i32 2
+  store ptr @"main.(*nopCloser).Read", ptr %397, align 8
+  %398 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %394, i32 0, i32 3
+  store ptr @main.nopCloser.Read, ptr %398, align 8
+  %399 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %394, align 8
+  %400 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 80)
+  %401 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %400, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %382, ptr %401, align 8
+  %402 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %400, i64 1
+  store %"github.com/goplus/llgo/internal/abi.Method" %399, ptr %402, align 8
+  %403 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %404 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %403, i32 0, i32 0
+  store ptr %400, ptr %404, align 8
+  %405 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %403, i32 0, i32 1
+  store i64 2, ptr %405, align 4
+  %406 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %403, i32 0, i32 2
+  store i64 2, ptr %406, align 4
+  %407 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %403, align 8
+  %408 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 80)
+  %409 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %408, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %376, ptr %409, align 8
+  %410 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %408, i64 1
+  store %"github.com/goplus/llgo/internal/abi.Method" %393, ptr %410, align 8
+  %411 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %412 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %411, i32 0, i32 0
+  store ptr %408, ptr %412, align 8
+  %413 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %411, i32 0, i32 1
+  store i64 2, ptr %413, align 4
+  %414 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %411, i32 0, i32 2
+  store i64 2, ptr %414, align 4
+  %415 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %411, align 8
+  %416 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %417 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %416, i32 0, i32 0
+  store ptr @1, ptr %417, align 8
+  %418 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %416, i32 0, i32 1
+  store i64 4, ptr %418, align 4
+  %419 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %416, align 8
+  %420 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %421 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %420, i32 0, i32 0
+  store ptr @12, ptr %421, align 8
+  %422 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %420, i32 0, i32 1
+  store i64 9, ptr %422, align 4
+  %423 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %420, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %364, %"github.com/goplus/llgo/internal/runtime.String" %419, %"github.com/goplus/llgo/internal/runtime.String" %423, ptr %365, %"github.com/goplus/llgo/internal/runtime.Slice" %407, %"github.com/goplus/llgo/internal/runtime.Slice" %415)
+  %424 = load ptr, ptr @"_llgo_iface$L2Ik-AJcd0jsoBw5fQ07pQpfUM-kh78Wn2bOeak6M3I", align 8
+  %425 = load ptr, ptr @_llgo_main.nopCloser, align 8
+  %426 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %424, ptr %425)
+  store ptr %426, ptr @"main.itab$tsa2aHcJJ8kb5cXnl7FCMXubKcgko6rrMsRq7vLA6q0", align 8
+  %427 = load ptr, ptr @_llgo_string, align 8
+  %428 = load ptr, ptr @_llgo_int, align 8
+  %429 = load ptr, ptr @_llgo_error, align 8
+  %430 = load ptr, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
+  %431 = icmp eq ptr %430, null
+  br i1 %431, label %_llgo_29, label %_llgo_30
 
 _llgo_29:                                         ; preds = %_llgo_28
-  %426 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %427 = getelementptr ptr, ptr %426, i64 0
-  store ptr %421, ptr %427, align 8
-  %428 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %429 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %428, i32 0, i32 0
-  store ptr %426, ptr %429, align 8
-  %430 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %428, i32 0, i32 1
-  store i64 1, ptr %430, align 4
-  %431 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %428, i32 0, i32 2
-  store i64 1, ptr %431, align 4
-  %432 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %428, align 8
-  %433 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %434 = getelementptr ptr, ptr %433, i64 0
-  store ptr %422, ptr %434, align 8
-  %435 = getelementptr ptr, ptr %433, i64 1
-  store ptr %423, ptr %435, align 8
-  %436 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %437 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %436, i32 0, i32 0
-  store ptr %433, ptr %437, align 8
-  %438 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %436, i32 0, i32 1
-  store i64 2, ptr %438, align 4
-  %439 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %436, i32 0, i32 2
-  store i64 2, ptr %439, align 4
-  %440 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %436, align 8
-  %441 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %432, %"github.com/goplus/llgo/internal/runtime.Slice" %440, i1 false)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %441)
-  store ptr %441, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
+  %432 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
+  %433 = getelementptr ptr, ptr %432, i64 0
+  store ptr %427, ptr %433, align 8
+  %434 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %435 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %434, i32 0, i32 0
+  store ptr %432, ptr %435, align 8
+  %436 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %434, i32 0, i32 1
+  store i64 1, ptr %436, align 4
+  %437 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %434, i32 0, i32 2
+  store i64 1, ptr %437, align 4
+  %438 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %434, align 8
+  %439 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  %440 = getelementptr ptr, ptr %439, i64 0
+  store ptr %428, ptr %440, align 8
+  %441 = getelementptr ptr, ptr %439, i64 1
+  store ptr %429, ptr %441, align 8
+  %442 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %443 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %442, i32 0, i32 0
+  store ptr %439, ptr %443, align 8
+  %444 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %442, i32 0, i32 1
+  store i64 2, ptr %444, align 4
+  %445 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %442, i32 0, i32 2
+  store i64 2, ptr %445, align 4
+  %446 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %442, align 8
+  %447 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %438, %"github.com/goplus/llgo/internal/runtime.Slice" %446, i1 false, ptr @"__llgo_call._llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", ptr @"__llgo_makefunc._llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw")
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %447)
+  store ptr %447, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
   br label %_llgo_30
 
 _llgo_30:                                         ; preds = %_llgo_29, %_llgo_28
-  %442 = load ptr, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
-  %443 = load ptr, ptr @_llgo_main.StringWriter, align 8
-  %444 = icmp eq ptr %443, null
-  br i1 %444, label %_llgo_31, label %_llgo_32
+  %448 = load ptr, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
+  %449 = load ptr, ptr @_llgo_main.StringWriter, align 8
+  %450 = icmp eq ptr %449, null
+  br i1 %450, label %_llgo_31, label %_llgo_32
 
 _llgo_31:                                         ; preds = %_llgo_30
-  %445 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %446 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %445, i32 0, i32 0
-  store ptr @13, ptr %446, align 8
-  %447 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %445, i32 0, i32 1
-  store i64 11, ptr %447, align 4
-  %448 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %445, align 8
-  %449 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %450 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %449, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %448, ptr %450, align 8
-  %451 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %449, i32 0, i32 1
-  store ptr %442, ptr %451, align 8
-  %452 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %449, align 8
-  %453 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
-  %454 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %453, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %452, ptr %454, align 8
-  %455 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %456 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %455, i32 0, i32 0
-  store ptr %453, ptr %456, align 8
-  %457 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %455, i32 0, i32 1
-  store i64 1, ptr %457, align 4
-  %458 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %455, i32 0, i32 2
-  store i64 1, ptr %458, align 4
-  %459 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %455, align 8
-  %460 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %461 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %460, i32 0, i32 0
-  store ptr @1, ptr %461, align 8
-  %462 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %460, i32 0, i32 1
-  store i64 4, ptr %462, align 4
-  %463 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %460, align 8
-  %464 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %465 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %464, i32 0, i32 0
-  store ptr @14, ptr %465, align 8
-  %466 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %464, i32 0, i32 1
-  store i64 17, ptr %466, align 4
-  %467 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %464, align 8
-  %468 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %463, %"github.com/goplus/llgo/internal/runtime.String" %467, %"github.com/goplus/llgo/internal/runtime.Slice" %459)
-  store ptr %468, ptr @_llgo_main.StringWriter, align 8
+  %451 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %452 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %451, i32 0, i32 0
+  store ptr @13, ptr %452, align 8
+  %453 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %451, i32 0, i32 1
+  store i64 11, ptr %453, align 4
+  %454 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %451, align 8
+  %455 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %456 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %455, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %454, ptr %456, align 8
+  %457 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %455, i32 0, i32 1
+  store ptr %448, ptr %457, align 8
+  %458 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %455, align 8
+  %459 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
+  %460 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %459, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %458, ptr %460, align 8
+  %461 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %462 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %461, i32 0, i32 0
+  store ptr %459, ptr %462, align 8
+  %463 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %461, i32 0, i32 1
+  store i64 1, ptr %463, align 4
+  %464 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %461, i32 0, i32 2
+  store i64 1, ptr %464, align 4
+  %465 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %461, align 8
+  %466 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %467 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %466, i32 0, i32 0
+  store ptr @1, ptr %467, align 8
+  %468 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %466, i32 0, i32 1
+  store i64 4, ptr %468, align 4
+  %469 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %466, align 8
+  %470 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %471 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %470, i32 0, i32 0
+  store ptr @14, ptr %471, align 8
+  %472 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %470, i32 0, i32 1
+  store i64 17, ptr %472, align 4
+  %473 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %470, align 8
+  %474 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %469, %"github.com/goplus/llgo/internal/runtime.String" %473, %"github.com/goplus/llgo/internal/runtime.Slice" %465)
+  store ptr %474, ptr @_llgo_main.StringWriter, align 8
   br label %_llgo_32
 
 _llgo_32:                                         ; preds = %_llgo_31, %_llgo_30
-  %469 = load ptr, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
-  %470 = load ptr, ptr @"_llgo_iface$Ly4zXiUMEac-hYAMw6b6miJ1JEhGfLyBWyBOhpsRZcU", align 8
-  %471 = icmp eq ptr %470, null
-  br i1 %471, label %_llgo_33, label %_llgo_34
+  %475 = load ptr, ptr @"_llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw", align 8
+  %476 = load ptr, ptr @"_llgo_iface$Ly4zXiUMEac-hYAMw6b6miJ1JEhGfLyBWyBOhpsRZcU", align 8
+  %477 = icmp eq ptr %476, null
+  br i1 %477, label %_llgo_33, label %_llgo_34
 
 _llgo_33:                                         ; preds = %_llgo_32
-  %472 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %473 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %472, i32 0, i32 0
-  store ptr @13, ptr %473, align 8
-  %474 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %472, i32 0, i32 1
-  store i64 11, ptr %474, align 4
-  %475 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %472, align 8
-  %476 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %477 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %476, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %475, ptr %477, align 8
-  %478 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %476, i32 0, i32 1
-  store ptr %469, ptr %478, align 8
-  %479 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %476, align 8
-  %480 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
-  %481 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %480, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %479, ptr %481, align 8
-  %482 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %483 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %482, i32 0, i32 0
-  store ptr %480, ptr %483, align 8
-  %484 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %482, i32 0, i32 1
-  store i64 1, ptr %484, align 4
-  %485 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %482, i32 0, i32 2
-  store i64 1, ptr %485, align 4
-  %486 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %482, align 8
-  %487 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %488 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %487, i32 0, i32 0
-  store ptr @1, ptr %488, align 8
-  %489 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %487, i32 0, i32 1
-  store i64 4, ptr %489, align 4
-  %490 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %487, align 8
-  %491 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %492 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %491, i32 0, i32 0
-  store ptr null, ptr %492, align 8
-  %493 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %491, i32 0, i32 1
-  store i64 0, ptr %493, align 4
-  %494 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %491, align 8
-  %495 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %490, %"github.com/goplus/llgo/internal/runtime.String" %494, %"github.com/goplus/llgo/internal/runtime.Slice" %486)
-  store ptr %495, ptr @"_llgo_iface$Ly4zXiUMEac-hYAMw6b6miJ1JEhGfLyBWyBOhpsRZcU", align 8
-  br label %_llgo_34
-
-_llgo_34:                                         ; preds = %_llgo_33, %_llgo_32
-  %496 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 0, i64 10)
-  store ptr %496, ptr @_llgo_main.stringReader, align 8
+  %478 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %479 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %478, i32 0, i32 0
+  store ptr @13, ptr %479, align 8
+  %480 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %478, i32 0, i32 1
+  store i64 11, ptr %480, align 4
+  %481 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %478, align 8
+  %482 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %483 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %482, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %481, ptr %483, align 8
+  %484 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %482, i32 0, i32 1
+  store ptr %475, ptr %484, align 8
+  %485 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %482, align 8
+  %486 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
+  %487 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %486, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %485, ptr %487, align 8
+  %488 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %489 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %488, i32 0, i32 0
+  store ptr %486, ptr %489, align 8
+  %490 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %488, i32 0, i32 1
+  store i64 1, ptr %490, align 4
+  %491 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %488, i32 0, i32 2
+  store i64 1, ptr %491, align 4
+  %492 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %488, align 8
+  %493 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %494 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %493, i32 0, i32 0
+  store ptr @1, ptr %494, align 8
+  %495 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %493, i32 0, i32 1
+  store i64 4, ptr %495, align 4
+  %496 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %493, align 8
   %497 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %498 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %497, i32 0, i32 0
-  store ptr @18, ptr %498, align 8
+  store ptr null, ptr %498, align 8
   %499 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %497, i32 0, i32 1
-  store i64 1, ptr %499, align 4
+  store i64 0, ptr %499, align 4
   %500 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %497, align 8
-  %501 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %502 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %501, i32 0, i32 0
-  store ptr null, ptr %502, align 8
-  %503 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %501, i32 0, i32 1
-  store i64 0, ptr %503, align 4
-  %504 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %501, align 8
-  %505 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 24)
-  %506 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %500, ptr %505, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %504, i1 false)
+  %501 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %496, %"github.com/goplus/llgo/internal/runtime.String" %500, %"github.com/goplus/llgo/internal/runtime.Slice" %492)
+  store ptr %501, ptr @"_llgo_iface$Ly4zXiUMEac-hYAMw6b6miJ1JEhGfLyBWyBOhpsRZcU", align 8
+  br label %_llgo_34
+
+_llgo_34:                                         ; preds = %_llgo_33, %_llgo_32
+  %502 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 0, i64 10)
+  store ptr %502, ptr @_llgo_main.stringReader, align 8
+  %503 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %504 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %503, i32 0, i32 0
+  store ptr @18, ptr %504, align 8
+  %505 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %503, i32 0, i32 1
+  store i64 1, ptr %505, align 4
+  %506 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %503, align 8
   %507 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %508 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %507, i32 0, i32 0
-  store ptr @19, ptr %508, align 8
+  store ptr null, ptr %508, align 8
   %509 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %507, i32 0, i32 1
-  store i64 1, ptr %509, align 4
+  store i64 0, ptr %509, align 4
   %510 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %507, align 8
-  %511 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %512 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %511, i32 0, i32 0
-  store ptr null, ptr %512, align 8
-  %513 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %511, i32 0, i32 1
-  store i64 0, ptr %513, align 4
-  %514 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %511, align 8
-  %515 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 38)
-  %516 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %510, ptr %515, i64 16, %"github.com/goplus/llgo/internal/runtime.String" %514, i1 false)
+  %511 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 24)
+  %512 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %506, ptr %511, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %510, i1 false)
+  %513 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %514 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %513, i32 0, i32 0
+  store ptr @19, ptr %514, align 8
+  %515 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %513, i32 0, i32 1
+  store i64 1, ptr %515, align 4
+  %516 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %513, align 8
   %517 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %518 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %517, i32 0, i32 0
-  store ptr @20, ptr %518, align 8
+  store ptr null, ptr %518, align 8
   %519 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %517, i32 0, i32 1
-  store i64 8, ptr %519, align 4
+  store i64 0, ptr %519, align 4
   %520 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %517, align 8
-  %521 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %522 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %521, i32 0, i32 0
-  store ptr null, ptr %522, align 8
-  %523 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %521, i32 0, i32 1
-  store i64 0, ptr %523, align 4
-  %524 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %521, align 8
-  %525 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
-  %526 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %520, ptr %525, i64 24, %"github.com/goplus/llgo/internal/runtime.String" %524, i1 false)
+  %521 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 38)
+  %522 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %516, ptr %521, i64 16, %"github.com/goplus/llgo/internal/runtime.String" %520, i1 false)
+  %523 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %524 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %523, i32 0, i32 0
+  store ptr @20, ptr %524, align 8
+  %525 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %523, i32 0, i32 1
+  store i64 8, ptr %525, align 4
+  %526 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %523, align 8
   %527 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %528 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %527, i32 0, i32 0
-  store ptr @1, ptr %528, align 8
+  store ptr null, ptr %528, align 8
   %529 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %527, i32 0, i32 1
-  store i64 4, ptr %529, align 4
+  store i64 0, ptr %529, align 4
   %530 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %527, align 8
-  %531 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 168)
-  %532 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %531, i64 0
-  store %"github.com/goplus/llgo/internal/abi.StructField" %506, ptr %532, align 8
-  %533 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %531, i64 1
-  store %"github.com/goplus/llgo/internal/abi.StructField" %516, ptr %533, align 8
-  %534 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %531, i64 2
-  store %"github.com/goplus/llgo/internal/abi.StructField" %526, ptr %534, align 8
-  %535 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %536 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %535, i32 0, i32 0
-  store ptr %531, ptr %536, align 8
-  %537 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %535, i32 0, i32 1
-  store i64 3, ptr %537, align 4
-  %538 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %535, i32 0, i32 2
-  store i64 3, ptr %538, align 4
-  %539 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %535, align 8
-  %540 = call ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplus/llgo/internal/runtime.String" %530, i64 32, %"github.com/goplus/llgo/internal/runtime.Slice" %539)
-  store ptr %540, ptr @"main.struct$Mdt84yjYYwxF9D2i4cRmpEPiWaO6tsjtrbGUjyESypk", align 8
-  %541 = load ptr, ptr @"main.struct$Mdt84yjYYwxF9D2i4cRmpEPiWaO6tsjtrbGUjyESypk", align 8
-  %542 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %543 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %542, i32 0, i32 0
-  store ptr @21, ptr %543, align 8
-  %544 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %542, i32 0, i32 1
+  %531 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
+  %532 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %526, ptr %531, i64 24, %"github.com/goplus/llgo/internal/runtime.String" %530, i1 false)
+  %533 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %534 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %533, i32 0, i32 0
+  store ptr @1, ptr %534, align 8
+  %535 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %533, i32 0, i32 1
+  store i64 4, ptr %535, align 4
+  %536 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %533, align 8
+  %537 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 168)
+  %538 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %537, i64 0
+  store %"github.com/goplus/llgo/internal/abi.StructField" %512, ptr %538, align 8
+  %539 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %537, i64 1
+  store %"github.com/goplus/llgo/internal/abi.StructField" %522, ptr %539, align 8
+  %540 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %537, i64 2
+  store %"github.com/goplus/llgo/internal/abi.StructField" %532, ptr %540, align 8
+  %541 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %542 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %541, i32 0, i32 0
+  store ptr %537, ptr %542, align 8
+  %543 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %541, i32 0, i32 1
+  store i64 3, ptr %543, align 4
+  %544 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %541, i32 0, i32 2
   store i64 3, ptr %544, align 4
-  %545 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %542, align 8
-  %546 = load ptr, ptr @_llgo_int, align 8
-  %547 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %548 = icmp eq ptr %547, null
-  br i1 %548, label %_llgo_35, label %_llgo_36
+  %545 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %541, align 8
+  %546 = call ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplus/llgo/internal/runtime.String" %536, i64 32, %"github.com/goplus/llgo/internal/runtime.Slice" %545)
+  store ptr %546, ptr @"main.struct$Mdt84yjYYwxF9D2i4cRmpEPiWaO6tsjtrbGUjyESypk", align 8
+  %547 = load ptr, ptr @"main.struct$Mdt84yjYYwxF9D2i4cRmpEPiWaO6tsjtrbGUjyESypk", align 8
+  %548 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %549 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %548, i32 0, i32 0
+  store ptr @21, ptr %549, align 8
+  %550 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %548, i32 0, i32 1
+  store i64 3, ptr %550, align 4
+  %551 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %548, align 8
+  %552 = load ptr, ptr @_llgo_int, align 8
+  %553 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %554 = icmp eq ptr %553, null
+  br i1 %554, label %_llgo_35, label %_llgo_36
 
 _llgo_35:                                         ; preds = %_llgo_34
-  %549 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
-  %550 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %551 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %550, i32 0, i32 0
-  store ptr %549, ptr %551, align 8
-  %552 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %550, i32 0, i32 1
-  store i64 0, ptr %552, align 4
-  %553 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %550, i32 0, i32 2
-  store i64 0, ptr %553, align 4
-  %554 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %550, align 8
-  %555 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %556 = getelementptr ptr, ptr %555, i64 0
-  store ptr %546, ptr %556, align 8
-  %557 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %558 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %557, i32 0, i32 0
-  store ptr %555, ptr %558, align 8
-  %559 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %557, i32 0, i32 1
-  store i64 1, ptr %559, align 4
-  %560 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %557, i32 0, i32 2
-  store i64 1, ptr %560, align 4
-  %561 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %557, align 8
-  %562 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %554, %"github.com/goplus/llgo/internal/runtime.Slice" %561, i1 false)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %562)
-  store ptr %562, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %555 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
+  %556 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %557 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %556, i32 0, i32 0
+  store ptr %555, ptr %557, align 8
+  %558 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %556, i32 0, i32 1
+  store i64 0, ptr %558, align 4
+  %559 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %556, i32 0, i32 2
+  store i64 0, ptr %559, align 4
+  %560 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %556, align 8
+  %561 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
+  %562 = getelementptr ptr, ptr %561, i64 0
+  store ptr %552, ptr %562, align 8
+  %563 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %564 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %563, i32 0, i32 0
+  store ptr %561, ptr %564, align 8
+  %565 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %563, i32 0, i32 1
+  store i64 1, ptr %565, align 4
+  %566 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %563, i32 0, i32 2
+  store i64 1, ptr %566, align 4
+  %567 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %563, align 8
+  %568 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %560, %"github.com/goplus/llgo/internal/runtime.Slice" %567, i1 false, ptr @"__llgo_call._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", ptr @"__llgo_makefunc._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA")
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %568)
+  store ptr %568, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
   br label %_llgo_36
 
 _llgo_36:                                         ; preds = %_llgo_35, %_llgo_34
-  %563 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
-  %564 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %565 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %564, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %545, ptr %565, align 8
-  %566 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %564, i32 0, i32 1
-  store ptr %563, ptr %566, align 8
-  %567 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %564, i32 0, i32 2
-  store ptr @"main.(*stringReader).Len", ptr %567, align 8
-  %568 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %564, i32 0, i32 3
-  store ptr @"main.(*stringReader).Len", ptr %568, align 8
-  %569 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %564, align 8
-  %570 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %571 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %570, i32 0, i32 0
-  store ptr @8, ptr %571, align 8
-  %572 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %570, i32 0, i32 1
-  store i64 4, ptr %572, align 4
-  %573 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %570, align 8
-  %574 = load ptr, ptr @"_llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", align 8
-  %575 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %576 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %575, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %573, ptr %576, align 8
-  %577 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %575, i32 0, i32 1
-  store ptr %574, ptr %577, align 8
-  %578 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %575, i32 0, i32 2
-  store ptr @"main.(*stringReader).Read", ptr %578, align 8
-  %579 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %575, i32 0, i32 3
-  store ptr @"main.(*stringReader).Read", ptr %579, align 8
-  %580 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %575, align 8
-  %581 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %582 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %581, i32 0, i32 0
-  store ptr @22, ptr %582, align 8
-  %583 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %581, i32 0, i32 1
-  store i64 6, ptr %583, align 4
-  %584 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %581, align 8
-  %585 = load ptr, ptr @"[]_llgo_byte", align 8
-  %586 = load ptr, ptr @_llgo_int64, align 8
-  %587 = load ptr, ptr @_llgo_int, align 8
-  %588 = load ptr, ptr @_llgo_error, align 8
-  %589 = load ptr, ptr @"_llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c", align 8
-  %590 = icmp eq ptr %589, null
-  br i1 %590, label %_llgo_37, label %_llgo_38
+  %569 = load ptr, ptr @"_llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA", align 8
+  %570 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %571 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %570, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %551, ptr %571, align 8
+  %572 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %570, i32 0, i32 1
+  store ptr %569, ptr %572, align 8
+  %573 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %570, i32 0, i32 2
+  store ptr @"main.(*stringReader).Len", ptr %573, align 8
+  %574 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %570, i32 0, i32 3
+  store ptr @"main.(*stringReader).Len", ptr %574, align 8
+  %575 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %570, align 8
+  %576 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %577 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %576, i32 0, i32 0
+  store ptr @8, ptr %577, align 8
+  %578 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %576, i32 0, i32 1
+  store i64 4, ptr %578, align 4
+  %579 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %576, align 8
+  %580 = load ptr, ptr @"_llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", align 8
+  %581 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %582 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %581, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %579, ptr %582, align 8
+  %583 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %581, i32 0, i32 1
+  store ptr %580, ptr %583, align 8
+  %584 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %581, i32 0, i32 2
+  store ptr @"main.(*stringReader).Read", ptr %584, align 8
+  %585 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %581, i32 0, i32 3
+  store ptr @"main.(*stringReader).Read", ptr %585, align 8
+  %586 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %581, align 8
+  %587 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %588 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %587, i32 0, i32 0
+  store ptr @22, ptr %588, align 8
+  %589 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %587, i32 0, i32 1
+  store i64 6, ptr %589, align 4
+  %590 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %587, align 8
+  %591 = load ptr, ptr @"[]_llgo_byte", align 8
+  %592 = load ptr, ptr @_llgo_int64, align 8
+  %593 = load ptr, ptr @_llgo_int, align 8
+  %594 = load ptr, ptr @_llgo_error, align 8
+  %595 = load ptr, ptr @"_llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c", align 8
+  %596 = icmp eq ptr %595, null
+  br i1 %596, label %_llgo_37, label %_llgo_38
 
 _llgo_37:                                         ; preds = %_llgo_36
-  %591 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %592 = getelementptr ptr, ptr %591, i64 0
-  store ptr %585, ptr %592, align 8
-  %593 = getelementptr ptr, ptr %591, i64 1
-  store ptr %586, ptr %593, align 8
-  %594 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %595 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %594, i32 0, i32 0
-  store ptr %591, ptr %595, align 8
-  %596 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %594, i32 0, i32 1
-  store i64 2, ptr %596, align 4
-  %597 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %594, i32 0, i32 2
-  store i64 2, ptr %597, align 4
-  %598 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %594, align 8
-  %599 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %600 = getelementptr ptr, ptr %599, i64 0
-  store ptr %587, ptr %600, align 8
-  %601 = getelementptr ptr, ptr %599, i64 1
-  store ptr %588, ptr %601, align 8
-  %602 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %603 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %602, i32 0, i32 0
-  store ptr %599, ptr %603, align 8
-  %604 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %602, i32 0, i32 1
-  store i64 2, ptr %604, align 4
-  %605 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %602, i32 0, i32 2
-  store i64 2, ptr %605, align 4
-  %606 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %602, align 8
-  %607 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %598, %"github.com/goplus/llgo/internal/runtime.Slice" %606, i1 false)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %607)
-  store ptr %607, ptr @"_llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c", align 8
+  %597 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  %598 = getelementptr ptr, ptr %597, i64 0
+  store ptr %591, ptr %598, align 8
+  %599 = getelementptr ptr, ptr %597, i64 1
+  store ptr %592, ptr %599, align 8
+  %600 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %601 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %600, i32 0, i32 0
+  store ptr %597, ptr %601, align 8
+  %602 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %600, i32 0, i32 1
+  store i64 2, ptr %602, align 4
+  %603 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %600, i32 0, i32 2
+  store i64 2, ptr %603, align 4
+  %604 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %600, align 8
+  %605 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  %606 = getelementptr ptr, ptr %605, i64 0
+  store ptr %593, ptr %606, align 8
+  %607 = getelementptr ptr, ptr %605, i64 1
+  store ptr %594, ptr %607, align 8
+  %608 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %609 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %608, i32 0, i32 0
+  store ptr %605, ptr %609, align 8
+  %610 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %608, i32 0, i32 1
+  store i64 2, ptr %610, align 4
+  %611 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %608, i32 0, i32 2
+  store i64 2, ptr %611, align 4
+  %612 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %608, align 8
+  %613 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %604, %"github.com/goplus/llgo/internal/runtime.Slice" %612, i1 false, ptr @"__llgo_call._llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c", ptr @"__llgo_makefunc._llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c")
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %613)
+  store ptr %613, ptr @"_llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c", align 8
   br label %_llgo_38
 
 _llgo_38:                                         ; preds = %_llgo_37, %_llgo_36
-  %608 = load ptr, ptr @"_llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c", align 8
-  %609 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %610 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %609, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %584, ptr %610, align 8
-  %611 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %609, i32 0, i32 1
-  store ptr %608, ptr %611, align 8
-  %612 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %609, i32 0, i32 2
-  store ptr @"main.(*stringReader).ReadAt", ptr %612, align 8
-  %613 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %609, i32 0, i32 3
-  store ptr @"main.(*stringReader).ReadAt", ptr %613, align 8
-  %614 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %609, align 8
-  %615 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %616 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %615, i32 0, i32 0
-  store ptr @23, ptr %616, align 8
-  %617 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %615, i32 0, i32 1
-  store i64 8, ptr %617, align 4
-  %618 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %615, align 8
-  %619 = load ptr, ptr @_llgo_byte, align 8
-  %620 = icmp eq ptr %619, null
-  br i1 %620, label %_llgo_39, label %_llgo_40
+  %614 = load ptr, ptr @"_llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c", align 8
+  %615 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %616 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %615, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %590, ptr %616, align 8
+  %617 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %615, i32 0, i32 1
+  store ptr %614, ptr %617, align 8
+  %618 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %615, i32 0, i32 2
+  store ptr @"main.(*stringReader).ReadAt", ptr %618, align 8
+  %619 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %615, i32 0, i32 3
+  store ptr @"main.(*stringReader).ReadAt", ptr %619, align 8
+  %620 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %615, align 8
+  %621 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %622 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %621, i32 0, i32 0
+  store ptr @23, ptr %622, align 8
+  %623 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %621, i32 0, i32 1
+  store i64 8, ptr %623, align 4
+  %624 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %621, align 8
+  %625 = load ptr, ptr @_llgo_byte, align 8
+  %626 = icmp eq ptr %625, null
+  br i1 %626, label %_llgo_39, label %_llgo_40
 
 _llgo_39:                                         ; preds = %_llgo_38
-  %621 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 40)
-  store ptr %621, ptr @_llgo_byte, align 8
+  %627 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 40)
+  store ptr %627, ptr @_llgo_byte, align 8
   br label %_llgo_40
 
 _llgo_40:                                         ; preds = %_llgo_39, %_llgo_38
-  %622 = load ptr, ptr @_llgo_byte, align 8
-  %623 = load ptr, ptr @_llgo_error, align 8
-  %624 = load ptr, ptr @"_llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM", align 8
-  %625 = icmp eq ptr %624, null
-  br i1 %625, label %_llgo_41, label %_llgo_42
+  %628 = load ptr, ptr @_llgo_byte, align 8
+  %629 = load ptr, ptr @_llgo_error, align 8
+  %630 = load ptr, ptr @"_llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM", align 8
+  %631 = icmp eq ptr %630, null
+  br i1 %631, label %_llgo_41, label %_llgo_42
 
 _llgo_41:                                         ; preds = %_llgo_40
-  %626 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
-  %627 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %628 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %627, i32 0, i32 0
-  store ptr %626, ptr %628, align 8
-  %629 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %627, i32 0, i32 1
-  store i64 0, ptr %629, align 4
-  %630 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %627, i32 0, i32 2
-  store i64 0, ptr %630, align 4
-  %631 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %627, align 8
-  %632 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %633 = getelementptr ptr, ptr %632, i64 0
-  store ptr %622, ptr %633, align 8
-  %634 = getelementptr ptr, ptr %632, i64 1
-  store ptr %623, ptr %634, align 8
-  %635 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %636 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %635, i32 0, i32 0
-  store ptr %632, ptr %636, align 8
-  %637 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %635, i32 0, i32 1
-  store i64 2, ptr %637, align 4
-  %638 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %635, i32 0, i32 2
-  store i64 2, ptr %638, align 4
-  %639 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %635, align 8
-  %640 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %631, %"github.com/goplus/llgo/internal/runtime.Slice" %639, i1 false)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %640)
-  store ptr %640, ptr @"_llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM", align 8
+  %632 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
+  %633 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %634 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %633, i32 0, i32 0
+  store ptr %632, ptr %634, align 8
+  %635 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %633, i32 0, i32 1
+  store i64 0, ptr %635, align 4
+  %636 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %633, i32 0, i32 2
+  store i64 0, ptr %636, align 4
+  %637 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %633, align 8
+  %638 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  %639 = getelementptr ptr, ptr %638, i64 0
+  store ptr %628, ptr %639, align 8
+  %640 = getelementptr ptr, ptr %638, i64 1
+  store ptr %629, ptr %640, align 8
+  %641 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %642 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %641, i32 0, i32 0
+  store ptr %638, ptr %642, align 8
+  %643 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %641, i32 0, i32 1
+  store i64 2, ptr %643, align 4
+  %644 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %641, i32 0, i32 2
+  store i64 2, ptr %644, align 4
+  %645 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %641, align 8
+  %646 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %637, %"github.com/goplus/llgo/internal/runtime.Slice" %645, i1 false, ptr @"__llgo_call._llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM", ptr @"__llgo_makefunc._llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM")
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %646)
+  store ptr %646, ptr @"_llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM", align 8
   br label %_llgo_42
 
 _llgo_42:                                         ; preds = %_llgo_41, %_llgo_40
-  %641 = load ptr, ptr @"_llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM", align 8
-  %642 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %643 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %642, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %618, ptr %643, align 8
-  %644 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %642, i32 0, i32 1
-  store ptr %641, ptr %644, align 8
-  %645 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %642, i32 0, i32 2
-  store ptr @"main.(*stringReader).ReadByte", ptr %645, align 8
-  %646 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %642, i32 0, i32 3
-  store ptr @"main.(*stringReader).ReadByte", ptr %646, align 8
-  %647 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %642, align 8
-  %648 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %649 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %648, i32 0, i32 0
-  store ptr @24, ptr %649, align 8
-  %650 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %648, i32 0, i32 1
-  store i64 8, ptr %650, align 4
-  %651 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %648, align 8
-  %652 = load ptr, ptr @_llgo_rune, align 8
-  %653 = icmp eq ptr %652, null
-  br i1 %653, label %_llgo_43, label %_llgo_44
+  %647 = load ptr, ptr @"_llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM", align 8
+  %648 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %649 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %648, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %624, ptr %649, align 8
+  %650 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %648, i32 0, i32 1
+  store ptr %647, ptr %650, align 8
+  %651 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %648, i32 0, i32 2
+  store ptr @"main.(*stringReader).ReadByte", ptr %651, align 8
+  %652 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %648, i32 0, i32 3
+  store ptr @"main.(*stringReader).ReadByte", ptr %652, align 8
+  %653 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %648, align 8
+  %654 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %655 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %654, i32 0, i32 0
+  store ptr @24, ptr %655, align 8
+  %656 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %654, i32 0, i32 1
+  store i64 8, ptr %656, align 4
+  %657 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %654, align 8
+  %658 = load ptr, ptr @_llgo_rune, align 8
+  %659 = icmp eq ptr %658, null
+  br i1 %659, label %_llgo_43, label %_llgo_44
 
 _llgo_43:                                         ; preds = %_llgo_42
-  %654 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 37)
-  store ptr %654, ptr @_llgo_rune, align 8
+  %660 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 37)
+  store ptr %660, ptr @_llgo_rune, align 8
   br label %_llgo_44
 
 _llgo_44:                                         ; preds = %_llgo_43, %_llgo_42
-  %655 = load ptr, ptr @_llgo_rune, align 8
-  %656 = load ptr, ptr @_llgo_int, align 8
-  %657 = load ptr, ptr @_llgo_error, align 8
-  %658 = load ptr, ptr @"_llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU", align 8
-  %659 = icmp eq ptr %658, null
-  br i1 %659, label %_llgo_45, label %_llgo_46
+  %661 = load ptr, ptr @_llgo_rune, align 8
+  %662 = load ptr, ptr @_llgo_int, align 8
+  %663 = load ptr, ptr @_llgo_error, align 8
+  %664 = load ptr, ptr @"_llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU", align 8
+  %665 = icmp eq ptr %664, null
+  br i1 %665, label %_llgo_45, label %_llgo_46
 
 _llgo_45:                                         ; preds = %_llgo_44
-  %660 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
-  %661 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %662 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %661, i32 0, i32 0
-  store ptr %660, ptr %662, align 8
-  %663 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %661, i32 0, i32 1
-  store i64 0, ptr %663, align 4
-  %664 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %661, i32 0, i32 2
-  store i64 0, ptr %664, align 4
-  %665 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %661, align 8
-  %666 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
-  %667 = getelementptr ptr, ptr %666, i64 0
-  store ptr %655, ptr %667, align 8
-  %668 = getelementptr ptr, ptr %666, i64 1
-  store ptr %656, ptr %668, align 8
-  %669 = getelementptr ptr, ptr %666, i64 2
-  store ptr %657, ptr %669, align 8
-  %670 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %671 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %670, i32 0, i32 0
-  store ptr %666, ptr %671, align 8
-  %672 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %670, i32 0, i32 1
-  store i64 3, ptr %672, align 4
-  %673 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %670, i32 0, i32 2
-  store i64 3, ptr %673, align 4
-  %674 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %670, align 8
-  %675 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %665, %"github.com/goplus/llgo/internal/runtime.Slice" %674, i1 false)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %675)
-  store ptr %675, ptr @"_llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU", align 8
+  %666 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
+  %667 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %668 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %667, i32 0, i32 0
+  store ptr %666, ptr %668, align 8
+  %669 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %667, i32 0, i32 1
+  store i64 0, ptr %669, align 4
+  %670 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %667, i32 0, i32 2
+  store i64 0, ptr %670, align 4
+  %671 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %667, align 8
+  %672 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
+  %673 = getelementptr ptr, ptr %672, i64 0
+  store ptr %661, ptr %673, align 8
+  %674 = getelementptr ptr, ptr %672, i64 1
+  store ptr %662, ptr %674, align 8
+  %675 = getelementptr ptr, ptr %672, i64 2
+  store ptr %663, ptr %675, align 8
+  %676 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %677 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %676, i32 0, i32 0
+  store ptr %672, ptr %677, align 8
+  %678 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %676, i32 0, i32 1
+  store i64 3, ptr %678, align 4
+  %679 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %676, i32 0, i32 2
+  store i64 3, ptr %679, align 4
+  %680 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %676, align 8
+  %681 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %671, %"github.com/goplus/llgo/internal/runtime.Slice" %680, i1 false, ptr @"__llgo_call._llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU", ptr @"__llgo_makefunc._llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU")
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %681)
+  store ptr %681, ptr @"_llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU", align 8
   br label %_llgo_46
 
 _llgo_46:                                         ; preds = %_llgo_45, %_llgo_44
-  %676 = load ptr, ptr @"_llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU", align 8
-  %677 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %678 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %677, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %651, ptr %678, align 8
-  %679 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %677, i32 0, i32 1
-  store ptr %676, ptr %679, align 8
-  %680 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %677, i32 0, i32 2
-  store ptr @"main.(*stringReader).ReadRune", ptr %680, align 8
-  %681 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %677, i32 0, i32 3
-  store ptr @"main.(*stringReader).ReadRune", ptr %681, align 8
-  %682 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %677, align 8
-  %683 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %684 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %683, i32 0, i32 0
-  store ptr @25, ptr %684, align 8
-  %685 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %683, i32 0, i32 1
-  store i64 4, ptr %685, align 4
-  %686 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %683, align 8
-  %687 = load ptr, ptr @_llgo_int64, align 8
-  %688 = load ptr, ptr @_llgo_int, align 8
-  %689 = load ptr, ptr @_llgo_int64, align 8
-  %690 = load ptr, ptr @_llgo_error, align 8
-  %691 = load ptr, ptr @"_llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms", align 8
-  %692 = icmp eq ptr %691, null
-  br i1 %692, label %_llgo_47, label %_llgo_48
+  %682 = load ptr, ptr @"_llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU", align 8
+  %683 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %684 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %683, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %657, ptr %684, align 8
+  %685 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %683, i32 0, i32 1
+  store ptr %682, ptr %685, align 8
+  %686 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %683, i32 0, i32 2
+  store ptr @"main.(*stringReader).ReadRune", ptr %686, align 8
+  %687 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %683, i32 0, i32 3
+  store ptr @"main.(*stringReader).ReadRune", ptr %687, align 8
+  %688 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %683, align 8
+  %689 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %690 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %689, i32 0, i32 0
+  store ptr @25, ptr %690, align 8
+  %691 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %689, i32 0, i32 1
+  store i64 4, ptr %691, align 4
+  %692 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %689, align 8
+  %693 = load ptr, ptr @_llgo_int64, align 8
+  %694 = load ptr, ptr @_llgo_int, align 8
+  %695 = load ptr, ptr @_llgo_int64, align 8
+  %696 = load ptr, ptr @_llgo_error, align 8
+  %697 = load ptr, ptr @"_llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms", align 8
+  %698 = icmp eq ptr %697, null
+  br i1 %698, label %_llgo_47, label %_llgo_48
 
 _llgo_47:                                         ; preds = %_llgo_46
-  %693 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %694 = getelementptr ptr, ptr %693, i64 0
-  store ptr %687, ptr %694, align 8
-  %695 = getelementptr ptr, ptr %693, i64 1
-  store ptr %688, ptr %695, align 8
-  %696 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %697 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %696, i32 0, i32 0
-  store ptr %693, ptr %697, align 8
-  %698 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %696, i32 0, i32 1
-  store i64 2, ptr %698, align 4
-  %699 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %696, i32 0, i32 2
-  store i64 2, ptr %699, align 4
-  %700 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %696, align 8
-  %701 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  %702 = getelementptr ptr, ptr %701, i64 0
-  store ptr %689, ptr %702, align 8
-  %703 = getelementptr ptr, ptr %701, i64 1
-  store ptr %690, ptr %703, align 8
-  %704 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %705 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %704, i32 0, i32 0
-  store ptr %701, ptr %705, align 8
-  %706 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %704, i32 0, i32 1
-  store i64 2, ptr %706, align 4
-  %707 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %704, i32 0, i32 2
-  store i64 2, ptr %707, align 4
-  %708 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %704, align 8
-  %709 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %700, %"github.com/goplus/llgo/internal/runtime.Slice" %708, i1 false)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %709)
-  store ptr %709, ptr @"_llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms", align 8
+  %699 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  %700 = getelementptr ptr, ptr %699, i64 0
+  store ptr %693, ptr %700, align 8
+  %701 = getelementptr ptr, ptr %699, i64 1
+  store ptr %694, ptr %701, align 8
+  %702 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %703 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %702, i32 0, i32 0
+  store ptr %699, ptr %703, align 8
+  %704 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %702, i32 0, i32 1
+  store i64 2, ptr %704, align 4
+  %705 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %702, i32 0, i32 2
+  store i64 2, ptr %705, align 4
+  %706 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %702, align 8
+  %707 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  %708 = getelementptr ptr, ptr %707, i64 0
+  store ptr %695, ptr %708, align 8
+  %709 = getelementptr ptr, ptr %707, i64 1
+  store ptr %696, ptr %709, align 8
+  %710 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %711 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %710, i32 0, i32 0
+  store ptr %707, ptr %711, align 8
+  %712 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %710, i32 0, i32 1
+  store i64 2, ptr %712, align 4
+  %713 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %710, i32 0, i32 2
+  store i64 2, ptr %713, align 4
+  %714 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %710, align 8
+  %715 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %706, %"github.com/goplus/llgo/internal/runtime.Slice" %714, i1 false, ptr @"__llgo_call._llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms", ptr @"__llgo_makefunc._llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms")
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %715)
+  store ptr %715, ptr @"_llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms", align 8
   br label %_llgo_48
 
 _llgo_48:                                         ; preds = %_llgo_47, %_llgo_46
-  %710 = load ptr, ptr @"_llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms", align 8
-  %711 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %712 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %711, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %686, ptr %712, align 8
-  %713 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %711, i32 0, i32 1
-  store ptr %710, ptr %713, align 8
-  %714 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %711, i32 0, i32 2
-  store ptr @"main.(*stringReader).Seek", ptr %714, align 8
-  %715 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %711, i32 0, i32 3
-  store ptr @"main.(*stringReader).Seek", ptr %715, align 8
-  %716 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %711, align 8
-  %717 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %718 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %717, i32 0, i32 0
-  store ptr @26, ptr %718, align 8
-  %719 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %717, i32 0, i32 1
-  store i64 4, ptr %719, align 4
-  %720 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %717, align 8
-  %721 = load ptr, ptr @_llgo_int64, align 8
-  %722 = load ptr, ptr @"_llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug", align 8
-  %723 = icmp eq ptr %722, null
-  br i1 %723, label %_llgo_49, label %_llgo_50
+  %716 = load ptr, ptr @"_llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms", align 8
+  %717 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %718 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %717, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %692, ptr %718, align 8
+  %719 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %717, i32 0, i32 1
+  store ptr %716, ptr %719, align 8
+  %720 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %717, i32 0, i32 2
+  store ptr @"main.(*stringReader).Seek", ptr %720, align 8
+  %721 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %717, i32 0, i32 3
+  store ptr @"main.(*stringReader).Seek", ptr %721, align 8
+  %722 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %717, align 8
+  %723 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %724 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %723, i32 0, i32 0
+  store ptr @26, ptr %724, align 8
+  %725 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %723, i32 0, i32 1
+  store i64 4, ptr %725, align 4
+  %726 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %723, align 8
+  %727 = load ptr, ptr @_llgo_int64, align 8
+  %728 = load ptr, ptr @"_llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug", align 8
+  %729 = icmp eq ptr %728, null
+  br i1 %729, label %_llgo_49, label %_llgo_50
 
 _llgo_49:                                         ; preds = %_llgo_48
-  %724 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
-  %725 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %726 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %725, i32 0, i32 0
-  store ptr %724, ptr %726, align 8
-  %727 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %725, i32 0, i32 1
-  store i64 0, ptr %727, align 4
-  %728 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %725, i32 0, i32 2
-  store i64 0, ptr %728, align 4
-  %729 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %725, align 8
-  %730 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %731 = getelementptr ptr, ptr %730, i64 0
-  store ptr %721, ptr %731, align 8
-  %732 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %733 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %732, i32 0, i32 0
-  store ptr %730, ptr %733, align 8
-  %734 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %732, i32 0, i32 1
-  store i64 1, ptr %734, align 4
-  %735 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %732, i32 0, i32 2
-  store i64 1, ptr %735, align 4
-  %736 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %732, align 8
-  %737 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %729, %"github.com/goplus/llgo/internal/runtime.Slice" %736, i1 false)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %737)
-  store ptr %737, ptr @"_llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug", align 8
+  %730 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 0)
+  %731 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %732 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %731, i32 0, i32 0
+  store ptr %730, ptr %732, align 8
+  %733 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %731, i32 0, i32 1
+  store i64 0, ptr %733, align 4
+  %734 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %731, i32 0, i32 2
+  store i64 0, ptr %734, align 4
+  %735 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %731, align 8
+  %736 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
+  %737 = getelementptr ptr, ptr %736, i64 0
+  store ptr %727, ptr %737, align 8
+  %738 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %739 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %738, i32 0, i32 0
+  store ptr %736, ptr %739, align 8
+  %740 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %738, i32 0, i32 1
+  store i64 1, ptr %740, align 4
+  %741 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %738, i32 0, i32 2
+  store i64 1, ptr %741, align 4
+  %742 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %738, align 8
+  %743 = call ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice" %735, %"github.com/goplus/llgo/internal/runtime.Slice" %742, i1 false, ptr @"__llgo_call._llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug", ptr @"__llgo_makefunc._llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug")
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %743)
+  store ptr %743, ptr @"_llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug", align 8
   br label %_llgo_50
 
 _llgo_50:                                         ; preds = %_llgo_49, %_llgo_48
-  %738 = load ptr, ptr @"_llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug", align 8
-  %739 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %740 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %739, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %720, ptr %740, align 8
-  %741 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %739, i32 0, i32 1
-  store ptr %738, ptr %741, align 8
-  %742 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %739, i32 0, i32 2
-  store ptr @"main.(*stringReader).Size", ptr %742, align 8
-  %743 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %739, i32 0, i32 3
-  store ptr @"main.(*stringReader).Size", ptr %743, align 8
-  %744 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %739, align 8
-  %745 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %746 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %745, i32 0, i32 0
-  store ptr @27, ptr %746, align 8
-  %747 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %745, i32 0, i32 1
-  store i64 10, ptr %747, align 4
-  %748 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %745, align 8
-  %749 = load ptr, ptr @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", align 8
-  %750 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %751 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %750, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %748, ptr %751, align 8
-  %752 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %750, i32 0, i32 1
-  store ptr %749, ptr %752, align 8
-  %753 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %750, i32 0, i32 2
-  store ptr @"main.(*stringReader).UnreadByte", ptr %753, align 8
-  %754 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %750, i32 0, i32 3
-  store ptr @"main.(*stringReader).UnreadByte", ptr %754, align 8
-  %755 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %750, align 8
-  %756 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %757 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %756, i32 0, i32 0
-  store ptr @28, ptr %757, align 8
-  %758 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %756, i32 0, i32 1
-  store i64 10, ptr %758, align 4
-  %759 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %756, align 8
-  %760 = load ptr, ptr @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", align 8
-  %761 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %762 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %761, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %759, ptr %762, align 8
-  %763 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %761, i32 0, i32 1
-  store ptr %760, ptr %763, align 8
-  %764 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %761, i32 0, i32 2
-  store ptr @"main.(*stringReader).UnreadRune", ptr %764, align 8
-  %765 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %761, i32 0, i32 3
-  store ptr @"main.(*stringReader).UnreadRune", ptr %765, align 8
-  %766 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %761, align 8
-  %767 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %768 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %767, i32 0, i32 0
-  store ptr @5, ptr %768, align 8
-  %769 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %767, i32 0, i32 1
-  store i64 7, ptr %769, align 4
-  %770 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %767, align 8
-  %771 = load ptr, ptr @"_llgo_func$MrYxYl10p_I07B55pBsGw9la9zbzU2vGDPLWrT714Uk", align 8
-  %772 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %773 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %772, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %770, ptr %773, align 8
-  %774 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %772, i32 0, i32 1
-  store ptr %771, ptr %774, align 8
-  %775 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %772, i32 0, i32 2
-  store ptr @"main.(*stringReader).WriteTo", ptr %775, align 8
-  %776 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %772, i32 0, i32 3
-  store ptr @"main.(*stringReader).WriteTo", ptr %776, align 8
-  %777 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %772, align 8
-  %778 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 400)
-  %779 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %569, ptr %779, align 8
-  %780 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 1
-  store %"github.com/goplus/llgo/internal/abi.Method" %580, ptr %780, align 8
-  %781 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 2
-  store %"github.com/goplus/llgo/internal/abi.Method" %614, ptr %781, align 8
-  %782 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 3
-  store %"github.com/goplus/llgo/internal/abi.Method" %647, ptr %782, align 8
-  %783 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 4
-  store %"github.com/goplus/llgo/internal/abi.Method" %682, ptr %783, align 8
-  %784 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 5
-  store %"github.com/goplus/llgo/internal/abi.Method" %716, ptr %784, align 8
-  %785 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 6
-  store %"github.com/goplus/llgo/internal/abi.Method" %744, ptr %785, align 8
-  %786 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 7
-  store %"github.com/goplus/llgo/internal/abi.Method" %755, ptr %786, align 8
-  %787 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 8
-  store %"github.com/goplus/llgo/internal/abi.Method" %766, ptr %787, align 8
-  %788 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i64 9
-  store %"github.com/goplus/llgo/internal/abi.Method" %777, ptr %788, align 8
-  %789 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %790 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %789, i32 0, i32 0
-  store ptr %778, ptr %790, align 8
-  %791 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %789, i32 0, i32 1
-  store i64 10, ptr %791, align 4
-  %792 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %789, i32 0, i32 2
-  store i64 10, ptr %792, align 4
-  %793 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %789, align 8
-  %794 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %795 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %794, i32 0, i32 0
-  store ptr @1, ptr %795, align 8
-  %796 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %794, i32 0, i32 1
-  store i64 4, ptr %796, align 4
-  %797 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %794, align 8
-  %798 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %799 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %798, i32 0, i32 0
-  store ptr @29, ptr %799, align 8
-  %800 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %798, i32 0, i32 1
-  store i64 12, ptr %800, align 4
-  %801 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %798, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %496, %"github.com/goplus/llgo/internal/runtime.String" %797, %"github.com/goplus/llgo/internal/runtime.String" %801, ptr %541, { ptr, i64, i64 } zeroinitializer, %"github.com/goplus/llgo/internal/runtime.Slice" %793)
-  %802 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %496)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %802)
-  store ptr %802, ptr @"*_llgo_main.stringReader", align 8
-  %803 = load ptr, ptr @"_llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", align 8
-  %804 = load ptr, ptr @"_llgo_iface$OFO8Us9n8ajWCabGedeuoJ-Za2zAMk4Jh0FunAcUCFE", align 8
-  %805 = icmp eq ptr %804, null
-  br i1 %805, label %_llgo_51, label %_llgo_52
+  %744 = load ptr, ptr @"_llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug", align 8
+  %745 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %746 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %745, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %726, ptr %746, align 8
+  %747 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %745, i32 0, i32 1
+  store ptr %744, ptr %747, align 8
+  %748 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %745, i32 0, i32 2
+  store ptr @"main.(*stringReader).Size", ptr %748, align 8
+  %749 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %745, i32 0, i32 3
+  store ptr @"main.(*stringReader).Size", ptr %749, align 8
+  %750 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %745, align 8
+  %751 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %752 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %751, i32 0, i32 0
+  store ptr @27, ptr %752, align 8
+  %753 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %751, i32 0, i32 1
+  store i64 10, ptr %753, align 4
+  %754 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %751, align 8
+  %755 = load ptr, ptr @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", align 8
+  %756 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %757 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %756, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %754, ptr %757, align 8
+  %758 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %756, i32 0, i32 1
+  store ptr %755, ptr %758, align 8
+  %759 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %756, i32 0, i32 2
+  store ptr @"main.(*stringReader).UnreadByte", ptr %759, align 8
+  %760 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %756, i32 0, i32 3
+  store ptr @"main.(*stringReader).UnreadByte", ptr %760, align 8
+  %761 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %756, align 8
+  %762 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %763 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %762, i32 0, i32 0
+  store ptr @28, ptr %763, align 8
+  %764 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %762, i32 0, i32 1
+  store i64 10, ptr %764, align 4
+  %765 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %762, align 8
+  %766 = load ptr, ptr @"_llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w", align 8
+  %767 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %768 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %767, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %765, ptr %768, align 8
+  %769 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %767, i32 0, i32 1
+  store ptr %766, ptr %769, align 8
+  %770 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %767, i32 0, i32 2
+  store ptr @"main.(*stringReader).UnreadRune", ptr %770, align 8
+  %771 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %767, i32 0, i32 3
+  store ptr @"main.(*stringReader).UnreadRune", ptr %771, align 8
+  %772 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %767, align 8
+  %773 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %774 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %773, i32 0, i32 0
+  store ptr @5, ptr %774, align 8
+  %775 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %773, i32 0, i32 1
+  store i64 7, ptr %775, align 4
+  %776 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %773, align 8
+  %777 = load ptr, ptr @"_llgo_func$MrYxYl10p_I07B55pBsGw9la9zbzU2vGDPLWrT714Uk", align 8
+  %778 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %779 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %776, ptr %779, align 8
+  %780 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i32 0, i32 1
+  store ptr %777, ptr %780, align 8
+  %781 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i32 0, i32 2
+  store ptr @"main.(*stringReader).WriteTo", ptr %781, align 8
+  %782 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %778, i32 0, i32 3
+  store ptr @"main.(*stringReader).WriteTo", ptr %782, align 8
+  %783 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %778, align 8
+  %784 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 400)
+  %785 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %575, ptr %785, align 8
+  %786 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 1
+  store %"github.com/goplus/llgo/internal/abi.Method" %586, ptr %786, align 8
+  %787 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 2
+  store %"github.com/goplus/llgo/internal/abi.Method" %620, ptr %787, align 8
+  %788 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 3
+  store %"github.com/goplus/llgo/internal/abi.Method" %653, ptr %788, align 8
+  %789 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 4
+  store %"github.com/goplus/llgo/internal/abi.Method" %688, ptr %789, align 8
+  %790 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 5
+  store %"github.com/goplus/llgo/internal/abi.Method" %722, ptr %790, align 8
+  %791 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 6
+  store %"github.com/goplus/llgo/internal/abi.Method" %750, ptr %791, align 8
+  %792 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 7
+  store %"github.com/goplus/llgo/internal/abi.Method" %761, ptr %792, align 8
+  %793 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 8
+  store %"github.com/goplus/llgo/internal/abi.Method" %772, ptr %793, align 8
+  %794 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %784, i64 9
+  store %"github.com/goplus/llgo/internal/abi.Method" %783, ptr %794, align 8
+  %795 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %796 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %795, i32 0, i32 0
+  store ptr %784, ptr %796, align 8
+  %797 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %795, i32 0, i32 1
+  store i64 10, ptr %797, align 4
+  %798 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %795, i32 0, i32 2
+  store i64 10, ptr %798, align 4
+  %799 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %795, align 8
+  %800 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %801 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %800, i32 0, i32 0
+  store ptr @1, ptr %801, align 8
+  %802 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %800, i32 0, i32 1
+  store i64 4, ptr %802, align 4
+  %803 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %800, align 8
+  %804 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %805 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %804, i32 0, i32 0
+  store ptr @29, ptr %805, align 8
+  %806 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %804, i32 0, i32 1
+  store i64 12, ptr %806, align 4
+  %807 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %804, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %502, %"github.com/goplus/llgo/internal/runtime.String" %803, %"github.com/goplus/llgo/internal/runtime.String" %807, ptr %547, { ptr, i64, i64 } zeroinitializer, %"github.com/goplus/llgo/internal/runtime.Slice" %799)
+  %808 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %502)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %808)
+  store ptr %808, ptr @"*_llgo_main.stringReader", align 8
+  %809 = load ptr, ptr @"_llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY", align 8
+  %810 = load ptr, ptr @"_llgo_iface$OFO8Us9n8ajWCabGedeuoJ-Za2zAMk4Jh0FunAcUCFE", align 8
+  %811 = icmp eq ptr %810, null
+  br i1 %811, label %_llgo_51, label %_llgo_52
 
 _llgo_51:                                         ; preds = %_llgo_50
-  %806 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %807 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %806, i32 0, i32 0
-  store ptr @8, ptr %807, align 8
-  %808 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %806, i32 0, i32 1
-  store i64 4, ptr %808, align 4
-  %809 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %806, align 8
-  %810 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %811 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %810, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %809, ptr %811, align 8
-  %812 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %810, i32 0, i32 1
-  store ptr %803, ptr %812, align 8
-  %813 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %810, align 8
-  %814 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
-  %815 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %814, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %813, ptr %815, align 8
-  %816 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %817 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %816, i32 0, i32 0
-  store ptr %814, ptr %817, align 8
-  %818 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %816, i32 0, i32 1
-  store i64 1, ptr %818, align 4
-  %819 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %816, i32 0, i32 2
-  store i64 1, ptr %819, align 4
-  %820 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %816, align 8
-  %821 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %822 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %821, i32 0, i32 0
-  store ptr @1, ptr %822, align 8
-  %823 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %821, i32 0, i32 1
-  store i64 4, ptr %823, align 4
-  %824 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %821, align 8
-  %825 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %826 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %825, i32 0, i32 0
-  store ptr null, ptr %826, align 8
-  %827 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %825, i32 0, i32 1
-  store i64 0, ptr %827, align 4
-  %828 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %825, align 8
-  %829 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %824, %"github.com/goplus/llgo/internal/runtime.String" %828, %"github.com/goplus/llgo/internal/runtime.Slice" %820)
-  store ptr %829, ptr @"_llgo_iface$OFO8Us9n8ajWCabGedeuoJ-Za2zAMk4Jh0FunAcUCFE", align 8
-  br label %_llgo_52
-
-_llgo_52:                                         ; preds = %_llgo_51, %_llgo_50
-  %830 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 0, i64 1)
-  store ptr %830, ptr @_llgo_main.errorString, align 8
+  %812 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %813 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %812, i32 0, i32 0
+  store ptr @8, ptr %813, align 8
+  %814 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %812, i32 0, i32 1
+  store i64 4, ptr %814, align 4
+  %815 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %812, align 8
+  %816 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %817 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %816, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %815, ptr %817, align 8
+  %818 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %816, i32 0, i32 1
+  store ptr %809, ptr %818, align 8
+  %819 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %816, align 8
+  %820 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
+  %821 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %820, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %819, ptr %821, align 8
+  %822 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %823 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %822, i32 0, i32 0
+  store ptr %820, ptr %823, align 8
+  %824 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %822, i32 0, i32 1
+  store i64 1, ptr %824, align 4
+  %825 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %822, i32 0, i32 2
+  store i64 1, ptr %825, align 4
+  %826 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %822, align 8
+  %827 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %828 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %827, i32 0, i32 0
+  store ptr @1, ptr %828, align 8
+  %829 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %827, i32 0, i32 1
+  store i64 4, ptr %829, align 4
+  %830 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %827, align 8
   %831 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %832 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %831, i32 0, i32 0
-  store ptr @18, ptr %832, align 8
+  store ptr null, ptr %832, align 8
   %833 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %831, i32 0, i32 1
-  store i64 1, ptr %833, align 4
+  store i64 0, ptr %833, align 4
   %834 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %831, align 8
-  %835 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %836 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %835, i32 0, i32 0
-  store ptr null, ptr %836, align 8
-  %837 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %835, i32 0, i32 1
-  store i64 0, ptr %837, align 4
-  %838 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %835, align 8
-  %839 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 24)
-  %840 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %834, ptr %839, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %838, i1 false)
-  %841 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %842 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %841, i32 0, i32 0
-  store ptr @1, ptr %842, align 8
-  %843 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %841, i32 0, i32 1
-  store i64 4, ptr %843, align 4
-  %844 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %841, align 8
-  %845 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 56)
-  %846 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %845, i64 0
-  store %"github.com/goplus/llgo/internal/abi.StructField" %840, ptr %846, align 8
-  %847 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %848 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %847, i32 0, i32 0
-  store ptr %845, ptr %848, align 8
-  %849 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %847, i32 0, i32 1
-  store i64 1, ptr %849, align 4
-  %850 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %847, i32 0, i32 2
-  store i64 1, ptr %850, align 4
-  %851 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %847, align 8
-  %852 = call ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplus/llgo/internal/runtime.String" %844, i64 16, %"github.com/goplus/llgo/internal/runtime.Slice" %851)
-  store ptr %852, ptr @"main.struct$QTufDJA9wEDzuzgkA-ZSrLqW-B6lWN8O25mTSglAoLQ", align 8
-  %853 = load ptr, ptr @"main.struct$QTufDJA9wEDzuzgkA-ZSrLqW-B6lWN8O25mTSglAoLQ", align 8
-  %854 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %855 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %854, i32 0, i32 0
-  store ptr @0, ptr %855, align 8
-  %856 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %854, i32 0, i32 1
-  store i64 5, ptr %856, align 4
-  %857 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %854, align 8
-  %858 = load ptr, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
-  %859 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
-  %860 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %859, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %857, ptr %860, align 8
-  %861 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %859, i32 0, i32 1
-  store ptr %858, ptr %861, align 8
-  %862 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %859, i32 0, i32 2
-  store ptr @"main.(*errorString).Error", ptr %862, align 8
-  %863 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %859, i32 0, i32 3
-  store ptr @"main.(*errorString).Error", ptr %863, align 8
-  %864 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %859, align 8
-  %865 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
-  %866 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %865, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Method" %864, ptr %866, align 8
-  %867 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %868 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %867, i32 0, i32 0
-  store ptr %865, ptr %868, align 8
-  %869 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %867, i32 0, i32 1
-  store i64 1, ptr %869, align 4
-  %870 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %867, i32 0, i32 2
-  store i64 1, ptr %870, align 4
-  %871 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %867, align 8
-  %872 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %873 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %872, i32 0, i32 0
-  store ptr @1, ptr %873, align 8
-  %874 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %872, i32 0, i32 1
-  store i64 4, ptr %874, align 4
-  %875 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %872, align 8
-  %876 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %877 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %876, i32 0, i32 0
-  store ptr @30, ptr %877, align 8
-  %878 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %876, i32 0, i32 1
-  store i64 11, ptr %878, align 4
-  %879 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %876, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %830, %"github.com/goplus/llgo/internal/runtime.String" %875, %"github.com/goplus/llgo/internal/runtime.String" %879, ptr %853, { ptr, i64, i64 } zeroinitializer, %"github.com/goplus/llgo/internal/runtime.Slice" %871)
-  %880 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %830)
-  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %880)
-  store ptr %880, ptr @"*_llgo_main.errorString", align 8
-  %881 = load ptr, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
-  %882 = load ptr, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
-  %883 = icmp eq ptr %882, null
-  br i1 %883, label %_llgo_53, label %_llgo_54
+  %835 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %830, %"github.com/goplus/llgo/internal/runtime.String" %834, %"github.com/goplus/llgo/internal/runtime.Slice" %826)
+  store ptr %835, ptr @"_llgo_iface$OFO8Us9n8ajWCabGedeuoJ-Za2zAMk4Jh0FunAcUCFE", align 8
+  br label %_llgo_52
+
+_llgo_52:                                         ; preds = %_llgo_51, %_llgo_50
+  %836 = load ptr, ptr @"_llgo_iface$OFO8Us9n8ajWCabGedeuoJ-Za2zAMk4Jh0FunAcUCFE", align 8
+  %837 = load ptr, ptr @"*_llgo_main.stringReader", align 8
+  %838 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %836, ptr %837)
+  store ptr %838, ptr @"main.itab$_9fBinrjivE22YXwHtKlRmczfYziQ4pej4h8feUsm40", align 8
+  %839 = call ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64 25, i64 0, i64 1)
+  store ptr %839, ptr @_llgo_main.errorString, align 8
+  %840 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %841 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %840, i32 0, i32 0
+  store ptr @18, ptr %841, align 8
+  %842 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %840, i32 0, i32 1
+  store i64 1, ptr %842, align 4
+  %843 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %840, align 8
+  %844 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %845 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %844, i32 0, i32 0
+  store ptr null, ptr %845, align 8
+  %846 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %844, i32 0, i32 1
+  store i64 0, ptr %846, align 4
+  %847 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %844, align 8
+  %848 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 24)
+  %849 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %843, ptr %848, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %847, i1 false)
+  %850 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %851 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %850, i32 0, i32 0
+  store ptr @1, ptr %851, align 8
+  %852 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %850, i32 0, i32 1
+  store i64 4, ptr %852, align 4
+  %853 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %850, align 8
+  %854 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 56)
+  %855 = getelementptr %"github.com/goplus/llgo/internal/abi.StructField", ptr %854, i64 0
+  store %"github.com/goplus/llgo/internal/abi.StructField" %849, ptr %855, align 8
+  %856 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %857 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %856, i32 0, i32 0
+  store ptr %854, ptr %857, align 8
+  %858 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %856, i32 0, i32 1
+  store i64 1, ptr %858, align 4
+  %859 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %856, i32 0, i32 2
+  store i64 1, ptr %859, align 4
+  %860 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %856, align 8
+  %861 = call ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplus/llgo/internal/runtime.String" %853, i64 16, %"github.com/goplus/llgo/internal/runtime.Slice" %860)
+  store ptr %861, ptr @"main.struct$QTufDJA9wEDzuzgkA-ZSrLqW-B6lWN8O25mTSglAoLQ", align 8
+  %862 = load ptr, ptr @"main.struct$QTufDJA9wEDzuzgkA-ZSrLqW-B6lWN8O25mTSglAoLQ", align 8
+  %863 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %864 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %863, i32 0, i32 0
+  store ptr @0, ptr %864, align 8
+  %865 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %863, i32 0, i32 1
+  store i64 5, ptr %865, align 4
+  %866 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %863, align 8
+  %867 = load ptr, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
+  %868 = alloca %"github.com/goplus/llgo/internal/abi.Method", align 8
+  %869 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %868, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %866, ptr %869, align 8
+  %870 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %868, i32 0, i32 1
+  store ptr %867, ptr %870, align 8
+  %871 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %868, i32 0, i32 2
+  store ptr @"main.(*errorString).Error", ptr %871, align 8
+  %872 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Method", ptr %868, i32 0, i32 3
+  store ptr @"main.(*errorString).Error", ptr %872, align 8
+  %873 = load %"github.com/goplus/llgo/internal/abi.Method", ptr %868, align 8
+  %874 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 40)
+  %875 = getelementptr %"github.com/goplus/llgo/internal/abi.Method", ptr %874, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Method" %873, ptr %875, align 8
+  %876 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %877 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %876, i32 0, i32 0
+  store ptr %874, ptr %877, align 8
+  %878 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %876, i32 0, i32 1
+  store i64 1, ptr %878, align 4
+  %879 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %876, i32 0, i32 2
+  store i64 1, ptr %879, align 4
+  %880 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %876, align 8
+  %881 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %882 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %881, i32 0, i32 0
+  store ptr @1, ptr %882, align 8
+  %883 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %881, i32 0, i32 1
+  store i64 4, ptr %883, align 4
+  %884 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %881, align 8
+  %885 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %886 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %885, i32 0, i32 0
+  store ptr @30, ptr %886, align 8
+  %887 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %885, i32 0, i32 1
+  store i64 11, ptr %887, align 4
+  %888 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %885, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr %839, %"github.com/goplus/llgo/internal/runtime.String" %884, %"github.com/goplus/llgo/internal/runtime.String" %888, ptr %862, { ptr, i64, i64 } zeroinitializer, %"github.com/goplus/llgo/internal/runtime.Slice" %880)
+  %889 = call ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr %839)
+  call void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr %889)
+  store ptr %889, ptr @"*_llgo_main.errorString", align 8
+  %890 = load ptr, ptr @"_llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to", align 8
+  %891 = load ptr, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
+  %892 = icmp eq ptr %891, null
+  br i1 %892, label %_llgo_53, label %_llgo_54
 
 _llgo_53:                                         ; preds = %_llgo_52
-  %884 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %885 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %884, i32 0, i32 0
-  store ptr @0, ptr %885, align 8
-  %886 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %884, i32 0, i32 1
-  store i64 5, ptr %886, align 4
-  %887 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %884, align 8
-  %888 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
-  %889 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %888, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %887, ptr %889, align 8
-  %890 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %888, i32 0, i32 1
-  store ptr %881, ptr %890, align 8
-  %891 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %888, align 8
-  %892 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
-  %893 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %892, i64 0
-  store %"github.com/goplus/llgo/internal/abi.Imethod" %891, ptr %893, align 8
-  %894 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %895 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %894, i32 0, i32 0
-  store ptr %892, ptr %895, align 8
-  %896 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %894, i32 0, i32 1
-  store i64 1, ptr %896, align 4
-  %897 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %894, i32 0, i32 2
-  store i64 1, ptr %897, align 4
-  %898 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %894, align 8
-  %899 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %900 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %899, i32 0, i32 0
-  store ptr @1, ptr %900, align 8
-  %901 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %899, i32 0, i32 1
-  store i64 4, ptr %901, align 4
-  %902 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %899, align 8
-  %903 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %904 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %903, i32 0, i32 0
-  store ptr null, ptr %904, align 8
-  %905 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %903, i32 0, i32 1
-  store i64 0, ptr %905, align 4
-  %906 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %903, align 8
-  %907 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %902, %"github.com/goplus/llgo/internal/runtime.String" %906, %"github.com/goplus/llgo/internal/runtime.Slice" %898)
-  store ptr %907, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
+  %893 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %894 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %893, i32 0, i32 0
+  store ptr @0, ptr %894, align 8
+  %895 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %893, i32 0, i32 1
+  store i64 5, ptr %895, align 4
+  %896 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %893, align 8
+  %897 = alloca %"github.com/goplus/llgo/internal/abi.Imethod", align 8
+  %898 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %897, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %896, ptr %898, align 8
+  %899 = getelementptr inbounds %"github.com/goplus/llgo/internal/abi.Imethod", ptr %897, i32 0, i32 1
+  store ptr %890, ptr %899, align 8
+  %900 = load %"github.com/goplus/llgo/internal/abi.Imethod", ptr %897, align 8
+  %901 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 24)
+  %902 = getelementptr %"github.com/goplus/llgo/internal/abi.Imethod", ptr %901, i64 0
+  store %"github.com/goplus/llgo/internal/abi.Imethod" %900, ptr %902, align 8
+  %903 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %904 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %903, i32 0, i32 0
+  store ptr %901, ptr %904, align 8
+  %905 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %903, i32 0, i32 1
+  store i64 1, ptr %905, align 4
+  %906 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %903, i32 0, i32 2
+  store i64 1, ptr %906, align 4
+  %907 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %903, align 8
+  %908 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %909 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %908, i32 0, i32 0
+  store ptr @1, ptr %909, align 8
+  %910 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %908, i32 0, i32 1
+  store i64 4, ptr %910, align 4
+  %911 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %908, align 8
+  %912 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %913 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %912, i32 0, i32 0
+  store ptr null, ptr %913, align 8
+  %914 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %912, i32 0, i32 1
+  store i64 0, ptr %914, align 4
+  %915 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %912, align 8
+  %916 = call ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String" %911, %"github.com/goplus/llgo/internal/runtime.String" %915, %"github.com/goplus/llgo/internal/runtime.Slice" %907)
+  store ptr %916, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
   br label %_llgo_54
 
 _llgo_54:                                         ; preds = %_llgo_53, %_llgo_52
+  %917 = load ptr, ptr @"_llgo_iface$Fh8eUJ-Gw4e6TYuajcFIOSCuqSPKAt5nS4ow7xeGXEU", align 8
+  %918 = load ptr, ptr @"*_llgo_main.errorString", align 8
+  %919 = call ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr %917, ptr %918)
+  store ptr %919, ptr @"main.itab$YYlqK2rl9kDO_RaJgVxNyvHoshlule1bVD5jPBTXCXM", align 8
   ret void
 }
 
@@ -2776,7 +2821,31 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.SliceOf"(ptr)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64)
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1)
+declare ptr @"github.com/goplus/llgo/internal/runtime.Func"(%"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice", i1, ptr, ptr)
+
+define linkonce void @"__llgo_call._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call %"github.com/goplus/llgo/internal/runtime.String" %6(ptr %5)
+  %8 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %7, ptr %8, align 8
+  ret void
+}
+
+define linkonce %"github.com/goplus/llgo/internal/runtime.String" @"__llgo_makefunc._llgo_func$zNDVRsWTIpUPKouNUS805RGX--IV9qVK8B31IZbg5to"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.String" }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %3, i32 0, i32 0
+  %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %6, align 8
+  ret %"github.com/goplus/llgo/internal/runtime.String" %7
+}
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
 
@@ -2784,6 +2853,86 @@ declare void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.Interface"(%"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.Slice")
 
+define linkonce void @"__llgo_call._llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice" }, ptr %2, i32 0, i32 0
+  %6 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %5, align 8
+  %7 = extractvalue { ptr, ptr } %4, 1
+  %8 = extractvalue { ptr, ptr } %4, 0
+  %9 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %8(ptr %7, %"github.com/goplus/llgo/internal/runtime.Slice" %6)
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %11 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %9, 0
+  store i64 %11, ptr %10, align 4
+  %12 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %13 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %9, 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %13, ptr %12, align 8
+  ret void
+}
+
+define linkonce { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"__llgo_makefunc._llgo_func$06yPPin-fnDnxFKkLLcJ1GEUhIobjPimde7T_Id_hmY"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
+_llgo_0:
+  %2 = load { ptr, ptr }, ptr %0, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.Slice" }, align 8
+  %4 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.Slice" %1, ptr %4, align 8
+  %5 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %6 = extractvalue { ptr, ptr } %2, 1
+  %7 = extractvalue { ptr, ptr } %2, 0
+  call void %7(ptr %6, ptr %3, ptr %5)
+  %8 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %5, i32 0, i32 0
+  %9 = load i64, ptr %8, align 4
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %5, i32 0, i32 1
+  %11 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %10, align 8
+  %12 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %13 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 0
+  store i64 %9, ptr %13, align 4
+  %14 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %11, ptr %14, align 8
+  %15 = load { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, align 8
+  ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %15
+}
+
+define linkonce void @"__llgo_call._llgo_func$MrYxYl10p_I07B55pBsGw9la9zbzU2vGDPLWrT714Uk"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %2, i32 0, i32 0
+  %6 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %5, align 8
+  %7 = extractvalue { ptr, ptr } %4, 1
+  %8 = extractvalue { ptr, ptr } %4, 0
+  %9 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %8(ptr %7, %"github.com/goplus/llgo/internal/runtime.iface" %6)
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %11 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %9, 0
+  store i64 %11, ptr %10, align 4
+  %12 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %13 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %9, 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %13, ptr %12, align 8
+  ret void
+}
+
+define linkonce { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"__llgo_makefunc._llgo_func$MrYxYl10p_I07B55pBsGw9la9zbzU2vGDPLWrT714Uk"(ptr %0, %"github.com/goplus/llgo/internal/runtime.iface" %1) {
+_llgo_0:
+  %2 = load { ptr, ptr }, ptr %0, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %4 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %1, ptr %4, align 8
+  %5 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %6 = extractvalue { ptr, ptr } %2, 1
+  %7 = extractvalue { ptr, ptr } %2, 0
+  call void %7(ptr %6, ptr %3, ptr %5)
+  %8 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %5, i32 0, i32 0
+  %9 = load i64, ptr %8, align 4
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %5, i32 0, i32 1
+  %11 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %10, align 8
+  %12 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %13 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 0
+  store i64 %9, ptr %13, align 4
+  %14 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %11, ptr %14, align 8
+  %15 = load { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, align 8
+  ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %15
+}
+
 declare i1 @"github.com/goplus/llgo/internal/runtime.Implements"(ptr, ptr)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.NewItab"(ptr, ptr)
@@ -2796,8 +2945,287 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.Struct"(%"github.com/goplu
 
 declare %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String", ptr, i64, %"github.com/goplus/llgo/internal/runtime.String", i1)
 
+define linkonce void @"__llgo_call._llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call %"github.com/goplus/llgo/internal/runtime.iface" %6(ptr %5)
+  %8 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.iface" %7, ptr %8, align 8
+  ret void
+}
+
+define linkonce %"github.com/goplus/llgo/internal/runtime.iface" @"__llgo_makefunc._llgo_func$8rsrSd_r3UHd_2DiYTyaOKR7BYkei4zw5ysG35KF38w"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %7 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %6, align 8
+  ret %"github.com/goplus/llgo/internal/runtime.iface" %7
+}
+
 declare void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", ptr, %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice")
 
+define linkonce void @"__llgo_call._llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %2, i32 0, i32 0
+  %6 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %5, align 8
+  %7 = extractvalue { ptr, ptr } %4, 1
+  %8 = extractvalue { ptr, ptr } %4, 0
+  %9 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %8(ptr %7, %"github.com/goplus/llgo/internal/runtime.String" %6)
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %11 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %9, 0
+  store i64 %11, ptr %10, align 4
+  %12 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %13 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %9, 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %13, ptr %12, align 8
+  ret void
+}
+
+define linkonce { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"__llgo_makefunc._llgo_func$thH5FBpdXzJNnCpSfiLU5ItTntFU6LWp0RJhDm2XJjw"(ptr %0, %"github.com/goplus/llgo/internal/runtime.String" %1) {
+_llgo_0:
+  %2 = load { ptr, ptr }, ptr %0, align 8
+  %3 = alloca { %"github.com/goplus/llgo/internal/runtime.String" }, align 8
+  %4 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String" }, ptr %3, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %1, ptr %4, align 8
+  %5 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %6 = extractvalue { ptr, ptr } %2, 1
+  %7 = extractvalue { ptr, ptr } %2, 0
+  call void %7(ptr %6, ptr %3, ptr %5)
+  %8 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %5, i32 0, i32 0
+  %9 = load i64, ptr %8, align 4
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %5, i32 0, i32 1
+  %11 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %10, align 8
+  %12 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %13 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 0
+  store i64 %9, ptr %13, align 4
+  %14 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %11, ptr %14, align 8
+  %15 = load { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, align 8
+  ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %15
+}
+
+define linkonce void @"__llgo_call._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call i64 %6(ptr %5)
+  %8 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  store i64 %7, ptr %8, align 4
+  ret void
+}
+
+define linkonce i64 @"__llgo_makefunc._llgo_func$ETeB8WwW04JEq0ztcm-XPTJtuYvtpkjIsAc0-2NT9zA"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { i64 }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  %7 = load i64, ptr %6, align 4
+  ret i64 %7
+}
+
+define linkonce void @"__llgo_call._llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", i64 }, ptr %2, i32 0, i32 0
+  %6 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %5, align 8
+  %7 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", i64 }, ptr %2, i32 0, i32 1
+  %8 = load i64, ptr %7, align 4
+  %9 = extractvalue { ptr, ptr } %4, 1
+  %10 = extractvalue { ptr, ptr } %4, 0
+  %11 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %10(ptr %9, %"github.com/goplus/llgo/internal/runtime.Slice" %6, i64 %8)
+  %12 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %13 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %11, 0
+  store i64 %13, ptr %12, align 4
+  %14 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %15 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %11, 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %15, ptr %14, align 8
+  ret void
+}
+
+define linkonce { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"__llgo_makefunc._llgo_func$TY5Etv7VBKM_-2um1BDEeQEE2lP06Pt6G54EuKiNC3c"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1, i64 %2) {
+_llgo_0:
+  %3 = load { ptr, ptr }, ptr %0, align 8
+  %4 = alloca { %"github.com/goplus/llgo/internal/runtime.Slice", i64 }, align 8
+  %5 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", i64 }, ptr %4, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.Slice" %1, ptr %5, align 8
+  %6 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.Slice", i64 }, ptr %4, i32 0, i32 1
+  store i64 %2, ptr %6, align 4
+  %7 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %8 = extractvalue { ptr, ptr } %3, 1
+  %9 = extractvalue { ptr, ptr } %3, 0
+  call void %9(ptr %8, ptr %4, ptr %7)
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %7, i32 0, i32 0
+  %11 = load i64, ptr %10, align 4
+  %12 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %7, i32 0, i32 1
+  %13 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, align 8
+  %14 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %15 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %14, i32 0, i32 0
+  store i64 %11, ptr %15, align 4
+  %16 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %14, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %13, ptr %16, align 8
+  %17 = load { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %14, align 8
+  ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %17
+}
+
+define linkonce void @"__llgo_call._llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call { i8, %"github.com/goplus/llgo/internal/runtime.iface" } %6(ptr %5)
+  %8 = getelementptr inbounds { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %9 = extractvalue { i8, %"github.com/goplus/llgo/internal/runtime.iface" } %7, 0
+  store i8 %9, ptr %8, align 1
+  %10 = getelementptr inbounds { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %11 = extractvalue { i8, %"github.com/goplus/llgo/internal/runtime.iface" } %7, 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %11, ptr %10, align 8
+  ret void
+}
+
+define linkonce { i8, %"github.com/goplus/llgo/internal/runtime.iface" } @"__llgo_makefunc._llgo_func$6bvVpCcGPUc3z_EmsQTHB0AVT1hP5-NNLVRgm43teCM"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %7 = load i8, ptr %6, align 1
+  %8 = getelementptr inbounds { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %9 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %8, align 8
+  %10 = alloca { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %11 = getelementptr inbounds { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %10, i32 0, i32 0
+  store i8 %7, ptr %11, align 1
+  %12 = getelementptr inbounds { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %10, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %9, ptr %12, align 8
+  %13 = load { i8, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %10, align 8
+  ret { i8, %"github.com/goplus/llgo/internal/runtime.iface" } %13
+}
+
+define linkonce void @"__llgo_call._llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" } %6(ptr %5)
+  %8 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %9 = extractvalue { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" } %7, 0
+  store i32 %9, ptr %8, align 4
+  %10 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %11 = extractvalue { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" } %7, 1
+  store i64 %11, ptr %10, align 4
+  %12 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 2
+  %13 = extractvalue { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" } %7, 2
+  store %"github.com/goplus/llgo/internal/runtime.iface" %13, ptr %12, align 8
+  ret void
+}
+
+define linkonce { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"__llgo_makefunc._llgo_func$CB0CO6hV_feSzhi4pz1P4omza2fKNK930wvOR1T33fU"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %7 = load i32, ptr %6, align 4
+  %8 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %9 = load i64, ptr %8, align 4
+  %10 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 2
+  %11 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %10, align 8
+  %12 = alloca { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %13 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 0
+  store i32 %7, ptr %13, align 4
+  %14 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 1
+  store i64 %9, ptr %14, align 4
+  %15 = getelementptr inbounds { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, i32 0, i32 2
+  store %"github.com/goplus/llgo/internal/runtime.iface" %11, ptr %15, align 8
+  %16 = load { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %12, align 8
+  ret { i32, i64, %"github.com/goplus/llgo/internal/runtime.iface" } %16
+}
+
+define linkonce void @"__llgo_call._llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = getelementptr inbounds { i64, i64 }, ptr %2, i32 0, i32 0
+  %6 = load i64, ptr %5, align 4
+  %7 = getelementptr inbounds { i64, i64 }, ptr %2, i32 0, i32 1
+  %8 = load i64, ptr %7, align 4
+  %9 = extractvalue { ptr, ptr } %4, 1
+  %10 = extractvalue { ptr, ptr } %4, 0
+  %11 = call { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %10(ptr %9, i64 %6, i64 %8)
+  %12 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 0
+  %13 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %11, 0
+  store i64 %13, ptr %12, align 4
+  %14 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %3, i32 0, i32 1
+  %15 = extractvalue { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %11, 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %15, ptr %14, align 8
+  ret void
+}
+
+define linkonce { i64, %"github.com/goplus/llgo/internal/runtime.iface" } @"__llgo_makefunc._llgo_func$HE7H49xPa1uXmrkMDpqB3RCRGf3qzhLGrxKCEXOYjms"(ptr %0, i64 %1, i64 %2) {
+_llgo_0:
+  %3 = load { ptr, ptr }, ptr %0, align 8
+  %4 = alloca { i64, i64 }, align 8
+  %5 = getelementptr inbounds { i64, i64 }, ptr %4, i32 0, i32 0
+  store i64 %1, ptr %5, align 4
+  %6 = getelementptr inbounds { i64, i64 }, ptr %4, i32 0, i32 1
+  store i64 %2, ptr %6, align 4
+  %7 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %8 = extractvalue { ptr, ptr } %3, 1
+  %9 = extractvalue { ptr, ptr } %3, 0
+  call void %9(ptr %8, ptr %4, ptr %7)
+  %10 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %7, i32 0, i32 0
+  %11 = load i64, ptr %10, align 4
+  %12 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %7, i32 0, i32 1
+  %13 = load %"github.com/goplus/llgo/internal/runtime.iface", ptr %12, align 8
+  %14 = alloca { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, align 8
+  %15 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %14, i32 0, i32 0
+  store i64 %11, ptr %15, align 4
+  %16 = getelementptr inbounds { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %14, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.iface" %13, ptr %16, align 8
+  %17 = load { i64, %"github.com/goplus/llgo/internal/runtime.iface" }, ptr %14, align 8
+  ret { i64, %"github.com/goplus/llgo/internal/runtime.iface" } %17
+}
+
+define linkonce void @"__llgo_call._llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug"(ptr %0, ptr %1, ptr %2, ptr %3) {
+_llgo_0:
+  %4 = load { ptr, ptr }, ptr %1, align 8
+  %5 = extractvalue { ptr, ptr } %4, 1
+  %6 = extractvalue { ptr, ptr } %4, 0
+  %7 = call i64 %6(ptr %5)
+  %8 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  store i64 %7, ptr %8, align 4
+  ret void
+}
+
+define linkonce i64 @"__llgo_makefunc._llgo_func$Eoig9xhJM5GShHH5aNPxTZZXp1IZxprRl4zPuv2hkug"(ptr %0) {
+_llgo_0:
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = alloca {}, align 8
+  %3 = alloca { i64 }, align 8
+  %4 = extractvalue { ptr, ptr } %1, 1
+  %5 = extractvalue { ptr, ptr } %1, 0
+  call void %5(ptr %4, ptr %2, ptr %3)
+  %6 = getelementptr inbounds { i64 }, ptr %3, i32 0, i32 0
+  %7 = load i64, ptr %6, align 4
+  ret i64 %7
+}
+
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
 
 declare %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr, i64, i64, i64, i64, i64)
@@ -2824,7 +3252,9 @@ declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintIface"(%"github.com/goplus/llgo/internal/runtime.iface")
 
-declare void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface")
+declare void @"github.com/goplus/llgo/internal/runtime.AssertNilWrap"(i1, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String")
+
+declare void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr, ptr)
 
 declare %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringSlice"(%"github.com/goplus/llgo/internal/runtime.String", i64, i64)
 
@@ -2833,3 +3263,7 @@ declare i64 @"github.com/goplus/llgo/internal/runtime.SliceCopy"(%"github.com/go
 declare void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1)
 
 declare { i32, i64 } @"unicode/utf8.DecodeRuneInString"(%"github.com/goplus/llgo/internal/runtime.String")
+
+declare void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface")
+
+!0 = !{}
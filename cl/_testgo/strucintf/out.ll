@@ -54,55 +54,55 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
 
 define i32 @main(i32 %0, ptr %1) {
 _llgo_0:
+  %2 = alloca { i64 }, align 8
+  %3 = alloca { i64 }, align 8
   store i32 %0, ptr @__llgo_argc, align 4
   store ptr %1, ptr @__llgo_argv, align 8
   call void @"github.com/goplus/llgo/internal/runtime.init"()
   call void @main.init()
-  %2 = call %"github.com/goplus/llgo/internal/runtime.eface" @main.Foo()
-  %3 = alloca { i64 }, align 8
-  %4 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %3, i64 8)
-  %5 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %2, 0
-  %6 = load ptr, ptr @"main.struct$MYpsoM99ZwFY087IpUOkIw1zjBA_sgFXVodmn1m-G88", align 8
-  %7 = icmp eq ptr %5, %6
-  br i1 %7, label %_llgo_10, label %_llgo_11
+  %4 = call %"github.com/goplus/llgo/internal/runtime.eface" @main.Foo()
+  %5 = alloca { i64 }, align 8
+  %6 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %5, i64 8)
+  %7 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %4, 0
+  %8 = load ptr, ptr @"main.struct$MYpsoM99ZwFY087IpUOkIw1zjBA_sgFXVodmn1m-G88", align 8
+  %9 = icmp eq ptr %7, %8
+  br i1 %9, label %_llgo_10, label %_llgo_11
 
 _llgo_1:                                          ; preds = %_llgo_12
-  %8 = getelementptr inbounds { i64 }, ptr %4, i32 0, i32 0
-  %9 = load i64, ptr %8, align 4
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %9)
+  %10 = getelementptr inbounds { i64 }, ptr %6, i32 0, i32 0
+  %11 = load i64, ptr %10, align 4
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %11)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   br label %_llgo_2
 
 _llgo_2:                                          ; preds = %_llgo_3, %_llgo_1
-  %10 = call %"github.com/goplus/llgo/internal/runtime.eface" @"github.com/goplus/llgo/cl/internal/foo.Bar"()
-  %11 = alloca { i64 }, align 8
-  %12 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %11, i64 8)
-  %13 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %10, 0
-  %14 = load ptr, ptr @"_llgo_struct$K-dZ9QotZfVPz2a0YdRa9vmZUuDXPTqZOlMShKEDJtk", align 8
-  %15 = icmp eq ptr %13, %14
-  br i1 %15, label %_llgo_13, label %_llgo_14
+  %12 = call %"github.com/goplus/llgo/internal/runtime.eface" @"github.com/goplus/llgo/cl/internal/foo.Bar"()
+  %13 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %2, i64 8)
+  %14 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %12, 0
+  %15 = load ptr, ptr @"_llgo_struct$K-dZ9QotZfVPz2a0YdRa9vmZUuDXPTqZOlMShKEDJtk", align 8
+  %16 = icmp eq ptr %14, %15
+  br i1 %16, label %_llgo_13, label %_llgo_14
 
 _llgo_3:                                          ; preds = %_llgo_12
-  %16 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %17 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %16, i32 0, i32 0
-  store ptr @2, ptr %17, align 8
-  %18 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %16, i32 0, i32 1
-  store i64 11, ptr %18, align 4
-  %19 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %16, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %19)
+  %17 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %18 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %17, i32 0, i32 0
+  store ptr @2, ptr %18, align 8
+  %19 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %17, i32 0, i32 1
+  store i64 11, ptr %19, align 4
+  %20 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %17, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %20)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   br label %_llgo_2
 
 _llgo_4:                                          ; preds = %_llgo_15
-  %20 = getelementptr inbounds { i64 }, ptr %12, i32 0, i32 0
-  %21 = load i64, ptr %20, align 4
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %21)
+  %21 = getelementptr inbounds { i64 }, ptr %13, i32 0, i32 0
+  %22 = load i64, ptr %21, align 4
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %22)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   br label %_llgo_5
 
 _llgo_5:                                          ; preds = %_llgo_6, %_llgo_4
-  %22 = alloca { i64 }, align 8
-  %23 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %22, i64 8)
+  %23 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %3, i64 8)
   %24 = call %"github.com/goplus/llgo/internal/runtime.eface" @"github.com/goplus/llgo/cl/internal/foo.F"()
   %25 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %24, 0
   %26 = load ptr, ptr @"main.struct$MYpsoM99ZwFY087IpUOkIw1zjBA_sgFXVodmn1m-G88", align 8
@@ -142,7 +142,7 @@ _llgo_9:                                          ; preds = %_llgo_18
   br label %_llgo_8
 
 _llgo_10:                                         ; preds = %_llgo_0
-  %38 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %2, 1
+  %38 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %4, 1
   %39 = ptrtoint ptr %38 to i64
   %40 = alloca { i64 }, align 8
   %41 = getelementptr inbounds { i64 }, ptr %40, i32 0, i32 0
@@ -168,12 +168,12 @@ _llgo_11:                                         ; preds = %_llgo_0
 _llgo_12:                                         ; preds = %_llgo_11, %_llgo_10
   %51 = phi { { i64 }, i1 } [ %46, %_llgo_10 ], [ %50, %_llgo_11 ]
   %52 = extractvalue { { i64 }, i1 } %51, 0
-  store { i64 } %52, ptr %4, align 4
+  store { i64 } %52, ptr %6, align 4
   %53 = extractvalue { { i64 }, i1 } %51, 1
   br i1 %53, label %_llgo_1, label %_llgo_3
 
 _llgo_13:                                         ; preds = %_llgo_2
-  %54 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %10, 1
+  %54 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %12, 1
   %55 = ptrtoint ptr %54 to i64
   %56 = alloca { i64 }, align 8
   %57 = getelementptr inbounds { i64 }, ptr %56, i32 0, i32 0
@@ -199,7 +199,7 @@ _llgo_14:                                         ; preds = %_llgo_2
 _llgo_15:                                         ; preds = %_llgo_14, %_llgo_13
   %67 = phi { { i64 }, i1 } [ %62, %_llgo_13 ], [ %66, %_llgo_14 ]
   %68 = extractvalue { { i64 }, i1 } %67, 0
-  store { i64 } %68, ptr %12, align 4
+  store { i64 } %68, ptr %13, align 4
   %69 = extractvalue { { i64 }, i1 } %67, 1
   br i1 %69, label %_llgo_4, label %_llgo_6
 
@@ -58,7 +58,7 @@ declare void @"github.com/goplus/llgo/internal/runtime.init"()
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
 
-define i64 @"main.index[int]"(%"github.com/goplus/llgo/internal/runtime.Slice" %0, i64 %1) {
+define linkonce_odr i64 @"main.index[int]"(%"github.com/goplus/llgo/internal/runtime.Slice" %0, i64 %1) {
 _llgo_0:
   %2 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %0, 1
   br label %_llgo_1
@@ -72,14 +72,10 @@ _llgo_1:                                          ; preds = %_llgo_2, %_llgo_0
 _llgo_2:                                          ; preds = %_llgo_1
   %6 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %0, 0
   %7 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %0, 1
-  %8 = icmp slt i64 %4, 0
-  %9 = icmp sge i64 %4, %7
-  %10 = or i1 %9, %8
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %10)
-  %11 = getelementptr inbounds i64, ptr %6, i64 %4
-  %12 = load i64, ptr %11, align 4
-  %13 = icmp eq i64 %1, %12
-  br i1 %13, label %_llgo_4, label %_llgo_1
+  %8 = getelementptr inbounds i64, ptr %6, i64 %4
+  %9 = load i64, ptr %8, align 4
+  %10 = icmp eq i64 %1, %9
+  br i1 %10, label %_llgo_4, label %_llgo_1
 
 _llgo_3:                                          ; preds = %_llgo_1
   ret i64 -1
@@ -91,5 +87,3 @@ _llgo_4:                                          ; preds = %_llgo_2
 declare void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
-
-declare void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1)
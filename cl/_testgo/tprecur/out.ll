@@ -66,7 +66,7 @@ _llgo_2:                                          ; preds = %_llgo_0
 
 declare void @"github.com/goplus/llgo/internal/runtime.init"()
 
-define i64 @"main.recur1[main.T]"(i64 %0) {
+define linkonce_odr i64 @"main.recur1[main.T]"(i64 %0) {
 _llgo_0:
   %1 = icmp eq i64 %0, 0
   br i1 %1, label %_llgo_1, label %_llgo_3
@@ -106,7 +106,7 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface")
 
-define i64 @"main.recur2[main.T]"(i64 %0) {
+define linkonce_odr i64 @"main.recur2[main.T]"(i64 %0) {
 _llgo_0:
   %1 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.MakeSlice"(i64 %0, i64 %0, i64 8)
   %2 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 1
@@ -122,44 +122,34 @@ _llgo_2:                                          ; preds = %_llgo_1
   %6 = add i64 %4, 1
   %7 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 0
   %8 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 1
-  %9 = icmp slt i64 %4, 0
-  %10 = icmp sge i64 %4, %8
-  %11 = or i1 %10, %9
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %11)
-  %12 = getelementptr inbounds i64, ptr %7, i64 %4
-  store i64 %6, ptr %12, align 4
+  %9 = getelementptr inbounds i64, ptr %7, i64 %4
+  store i64 %6, ptr %9, align 4
   br label %_llgo_1
 
 _llgo_3:                                          ; preds = %_llgo_1
-  %13 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 1
+  %10 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 1
   br label %_llgo_4
 
 _llgo_4:                                          ; preds = %_llgo_5, %_llgo_3
-  %14 = phi i64 [ 0, %_llgo_3 ], [ %25, %_llgo_5 ]
-  %15 = phi i64 [ -1, %_llgo_3 ], [ %16, %_llgo_5 ]
-  %16 = add i64 %15, 1
-  %17 = icmp slt i64 %16, %13
-  br i1 %17, label %_llgo_5, label %_llgo_6
+  %11 = phi i64 [ 0, %_llgo_3 ], [ %19, %_llgo_5 ]
+  %12 = phi i64 [ -1, %_llgo_3 ], [ %13, %_llgo_5 ]
+  %13 = add i64 %12, 1
+  %14 = icmp slt i64 %13, %10
+  br i1 %14, label %_llgo_5, label %_llgo_6
 
 _llgo_5:                                          ; preds = %_llgo_4
-  %18 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 0
-  %19 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 1
-  %20 = icmp slt i64 %16, 0
-  %21 = icmp sge i64 %16, %19
-  %22 = or i1 %21, %20
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %22)
-  %23 = getelementptr inbounds i64, ptr %18, i64 %16
-  %24 = load i64, ptr %23, align 4
-  %25 = add i64 %14, %24
+  %15 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 0
+  %16 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %1, 1
+  %17 = getelementptr inbounds i64, ptr %15, i64 %13
+  %18 = load i64, ptr %17, align 4
+  %19 = add i64 %11, %18
   br label %_llgo_4
 
 _llgo_6:                                          ; preds = %_llgo_4
-  %26 = sub i64 %0, 1
-  %27 = call i64 @"main.recur1[main.T]"(i64 %26)
-  %28 = add i64 %14, %27
-  ret i64 %28
+  %20 = sub i64 %0, 1
+  %21 = call i64 @"main.recur1[main.T]"(i64 %20)
+  %22 = add i64 %11, %21
+  ret i64 %22
 }
 
 declare %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.MakeSlice"(i64, i64, i64)
-
-declare void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1)
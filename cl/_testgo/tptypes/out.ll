@@ -204,7 +204,7 @@ declare void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
 
-define %"github.com/goplus/llgo/internal/runtime.Slice" @"main.(*Slice).Append[[]int, int]"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
+define linkonce_odr %"github.com/goplus/llgo/internal/runtime.Slice" @"main.(*Slice).Append[[]int, int]"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
 _llgo_0:
   %2 = getelementptr inbounds %"main.Slice[[]int, int]", ptr %0, i32 0, i32 0
   %3 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %2, align 8
@@ -218,7 +218,7 @@ _llgo_0:
   ret %"github.com/goplus/llgo/internal/runtime.Slice" %9
 }
 
-define %"github.com/goplus/llgo/internal/runtime.Slice" @"main.(*Slice).Append[[]string, string]"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
+define linkonce_odr %"github.com/goplus/llgo/internal/runtime.Slice" @"main.(*Slice).Append[[]string, string]"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
 _llgo_0:
   %2 = getelementptr inbounds %"main.Slice[[]string, string]", ptr %0, i32 0, i32 0
   %3 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %2, align 8
@@ -232,7 +232,7 @@ _llgo_0:
   ret %"github.com/goplus/llgo/internal/runtime.Slice" %9
 }
 
-define %"github.com/goplus/llgo/internal/runtime.Slice" @"main.(*Slice).Append2[[]int, int]"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
+define linkonce_odr %"github.com/goplus/llgo/internal/runtime.Slice" @"main.(*Slice).Append2[[]int, int]"(ptr %0, %"github.com/goplus/llgo/internal/runtime.Slice" %1) {
 _llgo_0:
   %2 = getelementptr inbounds %"main.Slice[[]int, int]", ptr %0, i32 0, i32 0
   %3 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %2, align 8
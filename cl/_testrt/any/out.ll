@@ -2,18 +2,15 @@
 source_filename = "main"
 
 %"github.com/goplus/llgo/internal/runtime.eface" = type { ptr, ptr }
-%"github.com/goplus/llgo/internal/runtime.String" = type { ptr, i64 }
 
 @"main.init$guard" = global i1 false, align 1
 @_llgo_int8 = linkonce global ptr null, align 8
 @"*_llgo_int8" = linkonce global ptr null, align 8
-@0 = private unnamed_addr constant [21 x i8] c"type assertion failed", align 1
-@_llgo_string = linkonce global ptr null, align 8
 @_llgo_int = linkonce global ptr null, align 8
 @__llgo_argc = global i32 0, align 4
 @__llgo_argv = global ptr null, align 8
-@1 = private unnamed_addr constant [7 x i8] c"%s %d\0A\00", align 1
-@2 = private unnamed_addr constant [6 x i8] c"Hello\00", align 1
+@0 = private unnamed_addr constant [7 x i8] c"%s %d\0A\00", align 1
+@1 = private unnamed_addr constant [6 x i8] c"Hello\00", align 1
 
 define ptr @main.hi(%"github.com/goplus/llgo/internal/runtime.eface" %0) {
 _llgo_0:
@@ -27,22 +24,7 @@ _llgo_1:                                          ; preds = %_llgo_0
   ret ptr %4
 
 _llgo_2:                                          ; preds = %_llgo_0
-  %5 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 0
-  store ptr @0, ptr %6, align 8
-  %7 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %5, i32 0, i32 1
-  store i64 21, ptr %7, align 4
-  %8 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %5, align 8
-  %9 = load ptr, ptr @_llgo_string, align 8
-  %10 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %8, ptr %10, align 8
-  %11 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %12 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %11, i32 0, i32 0
-  store ptr %9, ptr %12, align 8
-  %13 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %11, i32 0, i32 1
-  store ptr %10, ptr %13, align 8
-  %14 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %11, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %14)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %1, ptr %2)
   unreachable
 }
 
@@ -60,22 +42,7 @@ _llgo_1:                                          ; preds = %_llgo_0
   ret i64 %6
 
 _llgo_2:                                          ; preds = %_llgo_0
-  %7 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %7, i32 0, i32 0
-  store ptr @0, ptr %8, align 8
-  %9 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %7, i32 0, i32 1
-  store i64 21, ptr %9, align 4
-  %10 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %7, align 8
-  %11 = load ptr, ptr @_llgo_string, align 8
-  %12 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %10, ptr %12, align 8
-  %13 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %14 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %13, i32 0, i32 0
-  store ptr %11, ptr %14, align 8
-  %15 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %13, i32 0, i32 1
-  store ptr %12, ptr %15, align 8
-  %16 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %13, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %16)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %1, ptr %2)
   unreachable
 }
 
@@ -104,7 +71,7 @@ _llgo_0:
   %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %3, i32 0, i32 0
   store ptr %2, ptr %4, align 8
   %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %3, i32 0, i32 1
-  store ptr @2, ptr %5, align 8
+  store ptr @1, ptr %5, align 8
   %6 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %3, align 8
   %7 = call ptr @main.hi(%"github.com/goplus/llgo/internal/runtime.eface" %6)
   %8 = load ptr, ptr @_llgo_int, align 8
@@ -115,7 +82,7 @@ _llgo_0:
   store ptr inttoptr (i64 100 to ptr), ptr %11, align 8
   %12 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %9, align 8
   %13 = call i64 @main.incVal(%"github.com/goplus/llgo/internal/runtime.eface" %12)
-  %14 = call i32 (ptr, ...) @printf(ptr @1, ptr %7, i64 %13)
+  %14 = call i32 (ptr, ...) @printf(ptr @0, ptr %7, i64 %13)
   ret i32 0
 }
 
@@ -143,26 +110,16 @@ _llgo_3:                                          ; preds = %_llgo_2
   br label %_llgo_4
 
 _llgo_4:                                          ; preds = %_llgo_3, %_llgo_2
-  %7 = load ptr, ptr @_llgo_string, align 8
+  %7 = load ptr, ptr @_llgo_int, align 8
   %8 = icmp eq ptr %7, null
   br i1 %8, label %_llgo_5, label %_llgo_6
 
 _llgo_5:                                          ; preds = %_llgo_4
-  %9 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 24)
-  store ptr %9, ptr @_llgo_string, align 8
+  %9 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
+  store ptr %9, ptr @_llgo_int, align 8
   br label %_llgo_6
 
 _llgo_6:                                          ; preds = %_llgo_5, %_llgo_4
-  %10 = load ptr, ptr @_llgo_int, align 8
-  %11 = icmp eq ptr %10, null
-  br i1 %11, label %_llgo_7, label %_llgo_8
-
-_llgo_7:                                          ; preds = %_llgo_6
-  %12 = call ptr @"github.com/goplus/llgo/internal/runtime.Basic"(i64 34)
-  store ptr %12, ptr @_llgo_int, align 8
-  br label %_llgo_8
-
-_llgo_8:                                          ; preds = %_llgo_7, %_llgo_6
   ret void
 }
 
@@ -172,9 +129,7 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.PointerTo"(ptr)
 
 declare void @"github.com/goplus/llgo/internal/runtime.SetDirectIface"(ptr)
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
-
-declare void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface")
+declare void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr, ptr)
 
 declare void @"github.com/goplus/llgo/internal/runtime.init"()
 
@@ -85,49 +85,52 @@ _llgo_0:
   store ptr %1, ptr @__llgo_argv, align 8
   call void @"github.com/goplus/llgo/internal/runtime.init"()
   call void @main.init()
-  %2 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 32)
-  %3 = getelementptr inbounds i64, ptr %2, i64 0
-  store i64 1, ptr %3, align 4
-  %4 = getelementptr inbounds i64, ptr %2, i64 1
-  store i64 2, ptr %4, align 4
-  %5 = getelementptr inbounds i64, ptr %2, i64 2
-  store i64 3, ptr %5, align 4
-  %6 = getelementptr inbounds i64, ptr %2, i64 3
-  store i64 4, ptr %6, align 4
-  %7 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %8 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %7, i32 0, i32 0
-  store ptr %2, ptr %8, align 8
-  %9 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %7, i32 0, i32 1
-  store i64 4, ptr %9, align 4
-  %10 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %7, i32 0, i32 2
+  %2 = alloca [4 x i64], align 8
+  %3 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %2, i64 32)
+  %4 = getelementptr inbounds i64, ptr %3, i64 0
+  store i64 1, ptr %4, align 4
+  %5 = getelementptr inbounds i64, ptr %3, i64 1
+  store i64 2, ptr %5, align 4
+  %6 = getelementptr inbounds i64, ptr %3, i64 2
+  store i64 3, ptr %6, align 4
+  %7 = getelementptr inbounds i64, ptr %3, i64 3
+  store i64 4, ptr %7, align 4
+  %8 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %9 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %8, i32 0, i32 0
+  store ptr %3, ptr %9, align 8
+  %10 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %8, i32 0, i32 1
   store i64 4, ptr %10, align 4
-  %11 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %7, align 8
-  %12 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 32)
-  %13 = getelementptr inbounds i64, ptr %12, i64 0
-  %14 = getelementptr inbounds i64, ptr %12, i64 1
-  %15 = getelementptr inbounds i64, ptr %12, i64 2
-  %16 = getelementptr inbounds i64, ptr %12, i64 3
-  store i64 1, ptr %13, align 4
-  store i64 2, ptr %14, align 4
-  store i64 3, ptr %15, align 4
-  store i64 4, ptr %16, align 4
-  %17 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 10)
-  %18 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %17, i64 1, i64 10, i64 0, i64 4, i64 10)
-  %19 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 1
-  %20 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 2
-  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %11)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %19)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %20)
+  %11 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %8, i32 0, i32 2
+  store i64 4, ptr %11, align 4
+  %12 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %8, align 8
+  %13 = alloca [4 x i64], align 8
+  %14 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %13, i64 32)
+  %15 = getelementptr inbounds i64, ptr %14, i64 0
+  %16 = getelementptr inbounds i64, ptr %14, i64 1
+  %17 = getelementptr inbounds i64, ptr %14, i64 2
+  %18 = getelementptr inbounds i64, ptr %14, i64 3
+  store i64 1, ptr %15, align 4
+  store i64 2, ptr %16, align 4
+  store i64 3, ptr %17, align 4
+  store i64 4, ptr %18, align 4
+  %19 = alloca [10 x i8], align 1
+  %20 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %19, i64 10)
+  %21 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %20, i64 1, i64 10, i64 0, i64 4, i64 10)
+  %22 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 1
+  %23 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 2
+  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %12)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %22)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %23)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %21 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %18, 1
-  %22 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %18, 2
-  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %18)
+  %24 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %21, 1
+  %25 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %21, 2
+  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %21)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %21)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %24)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %22)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %25)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 4)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
@@ -137,57 +140,56 @@ _llgo_0:
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 4)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %23 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 32)
-  %24 = getelementptr inbounds i64, ptr %23, i64 0
-  store i64 1, ptr %24, align 4
-  %25 = getelementptr inbounds i64, ptr %23, i64 1
-  store i64 2, ptr %25, align 4
-  %26 = getelementptr inbounds i64, ptr %23, i64 2
-  store i64 3, ptr %26, align 4
-  %27 = getelementptr inbounds i64, ptr %23, i64 3
-  store i64 4, ptr %27, align 4
-  %28 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %29 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %28, i32 0, i32 0
-  store ptr %23, ptr %29, align 8
-  %30 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %28, i32 0, i32 1
-  store i64 4, ptr %30, align 4
-  %31 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %28, i32 0, i32 2
+  %26 = alloca [4 x i64], align 8
+  %27 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %26, i64 32)
+  %28 = getelementptr inbounds i64, ptr %27, i64 0
+  store i64 1, ptr %28, align 4
+  %29 = getelementptr inbounds i64, ptr %27, i64 1
+  store i64 2, ptr %29, align 4
+  %30 = getelementptr inbounds i64, ptr %27, i64 2
+  store i64 3, ptr %30, align 4
+  %31 = getelementptr inbounds i64, ptr %27, i64 3
   store i64 4, ptr %31, align 4
-  %32 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %28, align 8
-  %33 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %32, 1
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %33)
+  %32 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %33 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %32, i32 0, i32 0
+  store ptr %27, ptr %33, align 8
+  %34 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %32, i32 0, i32 1
+  store i64 4, ptr %34, align 4
+  %35 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %32, i32 0, i32 2
+  store i64 4, ptr %35, align 4
+  %36 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %32, align 8
+  %37 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %36, 1
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %37)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 4)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %34 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 2
-  %35 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 1
-  %36 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 0
-  %37 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %36, i64 8, i64 %34, i64 1, i64 %35, i64 %34)
-  %38 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %37, 1
-  %39 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 2
-  %40 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 1
-  %41 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 0
-  %42 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %41, i64 8, i64 %39, i64 1, i64 %40, i64 %39)
-  %43 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %42, 2
-  %44 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 2
-  %45 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 0
-  %46 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %45, i64 8, i64 %44, i64 1, i64 2, i64 %44)
-  %47 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %46, 1
-  %48 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 2
-  %49 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 0
+  %38 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 2
+  %39 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 1
+  %40 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
+  %41 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %40, i64 8, i64 %38, i64 1, i64 %39, i64 %38)
+  %42 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %41, 1
+  %43 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 2
+  %44 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 1
+  %45 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
+  %46 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %45, i64 8, i64 %43, i64 1, i64 %44, i64 %43)
+  %47 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %46, 2
+  %48 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 2
+  %49 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
   %50 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %49, i64 8, i64 %48, i64 1, i64 2, i64 %48)
-  %51 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %50, 2
-  %52 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 2
-  %53 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 0
-  %54 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %53, i64 8, i64 %52, i64 1, i64 2, i64 2)
-  %55 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %54, 1
-  %56 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 2
-  %57 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %11, 0
+  %51 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %50, 1
+  %52 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 2
+  %53 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
+  %54 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %53, i64 8, i64 %52, i64 1, i64 2, i64 %52)
+  %55 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %54, 2
+  %56 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 2
+  %57 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
   %58 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %57, i64 8, i64 %56, i64 1, i64 2, i64 2)
-  %59 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %58, 2
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %38)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %43)
+  %59 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %58, 1
+  %60 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 2
+  %61 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %12, 0
+  %62 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %61, i64 8, i64 %60, i64 1, i64 2, i64 2)
+  %63 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %62, 2
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %42)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %47)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
@@ -196,23 +198,21 @@ _llgo_0:
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %55)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %59)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %63)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %60 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %12, i64 8, i64 4, i64 1, i64 4, i64 4)
-  %61 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %60, 1
-  %62 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %12, i64 8, i64 4, i64 1, i64 4, i64 4)
-  %63 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %62, 2
-  %64 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %12, i64 8, i64 4, i64 1, i64 2, i64 4)
+  %64 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %14, i64 8, i64 4, i64 1, i64 4, i64 4)
   %65 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %64, 1
-  %66 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %12, i64 8, i64 4, i64 1, i64 2, i64 4)
+  %66 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %14, i64 8, i64 4, i64 1, i64 4, i64 4)
   %67 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %66, 2
-  %68 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %12, i64 8, i64 4, i64 1, i64 2, i64 2)
+  %68 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %14, i64 8, i64 4, i64 1, i64 2, i64 4)
   %69 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %68, 1
-  %70 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %12, i64 8, i64 4, i64 1, i64 2, i64 2)
+  %70 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %14, i64 8, i64 4, i64 1, i64 2, i64 4)
   %71 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %70, 2
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %61)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %63)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %72 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %14, i64 8, i64 4, i64 1, i64 2, i64 2)
+  %73 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %72, 1
+  %74 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %14, i64 8, i64 4, i64 1, i64 2, i64 2)
+  %75 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %74, 2
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %65)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %67)
@@ -220,104 +220,110 @@ _llgo_0:
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %69)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %71)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %73)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %75)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %72 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %73 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %72, i32 0, i32 0
-  store ptr @0, ptr %73, align 8
-  %74 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %72, i32 0, i32 1
-  store i64 5, ptr %74, align 4
-  %75 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %72, align 8
-  %76 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %75, 1
-  %77 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringSlice"(%"github.com/goplus/llgo/internal/runtime.String" %75, i64 1, i64 %76)
-  %78 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %79 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %78, i32 0, i32 0
-  store ptr @0, ptr %79, align 8
-  %80 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %78, i32 0, i32 1
-  store i64 5, ptr %80, align 4
-  %81 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %78, align 8
-  %82 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringSlice"(%"github.com/goplus/llgo/internal/runtime.String" %81, i64 1, i64 2)
-  %83 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %84 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %83, i32 0, i32 0
-  store ptr @0, ptr %84, align 8
-  %85 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %83, i32 0, i32 1
-  store i64 5, ptr %85, align 4
-  %86 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %83, align 8
-  %87 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %86, 1
-  %88 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringSlice"(%"github.com/goplus/llgo/internal/runtime.String" %86, i64 5, i64 %87)
-  %89 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %88, 1
-  %90 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %91 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %90, i32 0, i32 0
-  store ptr @0, ptr %91, align 8
-  %92 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %90, i32 0, i32 1
-  store i64 5, ptr %92, align 4
-  %93 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %90, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %93)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %77)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %82)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %89)
+  %76 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %77 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %76, i32 0, i32 0
+  store ptr @0, ptr %77, align 8
+  %78 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %76, i32 0, i32 1
+  store i64 5, ptr %78, align 4
+  %79 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %76, align 8
+  %80 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %79, 1
+  %81 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringSlice"(%"github.com/goplus/llgo/internal/runtime.String" %79, i64 1, i64 %80)
+  %82 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %83 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %82, i32 0, i32 0
+  store ptr @0, ptr %83, align 8
+  %84 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %82, i32 0, i32 1
+  store i64 5, ptr %84, align 4
+  %85 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %82, align 8
+  %86 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringSlice"(%"github.com/goplus/llgo/internal/runtime.String" %85, i64 1, i64 2)
+  %87 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %88 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %87, i32 0, i32 0
+  store ptr @0, ptr %88, align 8
+  %89 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %87, i32 0, i32 1
+  store i64 5, ptr %89, align 4
+  %90 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %87, align 8
+  %91 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %90, 1
+  %92 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringSlice"(%"github.com/goplus/llgo/internal/runtime.String" %90, i64 5, i64 %91)
+  %93 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %92, 1
+  %94 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %95 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %94, i32 0, i32 0
+  store ptr @0, ptr %95, align 8
+  %96 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %94, i32 0, i32 1
+  store i64 5, ptr %96, align 4
+  %97 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %94, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %97)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %81)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %86)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %93)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %94 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 32)
-  %95 = getelementptr inbounds i64, ptr %94, i64 0
-  store i64 5, ptr %95, align 4
-  %96 = getelementptr inbounds i64, ptr %94, i64 1
-  store i64 6, ptr %96, align 4
-  %97 = getelementptr inbounds i64, ptr %94, i64 2
-  store i64 7, ptr %97, align 4
-  %98 = getelementptr inbounds i64, ptr %94, i64 3
-  store i64 8, ptr %98, align 4
-  %99 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %100 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %99, i32 0, i32 0
-  store ptr %94, ptr %100, align 8
-  %101 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %99, i32 0, i32 1
-  store i64 4, ptr %101, align 4
-  %102 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %99, i32 0, i32 2
-  store i64 4, ptr %102, align 4
-  %103 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %99, align 8
-  %104 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %103, 0
-  %105 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %103, 1
-  %106 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.SliceAppend"(%"github.com/goplus/llgo/internal/runtime.Slice" %11, ptr %104, i64 %105, i64 8)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %106)
+  %98 = alloca [4 x i64], align 8
+  %99 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %98, i64 32)
+  %100 = getelementptr inbounds i64, ptr %99, i64 0
+  store i64 5, ptr %100, align 4
+  %101 = getelementptr inbounds i64, ptr %99, i64 1
+  store i64 6, ptr %101, align 4
+  %102 = getelementptr inbounds i64, ptr %99, i64 2
+  store i64 7, ptr %102, align 4
+  %103 = getelementptr inbounds i64, ptr %99, i64 3
+  store i64 8, ptr %103, align 4
+  %104 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %105 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %104, i32 0, i32 0
+  store ptr %99, ptr %105, align 8
+  %106 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %104, i32 0, i32 1
+  store i64 4, ptr %106, align 4
+  %107 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %104, i32 0, i32 2
+  store i64 4, ptr %107, align 4
+  %108 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %104, align 8
+  %109 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %108, 0
+  %110 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %108, 1
+  %111 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.SliceAppend"(%"github.com/goplus/llgo/internal/runtime.Slice" %12, ptr %109, i64 %110, i64 8)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %111)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %107 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 3)
-  %108 = getelementptr inbounds i8, ptr %107, i64 0
-  store i8 97, ptr %108, align 1
-  %109 = getelementptr inbounds i8, ptr %107, i64 1
-  store i8 98, ptr %109, align 1
-  %110 = getelementptr inbounds i8, ptr %107, i64 2
-  store i8 99, ptr %110, align 1
-  %111 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %112 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %111, i32 0, i32 0
-  store ptr %107, ptr %112, align 8
-  %113 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %111, i32 0, i32 1
-  store i64 3, ptr %113, align 4
-  %114 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %111, i32 0, i32 2
-  store i64 3, ptr %114, align 4
-  %115 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %111, align 8
-  %116 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %117 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %116, i32 0, i32 0
-  store ptr @1, ptr %117, align 8
-  %118 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %116, i32 0, i32 1
-  store i64 3, ptr %118, align 4
-  %119 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %116, align 8
-  %120 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %119, 0
-  %121 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %119, 1
-  %122 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.SliceAppend"(%"github.com/goplus/llgo/internal/runtime.Slice" %115, ptr %120, i64 %121, i64 1)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %122)
+  %112 = alloca [3 x i8], align 1
+  %113 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %112, i64 3)
+  %114 = getelementptr inbounds i8, ptr %113, i64 0
+  store i8 97, ptr %114, align 1
+  %115 = getelementptr inbounds i8, ptr %113, i64 1
+  store i8 98, ptr %115, align 1
+  %116 = getelementptr inbounds i8, ptr %113, i64 2
+  store i8 99, ptr %116, align 1
+  %117 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %118 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %117, i32 0, i32 0
+  store ptr %113, ptr %118, align 8
+  %119 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %117, i32 0, i32 1
+  store i64 3, ptr %119, align 4
+  %120 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %117, i32 0, i32 2
+  store i64 3, ptr %120, align 4
+  %121 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %117, align 8
+  %122 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %123 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %122, i32 0, i32 0
+  store ptr @1, ptr %123, align 8
+  %124 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %122, i32 0, i32 1
+  store i64 3, ptr %124, align 4
+  %125 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %122, align 8
+  %126 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %125, 0
+  %127 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %125, 1
+  %128 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.SliceAppend"(%"github.com/goplus/llgo/internal/runtime.Slice" %121, ptr %126, i64 %127, i64 1)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %128)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %123 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 16)
-  %124 = load ptr, ptr @_llgo_int, align 8
-  %125 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %126 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %125, i32 0, i32 0
-  store ptr %124, ptr %126, align 8
-  %127 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %125, i32 0, i32 1
-  store ptr inttoptr (i64 100 to ptr), ptr %127, align 8
-  %128 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %125, align 8
-  store %"github.com/goplus/llgo/internal/runtime.eface" %128, ptr %123, align 8
-  %129 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %123, align 8
-  %130 = ptrtoint ptr %123 to i64
+  %129 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 16)
+  %130 = load ptr, ptr @_llgo_int, align 8
+  %131 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %132 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %131, i32 0, i32 0
+  store ptr %130, ptr %132, align 8
+  %133 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %131, i32 0, i32 1
+  store ptr inttoptr (i64 100 to ptr), ptr %133, align 8
+  %134 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %131, align 8
+  store %"github.com/goplus/llgo/internal/runtime.eface" %134, ptr %129, align 8
+  %135 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %129, align 8
+  %136 = ptrtoint ptr %129 to i64
   call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 true)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 0)
@@ -334,282 +340,280 @@ _llgo_0:
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double 1.005000e+02)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintEface"(%"github.com/goplus/llgo/internal/runtime.eface" %129)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintEface"(%"github.com/goplus/llgo/internal/runtime.eface" %135)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr %123)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr %129)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %130)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %136)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %131 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 3)
-  %132 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 8)
-  %133 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %134 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %133, i32 0, i32 0
-  store ptr %131, ptr %134, align 8
-  %135 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %133, i32 0, i32 1
-  store i64 3, ptr %135, align 4
-  %136 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %133, i32 0, i32 2
-  store i64 3, ptr %136, align 4
-  %137 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %133, align 8
-  %138 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %122, 0
-  %139 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %122, 1
-  %140 = call i64 @"github.com/goplus/llgo/internal/runtime.SliceCopy"(%"github.com/goplus/llgo/internal/runtime.Slice" %137, ptr %138, i64 %139, i64 1)
-  store i64 %140, ptr %132, align 4
-  %141 = load i64, ptr %132, align 4
-  %142 = getelementptr inbounds i8, ptr %131, i64 0
-  %143 = load i8, ptr %142, align 1
-  %144 = getelementptr inbounds i8, ptr %131, i64 1
-  %145 = load i8, ptr %144, align 1
-  %146 = getelementptr inbounds i8, ptr %131, i64 2
-  %147 = load i8, ptr %146, align 1
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %141)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %148 = zext i8 %143 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %148)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %149 = zext i8 %145 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %149)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %150 = zext i8 %147 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %150)
+  %137 = alloca [3 x i8], align 1
+  %138 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %137, i64 3)
+  %139 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 8)
+  %140 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %141 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %140, i32 0, i32 0
+  store ptr %138, ptr %141, align 8
+  %142 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %140, i32 0, i32 1
+  store i64 3, ptr %142, align 4
+  %143 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %140, i32 0, i32 2
+  store i64 3, ptr %143, align 4
+  %144 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %140, align 8
+  %145 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %128, 0
+  %146 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %128, 1
+  %147 = call i64 @"github.com/goplus/llgo/internal/runtime.SliceCopy"(%"github.com/goplus/llgo/internal/runtime.Slice" %144, ptr %145, i64 %146, i64 1)
+  store i64 %147, ptr %139, align 4
+  %148 = load i64, ptr %139, align 4
+  %149 = getelementptr inbounds i8, ptr %138, i64 0
+  %150 = load i8, ptr %149, align 1
+  %151 = getelementptr inbounds i8, ptr %138, i64 1
+  %152 = load i8, ptr %151, align 1
+  %153 = getelementptr inbounds i8, ptr %138, i64 2
+  %154 = load i8, ptr %153, align 1
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %148)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %155 = zext i8 %150 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %155)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %156 = zext i8 %152 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %156)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %157 = zext i8 %154 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %157)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %151 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %131, i64 1, i64 3, i64 1, i64 3, i64 3)
-  %152 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %153 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %152, i32 0, i32 0
-  store ptr @2, ptr %153, align 8
-  %154 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %152, i32 0, i32 1
-  store i64 4, ptr %154, align 4
-  %155 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %152, align 8
-  %156 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %155, 0
-  %157 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %155, 1
-  %158 = call i64 @"github.com/goplus/llgo/internal/runtime.SliceCopy"(%"github.com/goplus/llgo/internal/runtime.Slice" %151, ptr %156, i64 %157, i64 1)
-  store i64 %158, ptr %132, align 4
-  %159 = load i64, ptr %132, align 4
-  %160 = getelementptr inbounds i8, ptr %131, i64 0
-  %161 = load i8, ptr %160, align 1
-  %162 = getelementptr inbounds i8, ptr %131, i64 1
-  %163 = load i8, ptr %162, align 1
-  %164 = getelementptr inbounds i8, ptr %131, i64 2
-  %165 = load i8, ptr %164, align 1
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %159)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %166 = zext i8 %161 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %166)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %167 = zext i8 %163 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %167)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %168 = zext i8 %165 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %168)
+  %158 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr %138, i64 1, i64 3, i64 1, i64 3, i64 3)
+  %159 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %160 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %159, i32 0, i32 0
+  store ptr @2, ptr %160, align 8
+  %161 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %159, i32 0, i32 1
+  store i64 4, ptr %161, align 4
+  %162 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %159, align 8
+  %163 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %162, 0
+  %164 = extractvalue %"github.com/goplus/llgo/internal/runtime.String" %162, 1
+  %165 = call i64 @"github.com/goplus/llgo/internal/runtime.SliceCopy"(%"github.com/goplus/llgo/internal/runtime.Slice" %158, ptr %163, i64 %164, i64 1)
+  store i64 %165, ptr %139, align 4
+  %166 = load i64, ptr %139, align 4
+  %167 = getelementptr inbounds i8, ptr %138, i64 0
+  %168 = load i8, ptr %167, align 1
+  %169 = getelementptr inbounds i8, ptr %138, i64 1
+  %170 = load i8, ptr %169, align 1
+  %171 = getelementptr inbounds i8, ptr %138, i64 2
+  %172 = load i8, ptr %171, align 1
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %166)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %173 = zext i8 %168 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %173)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %174 = zext i8 %170 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %174)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %175 = zext i8 %172 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintUint"(i64 %175)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %169 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %170 = getelementptr inbounds { ptr }, ptr %169, i32 0, i32 0
-  store ptr %132, ptr %170, align 8
-  %171 = alloca { ptr, ptr }, align 8
-  %172 = getelementptr inbounds { ptr, ptr }, ptr %171, i32 0, i32 0
-  store ptr @"main.main$2", ptr %172, align 8
-  %173 = getelementptr inbounds { ptr, ptr }, ptr %171, i32 0, i32 1
-  store ptr %169, ptr %173, align 8
-  %174 = load { ptr, ptr }, ptr %171, align 8
+  %176 = alloca { ptr, ptr }, align 8
+  %177 = getelementptr inbounds { ptr, ptr }, ptr %176, i32 0, i32 0
+  store ptr @"main.main$2", ptr %177, align 8
+  %178 = getelementptr inbounds { ptr, ptr }, ptr %176, i32 0, i32 1
+  store ptr %139, ptr %178, align 8
+  %179 = load { ptr, ptr }, ptr %176, align 8
   call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr @main.demo)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr @main.demo)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr @"main.main$1")
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %175 = extractvalue { ptr, ptr } %174, 0
-  call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr %175)
+  %180 = extractvalue { ptr, ptr } %179, 0
+  call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr %180)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %176 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %177 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %176, i32 0, i32 0
-  store ptr @3, ptr %177, align 8
-  %178 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %176, i32 0, i32 1
-  store i64 7, ptr %178, align 4
-  %179 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %176, align 8
-  %180 = call ptr @"github.com/goplus/llgo/internal/runtime.NewStringIter"(%"github.com/goplus/llgo/internal/runtime.String" %179)
+  %181 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %182 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %181, i32 0, i32 0
+  store ptr @3, ptr %182, align 8
+  %183 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %181, i32 0, i32 1
+  store i64 7, ptr %183, align 4
+  %184 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %181, align 8
+  %185 = call ptr @"github.com/goplus/llgo/internal/runtime.NewStringIter"(%"github.com/goplus/llgo/internal/runtime.String" %184)
   br label %_llgo_1
 
 _llgo_1:                                          ; preds = %_llgo_2, %_llgo_0
-  %181 = call { i1, i64, i32 } @"github.com/goplus/llgo/internal/runtime.StringIterNext"(ptr %180)
-  %182 = extractvalue { i1, i64, i32 } %181, 0
-  br i1 %182, label %_llgo_2, label %_llgo_3
+  %186 = call { i1, i64, i32 } @"github.com/goplus/llgo/internal/runtime.StringIterNext"(ptr %185)
+  %187 = extractvalue { i1, i64, i32 } %186, 0
+  br i1 %187, label %_llgo_2, label %_llgo_3
 
 _llgo_2:                                          ; preds = %_llgo_1
-  %183 = extractvalue { i1, i64, i32 } %181, 1
-  %184 = extractvalue { i1, i64, i32 } %181, 2
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %183)
+  %188 = extractvalue { i1, i64, i32 } %186, 1
+  %189 = extractvalue { i1, i64, i32 } %186, 2
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %188)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  %185 = sext i32 %184 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %185)
+  %190 = sext i32 %189 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %190)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   br label %_llgo_1
 
 _llgo_3:                                          ; preds = %_llgo_1
-  %186 = call double @main.Inf(i64 1)
-  %187 = call double @main.Inf(i64 -1)
-  %188 = call double @main.NaN()
-  %189 = call double @main.NaN()
-  %190 = call i1 @main.IsNaN(double %189)
-  %191 = call i1 @main.IsNaN(double 1.000000e+00)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double %186)
+  %191 = call double @main.Inf(i64 1)
+  %192 = call double @main.Inf(i64 -1)
+  %193 = call double @main.NaN()
+  %194 = call double @main.NaN()
+  %195 = call i1 @main.IsNaN(double %194)
+  %196 = call i1 @main.IsNaN(double 1.000000e+00)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double %191)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double %187)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double %192)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double %188)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintFloat"(double %193)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %190)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %195)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %191)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %196)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %192 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %193 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %192, i32 0, i32 0
-  store ptr @3, ptr %193, align 8
-  %194 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %192, i32 0, i32 1
-  store i64 7, ptr %194, align 4
-  %195 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %192, align 8
-  %196 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.StringToBytes"(%"github.com/goplus/llgo/internal/runtime.String" %195)
   %197 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %198 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %197, i32 0, i32 0
   store ptr @3, ptr %198, align 8
   %199 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %197, i32 0, i32 1
   store i64 7, ptr %199, align 4
   %200 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %197, align 8
-  %201 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.StringToRunes"(%"github.com/goplus/llgo/internal/runtime.String" %200)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %196)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  %201 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.StringToBytes"(%"github.com/goplus/llgo/internal/runtime.String" %200)
+  %202 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %203 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %202, i32 0, i32 0
+  store ptr @3, ptr %203, align 8
+  %204 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %202, i32 0, i32 1
+  store i64 7, ptr %204, align 4
+  %205 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %202, align 8
+  %206 = call %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.StringToRunes"(%"github.com/goplus/llgo/internal/runtime.String" %205)
   call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %201)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice" %206)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %202 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromBytes"(%"github.com/goplus/llgo/internal/runtime.Slice" %196)
-  %203 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRunes"(%"github.com/goplus/llgo/internal/runtime.Slice" %201)
-  %204 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %196, 0
-  %205 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %196, 1
-  %206 = icmp sge i64 3, %205
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %206)
-  %207 = getelementptr inbounds i8, ptr %204, i64 3
-  %208 = load i8, ptr %207, align 1
-  %209 = sext i8 %208 to i32
-  %210 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRune"(i32 %209)
-  %211 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %201, 0
-  %212 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %201, 1
-  %213 = icmp sge i64 0, %212
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %213)
-  %214 = getelementptr inbounds i32, ptr %211, i64 0
-  %215 = load i32, ptr %214, align 4
-  %216 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRune"(i32 %215)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %202)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %203)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %210)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %216)
+  %207 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromBytes"(%"github.com/goplus/llgo/internal/runtime.Slice" %201)
+  %208 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRunes"(%"github.com/goplus/llgo/internal/runtime.Slice" %206)
+  %209 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %201, 0
+  %210 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %201, 1
+  %211 = icmp sge i64 3, %210
+  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %211)
+  %212 = getelementptr inbounds i8, ptr %209, i64 3
+  %213 = load i8, ptr %212, align 1
+  %214 = sext i8 %213 to i32
+  %215 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRune"(i32 %214)
+  %216 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %206, 0
+  %217 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %206, 1
+  %218 = icmp sge i64 0, %217
+  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %218)
+  %219 = getelementptr inbounds i32, ptr %216, i64 0
+  %220 = load i32, ptr %219, align 4
+  %221 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRune"(i32 %220)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %207)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %208)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %215)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %221)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %217 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %218 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %217, i32 0, i32 0
-  store ptr @4, ptr %218, align 8
-  %219 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %217, i32 0, i32 1
-  store i64 3, ptr %219, align 4
-  %220 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %217, align 8
-  %221 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %222 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %221, i32 0, i32 0
-  store ptr @4, ptr %222, align 8
-  %223 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %221, i32 0, i32 1
-  store i64 3, ptr %223, align 4
-  %224 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %221, align 8
-  %225 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %220, %"github.com/goplus/llgo/internal/runtime.String" %224)
+  %222 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %223 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %222, i32 0, i32 0
+  store ptr @4, ptr %223, align 8
+  %224 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %222, i32 0, i32 1
+  store i64 3, ptr %224, align 4
+  %225 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %222, align 8
   %226 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %227 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %226, i32 0, i32 0
   store ptr @4, ptr %227, align 8
   %228 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %226, i32 0, i32 1
   store i64 3, ptr %228, align 4
   %229 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %226, align 8
-  %230 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %231 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %230, i32 0, i32 0
-  store ptr @5, ptr %231, align 8
-  %232 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %230, i32 0, i32 1
-  store i64 3, ptr %232, align 4
-  %233 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %230, align 8
-  %234 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %229, %"github.com/goplus/llgo/internal/runtime.String" %233)
+  %230 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %225, %"github.com/goplus/llgo/internal/runtime.String" %229)
+  %231 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %232 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %231, i32 0, i32 0
+  store ptr @4, ptr %232, align 8
+  %233 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %231, i32 0, i32 1
+  store i64 3, ptr %233, align 4
+  %234 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %231, align 8
   %235 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %236 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %235, i32 0, i32 0
-  store ptr @4, ptr %236, align 8
+  store ptr @5, ptr %236, align 8
   %237 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %235, i32 0, i32 1
   store i64 3, ptr %237, align 4
   %238 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %235, align 8
-  %239 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %240 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %239, i32 0, i32 0
-  store ptr @5, ptr %240, align 8
-  %241 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %239, i32 0, i32 1
-  store i64 3, ptr %241, align 4
-  %242 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %239, align 8
-  %243 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %238, %"github.com/goplus/llgo/internal/runtime.String" %242)
-  %244 = xor i1 %243, true
-  %245 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %246 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %245, i32 0, i32 0
-  store ptr @4, ptr %246, align 8
-  %247 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %245, i32 0, i32 1
-  store i64 3, ptr %247, align 4
-  %248 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %245, align 8
-  %249 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %250 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %249, i32 0, i32 0
-  store ptr @5, ptr %250, align 8
-  %251 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %249, i32 0, i32 1
-  store i64 3, ptr %251, align 4
-  %252 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %249, align 8
-  %253 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %248, %"github.com/goplus/llgo/internal/runtime.String" %252)
+  %239 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %234, %"github.com/goplus/llgo/internal/runtime.String" %238)
+  %240 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %241 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %240, i32 0, i32 0
+  store ptr @4, ptr %241, align 8
+  %242 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %240, i32 0, i32 1
+  store i64 3, ptr %242, align 4
+  %243 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %240, align 8
+  %244 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %245 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %244, i32 0, i32 0
+  store ptr @5, ptr %245, align 8
+  %246 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %244, i32 0, i32 1
+  store i64 3, ptr %246, align 4
+  %247 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %244, align 8
+  %248 = call i1 @"github.com/goplus/llgo/internal/runtime.StringEqual"(%"github.com/goplus/llgo/internal/runtime.String" %243, %"github.com/goplus/llgo/internal/runtime.String" %247)
+  %249 = xor i1 %248, true
+  %250 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %251 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %250, i32 0, i32 0
+  store ptr @4, ptr %251, align 8
+  %252 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %250, i32 0, i32 1
+  store i64 3, ptr %252, align 4
+  %253 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %250, align 8
   %254 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %255 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %254, i32 0, i32 0
-  store ptr @4, ptr %255, align 8
+  store ptr @5, ptr %255, align 8
   %256 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %254, i32 0, i32 1
   store i64 3, ptr %256, align 4
   %257 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %254, align 8
-  %258 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %259 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %258, i32 0, i32 0
-  store ptr @5, ptr %259, align 8
-  %260 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %258, i32 0, i32 1
-  store i64 3, ptr %260, align 4
-  %261 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %258, align 8
-  %262 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %261, %"github.com/goplus/llgo/internal/runtime.String" %257)
-  %263 = xor i1 %262, true
-  %264 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %265 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %264, i32 0, i32 0
-  store ptr @4, ptr %265, align 8
-  %266 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %264, i32 0, i32 1
-  store i64 3, ptr %266, align 4
-  %267 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %264, align 8
-  %268 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %269 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %268, i32 0, i32 0
-  store ptr @5, ptr %269, align 8
-  %270 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %268, i32 0, i32 1
-  store i64 3, ptr %270, align 4
-  %271 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %268, align 8
-  %272 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %271, %"github.com/goplus/llgo/internal/runtime.String" %267)
+  %258 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %253, %"github.com/goplus/llgo/internal/runtime.String" %257)
+  %259 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %260 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %259, i32 0, i32 0
+  store ptr @4, ptr %260, align 8
+  %261 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %259, i32 0, i32 1
+  store i64 3, ptr %261, align 4
+  %262 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %259, align 8
+  %263 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %264 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %263, i32 0, i32 0
+  store ptr @5, ptr %264, align 8
+  %265 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %263, i32 0, i32 1
+  store i64 3, ptr %265, align 4
+  %266 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %263, align 8
+  %267 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %266, %"github.com/goplus/llgo/internal/runtime.String" %262)
+  %268 = xor i1 %267, true
+  %269 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %270 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %269, i32 0, i32 0
+  store ptr @4, ptr %270, align 8
+  %271 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %269, i32 0, i32 1
+  store i64 3, ptr %271, align 4
+  %272 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %269, align 8
   %273 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %274 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %273, i32 0, i32 0
-  store ptr @4, ptr %274, align 8
+  store ptr @5, ptr %274, align 8
   %275 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %273, i32 0, i32 1
   store i64 3, ptr %275, align 4
   %276 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %273, align 8
-  %277 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %278 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %277, i32 0, i32 0
-  store ptr @5, ptr %278, align 8
-  %279 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %277, i32 0, i32 1
-  store i64 3, ptr %279, align 4
-  %280 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %277, align 8
-  %281 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %276, %"github.com/goplus/llgo/internal/runtime.String" %280)
-  %282 = xor i1 %281, true
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %225)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %234)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %244)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %253)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %263)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %272)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %282)
+  %277 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %276, %"github.com/goplus/llgo/internal/runtime.String" %272)
+  %278 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %279 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %278, i32 0, i32 0
+  store ptr @4, ptr %279, align 8
+  %280 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %278, i32 0, i32 1
+  store i64 3, ptr %280, align 4
+  %281 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %278, align 8
+  %282 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %283 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %282, i32 0, i32 0
+  store ptr @5, ptr %283, align 8
+  %284 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %282, i32 0, i32 1
+  store i64 3, ptr %284, align 4
+  %285 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %282, align 8
+  %286 = call i1 @"github.com/goplus/llgo/internal/runtime.StringLess"(%"github.com/goplus/llgo/internal/runtime.String" %281, %"github.com/goplus/llgo/internal/runtime.String" %285)
+  %287 = xor i1 %286, true
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %230)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %239)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %249)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %258)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %268)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %277)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %287)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret i32 0
 }
@@ -629,10 +633,8 @@ _llgo_0:
 
 define void @"main.main$2"(ptr %0) {
 _llgo_0:
-  %1 = load { ptr }, ptr %0, align 8
-  %2 = extractvalue { ptr } %1, 0
-  %3 = load i64, ptr %2, align 4
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %3)
+  %1 = load i64, ptr %0, align 4
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %1)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret void
 }
@@ -641,6 +643,8 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.init"()
 
+declare ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr, i64)
+
 declare %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.NewSlice3"(ptr, i64, i64, i64, i64, i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintSlice"(%"github.com/goplus/llgo/internal/runtime.Slice")
@@ -684,8 +688,6 @@ declare void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr)
 
 declare i64 @"github.com/goplus/llgo/internal/runtime.SliceCopy"(%"github.com/goplus/llgo/internal/runtime.Slice", ptr, i64, i64)
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
-
 declare ptr @"github.com/goplus/llgo/internal/runtime.NewStringIter"(%"github.com/goplus/llgo/internal/runtime.String")
 
 declare { i1, i64, i32 } @"github.com/goplus/llgo/internal/runtime.StringIterNext"(ptr)
@@ -35,18 +35,15 @@ _llgo_0:
   store ptr null, ptr %5, align 8
   %6 = load { ptr, ptr }, ptr %3, align 8
   store { ptr, ptr } %6, ptr %2, align 8
-  %7 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %8 = getelementptr inbounds { ptr }, ptr %7, i32 0, i32 0
-  store ptr %2, ptr %8, align 8
-  %9 = alloca { ptr, ptr }, align 8
-  %10 = getelementptr inbounds { ptr, ptr }, ptr %9, i32 0, i32 0
-  store ptr @"main.main$3", ptr %10, align 8
-  %11 = getelementptr inbounds { ptr, ptr }, ptr %9, i32 0, i32 1
-  store ptr %7, ptr %11, align 8
-  %12 = load { ptr, ptr }, ptr %9, align 8
-  %13 = extractvalue { ptr, ptr } %12, 1
-  %14 = extractvalue { ptr, ptr } %12, 0
-  call void %14(ptr %13)
+  %7 = alloca { ptr, ptr }, align 8
+  %8 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 0
+  store ptr @"main.main$3", ptr %8, align 8
+  %9 = getelementptr inbounds { ptr, ptr }, ptr %7, i32 0, i32 1
+  store ptr %2, ptr %9, align 8
+  %10 = load { ptr, ptr }, ptr %7, align 8
+  %11 = extractvalue { ptr, ptr } %10, 1
+  %12 = extractvalue { ptr, ptr } %10, 0
+  call void %12(ptr %11)
   ret i32 0
 }
 
@@ -64,12 +61,10 @@ _llgo_0:
 
 define void @"main.main$3"(ptr %0) {
 _llgo_0:
-  %1 = load { ptr }, ptr %0, align 8
-  %2 = extractvalue { ptr } %1, 0
-  %3 = load { ptr, ptr }, ptr %2, align 8
-  %4 = extractvalue { ptr, ptr } %3, 1
-  %5 = extractvalue { ptr, ptr } %3, 0
-  call void %5(ptr %4, i64 100, i64 200)
+  %1 = load { ptr, ptr }, ptr %0, align 8
+  %2 = extractvalue { ptr, ptr } %1, 1
+  %3 = extractvalue { ptr, ptr } %1, 0
+  call void %3(ptr %2, i64 100, i64 200)
   ret void
 }
 
@@ -83,6 +78,4 @@ _llgo_0:
   ret void
 }
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
-
 declare i32 @printf(ptr, ...)
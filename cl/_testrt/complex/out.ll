@@ -60,29 +60,14 @@ _llgo_0:
   %17 = load { double, double }, ptr %14, align 8
   call void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double } %17)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %18 = alloca { double, double }, align 8
-  %19 = getelementptr inbounds { double, double }, ptr %18, i32 0, i32 0
-  store double 4.400000e-01, ptr %19, align 8
-  %20 = getelementptr inbounds { double, double }, ptr %18, i32 0, i32 1
-  store double -8.000000e-02, ptr %20, align 8
-  %21 = load { double, double }, ptr %18, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double } %21)
+  %18 = call { double, double } @"github.com/goplus/llgo/internal/runtime.Complex128Div"({ double, double } { double 1.000000e+00, double 2.000000e+00 }, { double, double } { double 3.000000e+00, double 4.000000e+00 })
+  call void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double } %18)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %22 = alloca { double, double }, align 8
-  %23 = getelementptr inbounds { double, double }, ptr %22, i32 0, i32 0
-  store double 0x7FF0000000000000, ptr %23, align 8
-  %24 = getelementptr inbounds { double, double }, ptr %22, i32 0, i32 1
-  store double 0x7FF0000000000000, ptr %24, align 8
-  %25 = load { double, double }, ptr %22, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double } %25)
+  %19 = call { double, double } @"github.com/goplus/llgo/internal/runtime.Complex128Div"({ double, double } { double 1.000000e+00, double 2.000000e+00 }, { double, double } zeroinitializer)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double } %19)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %26 = alloca { double, double }, align 8
-  %27 = getelementptr inbounds { double, double }, ptr %26, i32 0, i32 0
-  store double 0x7FF8000000000000, ptr %27, align 8
-  %28 = getelementptr inbounds { double, double }, ptr %26, i32 0, i32 1
-  store double 0x7FF8000000000000, ptr %28, align 8
-  %29 = load { double, double }, ptr %26, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double } %29)
+  %20 = call { double, double } @"github.com/goplus/llgo/internal/runtime.Complex128Div"({ double, double } zeroinitializer, { double, double } zeroinitializer)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double } %20)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 true)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
@@ -92,28 +77,28 @@ _llgo_0:
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 true)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %30 = alloca { float, float }, align 8
-  %31 = getelementptr inbounds { float, float }, ptr %30, i32 0, i32 0
-  store float 1.000000e+00, ptr %31, align 4
-  %32 = getelementptr inbounds { float, float }, ptr %30, i32 0, i32 1
-  store float 2.000000e+00, ptr %32, align 4
-  %33 = load { float, float }, ptr %30, align 4
-  %34 = extractvalue { float, float } %33, 0
-  %35 = extractvalue { float, float } %33, 1
-  %36 = fpext float %34 to double
-  %37 = fpext float %35 to double
-  %38 = alloca { double, double }, align 8
-  %39 = getelementptr inbounds { double, double }, ptr %38, i32 0, i32 0
-  store double %36, ptr %39, align 8
-  %40 = getelementptr inbounds { double, double }, ptr %38, i32 0, i32 1
-  store double %37, ptr %40, align 8
-  %41 = load { double, double }, ptr %38, align 8
-  %42 = extractvalue { double, double } %41, 0
-  %43 = extractvalue { double, double } %41, 1
-  %44 = fcmp oeq double %42, 1.000000e+00
-  %45 = fcmp oeq double %43, 2.000000e+00
-  %46 = and i1 %44, %45
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %46)
+  %21 = alloca { float, float }, align 8
+  %22 = getelementptr inbounds { float, float }, ptr %21, i32 0, i32 0
+  store float 1.000000e+00, ptr %22, align 4
+  %23 = getelementptr inbounds { float, float }, ptr %21, i32 0, i32 1
+  store float 2.000000e+00, ptr %23, align 4
+  %24 = load { float, float }, ptr %21, align 4
+  %25 = extractvalue { float, float } %24, 0
+  %26 = extractvalue { float, float } %24, 1
+  %27 = fpext float %25 to double
+  %28 = fpext float %26 to double
+  %29 = alloca { double, double }, align 8
+  %30 = getelementptr inbounds { double, double }, ptr %29, i32 0, i32 0
+  store double %27, ptr %30, align 8
+  %31 = getelementptr inbounds { double, double }, ptr %29, i32 0, i32 1
+  store double %28, ptr %31, align 8
+  %32 = load { double, double }, ptr %29, align 8
+  %33 = extractvalue { double, double } %32, 0
+  %34 = extractvalue { double, double } %32, 1
+  %35 = fcmp oeq double %33, 1.000000e+00
+  %36 = fcmp oeq double %34, 2.000000e+00
+  %37 = and i1 %35, %36
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %37)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret i32 0
 }
@@ -126,4 +111,6 @@ declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintComplex"({ double, double })
 
+declare { double, double } @"github.com/goplus/llgo/internal/runtime.Complex128Div"({ double, double }, { double, double })
+
 declare void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1)
@@ -135,39 +135,41 @@ _llgo_0:
   %70 = call %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRune"(i32 %69)
   call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %70)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %71 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 16)
-  %72 = getelementptr inbounds i64, ptr %71, i64 0
-  %73 = getelementptr inbounds i64, ptr %71, i64 1
-  store i64 1, ptr %72, align 4
-  store i64 2, ptr %73, align 4
-  %74 = getelementptr inbounds i64, ptr %71, i64 1
-  %75 = load i64, ptr %74, align 4
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %75)
+  %71 = alloca [2 x i64], align 8
+  %72 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %71, i64 16)
+  %73 = getelementptr inbounds i64, ptr %72, i64 0
+  %74 = getelementptr inbounds i64, ptr %72, i64 1
+  store i64 1, ptr %73, align 4
+  store i64 2, ptr %74, align 4
+  %75 = getelementptr inbounds i64, ptr %72, i64 1
+  %76 = load i64, ptr %75, align 4
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %76)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %76 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 32)
-  %77 = getelementptr inbounds i64, ptr %76, i64 0
-  store i64 1, ptr %77, align 4
-  %78 = getelementptr inbounds i64, ptr %76, i64 1
-  store i64 2, ptr %78, align 4
-  %79 = getelementptr inbounds i64, ptr %76, i64 2
-  store i64 3, ptr %79, align 4
-  %80 = getelementptr inbounds i64, ptr %76, i64 3
-  store i64 4, ptr %80, align 4
-  %81 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
-  %82 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %81, i32 0, i32 0
-  store ptr %76, ptr %82, align 8
-  %83 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %81, i32 0, i32 1
-  store i64 4, ptr %83, align 4
-  %84 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %81, i32 0, i32 2
-  store i64 4, ptr %84, align 4
-  %85 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %81, align 8
-  %86 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %85, 0
-  %87 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %85, 1
-  %88 = icmp sge i64 1, %87
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %88)
-  %89 = getelementptr inbounds i64, ptr %86, i64 1
-  %90 = load i64, ptr %89, align 4
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %90)
+  %77 = alloca [4 x i64], align 8
+  %78 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %77, i64 32)
+  %79 = getelementptr inbounds i64, ptr %78, i64 0
+  store i64 1, ptr %79, align 4
+  %80 = getelementptr inbounds i64, ptr %78, i64 1
+  store i64 2, ptr %80, align 4
+  %81 = getelementptr inbounds i64, ptr %78, i64 2
+  store i64 3, ptr %81, align 4
+  %82 = getelementptr inbounds i64, ptr %78, i64 3
+  store i64 4, ptr %82, align 4
+  %83 = alloca %"github.com/goplus/llgo/internal/runtime.Slice", align 8
+  %84 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %83, i32 0, i32 0
+  store ptr %78, ptr %84, align 8
+  %85 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %83, i32 0, i32 1
+  store i64 4, ptr %85, align 4
+  %86 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.Slice", ptr %83, i32 0, i32 2
+  store i64 4, ptr %86, align 4
+  %87 = load %"github.com/goplus/llgo/internal/runtime.Slice", ptr %83, align 8
+  %88 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %87, 0
+  %89 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %87, 1
+  %90 = icmp sge i64 1, %89
+  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %90)
+  %91 = getelementptr inbounds i64, ptr %88, i64 1
+  %92 = load i64, ptr %91, align 4
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %92)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 0)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
@@ -187,5 +189,3 @@ declare void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1)
 declare %"github.com/goplus/llgo/internal/runtime.String" @"github.com/goplus/llgo/internal/runtime.StringFromRune"(i32)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String")
-
-declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
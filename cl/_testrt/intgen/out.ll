@@ -103,70 +103,64 @@ _llgo_2:                                          ; preds = %_llgo_1
 _llgo_3:                                          ; preds = %_llgo_1
   %19 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 4)
   store i32 1, ptr %19, align 4
-  %20 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %21 = getelementptr inbounds { ptr }, ptr %20, i32 0, i32 0
-  store ptr %19, ptr %21, align 8
-  %22 = alloca { ptr, ptr }, align 8
-  %23 = getelementptr inbounds { ptr, ptr }, ptr %22, i32 0, i32 0
-  store ptr @"main.main$1", ptr %23, align 8
-  %24 = getelementptr inbounds { ptr, ptr }, ptr %22, i32 0, i32 1
-  store ptr %20, ptr %24, align 8
-  %25 = load { ptr, ptr }, ptr %22, align 8
-  %26 = call %"github.com/goplus/llgo/internal/runtime.Slice" @main.genInts(i64 5, { ptr, ptr } %25)
-  %27 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %26, 1
+  %20 = alloca { ptr, ptr }, align 8
+  %21 = getelementptr inbounds { ptr, ptr }, ptr %20, i32 0, i32 0
+  store ptr @"main.main$1", ptr %21, align 8
+  %22 = getelementptr inbounds { ptr, ptr }, ptr %20, i32 0, i32 1
+  store ptr %19, ptr %22, align 8
+  %23 = load { ptr, ptr }, ptr %20, align 8
+  %24 = call %"github.com/goplus/llgo/internal/runtime.Slice" @main.genInts(i64 5, { ptr, ptr } %23)
+  %25 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %24, 1
   br label %_llgo_4
 
 _llgo_4:                                          ; preds = %_llgo_5, %_llgo_3
-  %28 = phi i64 [ -1, %_llgo_3 ], [ %29, %_llgo_5 ]
-  %29 = add i64 %28, 1
-  %30 = icmp slt i64 %29, %27
-  br i1 %30, label %_llgo_5, label %_llgo_6
+  %26 = phi i64 [ -1, %_llgo_3 ], [ %27, %_llgo_5 ]
+  %27 = add i64 %26, 1
+  %28 = icmp slt i64 %27, %25
+  br i1 %28, label %_llgo_5, label %_llgo_6
 
 _llgo_5:                                          ; preds = %_llgo_4
-  %31 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %26, 0
-  %32 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %26, 1
-  %33 = icmp slt i64 %29, 0
-  %34 = icmp sge i64 %29, %32
-  %35 = or i1 %34, %33
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %35)
-  %36 = getelementptr inbounds i32, ptr %31, i64 %29
-  %37 = load i32, ptr %36, align 4
-  %38 = call i32 (ptr, ...) @printf(ptr @1, i32 %37)
+  %29 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %24, 0
+  %30 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %24, 1
+  %31 = icmp slt i64 %27, 0
+  %32 = icmp sge i64 %27, %30
+  %33 = or i1 %32, %31
+  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %33)
+  %34 = getelementptr inbounds i32, ptr %29, i64 %27
+  %35 = load i32, ptr %34, align 4
+  %36 = call i32 (ptr, ...) @printf(ptr @1, i32 %35)
   br label %_llgo_4
 
 _llgo_6:                                          ; preds = %_llgo_4
-  %39 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 4)
-  %40 = getelementptr inbounds %main.generator, ptr %39, i32 0, i32 0
-  store i32 1, ptr %40, align 4
-  %41 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %42 = getelementptr inbounds { ptr }, ptr %41, i32 0, i32 0
-  store ptr %39, ptr %42, align 8
-  %43 = alloca { ptr, ptr }, align 8
-  %44 = getelementptr inbounds { ptr, ptr }, ptr %43, i32 0, i32 0
-  store ptr @"main.next$bound", ptr %44, align 8
-  %45 = getelementptr inbounds { ptr, ptr }, ptr %43, i32 0, i32 1
-  store ptr %41, ptr %45, align 8
-  %46 = load { ptr, ptr }, ptr %43, align 8
-  %47 = call %"github.com/goplus/llgo/internal/runtime.Slice" @main.genInts(i64 5, { ptr, ptr } %46)
-  %48 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %47, 1
+  %37 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64 4)
+  %38 = getelementptr inbounds %main.generator, ptr %37, i32 0, i32 0
+  store i32 1, ptr %38, align 4
+  %39 = alloca { ptr, ptr }, align 8
+  %40 = getelementptr inbounds { ptr, ptr }, ptr %39, i32 0, i32 0
+  store ptr @"main.next$bound", ptr %40, align 8
+  %41 = getelementptr inbounds { ptr, ptr }, ptr %39, i32 0, i32 1
+  store ptr %37, ptr %41, align 8
+  %42 = load { ptr, ptr }, ptr %39, align 8
+  %43 = call %"github.com/goplus/llgo/internal/runtime.Slice" @main.genInts(i64 5, { ptr, ptr } %42)
+  %44 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %43, 1
   br label %_llgo_7
 
 _llgo_7:                                          ; preds = %_llgo_8, %_llgo_6
-  %49 = phi i64 [ -1, %_llgo_6 ], [ %50, %_llgo_8 ]
-  %50 = add i64 %49, 1
-  %51 = icmp slt i64 %50, %48
-  br i1 %51, label %_llgo_8, label %_llgo_9
+  %45 = phi i64 [ -1, %_llgo_6 ], [ %46, %_llgo_8 ]
+  %46 = add i64 %45, 1
+  %47 = icmp slt i64 %46, %44
+  br i1 %47, label %_llgo_8, label %_llgo_9
 
 _llgo_8:                                          ; preds = %_llgo_7
-  %52 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %47, 0
-  %53 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %47, 1
-  %54 = icmp slt i64 %50, 0
-  %55 = icmp sge i64 %50, %53
-  %56 = or i1 %55, %54
-  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %56)
-  %57 = getelementptr inbounds i32, ptr %52, i64 %50
-  %58 = load i32, ptr %57, align 4
-  %59 = call i32 (ptr, ...) @printf(ptr @2, i32 %58)
+  %48 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %43, 0
+  %49 = extractvalue %"github.com/goplus/llgo/internal/runtime.Slice" %43, 1
+  %50 = icmp slt i64 %46, 0
+  %51 = icmp sge i64 %46, %49
+  %52 = or i1 %51, %50
+  call void @"github.com/goplus/llgo/internal/runtime.AssertIndexRange"(i1 %52)
+  %53 = getelementptr inbounds i32, ptr %48, i64 %46
+  %54 = load i32, ptr %53, align 4
+  %55 = call i32 (ptr, ...) @printf(ptr @2, i32 %54)
   br label %_llgo_7
 
 _llgo_9:                                          ; preds = %_llgo_7
@@ -175,15 +169,11 @@ _llgo_9:                                          ; preds = %_llgo_7
 
 define i32 @"main.main$1"(ptr %0) {
 _llgo_0:
-  %1 = load { ptr }, ptr %0, align 8
-  %2 = extractvalue { ptr } %1, 0
-  %3 = load i32, ptr %2, align 4
-  %4 = mul i32 %3, 2
-  %5 = extractvalue { ptr } %1, 0
-  store i32 %4, ptr %5, align 4
-  %6 = extractvalue { ptr } %1, 0
-  %7 = load i32, ptr %6, align 4
-  ret i32 %7
+  %1 = load i32, ptr %0, align 4
+  %2 = mul i32 %1, 2
+  store i32 %2, ptr %0, align 4
+  %3 = load i32, ptr %0, align 4
+  ret i32 %3
 }
 
 declare %"github.com/goplus/llgo/internal/runtime.Slice" @"github.com/goplus/llgo/internal/runtime.MakeSlice"(i64, i64, i64)
@@ -204,12 +194,8 @@ declare i32 @printf(ptr, ...)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
-
 define i32 @"main.next$bound"(ptr %0) {
 _llgo_0:
-  %1 = load { ptr }, ptr %0, align 8
-  %2 = extractvalue { ptr } %1, 0
-  %3 = call i32 @"main.(*generator).next"(ptr %2)
-  ret i32 %3
+  %1 = call i32 @"main.(*generator).next"(ptr %0)
+  ret i32 %1
 }
@@ -30,21 +30,20 @@ source_filename = "main"
 @_llgo_int = linkonce global ptr null, align 8
 @"[1]_llgo_int" = linkonce global ptr null, align 8
 @12 = private unnamed_addr constant [2 x i8] c"N1", align 1
-@13 = private unnamed_addr constant [21 x i8] c"type assertion failed", align 1
 @_llgo_main.K = linkonce global ptr null, align 8
 @_llgo_main.N = linkonce global ptr null, align 8
 @"main.struct$e65EDK9vxC36Nz3YTgO1ulssLlNH03Bva_WWaCjH-4A" = global ptr null, align 8
-@14 = private unnamed_addr constant [2 x i8] c"n1", align 1
-@15 = private unnamed_addr constant [2 x i8] c"n2", align 1
-@16 = private unnamed_addr constant [1 x i8] c"N", align 1
+@13 = private unnamed_addr constant [2 x i8] c"n1", align 1
+@14 = private unnamed_addr constant [2 x i8] c"n2", align 1
+@15 = private unnamed_addr constant [1 x i8] c"N", align 1
 @"[1]_llgo_main.N" = linkonce global ptr null, align 8
-@17 = private unnamed_addr constant [1 x i8] c"K", align 1
+@16 = private unnamed_addr constant [1 x i8] c"K", align 1
 @_llgo_main.K2 = linkonce global ptr null, align 8
 @"*_llgo_main.N" = linkonce global ptr null, align 8
 @"[1]*_llgo_main.N" = linkonce global ptr null, align 8
-@18 = private unnamed_addr constant [2 x i8] c"K2", align 1
+@17 = private unnamed_addr constant [2 x i8] c"K2", align 1
 @"chan _llgo_int" = linkonce global ptr null, align 8
-@19 = private unnamed_addr constant [4 x i8] c"chan", align 1
+@18 = private unnamed_addr constant [4 x i8] c"chan", align 1
 @"map[chan _llgo_int]_llgo_int" = linkonce global ptr null, align 8
 
 define void @main.init() {
@@ -122,7 +121,7 @@ _llgo_0:
   store i64 2, ptr %28, align 4
   %29 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAccess1"(ptr %27, ptr %1, ptr %28)
   %30 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %29, align 8
-  %31 = load i64, ptr %1, align 4
+  %31 = call i64 @"github.com/goplus/llgo/internal/runtime.MapLen"(ptr %1)
   call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr %1)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %26)
@@ -158,7 +157,7 @@ _llgo_2:                                          ; preds = %_llgo_13
   br label %_llgo_1
 
 _llgo_3:                                          ; preds = %_llgo_13
-  %42 = load i64, ptr %1, align 4
+  %42 = call i64 @"github.com/goplus/llgo/internal/runtime.MapLen"(ptr %1)
   %43 = load ptr, ptr @"map[_llgo_string]_llgo_int", align 8
   %44 = call ptr @"github.com/goplus/llgo/internal/runtime.MakeMap"(ptr %43, i64 %42)
   %45 = load ptr, ptr @"map[_llgo_int]_llgo_string", align 8
@@ -300,7 +299,7 @@ _llgo_7:                                          ; preds = %_llgo_6
   unreachable
 
 _llgo_8:                                          ; preds = %_llgo_6
-  %126 = load i64, ptr %44, align 4
+  %126 = call i64 @"github.com/goplus/llgo/internal/runtime.MapLen"(ptr %44)
   %127 = icmp ne i64 %126, 2
   br i1 %127, label %_llgo_9, label %_llgo_10
 
@@ -393,14 +392,16 @@ define void @main.make2() {
 _llgo_0:
   %0 = load ptr, ptr @"map[_llgo_int]_llgo_string", align 8
   %1 = call ptr @"github.com/goplus/llgo/internal/runtime.MakeMap"(ptr %0, i64 0)
-  %2 = load i64, ptr %1, align 4
+  %2 = call i64 @"github.com/goplus/llgo/internal/runtime.MapLen"(ptr %1)
+  %3 = icmp eq ptr %1, null
+  %4 = icmp ne ptr %1, null
   call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr %1)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %2)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 false)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %3)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 true)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %4)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   call void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr null)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
@@ -410,333 +411,303 @@ _llgo_0:
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
   call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 false)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %3 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %4 = call ptr @"github.com/goplus/llgo/internal/runtime.MakeMap"(ptr %3, i64 0)
-  %5 = alloca [1 x i64], align 8
-  %6 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %5, i64 8)
-  %7 = getelementptr inbounds i64, ptr %6, i64 0
-  store i64 1, ptr %7, align 4
-  %8 = load [1 x i64], ptr %6, align 4
-  %9 = load ptr, ptr @_llgo_main.N1, align 8
-  %10 = extractvalue [1 x i64] %8, 0
-  %11 = inttoptr i64 %10 to ptr
-  %12 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %13 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %12, i32 0, i32 0
-  store ptr %9, ptr %13, align 8
-  %14 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %12, i32 0, i32 1
-  store ptr %11, ptr %14, align 8
-  %15 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %12, align 8
-  %16 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %17 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.eface" %15, ptr %17, align 8
-  %18 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %16, ptr %4, ptr %17)
-  store i64 100, ptr %18, align 4
-  %19 = alloca [1 x i64], align 8
-  %20 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %19, i64 8)
-  %21 = getelementptr inbounds i64, ptr %20, i64 0
-  store i64 2, ptr %21, align 4
-  %22 = load [1 x i64], ptr %20, align 4
-  %23 = load ptr, ptr @_llgo_main.N1, align 8
-  %24 = extractvalue [1 x i64] %22, 0
-  %25 = inttoptr i64 %24 to ptr
-  %26 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %27 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %26, i32 0, i32 0
-  store ptr %23, ptr %27, align 8
-  %28 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %26, i32 0, i32 1
-  store ptr %25, ptr %28, align 8
-  %29 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %26, align 8
-  %30 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %31 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.eface" %29, ptr %31, align 8
-  %32 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %30, ptr %4, ptr %31)
-  store i64 200, ptr %32, align 4
-  %33 = alloca [1 x i64], align 8
-  %34 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %33, i64 8)
-  %35 = getelementptr inbounds i64, ptr %34, i64 0
-  store i64 3, ptr %35, align 4
-  %36 = load [1 x i64], ptr %34, align 4
-  %37 = load ptr, ptr @_llgo_main.N1, align 8
-  %38 = extractvalue [1 x i64] %36, 0
-  %39 = inttoptr i64 %38 to ptr
-  %40 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %41 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %40, i32 0, i32 0
-  store ptr %37, ptr %41, align 8
-  %42 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %40, i32 0, i32 1
-  store ptr %39, ptr %42, align 8
-  %43 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %40, align 8
-  %44 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %45 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.eface" %43, ptr %45, align 8
-  %46 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %44, ptr %4, ptr %45)
-  store i64 300, ptr %46, align 4
-  %47 = alloca [1 x i64], align 8
-  %48 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %47, i64 8)
-  %49 = getelementptr inbounds i64, ptr %48, i64 0
-  store i64 2, ptr %49, align 4
-  %50 = load [1 x i64], ptr %48, align 4
-  %51 = load ptr, ptr @_llgo_main.N1, align 8
-  %52 = extractvalue [1 x i64] %50, 0
-  %53 = inttoptr i64 %52 to ptr
-  %54 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %55 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %54, i32 0, i32 0
-  store ptr %51, ptr %55, align 8
-  %56 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %54, i32 0, i32 1
-  store ptr %53, ptr %56, align 8
-  %57 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %54, align 8
-  %58 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %59 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.eface" %57, ptr %59, align 8
-  %60 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %58, ptr %4, ptr %59)
-  store i64 -200, ptr %60, align 4
-  %61 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %62 = call ptr @"github.com/goplus/llgo/internal/runtime.NewMapIter"(ptr %61, ptr %4)
+  %5 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %6 = call ptr @"github.com/goplus/llgo/internal/runtime.MakeMap"(ptr %5, i64 0)
+  %7 = alloca [1 x i64], align 8
+  %8 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %7, i64 8)
+  %9 = getelementptr inbounds i64, ptr %8, i64 0
+  store i64 1, ptr %9, align 4
+  %10 = load [1 x i64], ptr %8, align 4
+  %11 = load ptr, ptr @_llgo_main.N1, align 8
+  %12 = extractvalue [1 x i64] %10, 0
+  %13 = inttoptr i64 %12 to ptr
+  %14 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %15 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %14, i32 0, i32 0
+  store ptr %11, ptr %15, align 8
+  %16 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %14, i32 0, i32 1
+  store ptr %13, ptr %16, align 8
+  %17 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %14, align 8
+  %18 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %19 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.eface" %17, ptr %19, align 8
+  %20 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %18, ptr %6, ptr %19)
+  store i64 100, ptr %20, align 4
+  %21 = alloca [1 x i64], align 8
+  %22 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %21, i64 8)
+  %23 = getelementptr inbounds i64, ptr %22, i64 0
+  store i64 2, ptr %23, align 4
+  %24 = load [1 x i64], ptr %22, align 4
+  %25 = load ptr, ptr @_llgo_main.N1, align 8
+  %26 = extractvalue [1 x i64] %24, 0
+  %27 = inttoptr i64 %26 to ptr
+  %28 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %29 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %28, i32 0, i32 0
+  store ptr %25, ptr %29, align 8
+  %30 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %28, i32 0, i32 1
+  store ptr %27, ptr %30, align 8
+  %31 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %28, align 8
+  %32 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %33 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.eface" %31, ptr %33, align 8
+  %34 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %32, ptr %6, ptr %33)
+  store i64 200, ptr %34, align 4
+  %35 = alloca [1 x i64], align 8
+  %36 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %35, i64 8)
+  %37 = getelementptr inbounds i64, ptr %36, i64 0
+  store i64 3, ptr %37, align 4
+  %38 = load [1 x i64], ptr %36, align 4
+  %39 = load ptr, ptr @_llgo_main.N1, align 8
+  %40 = extractvalue [1 x i64] %38, 0
+  %41 = inttoptr i64 %40 to ptr
+  %42 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %43 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %42, i32 0, i32 0
+  store ptr %39, ptr %43, align 8
+  %44 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %42, i32 0, i32 1
+  store ptr %41, ptr %44, align 8
+  %45 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %42, align 8
+  %46 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %47 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.eface" %45, ptr %47, align 8
+  %48 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %46, ptr %6, ptr %47)
+  store i64 300, ptr %48, align 4
+  %49 = alloca [1 x i64], align 8
+  %50 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %49, i64 8)
+  %51 = getelementptr inbounds i64, ptr %50, i64 0
+  store i64 2, ptr %51, align 4
+  %52 = load [1 x i64], ptr %50, align 4
+  %53 = load ptr, ptr @_llgo_main.N1, align 8
+  %54 = extractvalue [1 x i64] %52, 0
+  %55 = inttoptr i64 %54 to ptr
+  %56 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %57 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %56, i32 0, i32 0
+  store ptr %53, ptr %57, align 8
+  %58 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %56, i32 0, i32 1
+  store ptr %55, ptr %58, align 8
+  %59 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %56, align 8
+  %60 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %61 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.eface" %59, ptr %61, align 8
+  %62 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %60, ptr %6, ptr %61)
+  store i64 -200, ptr %62, align 4
+  %63 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %64 = call ptr @"github.com/goplus/llgo/internal/runtime.NewMapIter"(ptr %63, ptr %6)
   br label %_llgo_1
 
 _llgo_1:                                          ; preds = %_llgo_7, %_llgo_0
-  %63 = call { i1, ptr, ptr } @"github.com/goplus/llgo/internal/runtime.MapIterNext"(ptr %62)
-  %64 = extractvalue { i1, ptr, ptr } %63, 0
-  br i1 %64, label %_llgo_4, label %_llgo_5
+  %65 = call { i1, ptr, ptr } @"github.com/goplus/llgo/internal/runtime.MapIterNext"(ptr %64)
+  %66 = extractvalue { i1, ptr, ptr } %65, 0
+  br i1 %66, label %_llgo_4, label %_llgo_5
 
 _llgo_2:                                          ; preds = %_llgo_6
-  %65 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %84, 1
-  %66 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %84, 2
-  %67 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %65, 0
-  %68 = load ptr, ptr @_llgo_main.N1, align 8
-  %69 = icmp eq ptr %67, %68
-  br i1 %69, label %_llgo_7, label %_llgo_8
+  %67 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %86, 1
+  %68 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %86, 2
+  %69 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %67, 0
+  %70 = load ptr, ptr @_llgo_main.N1, align 8
+  %71 = icmp eq ptr %69, %70
+  br i1 %71, label %_llgo_7, label %_llgo_8
 
 _llgo_3:                                          ; preds = %_llgo_6
   ret void
 
 _llgo_4:                                          ; preds = %_llgo_1
-  %70 = extractvalue { i1, ptr, ptr } %63, 1
-  %71 = extractvalue { i1, ptr, ptr } %63, 2
-  %72 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %70, align 8
-  %73 = load i64, ptr %71, align 4
-  %74 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
-  %75 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %74, i32 0, i32 0
-  store i1 true, ptr %75, align 1
-  %76 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %74, i32 0, i32 1
-  store %"github.com/goplus/llgo/internal/runtime.eface" %72, ptr %76, align 8
-  %77 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %74, i32 0, i32 2
-  store i64 %73, ptr %77, align 4
-  %78 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %74, align 8
+  %72 = extractvalue { i1, ptr, ptr } %65, 1
+  %73 = extractvalue { i1, ptr, ptr } %65, 2
+  %74 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %72, align 8
+  %75 = load i64, ptr %73, align 4
+  %76 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
+  %77 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, i32 0, i32 0
+  store i1 true, ptr %77, align 1
+  %78 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.eface" %74, ptr %78, align 8
+  %79 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, i32 0, i32 2
+  store i64 %75, ptr %79, align 4
+  %80 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, align 8
   br label %_llgo_6
 
 _llgo_5:                                          ; preds = %_llgo_1
-  %79 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
-  %80 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %79, i32 0, i32 0
-  store i1 false, ptr %80, align 1
-  %81 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %79, i32 0, i32 1
-  store %"github.com/goplus/llgo/internal/runtime.eface" zeroinitializer, ptr %81, align 8
-  %82 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %79, i32 0, i32 2
-  store i64 0, ptr %82, align 4
-  %83 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %79, align 8
+  %81 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
+  %82 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %81, i32 0, i32 0
+  store i1 false, ptr %82, align 1
+  %83 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %81, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.eface" zeroinitializer, ptr %83, align 8
+  %84 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %81, i32 0, i32 2
+  store i64 0, ptr %84, align 4
+  %85 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %81, align 8
   br label %_llgo_6
 
 _llgo_6:                                          ; preds = %_llgo_5, %_llgo_4
-  %84 = phi { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } [ %78, %_llgo_4 ], [ %83, %_llgo_5 ]
-  %85 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %84, 0
-  br i1 %85, label %_llgo_2, label %_llgo_3
+  %86 = phi { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } [ %80, %_llgo_4 ], [ %85, %_llgo_5 ]
+  %87 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %86, 0
+  br i1 %87, label %_llgo_2, label %_llgo_3
 
 _llgo_7:                                          ; preds = %_llgo_2
-  %86 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %65, 1
-  %87 = ptrtoint ptr %86 to i64
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %87)
+  %88 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %67, 1
+  %89 = ptrtoint ptr %88 to i64
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %89)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %66)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %68)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   br label %_llgo_1
 
 _llgo_8:                                          ; preds = %_llgo_2
-  %88 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %89 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %88, i32 0, i32 0
-  store ptr @13, ptr %89, align 8
-  %90 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %88, i32 0, i32 1
-  store i64 21, ptr %90, align 4
-  %91 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %88, align 8
-  %92 = load ptr, ptr @_llgo_string, align 8
-  %93 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %91, ptr %93, align 8
-  %94 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %95 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %94, i32 0, i32 0
-  store ptr %92, ptr %95, align 8
-  %96 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %94, i32 0, i32 1
-  store ptr %93, ptr %96, align 8
-  %97 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %94, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %97)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %69, ptr %70)
   unreachable
 }
 
 define void @main.make3() {
 _llgo_0:
   %0 = alloca [1 x %main.N], align 8
-  %1 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %0, i64 2)
-  %2 = getelementptr inbounds %main.N, ptr %1, i64 0
-  %3 = getelementptr inbounds %main.N, ptr %2, i32 0, i32 0
-  %4 = getelementptr inbounds %main.N, ptr %2, i32 0, i32 1
-  store i8 1, ptr %3, align 1
-  store i8 2, ptr %4, align 1
-  %5 = load [1 x %main.N], ptr %1, align 1
-  %6 = load ptr, ptr @_llgo_main.K, align 8
-  %7 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
-  store [1 x %main.N] %5, ptr %7, align 1
-  %8 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %9 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %8, i32 0, i32 0
-  store ptr %6, ptr %9, align 8
-  %10 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %8, i32 0, i32 1
+  %1 = alloca [1 x %main.N], align 8
+  %2 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %0, i64 2)
+  %3 = getelementptr inbounds %main.N, ptr %2, i64 0
+  %4 = getelementptr inbounds %main.N, ptr %3, i32 0, i32 0
+  %5 = getelementptr inbounds %main.N, ptr %3, i32 0, i32 1
+  store i8 1, ptr %4, align 1
+  store i8 2, ptr %5, align 1
+  %6 = load [1 x %main.N], ptr %2, align 1
+  %7 = load ptr, ptr @_llgo_main.K, align 8
+  %8 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
+  store [1 x %main.N] %6, ptr %8, align 1
+  %9 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %10 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %9, i32 0, i32 0
   store ptr %7, ptr %10, align 8
-  %11 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %8, align 8
-  %12 = alloca [1 x %main.N], align 8
-  %13 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %12, i64 2)
-  %14 = getelementptr inbounds %main.N, ptr %13, i64 0
-  %15 = getelementptr inbounds %main.N, ptr %14, i32 0, i32 0
-  %16 = getelementptr inbounds %main.N, ptr %14, i32 0, i32 1
-  store i8 1, ptr %15, align 1
-  store i8 2, ptr %16, align 1
-  %17 = load [1 x %main.N], ptr %13, align 1
-  %18 = load ptr, ptr @_llgo_main.K, align 8
-  %19 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
-  store [1 x %main.N] %17, ptr %19, align 1
-  %20 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %21 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %20, i32 0, i32 0
-  store ptr %18, ptr %21, align 8
-  %22 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %20, i32 0, i32 1
+  %11 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %9, i32 0, i32 1
+  store ptr %8, ptr %11, align 8
+  %12 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %9, align 8
+  %13 = alloca [1 x %main.N], align 8
+  %14 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %13, i64 2)
+  %15 = getelementptr inbounds %main.N, ptr %14, i64 0
+  %16 = getelementptr inbounds %main.N, ptr %15, i32 0, i32 0
+  %17 = getelementptr inbounds %main.N, ptr %15, i32 0, i32 1
+  store i8 1, ptr %16, align 1
+  store i8 2, ptr %17, align 1
+  %18 = load [1 x %main.N], ptr %14, align 1
+  %19 = load ptr, ptr @_llgo_main.K, align 8
+  %20 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
+  store [1 x %main.N] %18, ptr %20, align 1
+  %21 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %22 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %21, i32 0, i32 0
   store ptr %19, ptr %22, align 8
-  %23 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %20, align 8
-  %24 = call i1 @"github.com/goplus/llgo/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/internal/runtime.eface" %11, %"github.com/goplus/llgo/internal/runtime.eface" %23)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %24)
+  %23 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %21, i32 0, i32 1
+  store ptr %20, ptr %23, align 8
+  %24 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %21, align 8
+  %25 = call i1 @"github.com/goplus/llgo/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/internal/runtime.eface" %12, %"github.com/goplus/llgo/internal/runtime.eface" %24)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %25)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %25 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %26 = call ptr @"github.com/goplus/llgo/internal/runtime.MakeMap"(ptr %25, i64 0)
-  %27 = alloca [1 x %main.N], align 8
-  %28 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %27, i64 2)
-  %29 = getelementptr inbounds %main.N, ptr %28, i64 0
-  %30 = getelementptr inbounds %main.N, ptr %29, i32 0, i32 0
-  %31 = getelementptr inbounds %main.N, ptr %29, i32 0, i32 1
-  store i8 1, ptr %30, align 1
-  store i8 2, ptr %31, align 1
-  %32 = load [1 x %main.N], ptr %28, align 1
-  %33 = load ptr, ptr @_llgo_main.K, align 8
-  %34 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
-  store [1 x %main.N] %32, ptr %34, align 1
-  %35 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %36 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %35, i32 0, i32 0
-  store ptr %33, ptr %36, align 8
-  %37 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %35, i32 0, i32 1
+  %26 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %27 = call ptr @"github.com/goplus/llgo/internal/runtime.MakeMap"(ptr %26, i64 0)
+  %28 = alloca [1 x %main.N], align 8
+  %29 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %28, i64 2)
+  %30 = getelementptr inbounds %main.N, ptr %29, i64 0
+  %31 = getelementptr inbounds %main.N, ptr %30, i32 0, i32 0
+  %32 = getelementptr inbounds %main.N, ptr %30, i32 0, i32 1
+  store i8 1, ptr %31, align 1
+  store i8 2, ptr %32, align 1
+  %33 = load [1 x %main.N], ptr %29, align 1
+  %34 = load ptr, ptr @_llgo_main.K, align 8
+  %35 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
+  store [1 x %main.N] %33, ptr %35, align 1
+  %36 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %37 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %36, i32 0, i32 0
   store ptr %34, ptr %37, align 8
-  %38 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %35, align 8
-  %39 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %40 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.eface" %38, ptr %40, align 8
-  %41 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %39, ptr %26, ptr %40)
-  store i64 100, ptr %41, align 4
-  %42 = alloca [1 x %main.N], align 8
-  %43 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %42, i64 2)
-  %44 = getelementptr inbounds %main.N, ptr %43, i64 0
-  %45 = getelementptr inbounds %main.N, ptr %44, i32 0, i32 0
-  %46 = getelementptr inbounds %main.N, ptr %44, i32 0, i32 1
-  store i8 3, ptr %45, align 1
-  store i8 4, ptr %46, align 1
-  %47 = load [1 x %main.N], ptr %43, align 1
-  %48 = load ptr, ptr @_llgo_main.K, align 8
-  %49 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
-  store [1 x %main.N] %47, ptr %49, align 1
-  %50 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %51 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, i32 0, i32 0
-  store ptr %48, ptr %51, align 8
-  %52 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, i32 0, i32 1
+  %38 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %36, i32 0, i32 1
+  store ptr %35, ptr %38, align 8
+  %39 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %36, align 8
+  %40 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %41 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.eface" %39, ptr %41, align 8
+  %42 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %40, ptr %27, ptr %41)
+  store i64 100, ptr %42, align 4
+  %43 = alloca [1 x %main.N], align 8
+  %44 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %43, i64 2)
+  %45 = getelementptr inbounds %main.N, ptr %44, i64 0
+  %46 = getelementptr inbounds %main.N, ptr %45, i32 0, i32 0
+  %47 = getelementptr inbounds %main.N, ptr %45, i32 0, i32 1
+  store i8 3, ptr %46, align 1
+  store i8 4, ptr %47, align 1
+  %48 = load [1 x %main.N], ptr %44, align 1
+  %49 = load ptr, ptr @_llgo_main.K, align 8
+  %50 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 2)
+  store [1 x %main.N] %48, ptr %50, align 1
+  %51 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %52 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %51, i32 0, i32 0
   store ptr %49, ptr %52, align 8
-  %53 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, align 8
-  %54 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %55 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.eface" %53, ptr %55, align 8
-  %56 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %54, ptr %26, ptr %55)
-  store i64 200, ptr %56, align 4
-  %57 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
-  %58 = call ptr @"github.com/goplus/llgo/internal/runtime.NewMapIter"(ptr %57, ptr %26)
+  %53 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %51, i32 0, i32 1
+  store ptr %50, ptr %53, align 8
+  %54 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %51, align 8
+  %55 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %56 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.eface" %54, ptr %56, align 8
+  %57 = call ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr %55, ptr %27, ptr %56)
+  store i64 200, ptr %57, align 4
+  %58 = load ptr, ptr @"map[_llgo_any]_llgo_int", align 8
+  %59 = call ptr @"github.com/goplus/llgo/internal/runtime.NewMapIter"(ptr %58, ptr %27)
   br label %_llgo_1
 
 _llgo_1:                                          ; preds = %_llgo_7, %_llgo_0
-  %59 = call { i1, ptr, ptr } @"github.com/goplus/llgo/internal/runtime.MapIterNext"(ptr %58)
-  %60 = extractvalue { i1, ptr, ptr } %59, 0
-  br i1 %60, label %_llgo_4, label %_llgo_5
+  %60 = call { i1, ptr, ptr } @"github.com/goplus/llgo/internal/runtime.MapIterNext"(ptr %59)
+  %61 = extractvalue { i1, ptr, ptr } %60, 0
+  br i1 %61, label %_llgo_4, label %_llgo_5
 
 _llgo_2:                                          ; preds = %_llgo_6
-  %61 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %80, 1
-  %62 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %80, 2
-  %63 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %61, 0
-  %64 = load ptr, ptr @_llgo_main.K, align 8
-  %65 = icmp eq ptr %63, %64
-  br i1 %65, label %_llgo_7, label %_llgo_8
+  %62 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %81, 1
+  %63 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %81, 2
+  %64 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %62, 0
+  %65 = load ptr, ptr @_llgo_main.K, align 8
+  %66 = icmp eq ptr %64, %65
+  br i1 %66, label %_llgo_7, label %_llgo_8
 
 _llgo_3:                                          ; preds = %_llgo_6
   ret void
 
 _llgo_4:                                          ; preds = %_llgo_1
-  %66 = extractvalue { i1, ptr, ptr } %59, 1
-  %67 = extractvalue { i1, ptr, ptr } %59, 2
-  %68 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %66, align 8
-  %69 = load i64, ptr %67, align 4
-  %70 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
-  %71 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %70, i32 0, i32 0
-  store i1 true, ptr %71, align 1
-  %72 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %70, i32 0, i32 1
-  store %"github.com/goplus/llgo/internal/runtime.eface" %68, ptr %72, align 8
-  %73 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %70, i32 0, i32 2
-  store i64 %69, ptr %73, align 4
-  %74 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %70, align 8
+  %67 = extractvalue { i1, ptr, ptr } %60, 1
+  %68 = extractvalue { i1, ptr, ptr } %60, 2
+  %69 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %67, align 8
+  %70 = load i64, ptr %68, align 4
+  %71 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
+  %72 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %71, i32 0, i32 0
+  store i1 true, ptr %72, align 1
+  %73 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %71, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.eface" %69, ptr %73, align 8
+  %74 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %71, i32 0, i32 2
+  store i64 %70, ptr %74, align 4
+  %75 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %71, align 8
   br label %_llgo_6
 
 _llgo_5:                                          ; preds = %_llgo_1
-  %75 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
-  %76 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %75, i32 0, i32 0
-  store i1 false, ptr %76, align 1
-  %77 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %75, i32 0, i32 1
-  store %"github.com/goplus/llgo/internal/runtime.eface" zeroinitializer, ptr %77, align 8
-  %78 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %75, i32 0, i32 2
-  store i64 0, ptr %78, align 4
-  %79 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %75, align 8
+  %76 = alloca { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, align 8
+  %77 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, i32 0, i32 0
+  store i1 false, ptr %77, align 1
+  %78 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, i32 0, i32 1
+  store %"github.com/goplus/llgo/internal/runtime.eface" zeroinitializer, ptr %78, align 8
+  %79 = getelementptr inbounds { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, i32 0, i32 2
+  store i64 0, ptr %79, align 4
+  %80 = load { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 }, ptr %76, align 8
   br label %_llgo_6
 
 _llgo_6:                                          ; preds = %_llgo_5, %_llgo_4
-  %80 = phi { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } [ %74, %_llgo_4 ], [ %79, %_llgo_5 ]
-  %81 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %80, 0
-  br i1 %81, label %_llgo_2, label %_llgo_3
+  %81 = phi { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } [ %75, %_llgo_4 ], [ %80, %_llgo_5 ]
+  %82 = extractvalue { i1, %"github.com/goplus/llgo/internal/runtime.eface", i64 } %81, 0
+  br i1 %82, label %_llgo_2, label %_llgo_3
 
 _llgo_7:                                          ; preds = %_llgo_2
-  %82 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %61, 1
-  %83 = load [1 x %main.N], ptr %82, align 1
-  %84 = alloca [1 x %main.N], align 8
-  %85 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %84, i64 2)
-  store [1 x %main.N] %83, ptr %85, align 1
+  %83 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %62, 1
+  %84 = load [1 x %main.N], ptr %83, align 1
+  %85 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %1, i64 2)
+  store [1 x %main.N] %84, ptr %85, align 1
   %86 = getelementptr inbounds %main.N, ptr %85, i64 0
   %87 = load %main.N, ptr %86, align 1
   %88 = extractvalue %main.N %87, 0
   %89 = sext i8 %88 to i64
   call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %89)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %62)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %63)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   br label %_llgo_1
 
 _llgo_8:                                          ; preds = %_llgo_2
-  %90 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %91 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %90, i32 0, i32 0
-  store ptr @13, ptr %91, align 8
-  %92 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %90, i32 0, i32 1
-  store i64 21, ptr %92, align 4
-  %93 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %90, align 8
-  %94 = load ptr, ptr @_llgo_string, align 8
-  %95 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %93, ptr %95, align 8
-  %96 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %97 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, i32 0, i32 0
-  store ptr %94, ptr %97, align 8
-  %98 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, i32 0, i32 1
-  store ptr %95, ptr %98, align 8
-  %99 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %99)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %64, ptr %65)
   unreachable
 }
 
@@ -892,22 +863,7 @@ _llgo_7:                                          ; preds = %_llgo_2
   br label %_llgo_1
 
 _llgo_8:                                          ; preds = %_llgo_2
-  %90 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %91 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %90, i32 0, i32 0
-  store ptr @13, ptr %91, align 8
-  %92 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %90, i32 0, i32 1
-  store i64 21, ptr %92, align 4
-  %93 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %90, align 8
-  %94 = load ptr, ptr @_llgo_string, align 8
-  %95 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %93, ptr %95, align 8
-  %96 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %97 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, i32 0, i32 0
-  store ptr %94, ptr %97, align 8
-  %98 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, i32 0, i32 1
-  store ptr %95, ptr %98, align 8
-  %99 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %96, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %99)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %67, ptr %68)
   unreachable
 }
 
@@ -1414,7 +1370,7 @@ _llgo_19:                                         ; preds = %_llgo_18
 _llgo_20:                                         ; preds = %_llgo_19, %_llgo_18
   %246 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %247 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %246, i32 0, i32 0
-  store ptr @14, ptr %247, align 8
+  store ptr @13, ptr %247, align 8
   %248 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %246, i32 0, i32 1
   store i64 2, ptr %248, align 4
   %249 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %246, align 8
@@ -1428,7 +1384,7 @@ _llgo_20:                                         ; preds = %_llgo_19, %_llgo_18
   %255 = call %"github.com/goplus/llgo/internal/abi.StructField" @"github.com/goplus/llgo/internal/runtime.StructField"(%"github.com/goplus/llgo/internal/runtime.String" %249, ptr %254, i64 0, %"github.com/goplus/llgo/internal/runtime.String" %253, i1 false)
   %256 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %257 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %256, i32 0, i32 0
-  store ptr @15, ptr %257, align 8
+  store ptr @14, ptr %257, align 8
   %258 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %256, i32 0, i32 1
   store i64 2, ptr %258, align 4
   %259 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %256, align 8
@@ -1473,7 +1429,7 @@ _llgo_21:                                         ; preds = %_llgo_20
   %283 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %280, align 8
   %284 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %285 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %284, i32 0, i32 0
-  store ptr @16, ptr %285, align 8
+  store ptr @15, ptr %285, align 8
   %286 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %284, i32 0, i32 1
   store i64 1, ptr %286, align 4
   %287 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %284, align 8
@@ -1504,7 +1460,7 @@ _llgo_25:                                         ; preds = %_llgo_24
   %296 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %293, align 8
   %297 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %298 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %297, i32 0, i32 0
-  store ptr @17, ptr %298, align 8
+  store ptr @16, ptr %298, align 8
   %299 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %297, i32 0, i32 1
   store i64 1, ptr %299, align 4
   %300 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %297, align 8
@@ -1559,7 +1515,7 @@ _llgo_33:                                         ; preds = %_llgo_32
   %316 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %313, align 8
   %317 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %318 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %317, i32 0, i32 0
-  store ptr @18, ptr %318, align 8
+  store ptr @17, ptr %318, align 8
   %319 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %317, i32 0, i32 1
   store i64 2, ptr %319, align 4
   %320 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %317, align 8
@@ -1574,7 +1530,7 @@ _llgo_34:                                         ; preds = %_llgo_33, %_llgo_32
 _llgo_35:                                         ; preds = %_llgo_34
   %323 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %324 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %323, i32 0, i32 0
-  store ptr @19, ptr %324, align 8
+  store ptr @18, ptr %324, align 8
   %325 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %323, i32 0, i32 1
   store i64 4, ptr %325, align 4
   %326 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %323, align 8
@@ -1592,7 +1548,7 @@ _llgo_36:                                         ; preds = %_llgo_35, %_llgo_34
 _llgo_37:                                         ; preds = %_llgo_36
   %331 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %332 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %331, i32 0, i32 0
-  store ptr @19, ptr %332, align 8
+  store ptr @18, ptr %332, align 8
   %333 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %331, i32 0, i32 1
   store i64 4, ptr %333, align 4
   %334 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %331, align 8
@@ -1628,7 +1584,7 @@ _llgo_37:                                         ; preds = %_llgo_36
   %356 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %353, align 8
   %357 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %358 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %357, i32 0, i32 0
-  store ptr @19, ptr %358, align 8
+  store ptr @18, ptr %358, align 8
   %359 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %357, i32 0, i32 1
   store i64 4, ptr %359, align 4
   %360 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %357, align 8
@@ -1718,6 +1674,8 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.MapAssign"(ptr, ptr, ptr)
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.MapAccess1"(ptr, ptr, ptr)
 
+declare i64 @"github.com/goplus/llgo/internal/runtime.MapLen"(ptr)
+
 declare void @"github.com/goplus/llgo/internal/runtime.PrintPointer"(ptr)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8)
@@ -1746,6 +1704,8 @@ declare ptr @"github.com/goplus/llgo/internal/runtime.NewNamed"(i64, i64, i64)
 
 declare void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr, %"github.com/goplus/llgo/internal/runtime.String", %"github.com/goplus/llgo/internal/runtime.String", ptr, %"github.com/goplus/llgo/internal/runtime.Slice", %"github.com/goplus/llgo/internal/runtime.Slice")
 
+declare void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr, ptr)
+
 declare i1 @"github.com/goplus/llgo/internal/runtime.EfaceEqual"(%"github.com/goplus/llgo/internal/runtime.eface", %"github.com/goplus/llgo/internal/runtime.eface")
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
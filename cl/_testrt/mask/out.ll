@@ -69,9 +69,8 @@ define i32 @main.mask(i8 %0) {
 _llgo_0:
   %1 = sext i8 %0 to i32
   %2 = shl i32 %1, 31
-  %3 = select i1 false, i32 0, i32 %2
-  %4 = ashr i32 %3, 31
-  ret i32 %4
+  %3 = ashr i32 %2, 31
+  ret i32 %3
 }
 
 define i64 @main.mask_shl(i64 %0, i64 %1) {
@@ -88,10 +87,10 @@ define i8 @main.mask_shl8(i8 %0, i64 %1) {
 _llgo_0:
   %2 = icmp slt i64 %1, 0
   call void @"github.com/goplus/llgo/internal/runtime.AssertNegativeShift"(i1 %2)
-  %3 = trunc i64 %1 to i8
-  %4 = icmp uge i8 %3, 8
-  %5 = shl i8 %0, %3
-  %6 = select i1 %4, i8 0, i8 %5
+  %3 = icmp uge i64 %1, 8
+  %4 = trunc i64 %1 to i8
+  %5 = shl i8 %0, %4
+  %6 = select i1 %3, i8 0, i8 %5
   ret i8 %6
 }
 
@@ -99,10 +98,10 @@ define i8 @main.mask_shl8u(i8 %0, i64 %1) {
 _llgo_0:
   %2 = icmp slt i64 %1, 0
   call void @"github.com/goplus/llgo/internal/runtime.AssertNegativeShift"(i1 %2)
-  %3 = trunc i64 %1 to i8
-  %4 = icmp uge i8 %3, 8
-  %5 = shl i8 %0, %3
-  %6 = select i1 %4, i8 0, i8 %5
+  %3 = icmp uge i64 %1, 8
+  %4 = trunc i64 %1 to i8
+  %5 = shl i8 %0, %4
+  %6 = select i1 %3, i8 0, i8 %5
   ret i8 %6
 }
 
@@ -120,9 +119,9 @@ define i8 @main.mask_shr8(i8 %0, i64 %1) {
 _llgo_0:
   %2 = icmp slt i64 %1, 0
   call void @"github.com/goplus/llgo/internal/runtime.AssertNegativeShift"(i1 %2)
-  %3 = trunc i64 %1 to i8
-  %4 = icmp uge i8 %3, 8
-  %5 = select i1 %4, i8 7, i8 %3
+  %3 = icmp uge i64 %1, 8
+  %4 = trunc i64 %1 to i8
+  %5 = select i1 %3, i8 7, i8 %4
   %6 = ashr i8 %0, %5
   ret i8 %6
 }
@@ -131,10 +130,10 @@ define i8 @main.mask_shr8u(i8 %0, i64 %1) {
 _llgo_0:
   %2 = icmp slt i64 %1, 0
   call void @"github.com/goplus/llgo/internal/runtime.AssertNegativeShift"(i1 %2)
-  %3 = trunc i64 %1 to i8
-  %4 = icmp uge i8 %3, 8
-  %5 = lshr i8 %0, %3
-  %6 = select i1 %4, i8 0, i8 %5
+  %3 = icmp uge i64 %1, 8
+  %4 = trunc i64 %1 to i8
+  %5 = lshr i8 %0, %4
+  %6 = select i1 %3, i8 0, i8 %5
   ret i8 %6
 }
 
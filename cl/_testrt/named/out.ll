@@ -71,73 +71,66 @@ _llgo_0:
   %33 = getelementptr inbounds %main.minfo, ptr %31, i32 0, i32 0
   store ptr %32, ptr %33, align 8
   %34 = load ptr, ptr %2, align 8
-  %35 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 8)
-  %36 = getelementptr inbounds { ptr }, ptr %35, i32 0, i32 0
-  store ptr %2, ptr %36, align 8
-  %37 = alloca { ptr, ptr }, align 8
-  %38 = getelementptr inbounds { ptr, ptr }, ptr %37, i32 0, i32 0
-  store ptr @"main.main$1", ptr %38, align 8
-  %39 = getelementptr inbounds { ptr, ptr }, ptr %37, i32 0, i32 1
-  store ptr %35, ptr %39, align 8
-  %40 = load { ptr, ptr }, ptr %37, align 8
-  %41 = getelementptr inbounds %main.mspan, ptr %34, i32 0, i32 5
-  store { ptr, ptr } %40, ptr %41, align 8
-  %42 = load ptr, ptr %2, align 8
-  %43 = getelementptr inbounds %main.mspan, ptr %42, i32 0, i32 0
-  %44 = load ptr, ptr %43, align 8
-  %45 = getelementptr inbounds %main.mspan, ptr %44, i32 0, i32 4
-  %46 = load i64, ptr %45, align 4
-  %47 = load ptr, ptr %2, align 8
-  %48 = getelementptr inbounds %main.mspan, ptr %47, i32 0, i32 2
+  %35 = alloca { ptr, ptr }, align 8
+  %36 = getelementptr inbounds { ptr, ptr }, ptr %35, i32 0, i32 0
+  store ptr @"main.main$1", ptr %36, align 8
+  %37 = getelementptr inbounds { ptr, ptr }, ptr %35, i32 0, i32 1
+  store ptr %2, ptr %37, align 8
+  %38 = load { ptr, ptr }, ptr %35, align 8
+  %39 = getelementptr inbounds %main.mspan, ptr %34, i32 0, i32 5
+  store { ptr, ptr } %38, ptr %39, align 8
+  %40 = load ptr, ptr %2, align 8
+  %41 = getelementptr inbounds %main.mspan, ptr %40, i32 0, i32 0
+  %42 = load ptr, ptr %41, align 8
+  %43 = getelementptr inbounds %main.mspan, ptr %42, i32 0, i32 4
+  %44 = load i64, ptr %43, align 4
+  %45 = load ptr, ptr %2, align 8
+  %46 = getelementptr inbounds %main.mspan, ptr %45, i32 0, i32 2
+  %47 = load ptr, ptr %46, align 8
+  %48 = getelementptr inbounds %main.mSpanList, ptr %47, i32 0, i32 1
   %49 = load ptr, ptr %48, align 8
-  %50 = getelementptr inbounds %main.mSpanList, ptr %49, i32 0, i32 1
-  %51 = load ptr, ptr %50, align 8
-  %52 = getelementptr inbounds %main.mspan, ptr %51, i32 0, i32 4
-  %53 = load i64, ptr %52, align 4
-  %54 = load ptr, ptr %2, align 8
-  %55 = getelementptr inbounds %main.mspan, ptr %54, i32 0, i32 3
-  %56 = getelementptr inbounds %main.minfo, ptr %55, i32 0, i32 1
-  %57 = load i64, ptr %56, align 4
-  %58 = load ptr, ptr %2, align 8
-  %59 = getelementptr inbounds %main.mspan, ptr %58, i32 0, i32 3
-  %60 = getelementptr inbounds %main.minfo, ptr %59, i32 0, i32 0
-  %61 = load ptr, ptr %60, align 8
-  %62 = getelementptr inbounds %main.mspan, ptr %61, i32 0, i32 4
-  %63 = load i64, ptr %62, align 4
-  %64 = load ptr, ptr %2, align 8
-  %65 = getelementptr inbounds %main.mspan, ptr %64, i32 0, i32 5
-  %66 = load { ptr, ptr }, ptr %65, align 8
-  %67 = extractvalue { ptr, ptr } %66, 1
-  %68 = extractvalue { ptr, ptr } %66, 0
-  %69 = call i64 %68(ptr %67, i64 -2)
-  %70 = load ptr, ptr %2, align 8
-  %71 = getelementptr inbounds %main.mspan, ptr %70, i32 0, i32 3
-  %72 = getelementptr inbounds %main.minfo, ptr %71, i32 0, i32 0
-  %73 = load ptr, ptr %72, align 8
-  %74 = getelementptr inbounds %main.mspan, ptr %73, i32 0, i32 5
-  %75 = load { ptr, ptr }, ptr %74, align 8
-  %76 = extractvalue { ptr, ptr } %75, 1
-  %77 = extractvalue { ptr, ptr } %75, 0
-  %78 = call i64 %77(ptr %76, i64 -3)
-  %79 = call i32 (ptr, ...) @printf(ptr @0, i64 %46, i64 %53, i64 %57, i64 %63, i64 %69, i64 %78)
+  %50 = getelementptr inbounds %main.mspan, ptr %49, i32 0, i32 4
+  %51 = load i64, ptr %50, align 4
+  %52 = load ptr, ptr %2, align 8
+  %53 = getelementptr inbounds %main.mspan, ptr %52, i32 0, i32 3
+  %54 = getelementptr inbounds %main.minfo, ptr %53, i32 0, i32 1
+  %55 = load i64, ptr %54, align 4
+  %56 = load ptr, ptr %2, align 8
+  %57 = getelementptr inbounds %main.mspan, ptr %56, i32 0, i32 3
+  %58 = getelementptr inbounds %main.minfo, ptr %57, i32 0, i32 0
+  %59 = load ptr, ptr %58, align 8
+  %60 = getelementptr inbounds %main.mspan, ptr %59, i32 0, i32 4
+  %61 = load i64, ptr %60, align 4
+  %62 = load ptr, ptr %2, align 8
+  %63 = getelementptr inbounds %main.mspan, ptr %62, i32 0, i32 5
+  %64 = load { ptr, ptr }, ptr %63, align 8
+  %65 = extractvalue { ptr, ptr } %64, 1
+  %66 = extractvalue { ptr, ptr } %64, 0
+  %67 = call i64 %66(ptr %65, i64 -2)
+  %68 = load ptr, ptr %2, align 8
+  %69 = getelementptr inbounds %main.mspan, ptr %68, i32 0, i32 3
+  %70 = getelementptr inbounds %main.minfo, ptr %69, i32 0, i32 0
+  %71 = load ptr, ptr %70, align 8
+  %72 = getelementptr inbounds %main.mspan, ptr %71, i32 0, i32 5
+  %73 = load { ptr, ptr }, ptr %72, align 8
+  %74 = extractvalue { ptr, ptr } %73, 1
+  %75 = extractvalue { ptr, ptr } %73, 0
+  %76 = call i64 %75(ptr %74, i64 -3)
+  %77 = call i32 (ptr, ...) @printf(ptr @0, i64 %44, i64 %51, i64 %55, i64 %61, i64 %67, i64 %76)
   ret i32 0
 }
 
 define i64 @"main.main$1"(ptr %0, i64 %1) {
 _llgo_0:
-  %2 = load { ptr }, ptr %0, align 8
-  %3 = extractvalue { ptr } %2, 0
-  %4 = load ptr, ptr %3, align 8
-  %5 = getelementptr inbounds %main.mspan, ptr %4, i32 0, i32 4
-  %6 = load i64, ptr %5, align 4
-  %7 = mul i64 %6, %1
-  ret i64 %7
+  %2 = load ptr, ptr %0, align 8
+  %3 = getelementptr inbounds %main.mspan, ptr %2, i32 0, i32 4
+  %4 = load i64, ptr %3, align 4
+  %5 = mul i64 %4, %1
+  ret i64 %5
 }
 
 declare void @"github.com/goplus/llgo/internal/runtime.init"()
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocZ"(i64)
 
-declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
-
 declare i32 @printf(ptr, ...)
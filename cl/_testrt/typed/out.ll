@@ -13,11 +13,10 @@ source_filename = "main"
 @_llgo_string = linkonce global ptr null, align 8
 @1 = private unnamed_addr constant [4 x i8] c"main", align 1
 @2 = private unnamed_addr constant [1 x i8] c"T", align 1
-@3 = private unnamed_addr constant [21 x i8] c"type assertion failed", align 1
 @_llgo_main.A = linkonce global ptr null, align 8
 @_llgo_int = linkonce global ptr null, align 8
 @"[2]_llgo_int" = linkonce global ptr null, align 8
-@4 = private unnamed_addr constant [1 x i8] c"A", align 1
+@3 = private unnamed_addr constant [1 x i8] c"A", align 1
 
 define void @main.init() {
 _llgo_0:
@@ -35,144 +34,129 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
 
 define i32 @main(i32 %0, ptr %1) {
 _llgo_0:
+  %2 = alloca [2 x i64], align 8
+  %3 = alloca [2 x i64], align 8
   store i32 %0, ptr @__llgo_argc, align 4
   store ptr %1, ptr @__llgo_argv, align 8
   call void @"github.com/goplus/llgo/internal/runtime.init"()
   call void @main.init()
-  %2 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %3 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 0
-  store ptr @0, ptr %3, align 8
-  %4 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %2, i32 0, i32 1
-  store i64 5, ptr %4, align 4
-  %5 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %2, align 8
-  %6 = load ptr, ptr @_llgo_main.T, align 8
-  %7 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %5, ptr %7, align 8
-  %8 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %9 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %8, i32 0, i32 0
-  store ptr %6, ptr %9, align 8
-  %10 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %8, i32 0, i32 1
-  store ptr %7, ptr %10, align 8
-  %11 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %8, align 8
-  %12 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %11, 0
-  %13 = load ptr, ptr @_llgo_main.T, align 8
-  %14 = icmp eq ptr %12, %13
-  br i1 %14, label %_llgo_1, label %_llgo_2
+  %4 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
+  %5 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 0
+  store ptr @0, ptr %5, align 8
+  %6 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %4, i32 0, i32 1
+  store i64 5, ptr %6, align 4
+  %7 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %4, align 8
+  %8 = load ptr, ptr @_llgo_main.T, align 8
+  %9 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store %"github.com/goplus/llgo/internal/runtime.String" %7, ptr %9, align 8
+  %10 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %11 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %10, i32 0, i32 0
+  store ptr %8, ptr %11, align 8
+  %12 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %10, i32 0, i32 1
+  store ptr %9, ptr %12, align 8
+  %13 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %10, align 8
+  %14 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %13, 0
+  %15 = load ptr, ptr @_llgo_main.T, align 8
+  %16 = icmp eq ptr %14, %15
+  br i1 %16, label %_llgo_1, label %_llgo_2
 
 _llgo_1:                                          ; preds = %_llgo_0
-  %15 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %11, 1
-  %16 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %15, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %16)
+  %17 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %13, 1
+  %18 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %17, align 8
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %18)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %17 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %11, 0
-  %18 = load ptr, ptr @_llgo_string, align 8
-  %19 = icmp eq ptr %17, %18
-  br i1 %19, label %_llgo_3, label %_llgo_4
+  %19 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %13, 0
+  %20 = load ptr, ptr @_llgo_string, align 8
+  %21 = icmp eq ptr %19, %20
+  br i1 %21, label %_llgo_3, label %_llgo_4
 
 _llgo_2:                                          ; preds = %_llgo_0
-  %20 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
-  %21 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %20, i32 0, i32 0
-  store ptr @3, ptr %21, align 8
-  %22 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %20, i32 0, i32 1
-  store i64 21, ptr %22, align 4
-  %23 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %20, align 8
-  %24 = load ptr, ptr @_llgo_string, align 8
-  %25 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store %"github.com/goplus/llgo/internal/runtime.String" %23, ptr %25, align 8
-  %26 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %27 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %26, i32 0, i32 0
-  store ptr %24, ptr %27, align 8
-  %28 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %26, i32 0, i32 1
-  store ptr %25, ptr %28, align 8
-  %29 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %26, align 8
-  call void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface" %29)
+  call void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr %14, ptr %15)
   unreachable
 
 _llgo_3:                                          ; preds = %_llgo_1
-  %30 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %11, 1
-  %31 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %30, align 8
-  %32 = alloca { %"github.com/goplus/llgo/internal/runtime.String", i1 }, align 8
-  %33 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %32, i32 0, i32 0
-  store %"github.com/goplus/llgo/internal/runtime.String" %31, ptr %33, align 8
-  %34 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %32, i32 0, i32 1
-  store i1 true, ptr %34, align 1
-  %35 = load { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %32, align 8
+  %22 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %13, 1
+  %23 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %22, align 8
+  %24 = alloca { %"github.com/goplus/llgo/internal/runtime.String", i1 }, align 8
+  %25 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %24, i32 0, i32 0
+  store %"github.com/goplus/llgo/internal/runtime.String" %23, ptr %25, align 8
+  %26 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %24, i32 0, i32 1
+  store i1 true, ptr %26, align 1
+  %27 = load { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %24, align 8
   br label %_llgo_5
 
 _llgo_4:                                          ; preds = %_llgo_1
-  %36 = alloca { %"github.com/goplus/llgo/internal/runtime.String", i1 }, align 8
-  %37 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %36, i32 0, i32 0
-  store { ptr, i64 } zeroinitializer, ptr %37, align 8
-  %38 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %36, i32 0, i32 1
-  store i1 false, ptr %38, align 1
-  %39 = load { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %36, align 8
+  %28 = alloca { %"github.com/goplus/llgo/internal/runtime.String", i1 }, align 8
+  %29 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %28, i32 0, i32 0
+  store { ptr, i64 } zeroinitializer, ptr %29, align 8
+  %30 = getelementptr inbounds { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %28, i32 0, i32 1
+  store i1 false, ptr %30, align 1
+  %31 = load { %"github.com/goplus/llgo/internal/runtime.String", i1 }, ptr %28, align 8
   br label %_llgo_5
 
 _llgo_5:                                          ; preds = %_llgo_4, %_llgo_3
-  %40 = phi { %"github.com/goplus/llgo/internal/runtime.String", i1 } [ %35, %_llgo_3 ], [ %39, %_llgo_4 ]
-  %41 = extractvalue { %"github.com/goplus/llgo/internal/runtime.String", i1 } %40, 0
-  %42 = extractvalue { %"github.com/goplus/llgo/internal/runtime.String", i1 } %40, 1
-  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %41)
+  %32 = phi { %"github.com/goplus/llgo/internal/runtime.String", i1 } [ %27, %_llgo_3 ], [ %31, %_llgo_4 ]
+  %33 = extractvalue { %"github.com/goplus/llgo/internal/runtime.String", i1 } %32, 0
+  %34 = extractvalue { %"github.com/goplus/llgo/internal/runtime.String", i1 } %32, 1
+  call void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String" %33)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %42)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %34)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
-  %43 = alloca [2 x i64], align 8
-  %44 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %43, i64 16)
-  %45 = getelementptr inbounds i64, ptr %44, i64 0
-  %46 = getelementptr inbounds i64, ptr %44, i64 1
-  store i64 1, ptr %45, align 4
-  store i64 2, ptr %46, align 4
-  %47 = load [2 x i64], ptr %44, align 4
-  %48 = load ptr, ptr @_llgo_main.A, align 8
-  %49 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
-  store [2 x i64] %47, ptr %49, align 4
-  %50 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
-  %51 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, i32 0, i32 0
-  store ptr %48, ptr %51, align 8
-  %52 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, i32 0, i32 1
-  store ptr %49, ptr %52, align 8
-  %53 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %50, align 8
-  %54 = alloca [2 x i64], align 8
-  %55 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %54, i64 16)
-  %56 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %53, 0
-  %57 = load ptr, ptr @_llgo_main.A, align 8
-  %58 = icmp eq ptr %56, %57
-  br i1 %58, label %_llgo_6, label %_llgo_7
+  %35 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %2, i64 16)
+  %36 = getelementptr inbounds i64, ptr %35, i64 0
+  %37 = getelementptr inbounds i64, ptr %35, i64 1
+  store i64 1, ptr %36, align 4
+  store i64 2, ptr %37, align 4
+  %38 = load [2 x i64], ptr %35, align 4
+  %39 = load ptr, ptr @_llgo_main.A, align 8
+  %40 = call ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64 16)
+  store [2 x i64] %38, ptr %40, align 4
+  %41 = alloca %"github.com/goplus/llgo/internal/runtime.eface", align 8
+  %42 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %41, i32 0, i32 0
+  store ptr %39, ptr %42, align 8
+  %43 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.eface", ptr %41, i32 0, i32 1
+  store ptr %40, ptr %43, align 8
+  %44 = load %"github.com/goplus/llgo/internal/runtime.eface", ptr %41, align 8
+  %45 = call ptr @"github.com/goplus/llgo/internal/runtime.Zeroinit"(ptr %3, i64 16)
+  %46 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %44, 0
+  %47 = load ptr, ptr @_llgo_main.A, align 8
+  %48 = icmp eq ptr %46, %47
+  br i1 %48, label %_llgo_6, label %_llgo_7
 
 _llgo_6:                                          ; preds = %_llgo_5
-  %59 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %53, 1
-  %60 = load [2 x i64], ptr %59, align 4
-  %61 = alloca { [2 x i64], i1 }, align 8
-  %62 = getelementptr inbounds { [2 x i64], i1 }, ptr %61, i32 0, i32 0
-  store [2 x i64] %60, ptr %62, align 4
-  %63 = getelementptr inbounds { [2 x i64], i1 }, ptr %61, i32 0, i32 1
-  store i1 true, ptr %63, align 1
-  %64 = load { [2 x i64], i1 }, ptr %61, align 4
+  %49 = extractvalue %"github.com/goplus/llgo/internal/runtime.eface" %44, 1
+  %50 = load [2 x i64], ptr %49, align 4
+  %51 = alloca { [2 x i64], i1 }, align 8
+  %52 = getelementptr inbounds { [2 x i64], i1 }, ptr %51, i32 0, i32 0
+  store [2 x i64] %50, ptr %52, align 4
+  %53 = getelementptr inbounds { [2 x i64], i1 }, ptr %51, i32 0, i32 1
+  store i1 true, ptr %53, align 1
+  %54 = load { [2 x i64], i1 }, ptr %51, align 4
   br label %_llgo_8
 
 _llgo_7:                                          ; preds = %_llgo_5
-  %65 = alloca { [2 x i64], i1 }, align 8
-  %66 = getelementptr inbounds { [2 x i64], i1 }, ptr %65, i32 0, i32 0
-  store [2 x i64] zeroinitializer, ptr %66, align 4
-  %67 = getelementptr inbounds { [2 x i64], i1 }, ptr %65, i32 0, i32 1
-  store i1 false, ptr %67, align 1
-  %68 = load { [2 x i64], i1 }, ptr %65, align 4
+  %55 = alloca { [2 x i64], i1 }, align 8
+  %56 = getelementptr inbounds { [2 x i64], i1 }, ptr %55, i32 0, i32 0
+  store [2 x i64] zeroinitializer, ptr %56, align 4
+  %57 = getelementptr inbounds { [2 x i64], i1 }, ptr %55, i32 0, i32 1
+  store i1 false, ptr %57, align 1
+  %58 = load { [2 x i64], i1 }, ptr %55, align 4
   br label %_llgo_8
 
 _llgo_8:                                          ; preds = %_llgo_7, %_llgo_6
-  %69 = phi { [2 x i64], i1 } [ %64, %_llgo_6 ], [ %68, %_llgo_7 ]
-  %70 = extractvalue { [2 x i64], i1 } %69, 0
-  store [2 x i64] %70, ptr %55, align 4
-  %71 = extractvalue { [2 x i64], i1 } %69, 1
-  %72 = getelementptr inbounds i64, ptr %55, i64 0
-  %73 = load i64, ptr %72, align 4
-  %74 = getelementptr inbounds i64, ptr %55, i64 1
-  %75 = load i64, ptr %74, align 4
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %73)
+  %59 = phi { [2 x i64], i1 } [ %54, %_llgo_6 ], [ %58, %_llgo_7 ]
+  %60 = extractvalue { [2 x i64], i1 } %59, 0
+  store [2 x i64] %60, ptr %45, align 4
+  %61 = extractvalue { [2 x i64], i1 } %59, 1
+  %62 = getelementptr inbounds i64, ptr %45, i64 0
+  %63 = load i64, ptr %62, align 4
+  %64 = getelementptr inbounds i64, ptr %45, i64 1
+  %65 = load i64, ptr %64, align 4
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %63)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %75)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintInt"(i64 %65)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 32)
-  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %71)
+  call void @"github.com/goplus/llgo/internal/runtime.PrintBool"(i1 %61)
   call void @"github.com/goplus/llgo/internal/runtime.PrintByte"(i8 10)
   ret i32 0
 }
@@ -265,7 +249,7 @@ _llgo_13:                                         ; preds = %_llgo_12
   %30 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %27, align 8
   %31 = alloca %"github.com/goplus/llgo/internal/runtime.String", align 8
   %32 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %31, i32 0, i32 0
-  store ptr @4, ptr %32, align 8
+  store ptr @3, ptr %32, align 8
   %33 = getelementptr inbounds %"github.com/goplus/llgo/internal/runtime.String", ptr %31, i32 0, i32 1
   store i64 1, ptr %33, align 4
   %34 = load %"github.com/goplus/llgo/internal/runtime.String", ptr %31, align 8
@@ -284,7 +268,7 @@ declare void @"github.com/goplus/llgo/internal/runtime.InitNamed"(ptr, %"github.
 
 declare ptr @"github.com/goplus/llgo/internal/runtime.AllocU"(i64)
 
-declare void @"github.com/goplus/llgo/internal/runtime.Panic"(%"github.com/goplus/llgo/internal/runtime.eface")
+declare void @"github.com/goplus/llgo/internal/runtime.PanicTypeAssert"(ptr, ptr)
 
 declare void @"github.com/goplus/llgo/internal/runtime.PrintString"(%"github.com/goplus/llgo/internal/runtime.String")
 
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	llssa "github.com/goplus/llgo/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// StubAsmFuncs gives a body to the functions of pkg which are declared in Go
+// (usually with //go:noescape) and implemented by the Plan 9 assembly files of
+// the package: names are their symbols without the package, eg. "add" for
+// TEXT ·add(SB). llgo can't assemble them, so the body panics: the package
+// links, and only fails if such a function is called.
+func StubAsmFuncs(pkg llssa.Package, names []string) {
+	prog := pkg.Prog
+	for _, name := range names {
+		fullName := pkg.Path() + "." + name
+		fn := pkg.FuncOf(fullName)
+		if fn == nil || fn.HasBody() {
+			continue // not called, or there is a Go implementation
+		}
+		b := fn.MakeBody(1)
+		msg := fullName + ": function implemented in assembly, not supported by llgo"
+		b.Panic(b.MakeInterface(prog.Any(), b.Str(msg)))
+	}
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// inBounds reports whether the index idx of x used by instr is known to be in
+// bounds, so its bounds check can be eliminated: idx is not negative (see
+// lowerBound), and it's less than the length of x by a condition dominating
+// instr, like in loops:
+//
+//	for i := range s { ... s[i] ... }
+//	for i := 0; i < len(s); i++ { ... s[i] ... }
+func inBounds(instr ssa.Instruction, x, idx ssa.Value) bool {
+	if lb, ok := lowerBound(idx, nil); !ok || lb < 0 {
+		return false
+	}
+	return lessThan(instr.Block(), idx, func(n ssa.Value) bool {
+		return isLenOf(n, x)
+	})
+}
+
+// lessThan reports whether v is less than a value n for which bound is true,
+// by a condition dominating blk.
+func lessThan(blk *ssa.BasicBlock, v ssa.Value, bound func(n ssa.Value) bool) bool {
+	for ; blk != nil; blk = blk.Idom() {
+		if len(blk.Preds) != 1 {
+			continue
+		}
+		pred := blk.Preds[0]
+		cond, ok := pred.Instrs[len(pred.Instrs)-1].(*ssa.If)
+		if !ok || pred.Succs[0] == pred.Succs[1] {
+			continue
+		}
+		c, ok := cond.Cond.(*ssa.BinOp)
+		if !ok {
+			continue
+		}
+		op := c.Op
+		if pred.Succs[1] == blk { // the condition is false
+			switch op {
+			case token.GEQ:
+				op = token.LSS
+			case token.LEQ:
+				op = token.GTR
+			default:
+				continue
+			}
+		}
+		switch {
+		case op == token.LSS && c.X == v && bound(c.Y):
+			return true
+		case op == token.GTR && c.Y == v && bound(c.X):
+			return true
+		}
+	}
+	return false
+}
+
+// isLenOf reports whether n is not greater than the length of x: it's len(x),
+// or a constant not greater than the length of the array x.
+func isLenOf(n, x ssa.Value) bool {
+	switch n := n.(type) {
+	case *ssa.Call:
+		fn, ok := n.Call.Value.(*ssa.Builtin)
+		return ok && fn.Name() == "len" && n.Call.Args[0] == x
+	case *ssa.Const:
+		t := x.Type().Underlying()
+		if pt, ok := t.(*types.Pointer); ok {
+			t = pt.Elem().Underlying()
+		}
+		if at, ok := t.(*types.Array); ok && n.Value != nil {
+			v, exact := constant.Int64Val(n.Value)
+			return exact && v <= at.Len()
+		}
+	}
+	return false
+}
+
+// isLen reports whether n is a length, or a constant which is less than the
+// largest int of all targets.
+func isLen(n ssa.Value) bool {
+	switch n := n.(type) {
+	case *ssa.Call:
+		fn, ok := n.Call.Value.(*ssa.Builtin)
+		return ok && fn.Name() == "len"
+	case *ssa.Const:
+		if n.Value != nil && n.Value.Kind() == constant.Int {
+			v, exact := constant.Int64Val(n.Value)
+			return exact && v < math.MaxInt32
+		}
+	}
+	return false
+}
+
+// incNoOverflow reports whether v, which is x+1, doesn't overflow: x is less
+// than a length (see isLen), by a condition dominating v, or x is a phi whose
+// values all are, as i in `for i := range s`. Only integers as wide as ints
+// qualify: int8(127)+1 overflows, though less than a length.
+func incNoOverflow(v *ssa.BinOp, x ssa.Value) bool {
+	t, ok := v.Type().Underlying().(*types.Basic)
+	if !ok {
+		return false
+	}
+	switch t.Kind() {
+	case types.Int, types.Uint, types.Uintptr:
+	default:
+		return false
+	}
+	if lessThan(v.Block(), x, isLen) {
+		return true
+	}
+	phi, ok := x.(*ssa.Phi)
+	if !ok {
+		return false
+	}
+	for i, e := range phi.Edges {
+		if !isLen(e) && !lessThan(phi.Block().Preds[i], e, isLen) {
+			return false
+		}
+	}
+	return true
+}
+
+// lbInCycle is the lower bound of a phi being computed, which is reached again
+// through a cycle of the phi.
+const lbInCycle = math.MaxInt64
+
+// lowerBound returns the lower bound of the integer v, which is computed from
+// constants, lengths, additions of constants and phis. The values of a phi
+// which come from the phi itself are ignored, provided that they are never
+// smaller than the phi: so the lower bound of i in
+// `for i := 0; i < len(s); i++` is 0. Additions of positive constants must
+// not overflow, so only increments by one are known (see incNoOverflow).
+func lowerBound(v ssa.Value, phis map[*ssa.Phi]bool) (int64, bool) {
+	switch v := v.(type) {
+	case *ssa.Const:
+		if v.Value != nil && v.Value.Kind() == constant.Int {
+			return constant.Int64Val(v.Value)
+		}
+	case *ssa.Call:
+		if fn, ok := v.Call.Value.(*ssa.Builtin); ok {
+			switch fn.Name() {
+			case "len", "cap":
+				return 0, true
+			}
+		}
+	case *ssa.BinOp:
+		if v.Op != token.ADD {
+			break
+		}
+		x, y := v.X, v.Y
+		if _, ok := x.(*ssa.Const); ok {
+			x, y = y, x
+		}
+		c, ok := y.(*ssa.Const)
+		if !ok || c.Value == nil {
+			break
+		}
+		n, exact := constant.Int64Val(c.Value)
+		if !exact {
+			break
+		}
+		if n > 0 && (n != 1 || !incNoOverflow(v, x)) {
+			break
+		}
+		lb, ok := lowerBound(x, phis)
+		if !ok {
+			break
+		}
+		if lb == lbInCycle {
+			return lb, n >= 0
+		}
+		if n < 0 && lb < math.MinInt64-n {
+			break
+		}
+		return lb + n, true
+	case *ssa.Phi:
+		if phis[v] {
+			return lbInCycle, true
+		}
+		if phis == nil {
+			phis = make(map[*ssa.Phi]bool)
+		}
+		phis[v] = true
+		defer delete(phis, v)
+		ret := int64(lbInCycle)
+		for _, e := range v.Edges {
+			lb, ok := lowerBound(e, phis)
+			if !ok {
+				return 0, false
+			}
+			if lb < ret {
+				ret = lb
+			}
+		}
+		return ret, ret != lbInCycle
+	}
+	return 0, false
+}
+
+// -----------------------------------------------------------------------------
@@ -148,6 +148,7 @@ retry:
 		panic("unreachable")
 	}
 	order = append(order, -1)
+	markLoops(states)
 	ret := make([]Info, n)
 	for i := 0; i < n; i++ {
 		iblk := order[i]
@@ -156,6 +157,57 @@ retry:
 	return ret
 }
 
+// markLoops marks all blocks in cycles as loop blocks, e.g. bodies of inner
+// loops which findLoop doesn't see. A block is in a cycle if its strongly
+// connected component has more than one block, or it jumps to itself.
+//
+// https://en.wikipedia.org/wiki/Tarjan%27s_strongly_connected_components_algorithm
+func markLoops(states []*blockState) {
+	n := len(states)
+	index := make([]int, n) // 0 means unvisited
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	stack := make([]int, 0, n)
+	next := 1
+	var visit func(v int)
+	visit = func(v int) {
+		index[v], low[v] = next, next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range states[v].succs {
+			if index[w] == 0 {
+				visit(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && index[w] < low[v] {
+				low[v] = index[w]
+			}
+		}
+		if low[v] != index[v] {
+			return
+		}
+		i := len(stack) - 1
+		for stack[i] != v {
+			i--
+		}
+		scc := stack[i:]
+		stack = stack[:i]
+		for _, w := range scc {
+			onStack[w] = false
+			if len(scc) > 1 || find(states[w].succs, w) >= 0 {
+				states[w].loop = true
+			}
+		}
+	}
+	for v := 0; v < n; v++ {
+		if index[v] == 0 {
+			visit(v)
+		}
+	}
+}
+
 func isEnd(blk *ssa.BasicBlock) bool {
 	// Note: skip recover block
 	return len(blk.Succs) == 0 && (len(blk.Preds) > 0 || blk.Index == 0)
@@ -56,6 +56,30 @@ func TestFirstLoop(t *testing.T) {
 	}
 }
 
+func TestNestedLoop(t *testing.T) {
+	// 0 -> 1; 1 -> 2, 3; 2 -> 4; 4 -> 5, 6; 5 -> 4; 6 -> 1
+	succs := [][]int{{1}, {2, 3}, {4}, {}, {5, 6}, {4}, {1}}
+	blks := make([]*ssa.BasicBlock, len(succs))
+	for i := range blks {
+		blks[i] = &ssa.BasicBlock{Index: i}
+	}
+	for i, blk := range blks {
+		for _, succ := range succs[i] {
+			blk.Succs = append(blk.Succs, blks[succ])
+			blks[succ].Preds = append(blks[succ].Preds, blk)
+		}
+	}
+	infos := Infos(blks)
+	for i, kind := range []llssa.DoAction{
+		llssa.DeferAlways, llssa.DeferInLoop, llssa.DeferInLoop, llssa.DeferAlways,
+		llssa.DeferInLoop, llssa.DeferInLoop, llssa.DeferInLoop,
+	} {
+		if infos[i].Kind != kind {
+			t.Fatal("TestNestedLoop:", i, kinds[infos[i].Kind])
+		}
+	}
+}
+
 func fromDir(t *testing.T, sel, relDir string, fn func(string) string) {
 	dir, err := os.Getwd()
 	if err != nil {
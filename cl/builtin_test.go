@@ -19,6 +19,8 @@ package cl
 import (
 	"go/ast"
 	"go/constant"
+	"go/parser"
+	"go/token"
 	"go/types"
 	"testing"
 	"unsafe"
@@ -232,6 +234,13 @@ func TestIgnoreName(t *testing.T) {
 	if !ignoreName("runtime/foo") || !ignoreName("internal/abi") {
 		t.Fatal("ignoreName failed")
 	}
+	if ignoreName("runtime/debug.SetMaxStack") || ignoreName("runtime/pprof.Lookup") ||
+		ignoreName("runtime/trace.Start") || ignoreName("runtime/metrics.Read") {
+		t.Fatal("ignoreName: patched runtime package ignored")
+	}
+	if ignoreName("crypto/aes.NewCipher") || ignoreName("crypto/rand.Read") || !ignoreName("crypto/tls.Dial") {
+		t.Fatal("ignoreName: crypto package")
+	}
 }
 
 func TestErrImport(t *testing.T) {
@@ -265,6 +274,47 @@ func TestErrInitLinkname(t *testing.T) {
 	})
 }
 
+func TestLinknameInFiles(t *testing.T) {
+	const src = `package foo
+
+var (
+	//go:linkname a C.a
+	a int
+	b int
+)
+
+//go:linkname b C.b
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal("ParseFile failed:", err)
+	}
+	ctx := &context{fset: fset, link: make(map[string]string)}
+	ctx.initFiles("foo", []*ast.File{f})
+	if ctx.link["foo.a"] != "C.a" || ctx.link["foo.b"] != "C.b" || ctx.link["foo.nanotime"] != "runtime.nanotime" {
+		t.Fatal("initFiles:", ctx.link)
+	}
+}
+
+func TestTrampolineFunc(t *testing.T) {
+	var ctx context
+	ctx.collectCgoImport(`//go:cgo_import_dynamic libc_getpid getpid "/usr/lib/libSystem.B.dylib"`)
+	ctx.collectCgoImport(`//go:cgo_import_dynamic libc_bad`)
+	if cname, ok := ctx.trampolineFunc("libc_getpid_trampoline_addr"); !ok || cname != "getpid" {
+		t.Fatal("trampolineFunc:", cname, ok)
+	}
+	if _, ok := ctx.trampolineFunc("libc_getpid"); ok {
+		t.Fatal("trampolineFunc: not a trampoline")
+	}
+	if _, ok := ctx.trampolineFunc("libc_bad_trampoline_addr"); ok {
+		t.Fatal("trampolineFunc: no C name")
+	}
+}
+
 func TestErrVarOf(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
@@ -282,3 +332,70 @@ func TestErrVarOf(t *testing.T) {
 	g := &ssa.Global{Pkg: ssaPkg}
 	ctx.varOf(nil, g)
 }
+
+func TestParseEmbedPatterns(t *testing.T) {
+	patterns, err := parseEmbedPatterns("a.txt  \"b c.txt\" `d\\e` all:static")
+	if err != nil || len(patterns) != 4 || patterns[1] != "b c.txt" || patterns[2] != "d\\e" || patterns[3] != "all:static" {
+		t.Fatal("parseEmbedPatterns:", patterns, err)
+	}
+	if _, err := parseEmbedPatterns(`"a.txt`); err == nil {
+		t.Fatal("parseEmbedPatterns: no error?")
+	}
+	if _, err := parseEmbedPatterns(`"a.txt"b`); err == nil {
+		t.Fatal("parseEmbedPatterns: no error?")
+	}
+	if validEmbedPattern("../a.txt") || validEmbedPattern("/a") || validEmbedPattern("a/./b") || !validEmbedPattern("a/*.txt") {
+		t.Fatal("validEmbedPattern")
+	}
+}
+
+func TestCgoFuncName(t *testing.T) {
+	if name, ok := CgoFuncName("_Cfunc_puts"); !ok || name != "puts" {
+		t.Fatal("CgoFuncName:", name, ok)
+	}
+	if _, ok := CgoFuncName("_Cfunc_GoString"); ok {
+		t.Fatal("CgoFuncName: GoString")
+	}
+	if _, ok := CgoFuncName("puts"); ok {
+		t.Fatal("CgoFuncName: puts")
+	}
+	if !checkCgo("_cgoexp_0123_f") || !checkCgo("_cgo_cmalloc") || checkCgo("_Cfunc_puts") {
+		t.Fatal("checkCgo")
+	}
+}
+
+func TestCoverStmts(t *testing.T) {
+	const src = `package foo
+
+func f(x int) int {
+	y := x
+	if y < 0 {
+		return -y
+	}
+	g := func() int { return y }
+	return g()
+}
+`
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "foo.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmts := coverStmtsOf(fset, []*ast.File{f})
+	if len(stmts) != 6 {
+		t.Fatal("coverStmtsOf:", len(stmts))
+	}
+	at := func(line, col int) token.Pos {
+		return fset.File(f.Pos()).LineStart(line) + token.Pos(col-1)
+	}
+	y, hdr, lit := stmts[0], stmts[1], stmts[4]
+	if y.next != hdr || hdr.prev != y || hdr.end != at(5, 11) || stmts[2].prev != nil {
+		t.Fatal("coverStmtsOf: y := x; if y < 0 {")
+	}
+	if s := innermostStmt(stmts, at(8, 27)); s != lit || s.parent != stmts[3] {
+		t.Fatal("innermostStmt: return y", s)
+	}
+	if s := innermostStmt(stmts, at(7, 2)); s != nil {
+		t.Fatal("innermostStmt: }", s)
+	}
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"go/types"
+	"strings"
+
+	llssa "github.com/goplus/llgo/ssa"
+	"golang.org/x/tools/go/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// CgoFuncPrefix is the prefix of C function pointers which are defined along
+// with the preamble of a cgo file: _cgo_llgo_<name> points to C function name.
+const CgoFuncPrefix = "_cgo_llgo_"
+
+// cgoGoFuncs are the functions generated by cgo which have Go bodies.
+var cgoGoFuncs = map[string]none{
+	"CString":   {},
+	"CBytes":    {},
+	"GoString":  {},
+	"GoStringN": {},
+	"GoBytes":   {},
+}
+
+// CgoFuncName returns the C function name of a cgo stub _Cfunc_<name>.
+func CgoFuncName(goName string) (string, bool) {
+	if name, ok := strings.CutPrefix(goName, "_Cfunc_"); ok {
+		if _, ok := cgoGoFuncs[name]; !ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// compileCgoFunc compiles the cgo stub fn of C function cname as a call through
+// the C function pointer CgoFuncPrefix+cname.
+func (p *context) compileCgoFunc(pkg llssa.Package, fn llssa.Function, sig *types.Signature, cname string) {
+	params, results := sig.Params(), sig.Results()
+	csig := sig
+	void := results.Len() == 1 && isCgoVoid(results.At(0).Type())
+	if void {
+		csig = types.NewSignatureType(nil, nil, nil, params, nil, false)
+	}
+	name := CgoFuncPrefix + cname
+	ptr := pkg.VarOf(name)
+	if ptr == nil {
+		ptr = pkg.NewVar(name, types.NewPointer(csig), llssa.InC)
+	}
+	b := fn.MakeBody(1)
+	args := make([]llssa.Expr, params.Len())
+	for i := range args {
+		args[i] = fn.Param(i)
+	}
+	ret := b.Call(b.Load(ptr.Expr), args...)
+	switch {
+	case void:
+		b.Return(p.prog.Zero(p.prog.Type(results.At(0).Type(), llssa.InGo)))
+	case results.Len() == 0:
+		b.Return()
+	default:
+		b.Return(ret)
+	}
+}
+
+// isCgoVoid reports whether t is _Ctype_void, the result type of C functions
+// returning void.
+func isCgoVoid(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Name() == "_Ctype_void"
+}
+
+// isCgoInit reports whether instr initializes the address of a cgo stub, eg.
+//
+//	_cgo_xxx_Cfunc_puts = unsafe.Pointer(&__cgofn__cgo_xxx_Cfunc_puts)
+//
+// which is unused because cgo stubs are compiled by compileCgoFunc.
+func isCgoInit(instr ssa.Instruction) bool {
+	switch v := instr.(type) {
+	case *ssa.Store:
+		if g, ok := v.Addr.(*ssa.Global); ok {
+			return checkCgo(g.Name())
+		}
+	case *ssa.Convert:
+		if g, ok := v.X.(*ssa.Global); ok {
+			return strings.HasPrefix(g.Name(), "__cgofn_")
+		}
+	}
+	return false
+}
+
+// The system calls of golang.org/x/sys/unix on Darwin are calls of the
+// functions of libSystem by the addresses their assembly trampolines store
+// into variables:
+//
+//	//go:cgo_import_dynamic libc_getpid getpid "/usr/lib/libSystem.B.dylib"
+//	var libc_getpid_trampoline_addr uintptr
+//
+// Such variables are initialized by the addresses of the C functions instead.
+
+const trampolineAddrSuffix = "_trampoline_addr"
+
+// collectCgoImport collects the C name of a //go:cgo_import_dynamic directive.
+func (p *context) collectCgoImport(line string) {
+	args, ok := strings.CutPrefix(line, "//go:cgo_import_dynamic ")
+	if !ok {
+		return
+	}
+	if fields := strings.Fields(args); len(fields) >= 2 {
+		if p.cimports == nil {
+			p.cimports = make(map[string]string)
+		}
+		p.cimports[fields[0]] = fields[1]
+	}
+}
+
+// trampolineFunc returns the C function whose address the variable name
+// holds, if it's the address of a trampoline.
+func (p *context) trampolineFunc(name string) (cname string, ok bool) {
+	if local, ok := strings.CutSuffix(name, trampolineAddrSuffix); ok {
+		cname, ok = p.cimports[local]
+		return cname, ok
+	}
+	return
+}
+
+// InitLibrary turns the main package pkg into a library, for the c-archive
+// and c-shared build modes: the main function is hidden, and the packages are
+// initialized by a constructor instead, when the library is loaded.
+func InitLibrary(pkg llssa.Package) {
+	if fn := pkg.FuncOf("main"); fn != nil {
+		fn.SetInternal()
+	}
+	fn := pkg.NewFunc("__llgo_libinit", llssa.NoArgsNoRet, llssa.InC)
+	b := fn.MakeBody(1)
+	callRuntimeInit(b, pkg)
+	b.Call(pkg.FuncOf("main.init").Expr)
+	b.Return()
+	fn.SetInternal()
+	pkg.AddCtor(fn, llssa.PriorityDefault)
+}
+
+// -----------------------------------------------------------------------------
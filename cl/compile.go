@@ -25,6 +25,7 @@ import (
 	"log"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/goplus/llgo/cl/blocks"
 	"github.com/goplus/llgo/internal/typepatch"
@@ -55,6 +56,33 @@ func SetDebug(dbgFlags dbgFlags) {
 	debugGoSSA = (dbgFlags & DbgFlagGoSSA) != 0
 }
 
+// LLGO_DUMPFUNC=name1,name2,... (or * for all functions) dumps the listed
+// functions to stderr after they are built, with Go SSA instructions
+// interleaved with the LLVM instructions they are compiled to.
+var dumpFuncs = parseDumpFuncs(os.Getenv("LLGO_DUMPFUNC"))
+
+func parseDumpFuncs(env string) map[string]none {
+	if env == "" {
+		return nil
+	}
+	ret := make(map[string]none)
+	for _, name := range strings.Split(env, ",") {
+		ret[strings.TrimSpace(name)] = none{}
+	}
+	return ret
+}
+
+func needDump(name string) bool {
+	if dumpFuncs == nil {
+		return false
+	}
+	if _, ok := dumpFuncs["*"]; ok {
+		return true
+	}
+	_, ok := dumpFuncs[name]
+	return ok
+}
+
 // -----------------------------------------------------------------------------
 
 type instrOrValue interface {
@@ -94,15 +122,36 @@ type context struct {
 	bvals  map[ssa.Value]llssa.Expr    // block values
 	vargs  map[*ssa.Alloc][]llssa.Expr // varargs
 
+	escParams map[*ssa.Parameter]bool // whether parameters escape (see paramEscapes)
+
 	patches  Patches
 	blkInfos []blocks.Info
 
 	inits []func()
 	phis  []func()
 
-	state   pkgState
-	inCFunc bool
-	skipall bool
+	strSwitches map[*ssa.BasicBlock]*strSwitch // string switches by first block; nil for merged blocks
+
+	cover *coverUnit // coverage counters of the package, or nil (see SetCoverage)
+
+	nosans    map[string]none            // functions excluded from sanitizers
+	hardens   map[string]llssa.Hardening // hardening options of functions (see llgo:harden)
+	pragmas   map[string]pragma          // compiler pragmas of functions (eg. go:noinline)
+	volatiles map[string]none            // volatile global variables
+	embeds    map[string]*embedVar       // global variables with //go:embed
+	exports   map[string]string          // C names of functions with //export
+	cimports  map[string]string          // C names of local names with //go:cgo_import_dynamic
+
+	state     pkgState
+	deferOn   llssa.Expr // defer stack of the defer statement being compiled
+	inCFunc   bool
+	rundefer  bool // run defers before returns (see needRunDefers)
+	recovers  bool // the function calls recover (see usesRecover)
+	glue      bool // the function is glue code (see isGlue)
+	skipall   bool
+	nosanall  bool // package excluded from sanitizers
+	noraceall bool // plain accesses of the package excluded from the race detector
+	dumpFn    bool // dump the function being compiled (see LLGO_DUMPFUNC)
 }
 
 type pkgState byte
@@ -160,7 +209,13 @@ func (p *context) compileGlobal(pkg llssa.Package, gbl *ssa.Global) {
 	}
 	g := pkg.NewVar(name, typ, llssa.Background(vtype))
 	if define {
-		g.InitNil()
+		if v, ok := p.embeds[llssa.FullName(gbl.Pkg.Pkg, gbl.Name())]; ok {
+			g.Init(p.embedInit(pkg, v, typ.(*types.Pointer).Elem()))
+		} else if cname, ok := p.trampolineFunc(gbl.Name()); ok {
+			g.Init(pkg.ConstFuncAddr(cname))
+		} else {
+			g.InitNil()
+		}
 	}
 }
 
@@ -224,9 +279,32 @@ func (p *context) compileFuncDecl(pkg llssa.Package, f *ssa.Function) (llssa.Fun
 			sig = types.NewSignatureType(nil, nil, nil, params, results, false)
 		}
 		fn = pkg.NewFuncEx(name, sig, llssa.Background(ftype), hasCtx)
+		if p.noSanitize(name) {
+			fn.SetNoSanitize()
+		}
+		if p.noraceall {
+			fn.SetNoRace()
+		}
+		if h, ok := p.hardens[name]; ok {
+			fn.SetHardening(h)
+		}
+		p.setPragmas(fn, name)
+		if cname, ok := p.exports[name]; ok {
+			pkg.NewExport(cname, fn) // see //export
+		}
+		if isInstance(f) && len(f.Blocks) > 0 {
+			fn.SetLinkOnceODR() // see instances
+		}
+		if cname, ok := CgoFuncName(f.Name()); ok && f.Parent() == nil {
+			p.compileCgoFunc(pkg, fn, sig, cname)
+			return fn, nil, goFunc
+		}
 	}
 
 	if nblk := len(f.Blocks); nblk > 0 {
+		if pos := funcPos(f); pos.IsValid() {
+			p.setFuncPos(fn, pos)
+		}
 		fn.MakeBlocks(nblk)   // to set fn.HasBody() = true
 		if f.Recover != nil { // set recover block
 			fn.SetRecover(fn.Block(f.Recover.Index))
@@ -238,6 +316,12 @@ func (p *context) compileFuncDecl(pkg llssa.Package, f *ssa.Function) (llssa.Fun
 				p.fn = nil
 			}()
 			p.phis = nil
+			if p.cover == nil { // merged blocks can't be counted
+				p.strSwitches = strSwitchesOf(f, isInit)
+			} else {
+				p.strSwitches = nil
+			}
+			p.dumpFn = needDump(name)
 			if debugGoSSA {
 				f.WriteTo(os.Stderr)
 			}
@@ -251,6 +335,10 @@ func (p *context) compileFuncDecl(pkg llssa.Package, f *ssa.Function) (llssa.Fun
 				off[i] = p.compilePhis(b, block)
 			}
 			p.blkInfos = blocks.Infos(f.Blocks)
+			deferInLoops(f.Blocks, p.blkInfos)
+			p.rundefer = needRunDefers(f)
+			p.recovers = usesRecover(f)
+			p.glue = isGlue(f)
 			i := 0
 			for {
 				block := f.Blocks[i]
@@ -265,6 +353,9 @@ func (p *context) compileFuncDecl(pkg llssa.Package, f *ssa.Function) (llssa.Fun
 				phi()
 			}
 			b.EndBuild()
+			if p.dumpFn {
+				fmt.Fprintln(os.Stderr, fn)
+			}
 		})
 		for _, af := range f.AnonFuncs {
 			p.compileFuncDecl(pkg, af)
@@ -273,6 +364,43 @@ func (p *context) compileFuncDecl(pkg llssa.Package, f *ssa.Function) (llssa.Fun
 	return fn, nil, goFunc
 }
 
+// funcPos returns the position of f, or the position of its first instruction
+// having one, like for package initializers, which have none.
+func funcPos(f *ssa.Function) token.Pos {
+	if pos := f.Pos(); pos.IsValid() {
+		return pos
+	}
+	for _, block := range f.Blocks {
+		for _, instr := range block.Instrs {
+			if pos := instr.Pos(); pos.IsValid() {
+				return pos
+			}
+		}
+	}
+	return token.NoPos
+}
+
+// setFuncPos sets the position of fn for line tables. Like in Go, positions
+// follow line directives, like those of the files generated by cgo.
+func (p *context) setFuncPos(fn llssa.Function, pos token.Pos) {
+	position := p.fset.Position(pos)
+	fn.SetPos(position.Filename, position.Line)
+}
+
+// setPos sets the position of the instructions built after for line tables.
+func (p *context) setPos(b llssa.Builder, pos token.Pos) {
+	position := p.fset.Position(pos)
+	b.SetPos(position.Filename, position.Line, position.Column)
+}
+
+// instrString returns the Go SSA text of instr, e.g. "t2 = t0 + t1".
+func instrString(instr ssa.Instruction) string {
+	if v, ok := instr.(ssa.Value); ok && v.Name() != "" {
+		return v.Name() + " = " + instr.String()
+	}
+	return instr.String()
+}
+
 func (p *context) compileBlock(b llssa.Builder, block *ssa.BasicBlock, n int, doMainInit, doModInit bool) llssa.BasicBlock {
 	var last int
 	var pyModInit bool
@@ -281,7 +409,16 @@ func (p *context) compileBlock(b llssa.Builder, block *ssa.BasicBlock, n int, do
 	var fn = p.fn
 	var instrs = block.Instrs[n:]
 	var ret = fn.Block(block.Index)
+	var strSw, isStrSw = p.strSwitches[block]
 	b.SetBlock(ret)
+	if isStrSw && strSw == nil { // merged into a string switch
+		b.Unreachable()
+		return ret
+	}
+	if block.Index == 0 && p.recovers {
+		b.InitRecover()
+	}
+	p.coverBlock(b, block)
 	if doModInit {
 		if pyModInit = p.pyMod != ""; pyModInit {
 			last = len(instrs) - 1
@@ -302,14 +439,33 @@ func (p *context) compileBlock(b llssa.Builder, block *ssa.BasicBlock, n int, do
 		callRuntimeInit(b, pkg)
 		b.Call(pkg.FuncOf("main.init").Expr)
 	}
+	if strSw != nil {
+		instrs = instrs[:len(instrs)-2]
+	}
+	callOldInit := doModInit && p.state == pkgInPatch
 	for i, instr := range instrs {
-		if i == 1 && doModInit && p.state == pkgInPatch {
+		if callOldInit && i > 0 && !isImportInit(instr) {
+			// the patched package is one package: its imports, including
+			// those of the patch, are initialized before any of its variables.
+			callOldInit = false
 			initFnNameOld := initFnNameOfHasPatch(p.fn.Name())
 			fnOld := pkg.NewFunc(initFnNameOld, llssa.NoArgsNoRet, llssa.InC)
 			b.Call(fnOld.Expr)
 		}
+		if p.dumpFn {
+			b.Note(instrString(instr))
+		}
+		if isCgoInit(instr) {
+			continue
+		}
+		if pos := instr.Pos(); pos.IsValid() {
+			p.setPos(b, pos)
+		}
 		p.compileInstr(b, instr)
 	}
+	if strSw != nil {
+		p.compileStrSwitch(b, strSw)
+	}
 	if pyModInit {
 		jump := block.Instrs[n+last].(*ssa.Jump)
 		jumpTo := p.jumpTo(jump)
@@ -327,6 +483,124 @@ func (p *context) compileBlock(b llssa.Builder, block *ssa.BasicBlock, n int, do
 	return ret
 }
 
+// -----------------------------------------------------------------------------
+
+const (
+	strSwitchMinCases = 8 // min number of cases to lower a string switch by hashing
+)
+
+type strCase struct {
+	val string
+	blk *ssa.BasicBlock
+}
+
+type strSwitch struct {
+	x     ssa.Value
+	cases []strCase
+	def   *ssa.BasicBlock
+	chain []*ssa.BasicBlock // blocks after the first one
+}
+
+// strSwitchOf checks if block starts a chain of `if x == "const"` blocks, which
+// is how go/ssa lowers a switch on a string (as well as an if-else chain of the
+// same shape). It returns nil if the chain is too short, or if a case block or
+// the default block has phi nodes.
+func strSwitchOf(block *ssa.BasicBlock) *strSwitch {
+	x, val, ok := strCaseOf(block)
+	if !ok {
+		return nil
+	}
+	sw := &strSwitch{x: x}
+	for {
+		sw.cases = append(sw.cases, strCase{val, block.Succs[0]})
+		next := block.Succs[1]
+		nx, nval, ok := strCaseOf(next)
+		if !ok || nx != x || len(next.Instrs) != 2 || len(next.Preds) != 1 {
+			sw.def = next
+			break
+		}
+		sw.chain = append(sw.chain, next)
+		block, val = next, nval
+	}
+	if len(sw.cases) < strSwitchMinCases || hasPhi(sw.def) {
+		return nil
+	}
+	for _, c := range sw.cases {
+		if hasPhi(c.blk) {
+			return nil
+		}
+	}
+	return sw
+}
+
+// strCaseOf checks if block ends with `if x == "const"` where the comparison
+// is used only by the if.
+func strCaseOf(block *ssa.BasicBlock) (x ssa.Value, val string, ok bool) {
+	n := len(block.Instrs)
+	if n < 2 {
+		return
+	}
+	cond, ok := block.Instrs[n-1].(*ssa.If)
+	if !ok {
+		return
+	}
+	cmp, ok := block.Instrs[n-2].(*ssa.BinOp)
+	if !ok || cmp.Op != token.EQL || cond.Cond != cmp || len(*cmp.Referrers()) != 1 {
+		return nil, "", false
+	}
+	x = cmp.X
+	c, ok := cmp.Y.(*ssa.Const)
+	if !ok {
+		x = cmp.Y
+		c, ok = cmp.X.(*ssa.Const)
+	}
+	if !ok || c.Value == nil || c.Value.Kind() != constant.String {
+		return nil, "", false
+	}
+	if t, ok := x.Type().Underlying().(*types.Basic); !ok || t.Kind() != types.String {
+		return nil, "", false
+	}
+	return x, constant.StringVal(c.Value), true
+}
+
+func hasPhi(block *ssa.BasicBlock) bool {
+	return len(block.Instrs) > 0 && isPhi(block.Instrs[0])
+}
+
+// strSwitchesOf finds string switches of f (see strSwitchOf).
+func strSwitchesOf(f *ssa.Function, isInit bool) (ret map[*ssa.BasicBlock]*strSwitch) {
+	for _, block := range f.Blocks {
+		if _, ok := ret[block]; ok || (isInit && block.Index == 1) {
+			continue
+		}
+		if sw := strSwitchOf(block); sw != nil {
+			if ret == nil {
+				ret = make(map[*ssa.BasicBlock]*strSwitch)
+			}
+			ret[block] = sw
+			for _, blk := range sw.chain {
+				ret[blk] = nil
+			}
+		}
+	}
+	return
+}
+
+func (p *context) compileStrSwitch(b llssa.Builder, sw *strSwitch) {
+	fn := p.fn
+	if p.dumpFn {
+		b.Note(fmt.Sprintf("string switch %s: %d cases", sw.x.Name(), len(sw.cases)))
+	}
+	x := p.compileValue(b, sw.x)
+	ssw := b.StringSwitch(x, fn.Block(sw.def.Index))
+	for _, c := range sw.cases {
+		ssw.Case(c.val, fn.Block(c.blk.Index))
+	}
+	ssw.End(b)
+}
+
+// -----------------------------------------------------------------------------
+
 const (
 	RuntimeInit = llssa.PkgRuntime + ".init"
 )
@@ -395,6 +669,52 @@ func isAllocVargs(ctx *context, v *ssa.Alloc) bool {
 	return false
 }
 
+// deferInLoops makes all defer statements of a function DeferInLoop if any of
+// them is in a loop, so the deferred calls run in LIFO order.
+func deferInLoops(blks []*ssa.BasicBlock, infos []blocks.Info) {
+	for i, blk := range blks {
+		if infos[i].Kind != llssa.DeferInLoop {
+			continue
+		}
+		for _, instr := range blk.Instrs {
+			if _, ok := instr.(*ssa.Defer); ok {
+				for i := range infos {
+					infos[i].Kind = llssa.DeferInLoop
+				}
+				return
+			}
+		}
+	}
+}
+
+// deferStackOf returns the defer stack of a defer statement, which is set only
+// for functions with range-over-func loops.
+func deferStackOf(v *ssa.Defer) ssa.Value {
+	ops := v.Operands(nil)
+	return *ops[len(ops)-1]
+}
+
+// needRunDefers reports whether a function must run its defer stack before
+// returning: lifting removes RunDefers from functions without defer
+// statements, but the defer statements of a range-over-func loop body push
+// their calls on the defer stack of the enclosing function.
+func needRunDefers(f *ssa.Function) bool {
+	hasStack := false
+	for _, blk := range f.Blocks {
+		for _, instr := range blk.Instrs {
+			switch instr := instr.(type) {
+			case *ssa.RunDefers:
+				return false
+			case *ssa.Call:
+				if fn, ok := instr.Call.Value.(*ssa.Builtin); ok && fn.Name() == "ssa:deferstack" {
+					hasStack = true
+				}
+			}
+		}
+	}
+	return hasStack
+}
+
 func isPhi(i ssa.Instruction) bool {
 	_, ok := i.(*ssa.Phi)
 	return ok
@@ -452,7 +772,7 @@ func (p *context) compileInstrOrValue(b llssa.Builder, iv instrOrValue, asValue
 	}
 	switch v := iv.(type) {
 	case *ssa.Call:
-		ret = p.call(b, llssa.Call, &v.Call)
+		ret = p.call(b, llssa.Call, v)
 	case *ssa.BinOp:
 		x := p.compileValue(b, v.X)
 		y := p.compileValue(b, v.Y)
@@ -461,6 +781,8 @@ func (p *context) compileInstrOrValue(b llssa.Builder, iv instrOrValue, asValue
 		x := p.compileValue(b, v.X)
 		if v.Op == token.ARROW {
 			ret = b.Recv(x, v.CommaOk)
+		} else if v.Op == token.MUL && p.isVolatile(v.X) {
+			ret = b.LoadVolatile(x)
 		} else {
 			ret = b.UnOp(v.Op, x)
 		}
@@ -481,7 +803,7 @@ func (p *context) compileInstrOrValue(b llssa.Builder, iv instrOrValue, asValue
 			return
 		}
 		elem := p.prog.Type(t.Elem(), llssa.InGo)
-		ret = b.Alloc(elem, v.Heap)
+		ret = b.Alloc(elem, v.Heap && !p.allocOnStack(v))
 	case *ssa.IndexAddr:
 		vx := v.X
 		if _, ok := p.isVArgs(vx); ok { // varargs: this is a varargs index
@@ -489,11 +811,11 @@ func (p *context) compileInstrOrValue(b llssa.Builder, iv instrOrValue, asValue
 		}
 		x := p.compileValue(b, vx)
 		idx := p.compileValue(b, v.Index)
-		ret = b.IndexAddr(x, idx)
+		ret = b.IndexAddrEx(x, idx, inBounds(v, vx, v.Index))
 	case *ssa.Index:
 		x := p.compileValue(b, v.X)
 		idx := p.compileValue(b, v.Index)
-		ret = b.Index(x, idx, func() (addr llssa.Expr, zero bool) {
+		ret = b.IndexEx(x, idx, inBounds(v, v.X, v.Index), func() (addr llssa.Expr, zero bool) {
 			switch n := v.X.(type) {
 			case *ssa.Const:
 				zero = true
@@ -559,7 +881,7 @@ func (p *context) compileInstrOrValue(b llssa.Builder, iv instrOrValue, asValue
 	case *ssa.MakeClosure:
 		fn := p.compileValue(b, v.Fn)
 		bindings := p.compileValues(b, v.Bindings, 0)
-		ret = b.MakeClosure(fn, bindings)
+		ret = b.MakeClosureEx(fn, bindings, p.escapes(v))
 	case *ssa.TypeAssert:
 		x := p.compileValue(b, v.X)
 		t := p.prog.Type(v.AssertedType, llssa.InGo)
@@ -634,11 +956,18 @@ func (p *context) compileInstr(b llssa.Builder, instr ssa.Instruction) {
 		}
 		ptr := p.compileValue(b, va)
 		val := p.compileValue(b, v.Val)
-		b.Store(ptr, val)
+		if p.isVolatile(va) {
+			b.StoreVolatile(ptr, val)
+		} else {
+			b.Store(ptr, val)
+		}
 	case *ssa.Jump:
 		jmpb := p.jumpTo(v)
 		b.Jump(jmpb)
 	case *ssa.Return:
+		if p.rundefer && v.Block() != v.Parent().Recover {
+			b.RunDefers()
+		}
 		var results []llssa.Expr
 		if n := len(v.Results); n > 0 {
 			results = make([]llssa.Expr, n)
@@ -664,9 +993,15 @@ func (p *context) compileInstr(b llssa.Builder, instr ssa.Instruction) {
 		val := p.compileValue(b, v.Value)
 		b.MapUpdate(m, key, val)
 	case *ssa.Defer:
-		p.call(b, p.blkInfos[v.Block().Index].Kind, &v.Call)
+		if stack := deferStackOf(v); stack != nil { // range-over-func
+			p.deferOn = p.compileValue(b, stack)
+			p.call(b, llssa.DeferInLoop, v)
+			p.deferOn = llssa.Expr{}
+			break
+		}
+		p.call(b, p.blkInfos[v.Block().Index].Kind, v)
 	case *ssa.Go:
-		p.call(b, llssa.Go, &v.Call)
+		p.call(b, llssa.Go, v)
 	case *ssa.RunDefers:
 		b.RunDefers()
 	case *ssa.Panic:
@@ -792,22 +1127,32 @@ func NewPackageEx(prog llssa.Program, patches Patches, pkg *ssa.Package, files [
 	ret = prog.NewPackage(pkgName, pkgPath)
 
 	ctx := &context{
-		prog:    prog,
-		pkg:     ret,
-		fset:    pkgProg.Fset,
-		goProg:  pkgProg,
-		goTyps:  pkgTypes,
-		goPkg:   pkg,
-		patches: patches,
-		link:    make(map[string]string),
-		skips:   make(map[string]none),
-		vargs:   make(map[*ssa.Alloc][]llssa.Expr),
+		prog:      prog,
+		pkg:       ret,
+		fset:      pkgProg.Fset,
+		goProg:    pkgProg,
+		goTyps:    pkgTypes,
+		goPkg:     pkg,
+		patches:   patches,
+		link:      make(map[string]string),
+		skips:     make(map[string]none),
+		nosans:    make(map[string]none),
+		hardens:   make(map[string]llssa.Hardening),
+		pragmas:   make(map[string]pragma),
+		volatiles: make(map[string]none),
+		embeds:    make(map[string]*embedVar),
+		exports:   make(map[string]string),
+		vargs:     make(map[*ssa.Alloc][]llssa.Expr),
+		escParams: make(map[*ssa.Parameter]bool),
 		loaded: map[*types.Package]*pkgInfo{
 			types.Unsafe: {kind: PkgDeclOnly}, // TODO(xsw): PkgNoInit or PkgDeclOnly?
 		},
 	}
 	ctx.initPyModule()
 	ctx.initFiles(pkgPath, files)
+	if !hasPatch && CoverageOf(pkgPath) != llssa.CoverNone {
+		ctx.cover = ctx.newCoverUnit(ret, pkg, files)
+	}
 	ret.SetPatch(ctx.patchType)
 
 	if hasPatch {
@@ -835,6 +1180,17 @@ func NewPackageEx(prog llssa.Program, patches Patches, pkg *ssa.Package, files [
 	return
 }
 
+// isImportInit reports whether instr calls the init function of an imported
+// package, as the init function of a package does first.
+func isImportInit(instr ssa.Instruction) bool {
+	if call, ok := instr.(*ssa.Call); ok {
+		if fn, ok := call.Call.Value.(*ssa.Function); ok {
+			return fn.Name() == "init" && fn.Signature.Recv() == nil && fn.Pkg != instr.Parent().Pkg
+		}
+	}
+	return false
+}
+
 func initFnNameOfHasPatch(name string) string {
 	return name + "$hasPatch"
 }
@@ -17,12 +17,21 @@
 package cl_test
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
 	"testing"
 
+	"github.com/goplus/gogen/packages"
 	"github.com/goplus/llgo/cl"
 	"github.com/goplus/llgo/cl/cltest"
 	"github.com/goplus/llgo/internal/build"
 	"github.com/goplus/llgo/ssa"
+	"github.com/goplus/llgo/ssa/ssatest"
+	gossa "golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/ssa/ssautil"
 )
 
 func testCompile(t *testing.T, src, expected string) {
@@ -124,3 +133,95 @@ _llgo_2:                                          ; preds = %_llgo_1, %_llgo_0
 }
 `)
 }
+
+// compileIR compiles src, the package foo, and returns its IR.
+func compileIR(t *testing.T, src string) string {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal("ParseFile failed:", err)
+	}
+	files := []*ast.File{f}
+	imp := packages.NewImporter(fset)
+	foo, _, err := ssautil.BuildPackage(
+		&types.Config{Importer: imp}, fset, types.NewPackage("foo", "foo"), files, gossa.SanityCheckFunctions)
+	if err != nil {
+		t.Fatal("BuildPackage failed:", err)
+	}
+	prog := ssatest.NewProgramEx(t, nil, imp)
+	ret, err := cl.NewPackage(prog, foo, files)
+	if err != nil {
+		t.Fatal("cl.NewPackage failed:", err)
+	}
+	return ret.String()
+}
+
+// funcIR returns the definition of the function name in ir.
+func funcIR(ir, name string) string {
+	_, def, _ := strings.Cut(ir, "define void @"+name+"()")
+	def, _, _ = strings.Cut(def, "\n}\n")
+	return def
+}
+
+func TestCover(t *testing.T) {
+	cl.SetCoverage(ssa.CoverCount, func(pkgPath string) bool { return true })
+	defer cl.SetCoverage(ssa.CoverNone, nil)
+	ir := compileIR(t, `package foo
+
+func f() {
+	println("f")
+}
+
+//llgo:nosanitize
+func g() {
+	println("g")
+}
+`)
+	if !strings.Contains(funcIR(ir, "foo.f"), "foo.cover$counters") {
+		t.Fatal("Cover: f isn't counted\n" + ir)
+	}
+	if strings.Contains(funcIR(ir, "foo.g"), "foo.cover$counters") {
+		t.Fatal("Cover: nosanitize g is counted\n" + ir)
+	}
+
+	ir = compileIR(t, `//llgo:build nosanitize
+
+package foo
+
+func f() {
+	println("f")
+}
+`)
+	if strings.Contains(ir, "foo.cover$counters") {
+		t.Fatal("Cover: nosanitize package is counted\n" + ir)
+	}
+
+	ir = compileIR(t, `package foo
+
+//llgo:build nosanitize
+
+func f() {
+	println("f")
+}
+`)
+	if !strings.Contains(funcIR(ir, "foo.f"), "foo.cover$counters") {
+		t.Fatal("Cover: build directive after the package clause\n" + ir)
+	}
+}
+
+func TestVolatile(t *testing.T) {
+	ir := compileIR(t, `package foo
+
+//llgo:volatile
+var reg uint32
+
+func f() {
+	reg |= 1
+}
+`)
+	def := funcIR(ir, "foo.f")
+	if !strings.Contains(def, "load volatile i32, ptr @foo.reg") || !strings.Contains(def, "store volatile i32") {
+		t.Fatal("Volatile:\n" + ir)
+	}
+}
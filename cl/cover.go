@@ -0,0 +1,383 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	llssa "github.com/goplus/llgo/ssa"
+	"golang.org/x/tools/go/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// Coverage counts the runs of the basic blocks of the functions of covered
+// packages. Like in a Go coverage profile, a block is reported as the source
+// range of the statements it runs and their number: each statement is owned
+// by the first block having an instruction of it, or if it has none (like
+// `x := y`), by the block of the statement before or after it in the same
+// list, or by the entry block if it's the first one of a function. A
+// compound statement (if, for, switch...) is its header, up to the opening
+// brace of its body. Blocks owning no statement aren't counted, nor are the
+// instances of generic functions, which are compiled where they're used, nor
+// the functions which aren't instrumented (see context.noSanitize).
+
+var (
+	coverMode    llssa.CoverMode
+	coverCovered func(pkgPath string) bool
+)
+
+// SetCoverage enables the coverage counters of mode in the packages compiled
+// after for which covered returns true, or disables them with
+// llssa.CoverNone. Patched packages aren't covered.
+func SetCoverage(mode llssa.CoverMode, covered func(pkgPath string) bool) {
+	coverMode, coverCovered = mode, covered
+}
+
+// CoverageOf returns the mode of the coverage counters of the package
+// pkgPath set by SetCoverage, or llssa.CoverNone if it isn't covered.
+func CoverageOf(pkgPath string) llssa.CoverMode {
+	if coverMode != llssa.CoverNone && coverCovered(pkgPath) {
+		return coverMode
+	}
+	return llssa.CoverNone
+}
+
+// coverUnit is the coverage counters of the package being compiled.
+type coverUnit struct {
+	counters llssa.Global
+	blocks   map[*ssa.BasicBlock]int // counter indexes of the blocks counted
+}
+
+// coverStmt is a statement counted, or the header of a compound statement.
+type coverStmt struct {
+	pos, end token.Pos
+	prev     *coverStmt // statement before it in the same list, running before it
+	next     *coverStmt // statement after it in the same list, running after it
+	parent   *coverStmt // innermost statement containing it, like a function literal
+	blk      *ssa.BasicBlock
+}
+
+// newCoverUnit returns the coverage counters of the functions of pkg, whose
+// syntax is files, or nil if it has no statement counted.
+func (p *context) newCoverUnit(ret llssa.Package, pkg *ssa.Package, files []*ast.File) *coverUnit {
+	if p.nosanall {
+		return nil
+	}
+	fset := p.fset
+	stmts := coverStmtsOf(fset, files)
+	for _, fn := range coverFuncsOf(pkg) {
+		if len(fn.Blocks) == 0 {
+			continue
+		}
+		if _, name, _ := p.funcName(fn, true); p.noSanitize(name) {
+			continue // its statements own no block
+		}
+		if body := funcBody(fn); body != nil && len(body.List) > 0 {
+			if s := innermostStmt(stmts, body.List[0].Pos()); s != nil && s.pos == body.List[0].Pos() {
+				s.blk = fn.Blocks[0]
+			}
+		}
+		for _, block := range fn.Blocks {
+			for _, instr := range block.Instrs {
+				if pos := instr.Pos(); pos.IsValid() {
+					if s := innermostStmt(stmts, pos); s != nil && s.blk == nil {
+						s.blk = block
+					}
+				}
+			}
+		}
+	}
+
+	for _, s := range stmts { // in source order, so prev is done
+		if s.blk == nil && s.prev != nil {
+			s.blk = s.prev.blk
+		}
+	}
+	for i := len(stmts) - 1; i >= 0; i-- {
+		if s := stmts[i]; s.blk == nil && s.next != nil {
+			s.blk = s.next.blk
+		}
+	}
+
+	type coverBlock struct {
+		pos, end token.Pos
+		nstmt    int
+	}
+	byBlock := make(map[*ssa.BasicBlock]*coverBlock)
+	for _, s := range stmts {
+		if s.blk == nil {
+			continue
+		}
+		if cb := byBlock[s.blk]; cb != nil {
+			if s.pos < cb.pos {
+				cb.pos = s.pos
+			}
+			if s.end > cb.end {
+				cb.end = s.end
+			}
+			cb.nstmt++
+		} else {
+			byBlock[s.blk] = &coverBlock{s.pos, s.end, 1}
+		}
+	}
+	if len(byBlock) == 0 {
+		return nil
+	}
+	blocks := make([]*ssa.BasicBlock, 0, len(byBlock))
+	for block := range byBlock {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return byBlock[blocks[i]].pos < byBlock[blocks[j]].pos
+	})
+
+	unit := &coverUnit{blocks: make(map[*ssa.BasicBlock]int, len(blocks))}
+	var lines strings.Builder
+	for i, block := range blocks {
+		cb := byBlock[block]
+		start, end := fset.Position(cb.pos), fset.Position(cb.end)
+		fmt.Fprintf(&lines, "%s/%s:%d.%d,%d.%d %d\n", ret.Path(), filepath.Base(start.Filename),
+			start.Line, start.Column, end.Line, end.Column, cb.nstmt)
+		unit.blocks[block] = i
+	}
+	unit.counters = ret.NewCoverCounters(coverMode, lines.String(), len(blocks))
+	return unit
+}
+
+// coverBlock counts a run of block, if it's counted.
+func (p *context) coverBlock(b llssa.Builder, block *ssa.BasicBlock) {
+	if p.cover != nil {
+		if i, ok := p.cover.blocks[block]; ok {
+			b.CoverCount(p.cover.counters, i, coverMode)
+		}
+	}
+}
+
+// coverFuncsOf returns the functions of pkg written in its source, with their
+// function literals, sorted by position.
+func coverFuncsOf(pkg *ssa.Package) (fns []*ssa.Function) {
+	prog := pkg.Prog
+	seen := make(map[*ssa.Function]bool)
+	var add func(fn *ssa.Function)
+	add = func(fn *ssa.Function) {
+		if seen[fn] {
+			return
+		}
+		seen[fn] = true
+		if fn.Synthetic == "" {
+			fns = append(fns, fn)
+		}
+		for _, anon := range fn.AnonFuncs {
+			add(anon)
+		}
+	}
+	for _, m := range pkg.Members {
+		switch m := m.(type) {
+		case *ssa.Function:
+			if m.TypeParams() == nil {
+				add(m) // the package initializer for its function literals
+			}
+		case *ssa.Type:
+			if named, ok := m.Type().(*types.Named); ok && named.TypeParams() != nil {
+				continue
+			}
+			for _, t := range []types.Type{m.Type(), types.NewPointer(m.Type())} {
+				mset := prog.MethodSets.MethodSet(t)
+				for i, n := 0, mset.Len(); i < n; i++ {
+					if fn := prog.MethodValue(mset.At(i)); fn != nil && fn.Pkg == pkg {
+						add(fn)
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(fns, func(i, j int) bool {
+		return fns[i].Pos() < fns[j].Pos()
+	})
+	return
+}
+
+// funcBody returns the body of the function fn, or nil if it has no syntax.
+func funcBody(fn *ssa.Function) *ast.BlockStmt {
+	switch syntax := fn.Syntax().(type) {
+	case *ast.FuncDecl:
+		return syntax.Body
+	case *ast.FuncLit:
+		return syntax.Body
+	}
+	return nil
+}
+
+// coverStmtsOf returns the statements counted of files, sorted by position.
+// The files generated by cgo are skipped.
+func coverStmtsOf(fset *token.FileSet, files []*ast.File) []*coverStmt {
+	var c coverCollector
+	for _, f := range files {
+		if strings.HasPrefix(filepath.Base(fset.File(f.Pos()).Name()), "_cgo_") {
+			continue
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				if fn.Body != nil {
+					c.list(fn.Body.List)
+				}
+			} else {
+				c.funcLits(decl)
+			}
+		}
+	}
+	stmts := c.stmts
+	sort.Slice(stmts, func(i, j int) bool {
+		return stmts[i].pos < stmts[j].pos
+	})
+	// statements are nested or disjoint
+	var stack []*coverStmt
+	for _, s := range stmts {
+		for len(stack) > 0 && stack[len(stack)-1].end <= s.pos {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) > 0 {
+			s.parent = stack[len(stack)-1]
+		}
+		stack = append(stack, s)
+	}
+	return stmts
+}
+
+// innermostStmt returns the innermost statement of stmts containing pos, or
+// nil if there is none.
+func innermostStmt(stmts []*coverStmt, pos token.Pos) *coverStmt {
+	i := sort.Search(len(stmts), func(i int) bool {
+		return stmts[i].pos > pos
+	})
+	if i == 0 {
+		return nil
+	}
+	s := stmts[i-1]
+	for s != nil && s.end <= pos {
+		s = s.parent
+	}
+	return s
+}
+
+type coverCollector struct {
+	stmts []*coverStmt
+}
+
+func (c *coverCollector) add(pos, end token.Pos, prev *coverStmt) *coverStmt {
+	s := &coverStmt{pos: pos, end: end, prev: prev}
+	if prev != nil {
+		prev.next = s
+	}
+	c.stmts = append(c.stmts, s)
+	return s
+}
+
+func (c *coverCollector) list(list []ast.Stmt) {
+	var prev *coverStmt
+	for _, s := range list {
+		prev = c.stmt(s, prev)
+	}
+}
+
+// stmt collects the statement s, running after prev if it isn't nil. It
+// returns the statement the next one of the list runs after, if any.
+func (c *coverCollector) stmt(s ast.Stmt, prev *coverStmt) *coverStmt {
+	switch s := s.(type) {
+	case *ast.BlockStmt:
+		c.list(s.List)
+	case *ast.LabeledStmt: // may be jumped to
+		c.stmt(s.Stmt, nil)
+	case *ast.EmptyStmt:
+		return prev
+	case *ast.IfStmt:
+		c.header(s, s.Body, prev, s.Init, s.Cond)
+		c.list(s.Body.List)
+		if s.Else != nil {
+			c.stmt(s.Else, nil)
+		}
+	case *ast.ForStmt:
+		c.header(s, s.Body, prev, s.Init, s.Cond, s.Post)
+		c.list(s.Body.List)
+	case *ast.RangeStmt:
+		c.header(s, s.Body, prev, s.Key, s.Value, s.X)
+		c.list(s.Body.List)
+	case *ast.SwitchStmt:
+		c.header(s, s.Body, prev, s.Init, s.Tag)
+		for _, cc := range s.Body.List {
+			cc := cc.(*ast.CaseClause)
+			for _, x := range cc.List {
+				c.funcLits(x)
+			}
+			c.list(cc.Body)
+		}
+	case *ast.TypeSwitchStmt:
+		c.header(s, s.Body, prev, s.Init, s.Assign)
+		for _, cc := range s.Body.List {
+			c.list(cc.(*ast.CaseClause).Body)
+		}
+	case *ast.SelectStmt:
+		c.header(s, s.Body, prev)
+		for _, cc := range s.Body.List {
+			cc := cc.(*ast.CommClause)
+			if cc.Comm != nil {
+				c.list(append([]ast.Stmt{cc.Comm}, cc.Body...))
+			} else {
+				c.list(cc.Body)
+			}
+		}
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		c.add(s.Pos(), s.End(), prev)
+		c.funcLits(s)
+	default:
+		ret := c.add(s.Pos(), s.End(), prev)
+		c.funcLits(s)
+		return ret
+	}
+	return nil
+}
+
+// header collects the header of the compound statement s, up to the opening
+// brace of body, and the function literals of its parts.
+func (c *coverCollector) header(s ast.Stmt, body *ast.BlockStmt, prev *coverStmt, parts ...ast.Node) {
+	c.add(s.Pos(), body.Lbrace, prev)
+	for _, part := range parts {
+		if part != nil {
+			c.funcLits(part)
+		}
+	}
+}
+
+// funcLits collects the statements of the function literals of node.
+func (c *coverCollector) funcLits(node ast.Node) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if fn, ok := n.(*ast.FuncLit); ok {
+			c.list(fn.Body.List)
+			return false
+		}
+		return true
+	})
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"go/token"
+	"go/types"
+	"sort"
+	"sync"
+
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// -----------------------------------------------------------------------------
+
+// LiveMethods finds the methods of a program that may be called, starting from
+// the functions roots, like the deadcode pass of the gc linker. A method of a
+// type whose values may be in interfaces is live if:
+//   - it's called directly or its function value is used, or
+//   - a method of the same name and signature is called through an interface,
+//     or
+//   - it's exported, and reflect's Method or MethodByName is reachable.
+//
+// The type descriptors of the program only refer to live methods, so that the
+// linker can drop the others (see llssa.Program.SetLiveMethod). The returned
+// function reports whether method id of type recv is live: methods of types
+// the analysis never saw are live, and it's safe for concurrent use. dead
+// lists the dead methods as recv.id, sorted, which identifies the result for
+// the build cache.
+func LiveMethods(roots []*ssa.Function) (live func(recv types.Type, id string) bool, dead []string) {
+	if len(roots) == 0 {
+		return nil, nil
+	}
+	d := &deadcode{
+		prog:    roots[0].Prog,
+		live:    make(map[*ssa.Function]none),
+		methods: make(map[string][]*types.Selection),
+		calls:   make(map[string][]*types.Signature),
+	}
+	for _, fn := range roots {
+		d.addFunc(fn)
+	}
+	d.run()
+	return d.deadMethods()
+}
+
+type deadcode struct {
+	prog    *ssa.Program
+	live    map[*ssa.Function]none        // reachable functions
+	queue   []*ssa.Function               // reachable functions to visit
+	rtypes  typeutil.Map                  // types whose values may be in interfaces
+	methods map[string][]*types.Selection // method id => methods of rtypes
+	calls   map[string][]*types.Signature // method id => signatures of interface calls
+	reflect bool                          // reflect's Method or MethodByName is reachable
+}
+
+func (d *deadcode) run() {
+	var ops []*ssa.Value
+	for len(d.queue) > 0 {
+		fn := d.queue[len(d.queue)-1]
+		d.queue = d.queue[:len(d.queue)-1]
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				switch v := instr.(type) {
+				case *ssa.MakeInterface:
+					d.addType(v.X.Type())
+				case ssa.CallInstruction:
+					if call := v.Common(); call.IsInvoke() {
+						d.addCall(call.Method)
+					}
+				}
+				ops = instr.Operands(ops[:0])
+				for _, op := range ops {
+					if fn, ok := (*op).(*ssa.Function); ok {
+						d.addFunc(fn)
+					}
+				}
+			}
+		}
+	}
+}
+
+func (d *deadcode) addFunc(fn *ssa.Function) {
+	if fn == nil {
+		return
+	}
+	if _, ok := d.live[fn]; ok {
+		return
+	}
+	if fn.TypeParams().Len() > 0 && len(fn.TypeArgs()) == 0 {
+		return // generic function, only its instantiations are called
+	}
+	d.live[fn] = none{}
+	d.queue = append(d.queue, fn)
+	if !d.reflect && isReflectMethod(fn) {
+		d.reflect = true
+		for _, mthds := range d.methods {
+			for _, m := range mthds {
+				if token.IsExported(m.Obj().Name()) {
+					d.addFunc(d.prog.MethodValue(m))
+				}
+			}
+		}
+	}
+}
+
+// isReflectMethod reports whether fn is Method or MethodByName of reflect's
+// Type or Value, which can call any exported method.
+func isReflectMethod(fn *ssa.Function) bool {
+	if fn.Pkg == nil || fn.Pkg.Pkg.Path() != "reflect" || fn.Signature.Recv() == nil {
+		return false
+	}
+	name := fn.Name()
+	return name == "Method" || name == "MethodByName"
+}
+
+// addCall records an interface call of method m.
+func (d *deadcode) addCall(m *types.Func) {
+	id := m.Id()
+	sig := m.Type().(*types.Signature)
+	for _, s := range d.calls[id] {
+		if types.Identical(s, sig) {
+			return
+		}
+	}
+	d.calls[id] = append(d.calls[id], sig)
+	for _, sel := range d.methods[id] {
+		if types.Identical(sel.Type(), sig) {
+			d.addFunc(d.prog.MethodValue(sel))
+		}
+	}
+}
+
+// addType records that values of t may be in interfaces. So may the values
+// reflect can get from them, eg. their fields, elements, or addresses.
+func (d *deadcode) addType(t types.Type) {
+	if d.rtypes.At(t) != nil {
+		return
+	}
+	d.rtypes.Set(t, true)
+	switch t := t.(type) {
+	case *types.Named:
+		d.addType(types.NewPointer(t))
+		d.addType(t.Underlying())
+	case *types.Pointer:
+		d.addType(t.Elem())
+	case *types.Slice:
+		d.addType(t.Elem())
+	case *types.Array:
+		d.addType(t.Elem())
+	case *types.Chan:
+		d.addType(t.Elem())
+	case *types.Map:
+		d.addType(t.Key())
+		d.addType(t.Elem())
+	case *types.Struct:
+		for i, n := 0, t.NumFields(); i < n; i++ {
+			d.addType(t.Field(i).Type())
+		}
+	case *types.Signature:
+		d.addTuple(t.Params())
+		d.addTuple(t.Results())
+	case *types.Interface, *types.TypeParam:
+		return
+	}
+	mset := d.prog.MethodSets.MethodSet(t)
+	for i, n := 0, mset.Len(); i < n; i++ {
+		d.addMethod(mset.At(i))
+	}
+}
+
+func (d *deadcode) addTuple(t *types.Tuple) {
+	for i, n := 0, t.Len(); i < n; i++ {
+		d.addType(t.At(i).Type())
+	}
+}
+
+func (d *deadcode) addMethod(sel *types.Selection) {
+	m := sel.Obj()
+	id := m.Id()
+	d.methods[id] = append(d.methods[id], sel)
+	if d.reflect && token.IsExported(m.Name()) {
+		d.addFunc(d.prog.MethodValue(sel))
+		return
+	}
+	sig := sel.Type()
+	for _, s := range d.calls[id] {
+		if types.Identical(s, sig) {
+			d.addFunc(d.prog.MethodValue(sel))
+			return
+		}
+	}
+}
+
+// deadMethods returns the function reporting whether method id of type recv
+// is live.
+func (d *deadcode) deadMethods() (func(recv types.Type, id string) bool, []string) {
+	var dead typeutil.Map // type => map[string]none
+	var names []string
+	for id, mthds := range d.methods {
+		for _, sel := range mthds {
+			if _, ok := d.live[d.prog.MethodValue(sel)]; ok {
+				continue
+			}
+			ids, _ := dead.At(sel.Recv()).(map[string]none)
+			if ids == nil {
+				ids = make(map[string]none)
+				dead.Set(sel.Recv(), ids)
+			}
+			if _, ok := ids[id]; !ok {
+				ids[id] = none{}
+				names = append(names, types.TypeString(sel.Recv(), nil)+"."+id)
+			}
+		}
+	}
+	sort.Strings(names)
+	var mu sync.Mutex // dead.At memoizes the hashes of types
+	return func(recv types.Type, id string) bool {
+		mu.Lock()
+		ids, _ := dead.At(recv).(map[string]none)
+		mu.Unlock()
+		_, ok := ids[id]
+		return !ok
+	}, names
+}
+
+// -----------------------------------------------------------------------------
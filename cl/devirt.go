@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+var noDevirt bool
+
+// EnableDevirt enables or disables devirtualization of interface method calls
+// (see devirtualize). It's enabled by default.
+func EnableDevirt(b bool) {
+	noDevirt = !b
+}
+
+// devirtualize returns the method called by the interface method call instr
+// and its receiver, if the concrete type of the interface value is known:
+//   - the value is made by MakeInterface (maybe through ChangeInterface), or
+//   - instr is dominated by a successful TypeAssert of the value to a concrete
+//     type, like in a type switch arm.
+//
+// So the call can be compiled as a direct call, which LLVM may inline.
+func (p *context) devirtualize(instr ssa.CallInstruction) (fn *ssa.Function, recv ssa.Value) {
+	call := instr.Common()
+	if noDevirt || call.Method == nil {
+		return
+	}
+	x := call.Value
+	for {
+		v, ok := x.(*ssa.ChangeInterface)
+		if !ok {
+			break
+		}
+		x = v.X
+	}
+	if v, ok := x.(*ssa.MakeInterface); ok {
+		recv = v.X
+	} else {
+		recv = assertedValue(instr.Block(), x)
+	}
+	if recv == nil {
+		return
+	}
+	mthd := call.Method
+	prog := p.goProg
+	sel := prog.MethodSets.MethodSet(recv.Type()).Lookup(mthd.Pkg(), mthd.Name())
+	if sel == nil {
+		return nil, nil
+	}
+	// skip wrappers, which may check nil pointers (see ssa:wrapnilchk)
+	fn = prog.MethodValue(sel)
+	if fn == nil || fn.Synthetic != "" && !strings.HasPrefix(fn.Synthetic, "instance of ") {
+		return nil, nil
+	}
+	return
+}
+
+// assertedValue returns the value of the interface x asserted to a concrete
+// type, if the block blk is dominated by the success of the assertion.
+func assertedValue(blk *ssa.BasicBlock, x ssa.Value) ssa.Value {
+	for ; blk != nil; blk = blk.Idom() {
+		if len(blk.Preds) != 1 {
+			continue
+		}
+		pred := blk.Preds[0]
+		cond, ok := pred.Instrs[len(pred.Instrs)-1].(*ssa.If)
+		if !ok || pred.Succs[0] != blk || pred.Succs[1] == blk {
+			continue
+		}
+		commaOk, _ := cond.Cond.(*ssa.Extract)
+		if commaOk == nil || commaOk.Index != 1 {
+			continue
+		}
+		ta, _ := commaOk.Tuple.(*ssa.TypeAssert)
+		if ta == nil || ta.X != x || types.IsInterface(ta.AssertedType) {
+			continue
+		}
+		for _, ref := range *ta.Referrers() {
+			if v, _ := ref.(*ssa.Extract); v != nil && v.Index == 0 {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	llssa "github.com/goplus/llgo/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// embedVar presents a variable with //go:embed directives.
+type embedVar struct {
+	pos      string   // position of the first directive
+	dir      string   // package directory
+	patterns []string // patterns of all directives
+}
+
+// go:embed pattern1 "pattern 2" ...
+func (p *context) collectEmbed(fullName string, spec *ast.ValueSpec, c *ast.Comment, args string) {
+	pos := p.fset.Position(c.Pos())
+	if len(spec.Names) != 1 || len(spec.Values) != 0 {
+		panic(pos.String() + ": go:embed cannot apply to multiple vars or var with initializer")
+	}
+	patterns, err := parseEmbedPatterns(args)
+	if err != nil {
+		panic(pos.String() + ": invalid go:embed: " + err.Error())
+	}
+	v, ok := p.embeds[fullName]
+	if !ok {
+		v = &embedVar{pos: pos.String(), dir: filepath.Dir(pos.Filename)}
+		p.embeds[fullName] = v
+	}
+	v.patterns = append(v.patterns, patterns...)
+}
+
+// parseEmbedPatterns splits the arguments of a go:embed directive, which are
+// separated by spaces and may be Go string literals.
+func parseEmbedPatterns(args string) (patterns []string, err error) {
+	for {
+		args = strings.TrimLeft(args, " \t")
+		if args == "" {
+			return
+		}
+		var pattern string
+		switch args[0] {
+		case '"', '`':
+			quote := args[0]
+			i := 1
+			for i < len(args) && args[i] != quote {
+				if args[i] == '\\' && quote == '"' {
+					i++
+				}
+				i++
+			}
+			if i >= len(args) {
+				return nil, fmt.Errorf("unterminated string: %s", args)
+			}
+			if pattern, err = strconv.Unquote(args[:i+1]); err != nil {
+				return nil, fmt.Errorf("invalid quoted string: %s", args[:i+1])
+			}
+			args = args[i+1:]
+			if args != "" && args[0] != ' ' && args[0] != '\t' {
+				return nil, fmt.Errorf("missing space after quoted string")
+			}
+		default:
+			i := strings.IndexAny(args, " \t")
+			if i < 0 {
+				i = len(args)
+			}
+			pattern, args = args[:i], args[i:]
+		}
+		patterns = append(patterns, pattern)
+	}
+}
+
+// embedFiles resolves the patterns of v to the files they match, which are
+// relative to the package directory and sorted. Directories matched are
+// walked recursively, skipping files whose names begin with '.' or '_'
+// unless the pattern has the prefix "all:".
+func (v *embedVar) embedFiles() []string {
+	var files []string
+	have := make(map[string]bool)
+	for _, pattern := range v.patterns {
+		all := strings.HasPrefix(pattern, "all:")
+		if all {
+			pattern = pattern[4:]
+		}
+		if _, err := path.Match(pattern, ""); err != nil || !validEmbedPattern(pattern) {
+			v.fatal("invalid pattern syntax: %s", pattern)
+		}
+		matches, _ := filepath.Glob(filepath.Join(v.dir, filepath.FromSlash(pattern)))
+		if len(matches) == 0 {
+			v.fatal("pattern %s: no matching files found", pattern)
+		}
+		for _, match := range matches {
+			n := len(files)
+			err := filepath.WalkDir(match, func(file string, d fs.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if file != match {
+					name := d.Name()
+					if !all && (name[0] == '.' || name[0] == '_') {
+						if d.IsDir() {
+							return filepath.SkipDir
+						}
+						return nil
+					}
+					if d.IsDir() {
+						if _, err := os.Stat(filepath.Join(file, "go.mod")); err == nil {
+							return filepath.SkipDir // in another module
+						}
+					}
+				}
+				if d.IsDir() {
+					return nil
+				}
+				if !d.Type().IsRegular() {
+					if file == match {
+						v.fatal("pattern %s: cannot embed irregular file %s", pattern, d.Name())
+					}
+					return nil
+				}
+				rel, _ := filepath.Rel(v.dir, file)
+				if rel = filepath.ToSlash(rel); !have[rel] {
+					have[rel] = true
+					files = append(files, rel)
+				}
+				return nil
+			})
+			if err != nil {
+				v.fatal("pattern %s: %v", pattern, err)
+			}
+			if len(files) == n {
+				v.fatal("pattern %s: cannot embed directory %s: contains no embeddable files", pattern, match)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// validEmbedPattern reports whether pattern is a clean unrooted path, which
+// doesn't refer to parent directories.
+func validEmbedPattern(pattern string) bool {
+	if pattern == "" || pattern == "." || path.Clean(pattern) != pattern || path.IsAbs(pattern) {
+		return false
+	}
+	for _, elem := range strings.Split(pattern, "/") {
+		if elem == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *embedVar) fatal(format string, args ...any) {
+	panic(v.pos + ": " + fmt.Sprintf(format, args...))
+}
+
+func (v *embedVar) readFile(name string) string {
+	b, err := os.ReadFile(filepath.Join(v.dir, filepath.FromSlash(name)))
+	if err != nil {
+		v.fatal("%v", err)
+	}
+	return string(b)
+}
+
+// embedInit returns the initial value of a //go:embed variable of type typ,
+// which is string, []byte or embed.FS.
+func (p *context) embedInit(pkg llssa.Package, v *embedVar, typ types.Type) llssa.Expr {
+	files := v.embedFiles()
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		if t.Kind() == types.String {
+			return pkg.ConstStr(v.readFile(v.singleFile(files)))
+		}
+	case *types.Slice:
+		if elem, ok := t.Elem().(*types.Basic); ok && elem.Kind() == types.Byte {
+			return pkg.ConstBytes(v.readFile(v.singleFile(files)))
+		}
+	case *types.Struct:
+		if named, ok := typ.(*types.Named); ok {
+			if obj := named.Obj(); obj.Name() == "FS" && obj.Pkg() != nil && obj.Pkg().Path() == "embed" {
+				return p.embedFS(pkg, v, files, typ)
+			}
+		}
+	}
+	v.fatal("go:embed cannot apply to var of type %v", typ)
+	return llssa.Expr{}
+}
+
+func (v *embedVar) singleFile(files []string) string {
+	if len(files) != 1 {
+		v.fatal("invalid go:embed: multiple files for type string or []byte")
+	}
+	return files[0]
+}
+
+// embedFS returns an embed.FS of files and their parent directories:
+//
+//	type FS struct { files *[]file }
+//	type file struct { name, data string; hash [16]byte }
+//
+// The files are sorted by directory and then by name, as package embed
+// requires.
+func (p *context) embedFS(pkg llssa.Package, v *embedVar, files []string, typ types.Type) llssa.Expr {
+	prog := p.prog
+	tfs := prog.Type(typ, llssa.InGo)
+	tfiles := prog.Elem(prog.Field(tfs, 0))
+	tfile := prog.Index(tfiles)
+	hash := prog.Zero(prog.Field(tfile, 2)) // not used by package embed
+	names := make([]string, 0, len(files))
+	have := make(map[string]bool)
+	for _, file := range files {
+		names = append(names, file)
+		for dir := path.Dir(file); dir != "." && !have[dir]; dir = path.Dir(dir) {
+			have[dir] = true
+			names = append(names, dir+"/")
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		idir, ielem := splitEmbedName(names[i])
+		jdir, jelem := splitEmbedName(names[j])
+		return idir < jdir || idir == jdir && ielem < jelem
+	})
+	elts := make([]llssa.Expr, len(names))
+	for i, name := range names {
+		var data string
+		if !strings.HasSuffix(name, "/") {
+			data = v.readFile(name)
+		}
+		elts[i] = prog.ConstStruct(tfile, pkg.ConstStr(name), pkg.ConstStr(data), hash)
+	}
+	ptr := pkg.ConstAddr(pkg.ConstSlice(tfiles, elts...))
+	return prog.ConstStruct(tfs, ptr)
+}
+
+// splitEmbedName splits name into its directory and the last element, without
+// the trailing slash of a directory.
+func splitEmbedName(name string) (dir, elem string) {
+	trimmed := strings.TrimSuffix(name, "/")
+	i := strings.LastIndexByte(trimmed, '/')
+	if i < 0 {
+		return ".", trimmed
+	}
+	return trimmed[:i], trimmed[i+1:]
+}
+
+// -----------------------------------------------------------------------------
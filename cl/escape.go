@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ssa"
+
+	llssa "github.com/goplus/llgo/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// maxStackAlloc is the max size of an allocation moved to the stack, which is
+// the same as gc's limit of implicit stack variables.
+const maxStackAlloc = 64 << 10
+
+// allocOnStack reports whether a heap allocation (a composite literal, new(T)
+// or a variable whose address is taken) can be on the stack: its address, and
+// pointers derived from it, don't escape from the function (see escapes).
+func (p *context) allocOnStack(v *ssa.Alloc) bool {
+	elem := v.Type().(*types.Pointer).Elem()
+	if p.prog.SizeOf(p.prog.Type(elem, llssa.InGo)) > maxStackAlloc {
+		return false
+	}
+	return !p.escapes(v)
+}
+
+// escapes reports whether the pointer v may outlive the function: it's stored
+// into memory, returned, captured, converted, merged by a phi (so allocations
+// of different loop iterations don't share the stack slot), or passed to a
+// function which doesn't promise not to retain it.
+func (p *context) escapes(v ssa.Value) bool {
+	for _, ref := range *v.Referrers() {
+		switch ref := ref.(type) {
+		case *ssa.UnOp:
+			if ref.Op != token.MUL {
+				return true
+			}
+		case *ssa.Store:
+			if ref.Val == v {
+				return true
+			}
+		case *ssa.BinOp: // comparison
+		case *ssa.DebugRef:
+		case *ssa.FieldAddr, *ssa.IndexAddr, *ssa.Slice, *ssa.ChangeType:
+			if p.escapes(ref.(ssa.Value)) {
+				return true
+			}
+		case *ssa.Call:
+			if p.callEscapes(ref, v) {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// callEscapes reports whether the pointer v escapes through the call.
+func (p *context) callEscapes(call *ssa.Call, v ssa.Value) bool {
+	c := &call.Call
+	if c.Value == v { // a called closure doesn't escape, its context isn't retained
+		for _, arg := range c.Args {
+			if arg == v {
+				return true
+			}
+		}
+		return false
+	}
+	switch fn := c.Value.(type) {
+	case *ssa.Builtin:
+		switch fn.Name() {
+		case "len", "cap", "copy", "clear", "print", "println":
+			return false
+		case "append": // the result refers to the first argument
+			return c.Args[0] == v && p.escapes(call)
+		case "ssa:wrapnilchk":
+			return p.escapes(call)
+		}
+		return true
+	case *ssa.Function:
+		if fn.Pkg != nil && p.pragmas[funcName(fn.Pkg.Pkg, fn)]&pragmaNoEscape != 0 {
+			return false
+		}
+		for i, arg := range c.Args {
+			if arg == v && p.paramEscapes(fn, i) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// paramEscapes reports whether the ith parameter of fn may escape. Only
+// functions of the package being compiled are analyzed, and a parameter is
+// assumed to escape while its function is being analyzed (for recursions).
+func (p *context) paramEscapes(fn *ssa.Function, i int) bool {
+	if fn.Pkg != p.goPkg || fn.Blocks == nil || i >= len(fn.Params) {
+		return true
+	}
+	param := fn.Params[i]
+	if ret, ok := p.escParams[param]; ok {
+		return ret
+	}
+	p.escParams[param] = true
+	ret := p.escapes(param)
+	p.escParams[param] = ret
+	return ret
+}
+
+// -----------------------------------------------------------------------------
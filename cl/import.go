@@ -174,21 +174,24 @@ start:
 }
 
 func (p *context) initFiles(pkgPath string, files []*ast.File) {
+	syms := make(map[string]symInfo) // inPkgName => symbol
 	for _, file := range files {
+		p.collectBuildDirectives(file)
 		for _, decl := range file.Decls {
 			switch decl := decl.(type) {
 			case *ast.FuncDecl:
 				fullName, inPkgName := astFuncName(pkgPath, decl)
-				p.initLinknameByDoc(decl.Doc, fullName, inPkgName, false)
+				syms[inPkgName] = symInfo{fullName: fullName}
+				p.collectFuncDirectives(decl.Doc, fullName)
 			case *ast.GenDecl:
 				switch decl.Tok {
 				case token.VAR:
-					if len(decl.Specs) == 1 {
-						if names := decl.Specs[0].(*ast.ValueSpec).Names; len(names) == 1 {
-							inPkgName := names[0].Name
-							p.initLinknameByDoc(decl.Doc, pkgPath+"."+inPkgName, inPkgName, true)
+					for _, spec := range decl.Specs {
+						for _, name := range spec.(*ast.ValueSpec).Names {
+							syms[name.Name] = symInfo{fullName: pkgPath + "." + name.Name, isVar: true}
 						}
 					}
+					p.collectVarDirectives(pkgPath, decl)
 				case token.IMPORT:
 					if doc := decl.Doc; doc != nil {
 						if n := len(doc.List); n > 0 {
@@ -202,6 +205,20 @@ func (p *context) initFiles(pkgPath string, files []*ast.File) {
 			}
 		}
 	}
+	// linkname directives may be anywhere in the files of the package
+	for _, file := range files {
+		for _, group := range file.Comments {
+			for _, c := range group.List {
+				p.initLinkname(c.Text, func(inPkgName string) (fullName string, isVar, ok bool) {
+					if sym, ok := syms[inPkgName]; ok {
+						return sym.fullName, sym.isVar, true
+					}
+					return
+				})
+				p.collectCgoImport(c.Text)
+			}
+		}
+	}
 }
 
 // llgo:skip symbol1 symbol2 ...
@@ -235,17 +252,180 @@ func (p *context) collectSkip(line string, prefix int) {
 	}
 }
 
-func (p *context) initLinknameByDoc(doc *ast.CommentGroup, fullName, inPkgName string, isVar bool) {
-	if doc != nil {
-		if n := len(doc.List); n > 0 {
-			line := doc.List[n-1].Text
-			p.initLinkname(line, func(name string) (_ string, _, ok bool) {
-				return fullName, isVar, name == inPkgName
-			})
+// llgo:build nosanitize norace harden=sspstrong,stackclash
+//
+// Like go:build, they are only in the header comments of a file, before its
+// package clause.
+func (p *context) collectBuildDirectives(file *ast.File) {
+	const (
+		build  = "//llgo:build "
+		build2 = "// llgo:build "
+	)
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+		for _, c := range group.List {
+			line := c.Text
+			if strings.HasPrefix(line, build2) {
+				line = line[len(build2):]
+			} else if strings.HasPrefix(line, build) {
+				line = line[len(build):]
+			} else {
+				continue
+			}
+			for _, opt := range strings.Fields(line) {
+				switch {
+				case opt == "nosanitize":
+					p.nosanall = true
+				case opt == "norace":
+					p.noraceall = true
+				case strings.HasPrefix(opt, "harden="):
+					pkg := p.pkg
+					pkg.SetHardening(pkg.Hardening() | parseHardening(c.Text, opt[7:]))
+				}
+			}
+		}
+	}
+}
+
+// pragma represents compiler pragmas of a function.
+type pragma uint8
+
+const (
+	pragmaNoInline       pragma = 1 << iota // go:noinline
+	pragmaAlwaysInline                      // llgo:alwaysinline
+	pragmaNoSplit                           // go:nosplit
+	pragmaNoEscape                          // go:noescape
+	pragmaNoWriteBarrier                    // go:nowritebarrier, go:nowritebarrierrec
+)
+
+// llgo:nosanitize
+// llgo:harden [sspstrong,stackclash]
+// llgo:alwaysinline
+// go:noinline, go:nosplit, go:noescape, go:nowritebarrier[rec]
+func (p *context) collectFuncDirectives(doc *ast.CommentGroup, fullName string) {
+	if doc == nil {
+		return
+	}
+	for _, c := range doc.List {
+		line := strings.TrimSpace(c.Text)
+		switch line {
+		case "//go:noinline":
+			p.pragmas[fullName] |= pragmaNoInline
+		case "//go:nosplit":
+			p.pragmas[fullName] |= pragmaNoSplit
+		case "//go:noescape":
+			p.pragmas[fullName] |= pragmaNoEscape
+		case "//go:nowritebarrier", "//go:nowritebarrierrec":
+			p.pragmas[fullName] |= pragmaNoWriteBarrier
+		case "//llgo:alwaysinline", "// llgo:alwaysinline":
+			p.pragmas[fullName] |= pragmaAlwaysInline
+		case "//llgo:nosanitize", "// llgo:nosanitize":
+			p.nosans[fullName] = none{}
+		case "//llgo:harden", "// llgo:harden":
+			p.hardens[fullName] = llssa.HardenAll
+		default:
+			if opts, ok := cutDirective(line, "llgo:harden "); ok {
+				p.hardens[fullName] = parseHardening(line, opts)
+			} else if name, ok := strings.CutPrefix(line, "//export "); ok {
+				p.exports[fullName] = strings.TrimSpace(name)
+			}
+		}
+	}
+}
+
+// cutDirective returns the arguments of a directive line `//name args` or
+// `// name args`.
+func cutDirective(line, name string) (args string, ok bool) {
+	line = strings.TrimPrefix(line, "//")
+	line = strings.TrimPrefix(line, " ")
+	return strings.CutPrefix(line, name)
+}
+
+func parseHardening(line, opts string) llssa.Hardening {
+	h, err := llssa.ParseHardening(opts)
+	if err != nil {
+		panic(line + ": " + err.Error())
+	}
+	return h
+}
+
+// llgo:volatile
+// go:embed patterns
+func (p *context) collectVarDirectives(pkgPath string, decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		spec := spec.(*ast.ValueSpec)
+		doc := spec.Doc
+		if doc == nil && len(decl.Specs) == 1 {
+			doc = decl.Doc
+		}
+		if doc == nil {
+			continue
+		}
+		for _, c := range doc.List {
+			switch line := strings.TrimSpace(c.Text); line {
+			case "//llgo:volatile", "// llgo:volatile":
+				for _, name := range spec.Names {
+					p.volatiles[pkgPath+"."+name.Name] = none{}
+				}
+			default:
+				if args, ok := strings.CutPrefix(line, "//go:embed "); ok {
+					p.collectEmbed(pkgPath+"."+spec.Names[0].Name, spec, c, args)
+				}
+			}
 		}
 	}
 }
 
+// isVolatile checks if addr is an address of a volatile global variable
+// (or a field/element of it).
+func (p *context) isVolatile(addr ssa.Value) bool {
+	if len(p.volatiles) == 0 {
+		return false
+	}
+	for {
+		switch v := addr.(type) {
+		case *ssa.FieldAddr:
+			addr = v.X
+		case *ssa.IndexAddr:
+			if _, ok := v.X.Type().Underlying().(*types.Pointer); !ok { // slice
+				return false
+			}
+			addr = v.X
+		case *ssa.Global:
+			_, ok := p.volatiles[llssa.FullName(v.Pkg.Pkg, v.Name())]
+			return ok
+		default:
+			return false
+		}
+	}
+}
+
+// setPragmas applies the compiler pragmas of a function.
+func (p *context) setPragmas(fn llssa.Function, fullName string) {
+	pragma := p.pragmas[fullName]
+	if pragma&pragmaNoInline != 0 {
+		fn.SetNoInline()
+	} else if pragma&pragmaAlwaysInline != 0 {
+		fn.SetAlwaysInline()
+	}
+	if pragma&pragmaNoSplit != 0 {
+		fn.SetNoSplit()
+	}
+	if pragma&pragmaNoWriteBarrier != 0 {
+		fn.SetNoWriteBarrier()
+	}
+}
+
+func (p *context) noSanitize(fullName string) bool {
+	if p.nosanall {
+		return true
+	}
+	_, ok := p.nosans[fullName]
+	return ok
+}
+
 func (p *context) initLinkname(line string, f func(inPkgName string) (fullName string, isVar, ok bool)) {
 	const (
 		linkname  = "//go:linkname "
@@ -267,6 +447,9 @@ func (p *context) initLink(line string, prefix int, f func(inPkgName string) (fu
 		inPkgName := text[:idx]
 		if fullName, isVar, ok := f(inPkgName); ok {
 			link := strings.TrimLeft(text[idx+1:], " ")
+			if link == inPkgName { // eg. cgo exports _cgoexp_xxx_f by linkname to itself
+				return
+			}
 			if isVar || strings.Contains(link, ".") { // eg. C.printf, C.strlen, llgo.cstr
 				p.link[fullName] = link
 			} else {
@@ -366,7 +549,7 @@ func funcName(pkg *types.Package, fn *ssa.Function) string {
 func checkCgo(fnName string) bool {
 	return len(fnName) > 4 && fnName[0] == '_' && fnName[2] == 'g' && fnName[3] == 'o' &&
 		(fnName[1] == 'C' || fnName[1] == 'c') &&
-		(fnName[4] == '_' || strings.HasPrefix(fnName[4:], "Check"))
+		(fnName[4] == '_' || strings.HasPrefix(fnName[4:], "Check") || strings.HasPrefix(fnName[4:], "exp_"))
 }
 
 const (
@@ -394,9 +577,13 @@ const (
 
 	llgoFuncAddr = llgoInstrBase + 0xd
 
+	llgoVolatileLoad  = llgoInstrBase + 0xe
+	llgoVolatileStore = llgoInstrBase + 0xf
+
 	llgoPyList = llgoInstrBase + 0x10
 	llgoPyStr  = llgoInstrBase + 0x11
 
+	llgoAtomicFence   = llgoInstrBase + 0x1c
 	llgoAtomicLoad    = llgoInstrBase + 0x1d
 	llgoAtomicStore   = llgoInstrBase + 0x1e
 	llgoAtomicCmpXchg = llgoInstrBase + 0x1f
@@ -432,9 +619,20 @@ func (p *context) funcName(fn *ssa.Function, ignore bool) (*types.Package, strin
 		}
 		p.ensureLoaded(pkg)
 		orgName = funcName(pkg, fn)
-		if ignore && ignoreName(orgName) || checkCgo(fn.Name()) {
+		if ignore && ignoreName(orgName) {
 			return nil, orgName, ignoredFunc
 		}
+		if name := fn.Name(); checkCgo(name) {
+			switch name {
+			case "_Cgo_ptr":
+			case "_cgo_cmalloc":
+				return nil, "malloc", cFunc
+			default:
+				if _, ok := p.link[orgName]; !ok { // eg. _cgo_runtime_gostring => runtime.gostring
+					return nil, orgName, ignoredFunc
+				}
+			}
+		}
 	}
 	if v, ok := p.link[orgName]; ok {
 		if strings.HasPrefix(v, "C.") {
@@ -446,9 +644,13 @@ func (p *context) funcName(fn *ssa.Function, ignore bool) (*types.Package, strin
 		if strings.HasPrefix(v, "llgo.") {
 			return nil, v[5:], llgoInstr
 		}
-		return pkg, v, goFunc
+		return pkg, replaceGoName(v, strings.IndexByte(v, '.')), goFunc
+	}
+	name := funcName(pkg, fn)
+	if isInstance(fn) {
+		name = p.instanceName(fn, name)
 	}
-	return pkg, funcName(pkg, fn), goFunc
+	return pkg, name, goFunc
 }
 
 const (
@@ -524,9 +726,10 @@ func ignoreName(name string) bool {
 		return true
 	}
 	*/
-	const internal = "internal/"
+	const internal, runtime, crypto = "internal/", "runtime/", "crypto/"
 	return (strings.HasPrefix(name, internal) && !supportedInternal(name[len(internal):])) ||
-		strings.HasPrefix(name, "crypto/") || strings.HasPrefix(name, "runtime/") ||
+		(strings.HasPrefix(name, runtime) && !supportedRuntime(name[len(runtime):])) ||
+		(strings.HasPrefix(name, crypto) && !supportedCrypto(name[len(crypto):])) ||
 		strings.HasPrefix(name, "arena.") || strings.HasPrefix(name, "maps.") ||
 		strings.HasPrefix(name, "plugin.")
 }
@@ -534,7 +737,24 @@ func ignoreName(name string) bool {
 func supportedInternal(name string) bool {
 	return strings.HasPrefix(name, "abi.") || strings.HasPrefix(name, "bytealg.") ||
 		strings.HasPrefix(name, "oserror.") || strings.HasPrefix(name, "reflectlite.") ||
-		strings.HasPrefix(name, "syscall/execenv.")
+		strings.HasPrefix(name, "syscall/execenv.") || strings.HasPrefix(name, "godebug.") ||
+		strings.HasPrefix(name, "byteorder.") || strings.HasPrefix(name, "chacha8rand.") ||
+		strings.HasPrefix(name, "sysinfo.")
+}
+
+// supportedRuntime reports whether a runtime/ package is compiled, from the
+// patch of llgo which replaces it (see hasAltPkg of package build).
+func supportedRuntime(name string) bool {
+	return strings.HasPrefix(name, "debug.") || strings.HasPrefix(name, "metrics.") ||
+		strings.HasPrefix(name, "pprof.") || strings.HasPrefix(name, "trace.")
+}
+
+// supportedCrypto reports whether a crypto/ package is compiled, like
+// supportedRuntime.
+func supportedCrypto(name string) bool {
+	return strings.HasPrefix(name, "aes.") || strings.HasPrefix(name, "cipher.") ||
+		strings.HasPrefix(name, "rand.") || strings.HasPrefix(name, "sha256.") ||
+		strings.HasPrefix(name, "subtle.")
 }
 
 // -----------------------------------------------------------------------------
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cl
+
+import (
+	"go/types"
+	"strconv"
+	"sync"
+
+	"golang.org/x/tools/go/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// An instantiation of a generic function or method doesn't belong to any
+// package: it's compiled into every package using it, as a linkonce_odr
+// function, and the linker keeps one of the copies. So all packages of a
+// program must agree on its name, and different instantiations must not share
+// a name, which happens when type arguments are function-local types of the
+// same name (eg. two `type T int` in different functions).
+//
+// instances is the registry of the instantiations of a program, shared by all
+// packages compiled from it. It maps the name of an instantiation (see
+// funcName) to its type arguments, and names other instantiations of the same
+// name with a #n suffix.
+type instances struct {
+	mu    sync.Mutex
+	names map[string][][]types.Type // name => type arguments of instantiations
+}
+
+var progInstances sync.Map // *ssa.Program => *instances
+
+func instancesOf(prog *ssa.Program) *instances {
+	if v, ok := progInstances.Load(prog); ok {
+		return v.(*instances)
+	}
+	v, _ := progInstances.LoadOrStore(prog, &instances{names: make(map[string][][]types.Type)})
+	return v.(*instances)
+}
+
+// isInstance reports whether fn is an instantiation of a generic function or
+// method, or an anonymous function in one.
+func isInstance(fn *ssa.Function) bool {
+	for ; fn != nil; fn = fn.Parent() {
+		if fn.Origin() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// instanceName returns the canonical name of the instantiation fn, whose name
+// by funcName is name.
+func (p *context) instanceName(fn *ssa.Function, name string) string {
+	for fn.Parent() != nil {
+		fn = fn.Parent()
+	}
+	targs := fn.TypeArgs()
+	r := instancesOf(p.goProg)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	insts := r.names[name]
+	for i, inst := range insts {
+		if identicalTypes(inst, targs) {
+			return instanceSuffix(name, i)
+		}
+	}
+	r.names[name] = append(insts, targs)
+	return instanceSuffix(name, len(insts))
+}
+
+func instanceSuffix(name string, i int) string {
+	if i == 0 {
+		return name
+	}
+	return name + "#" + strconv.Itoa(i)
+}
+
+func identicalTypes(a, b []types.Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, t := range a {
+		if !types.Identical(t, b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
@@ -20,6 +20,7 @@ import (
 	"go/constant"
 	"go/types"
 	"log"
+	"strings"
 
 	"golang.org/x/tools/go/ssa"
 
@@ -177,7 +178,7 @@ func (p *context) atomic(b llssa.Builder, op llssa.AtomicOp, args []ssa.Value) (
 func (p *context) atomicLoad(b llssa.Builder, args []ssa.Value) llssa.Expr {
 	if len(args) == 1 {
 		addr := p.compileValue(b, args[0])
-		return b.Load(addr).SetOrdering(llssa.OrderingSeqConsistent)
+		return b.AtomicLoad(addr)
 	}
 	panic("atomicLoad(addr *T) T: invalid arguments")
 }
@@ -186,12 +187,30 @@ func (p *context) atomicStore(b llssa.Builder, args []ssa.Value) {
 	if len(args) == 2 {
 		addr := p.compileValue(b, args[0])
 		val := p.compileValue(b, args[1])
-		b.Store(addr, val).SetOrdering(llssa.OrderingSeqConsistent)
+		b.AtomicStore(addr, val)
 		return
 	}
 	panic("atomicStore(addr *T, val T) T: invalid arguments")
 }
 
+func (p *context) volatileLoad(b llssa.Builder, args []ssa.Value) llssa.Expr {
+	if len(args) == 1 {
+		addr := p.compileValue(b, args[0])
+		return b.LoadVolatile(addr)
+	}
+	panic("volatileLoad(addr *T) T: invalid arguments")
+}
+
+func (p *context) volatileStore(b llssa.Builder, args []ssa.Value) {
+	if len(args) == 2 {
+		addr := p.compileValue(b, args[0])
+		val := p.compileValue(b, args[1])
+		b.StoreVolatile(addr, val)
+		return
+	}
+	panic("volatileStore(addr *T, val T): invalid arguments")
+}
+
 func (p *context) atomicCmpXchg(b llssa.Builder, args []ssa.Value) llssa.Expr {
 	if len(args) == 3 {
 		addr := p.compileValue(b, args[0])
@@ -222,6 +241,10 @@ var llgoInstrs = map[string]int{
 	"deferData":   llgoDeferData,
 	"unreachable": llgoUnreachable,
 
+	"volatileLoad":  llgoVolatileLoad,
+	"volatileStore": llgoVolatileStore,
+
+	"atomicFence":   llgoAtomicFence,
 	"atomicLoad":    llgoAtomicLoad,
 	"atomicStore":   llgoAtomicStore,
 	"atomicCmpXchg": llgoAtomicCmpXchg,
@@ -271,6 +294,57 @@ func (p *context) funcOf(fn *ssa.Function) (aFn llssa.Function, pyFn llssa.PyObj
 	return
 }
 
+// do calls b.Do, or pushes the deferred call on p.deferOn for a defer
+// statement with a defer stack (see compileInstr).
+func (p *context) do(b llssa.Builder, act llssa.DoAction, call *ssa.CallCommon, fn llssa.Expr, args ...llssa.Expr) llssa.Expr {
+	if stack := p.deferOn; !stack.IsNil() {
+		b.DeferOn(stack, fn, args...)
+		return llssa.Expr{}
+	}
+	if act == llssa.Call && p.glue && mayRecover(call) {
+		b.ForwardRecover(fn)
+	}
+	return b.Do(act, fn, args...)
+}
+
+// isGlue reports whether f is glue code generated for a method or a method
+// value, which recover sees through: a deferred call invoking it invokes the
+// function it calls (see llssa.Builder.ForwardRecover).
+func isGlue(f *ssa.Function) bool {
+	s := f.Synthetic
+	return strings.HasPrefix(s, "wrapper for ") || strings.HasPrefix(s, "thunk for ") ||
+		strings.HasPrefix(s, "bound method wrapper for ")
+}
+
+// usesRecover reports whether f calls recover, or defers it.
+func usesRecover(f *ssa.Function) bool {
+	for _, blk := range f.Blocks {
+		for _, instr := range blk.Instrs {
+			if call, ok := instr.(ssa.CallInstruction); ok {
+				if fn, ok := call.Common().Value.(*ssa.Builtin); ok && fn.Name() == "recover" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// mayRecover reports whether the function called by call may call recover.
+// Functions of other packages, which have no body here, may.
+func mayRecover(call *ssa.CallCommon) bool {
+	if call.IsInvoke() {
+		return true
+	}
+	switch fn := call.Value.(type) {
+	case *ssa.Builtin:
+		return false
+	case *ssa.Function:
+		return fn.Blocks == nil || isGlue(fn) || usesRecover(fn)
+	}
+	return true
+}
+
 // -----------------------------------------------------------------------------
 
 const (
@@ -309,13 +383,21 @@ func (p *context) pkgNoInit(pkg *types.Package) bool {
 
 // -----------------------------------------------------------------------------
 
-func (p *context) call(b llssa.Builder, act llssa.DoAction, call *ssa.CallCommon) (ret llssa.Expr) {
+func (p *context) call(b llssa.Builder, act llssa.DoAction, instr ssa.CallInstruction) (ret llssa.Expr) {
+	call := instr.Common()
 	cv := call.Value
+	if fn, recv := p.devirtualize(instr); fn != nil {
+		if aFn, _, ftype := p.compileFunction(fn); ftype == goFunc {
+			args := p.compileValues(b, append([]ssa.Value{recv}, call.Args...), p.funcKind(fn))
+			ret = p.do(b, act, call, aFn.Expr, args...)
+			return
+		}
+	}
 	if mthd := call.Method; mthd != nil {
 		o := p.compileValue(b, cv)
 		fn := b.Imethod(o, mthd)
 		args := p.compileValues(b, call.Args, fnNormal)
-		ret = b.Do(act, fn, args...)
+		ret = p.do(b, act, call, fn, args...)
 		return
 	}
 	kind := p.funcKind(cv)
@@ -329,12 +411,16 @@ func (p *context) call(b llssa.Builder, act llssa.DoAction, call *ssa.CallCommon
 	switch cv := cv.(type) {
 	case *ssa.Builtin:
 		fn := cv.Name()
-		if fn == "ssa:wrapnilchk" { // TODO(xsw): check nil ptr
-			arg := args[0]
-			ret = p.compileValue(b, arg)
+		if fn == "ssa:wrapnilchk" {
+			x := p.compileValue(b, args[0])
+			typ := constant.StringVal(args[1].(*ssa.Const).Value)
+			method := constant.StringVal(args[2].(*ssa.Const).Value)
+			ret = b.WrapNilCheck(x, typ, method)
+		} else if fn == "ssa:deferstack" {
+			ret = b.DeferStack()
 		} else {
 			args := p.compileValues(b, args, kind)
-			ret = b.Do(act, llssa.Builtin(fn), args...)
+			ret = p.do(b, act, call, llssa.Builtin(fn), args...)
 		}
 	case *ssa.Function:
 		aFn, pyFn, ftype := p.compileFunction(cv)
@@ -344,13 +430,13 @@ func (p *context) call(b llssa.Builder, act llssa.DoAction, call *ssa.CallCommon
 			p.inCFunc = true
 			args := p.compileValues(b, args, kind)
 			p.inCFunc = false
-			ret = b.Do(act, aFn.Expr, args...)
+			ret = p.do(b, act, call, aFn.Expr, args...)
 		case goFunc:
 			args := p.compileValues(b, args, kind)
-			ret = b.Do(act, aFn.Expr, args...)
+			ret = p.do(b, act, call, aFn.Expr, args...)
 		case pyFunc:
 			args := p.compileValues(b, args, kind)
-			ret = b.Do(act, pyFn.Expr, args...)
+			ret = p.do(b, act, call, pyFn.Expr, args...)
 		case llgoPyList:
 			args := p.compileValues(b, args, fnHasVArg)
 			ret = b.PyList(args...)
@@ -372,6 +458,12 @@ func (p *context) call(b llssa.Builder, act llssa.DoAction, call *ssa.CallCommon
 			ret = p.string(b, args)
 		case llgoStringData:
 			ret = p.stringData(b, args)
+		case llgoVolatileLoad:
+			ret = p.volatileLoad(b, args)
+		case llgoVolatileStore:
+			p.volatileStore(b, args)
+		case llgoAtomicFence: // func atomicFence()
+			b.Fence(llssa.OrderingSeqConsistent, false)
 		case llgoAtomicLoad:
 			ret = p.atomicLoad(b, args)
 		case llgoAtomicStore:
@@ -400,7 +492,7 @@ func (p *context) call(b llssa.Builder, act llssa.DoAction, call *ssa.CallCommon
 	default:
 		fn := p.compileValue(b, cv)
 		args := p.compileValues(b, args, kind)
-		ret = b.Do(act, fn, args...)
+		ret = p.do(b, act, call, fn, args...)
 	}
 	return
 }
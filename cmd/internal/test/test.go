@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package test implements the "llgo test" command.
+package test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goplus/llgo/cmd/internal/base"
+	"github.com/goplus/llgo/internal/build"
+)
+
+// llgo test
+var Cmd = &base.Command{
+	UsageLine: "llgo test [build/test flags] [packages] [-args ...]",
+	Short:     "Test packages like go test, or run the conformance suite with -conformance [dir]",
+}
+
+func init() {
+	Cmd.Run = runCmd
+}
+
+// testFlags are the flags of go test passed to test binaries, as -test.name,
+// and whether they take an argument.
+var testFlags = map[string]bool{
+	"bench":                true,
+	"benchmem":             false,
+	"benchtime":            true,
+	"blockprofile":         true,
+	"blockprofilerate":     true,
+	"count":                true,
+	"coverprofile":         true,
+	"cpu":                  true,
+	"cpuprofile":           true,
+	"failfast":             false,
+	"fullpath":             false,
+	"fuzz":                 true,
+	"fuzzminimizetime":     true,
+	"fuzztime":             true,
+	"list":                 true,
+	"memprofile":           true,
+	"memprofilerate":       true,
+	"mutexprofile":         true,
+	"mutexprofilefraction": true,
+	"outputdir":            true,
+	"parallel":             true,
+	"run":                  true,
+	"short":                false,
+	"shuffle":              true,
+	"skip":                 true,
+	"timeout":              true,
+	"trace":                true,
+	"v":                    false,
+}
+
+// profileFlags are the test flags naming output files, which are relative to
+// -outputdir, the current directory by default like in go test. The coverage
+// profile, which gathers those of all the packages tested, is written by
+// llgo test (see build.ModeTest).
+var profileFlags = map[string]bool{
+	"blockprofile": true, "cpuprofile": true, "memprofile": true,
+	"mutexprofile": true, "trace": true,
+}
+
+func runCmd(cmd *base.Command, args []string) {
+	for _, arg := range args {
+		if arg == "-conformance" || arg == "--conformance" {
+			runConformance(args)
+			return
+		}
+	}
+	flags, runArgs, err := parseTestArgs(args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "llgo test:", err)
+		os.Exit(2)
+	}
+	conf := build.NewDefaultConf(build.ModeTest)
+	conf.RunArgs = runArgs
+	build.Do(flags, conf)
+}
+
+// parseTestArgs splits args into the build flags and packages, and the
+// arguments of test binaries: test flags as -test.name, and the arguments
+// after -args.
+func parseTestArgs(args []string) (flags, runArgs []string, err error) {
+	var pkgs []string
+	var coverprofile, outputdir string
+	timeout, profile, fuzz := false, false, false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "-args" || arg == "--args" {
+			runArgs = append(runArgs, args[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			pkgs = append(pkgs, arg)
+			continue
+		}
+		name, val, hasVal := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if hasarg, ok := testFlags[name]; ok {
+			if hasarg && !hasVal {
+				if i++; i >= len(args) {
+					return nil, nil, errors.New("flag needs an argument: " + arg)
+				}
+				val, hasVal = args[i], true
+			}
+			timeout = timeout || name == "timeout"
+			profile = profile || profileFlags[name]
+			switch name {
+			case "coverprofile": // sets -cover
+				coverprofile = val
+				continue
+			case "outputdir":
+				outputdir = val
+			case "fuzz": // builds for libFuzzer
+				fuzz = val != ""
+			}
+			if hasVal {
+				runArgs = append(runArgs, "-test."+name+"="+val)
+			} else {
+				runArgs = append(runArgs, "-test."+name)
+			}
+			continue
+		}
+		hasarg, ok := build.FlagHasArg("-" + name)
+		if !ok {
+			return nil, nil, errors.New("unknown flag: " + arg)
+		}
+		flags = append(flags, "-"+strings.TrimLeft(arg, "-"))
+		if hasarg && !hasVal {
+			if i++; i >= len(args) {
+				return nil, nil, errors.New("flag needs an argument: " + arg)
+			}
+			flags = append(flags, args[i])
+		}
+	}
+	if fuzz {
+		flags = append(flags, "-fuzz")
+	} else if !timeout { // fuzzing runs until -fuzztime, without a default timeout
+		runArgs = append(runArgs, "-test.timeout=10m0s")
+	}
+	if (profile || coverprofile != "") && outputdir == "" {
+		// test binaries run in the directories of their packages
+		dir, err := os.Getwd()
+		if err != nil {
+			return nil, nil, err
+		}
+		if profile {
+			runArgs = append(runArgs, "-test.outputdir="+dir)
+		}
+		outputdir = dir
+	}
+	if coverprofile != "" {
+		if !filepath.IsAbs(coverprofile) {
+			coverprofile = filepath.Join(outputdir, coverprofile)
+		}
+		flags = append(flags, "-cover")
+		runArgs = append(runArgs, "-test.coverprofile="+coverprofile)
+	}
+	return append(flags, pkgs...), runArgs, nil
+}
+
+// runConformance compiles each program in the conformance directories of
+// args (default _conformance) by llgo and go, and compares their results.
+func runConformance(args []string) {
+	flags := make([]string, 0, len(args))
+	dirs := make([]string, 0, 1)
+	for _, arg := range args {
+		switch {
+		case arg == "-conformance" || arg == "--conformance":
+		case len(arg) > 0 && arg[0] == '-':
+			flags = append(flags, arg)
+		default:
+			dirs = append(dirs, arg)
+		}
+	}
+	if len(dirs) == 0 {
+		dirs = append(dirs, "_conformance")
+	}
+
+	var total, failed int
+	for _, dir := range dirs {
+		cases, err := os.ReadDir(dir)
+		check(err)
+		for _, c := range cases {
+			name := c.Name()
+			if !c.IsDir() || name[0] == '_' || name[0] == '.' {
+				continue
+			}
+			total++
+			pkg := "./" + filepath.ToSlash(filepath.Join(dir, name))
+			if runCase(pkg, flags) {
+				fmt.Println("ok  ", pkg)
+			} else {
+				fmt.Println("FAIL", pkg)
+				failed++
+			}
+		}
+	}
+	fmt.Printf("%d/%d passed\n", total-failed, total)
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// runCase compiles pkg by llgo and go, runs them and compares their
+// stdout/stderr/exitcode. It reports whether they are the same.
+func runCase(pkg string, flags []string) (ok bool) {
+	defer func() {
+		if e := recover(); e != nil {
+			fmt.Fprintln(os.Stderr, e)
+			ok = false
+		}
+	}()
+	args := append(flags[:len(flags):len(flags)], pkg)
+	conf := build.NewDefaultConf(build.ModeCmpTest)
+	build.Do(args, conf)
+	return true
+}
+
+func check(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
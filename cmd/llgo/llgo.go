@@ -30,6 +30,7 @@ import (
 	"github.com/goplus/llgo/cmd/internal/help"
 	"github.com/goplus/llgo/cmd/internal/install"
 	"github.com/goplus/llgo/cmd/internal/run"
+	"github.com/goplus/llgo/cmd/internal/test"
 	"github.com/goplus/llgo/cmd/internal/version"
 )
 
@@ -45,6 +46,7 @@ func init() {
 		install.Cmd,
 		run.Cmd,
 		run.CmpTestCmd,
+		test.Cmd,
 		clean.Cmd,
 		version.Cmd,
 	}
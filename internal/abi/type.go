@@ -252,6 +252,20 @@ type FuncType struct {
 	Type
 	In  []*Type
 	Out []*Type
+
+	// Call and MakeFunc are the thunks reflect calls and makes functions of
+	// this type through (nil if the type isn't the type of a func value):
+	//
+	//	func Call(ctx, fn, args, results unsafe.Pointer)
+	//	func MakeFunc(ctx unsafe.Pointer, params...) (results...)
+	//
+	// Call calls the closure at fn, loading its arguments from the frame args
+	// and storing its results into the frame results. MakeFunc is the function
+	// of closures whose context points to a func(args, results unsafe.Pointer)
+	// value: it stores its parameters into the frame args and calls it. The
+	// frames lay out the parameters and the results like struct fields.
+	Call     unsafe.Pointer
+	MakeFunc unsafe.Pointer
 }
 
 // Variadic reports whether the function type is variadic.
@@ -554,7 +568,7 @@ func addChecked(p unsafe.Pointer, x uintptr, whySafe string) unsafe.Pointer {
 func splitName(s string) (pkg string, name string) {
 	i := lastDot(s)
 	if i == -1 {
-		return s, ""
+		return "", s
 	}
 	return s[:i], s[i+1:]
 }
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+
+// defaultTags are the build tags llgo always sets. llgo can't assemble the
+// Plan 9 assembly files of packages, and by convention these tags select
+// their pure Go implementations instead, like math_big_pure_go for math/big.
+var defaultTags = []string{"purego", "noasm", "math_big_pure_go"}
+
+// addBuildTags adds tags to the -tags flag of flags, or adds a -tags flag.
+func addBuildTags(flags []string, tags ...string) []string {
+	ret := make([]string, 0, len(flags)+2)
+	found := false
+	for i := 0; i < len(flags); i++ {
+		flag := flags[i]
+		name, val, hasVal := strings.Cut(flag, "=")
+		if name != "-tags" && name != "--tags" {
+			ret = append(ret, flag)
+			continue
+		}
+		if !hasVal && i+1 < len(flags) {
+			i++
+			val = flags[i]
+		}
+		found = true
+		ret = append(ret, name+"="+joinTags(val, tags))
+	}
+	if !found {
+		ret = append(ret, "-tags="+strings.Join(tags, ","))
+	}
+	return ret
+}
+
+// joinTags appends tags to the tag list val, separated by commas or spaces.
+func joinTags(val string, tags []string) string {
+	list := strings.FieldsFunc(val, func(c rune) bool {
+		return c == ',' || c == ' '
+	})
+	return strings.Join(append(list, tags...), ",")
+}
+
+// asmFuncs returns the functions the assembly files among files implement,
+// from their TEXT directives: "add" for TEXT ·add(SB), NOSPLIT, $0-24. Static
+// symbols like ·add<>(SB) have no Go declaration and are skipped.
+func asmFuncs(files []string) (names []string) {
+	for _, file := range files {
+		if filepath.Ext(file) != ".s" {
+			continue
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			continue
+		}
+		scan := bufio.NewScanner(f)
+		for scan.Scan() {
+			line := strings.TrimSpace(scan.Text())
+			sym, ok := strings.CutPrefix(line, "TEXT")
+			if !ok {
+				continue
+			}
+			sym, _, ok = strings.Cut(strings.TrimSpace(sym), "(SB)")
+			if !ok || strings.HasSuffix(sym, "<>") {
+				continue
+			}
+			if pos := strings.LastIndex(sym, "·"); pos >= 0 {
+				names = append(names, sym[pos+len("·"):])
+			}
+		}
+		f.Close()
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
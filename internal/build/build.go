@@ -18,6 +18,7 @@ package build
 
 import (
 	"fmt"
+	"go/ast"
 	"go/constant"
 	"go/token"
 	"go/types"
@@ -50,6 +51,7 @@ const (
 	ModeInstall
 	ModeRun
 	ModeCmpTest
+	ModeTest
 )
 
 const (
@@ -62,10 +64,59 @@ func needLLFile(mode Mode) bool {
 
 type Config struct {
 	BinPath string
-	AppExt  string   // ".exe" on Windows, empty on Unix
-	OutFile string   // only valid for ModeBuild when len(pkgs) == 1
-	RunArgs []string // only valid for ModeRun
+	AppExt  string          // ".exe" on Windows, empty on Unix
+	OutFile string          // only valid for ModeBuild when len(pkgs) == 1
+	RunArgs []string        // only valid for ModeRun and ModeTest
+	RtFiles []string        // link files of an alternative runtime (see llssa.RuntimeHooks)
+	LTO     LTO             // link with LTO, so functions are inlined and dropped across Go packages and C files
+	Harden  llssa.Hardening // security hardening options of generated code
+	Opt     llssa.OptLevel  // optimization level of generated code, -O0 by default, -O2 with LTO (see llssa.Package.Optimize)
 	Mode    Mode
+
+	BuildMode BuildMode // kind of the output of main packages: exe (default), c-archive or c-shared
+	NoDevirt  bool      // don't devirtualize interface method calls (see cl.EnableDevirt)
+	KeepMeths bool      // keep methods never called, through interfaces or reflection (see cl.LiveMethods)
+	Race      bool      // detect data races with ThreadSanitizer (see llssa.RaceDetector)
+	NoLines   bool      // don't emit line tables: stack traces have no file:line (see llssa.Program.SetLineTables)
+
+	Sanitizers llssa.Sanitizers // LLVM sanitizers instrumenting generated code (-asan, -msan)
+
+	Cover    llssa.CoverMode // count the runs of the blocks of covered packages, only valid for ModeTest (see cl.SetCoverage)
+	CoverPkg []string        // patterns of the covered packages, the package tested by default
+
+	Fuzz bool // instrument with SanitizerCoverage and link libFuzzer, only valid for ModeTest (see llgo test -fuzz)
+
+	PGO string // pprof CPU profile for profile-guided optimization, "auto" (default.pgo of the main package, by default) or "off"
+
+	Emit Emit // write the code of the packages compiled to the directory OutFile instead of linking, only valid for ModeBuild
+}
+
+// LTO specifies the link time optimization of main packages, like the -flto
+// option of clang. The modules of the Go packages and of the C files of
+// LLGoFiles are written as LLVM bitcode, which lld optimizes together when
+// linking: functions are inlined across packages and between Go and C, and
+// those unreachable from main are dropped. ThinLTO optimizes the modules in
+// parallel, from summaries of the others, full LTO merges them into one.
+// C archives are linked without LTO.
+type LTO string
+
+const (
+	LTONone LTO = ""     // no LTO (default)
+	LTOThin LTO = "thin" // ThinLTO
+	LTOFull LTO = "full" // full LTO
+)
+
+func parseLTO(val string) (LTO, error) {
+	switch m := LTO(val); m {
+	case LTONone, LTOThin, LTOFull:
+		return m, nil
+	}
+	return LTONone, fmt.Errorf("unsupported LTO mode: %s", val)
+}
+
+// flag returns the option of clang for m.
+func (m LTO) flag() string {
+	return "-flto=" + string(m)
 }
 
 func NewDefaultConf(mode Mode) *Config {
@@ -116,10 +167,30 @@ const (
 )
 
 func Do(args []string, conf *Config) {
+	args = parseLLGoFlags(args, conf)
+	if conf.Cover != llssa.CoverNone && conf.Mode != ModeTest {
+		panic("-cover is only supported by llgo test")
+	}
+	if conf.Fuzz && conf.Mode != ModeTest {
+		panic("-fuzz is only supported by llgo test")
+	}
+	if conf.Emit != EmitNone && conf.Mode != ModeBuild {
+		panic("-emit-llvm, -S and -framelayout are only supported by llgo build")
+	}
 	flags, patterns, verbose := ParseArgs(args, buildFlags)
+	tags := defaultTags[:len(defaultTags):len(defaultTags)]
+	if conf.Race {
+		tags = append(tags, "race")
+	}
+	if conf.Sanitizers&llssa.SanitizeAddress != 0 {
+		tags = append(tags, "asan")
+	}
+	if conf.Sanitizers&llssa.SanitizeMemory != 0 {
+		tags = append(tags, "msan")
+	}
 	cfg := &packages.Config{
 		Mode:       loadSyntax | packages.NeedDeps | packages.NeedModule | packages.NeedExportFile,
-		BuildFlags: flags,
+		BuildFlags: addBuildTags(flags, tags...),
 		Fset:       token.NewFileSet(),
 	}
 
@@ -131,21 +202,54 @@ func Do(args []string, conf *Config) {
 		}
 	}
 
+	if patterns == nil {
+		patterns = []string{"."}
+	}
+	if conf.Mode == ModeTest {
+		cfg.Tests = true
+	}
+
 	llssa.Initialize(llssa.InitAll)
 
-	prog := llssa.NewProgram(nil)
+	env := llvm.New("")
+	os.Setenv("PATH", env.BinDir()+":"+os.Getenv("PATH")) // TODO(xsw): check windows
+
+	var nErr int
+	if conf.Mode == ModeTest {
+		nErr = doTest(env, cfg, patterns, conf, verbose)
+	} else {
+		nErr = do(env, cfg, patterns, conf, verbose)
+	}
+	if nErr > 0 {
+		os.Exit(nErr)
+	}
+}
+
+// do builds the packages of patterns, and links, installs or runs their
+// main packages, depending on conf.Mode. It returns the number of main
+// packages failing to link, or to pass their tests.
+func do(env *llvm.Env, cfg *packages.Config, patterns []string, conf *Config, verbose bool) int {
+	prog := newProgram(conf)
+	cl.EnableDevirt(!conf.NoDevirt)
 	sizes := prog.TypeSizes
 	dedup := packages.NewDeduper()
 
-	if patterns == nil {
-		patterns = []string{"."}
-	}
 	initial, err := packages.LoadEx(dedup, sizes, cfg, patterns...)
 	check(err)
 
 	mode := conf.Mode
+	if conf.BuildMode.isLibrary() && (mode == ModeRun || mode == ModeCmpTest || mode == ModeTest) {
+		fmt.Fprintf(os.Stderr, "cannot run a -buildmode=%s library\n", conf.BuildMode)
+		return 0
+	}
+	if mode == ModeTest {
+		if initial = testMainPkgs(initial); initial == nil {
+			fmt.Printf("?   \t%s\t[no test files]\n", patterns[0])
+			return 0
+		}
+	}
 	if len(initial) == 1 && len(initial[0].CompiledGoFiles) > 0 {
-		if mode == ModeBuild {
+		if mode == ModeBuild && conf.Emit == EmitNone {
 			mode = ModeInstall
 		}
 	} else if mode == ModeRun {
@@ -154,51 +258,93 @@ func Do(args []string, conf *Config) {
 		} else {
 			fmt.Fprintln(os.Stderr, "no Go files in matched packages")
 		}
-		return
+		return 0
 	}
 
 	altPkgPaths := altPkgs(initial, llssa.PkgRuntime)
 	altPkgs, err := packages.LoadEx(dedup, sizes, cfg, altPkgPaths...)
 	check(err)
 
-	noRt := 1
-	prog.SetRuntime(func() *types.Package {
-		noRt = 0
-		return altPkgs[0].Types
-	})
-	prog.SetPython(func() *types.Package {
-		return dedup.Check(llssa.PkgPython).Types
-	})
-
 	progSSA := ssa.NewProgram(initial[0].Fset, ssaBuildMode)
 	patches := make(cl.Patches, len(altPkgPaths))
 	altSSAPkgs(progSSA, patches, altPkgs[1:], verbose)
 
-	env := llvm.New("")
-	os.Setenv("PATH", env.BinDir()+":"+os.Getenv("PATH")) // TODO(xsw): check windows
-
-	ctx := &context{env, progSSA, prog, dedup, patches, make(map[string]none), initial, mode, 0}
+	ctx := &context{env, progSSA, prog, dedup, patches, make(map[string]none), initial, mode, 0, conf.LTO, conf.Opt, conf.Emit, nil, nil}
+	var live func(recv types.Type, id string) bool
+	var dead []string
+	if !conf.KeepMeths && mode != ModeBuild {
+		live, dead = liveMethods(ctx, altPkgs, conf.BuildMode.isLibrary(), verbose)
+	}
+	profile, cleanup := pgoProfile(conf, initial, verbose)
+	defer cleanup()
+	ctx.cache = newBuildCache(conf, cfg, altPkgs, dead, profile, verbose)
+	ctx.workers = make([]*worker, numWorkers(cfg.BuildFlags, verbose))
+	for i := range ctx.workers {
+		prog := prog
+		if i > 0 {
+			prog = newProgram(conf)
+		}
+		prog.SetRuntime(func() *types.Package {
+			return altPkgs[0].Types
+		})
+		prog.SetPython(func() *types.Package {
+			return dedup.Check(llssa.PkgPython).Types
+		})
+		if live != nil {
+			prog.SetLiveMethod(live)
+		}
+		if profile != "" {
+			prog.SetSampleProfile(profile)
+		}
+		ctx.workers[i] = newWorker(prog)
+	}
 	pkgs := buildAllPkgs(ctx, initial, verbose)
 
+	noRt := 1
+	for _, pkg := range pkgs {
+		if pkg.NeedRuntime {
+			noRt = 0
+			break
+		}
+	}
 	var llFiles []string
 	dpkg := buildAllPkgs(ctx, altPkgs[noRt:], verbose)
 	for _, pkg := range dpkg {
 		if !strings.HasSuffix(pkg.ExportFile, ".ll") {
 			continue
 		}
+		if conf.RtFiles != nil && pkg.PkgPath == llssa.PkgRuntime {
+			continue // replaced by an alternative runtime
+		}
 		llFiles = append(llFiles, pkg.ExportFile)
 	}
+	llFiles = append(llFiles, conf.RtFiles...)
+	if conf.Emit != EmitNone {
+		emitPkgs(ctx, append(pkgs, dpkg...), conf, verbose)
+		return 0
+	}
+	nErr := 0
 	if mode != ModeBuild {
-		nErr := 0
 		for _, pkg := range initial {
 			if pkg.Name == "main" {
 				nErr += linkMainPkg(ctx, pkg, pkgs, llFiles, conf, mode, verbose)
 			}
 		}
-		if nErr > 0 {
-			os.Exit(nErr)
-		}
 	}
+	return nErr
+}
+
+// newProgram creates a program compiling packages with the options of conf.
+func newProgram(conf *Config) llssa.Program {
+	prog := llssa.NewProgram(nil)
+	prog.SetHardening(conf.Harden)
+	prog.SetLineTables(!conf.NoLines)
+	prog.SetNoscanAlloc(true) // bdwgc doesn't scan atomic objects
+	if conf.Race {
+		prog.SetInstrumenter(llssa.RaceDetector)
+	}
+	prog.SetSanitizers(conf.Sanitizers)
+	return prog
 }
 
 func setNeedRuntimeOrPyInit(pkg *packages.Package, needRuntime, needPyInit bool) {
@@ -233,10 +379,14 @@ type context struct {
 	initial []*packages.Package
 	mode    Mode
 	nLibdir int
+	lto     LTO
+	opt     llssa.OptLevel
+	emit    Emit
+	cache   *buildCache
+	workers []*worker // workers[0] compiles with prog
 }
 
 func buildAllPkgs(ctx *context, initial []*packages.Package, verbose bool) (pkgs []*aPackage) {
-	prog := ctx.prog
 	pkgs, errPkgs := allPkgs(ctx, initial, verbose)
 	for _, errPkg := range errPkgs {
 		for _, err := range errPkg.Errors {
@@ -245,6 +395,7 @@ func buildAllPkgs(ctx *context, initial []*packages.Package, verbose bool) (pkgs
 		fmt.Fprintln(os.Stderr, "cannot build SSA for package", errPkg)
 	}
 	built := ctx.built
+	n := 0
 	for _, aPkg := range pkgs {
 		pkg := aPkg.Package
 		if _, ok := built[pkg.PkgPath]; ok {
@@ -252,15 +403,20 @@ func buildAllPkgs(ctx *context, initial []*packages.Package, verbose bool) (pkgs
 			continue
 		}
 		built[pkg.PkgPath] = none{}
+		pkgs[n] = aPkg
+		n++
+	}
+	pkgs = pkgs[:n]
+	compilePkgs(ctx, pkgs, verbose)
+	for _, aPkg := range pkgs {
+		pkg := aPkg.Package
 		switch kind, param := cl.PkgKindOf(pkg.Types); kind {
 		case cl.PkgDeclOnly:
 			// skip packages that only contain declarations
 			// and set no export file
-			cl.ParsePkgSyntax(ctx.prog, pkg.Types, pkg.Syntax)
 			pkg.ExportFile = ""
 		case cl.PkgLinkIR, cl.PkgLinkExtern, cl.PkgPyModule:
 			if len(pkg.GoFiles) > 0 {
-				buildPkg(ctx, aPkg, verbose)
 				pkg.ExportFile = " " + concatPkgLinkFiles(ctx, pkg, verbose) + " " + pkg.ExportFile
 			} else {
 				// panic("todo")
@@ -311,8 +467,10 @@ func buildAllPkgs(ctx *context, initial []*packages.Package, verbose bool) (pkgs
 				}
 			}
 		default:
-			buildPkg(ctx, aPkg, verbose)
-			setNeedRuntimeOrPyInit(pkg, prog.NeedRuntime, prog.NeedPyInit)
+			if pkg.ExportFile != "" && isCgoPkg(pkg) {
+				pkg.ExportFile = " " + concatPkgLinkFiles(ctx, pkg, verbose) + " " + pkg.ExportFile
+			}
+			setNeedRuntimeOrPyInit(pkg, aPkg.NeedRuntime, aPkg.NeedPyInit)
 		}
 	}
 	return
@@ -322,8 +480,13 @@ func linkMainPkg(ctx *context, pkg *packages.Package, pkgs []*aPackage, llFiles
 	pkgPath := pkg.PkgPath
 	name := path.Base(pkgPath)
 	app := conf.OutFile
-	if app == "" {
-		app = filepath.Join(conf.BinPath, name+conf.AppExt)
+	if mode == ModeTest {
+		dir, err := os.MkdirTemp("", "llgo-test")
+		check(err)
+		defer os.RemoveAll(dir)
+		app = filepath.Join(dir, name+conf.AppExt)
+	} else if app == "" {
+		app = filepath.Join(conf.BinPath, name+conf.BuildMode.outExt(conf.AppExt))
 	}
 	args := make([]string, 0, len(pkg.Imports)+len(llFiles)+16)
 	args = append(
@@ -331,8 +494,22 @@ func linkMainPkg(ctx *context, pkg *packages.Package, pkgs []*aPackage, llFiles
 		"-o", app,
 		"-fuse-ld=lld",
 		"-Wno-override-module",
-		// "-O2", // FIXME: This will cause TestFinalizer in _test/bdwgc.go to fail on macOS.
+		conf.Opt.String(), // -O0 by default. FIXME: -O2 causes TestFinalizer in _test/bdwgc.go to fail on macOS.
 	)
+	if conf.LTO != LTONone && conf.BuildMode != BuildModeCArchive {
+		args = append(args, conf.LTO.flag())
+	}
+	if conf.BuildMode == BuildModeCShared {
+		args = append(args, "-shared", "-fPIC")
+	}
+	if conf.Race {
+		args = append(args, "-fsanitize=thread") // links the ThreadSanitizer runtime
+	}
+	// runs the passes of the sanitizers over the modules, and links their runtimes
+	args = append(args, sanitizeFlags(conf.Sanitizers)...)
+	if conf.Fuzz {
+		args = append(args, fuzzFlags(ctx.env)...)
+	}
 	switch runtime.GOOS {
 	case "darwin": // ld64.lld (macOS)
 		args = append(
@@ -341,6 +518,9 @@ func linkMainPkg(ctx *context, pkg *packages.Package, pkgs []*aPackage, llFiles
 			"-rpath", "@loader_path/../lib",
 			"-Xlinker", "-dead_strip",
 		)
+		if mode == ModeTest && !conf.Fuzz { // weakly referenced by testdeps
+			args = append(args, "-Xlinker", "-U", "-Xlinker", "_LLVMFuzzerRunDriver")
+		}
 	case "windows": // lld-link (Windows)
 		// TODO: Add options for Windows.
 	default: // ld.lld (Unix), wasm-ld (WebAssembly)
@@ -348,10 +528,12 @@ func linkMainPkg(ctx *context, pkg *packages.Package, pkgs []*aPackage, llFiles
 			args,
 			"-rpath", "$ORIGIN",
 			"-rpath", "$ORIGIN/../lib",
+			"-ffunction-sections", "-fdata-sections",
 			"-Xlinker", "--gc-sections",
 			"-lpthread", // libpthread is built-in since glibc 2.34 (2021-08-01); we need to support earlier versions.
 		)
 	}
+	nflags := len(args)
 	needRuntime := false
 	needPyInit := false
 	packages.Visit([]*packages.Package{pkg}, nil, func(p *packages.Package) {
@@ -388,21 +570,37 @@ func linkMainPkg(ctx *context, pkg *packages.Package, pkgs []*aPackage, llFiles
 	if needPyInit {
 		dirty = aPkg.LPkg.PyInit()
 	}
+	if conf.BuildMode.isLibrary() {
+		cl.InitLibrary(aPkg.LPkg)
+		dirty = true
+	}
 
 	if dirty && needLLFile(mode) {
 		lpkg := aPkg.LPkg
 		os.WriteFile(pkg.ExportFile, []byte(lpkg.String()), 0644)
 	}
 
-	if verbose || mode != ModeRun {
+	if verbose || (mode != ModeRun && mode != ModeTest) {
 		fmt.Fprintln(os.Stderr, "#", pkgPath)
 	}
 	defer func() {
 		if e := recover(); e != nil {
 			nErr = 1
+			if mode == ModeTest {
+				fmt.Printf("FAIL\t%s [build failed]\n", strings.TrimSuffix(pkgPath, ".test"))
+			}
 		}
 	}()
 
+	if conf.BuildMode.isLibrary() {
+		header := strings.TrimSuffix(app, filepath.Ext(app)) + ".h"
+		writeExportHeader(header, pkg, ctx.prog.PointerSize())
+	}
+	if conf.BuildMode == BuildModeCArchive {
+		buildArchive(ctx, app, args[nflags:], verbose)
+		return
+	}
+
 	// add rpath
 	exargs := make([]string, 0, ctx.nLibdir<<1)
 	for _, arg := range args {
@@ -431,11 +629,14 @@ func linkMainPkg(ctx *context, pkg *packages.Package, pkgs []*aPackage, llFiles
 		}
 	case ModeCmpTest:
 		cmpTest("", pkgPath, app, conf.RunArgs)
+	case ModeTest:
+		nErr = runTest(pkg, app, conf.RunArgs)
 	}
 	return
 }
 
-func buildPkg(ctx *context, aPkg *aPackage, verbose bool) {
+// buildPkg compiles aPkg with prog, or loads it from the build cache.
+func buildPkg(ctx *context, prog llssa.Program, aPkg *aPackage, verbose bool) {
 	pkg := aPkg.Package
 	pkgPath := pkg.PkgPath
 	if debugBuild || verbose {
@@ -445,6 +646,22 @@ func buildPkg(ctx *context, aPkg *aPackage, verbose bool) {
 		pkg.ExportFile = ""
 		return
 	}
+	needLL := needLLFile(ctx.mode) || ctx.emit != EmitNone
+	key := ctx.cache.key(aPkg)
+	if key != "" {
+		if ir, needRuntime, ok := ctx.cache.load(key); ok {
+			if needLL {
+				pkg.ExportFile += ".ll"
+				os.WriteFile(pkg.ExportFile, ir, 0644)
+				aPkg.LLFile = pkg.ExportFile
+			}
+			if debugBuild || verbose {
+				fmt.Fprintf(os.Stderr, "==> Cached %s: %s\n", pkgPath, ctx.cache.file(key))
+			}
+			aPkg.NeedRuntime = needRuntime
+			return
+		}
+	}
 	var syntax = pkg.Syntax
 	if altPkg := aPkg.AltPkg; altPkg != nil {
 		syntax = append(syntax, altPkg.Syntax...)
@@ -455,15 +672,27 @@ func buildPkg(ctx *context, aPkg *aPackage, verbose bool) {
 		cl.SetDebug(cl.DbgFlagAll)
 	}
 
-	ret, err := cl.NewPackageEx(ctx.prog, ctx.patches, aPkg.SSA, syntax)
+	ret, err := cl.NewPackageEx(prog, ctx.patches, aPkg.SSA, syntax)
 	if showDetail {
 		llssa.SetDebug(0)
 		cl.SetDebug(0)
 	}
 	check(err)
-	if needLLFile(ctx.mode) {
+	cl.StubAsmFuncs(ret, asmFuncs(pkg.OtherFiles))
+	check(ret.Optimize(ctx.opt))
+	aPkg.NeedRuntime, aPkg.NeedPyInit = prog.NeedRuntime, prog.NeedPyInit
+	var ir string
+	if key != "" && !aPkg.NeedPyInit { // Python modules aren't cached
+		ir = ret.String()
+		ctx.cache.store(key, ir, aPkg.NeedRuntime)
+	}
+	if needLL {
+		if ir == "" {
+			ir = ret.String()
+		}
 		pkg.ExportFile += ".ll"
-		os.WriteFile(pkg.ExportFile, []byte(ret.String()), 0644)
+		os.WriteFile(pkg.ExportFile, []byte(ir), 0644)
+		aPkg.LLFile = pkg.ExportFile
 		if debugBuild || verbose {
 			fmt.Fprintf(os.Stderr, "==> Export %s: %s\n", aPkg.PkgPath, pkg.ExportFile)
 		}
@@ -510,6 +739,10 @@ type aPackage struct {
 	SSA    *ssa.Package
 	AltPkg *packages.Cached
 	LPkg   llssa.Package
+	LLFile string // LLVM IR file written by buildPkg, if any
+
+	NeedRuntime bool // set by buildPkg
+	NeedPyInit  bool
 }
 
 func allPkgs(ctx *context, initial []*packages.Package, verbose bool) (all []*aPackage, errs []*packages.Package) {
@@ -528,7 +761,7 @@ func allPkgs(ctx *context, initial []*packages.Package, verbose bool) (all []*aP
 					return
 				}
 			}
-			all = append(all, &aPackage{p, ssaPkg, altPkg, nil})
+			all = append(all, &aPackage{Package: p, SSA: ssaPkg, AltPkg: altPkg})
 		} else {
 			errs = append(errs, p)
 		}
@@ -536,6 +769,107 @@ func allPkgs(ctx *context, initial []*packages.Package, verbose bool) (all []*aP
 	return
 }
 
+// liveMethods analyzes the whole program, so all its packages are created
+// ahead. The analysis starts from the main and init functions of the initial
+// packages (all functions of library ones), all functions of the runtime
+// and alternative packages, which are called by compiled code, and the
+// functions called by name from C or other packages (see linkedFuncs).
+func liveMethods(ctx *context, alts []*packages.Package, lib, verbose bool) (func(recv types.Type, id string) bool, []string) {
+	prog := ctx.progSSA
+	var roots []*ssa.Function
+	addPkg := func(pkg *ssa.Package, all bool) {
+		for _, m := range pkg.Members {
+			switch m := m.(type) {
+			case *ssa.Function:
+				if all || m.Name() == "main" || m.Name() == "init" {
+					roots = append(roots, m)
+				}
+			case *ssa.Type:
+				if !all {
+					continue
+				}
+				for _, t := range []types.Type{m.Type(), types.NewPointer(m.Type())} {
+					mset := prog.MethodSets.MethodSet(t)
+					for i, n := 0, mset.Len(); i < n; i++ {
+						roots = append(roots, prog.MethodValue(mset.At(i)))
+					}
+				}
+			}
+		}
+	}
+	var linked []*packages.Package
+	packages.Visit(ctx.initial, nil, func(p *packages.Package) {
+		if p.Types != nil && !p.IllTyped && !strings.HasPrefix(p.PkgPath, altPkgPathPrefix) {
+			createSSAPkg(prog, p, verbose)
+			linked = append(linked, p)
+		}
+	})
+	for _, p := range linked { // all packages are created: linkname targets resolve
+		roots = linkedFuncs(roots, prog, p)
+	}
+	for _, p := range ctx.initial {
+		if pkg := prog.ImportedPackage(p.PkgPath); pkg != nil {
+			addPkg(pkg, lib)
+		}
+	}
+	packages.Visit(alts, nil, func(p *packages.Package) {
+		if p.Types != nil && !p.IllTyped {
+			addPkg(createSSAPkg(prog, p, verbose), true)
+		}
+	})
+	return cl.LiveMethods(roots)
+}
+
+// linkedFuncs appends to roots the functions of p called by name, which the
+// analysis can't see: the ones exported to C by //export, and the ones linked
+// by //go:linkname, either defined in p or the targets of declarations.
+func linkedFuncs(roots []*ssa.Function, prog *ssa.Program, p *packages.Package) []*ssa.Function {
+	pkg := prog.ImportedPackage(p.PkgPath)
+	if pkg == nil {
+		return roots
+	}
+	for _, file := range p.Syntax {
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok && decl.Recv == nil && decl.Doc != nil {
+				for _, c := range decl.Doc.List {
+					if strings.HasPrefix(c.Text, "//export ") {
+						if fn := pkg.Func(decl.Name.Name); fn != nil {
+							roots = append(roots, fn)
+						}
+						break
+					}
+				}
+			}
+		}
+		for _, group := range file.Comments {
+			for _, c := range group.List {
+				name, ok := strings.CutPrefix(c.Text, "//go:linkname ")
+				if !ok {
+					continue
+				}
+				args := strings.Fields(name) // localname [importpath.name]
+				if len(args) == 0 {
+					continue
+				}
+				if fn := pkg.Func(args[0]); fn != nil && fn.Blocks != nil {
+					roots = append(roots, fn)
+				}
+				if len(args) < 2 {
+					continue
+				}
+				if i := strings.LastIndexByte(args[1], '.'); i > 0 {
+					if target := prog.ImportedPackage(args[1][:i]); target != nil {
+						if fn := target.Func(args[1][i+1:]); fn != nil && fn.Blocks != nil {
+							roots = append(roots, fn)
+						}
+					}
+				}
+			}
+		}
+	}
+	return roots
+}
+
 func createSSAPkg(prog *ssa.Program, p *packages.Package, verbose bool) *ssa.Package {
 	pkgSSA := prog.ImportedPackage(p.PkgPath)
 	if pkgSSA == nil {
@@ -551,22 +885,169 @@ func createSSAPkg(prog *ssa.Program, p *packages.Package, verbose bool) *ssa.Pac
 var (
 	// TODO(xsw): complete build flags
 	buildFlags = map[string]bool{
-		"-C":         true,  // -C dir: Change to dir before running the command
-		"-a":         false, // -a: force rebuilding of packages that are already up-to-date
-		"-n":         false, // -n: print the commands but do not run them
-		"-p":         true,  // -p n: the number of programs to run in parallel
-		"-race":      false, // -race: enable data race detection
-		"-cover":     false, // -cover: enable coverage analysis
-		"-covermode": true,  // -covermode mode: set the mode for coverage analysis
-		"-v":         false, // -v: print the names of packages as they are compiled
-		"-work":      false, // -work: print the name of the temporary work directory and do not delete it when exiting
-		"-x":         false, // -x: print the commands
-		"-tags":      true,  // -tags 'tag,list': a space-separated list of build tags to consider satisfied during the build
-		"-pkgdir":    true,  // -pkgdir dir: install and load all packages from dir instead of the usual locations
-		"-ldflags":   true,  // --ldflags 'flag list': arguments to pass on each go tool link invocation
+		"-C":       true,  // -C dir: Change to dir before running the command
+		"-a":       false, // -a: force rebuilding of packages that are already up-to-date
+		"-n":       false, // -n: print the commands but do not run them
+		"-p":       true,  // -p n: the number of programs to run in parallel
+		"-v":       false, // -v: print the names of packages as they are compiled
+		"-work":    false, // -work: print the name of the temporary work directory and do not delete it when exiting
+		"-x":       false, // -x: print the commands
+		"-tags":    true,  // -tags 'tag,list': a space-separated list of build tags to consider satisfied during the build
+		"-pkgdir":  true,  // -pkgdir dir: install and load all packages from dir instead of the usual locations
+		"-ldflags": true,  // --ldflags 'flag list': arguments to pass on each go tool link invocation
+	}
+
+	// llgo specific build flags, they are not passed to go list
+	llgoFlags = map[string]bool{
+		"-rt":      true,  // -rt 'file list': link with an alternative runtime instead of the llgo runtime
+		"-lto":     true,  // -lto mode: link with LTO, thin or full, to inline and drop functions across Go packages and C files
+		"-thinlto": false, // -thinlto: link with ThinLTO, same as -lto=thin
+		"-harden":  true,  // -harden 'option list': sspstrong, stackclash, cfprotection or all (see llssa.Hardening)
+
+		"-buildmode": true,  // -buildmode mode: exe (default), c-archive or c-shared
+		"-nodevirt":  false, // -nodevirt: don't devirtualize interface method calls
+		"-keepmeths": false, // -keepmeths: keep methods never called, don't let the linker drop them
+		"-race":      false, // -race: enable data race detection, with ThreadSanitizer
+		"-asan":      false, // -asan: enable interoperation with AddressSanitizer
+		"-msan":      false, // -msan: enable interoperation with MemorySanitizer
+		"-nolines":   false, // -nolines: don't emit line tables, stack traces print no file:line
+
+		"-cover":     false, // -cover: enable coverage analysis (llgo test)
+		"-covermode": true,  // -covermode mode: set, count or atomic, set by default (atomic with -race)
+		"-coverpkg":  true,  // -coverpkg pattern1,pattern2: apply coverage analysis to the packages matching the patterns
+		"-fuzz":      false, // -fuzz: build test binaries for fuzzing with libFuzzer (llgo test -fuzz regexp)
+
+		"-O0": false, // -O0: don't optimize generated code (default)
+		"-O1": false, // -O1: optimize generated code, quickly
+		"-O2": false, // -O2: optimize generated code (default with -lto)
+		"-O3": false, // -O3: optimize generated code aggressively, at the cost of size
+		"-Os": false, // -Os: optimize generated code for size
+		"-Oz": false, // -Oz: optimize generated code for size above all else, for embedded targets
+
+		"-pgo": true, // -pgo file: optimize with a pprof CPU profile, auto (default.pgo of the main package) by default, or off
+
+		"-emit-llvm":   false, // -emit-llvm: write the LLVM bitcode (.bc) of the packages instead of linking, LLVM IR (.ll) with -S
+		"-S":           false, // -S: write the assembly (.s) of the packages instead of linking
+		"-framelayout": false, // -framelayout: write the frame layouts (.frames) of the functions of the packages, from the code generator (LLVM 16+), instead of linking
 	}
 )
 
+// parseLLGoFlags applies llgo specific flags to conf and removes them from args.
+func parseLLGoFlags(args []string, conf *Config) []string {
+	ret := make([]string, 0, len(args))
+	n := len(args)
+	cover, opt := false, false
+	emitLLVM, asm, frames := false, false, false
+	defer func() {
+		if conf.LTO != LTONone && !opt { // LTO is for optimized builds
+			conf.Opt = llssa.OptO2
+		}
+		if cover && conf.Cover == llssa.CoverNone {
+			conf.Cover = llssa.CoverSet
+			if conf.Race {
+				conf.Cover = llssa.CoverAtomic
+			}
+		}
+		if emit := emitOf(emitLLVM, asm, frames); emit != EmitNone {
+			conf.Emit = emit
+		}
+	}()
+	for i := 0; i < n; i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			return append(ret, args[i:]...)
+		}
+		name, val, hasVal := strings.Cut(arg, "=")
+		hasarg, ok := llgoFlags[name]
+		if !ok {
+			ret = append(ret, arg)
+			if buildFlags[arg] && i+1 < n {
+				i++
+				ret = append(ret, args[i])
+			}
+			continue
+		}
+		if hasarg && !hasVal {
+			if i++; i >= n {
+				panic("flag needs an argument: " + arg)
+			}
+			val = args[i]
+		}
+		switch name {
+		case "-rt":
+			conf.RtFiles = strings.Fields(val)
+		case "-lto":
+			lto, err := parseLTO(val)
+			check(err)
+			conf.LTO = lto
+		case "-thinlto":
+			if !hasVal || val == "true" {
+				conf.LTO = LTOThin
+			} else if conf.LTO == LTOThin {
+				conf.LTO = LTONone
+			}
+		case "-harden":
+			h, err := llssa.ParseHardening(val)
+			check(err)
+			conf.Harden = h
+		case "-buildmode":
+			conf.BuildMode = parseBuildMode(val)
+		case "-nodevirt":
+			conf.NoDevirt = !hasVal || val == "true"
+		case "-keepmeths":
+			conf.KeepMeths = !hasVal || val == "true"
+		case "-race":
+			conf.Race = !hasVal || val == "true"
+		case "-nolines":
+			conf.NoLines = !hasVal || val == "true"
+		case "-asan":
+			conf.Sanitizers = setSanitizer(conf.Sanitizers, llssa.SanitizeAddress, !hasVal || val == "true")
+		case "-msan":
+			conf.Sanitizers = setSanitizer(conf.Sanitizers, llssa.SanitizeMemory, !hasVal || val == "true")
+		case "-cover":
+			cover = !hasVal || val == "true"
+		case "-covermode":
+			mode, err := llssa.ParseCoverMode(val)
+			check(err)
+			conf.Cover, cover = mode, true
+		case "-coverpkg":
+			conf.CoverPkg, cover = strings.Split(val, ","), true
+		case "-fuzz":
+			conf.Fuzz = !hasVal || val == "true"
+		case "-O0", "-O1", "-O2", "-O3", "-Os", "-Oz":
+			conf.Opt, _ = llssa.ParseOptLevel(name[2:])
+			opt = true
+		case "-pgo":
+			conf.PGO = val
+		case "-emit-llvm":
+			emitLLVM = !hasVal || val == "true"
+		case "-S":
+			asm = !hasVal || val == "true"
+		case "-framelayout":
+			frames = !hasVal || val == "true"
+		}
+	}
+	return ret
+}
+
+func setSanitizer(s, san llssa.Sanitizers, on bool) llssa.Sanitizers {
+	if on {
+		return s | san
+	}
+	return s &^ san
+}
+
+// sanitizeFlags returns the clang flags enabling the sanitizers s.
+func sanitizeFlags(s llssa.Sanitizers) (flags []string) {
+	if s&llssa.SanitizeAddress != 0 {
+		flags = append(flags, "-fsanitize=address")
+	}
+	if s&llssa.SanitizeMemory != 0 {
+		flags = append(flags, "-fsanitize=memory")
+	}
+	return
+}
+
 func ParseArgs(args []string, swflags map[string]bool) (flags, patterns []string, verbose bool) {
 	n := len(args)
 	for i := 0; i < n; i++ {
@@ -582,6 +1063,15 @@ func ParseArgs(args []string, swflags map[string]bool) (flags, patterns []string
 	return
 }
 
+// FlagHasArg reports whether flag, a build flag of go or llgo without its
+// value (like -tags), takes an argument, and whether it's a known flag.
+func FlagHasArg(flag string) (hasarg, ok bool) {
+	if hasarg, ok = buildFlags[flag]; !ok {
+		hasarg, ok = llgoFlags[flag]
+	}
+	return
+}
+
 func SkipFlagArgs(args []string) int {
 	n := len(args)
 	for i := 0; i < n; i++ {
@@ -606,6 +1096,10 @@ func checkFlag(arg string, i *int, verbose *bool, swflags map[string]bool) {
 		} else if verbose != nil && arg == "-v" {
 			*verbose = true
 		}
+	} else if hasarg, ok := llgoFlags[arg]; ok {
+		if hasarg {
+			*i++
+		}
 	} else {
 		panic("unknown flag: " + arg)
 	}
@@ -646,6 +1140,9 @@ func concatPkgLinkFiles(ctx *context, pkg *packages.Package, verbose bool) strin
 
 // const LLGoFiles = "file1; file2; ..."
 func llgoPkgLinkFiles(ctx *context, pkg *packages.Package, procFile func(linkFile string), verbose bool) {
+	if isCgoPkg(pkg) {
+		cgoPkgLinkFiles(ctx, pkg, procFile, verbose)
+	}
 	if o := pkg.Types.Scope().Lookup("LLGoFiles"); o != nil {
 		val := o.(*types.Const).Val()
 		if val.Kind() == constant.String {
@@ -674,8 +1171,26 @@ func clFiles(ctx *context, files string, pkg *packages.Package, procFile func(li
 }
 
 func clFile(ctx *context, args []string, cFile, expFile string, procFile func(linkFile string), verbose bool) {
-	llFile := expFile + filepath.Base(cFile) + ".ll"
-	args = append(args, "-emit-llvm", "-S", "-o", llFile, "-c", cFile)
+	var llFile string
+	if ctx.lto != LTONone {
+		// emit optimized bitcode (not optnone) for LTO, with a summary for
+		// ThinLTO, so its functions can be inlined into Go callers at link
+		// time.
+		llFile = expFile + filepath.Base(cFile) + ".bc"
+		args = append(args, ctx.lto.flag(), ctx.opt.String(), "-o", llFile, "-c", cFile)
+	} else {
+		llFile = expFile + filepath.Base(cFile) + ".ll"
+		args = append(args, ctx.opt.String(), "-emit-llvm", "-S", "-o", llFile, "-c", cFile)
+	}
+	if flags := sanitizeFlags(ctx.prog.Sanitizers()); flags != nil {
+		// C code is instrumented too, to catch the bugs of cgo and C interop.
+		// The .ll files are only marked here, the sanitizers run at link time
+		// over all the modules.
+		args = append(args, flags...)
+		if ctx.lto == LTONone {
+			args = append(args, "-Xclang", "-disable-llvm-passes")
+		}
+	}
 	if verbose {
 		fmt.Fprintln(os.Stderr, "clang", args)
 	}
@@ -709,22 +1224,43 @@ func canSkipToBuild(pkgPath string) bool {
 type none struct{}
 
 var hasAltPkg = map[string]none{
-	"fmt":                      {},
-	"internal/abi":             {},
-	"internal/bytealg":         {},
-	"internal/oserror":         {},
-	"internal/reflectlite":     {},
-	"internal/syscall/execenv": {},
-	"math":                     {},
-	"math/cmplx":               {},
-	"reflect":                  {},
-	"sync":                     {},
-	"sync/atomic":              {},
-	"syscall":                  {},
-	"time":                     {},
-	"os":                       {},
-	"os/exec":                  {},
-	"runtime":                  {},
+	"crypto/aes":                           {},
+	"crypto/cipher":                        {},
+	"crypto/rand":                          {},
+	"crypto/sha256":                        {},
+	"crypto/subtle":                        {},
+	"fmt":                                  {},
+	"golang.org/x/crypto/chacha20poly1305": {},
+	"golang.org/x/sys/unix":                {},
+	"hash/maphash":                         {},
+	"internal/abi":                         {},
+	"internal/bytealg":                     {},
+	"internal/byteorder":                   {},
+	"internal/chacha8rand":                 {},
+	"internal/godebug":                     {},
+	"internal/oserror":                     {},
+	"internal/poll":                        {},
+	"internal/race":                        {},
+	"internal/reflectlite":                 {},
+	"internal/syscall/execenv":             {},
+	"internal/sysinfo":                     {},
+	"math":                                 {},
+	"math/cmplx":                           {},
+	"reflect":                              {},
+	"sync":                                 {},
+	"sync/atomic":                          {},
+	"syscall":                              {},
+	"time":                                 {},
+	"os":                                   {},
+	"os/exec":                              {},
+	"os/signal":                            {},
+	"runtime":                              {},
+	"runtime/debug":                        {},
+	"runtime/metrics":                      {},
+	"runtime/pprof":                        {},
+	"runtime/trace":                        {},
+	"testing/internal/testdeps":            {},
+	"weak":                                 {},
 }
 
 var overlayFiles = map[string]string{
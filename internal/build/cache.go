@@ -0,0 +1,325 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goplus/llgo/cl"
+	"github.com/goplus/llgo/internal/packages"
+	"github.com/goplus/llgo/xtool/env"
+)
+
+// -----------------------------------------------------------------------------
+
+// The build cache keeps the LLVM IR of the packages compiled, so that only
+// the packages changed, and those depending on them, are compiled again. An
+// entry is the .ll file of a package, named by the hash of the inputs of its
+// compilation (see buildCache.key):
+//   - llgo itself, by its version and the hash of its executable, and the
+//     target;
+//   - the options of the program changing the code generated, like the
+//     sanitizers, the coverage counters and the profile of PGO, the methods
+//     of the program found dead, which type descriptors don't refer to (see
+//     liveMethods);
+//   - the files of the runtime and of its dependencies, which all compiled
+//     code calls;
+//   - the path and the files of the package, and the keys of its imports and
+//     of its patch.
+//
+// Main packages, patched packages, which are merged with their patch when
+// compiled, packages embedding files and Python modules are always compiled.
+// The cache is in the llgo/build directory of the user cache directory, or
+// in $LLGOCACHE, "off" disabling it. With -a, all packages are compiled, and
+// only written to the cache. Entries unused for 5 days are removed.
+
+const (
+	cacheTrimInterval = 24 * time.Hour     // time between the removals of unused entries
+	cacheTrimAge      = 5 * 24 * time.Hour // unused entries older are removed
+	cacheUsedAge      = time.Hour          // entries used are touched if older
+
+	cacheHeaderRuntime   = "; llgo cache: runtime\n" // the package needs the runtime
+	cacheHeaderNoRuntime = "; llgo cache: noruntime\n"
+)
+
+type buildCache struct {
+	dir   string                       // "" if the cache is off
+	force bool                         // -a: don't read entries
+	base  []byte                       // hash of llgo, of the options and of the runtime
+	alts  map[string]*packages.Package // patches, by path of the package patched
+	keys  map[*packages.Package]string // keys computed, "" if not cacheable
+	mu    sync.Mutex                   // guards keys, packages are compiled concurrently
+}
+
+// newBuildCache opens the build cache for a program of conf, loaded by cfg,
+// whose runtime and patches are alts, whose dead methods are dead, and whose
+// sample profile for PGO is profile.
+func newBuildCache(conf *Config, cfg *packages.Config, alts []*packages.Package, dead []string, profile string, verbose bool) *buildCache {
+	dir := buildCacheDir()
+	if dir == "" {
+		return &buildCache{}
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return &buildCache{}
+	}
+	h := sha256.New()
+	if !hashFile(h, exe) {
+		return &buildCache{}
+	}
+	fmt.Fprintf(h, "llgo %s %s/%s\n", env.Version(), runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(h, "options %d %v %v %d %v %v\n",
+		conf.Harden, conf.NoLines, conf.Race, conf.Sanitizers, conf.NoDevirt, conf.Opt)
+	if profile != "" {
+		fmt.Fprintln(h, "pgo", filepath.Base(profile)) // named by the hash of the pprof profile
+	}
+	for _, name := range dead {
+		fmt.Fprintln(h, "dead", name)
+	}
+	ok := true
+	packages.Visit(alts[:1], nil, func(p *packages.Package) {
+		fmt.Fprintln(h, "runtime", p.PkgPath)
+		ok = ok && hashFiles(h, p)
+	})
+	if !ok {
+		return &buildCache{}
+	}
+	c := &buildCache{
+		dir:   dir,
+		base:  h.Sum(nil),
+		alts:  make(map[string]*packages.Package),
+		keys:  make(map[*packages.Package]string),
+		force: hasFlag(cfg.BuildFlags, "-a"),
+	}
+	packages.Visit(alts[1:], nil, func(p *packages.Package) {
+		if path := strings.TrimPrefix(p.PkgPath, altPkgPathPrefix); path != p.PkgPath {
+			c.alts[path] = p
+		}
+	})
+	c.trim(verbose)
+	return c
+}
+
+// key returns the key of the entry of aPkg, or "" if it isn't cacheable.
+func (c *buildCache) key(aPkg *aPackage) string {
+	pkg := aPkg.Package
+	if c.dir == "" || pkg.Name == "main" || aPkg.AltPkg != nil {
+		return ""
+	}
+	if _, ok := pkg.Imports["embed"]; ok {
+		return ""
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.pkgKey(pkg)
+}
+
+func (c *buildCache) pkgKey(p *packages.Package) string {
+	if key, ok := c.keys[p]; ok {
+		return key
+	}
+	c.keys[p] = "" // an import cycle through a patch isn't cacheable
+	h := sha256.New()
+	h.Write(c.base)
+	fmt.Fprintf(h, "package %s %v\n", p.PkgPath, cl.CoverageOf(p.PkgPath))
+	if !hashFiles(h, p) {
+		return ""
+	}
+	paths := make([]string, 0, len(p.Imports))
+	for path := range p.Imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		key := c.pkgKey(p.Imports[path])
+		if key == "" {
+			return ""
+		}
+		fmt.Fprintln(h, "import", path, key)
+	}
+	if alt, ok := c.alts[p.PkgPath]; ok {
+		key := c.pkgKey(alt)
+		if key == "" {
+			return ""
+		}
+		fmt.Fprintln(h, "patch", key)
+	}
+	key := hex.EncodeToString(h.Sum(nil))
+	c.keys[p] = key
+	return key
+}
+
+// hashFiles writes the names and contents of the files of p to h. It reports
+// whether they are all read.
+func hashFiles(h hash.Hash, p *packages.Package) bool {
+	for _, files := range [][]string{p.CompiledGoFiles, p.OtherFiles} {
+		for _, file := range files {
+			fmt.Fprintln(h, "file", filepath.Base(file))
+			if !hashFile(h, file) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func hashFile(h hash.Hash, file string) bool {
+	f, err := os.Open(file)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	_, err = io.Copy(h, f)
+	return err == nil
+}
+
+func (c *buildCache) file(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".ll")
+}
+
+// load returns the LLVM IR of the entry key, and whether its package needs
+// the runtime, or ok = false if there's none.
+func (c *buildCache) load(key string) (ir []byte, needRuntime, ok bool) {
+	if c.force {
+		return
+	}
+	file := c.file(key)
+	ir, err := os.ReadFile(file)
+	if err != nil {
+		return
+	}
+	switch {
+	case bytes.HasPrefix(ir, []byte(cacheHeaderRuntime)):
+		needRuntime = true
+	case !bytes.HasPrefix(ir, []byte(cacheHeaderNoRuntime)):
+		return nil, false, false
+	}
+	if fi, err := os.Stat(file); err == nil && time.Since(fi.ModTime()) > cacheUsedAge {
+		now := time.Now()
+		os.Chtimes(file, now, now)
+	}
+	return ir, needRuntime, true
+}
+
+// store adds the LLVM IR of a package to the cache as the entry key. Errors
+// are ignored: the package is compiled again next time.
+func (c *buildCache) store(key, ir string, needRuntime bool) {
+	file := c.file(key)
+	dir := filepath.Dir(file)
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return
+	}
+	f, err := os.CreateTemp(dir, key+".tmp*")
+	if err != nil {
+		return
+	}
+	header := cacheHeaderNoRuntime
+	if needRuntime {
+		header = cacheHeaderRuntime
+	}
+	_, err = io.WriteString(f, header)
+	if err == nil {
+		_, err = io.WriteString(f, ir)
+	}
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), file) // entries appear complete
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// trim removes the entries unused for cacheTrimAge, at most once per
+// cacheTrimInterval.
+func (c *buildCache) trim(verbose bool) {
+	stamp := filepath.Join(c.dir, "trim.txt")
+	if fi, err := os.Stat(stamp); err == nil && time.Since(fi.ModTime()) < cacheTrimInterval {
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0777); err != nil {
+		return
+	}
+	os.WriteFile(stamp, nil, 0666)
+	dirs, _ := os.ReadDir(c.dir)
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		dir := filepath.Join(c.dir, d.Name())
+		entries, _ := os.ReadDir(dir)
+		for _, e := range entries {
+			if fi, err := e.Info(); err == nil && time.Since(fi.ModTime()) > cacheTrimAge {
+				if verbose {
+					fmt.Fprintln(os.Stderr, "Remove", filepath.Join(dir, e.Name()))
+				}
+				os.Remove(filepath.Join(dir, e.Name()))
+			}
+		}
+	}
+}
+
+// buildCacheDir returns the directory of the build cache, or "" if it's off.
+func buildCacheDir() string {
+	dir := os.Getenv("LLGOCACHE")
+	if dir == "off" {
+		return ""
+	}
+	if dir == "" {
+		cacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(cacheDir, "llgo", "build")
+	}
+	return dir
+}
+
+// cleanCache removes the build cache.
+func cleanCache(verbose bool) {
+	if dir := buildCacheDir(); dir != "" {
+		if verbose {
+			fmt.Fprintln(os.Stderr, "Remove", dir)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func hasFlag(flags []string, flag string) bool {
+	for _, f := range flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
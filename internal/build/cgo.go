@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llgo/cl"
+	"github.com/goplus/llgo/internal/packages"
+	"github.com/goplus/llgo/xtool/env"
+)
+
+// -----------------------------------------------------------------------------
+
+// cgoFile presents a Go file which imports "C".
+type cgoFile struct {
+	name     string   // file name
+	line     int      // line of the preamble
+	preamble string   // preamble without #cgo directives
+	funcs    []string // C functions referenced by the file
+}
+
+// cgoDirective presents a #cgo directive.
+type cgoDirective struct {
+	pos  string // position of the directive
+	text string // text after "#cgo "
+}
+
+// isCgoPkg reports whether pkg has files processed by cgo.
+func isCgoPkg(pkg *packages.Package) bool {
+	return pkg.Types.Scope().Lookup("_Cgo_ptr") != nil
+}
+
+// cgoPkgLinkFiles compiles the preambles of cgo files and the C files of pkg
+// with clang, and passes the compiled files and the #cgo LDFLAGS to procFile.
+// Each preamble is compiled along with C function pointers used by the cgo
+// stubs (see cl.CgoFuncPrefix):
+//
+//	__typeof__(puts) *_cgo_llgo_puts = puts;
+func cgoPkgLinkFiles(ctx *context, pkg *packages.Package, procFile func(linkFile string), verbose bool) {
+	dir := filepath.Dir(pkg.GoFiles[0])
+	fset := token.NewFileSet()
+	var files []*cgoFile
+	var cflags, ldflags []string
+	for _, file := range pkg.GoFiles {
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		check(err)
+		cf, directives := parseCgoFile(fset, f, pkg.Types.Scope())
+		if cf == nil {
+			continue
+		}
+		for _, d := range directives {
+			c, l := d.flags(dir)
+			cflags = append(cflags, c...)
+			ldflags = append(ldflags, l...)
+		}
+		files = append(files, cf)
+	}
+	expFile := pkg.ExportFile
+	args := append([]string{"-I" + dir}, cflags...)
+	for _, cf := range files {
+		cFile := expFile + "." + filepath.Base(cf.name) + ".c"
+		err := os.WriteFile(cFile, []byte(cf.source()), 0644)
+		check(err)
+		clFile(ctx, args, cFile, expFile, procFile, verbose)
+	}
+	for _, file := range pkg.OtherFiles {
+		if filepath.Ext(file) == ".c" {
+			clFile(ctx, args, file, expFile, procFile, verbose)
+		}
+	}
+	for _, flag := range ldflags {
+		procFile(flag)
+	}
+}
+
+// parseCgoFile returns the preamble, the #cgo directives and the referenced C
+// functions of f, or nil if f doesn't import "C".
+func parseCgoFile(fset *token.FileSet, f *ast.File, scope *types.Scope) (cf *cgoFile, directives []cgoDirective) {
+	for _, decl := range f.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.IMPORT {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ImportSpec)
+			if spec.Path.Value != `"C"` {
+				continue
+			}
+			doc := spec.Doc
+			if doc == nil && len(decl.Specs) == 1 {
+				doc = decl.Doc
+			}
+			if cf == nil {
+				cf = &cgoFile{name: fset.Position(f.Pos()).Filename}
+			}
+			if doc != nil {
+				cf.line = fset.Position(doc.Pos()).Line
+				lines := strings.Split(commentText(doc), "\n")
+				for i, line := range lines {
+					if d, ok := strings.CutPrefix(strings.TrimSpace(line), "#cgo "); ok {
+						pos := fmt.Sprintf("%s:%d", cf.name, cf.line+i)
+						directives = append(directives, cgoDirective{pos, d})
+						lines[i] = ""
+					}
+				}
+				cf.preamble = strings.Join(lines, "\n")
+			}
+		}
+	}
+	if cf == nil {
+		return
+	}
+	have := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == "C" && !have[sel.Sel.Name] {
+				goName := "_Cfunc_" + sel.Sel.Name
+				if name, ok := cl.CgoFuncName(goName); ok {
+					if _, ok := scope.Lookup(goName).(*types.Func); ok {
+						have[name] = true
+						cf.funcs = append(cf.funcs, name)
+					}
+				}
+			}
+		}
+		return true
+	})
+	sort.Strings(cf.funcs)
+	return
+}
+
+// commentText returns the text of doc without comment markers, keeping the
+// lines as they are in the source.
+func commentText(doc *ast.CommentGroup) string {
+	var b strings.Builder
+	for i, c := range doc.List {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		if text, ok := strings.CutPrefix(c.Text, "//"); ok {
+			b.WriteString(text)
+		} else {
+			b.WriteString(strings.TrimSuffix(c.Text[2:], "*/"))
+		}
+	}
+	return b.String()
+}
+
+// source returns the C source of the preamble and the C function pointers.
+func (cf *cgoFile) source() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "#line %d %s\n", cf.line, strconv.Quote(cf.name))
+	b.WriteString(cf.preamble)
+	b.WriteString("\n#line 1 \"cgo-generated\"\n")
+	for _, fn := range cf.funcs {
+		fmt.Fprintf(&b, "__attribute__((weak)) __typeof__(%s) *%s%s = %s;\n", fn, cl.CgoFuncPrefix, fn, fn)
+	}
+	return b.String()
+}
+
+// flags parses the #cgo directive d, which is in the form:
+//
+//	[GOOS GOARCH constraints] CFLAGS|CPPFLAGS|LDFLAGS|pkg-config: flags...
+func (d cgoDirective) flags(dir string) (cflags, ldflags []string) {
+	pos := d.pos
+	verb, args, ok := strings.Cut(d.text, ":")
+	if !ok {
+		panic(pos + ": invalid #cgo directive: " + d.text)
+	}
+	fields := strings.Fields(verb)
+	if len(fields) == 0 {
+		panic(pos + ": invalid #cgo directive: " + d.text)
+	}
+	if n := len(fields) - 1; n > 0 && !cgoMatch(fields[:n]) {
+		return
+	}
+	args = strings.ReplaceAll(args, "${SRCDIR}", dir)
+	switch verb := fields[len(fields)-1]; verb {
+	case "CFLAGS", "CPPFLAGS":
+		cflags = strings.Fields(args)
+	case "LDFLAGS":
+		ldflags = strings.Fields(args)
+	case "pkg-config":
+		pkgs := strings.TrimSpace(args)
+		cflags = strings.Fields(env.ExpandEnv("$(pkg-config --cflags " + pkgs + ")"))
+		ldflags = strings.Fields(env.ExpandEnv("$(pkg-config --libs " + pkgs + ")"))
+	case "CXXFLAGS", "FFLAGS":
+	default:
+		panic(pos + ": unsupported #cgo verb: " + verb)
+	}
+	return
+}
+
+// cgoMatch reports whether a constraint list like `linux,amd64 darwin` of a
+// #cgo directive matches the target.
+func cgoMatch(opts []string) bool {
+	for _, opt := range opts {
+		ok := true
+		for _, term := range strings.Split(opt, ",") {
+			not := strings.HasPrefix(term, "!")
+			if cgoMatchTag(strings.TrimPrefix(term, "!")) == not {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func cgoMatchTag(tag string) bool {
+	switch tag {
+	case runtime.GOOS, runtime.GOARCH, "cgo":
+		return true
+	case "unix":
+		switch runtime.GOOS {
+		case "windows", "plan9", "js", "wasip1":
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
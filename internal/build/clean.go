@@ -28,15 +28,26 @@ import (
 var (
 	// TODO(xsw): complete clean flags
 	cleanFlags = map[string]bool{
-		"-v": false, // -v: print the paths of packages as they are clean
+		"-v":     false, // -v: print the paths of packages as they are clean
+		"-cache": false, // -cache: remove the build cache (see buildCache)
 	}
 )
 
 func Clean(args []string, conf *Config) {
+	cache := hasFlag(args, "-cache")
+	if cache {
+		args = removeFlag(args, "-cache")
+	}
 	flags, patterns, verbose := ParseArgs(args, cleanFlags)
+	if cache {
+		cleanCache(verbose)
+		if patterns == nil { // like go clean -cache, only the cache is removed
+			return
+		}
+	}
 	cfg := &packages.Config{
 		Mode:       loadSyntax | packages.NeedExportFile,
-		BuildFlags: flags,
+		BuildFlags: addBuildTags(flags, defaultTags...),
 	}
 
 	if patterns == nil {
@@ -74,6 +85,16 @@ func cleanPkgs(initial []*packages.Package, verbose bool) {
 	})
 }
 
+func removeFlag(args []string, flag string) []string {
+	ret := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg != flag {
+			ret = append(ret, arg)
+		}
+	}
+	return ret
+}
+
 func removeFile(file string, verbose bool) {
 	if _, err := os.Stat(file); os.IsNotExist(err) {
 		return
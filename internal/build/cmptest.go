@@ -24,24 +24,58 @@ import (
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 )
 
 func cmpTest(dir, pkgPath, llApp string, runArgs []string) {
+	// the program is built rather than run by go run, which exits with 1
+	// and reports the exit status of the program on stderr
+	tmp, err := os.MkdirTemp("", "llgo-cmptest")
+	if err != nil {
+		fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	goApp := filepath.Join(tmp, "app")
+	build := exec.Command("go", "build", "-o", goApp, pkgPath)
+	build.Dir = dir
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		fatal(err)
+	}
+
 	var goOut, goErr bytes.Buffer
 	var llgoOut, llgoErr bytes.Buffer
 	var llgoRunErr = runApp(runArgs, dir, &llgoOut, &llgoErr, llApp)
-	var goRunErr = runApp(runArgs, dir, &goOut, &goErr, "go", "run", pkgPath)
+	var goRunErr = runApp(runArgs, dir, &goOut, &goErr, goApp)
 	checkEqual("output", llgoOut.Bytes(), goOut.Bytes())
 	checkEqual("stderr", llgoErr.Bytes(), goErr.Bytes())
 	checkEqualRunErr(llgoRunErr, goRunErr)
 }
 
+// checkEqualRunErr checks that the programs compared exit with the same
+// status.
 func checkEqualRunErr(llgoRunErr, goRunErr error) {
-	if llgoRunErr == goRunErr {
+	code, expected := exitCode(llgoRunErr), exitCode(goRunErr)
+	if code == expected {
 		return
 	}
 	fmt.Fprintln(os.Stderr, "=> Exit:", llgoRunErr)
 	fmt.Fprintln(os.Stderr, "\n=> Expected Exit:", goRunErr)
+
+	fatal(fmt.Errorf("checkEqual: unexpected exit code %d, expected %d", code, expected))
+}
+
+// exitCode returns the exit code of a program run with the error err, -1 if
+// it was killed by a signal.
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	if e, ok := err.(*exec.ExitError); ok {
+		return e.ExitCode()
+	}
+	fatal(err) // not run
+	return 0
 }
 
 func checkEqual(prompt string, a, expected []byte) {
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llgo/cl"
+	"github.com/goplus/llgo/internal/packages"
+	llssa "github.com/goplus/llgo/ssa"
+)
+
+// -----------------------------------------------------------------------------
+
+// Packages are compiled concurrently by a pool of workers, as many as -p n
+// (GOMAXPROCS by default). Each worker has its own program, and so its own
+// LLVM context, which isn't safe for concurrent use. A package is compiled
+// after its imports, as patched packages change their types when compiled
+// (see typepatch.Merge), and the llgo:type directives of its imports are
+// registered in the program of its worker first, which a single program
+// compiling the imports would have done. Verbose builds, which trace the
+// instructions compiled, are serial.
+
+type worker struct {
+	prog   llssa.Program
+	parsed map[*packages.Package]none // packages whose llgo:type directives are registered
+}
+
+func newWorker(prog llssa.Program) *worker {
+	return &worker{prog: prog, parsed: make(map[*packages.Package]none)}
+}
+
+// compile compiles aPkg, whose imports are deps. It returns the value of the
+// panic of the compilation, if any.
+func (w *worker) compile(ctx *context, aPkg *aPackage, deps []*packages.Package, verbose bool) (e any) {
+	defer func() {
+		e = recover()
+	}()
+	packages.Visit(deps, func(p *packages.Package) bool {
+		if _, ok := w.parsed[p]; ok {
+			return false
+		}
+		w.parsed[p] = none{}
+		return true
+	}, func(p *packages.Package) {
+		if p.Types != nil && !p.IllTyped {
+			cl.ParsePkgSyntax(w.prog, p.Types, p.Syntax)
+		}
+	})
+	buildPkg(ctx, w.prog, aPkg, verbose)
+	return nil
+}
+
+// A compileJob is a package to compile, with the jobs waiting for it.
+type compileJob struct {
+	pkg   *aPackage
+	deps  []*packages.Package // imports of pkg, and of its patch
+	waits int                 // number of deps not compiled yet
+	users []*compileJob
+}
+
+type compileResult struct {
+	job *compileJob
+	err any
+}
+
+// compilePkgs compiles the packages of pkgs, which are in dependency order,
+// by the workers of ctx. A panic compiling a package stops scheduling the
+// others, and is raised again once the packages compiling are done.
+func compilePkgs(ctx *context, pkgs []*aPackage, verbose bool) {
+	jobs := make([]*compileJob, 0, len(pkgs))
+	index := make(map[string]*compileJob, len(pkgs))
+	for _, aPkg := range pkgs {
+		pkg := aPkg.Package
+		switch kind, _ := cl.PkgKindOf(pkg.Types); kind {
+		case cl.PkgDeclOnly:
+			continue
+		case cl.PkgLinkIR, cl.PkgLinkExtern, cl.PkgPyModule:
+			if len(pkg.GoFiles) == 0 {
+				continue
+			}
+		}
+		job := &compileJob{pkg: aPkg}
+		addDep := func(path string) bool {
+			// a patch may import packages after aPkg, compiled after it
+			// as in a serial build
+			if dep, ok := index[path]; ok {
+				job.deps = append(job.deps, dep.pkg.Package)
+				job.waits++
+				dep.users = append(dep.users, job)
+				return true
+			}
+			return false
+		}
+		paths := make([]string, 0, len(pkg.Imports))
+		for path := range pkg.Imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			if !addDep(path) {
+				job.deps = append(job.deps, pkg.Imports[path])
+			}
+		}
+		if alt := aPkg.AltPkg; alt != nil {
+			for _, imp := range alt.Types.Imports() {
+				if _, ok := pkg.Imports[imp.Path()]; !ok {
+					addDep(imp.Path())
+				}
+			}
+		}
+		jobs = append(jobs, job)
+		index[pkg.PkgPath] = job
+	}
+	if len(jobs) == 0 {
+		return
+	}
+
+	queue := make(chan *compileJob, len(jobs))
+	results := make(chan compileResult)
+	workers := ctx.workers
+	if len(workers) > len(jobs) {
+		workers = workers[:len(jobs)]
+	}
+	for _, w := range workers {
+		go func(w *worker) {
+			for job := range queue {
+				results <- compileResult{job, w.compile(ctx, job.pkg, job.deps, verbose)}
+			}
+		}(w)
+	}
+	running := 0
+	for _, job := range jobs {
+		if job.waits == 0 {
+			queue <- job
+			running++
+		}
+	}
+	var failure any
+	for running > 0 {
+		ret := <-results
+		running--
+		if ret.err != nil {
+			if failure == nil {
+				failure = ret.err
+			}
+			continue
+		}
+		if failure != nil {
+			continue
+		}
+		for _, user := range ret.job.users {
+			if user.waits--; user.waits == 0 {
+				queue <- user
+				running++
+			}
+		}
+	}
+	close(queue)
+	if failure != nil {
+		panic(failure)
+	}
+}
+
+// numWorkers returns the number of packages compiled concurrently: n of the
+// build flag -p n, GOMAXPROCS by default, and 1 for verbose builds.
+func numWorkers(flags []string, verbose bool) int {
+	if verbose {
+		return 1
+	}
+	for i, flag := range flags {
+		var arg string
+		if strings.HasPrefix(flag, "-p=") {
+			arg = flag[3:]
+		} else if flag == "-p" && i+1 < len(flags) {
+			arg = flags[i+1]
+		} else {
+			continue
+		}
+		if n, err := strconv.Atoi(arg); err == nil && n > 0 {
+			return n
+		}
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// -----------------------------------------------------------------------------
+
+// Emit specifies the kind of the files llgo build writes for the packages it
+// compiles, instead of linking main packages. The file of a package is named
+// by its import path, in the directory of -o (the current one by default):
+// github.com/user/repo/pkg.ll for github.com/user/repo/pkg with -S -emit-llvm.
+type Emit int
+
+const (
+	EmitNone    Emit = iota // link main packages (default)
+	EmitLL                  // LLVM IR, .ll (-S -emit-llvm)
+	EmitBitcode             // LLVM bitcode, .bc (-emit-llvm)
+	EmitAsm                 // target assembly, .s (-S)
+	EmitFrames              // frame layouts of the functions, .frames (-framelayout)
+)
+
+// emitOf returns the kind of the files written for the flags -emit-llvm and
+// -S, like clang, and -framelayout.
+func emitOf(emitLLVM, asm, frames bool) Emit {
+	switch {
+	case frames:
+		return EmitFrames
+	case emitLLVM && asm:
+		return EmitLL
+	case emitLLVM:
+		return EmitBitcode
+	case asm:
+		return EmitAsm
+	}
+	return EmitNone
+}
+
+// ext returns the file extension of the files of e.
+func (e Emit) ext() string {
+	switch e {
+	case EmitLL:
+		return ".ll"
+	case EmitBitcode:
+		return ".bc"
+	case EmitFrames:
+		return ".frames"
+	}
+	return ".s"
+}
+
+// emitPkgs writes the files of conf.Emit for the packages of pkgs compiled,
+// from their LLVM IR.
+func emitPkgs(ctx *context, pkgs []*aPackage, conf *Config, verbose bool) {
+	dir := conf.OutFile
+	if dir == "" {
+		dir = "."
+	}
+	for _, aPkg := range pkgs {
+		if aPkg.LLFile == "" {
+			continue
+		}
+		file := filepath.Join(dir, filepath.FromSlash(aPkg.PkgPath)+conf.Emit.ext())
+		check(os.MkdirAll(filepath.Dir(file), 0755))
+		switch conf.Emit {
+		case EmitLL:
+			ir, err := os.ReadFile(aPkg.LLFile)
+			check(err)
+			check(os.WriteFile(file, ir, 0644))
+		case EmitFrames:
+			emitFrames(ctx, aPkg.LLFile, file, conf, verbose)
+		default:
+			args := []string{"-Wno-override-module", "-o", file}
+			if conf.Emit == EmitBitcode {
+				// the bitcode is the IR as is, like the .ll file
+				args = append(args, "-c", "-emit-llvm", "-Xclang", "-disable-llvm-passes")
+			} else {
+				// the sanitizers run over the modules when linked
+				args = append(args, "-S", conf.Opt.String())
+				args = append(args, sanitizeFlags(conf.Sanitizers)...)
+			}
+			args = append(args, aPkg.LLFile)
+			if verbose {
+				fmt.Fprintln(os.Stderr, "clang", args)
+			}
+			check(ctx.env.Clang().Exec(args...))
+		}
+		if debugBuild || verbose {
+			fmt.Fprintf(os.Stderr, "==> Emit %s: %s\n", aPkg.PkgPath, file)
+		}
+	}
+}
+
+// emitFrames writes to file the frame layouts of the functions of the module
+// llFile, as the code generator lays them out: it compiles the module, like
+// to link it, and reads its stack-frame-layout remarks (see
+// llssa.Program.ReadFrameLayouts), which need LLVM 16 or later: with an
+// older one, there are none and it fails.
+func emitFrames(ctx *context, llFile, file string, conf *Config, verbose bool) {
+	args := []string{"-Wno-override-module", "-c", "-o", os.DevNull, conf.Opt.String()}
+	args = append(args, sanitizeFlags(conf.Sanitizers)...)
+	args = append(args, "-Rpass-analysis=stack-frame-layout", llFile)
+	if verbose {
+		fmt.Fprintln(os.Stderr, "clang", args)
+	}
+	var remarks bytes.Buffer
+	clang := ctx.env.Clang()
+	clang.Stderr = &remarks
+	if err := clang.Exec(args...); err != nil {
+		os.Stderr.Write(remarks.Bytes())
+		check(err)
+	}
+	layouts, err := ctx.prog.ReadFrameLayouts(&remarks, llFile)
+	if err != nil {
+		check(fmt.Errorf("-framelayout: %w", err))
+	}
+	var out bytes.Buffer
+	check(layouts.Write(&out))
+	check(os.WriteFile(file, out.Bytes(), 0644))
+}
+
+// -----------------------------------------------------------------------------
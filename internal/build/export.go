@@ -0,0 +1,359 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llgo/internal/packages"
+)
+
+// -----------------------------------------------------------------------------
+
+// BuildMode specifies the kind of the output of a main package.
+type BuildMode string
+
+const (
+	BuildModeExe      BuildMode = "exe"       // an executable (default)
+	BuildModeCArchive BuildMode = "c-archive" // a C archive (.a) and its header
+	BuildModeCShared  BuildMode = "c-shared"  // a C shared library and its header
+)
+
+// isLibrary reports whether m builds a C library, which exports the Go
+// functions marked by //export.
+func (m BuildMode) isLibrary() bool {
+	return m == BuildModeCArchive || m == BuildModeCShared
+}
+
+// outExt returns the file extension of the output of m.
+func (m BuildMode) outExt(appExt string) string {
+	switch m {
+	case BuildModeCArchive:
+		return ".a"
+	case BuildModeCShared:
+		switch runtime.GOOS {
+		case "darwin":
+			return ".dylib"
+		case "windows":
+			return ".dll"
+		}
+		return ".so"
+	}
+	return appExt
+}
+
+func parseBuildMode(val string) BuildMode {
+	switch m := BuildMode(val); m {
+	case "default", BuildModeExe:
+		return BuildModeExe
+	case BuildModeCArchive, BuildModeCShared:
+		return m
+	}
+	panic("unsupported build mode: " + val)
+}
+
+// -----------------------------------------------------------------------------
+
+// buildArchive creates the C archive app from the link files of a main
+// package. LLVM IR files are compiled into object files, and linker flags are
+// dropped: the libraries they refer to are to be linked with the archive.
+func buildArchive(ctx *context, app string, files []string, verbose bool) {
+	dir, err := os.MkdirTemp("", "llgo-archive")
+	check(err)
+	defer os.RemoveAll(dir)
+
+	objs := make([]string, 0, len(files))
+	for i := 0; i < len(files); i++ {
+		file := files[i]
+		if strings.HasPrefix(file, "-") {
+			if file == "-framework" { // -framework name
+				i++
+			}
+			continue
+		}
+		switch filepath.Ext(file) {
+		case ".ll", ".bc":
+			obj := filepath.Join(dir, strconv.Itoa(len(objs))+"_"+filepath.Base(file)+".o")
+			args := []string{"-c", "-fPIC", "-Wno-override-module", "-o", obj, file}
+			if verbose {
+				fmt.Fprintln(os.Stderr, "clang", args)
+			}
+			check(ctx.env.Clang().Exec(args...))
+			file = obj
+		case ".o":
+		default:
+			panic("cannot add to a C archive: " + file)
+		}
+		objs = append(objs, file)
+	}
+
+	os.Remove(app)
+	args := append([]string{"rcs", app}, objs...)
+	if verbose {
+		fmt.Fprintln(os.Stderr, "llvm-ar", args)
+	}
+	cmd := exec.Command(filepath.Join(ctx.env.BinDir(), "llvm-ar"), args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	check(cmd.Run())
+}
+
+// -----------------------------------------------------------------------------
+
+// cExport presents a Go function exported to C by //export.
+type cExport struct {
+	name string
+	fn   *types.Func
+}
+
+// exportsOf returns the functions of pkg exported to C.
+func exportsOf(pkg *packages.Package) (exports []cExport) {
+	for _, f := range pkg.Syntax {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Doc == nil || decl.Recv != nil {
+				continue
+			}
+			for _, c := range decl.Doc.List {
+				if name, ok := strings.CutPrefix(c.Text, "//export "); ok {
+					fn := pkg.TypesInfo.Defs[decl.Name].(*types.Func)
+					exports = append(exports, cExport{strings.TrimSpace(name), fn})
+				}
+			}
+		}
+	}
+	return
+}
+
+// writeExportHeader writes the C header of the functions exported by pkg to
+// file, in the same form as the header generated by gc for c-archive and
+// c-shared build modes.
+func writeExportHeader(file string, pkg *packages.Package, ptrSize int) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "/* Code generated by llgo; DO NOT EDIT. */\n\n/* package %s */\n\n", pkg.PkgPath)
+	b.WriteString(exportProlog)
+
+	b.WriteString("\n/* Start of preamble from import \"C\" comments.  */\n\n")
+	fset := token.NewFileSet()
+	for _, name := range pkg.GoFiles {
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments|parser.ImportsOnly)
+		check(err)
+		if cf, _ := parseCgoFile(fset, f, pkg.Types.Scope()); cf != nil && cf.preamble != "" {
+			fmt.Fprintf(&b, "\n#line %d %s\n%s\n", cf.line, strconv.Quote(cf.name), cf.preamble)
+		}
+	}
+	b.WriteString("\n/* End of preamble from import \"C\" comments.  */\n\n")
+
+	bits := ptrSize * 8
+	fmt.Fprintf(&b, exportTypes, bits, bits, bits, bits)
+
+	b.WriteString("\n#ifdef __cplusplus\nextern \"C\" {\n#endif\n\n")
+	for _, exp := range exportsOf(pkg) {
+		sig := exp.fn.Type().(*types.Signature)
+		ret := "void"
+		switch results := sig.Results(); results.Len() {
+		case 0:
+		case 1:
+			ret = cTypeOf(results.At(0).Type())
+		default:
+			fmt.Fprintf(&b, "/* Return type for %s */\nstruct %s_return {\n", exp.name, exp.name)
+			for i := 0; i < results.Len(); i++ {
+				v := results.At(i)
+				fmt.Fprintf(&b, "\t%s %s;\n", cTypeOf(v.Type()), cName(v, "r", i))
+			}
+			b.WriteString("};\n")
+			ret = "struct " + exp.name + "_return"
+		}
+		fmt.Fprintf(&b, "extern %s %s(", ret, exp.name)
+		params := sig.Params()
+		for i := 0; i < params.Len(); i++ {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			v := params.At(i)
+			fmt.Fprintf(&b, "%s %s", cTypeOf(v.Type()), cName(v, "p", i))
+		}
+		b.WriteString(");\n")
+	}
+	b.WriteString("\n#ifdef __cplusplus\n}\n#endif\n")
+
+	err := os.WriteFile(file, []byte(b.String()), 0644)
+	check(err)
+}
+
+func cName(v *types.Var, prefix string, i int) string {
+	if name := v.Name(); name != "" && name != "_" {
+		return name
+	}
+	return prefix + strconv.Itoa(i)
+}
+
+// cTypeOf returns the C type of a parameter or a result of an exported
+// function.
+func cTypeOf(t types.Type) string {
+	if named, ok := t.(*types.Named); ok {
+		if name, ok := strings.CutPrefix(named.Obj().Name(), "_Ctype_"); ok {
+			return cTypeName(name)
+		}
+	}
+	switch t := t.Underlying().(type) {
+	case *types.Basic:
+		switch kind := t.Kind(); kind {
+		case types.Bool:
+			return "GoUint8"
+		case types.String:
+			return "GoString"
+		case types.UnsafePointer:
+			return "void*"
+		}
+		if name, ok := goBasicTypes[t.Kind()]; ok {
+			return name
+		}
+	case *types.Pointer:
+		return cTypeOf(t.Elem()) + "*"
+	case *types.Slice:
+		return "GoSlice"
+	case *types.Map:
+		return "GoMap"
+	case *types.Chan:
+		return "GoChan"
+	case *types.Interface:
+		return "GoInterface"
+	}
+	panic(fmt.Sprintf("unsupported type of exported function: %v", t))
+}
+
+// cTypeName returns the C type of a cgo type name _Ctype_name.
+func cTypeName(name string) string {
+	if s, ok := strings.CutPrefix(name, "struct_"); ok {
+		return "struct " + s
+	}
+	if s, ok := strings.CutPrefix(name, "union_"); ok {
+		return "union " + s
+	}
+	if s, ok := strings.CutPrefix(name, "enum_"); ok {
+		return "enum " + s
+	}
+	switch name {
+	case "schar":
+		return "signed char"
+	case "uchar":
+		return "unsigned char"
+	case "ushort":
+		return "unsigned short"
+	case "uint":
+		return "unsigned int"
+	case "ulong":
+		return "unsigned long"
+	case "longlong":
+		return "long long"
+	case "ulonglong":
+		return "unsigned long long"
+	}
+	return name
+}
+
+var goBasicTypes = map[types.BasicKind]string{
+	types.Int:        "GoInt",
+	types.Int8:       "GoInt8",
+	types.Int16:      "GoInt16",
+	types.Int32:      "GoInt32",
+	types.Int64:      "GoInt64",
+	types.Uint:       "GoUint",
+	types.Uint8:      "GoUint8",
+	types.Uint16:     "GoUint16",
+	types.Uint32:     "GoUint32",
+	types.Uint64:     "GoUint64",
+	types.Uintptr:    "GoUintptr",
+	types.Float32:    "GoFloat32",
+	types.Float64:    "GoFloat64",
+	types.Complex64:  "GoComplex64",
+	types.Complex128: "GoComplex128",
+}
+
+const exportProlog = `#line 1 "cgo-builtin-export-prolog"
+
+#include <stddef.h>
+
+#ifndef GO_CGO_EXPORT_PROLOGUE_H
+#define GO_CGO_EXPORT_PROLOGUE_H
+
+#ifndef GO_CGO_GOSTRING_TYPEDEF
+typedef struct { const char *p; ptrdiff_t n; } _GoString_;
+#endif
+
+#endif
+`
+
+const exportTypes = `/* Start of boilerplate cgo prologue.  */
+#line 1 "cgo-gcc-export-header-prolog"
+
+#ifndef GO_CGO_PROLOGUE_H
+#define GO_CGO_PROLOGUE_H
+
+typedef signed char GoInt8;
+typedef unsigned char GoUint8;
+typedef short GoInt16;
+typedef unsigned short GoUint16;
+typedef int GoInt32;
+typedef unsigned int GoUint32;
+typedef long long GoInt64;
+typedef unsigned long long GoUint64;
+typedef GoInt%d GoInt;
+typedef GoUint%d GoUint;
+typedef size_t GoUintptr;
+typedef float GoFloat32;
+typedef double GoFloat64;
+#ifdef _MSC_VER
+#include <complex.h>
+typedef _Fcomplex GoComplex64;
+typedef _Dcomplex GoComplex128;
+#else
+typedef float _Complex GoComplex64;
+typedef double _Complex GoComplex128;
+#endif
+
+/*
+  static assertion to make sure the file is being used on architecture
+  at least with matching size of GoInt.
+*/
+typedef char _check_for_%d_bit_pointer_matching_GoInt[sizeof(void*)==%d/8 ? 1:-1];
+
+#ifndef GO_CGO_GOSTRING_TYPEDEF
+typedef _GoString_ GoString;
+#endif
+typedef void *GoMap;
+typedef void *GoChan;
+typedef struct { void *t; void *v; } GoInterface;
+typedef struct { void *data; GoInt len; GoInt cap; } GoSlice;
+
+#endif
+
+/* End of boilerplate cgo prologue.  */
+`
+
+// -----------------------------------------------------------------------------
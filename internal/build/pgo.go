@@ -0,0 +1,501 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llgo/internal/packages"
+)
+
+// -----------------------------------------------------------------------------
+
+// Profile-guided optimization works like in Go: -pgo names a pprof CPU
+// profile of the program, default.pgo in the directory of the main package
+// by default (-pgo=auto), or none (-pgo=off). It's converted to a sample
+// profile of LLVM, which the pass pipeline of the packages compiled loads
+// (see llssa.Program.SetSampleProfile). A sample counts for each line of its
+// stack, inlined frames included, as the samples of the function of the line,
+// at its offset from the line of the function, and a call from a line to the
+// function of the frame below counts as a call target of the line. Functions
+// are named as llgo does: the closures of go/ssa are named f$1, not f.func1,
+// and the instantiations of generic functions, named by shapes, are dropped.
+
+const (
+	pgoAuto = "auto"
+	pgoOff  = "off"
+
+	pgoDefaultFile = "default.pgo"
+)
+
+// pgoProfile returns the file of the sample profile of the pprof profile of
+// conf.PGO, for the main packages of initial, or "" if there's none. The
+// file is converted once, in the cache directory, or in a temporary one
+// removed by the returned cleanup function.
+func pgoProfile(conf *Config, initial []*packages.Package, verbose bool) (file string, cleanup func()) {
+	cleanup = func() {}
+	pprof := conf.PGO
+	switch pprof {
+	case pgoOff:
+		return
+	case "", pgoAuto:
+		pprof = ""
+		if len(initial) == 1 && initial[0].Name == "main" && len(initial[0].GoFiles) > 0 {
+			auto := filepath.Join(filepath.Dir(initial[0].GoFiles[0]), pgoDefaultFile)
+			if _, err := os.Stat(auto); err == nil {
+				pprof = auto
+			}
+		}
+		if pprof == "" {
+			return
+		}
+	}
+	data, err := os.ReadFile(pprof)
+	check(err)
+	h := sha256.Sum256(data)
+	dir := buildCacheDir()
+	if dir == "" {
+		dir, err = os.MkdirTemp("", "llgo-pgo")
+		check(err)
+		cleanup = func() { os.RemoveAll(dir) }
+	} else {
+		dir = filepath.Join(filepath.Dir(dir), "pgo")
+		check(os.MkdirAll(dir, 0777))
+	}
+	file = filepath.Join(dir, hex.EncodeToString(h[:16])+".prof")
+	if _, err := os.Stat(file); err == nil {
+		return
+	}
+	if verbose {
+		fmt.Fprintln(os.Stderr, "==> PGO", pprof, "=>", file)
+	}
+	prof, err := parsePprof(data)
+	if err != nil {
+		panic(fmt.Sprintf("-pgo=%s: %v", pprof, err))
+	}
+	var buf bytes.Buffer
+	writeSampleProfile(&buf, prof)
+	tmp := file + ".tmp" + strconv.Itoa(os.Getpid())
+	check(os.WriteFile(tmp, buf.Bytes(), 0666))
+	check(os.Rename(tmp, file))
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+// pprofProfile is the part of a pprof profile (see profile.proto of pprof)
+// giving the lines of the samples of a CPU profile.
+type pprofProfile struct {
+	sampleTypes [][2]int64               // types and units, as indexes of strs
+	samples     []pprofSample            // samples of the profile
+	locations   map[uint64][]pprofLine   // lines of locations, innermost first
+	functions   map[uint64]pprofFunction // functions by id
+	strs        []string
+}
+
+type pprofSample struct {
+	locations []uint64 // leaf first
+	values    []int64  // by sample type
+}
+
+type pprofLine struct {
+	function uint64
+	line     int64
+}
+
+type pprofFunction struct {
+	name      int64 // index of strs
+	startLine int64
+}
+
+// parsePprof parses a pprof profile, compressed by gzip or not.
+func parsePprof(data []byte) (*pprofProfile, error) {
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(zr); err != nil {
+			return nil, err
+		}
+	}
+	p := &pprofProfile{
+		locations: make(map[uint64][]pprofLine),
+		functions: make(map[uint64]pprofFunction),
+	}
+	r := protoReader{data: data}
+	for r.next() {
+		switch r.field {
+		case 1: // sample_type
+			m := r.message()
+			var vt [2]int64
+			for m.next() {
+				if m.field == 1 || m.field == 2 {
+					vt[m.field-1] = int64(m.varint())
+				} else {
+					m.skip()
+				}
+			}
+			r.err = m.err
+			p.sampleTypes = append(p.sampleTypes, vt)
+		case 2: // sample
+			m := r.message()
+			var s pprofSample
+			for m.next() {
+				switch m.field {
+				case 1:
+					s.locations = m.varints(s.locations)
+				case 2:
+					for _, v := range m.varints(nil) {
+						s.values = append(s.values, int64(v))
+					}
+				default:
+					m.skip()
+				}
+			}
+			r.err = m.err
+			p.samples = append(p.samples, s)
+		case 4: // location
+			m := r.message()
+			var id uint64
+			var lines []pprofLine
+			for m.next() {
+				switch m.field {
+				case 1:
+					id = m.varint()
+				case 4:
+					l := m.message()
+					var line pprofLine
+					for l.next() {
+						switch l.field {
+						case 1:
+							line.function = l.varint()
+						case 2:
+							line.line = int64(l.varint())
+						default:
+							l.skip()
+						}
+					}
+					m.err = l.err
+					lines = append(lines, line)
+				default:
+					m.skip()
+				}
+			}
+			r.err = m.err
+			p.locations[id] = lines
+		case 5: // function
+			m := r.message()
+			var id uint64
+			var fn pprofFunction
+			for m.next() {
+				switch m.field {
+				case 1:
+					id = m.varint()
+				case 2:
+					fn.name = int64(m.varint())
+				case 5:
+					fn.startLine = int64(m.varint())
+				default:
+					m.skip()
+				}
+			}
+			r.err = m.err
+			p.functions[id] = fn
+		case 6: // string_table
+			p.strs = append(p.strs, string(r.bytes()))
+		default:
+			r.skip()
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, fn := range p.functions {
+		if fn.name < 0 || fn.name >= int64(len(p.strs)) {
+			return nil, errors.New("malformed profile: bad function name")
+		}
+	}
+	return p, nil
+}
+
+// protoReader reads the fields of a message of protocol buffers.
+type protoReader struct {
+	data  []byte
+	field int
+	wire  int
+	err   error
+}
+
+func (r *protoReader) next() bool {
+	if r.err != nil || len(r.data) == 0 {
+		return false
+	}
+	key := r.varint()
+	r.field, r.wire = int(key>>3), int(key&7)
+	return r.err == nil
+}
+
+func (r *protoReader) varint() (v uint64) {
+	for shift := uint(0); shift < 64; shift += 7 {
+		if len(r.data) == 0 {
+			break
+		}
+		b := r.data[0]
+		r.data = r.data[1:]
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return
+		}
+	}
+	r.fail()
+	return 0
+}
+
+// varints appends the values of a repeated integer field, packed or not.
+func (r *protoReader) varints(vals []uint64) []uint64 {
+	if r.wire != 2 {
+		return append(vals, r.varint())
+	}
+	packed := protoReader{data: r.bytes()}
+	for len(packed.data) > 0 && packed.err == nil {
+		vals = append(vals, packed.varint())
+	}
+	if packed.err != nil {
+		r.err = packed.err
+	}
+	return vals
+}
+
+func (r *protoReader) bytes() []byte {
+	n := r.varint()
+	if r.err != nil || n > uint64(len(r.data)) {
+		r.fail()
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *protoReader) message() *protoReader {
+	return &protoReader{data: r.bytes(), err: r.err}
+}
+
+func (r *protoReader) skip() {
+	switch r.wire {
+	case 0:
+		r.varint()
+	case 1, 5:
+		n := 8
+		if r.wire == 5 {
+			n = 4
+		}
+		if len(r.data) < n {
+			r.fail()
+			return
+		}
+		r.data = r.data[n:]
+	case 2:
+		r.bytes()
+	default:
+		r.fail()
+	}
+}
+
+func (r *protoReader) fail() {
+	if r.err == nil {
+		r.err = errors.New("malformed profile")
+	}
+	r.data = nil
+}
+
+// -----------------------------------------------------------------------------
+
+// funcSamples are the samples of a function in a sample profile.
+type funcSamples struct {
+	name  string
+	total int64
+	head  int64                      // samples of the calls of the function
+	body  map[int64]int64            // samples by line offset
+	calls map[int64]map[string]int64 // samples of call targets by line offset
+}
+
+// writeSampleProfile writes the samples of p in the text format of sample
+// profiles of LLVM. It panics if p isn't a CPU profile.
+func writeSampleProfile(w io.Writer, p *pprofProfile) {
+	idx := -1
+	for i, vt := range p.sampleTypes {
+		if p.str(vt[0]) == "samples" && p.str(vt[1]) == "count" {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		panic("not a CPU profile: no samples/count sample type")
+	}
+	type frame struct {
+		fn   *funcSamples
+		line int64 // offset
+	}
+	fns := make(map[string]*funcSamples)
+	var frames []frame
+	for _, s := range p.samples {
+		if idx >= len(s.values) || s.values[idx] <= 0 {
+			continue
+		}
+		v := s.values[idx]
+		frames = frames[:0]
+		for _, loc := range s.locations {
+			for _, line := range p.locations[loc] {
+				pfn, ok := p.functions[line.function]
+				if !ok {
+					continue
+				}
+				name := llgoFuncName(p.str(pfn.name))
+				off := line.line - pfn.startLine
+				if name == "" || pfn.startLine == 0 || off < 0 {
+					continue
+				}
+				fn := fns[name]
+				if fn == nil {
+					fn = &funcSamples{
+						name:  name,
+						body:  make(map[int64]int64),
+						calls: make(map[int64]map[string]int64),
+					}
+					fns[name] = fn
+				}
+				frames = append(frames, frame{fn, off})
+			}
+		}
+		// a sample counts once for a line, a function or a call, even if
+		// recursive
+		seen := make(map[any]none)
+		once := func(key any) bool {
+			if _, ok := seen[key]; ok {
+				return false
+			}
+			seen[key] = none{}
+			return true
+		}
+		type call struct {
+			frame
+			callee string
+		}
+		type head struct {
+			fn *funcSamples
+		}
+		for i, f := range frames {
+			if once(f) {
+				f.fn.body[f.line] += v
+			}
+			if once(f.fn) {
+				f.fn.total += v
+			}
+			if i > 0 && once(call{f, frames[i-1].fn.name}) {
+				targets := f.fn.calls[f.line]
+				if targets == nil {
+					targets = make(map[string]int64)
+					f.fn.calls[f.line] = targets
+				}
+				targets[frames[i-1].fn.name] += v
+			}
+			if i+1 < len(frames) && once(head{f.fn}) {
+				f.fn.head += v
+			}
+		}
+	}
+
+	all := make([]*funcSamples, 0, len(fns))
+	for _, fn := range fns {
+		all = append(all, fn)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].total != all[j].total {
+			return all[i].total > all[j].total
+		}
+		return all[i].name < all[j].name
+	})
+	bw := bufio.NewWriter(w)
+	for _, fn := range all {
+		fmt.Fprintf(bw, "%s:%d:%d\n", fn.name, fn.total, fn.head)
+		for _, off := range sortedKeys(fn.body) {
+			fmt.Fprintf(bw, " %d: %d", off, fn.body[off])
+			targets := fn.calls[off]
+			names := make([]string, 0, len(targets))
+			for name := range targets {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				fmt.Fprintf(bw, " %s:%d", name, targets[name])
+			}
+			bw.WriteByte('\n')
+		}
+	}
+	bw.Flush()
+}
+
+func (p *pprofProfile) str(i int64) string {
+	if i < 0 || i >= int64(len(p.strs)) {
+		return ""
+	}
+	return p.strs[i]
+}
+
+func sortedKeys(m map[int64]int64) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+var closureSuffix = regexp.MustCompile(`\.func(\d+)((?:\.\d+)*)$`)
+
+// llgoFuncName returns the name llgo gives to the function named name by gc,
+// or "" if it has none.
+func llgoFuncName(name string) string {
+	if strings.ContainsAny(name, "[ ") { // generic instantiations
+		return ""
+	}
+	name = strings.ReplaceAll(name, "%2e", ".") // escaped in the last element of package paths
+	if name == "main.main" {
+		return "main"
+	}
+	if m := closureSuffix.FindStringSubmatchIndex(name); m != nil {
+		ret := name[:m[0]] + "$" + name[m[2]:m[3]]
+		return ret + strings.ReplaceAll(name[m[4]:m[5]], ".", "$")
+	}
+	return name
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+// The fixtures of _testdata/pgo are small pprof profiles, compressed by gzip:
+// cpu.pprof has the samples below, as samples/count and cpu/nanoseconds, and
+// heap.pprof is a heap profile (alloc_objects/count and alloc_space/bytes).
+//
+//	main.main          10: main.go:12
+//	main.work          20: main.go:22, main.go:25
+//	main.work.func1    30: main.go:32
+//	main.add           40: main.go:41, inlined into main.work at main.go:23
+//	main.gen[...]      50: main.go:52
+//	example.com/a%2eb.run.func2.1 60: main.go:61
+//
+//	5: main.work.func1:32 <- main.work:25 <- main.main:12
+//	3: main.work:22 <- main.main:12
+//	2: main.work:22 <- main.work:25 <- main.main:12
+//	4: main.add:41, main.work:23 <- main.main:12
+//	1: main.gen[...]:52 <- main.main:12
+//	1: example.com/a%2eb.run.func2.1:61
+//	0: main.work:22 <- main.main:12
+
+func TestPgoSampleProfile(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want string // sample profile, or the panic if it starts with "panic: "
+	}{
+		{"cpu", "cpu.pprof", `main:15:0
+ 2: 15 main.work:14
+main.work:14:14
+ 2: 5
+ 3: 4 main.add:4
+ 5: 7 main.work:2 main.work$1:5
+main.work$1:5:5
+ 2: 5
+main.add:4:4
+ 1: 4
+example.com/a.b.run$2$1:1:0
+ 1: 1
+`},
+		{"heap", "heap.pprof", "panic: not a CPU profile: no samples/count sample type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := os.ReadFile("_testdata/pgo/" + tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prof, err := parsePprof(data)
+			if err != nil {
+				t.Fatal("parsePprof:", err)
+			}
+			got := sampleProfile(prof)
+			if got != tt.want {
+				t.Fatalf("writeSampleProfile:\n==> got:\n%s\n==> expected:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func sampleProfile(prof *pprofProfile) (ret string) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = "panic: " + r.(string)
+		}
+	}()
+	var buf bytes.Buffer
+	writeSampleProfile(&buf, prof)
+	return buf.String()
+}
+
+func TestPgoMalformed(t *testing.T) {
+	data, err := os.ReadFile("_testdata/pgo/cpu.pprof")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"truncated gzip", data[:len(data)/2], "unexpected EOF"},
+		{"truncated field", []byte{0x0a, 0x05, 0x08}, "malformed profile"},
+		{"bad wire type", []byte{0x0f}, "malformed profile"},
+		{"bad function name", []byte{0x2a, 0x04, 0x08, 0x01, 0x10, 0x07}, "malformed profile: bad function name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parsePprof(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("parsePprof: got %v, expected %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLlgoFuncName(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"main.main", "main"},
+		{"main.work", "main.work"},
+		{"main.work.func1", "main.work$1"},
+		{"main.work.func1.2", "main.work$1$2"},
+		{"main.(*T).m.func3", "main.(*T).m$3"},
+		{"example.com/a%2eb.run", "example.com/a.b.run"},
+		{"example.com/a%2eb.run.func2.1", "example.com/a.b.run$2$1"},
+		{"main.funcs", "main.funcs"},
+		{"main.gen[...]", ""},
+		{"main.gen[go.shape.int]", ""},
+	}
+	for _, tt := range tests {
+		if got := llgoFuncName(tt.name); got != tt.want {
+			t.Errorf("llgoFuncName(%q) = %q, expected %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/goplus/llgo/cl"
+	"github.com/goplus/llgo/internal/packages"
+	llssa "github.com/goplus/llgo/ssa"
+	"github.com/goplus/llgo/xtool/env/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// ModeTest builds a test binary for each package matched, like go test: the
+// main package p.test generated by go list, which runs the tests, examples
+// and benchmarks of package p and of its external test package p_test with
+// the testing package. Each package is built separately, since the variants
+// of packages recompiled with the tests of p ("q [p.test]") have the paths
+// of the original ones.
+//
+// With -cover, the packages tested, or those of -coverpkg, are compiled with
+// coverage counters, and the test binaries write their profiles, which are
+// concatenated to the one of -coverprofile.
+//
+// With -fuzz, a single package is tested, compiled with SanitizerCoverage
+// and linked with libFuzzer (libclang_rt.fuzzer_no_main of clang), and its
+// test binary fuzzes the target of -test.fuzz in a worker process (see
+// testing/internal/testdeps). The inputs found are kept in the user cache
+// directory, like in $GOCACHE/fuzz with go test.
+
+// doTest builds and runs the tests of the packages of patterns, and returns
+// the number of packages failing.
+func doTest(env *llvm.Env, cfg *packages.Config, patterns []string, conf *Config, verbose bool) (nErr int) {
+	lcfg := *cfg
+	lcfg.Mode = packages.NeedName
+	lcfg.Tests = false
+	pkgs, err := packages.LoadEx(nil, nil, &lcfg, patterns...)
+	check(err)
+	if conf.Fuzz && len(pkgs) != 1 {
+		panic("cannot use -fuzz flag with multiple packages")
+	}
+	var coverPkgs map[string]none
+	if conf.Cover != llssa.CoverNone {
+		if conf.CoverPkg != nil {
+			covered, err := packages.LoadEx(nil, nil, &lcfg, conf.CoverPkg...)
+			check(err)
+			coverPkgs = make(map[string]none, len(covered))
+			for _, pkg := range covered {
+				coverPkgs[pkg.PkgPath] = none{}
+			}
+		}
+		if profile := testFlagOf(conf.RunArgs, "-test.coverprofile"); profile != "" {
+			err = os.WriteFile(profile, []byte("mode: "+conf.Cover.String()+"\n"), 0644)
+			check(err)
+		}
+	}
+	for _, pkg := range pkgs {
+		nErr += doTestPkg(env, cfg, pkg.PkgPath, conf, coverPkgs, verbose)
+	}
+	return
+}
+
+func doTestPkg(env *llvm.Env, cfg *packages.Config, pkgPath string, conf *Config, coverPkgs map[string]none, verbose bool) (nErr int) {
+	defer func() {
+		if e := recover(); e != nil {
+			fmt.Fprintln(os.Stderr, e)
+			fmt.Printf("FAIL\t%s [build failed]\n", pkgPath)
+			nErr = 1
+		}
+	}()
+	if conf.Cover != llssa.CoverNone {
+		cl.SetCoverage(conf.Cover, func(path string) bool {
+			if coverPkgs == nil {
+				return path == pkgPath
+			}
+			_, ok := coverPkgs[path]
+			return ok
+		})
+		defer cl.SetCoverage(llssa.CoverNone, nil)
+	}
+	return do(env, cfg, []string{pkgPath}, conf, verbose)
+}
+
+// testMainPkgs returns the test binaries of initial, loaded with tests, or
+// nil if there are none.
+func testMainPkgs(initial []*packages.Package) (mains []*packages.Package) {
+	for _, pkg := range initial {
+		if pkg.Name == "main" && strings.HasSuffix(pkg.PkgPath, ".test") {
+			mains = append(mains, pkg)
+		}
+	}
+	return
+}
+
+// runTest runs app, the test binary of the main package pkg, with args in
+// the directory of the package tested, and reports its result like go test:
+// the output of the tests is only printed if they fail, unless it's asked
+// for by args (-test.v, -test.list, -test.bench or -test.fuzz), and the
+// coverage they print is added to the result. It returns 1 if the tests fail, or 0.
+func runTest(pkg *packages.Package, app string, args []string) int {
+	pkgPath := strings.TrimSuffix(pkg.PkgPath, ".test")
+	var out bytes.Buffer
+	profile := testFlagOf(args, "-test.coverprofile")
+	if profile != "" { // written by the binary, then added to profile
+		args = append(args[:len(args):len(args)], "-test.coverprofile="+app+".cover")
+	}
+	if testFlagOf(args, "-test.fuzz") != "" {
+		args = append(args[:len(args):len(args)], "-test.fuzzcachedir="+fuzzCacheDir(pkgPath))
+	}
+	cmd := exec.Command(app, args...)
+	cmd.Dir = testDir(pkg, pkgPath)
+	if streamTestOutput(args) {
+		cmd.Stdout = io.MultiWriter(os.Stdout, &out)
+		cmd.Stderr = os.Stderr
+	} else {
+		cmd.Stdout = &out
+		cmd.Stderr = &out
+	}
+	start := time.Now()
+	err := cmd.Run()
+	elapsed := time.Since(start).Seconds()
+	if err != nil {
+		os.Stdout.Write(out.Bytes())
+		if _, ok := err.(*exec.ExitError); !ok {
+			fmt.Println(err)
+		}
+		fmt.Printf("FAIL\t%s\t%.3fs\n", pkgPath, elapsed)
+		return 1
+	}
+	if profile != "" {
+		check(appendCoverProfile(profile, app+".cover"))
+	}
+	fmt.Printf("ok  \t%s\t%.3fs%s\n", pkgPath, elapsed, coverageOf(out.Bytes()))
+	return 0
+}
+
+// testFlagOf returns the value of the last test flag name of args, like
+// -test.coverprofile, or "".
+func testFlagOf(args []string, name string) (val string) {
+	for _, arg := range args {
+		if n, v, ok := strings.Cut(arg, "="); ok && n == name {
+			val = v
+		}
+	}
+	return
+}
+
+// fuzzCacheDir returns the directory of the inputs found by fuzzing the
+// package pkgPath, in the user cache directory.
+func fuzzCacheDir(pkgPath string) string {
+	dir, err := os.UserCacheDir()
+	check(err)
+	return filepath.Join(dir, "llgo", "fuzz", filepath.FromSlash(pkgPath))
+}
+
+// fuzzFlags returns the clang flags linking a test binary for fuzzing: the
+// modules are instrumented by SanitizerCoverage, and libFuzzer is linked
+// without its main function, the test binary calling its driver.
+func fuzzFlags(env *llvm.Env) []string {
+	var out bytes.Buffer
+	cmd := env.Clang()
+	cmd.Stdout = &out
+	check(cmd.Exec("-print-runtime-dir"))
+	dir := strings.TrimSpace(out.String())
+	arch := map[string]string{"amd64": "x86_64", "arm64": "aarch64", "386": "i386"}[runtime.GOARCH]
+	for _, name := range []string{
+		"libclang_rt.fuzzer_no_main.a",              // per target runtime directory
+		"libclang_rt.fuzzer_no_main-" + arch + ".a", // lib/linux
+		"libclang_rt.fuzzer_no_main_osx.a",          // lib/darwin
+	} {
+		lib := filepath.Join(dir, name)
+		if _, err := os.Stat(lib); err == nil {
+			cxx := "-lstdc++"
+			if runtime.GOOS == "darwin" {
+				cxx = "-lc++"
+			}
+			return []string{"-fsanitize=fuzzer-no-link", lib, cxx}
+		}
+	}
+	panic("libFuzzer not found in " + dir + ": llgo test -fuzz needs the compiler-rt runtimes of clang")
+}
+
+// appendCoverProfile adds the blocks of the coverage profile file to the one
+// of profile, without its mode line.
+func appendCoverProfile(profile, file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if i := bytes.IndexByte(data, '\n'); bytes.HasPrefix(data, []byte("mode:")) && i >= 0 {
+		data = data[i+1:]
+	}
+	f, err := os.OpenFile(profile, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+// coverageOf returns the coverage printed in the output of a test binary, as
+// "\tcoverage: 75.0% of statements", or "".
+func coverageOf(out []byte) string {
+	for _, line := range bytes.Split(out, []byte("\n")) {
+		if bytes.HasPrefix(line, []byte("coverage: ")) {
+			return "\t" + string(line)
+		}
+	}
+	return ""
+}
+
+// testDir returns the directory of the package pkgPath tested by its test
+// binary pkg, from the files of the package or of its external test package.
+func testDir(pkg *packages.Package, pkgPath string) string {
+	for _, path := range []string{pkgPath, pkgPath + "_test"} {
+		if p, ok := pkg.Imports[path]; ok && len(p.GoFiles) > 0 {
+			return filepath.Dir(p.GoFiles[0])
+		}
+	}
+	return ""
+}
+
+func streamTestOutput(args []string) bool {
+	for _, arg := range args {
+		name, val, _ := strings.Cut(arg, "=")
+		switch name {
+		case "-test.v", "-test.list", "-test.bench":
+			return val != "false"
+		case "-test.fuzz":
+			return val != ""
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
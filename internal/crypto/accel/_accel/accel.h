@@ -0,0 +1,46 @@
+#ifndef LLGO_ACCEL_H
+#define LLGO_ACCEL_H
+
+#include <stddef.h>
+#include <stdint.h>
+
+#if defined(__x86_64__) || defined(__i386__)
+#define ACCEL_X86 1
+#elif defined(__aarch64__) && (defined(__linux__) || defined(__APPLE__))
+#define ACCEL_ARM64 1
+#endif
+
+extern int llgoHasAES, llgoHasCLMUL, llgoHasSHA2;
+
+static inline uint32_t loadBE32(const uint8_t *p) {
+    return (uint32_t)p[0] << 24 | (uint32_t)p[1] << 16 | (uint32_t)p[2] << 8 | p[3];
+}
+
+static inline void storeBE32(uint8_t *p, uint32_t v) {
+    p[0] = v >> 24;
+    p[1] = v >> 16;
+    p[2] = v >> 8;
+    p[3] = v;
+}
+
+static inline uint32_t loadLE32(const uint8_t *p) {
+    return (uint32_t)p[3] << 24 | (uint32_t)p[2] << 16 | (uint32_t)p[1] << 8 | p[0];
+}
+
+static inline void storeLE32(uint8_t *p, uint32_t v) {
+    p[0] = v;
+    p[1] = v >> 8;
+    p[2] = v >> 16;
+    p[3] = v >> 24;
+}
+
+static inline uint64_t loadLE64(const uint8_t *p) {
+    return (uint64_t)loadLE32(p + 4) << 32 | loadLE32(p);
+}
+
+static inline void storeLE64(uint8_t *p, uint64_t v) {
+    storeLE32(p, (uint32_t)v);
+    storeLE32(p + 4, (uint32_t)(v >> 32));
+}
+
+#endif
@@ -0,0 +1,566 @@
+#include <string.h>
+
+#include "accel.h"
+
+#if ACCEL_X86
+#include <immintrin.h>
+#elif ACCEL_ARM64
+#include <arm_neon.h>
+#endif
+
+// -----------------------------------------------------------------------------
+
+// AES blocks are encrypted by AES-NI or by the AES instructions of ARMv8,
+// four at a time in counter mode, or by portable code with lookup tables,
+// like the generic code of Go. The round keys are expanded by the portable
+// code, for all of them: those to decrypt are those of the equivalent
+// inverse cipher, which aesdec and aesd with aesimc compute too.
+
+typedef struct {
+    uint8_t enc[240];
+    uint8_t dec[240];
+    int32_t rounds;
+} llgoAES;
+
+static uint8_t sbox[256], isbox[256];
+static uint32_t te[4][256], td[4][256];
+
+static uint8_t mul(uint8_t a, uint8_t b) {
+    uint8_t p = 0;
+    for (; b; b >>= 1) {
+        if (b & 1) {
+            p ^= a;
+        }
+        a = (uint8_t)(a << 1) ^ (a & 0x80 ? 0x1b : 0);
+    }
+    return p;
+}
+
+#define ROTR(x, n) ((x) >> (n) | (x) << (32 - (n)))
+
+__attribute__((constructor))
+static void aesInit(void) {
+    // the S-box maps x to the affine transform of its inverse, walking the
+    // multiplicative group by generator 3
+    uint8_t p = 1, q = 1;
+    do {
+        p = p ^ (uint8_t)(p << 1) ^ (p & 0x80 ? 0x1b : 0);
+        q ^= q << 1;
+        q ^= q << 2;
+        q ^= q << 4;
+        q ^= q & 0x80 ? 0x09 : 0;
+        uint8_t x = q ^ (uint8_t)(q << 1 | q >> 7) ^ (uint8_t)(q << 2 | q >> 6) ^
+                    (uint8_t)(q << 3 | q >> 5) ^ (uint8_t)(q << 4 | q >> 4);
+        sbox[p] = x ^ 0x63;
+    } while (p != 1);
+    sbox[0] = 0x63;
+    for (int i = 0; i < 256; i++) {
+        isbox[sbox[i]] = i;
+    }
+    for (int i = 0; i < 256; i++) {
+        uint8_t s = sbox[i], is = isbox[i];
+        uint32_t e = (uint32_t)mul(s, 2) << 24 | (uint32_t)s << 16 | (uint32_t)s << 8 | mul(s, 3);
+        uint32_t d = (uint32_t)mul(is, 0xe) << 24 | (uint32_t)mul(is, 9) << 16 |
+                     (uint32_t)mul(is, 0xd) << 8 | mul(is, 0xb);
+        for (int j = 0; j < 4; j++) {
+            te[j][i] = j ? ROTR(e, 8 * j) : e;
+            td[j][i] = j ? ROTR(d, 8 * j) : d;
+        }
+    }
+}
+
+static uint32_t subw(uint32_t w) {
+    return (uint32_t)sbox[w >> 24] << 24 | (uint32_t)sbox[w >> 16 & 0xff] << 16 |
+           (uint32_t)sbox[w >> 8 & 0xff] << 8 | sbox[w & 0xff];
+}
+
+// llgoAESExpand expands a key of 16, 24 or 32 bytes.
+void llgoAESExpand(llgoAES *c, const uint8_t *key, int n) {
+    uint32_t enc[60], dec[60];
+    int nk = n / 4, nw = n + 28;
+    for (int i = 0; i < nk; i++) {
+        enc[i] = loadBE32(key + 4 * i);
+    }
+    uint32_t rcon = 1;
+    for (int i = nk; i < nw; i++) {
+        uint32_t t = enc[i - 1];
+        if (i % nk == 0) {
+            t = subw(t << 8 | t >> 24) ^ rcon << 24;
+            rcon = mul(rcon, 2);
+        } else if (nk > 6 && i % nk == 4) {
+            t = subw(t);
+        }
+        enc[i] = enc[i - nk] ^ t;
+    }
+    for (int i = 0; i < nw; i += 4) {
+        int ei = nw - i - 4;
+        for (int j = 0; j < 4; j++) {
+            uint32_t x = enc[ei + j];
+            if (i > 0 && i + 4 < nw) {
+                x = td[0][sbox[x >> 24]] ^ td[1][sbox[x >> 16 & 0xff]] ^
+                    td[2][sbox[x >> 8 & 0xff]] ^ td[3][sbox[x & 0xff]];
+            }
+            dec[i + j] = x;
+        }
+    }
+    for (int i = 0; i < nw; i++) {
+        storeBE32(c->enc + 4 * i, enc[i]);
+        storeBE32(c->dec + 4 * i, dec[i]);
+    }
+    c->rounds = nk + 6;
+}
+
+static void encryptGeneric(const llgoAES *c, uint8_t *dst, const uint8_t *src) {
+    const uint8_t *k = c->enc;
+    uint32_t s0 = loadBE32(src) ^ loadBE32(k), s1 = loadBE32(src + 4) ^ loadBE32(k + 4);
+    uint32_t s2 = loadBE32(src + 8) ^ loadBE32(k + 8), s3 = loadBE32(src + 12) ^ loadBE32(k + 12);
+    for (int r = 1; r < c->rounds; r++) {
+        k += 16;
+        uint32_t t0 = loadBE32(k) ^ te[0][s0 >> 24] ^ te[1][s1 >> 16 & 0xff] ^ te[2][s2 >> 8 & 0xff] ^ te[3][s3 & 0xff];
+        uint32_t t1 = loadBE32(k + 4) ^ te[0][s1 >> 24] ^ te[1][s2 >> 16 & 0xff] ^ te[2][s3 >> 8 & 0xff] ^ te[3][s0 & 0xff];
+        uint32_t t2 = loadBE32(k + 8) ^ te[0][s2 >> 24] ^ te[1][s3 >> 16 & 0xff] ^ te[2][s0 >> 8 & 0xff] ^ te[3][s1 & 0xff];
+        uint32_t t3 = loadBE32(k + 12) ^ te[0][s3 >> 24] ^ te[1][s0 >> 16 & 0xff] ^ te[2][s1 >> 8 & 0xff] ^ te[3][s2 & 0xff];
+        s0 = t0, s1 = t1, s2 = t2, s3 = t3;
+    }
+    k += 16;
+#define LAST(a, b, c, d) \
+    ((uint32_t)sbox[(a) >> 24] << 24 | (uint32_t)sbox[(b) >> 16 & 0xff] << 16 | (uint32_t)sbox[(c) >> 8 & 0xff] << 8 | sbox[(d) & 0xff])
+    storeBE32(dst, LAST(s0, s1, s2, s3) ^ loadBE32(k));
+    storeBE32(dst + 4, LAST(s1, s2, s3, s0) ^ loadBE32(k + 4));
+    storeBE32(dst + 8, LAST(s2, s3, s0, s1) ^ loadBE32(k + 8));
+    storeBE32(dst + 12, LAST(s3, s0, s1, s2) ^ loadBE32(k + 12));
+#undef LAST
+}
+
+static void decryptGeneric(const llgoAES *c, uint8_t *dst, const uint8_t *src) {
+    const uint8_t *k = c->dec;
+    uint32_t s0 = loadBE32(src) ^ loadBE32(k), s1 = loadBE32(src + 4) ^ loadBE32(k + 4);
+    uint32_t s2 = loadBE32(src + 8) ^ loadBE32(k + 8), s3 = loadBE32(src + 12) ^ loadBE32(k + 12);
+    for (int r = 1; r < c->rounds; r++) {
+        k += 16;
+        uint32_t t0 = loadBE32(k) ^ td[0][s0 >> 24] ^ td[1][s3 >> 16 & 0xff] ^ td[2][s2 >> 8 & 0xff] ^ td[3][s1 & 0xff];
+        uint32_t t1 = loadBE32(k + 4) ^ td[0][s1 >> 24] ^ td[1][s0 >> 16 & 0xff] ^ td[2][s3 >> 8 & 0xff] ^ td[3][s2 & 0xff];
+        uint32_t t2 = loadBE32(k + 8) ^ td[0][s2 >> 24] ^ td[1][s1 >> 16 & 0xff] ^ td[2][s0 >> 8 & 0xff] ^ td[3][s3 & 0xff];
+        uint32_t t3 = loadBE32(k + 12) ^ td[0][s3 >> 24] ^ td[1][s2 >> 16 & 0xff] ^ td[2][s1 >> 8 & 0xff] ^ td[3][s0 & 0xff];
+        s0 = t0, s1 = t1, s2 = t2, s3 = t3;
+    }
+    k += 16;
+#define LAST(a, b, c, d) \
+    ((uint32_t)isbox[(a) >> 24] << 24 | (uint32_t)isbox[(b) >> 16 & 0xff] << 16 | (uint32_t)isbox[(c) >> 8 & 0xff] << 8 | isbox[(d) & 0xff])
+    storeBE32(dst, LAST(s0, s3, s2, s1) ^ loadBE32(k));
+    storeBE32(dst + 4, LAST(s1, s0, s3, s2) ^ loadBE32(k + 4));
+    storeBE32(dst + 8, LAST(s2, s1, s0, s3) ^ loadBE32(k + 8));
+    storeBE32(dst + 12, LAST(s3, s2, s1, s0) ^ loadBE32(k + 12));
+#undef LAST
+}
+
+static void ctrGeneric(const llgoAES *c, uint8_t ctr[16], uint8_t *dst, const uint8_t *src, size_t n) {
+    uint8_t ks[16];
+    for (; n > 0;) {
+        encryptGeneric(c, ks, ctr);
+        storeBE32(ctr + 12, loadBE32(ctr + 12) + 1);
+        size_t m = n < 16 ? n : 16;
+        for (size_t i = 0; i < m; i++) {
+            dst[i] = src[i] ^ ks[i];
+        }
+        dst += m, src += m, n -= m;
+    }
+}
+
+#if ACCEL_X86
+
+#define AES_TARGET __attribute__((target("aes,sse4.1")))
+
+AES_TARGET
+static void encryptHW(const llgoAES *c, uint8_t *dst, const uint8_t *src, size_t n) {
+    const __m128i *k = (const __m128i *)c->enc;
+    int r = c->rounds;
+    for (; n >= 16; dst += 16, src += 16, n -= 16) {
+        __m128i s = _mm_xor_si128(_mm_loadu_si128((const __m128i *)src), _mm_loadu_si128(k));
+        for (int i = 1; i < r; i++) {
+            s = _mm_aesenc_si128(s, _mm_loadu_si128(k + i));
+        }
+        _mm_storeu_si128((__m128i *)dst, _mm_aesenclast_si128(s, _mm_loadu_si128(k + r)));
+    }
+}
+
+AES_TARGET
+static void decryptHW(const llgoAES *c, uint8_t *dst, const uint8_t *src, size_t n) {
+    const __m128i *k = (const __m128i *)c->dec;
+    int r = c->rounds;
+    for (; n >= 16; dst += 16, src += 16, n -= 16) {
+        __m128i s = _mm_xor_si128(_mm_loadu_si128((const __m128i *)src), _mm_loadu_si128(k));
+        for (int i = 1; i < r; i++) {
+            s = _mm_aesdec_si128(s, _mm_loadu_si128(k + i));
+        }
+        _mm_storeu_si128((__m128i *)dst, _mm_aesdeclast_si128(s, _mm_loadu_si128(k + r)));
+    }
+}
+
+AES_TARGET
+static void ctrHW(const llgoAES *c, uint8_t ctr[16], uint8_t *dst, const uint8_t *src, size_t n) {
+    const __m128i *k = (const __m128i *)c->enc;
+    int r = c->rounds;
+    uint32_t next = loadBE32(ctr + 12);
+    for (; n >= 64; dst += 64, src += 64, n -= 64) {
+        __m128i s[4];
+        for (int j = 0; j < 4; j++) {
+            storeBE32(ctr + 12, next++);
+            s[j] = _mm_xor_si128(_mm_loadu_si128((const __m128i *)ctr), _mm_loadu_si128(k));
+        }
+        for (int i = 1; i < r; i++) {
+            __m128i ki = _mm_loadu_si128(k + i);
+            for (int j = 0; j < 4; j++) {
+                s[j] = _mm_aesenc_si128(s[j], ki);
+            }
+        }
+        __m128i kr = _mm_loadu_si128(k + r);
+        for (int j = 0; j < 4; j++) {
+            __m128i in = _mm_loadu_si128((const __m128i *)(src + 16 * j));
+            _mm_storeu_si128((__m128i *)(dst + 16 * j), _mm_xor_si128(in, _mm_aesenclast_si128(s[j], kr)));
+        }
+    }
+    storeBE32(ctr + 12, next);
+    for (; n > 0;) {
+        uint8_t ks[16];
+        encryptHW(c, ks, ctr, 16);
+        storeBE32(ctr + 12, ++next);
+        size_t m = n < 16 ? n : 16;
+        for (size_t i = 0; i < m; i++) {
+            dst[i] = src[i] ^ ks[i];
+        }
+        dst += m, src += m, n -= m;
+    }
+}
+
+#elif ACCEL_ARM64
+
+#define AES_TARGET __attribute__((target("aes")))
+
+AES_TARGET
+static inline uint8x16_t encryptBlockHW(const uint8_t *k, int r, uint8x16_t s) {
+    for (int i = 0; i < r - 1; i++) {
+        s = vaesmcq_u8(vaeseq_u8(s, vld1q_u8(k + 16 * i)));
+    }
+    s = vaeseq_u8(s, vld1q_u8(k + 16 * (r - 1)));
+    return veorq_u8(s, vld1q_u8(k + 16 * r));
+}
+
+AES_TARGET
+static void encryptHW(const llgoAES *c, uint8_t *dst, const uint8_t *src, size_t n) {
+    for (; n >= 16; dst += 16, src += 16, n -= 16) {
+        vst1q_u8(dst, encryptBlockHW(c->enc, c->rounds, vld1q_u8(src)));
+    }
+}
+
+AES_TARGET
+static void decryptHW(const llgoAES *c, uint8_t *dst, const uint8_t *src, size_t n) {
+    const uint8_t *k = c->dec;
+    int r = c->rounds;
+    for (; n >= 16; dst += 16, src += 16, n -= 16) {
+        uint8x16_t s = vld1q_u8(src);
+        for (int i = 0; i < r - 1; i++) {
+            s = vaesimcq_u8(vaesdq_u8(s, vld1q_u8(k + 16 * i)));
+        }
+        s = vaesdq_u8(s, vld1q_u8(k + 16 * (r - 1)));
+        vst1q_u8(dst, veorq_u8(s, vld1q_u8(k + 16 * r)));
+    }
+}
+
+AES_TARGET
+static void ctrHW(const llgoAES *c, uint8_t ctr[16], uint8_t *dst, const uint8_t *src, size_t n) {
+    const uint8_t *k = c->enc;
+    int r = c->rounds;
+    uint32_t next = loadBE32(ctr + 12);
+    for (; n >= 64; dst += 64, src += 64, n -= 64) {
+        uint8x16_t s[4];
+        for (int j = 0; j < 4; j++) {
+            storeBE32(ctr + 12, next++);
+            s[j] = vld1q_u8(ctr);
+        }
+        for (int i = 0; i < r - 1; i++) {
+            uint8x16_t ki = vld1q_u8(k + 16 * i);
+            for (int j = 0; j < 4; j++) {
+                s[j] = vaesmcq_u8(vaeseq_u8(s[j], ki));
+            }
+        }
+        uint8x16_t kl = vld1q_u8(k + 16 * (r - 1)), kr = vld1q_u8(k + 16 * r);
+        for (int j = 0; j < 4; j++) {
+            uint8x16_t ks = veorq_u8(vaeseq_u8(s[j], kl), kr);
+            vst1q_u8(dst + 16 * j, veorq_u8(vld1q_u8(src + 16 * j), ks));
+        }
+    }
+    storeBE32(ctr + 12, next);
+    for (; n > 0;) {
+        uint8_t ks[16];
+        encryptHW(c, ks, ctr, 16);
+        storeBE32(ctr + 12, ++next);
+        size_t m = n < 16 ? n : 16;
+        for (size_t i = 0; i < m; i++) {
+            dst[i] = src[i] ^ ks[i];
+        }
+        dst += m, src += m, n -= m;
+    }
+}
+
+#endif
+
+// llgoAESEncrypt encrypts the blocks of src, n being a multiple of 16.
+void llgoAESEncrypt(const llgoAES *c, uint8_t *dst, const uint8_t *src, size_t n) {
+#if ACCEL_X86 || ACCEL_ARM64
+    if (llgoHasAES) {
+        encryptHW(c, dst, src, n);
+        return;
+    }
+#endif
+    for (; n >= 16; dst += 16, src += 16, n -= 16) {
+        encryptGeneric(c, dst, src);
+    }
+}
+
+// llgoAESDecrypt decrypts the blocks of src, n being a multiple of 16.
+void llgoAESDecrypt(const llgoAES *c, uint8_t *dst, const uint8_t *src, size_t n) {
+#if ACCEL_X86 || ACCEL_ARM64
+    if (llgoHasAES) {
+        decryptHW(c, dst, src, n);
+        return;
+    }
+#endif
+    for (; n >= 16; dst += 16, src += 16, n -= 16) {
+        decryptGeneric(c, dst, src);
+    }
+}
+
+// llgoAESCTR32 XORs src with the key stream of the counter block ctr, whose
+// last 4 bytes are incremented as a big-endian number, like in GCM. ctr is
+// advanced past the blocks used, the last one possibly in part.
+void llgoAESCTR32(const llgoAES *c, uint8_t ctr[16], uint8_t *dst, const uint8_t *src, size_t n) {
+#if ACCEL_X86 || ACCEL_ARM64
+    if (llgoHasAES) {
+        ctrHW(c, ctr, dst, src, n);
+        return;
+    }
+#endif
+    ctrGeneric(c, ctr, dst, src, n);
+}
+
+// -----------------------------------------------------------------------------
+
+// GHASH multiplies by H in GF(2^128) by carry-less multiplication, with
+// PCLMULQDQ or PMULL, on byte-reversed blocks, or by the portable code of Go,
+// which multiplies with integer multiplications, in constant time.
+
+static uint64_t ghashMul(uint32_t x, uint32_t y) {
+    uint32_t xm[4], ym[4];
+    uint64_t z[4];
+    for (int i = 0; i < 4; i++) {
+        xm[i] = x & (0x11111111u << i);
+        ym[i] = y & (0x11111111u << i);
+    }
+    for (int i = 0; i < 4; i++) {
+        z[i] = ((uint64_t)xm[0] * ym[i]) ^ ((uint64_t)xm[1] * ym[(i + 3) % 4]) ^
+               ((uint64_t)xm[2] * ym[(i + 2) % 4]) ^ ((uint64_t)xm[3] * ym[(i + 1) % 4]);
+        z[i] &= 0x1111111111111111ull << i;
+    }
+    return z[0] | z[1] | z[2] | z[3];
+}
+
+static void ghashGeneric(const uint8_t H[16], uint8_t out[16], const uint8_t *p, size_t n) {
+    uint32_t y[4], h[4];
+    for (int i = 0; i < 4; i++) {
+        h[3 - i] = loadBE32(H + 4 * i);
+        y[3 - i] = loadBE32(out + 4 * i);
+    }
+    for (; n >= 16; p += 16, n -= 16) {
+        for (int i = 0; i < 4; i++) {
+            y[3 - i] ^= loadBE32(p + 4 * i);
+        }
+        uint64_t zLo[3], zHi[3], zSum[3];
+        zLo[0] = ghashMul(y[0], h[0]);
+        zHi[0] = ghashMul(y[1], h[1]);
+        zSum[0] = ghashMul(y[0] ^ y[1], h[0] ^ h[1]);
+        zLo[1] = ghashMul(y[2], h[2]);
+        zHi[1] = ghashMul(y[3], h[3]);
+        zSum[1] = ghashMul(y[2] ^ y[3], h[2] ^ h[3]);
+        zLo[2] = ghashMul(y[0] ^ y[2], h[0] ^ h[2]);
+        zHi[2] = ghashMul(y[1] ^ y[3], h[1] ^ h[3]);
+        zSum[2] = ghashMul((y[0] ^ y[2]) ^ (y[1] ^ y[3]), (h[0] ^ h[2]) ^ (h[1] ^ h[3]));
+
+        uint64_t res[3][2];
+        for (int i = 0; i < 3; i++) {
+            uint64_t mid = zSum[i] ^ zLo[i] ^ zHi[i];
+            res[i][0] = zLo[i] ^ (mid << 32);
+            res[i][1] = zHi[i] ^ (mid >> 32);
+        }
+        res[2][0] ^= res[0][0] ^ res[1][0];
+        res[2][1] ^= res[0][1] ^ res[1][1];
+        res[0][1] ^= res[2][0];
+        res[1][0] ^= res[2][1];
+
+        uint64_t z[4];
+        z[0] = res[0][0] << 1;
+        z[1] = (res[0][1] << 1) | (res[0][0] >> 63);
+        z[2] = (res[1][0] << 1) | (res[0][1] >> 63);
+        z[3] = (res[1][1] << 1) | (res[1][0] >> 63);
+        for (int i = 0; i < 2; i++) {
+            uint64_t lw = z[i];
+            z[i + 2] ^= lw ^ (lw >> 1) ^ (lw >> 2) ^ (lw >> 7);
+            z[i + 1] ^= (lw << 63) ^ (lw << 62) ^ (lw << 57);
+        }
+        y[0] = (uint32_t)z[2], y[1] = (uint32_t)(z[2] >> 32);
+        y[2] = (uint32_t)z[3], y[3] = (uint32_t)(z[3] >> 32);
+    }
+    for (int i = 0; i < 4; i++) {
+        storeBE32(out + 4 * i, y[3 - i]);
+    }
+}
+
+#if ACCEL_X86
+
+#define CLMUL_TARGET __attribute__((target("pclmul,ssse3")))
+
+// gfmul multiplies byte-reversed elements, as in the white paper of Intel on
+// carry-less multiplication for GCM: the product is shifted left by one bit,
+// then reduced.
+CLMUL_TARGET
+static __m128i gfmul(__m128i a, __m128i b) {
+    __m128i t3 = _mm_clmulepi64_si128(a, b, 0x00);
+    __m128i t4 = _mm_clmulepi64_si128(a, b, 0x10);
+    __m128i t5 = _mm_clmulepi64_si128(a, b, 0x01);
+    __m128i t6 = _mm_clmulepi64_si128(a, b, 0x11);
+    t4 = _mm_xor_si128(t4, t5);
+    t5 = _mm_slli_si128(t4, 8);
+    t4 = _mm_srli_si128(t4, 8);
+    t3 = _mm_xor_si128(t3, t5);
+    t6 = _mm_xor_si128(t6, t4);
+
+    __m128i t7 = _mm_srli_epi32(t3, 31);
+    __m128i t8 = _mm_srli_epi32(t6, 31);
+    t3 = _mm_slli_epi32(t3, 1);
+    t6 = _mm_slli_epi32(t6, 1);
+    __m128i t9 = _mm_srli_si128(t7, 12);
+    t8 = _mm_slli_si128(t8, 4);
+    t7 = _mm_slli_si128(t7, 4);
+    t3 = _mm_or_si128(t3, t7);
+    t6 = _mm_or_si128(t6, t8);
+    t6 = _mm_or_si128(t6, t9);
+
+    t7 = _mm_slli_epi32(t3, 31);
+    t8 = _mm_slli_epi32(t3, 30);
+    t9 = _mm_slli_epi32(t3, 25);
+    t7 = _mm_xor_si128(_mm_xor_si128(t7, t8), t9);
+    t8 = _mm_srli_si128(t7, 4);
+    t7 = _mm_slli_si128(t7, 12);
+    t3 = _mm_xor_si128(t3, t7);
+
+    __m128i t2 = _mm_srli_epi32(t3, 1);
+    t4 = _mm_srli_epi32(t3, 2);
+    t5 = _mm_srli_epi32(t3, 7);
+    t2 = _mm_xor_si128(_mm_xor_si128(t2, t4), _mm_xor_si128(t5, t8));
+    t3 = _mm_xor_si128(t3, t2);
+    return _mm_xor_si128(t6, t3);
+}
+
+CLMUL_TARGET
+static void ghashHW(const uint8_t H[16], uint8_t out[16], const uint8_t *p, size_t n) {
+    const __m128i rev = _mm_set_epi8(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15);
+    __m128i h = _mm_shuffle_epi8(_mm_loadu_si128((const __m128i *)H), rev);
+    __m128i y = _mm_shuffle_epi8(_mm_loadu_si128((const __m128i *)out), rev);
+    for (; n >= 16; p += 16, n -= 16) {
+        y = _mm_xor_si128(y, _mm_shuffle_epi8(_mm_loadu_si128((const __m128i *)p), rev));
+        y = gfmul(y, h);
+    }
+    _mm_storeu_si128((__m128i *)out, _mm_shuffle_epi8(y, rev));
+}
+
+#elif ACCEL_ARM64
+
+#define CLMUL_TARGET __attribute__((target("aes")))
+
+CLMUL_TARGET
+static inline uint8x16_t clmul(uint8x16_t a, int i, uint8x16_t b, int j) {
+    poly64_t x = vgetq_lane_p64(vreinterpretq_p64_u8(a), 0), y = vgetq_lane_p64(vreinterpretq_p64_u8(b), 0);
+    if (i) {
+        x = vgetq_lane_p64(vreinterpretq_p64_u8(a), 1);
+    }
+    if (j) {
+        y = vgetq_lane_p64(vreinterpretq_p64_u8(b), 1);
+    }
+    return vreinterpretq_u8_p128(vmull_p64(x, y));
+}
+
+// The steps of gfmul for x86, with the byte shifts of SSE made by vext.
+#define SHL_BYTES(x, n) vextq_u8(vdupq_n_u8(0), (x), 16 - (n))
+#define SHR_BYTES(x, n) vextq_u8((x), vdupq_n_u8(0), (n))
+#define U32(x) vreinterpretq_u32_u8(x)
+#define U8(x) vreinterpretq_u8_u32(x)
+
+CLMUL_TARGET
+static uint8x16_t gfmul(uint8x16_t a, uint8x16_t b) {
+    uint8x16_t t3 = clmul(a, 0, b, 0);
+    uint8x16_t t4 = clmul(a, 0, b, 1);
+    uint8x16_t t5 = clmul(a, 1, b, 0);
+    uint8x16_t t6 = clmul(a, 1, b, 1);
+    t4 = veorq_u8(t4, t5);
+    t5 = SHL_BYTES(t4, 8);
+    t4 = SHR_BYTES(t4, 8);
+    t3 = veorq_u8(t3, t5);
+    t6 = veorq_u8(t6, t4);
+
+    uint8x16_t t7 = U8(vshrq_n_u32(U32(t3), 31));
+    uint8x16_t t8 = U8(vshrq_n_u32(U32(t6), 31));
+    t3 = U8(vshlq_n_u32(U32(t3), 1));
+    t6 = U8(vshlq_n_u32(U32(t6), 1));
+    uint8x16_t t9 = SHR_BYTES(t7, 12);
+    t8 = SHL_BYTES(t8, 4);
+    t7 = SHL_BYTES(t7, 4);
+    t3 = vorrq_u8(t3, t7);
+    t6 = vorrq_u8(t6, t8);
+    t6 = vorrq_u8(t6, t9);
+
+    t7 = U8(vshlq_n_u32(U32(t3), 31));
+    t8 = U8(vshlq_n_u32(U32(t3), 30));
+    t9 = U8(vshlq_n_u32(U32(t3), 25));
+    t7 = veorq_u8(veorq_u8(t7, t8), t9);
+    t8 = SHR_BYTES(t7, 4);
+    t7 = SHL_BYTES(t7, 12);
+    t3 = veorq_u8(t3, t7);
+
+    uint8x16_t t2 = U8(vshrq_n_u32(U32(t3), 1));
+    t4 = U8(vshrq_n_u32(U32(t3), 2));
+    t5 = U8(vshrq_n_u32(U32(t3), 7));
+    t2 = veorq_u8(veorq_u8(t2, t4), veorq_u8(t5, t8));
+    t3 = veorq_u8(t3, t2);
+    return veorq_u8(t6, t3);
+}
+
+CLMUL_TARGET
+static inline uint8x16_t rev128(uint8x16_t x) {
+    x = vrev64q_u8(x);
+    return vextq_u8(x, x, 8);
+}
+
+CLMUL_TARGET
+static void ghashHW(const uint8_t H[16], uint8_t out[16], const uint8_t *p, size_t n) {
+    uint8x16_t h = rev128(vld1q_u8(H)), y = rev128(vld1q_u8(out));
+    for (; n >= 16; p += 16, n -= 16) {
+        y = gfmul(veorq_u8(y, rev128(vld1q_u8(p))), h);
+    }
+    vst1q_u8(out, rev128(y));
+}
+
+#endif
+
+// llgoGHASH updates the hash y of GHASH with key H by the blocks of p, n
+// being a multiple of 16.
+void llgoGHASH(const uint8_t H[16], uint8_t y[16], const uint8_t *p, size_t n) {
+#if ACCEL_X86 || ACCEL_ARM64
+    if (llgoHasCLMUL) {
+        ghashHW(H, y, p, n);
+        return;
+    }
+#endif
+    ghashGeneric(H, y, p, n);
+}
+
+// -----------------------------------------------------------------------------
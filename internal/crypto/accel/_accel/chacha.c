@@ -0,0 +1,317 @@
+#include <string.h>
+
+#include "accel.h"
+
+// -----------------------------------------------------------------------------
+
+// ChaCha20 computes four blocks at a time with the vector extensions of the
+// compiler, each lane of a vector holding a word of a block: they are SSE2
+// or NEON instructions, which all the targets of x86-64 and arm64 have, so
+// no feature is detected. The last blocks are computed one at a time.
+
+typedef uint32_t u32x4 __attribute__((vector_size(16)));
+
+#define ROTL(x, n) ((x) << (n) | (x) >> (32 - (n)))
+
+#define QR(a, b, c, d)          \
+    a += b, d ^= a, d = ROTL(d, 16); \
+    c += d, b ^= c, b = ROTL(b, 12); \
+    a += b, d ^= a, d = ROTL(d, 8);  \
+    c += d, b ^= c, b = ROTL(b, 7)
+
+#define DOUBLE_ROUND(x)                 \
+    QR(x[0], x[4], x[8], x[12]);        \
+    QR(x[1], x[5], x[9], x[13]);        \
+    QR(x[2], x[6], x[10], x[14]);       \
+    QR(x[3], x[7], x[11], x[15]);       \
+    QR(x[0], x[5], x[10], x[15]);       \
+    QR(x[1], x[6], x[11], x[12]);       \
+    QR(x[2], x[7], x[8], x[13]);        \
+    QR(x[3], x[4], x[9], x[14])
+
+static void initState(uint32_t s[16], const uint8_t key[32], const uint8_t nonce[12], uint32_t counter) {
+    s[0] = 0x61707865;
+    s[1] = 0x3320646e;
+    s[2] = 0x79622d32;
+    s[3] = 0x6b206574;
+    for (int i = 0; i < 8; i++) {
+        s[4 + i] = loadLE32(key + 4 * i);
+    }
+    s[12] = counter;
+    for (int i = 0; i < 3; i++) {
+        s[13 + i] = loadLE32(nonce + 4 * i);
+    }
+}
+
+static void block(const uint32_t s[16], uint8_t out[64]) {
+    uint32_t x[16];
+    memcpy(x, s, sizeof(x));
+    for (int i = 0; i < 10; i++) {
+        DOUBLE_ROUND(x);
+    }
+    for (int i = 0; i < 16; i++) {
+        storeLE32(out + 4 * i, x[i] + s[i]);
+    }
+}
+
+// llgoChaCha20 XORs src with the key stream of ChaCha20 (RFC 8439) from the
+// block counter.
+void llgoChaCha20(const uint8_t key[32], const uint8_t nonce[12], uint32_t counter, uint8_t *dst,
+                  const uint8_t *src, size_t n) {
+    uint32_t s[16];
+    initState(s, key, nonce, counter);
+    for (; n >= 256; dst += 256, src += 256, n -= 256) {
+        u32x4 x[16], in[16];
+        for (int i = 0; i < 16; i++) {
+            in[i] = (u32x4){s[i], s[i], s[i], s[i]};
+        }
+        in[12] += (u32x4){0, 1, 2, 3};
+        memcpy(x, in, sizeof(x));
+        for (int i = 0; i < 10; i++) {
+            DOUBLE_ROUND(x);
+        }
+        for (int i = 0; i < 16; i++) {
+            x[i] += in[i];
+        }
+        for (int j = 0; j < 4; j++) {
+            for (int i = 0; i < 16; i++) {
+                const uint8_t *p = src + 64 * j + 4 * i;
+                storeLE32(dst + 64 * j + 4 * i, loadLE32(p) ^ x[i][j]);
+            }
+        }
+        s[12] += 4;
+    }
+    uint8_t ks[64];
+    for (; n > 0;) {
+        block(s, ks);
+        s[12]++;
+        size_t m = n < 64 ? n : 64;
+        for (size_t i = 0; i < m; i++) {
+            dst[i] = src[i] ^ ks[i];
+        }
+        dst += m, src += m, n -= m;
+    }
+}
+
+// llgoHChaCha20 derives the subkey of XChaCha20 from the key and the first 16
+// bytes of its nonce.
+void llgoHChaCha20(uint8_t out[32], const uint8_t key[32], const uint8_t nonce[16]) {
+    uint32_t x[16];
+    initState(x, key, nonce + 4, loadLE32(nonce));
+    for (int i = 0; i < 10; i++) {
+        DOUBLE_ROUND(x);
+    }
+    for (int i = 0; i < 4; i++) {
+        storeLE32(out + 4 * i, x[i]);
+        storeLE32(out + 16 + 4 * i, x[12 + i]);
+    }
+}
+
+// -----------------------------------------------------------------------------
+
+// Poly1305 is computed with limbs of 44 bits multiplied into 128 bits where
+// the compiler has them, or of 26 bits multiplied into 64 bits, like in
+// poly1305-donna.
+
+typedef struct {
+#ifdef __SIZEOF_INT128__
+    uint64_t r[3], h[3];
+#else
+    uint32_t r[5], h[5];
+#endif
+} poly1305;
+
+#ifdef __SIZEOF_INT128__
+
+#define M44 0xfffffffffffULL
+#define M42 0x3ffffffffffULL
+
+static void polyInit(poly1305 *st, const uint8_t key[32]) {
+    uint64_t t0 = loadLE64(key), t1 = loadLE64(key + 8);
+    st->r[0] = t0 & 0xffc0fffffffULL;
+    st->r[1] = ((t0 >> 44) | (t1 << 20)) & 0xfffffc0ffffULL;
+    st->r[2] = (t1 >> 24) & 0x00ffffffc0fULL;
+    st->h[0] = st->h[1] = st->h[2] = 0;
+}
+
+static void polyBlocks(poly1305 *st, const uint8_t *m, size_t n, uint64_t hibit) {
+    typedef unsigned __int128 u128;
+    uint64_t r0 = st->r[0], r1 = st->r[1], r2 = st->r[2];
+    uint64_t s1 = r1 * (5 << 2), s2 = r2 * (5 << 2);
+    uint64_t h0 = st->h[0], h1 = st->h[1], h2 = st->h[2];
+    for (; n >= 16; m += 16, n -= 16) {
+        uint64_t t0 = loadLE64(m), t1 = loadLE64(m + 8);
+        h0 += t0 & M44;
+        h1 += ((t0 >> 44) | (t1 << 20)) & M44;
+        h2 += ((t1 >> 24) & M42) | hibit;
+
+        u128 d0 = (u128)h0 * r0 + (u128)h1 * s2 + (u128)h2 * s1;
+        u128 d1 = (u128)h0 * r1 + (u128)h1 * r0 + (u128)h2 * s2;
+        u128 d2 = (u128)h0 * r2 + (u128)h1 * r1 + (u128)h2 * r0;
+
+        uint64_t c = (uint64_t)(d0 >> 44);
+        h0 = (uint64_t)d0 & M44;
+        d1 += c;
+        c = (uint64_t)(d1 >> 44);
+        h1 = (uint64_t)d1 & M44;
+        d2 += c;
+        c = (uint64_t)(d2 >> 42);
+        h2 = (uint64_t)d2 & M42;
+        h0 += c * 5;
+        c = h0 >> 44;
+        h0 &= M44;
+        h1 += c;
+    }
+    st->h[0] = h0, st->h[1] = h1, st->h[2] = h2;
+}
+
+static void polyFinish(poly1305 *st, const uint8_t key[32], uint8_t tag[16]) {
+    uint64_t h0 = st->h[0], h1 = st->h[1], h2 = st->h[2], c;
+    c = h1 >> 44, h1 &= M44, h2 += c;
+    c = h2 >> 42, h2 &= M42, h0 += c * 5;
+    c = h0 >> 44, h0 &= M44, h1 += c;
+    c = h1 >> 44, h1 &= M44, h2 += c;
+    c = h2 >> 42, h2 &= M42, h0 += c * 5;
+    c = h0 >> 44, h0 &= M44, h1 += c;
+
+    // h - p, kept if h >= p, in constant time
+    uint64_t g0 = h0 + 5;
+    c = g0 >> 44, g0 &= M44;
+    uint64_t g1 = h1 + c;
+    c = g1 >> 44, g1 &= M44;
+    uint64_t g2 = h2 + c - (1ULL << 42);
+    c = (g2 >> 63) - 1;
+    g0 &= c, g1 &= c, g2 &= c;
+    c = ~c;
+    h0 = (h0 & c) | g0;
+    h1 = (h1 & c) | g1;
+    h2 = (h2 & c) | g2;
+
+    uint64_t t0 = loadLE64(key + 16), t1 = loadLE64(key + 24);
+    h0 += t0 & M44;
+    c = h0 >> 44, h0 &= M44;
+    h1 += (((t0 >> 44) | (t1 << 20)) & M44) + c;
+    c = h1 >> 44, h1 &= M44;
+    h2 += ((t1 >> 24) & M42) + c;
+    h2 &= M42;
+    storeLE64(tag, h0 | (h1 << 44));
+    storeLE64(tag + 8, (h1 >> 20) | (h2 << 24));
+}
+
+#define HIBIT (1ULL << 40)
+
+#else
+
+static void polyInit(poly1305 *st, const uint8_t key[32]) {
+    st->r[0] = loadLE32(key) & 0x3ffffff;
+    st->r[1] = (loadLE32(key + 3) >> 2) & 0x3ffff03;
+    st->r[2] = (loadLE32(key + 6) >> 4) & 0x3ffc0ff;
+    st->r[3] = (loadLE32(key + 9) >> 6) & 0x3f03fff;
+    st->r[4] = (loadLE32(key + 12) >> 8) & 0x00fffff;
+    memset(st->h, 0, sizeof(st->h));
+}
+
+static void polyBlocks(poly1305 *st, const uint8_t *m, size_t n, uint32_t hibit) {
+    uint32_t r0 = st->r[0], r1 = st->r[1], r2 = st->r[2], r3 = st->r[3], r4 = st->r[4];
+    uint32_t s1 = r1 * 5, s2 = r2 * 5, s3 = r3 * 5, s4 = r4 * 5;
+    uint32_t h0 = st->h[0], h1 = st->h[1], h2 = st->h[2], h3 = st->h[3], h4 = st->h[4];
+    for (; n >= 16; m += 16, n -= 16) {
+        h0 += loadLE32(m) & 0x3ffffff;
+        h1 += (loadLE32(m + 3) >> 2) & 0x3ffffff;
+        h2 += (loadLE32(m + 6) >> 4) & 0x3ffffff;
+        h3 += (loadLE32(m + 9) >> 6) & 0x3ffffff;
+        h4 += (loadLE32(m + 12) >> 8) | hibit;
+
+        uint64_t d0 = (uint64_t)h0 * r0 + (uint64_t)h1 * s4 + (uint64_t)h2 * s3 + (uint64_t)h3 * s2 + (uint64_t)h4 * s1;
+        uint64_t d1 = (uint64_t)h0 * r1 + (uint64_t)h1 * r0 + (uint64_t)h2 * s4 + (uint64_t)h3 * s3 + (uint64_t)h4 * s2;
+        uint64_t d2 = (uint64_t)h0 * r2 + (uint64_t)h1 * r1 + (uint64_t)h2 * r0 + (uint64_t)h3 * s4 + (uint64_t)h4 * s3;
+        uint64_t d3 = (uint64_t)h0 * r3 + (uint64_t)h1 * r2 + (uint64_t)h2 * r1 + (uint64_t)h3 * r0 + (uint64_t)h4 * s4;
+        uint64_t d4 = (uint64_t)h0 * r4 + (uint64_t)h1 * r3 + (uint64_t)h2 * r2 + (uint64_t)h3 * r1 + (uint64_t)h4 * r0;
+
+        uint32_t c = (uint32_t)(d0 >> 26);
+        h0 = (uint32_t)d0 & 0x3ffffff;
+        d1 += c, c = (uint32_t)(d1 >> 26), h1 = (uint32_t)d1 & 0x3ffffff;
+        d2 += c, c = (uint32_t)(d2 >> 26), h2 = (uint32_t)d2 & 0x3ffffff;
+        d3 += c, c = (uint32_t)(d3 >> 26), h3 = (uint32_t)d3 & 0x3ffffff;
+        d4 += c, c = (uint32_t)(d4 >> 26), h4 = (uint32_t)d4 & 0x3ffffff;
+        h0 += c * 5, c = h0 >> 26, h0 &= 0x3ffffff;
+        h1 += c;
+    }
+    st->h[0] = h0, st->h[1] = h1, st->h[2] = h2, st->h[3] = h3, st->h[4] = h4;
+}
+
+static void polyFinish(poly1305 *st, const uint8_t key[32], uint8_t tag[16]) {
+    uint32_t h0 = st->h[0], h1 = st->h[1], h2 = st->h[2], h3 = st->h[3], h4 = st->h[4], c;
+    c = h1 >> 26, h1 &= 0x3ffffff;
+    h2 += c, c = h2 >> 26, h2 &= 0x3ffffff;
+    h3 += c, c = h3 >> 26, h3 &= 0x3ffffff;
+    h4 += c, c = h4 >> 26, h4 &= 0x3ffffff;
+    h0 += c * 5, c = h0 >> 26, h0 &= 0x3ffffff;
+    h1 += c;
+
+    // h - p, kept if h >= p, in constant time
+    uint32_t g0 = h0 + 5, g1, g2, g3, g4;
+    c = g0 >> 26, g0 &= 0x3ffffff;
+    g1 = h1 + c, c = g1 >> 26, g1 &= 0x3ffffff;
+    g2 = h2 + c, c = g2 >> 26, g2 &= 0x3ffffff;
+    g3 = h3 + c, c = g3 >> 26, g3 &= 0x3ffffff;
+    g4 = h4 + c - (1UL << 26);
+    uint32_t mask = (g4 >> 31) - 1;
+    g0 &= mask, g1 &= mask, g2 &= mask, g3 &= mask, g4 &= mask;
+    mask = ~mask;
+    h0 = (h0 & mask) | g0;
+    h1 = (h1 & mask) | g1;
+    h2 = (h2 & mask) | g2;
+    h3 = (h3 & mask) | g3;
+    h4 = (h4 & mask) | g4;
+
+    h0 = (h0 | (h1 << 26));
+    h1 = ((h1 >> 6) | (h2 << 20));
+    h2 = ((h2 >> 12) | (h3 << 14));
+    h3 = ((h3 >> 18) | (h4 << 8));
+
+    uint64_t f = (uint64_t)h0 + loadLE32(key + 16);
+    h0 = (uint32_t)f;
+    f = (uint64_t)h1 + loadLE32(key + 20) + (f >> 32);
+    h1 = (uint32_t)f;
+    f = (uint64_t)h2 + loadLE32(key + 24) + (f >> 32);
+    h2 = (uint32_t)f;
+    f = (uint64_t)h3 + loadLE32(key + 28) + (f >> 32);
+    h3 = (uint32_t)f;
+    storeLE32(tag, h0);
+    storeLE32(tag + 4, h1);
+    storeLE32(tag + 8, h2);
+    storeLE32(tag + 12, h3);
+}
+
+#define HIBIT (1UL << 24)
+
+#endif
+
+// polyPadded adds p, zero-padded to a multiple of 16 bytes.
+static void polyPadded(poly1305 *st, const uint8_t *p, size_t n) {
+    size_t full = n & ~(size_t)15;
+    polyBlocks(st, p, full, HIBIT);
+    if (n > full) {
+        uint8_t last[16] = {0};
+        memcpy(last, p + full, n - full);
+        polyBlocks(st, last, 16, HIBIT);
+    }
+}
+
+// llgoPoly1305AEAD computes the tag of ChaCha20-Poly1305 (RFC 8439) over the
+// additional data and the ciphertext, with the one-time key.
+void llgoPoly1305AEAD(uint8_t tag[16], const uint8_t key[32], const uint8_t *ad, size_t nad,
+                      const uint8_t *ct, size_t nct) {
+    poly1305 st;
+    polyInit(&st, key);
+    polyPadded(&st, ad, nad);
+    polyPadded(&st, ct, nct);
+    uint8_t lens[16];
+    storeLE64(lens, nad);
+    storeLE64(lens + 8, nct);
+    polyBlocks(&st, lens, 16, HIBIT);
+    polyFinish(&st, key, tag);
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,76 @@
+#include <stdlib.h>
+#include <string.h>
+
+#if defined(__x86_64__) || defined(__i386__)
+#include <cpuid.h>
+#elif defined(__aarch64__) && defined(__linux__)
+#include <sys/auxv.h>
+#endif
+
+#include "accel.h"
+
+// -----------------------------------------------------------------------------
+
+// The instructions the fast paths need are detected once, before main: by
+// cpuid on x86, by the hardware capabilities the kernel reports on Linux
+// arm64, and are always there on Apple silicon. Like in Go, they can be
+// turned off by GODEBUG=cpu.<name>=off or cpu.all=off, with the names of Go.
+
+int llgoHasAES;   // AES-NI or ARMv8 AES
+int llgoHasCLMUL; // PCLMULQDQ or PMULL
+int llgoHasSHA2;  // SHA extensions or ARMv8 SHA2
+
+// cpuOff reports whether GODEBUG turns the feature off.
+static int cpuOff(const char *godebug, const char *name) {
+    size_t n = strlen(name);
+    for (const char *p = godebug; p && *p;) {
+        const char *end = strchr(p, ',');
+        size_t len = end ? (size_t)(end - p) : strlen(p);
+        if (len > 4 && strncmp(p, "cpu.", 4) == 0) {
+            const char *opt = p + 4;
+            size_t optLen = len - 4;
+            if ((optLen == n + 4 && strncmp(opt, name, n) == 0 && strncmp(opt + n, "=off", 4) == 0) ||
+                (optLen == 7 && strncmp(opt, "all=off", 7) == 0)) {
+                return 1;
+            }
+        }
+        p = end ? end + 1 : NULL;
+    }
+    return 0;
+}
+
+__attribute__((constructor))
+static void cpuInit(void) {
+    const char *godebug = getenv("GODEBUG");
+#if defined(__x86_64__) || defined(__i386__)
+    unsigned a, b, c, d;
+    if (!__get_cpuid(1, &a, &b, &c, &d)) {
+        return;
+    }
+    int ssse3 = (c >> 9) & 1, sse41 = (c >> 19) & 1;
+    llgoHasAES = ((c >> 25) & 1) && sse41 && !cpuOff(godebug, "aes");
+    llgoHasCLMUL = ((c >> 1) & 1) && ssse3 && !cpuOff(godebug, "pclmulqdq");
+    if (__get_cpuid_count(7, 0, &a, &b, &c, &d)) {
+        llgoHasSHA2 = ((b >> 29) & 1) && sse41 && !cpuOff(godebug, "sha");
+    }
+#elif defined(__aarch64__) && defined(__APPLE__)
+    llgoHasAES = !cpuOff(godebug, "aes");
+    llgoHasCLMUL = !cpuOff(godebug, "pmull");
+    llgoHasSHA2 = !cpuOff(godebug, "sha2");
+#elif defined(__aarch64__) && defined(__linux__)
+    unsigned long hwcap = getauxval(AT_HWCAP);
+    llgoHasAES = ((hwcap >> 3) & 1) && !cpuOff(godebug, "aes");
+    llgoHasCLMUL = ((hwcap >> 4) & 1) && !cpuOff(godebug, "pmull");
+    llgoHasSHA2 = ((hwcap >> 6) & 1) && !cpuOff(godebug, "sha2");
+#else
+    (void)godebug;
+#endif
+}
+
+// llgoCPUFeatures reports the features the fast paths use, as bits: 1 for
+// AES, 2 for carry-less multiplication, 4 for SHA-256.
+int llgoCPUFeatures(void) {
+    return llgoHasAES | llgoHasCLMUL << 1 | llgoHasSHA2 << 2;
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,153 @@
+#include "accel.h"
+
+#if ACCEL_X86
+#include <immintrin.h>
+#elif ACCEL_ARM64
+#include <arm_neon.h>
+#endif
+
+// -----------------------------------------------------------------------------
+
+// SHA-256 blocks are hashed by the SHA extensions of x86, by the SHA2
+// instructions of ARMv8, or by portable code.
+
+static const uint32_t K[64] = {
+    0x428a2f98, 0x71374491, 0xb5c0fbcf, 0xe9b5dba5, 0x3956c25b, 0x59f111f1, 0x923f82a4, 0xab1c5ed5,
+    0xd807aa98, 0x12835b01, 0x243185be, 0x550c7dc3, 0x72be5d74, 0x80deb1fe, 0x9bdc06a7, 0xc19bf174,
+    0xe49b69c1, 0xefbe4786, 0x0fc19dc6, 0x240ca1cc, 0x2de92c6f, 0x4a7484aa, 0x5cb0a9dc, 0x76f988da,
+    0x983e5152, 0xa831c66d, 0xb00327c8, 0xbf597fc7, 0xc6e00bf3, 0xd5a79147, 0x06ca6351, 0x14292967,
+    0x27b70a85, 0x2e1b2138, 0x4d2c6dfc, 0x53380d13, 0x650a7354, 0x766a0abb, 0x81c2c92e, 0x92722c85,
+    0xa2bfe8a1, 0xa81a664b, 0xc24b8b70, 0xc76c51a3, 0xd192e819, 0xd6990624, 0xf40e3585, 0x106aa070,
+    0x19a4c116, 0x1e376c08, 0x2748774c, 0x34b0bcb5, 0x391c0cb3, 0x4ed8aa4a, 0x5b9cca4f, 0x682e6ff3,
+    0x748f82ee, 0x78a5636f, 0x84c87814, 0x8cc70208, 0x90befffa, 0xa4506ceb, 0xbef9a3f7, 0xc67178f2,
+};
+
+#define ROTR(x, n) ((x) >> (n) | (x) << (32 - (n)))
+
+static void blockGeneric(uint32_t h[8], const uint8_t *p, size_t n) {
+    uint32_t w[64];
+    for (; n >= 64; p += 64, n -= 64) {
+        for (int i = 0; i < 16; i++) {
+            w[i] = loadBE32(p + 4 * i);
+        }
+        for (int i = 16; i < 64; i++) {
+            uint32_t v1 = w[i - 2], v2 = w[i - 15];
+            uint32_t t1 = ROTR(v1, 17) ^ ROTR(v1, 19) ^ (v1 >> 10);
+            uint32_t t2 = ROTR(v2, 7) ^ ROTR(v2, 18) ^ (v2 >> 3);
+            w[i] = t1 + w[i - 7] + t2 + w[i - 16];
+        }
+        uint32_t a = h[0], b = h[1], c = h[2], d = h[3], e = h[4], f = h[5], g = h[6], hh = h[7];
+        for (int i = 0; i < 64; i++) {
+            uint32_t t1 = hh + (ROTR(e, 6) ^ ROTR(e, 11) ^ ROTR(e, 25)) + ((e & f) ^ (~e & g)) + K[i] + w[i];
+            uint32_t t2 = (ROTR(a, 2) ^ ROTR(a, 13) ^ ROTR(a, 22)) + ((a & b) ^ (a & c) ^ (b & c));
+            hh = g;
+            g = f;
+            f = e;
+            e = d + t1;
+            d = c;
+            c = b;
+            b = a;
+            a = t1 + t2;
+        }
+        h[0] += a;
+        h[1] += b;
+        h[2] += c;
+        h[3] += d;
+        h[4] += e;
+        h[5] += f;
+        h[6] += g;
+        h[7] += hh;
+    }
+}
+
+#if ACCEL_X86
+
+// The state is kept as ABEF and CDGH, the order of sha256rnds2. Each group
+// of four rounds also computes the message words of a later one.
+__attribute__((target("sha,sse4.1")))
+static void blockSHA(uint32_t h[8], const uint8_t *p, size_t n) {
+    const __m128i mask = _mm_set_epi64x(0x0c0d0e0f08090a0bULL, 0x0405060700010203ULL);
+    __m128i tmp = _mm_shuffle_epi32(_mm_loadu_si128((const __m128i *)&h[0]), 0xb1);  // CDAB
+    __m128i st1 = _mm_shuffle_epi32(_mm_loadu_si128((const __m128i *)&h[4]), 0x1b);  // EFGH
+    __m128i st0 = _mm_alignr_epi8(tmp, st1, 8);                                      // ABEF
+    st1 = _mm_blend_epi16(st1, tmp, 0xf0);                                           // CDGH
+    for (; n >= 64; p += 64, n -= 64) {
+        __m128i save0 = st0, save1 = st1;
+        __m128i w[4];
+        for (int g = 0; g < 16; g++) {
+            if (g < 4) {
+                w[g] = _mm_shuffle_epi8(_mm_loadu_si128((const __m128i *)(p + 16 * g)), mask);
+            }
+            __m128i cur = w[g % 4];
+            __m128i msg = _mm_add_epi32(cur, _mm_loadu_si128((const __m128i *)&K[4 * g]));
+            st1 = _mm_sha256rnds2_epu32(st1, st0, msg);
+            if (g >= 3 && g < 15) {
+                __m128i *next = &w[(g + 1) % 4];
+                *next = _mm_add_epi32(*next, _mm_alignr_epi8(cur, w[(g + 3) % 4], 4));
+                *next = _mm_sha256msg2_epu32(*next, cur);
+            }
+            st0 = _mm_sha256rnds2_epu32(st0, st1, _mm_shuffle_epi32(msg, 0x0e));
+            if (g >= 1 && g < 13) {
+                w[(g + 3) % 4] = _mm_sha256msg1_epu32(w[(g + 3) % 4], cur);
+            }
+        }
+        st0 = _mm_add_epi32(st0, save0);
+        st1 = _mm_add_epi32(st1, save1);
+    }
+    tmp = _mm_shuffle_epi32(st0, 0x1b);       // FEBA
+    st1 = _mm_shuffle_epi32(st1, 0xb1);       // DCHG
+    st0 = _mm_blend_epi16(tmp, st1, 0xf0);    // DCBA
+    st1 = _mm_alignr_epi8(st1, tmp, 8);       // ABEF
+    _mm_storeu_si128((__m128i *)&h[0], st0);
+    _mm_storeu_si128((__m128i *)&h[4], st1);
+}
+
+#elif ACCEL_ARM64
+
+__attribute__((target("sha2")))
+static void blockSHA(uint32_t h[8], const uint8_t *p, size_t n) {
+    uint32x4_t st0 = vld1q_u32(&h[0]), st1 = vld1q_u32(&h[4]);
+    for (; n >= 64; p += 64, n -= 64) {
+        uint32x4_t save0 = st0, save1 = st1;
+        uint32x4_t w[4];
+        for (int i = 0; i < 4; i++) {
+            w[i] = vreinterpretq_u32_u8(vrev32q_u8(vld1q_u8(p + 16 * i)));
+        }
+        uint32x4_t msg = vaddq_u32(w[0], vld1q_u32(&K[0]));
+        for (int g = 0; g < 16; g++) {
+            uint32x4_t next = msg;
+            if (g < 12) {
+                w[g % 4] = vsha256su0q_u32(w[g % 4], w[(g + 1) % 4]);
+            }
+            uint32x4_t a = st0;
+            if (g < 15) {
+                next = vaddq_u32(w[(g + 1) % 4], vld1q_u32(&K[4 * (g + 1)]));
+            }
+            st0 = vsha256hq_u32(st0, st1, msg);
+            st1 = vsha256h2q_u32(st1, a, msg);
+            if (g < 12) {
+                w[g % 4] = vsha256su1q_u32(w[g % 4], w[(g + 2) % 4], w[(g + 3) % 4]);
+            }
+            msg = next;
+        }
+        st0 = vaddq_u32(st0, save0);
+        st1 = vaddq_u32(st1, save1);
+    }
+    vst1q_u32(&h[0], st0);
+    vst1q_u32(&h[4], st1);
+}
+
+#endif
+
+// llgoSHA256Block hashes the blocks of p, n being a multiple of 64, into h.
+void llgoSHA256Block(uint32_t h[8], const uint8_t *p, size_t n) {
+#if ACCEL_X86 || ACCEL_ARM64
+    if (llgoHasSHA2) {
+        blockSHA(h, p, n);
+        return;
+    }
+#endif
+    blockGeneric(h, p, n);
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package accel implements the primitives of the crypto packages which gain
+// most from instructions made for them: SHA-256 blocks, AES blocks, AES in
+// counter mode, GHASH, ChaCha20 and Poly1305. They are in C, which selects
+// the instructions of the CPU it runs on (see Features), with portable code
+// when they are missing, so that the binaries of llgo run on any CPU of
+// their architecture.
+package accel
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+const (
+	LLGoFiles   = "_accel/cpu.c; _accel/sha256.c; _accel/aes.c; _accel/chacha.c"
+	LLGoPackage = "link"
+)
+
+// -----------------------------------------------------------------------------
+
+// The features of the CPU used, as reported by Features.
+const (
+	FeatureAES   = 1 << iota // AES-NI or ARMv8 AES
+	FeatureCLMUL             // PCLMULQDQ or ARMv8 PMULL
+	FeatureSHA2              // SHA extensions or ARMv8 SHA2
+)
+
+// Features reports the features of the CPU used by the fast paths. Like in
+// Go, GODEBUG=cpu.<name>=off, with the name of a feature in Go, like aes,
+// pclmulqdq or pmull, or cpu.all=off turns them off when the program starts.
+func Features() int {
+	return int(cpuFeatures())
+}
+
+//go:linkname cpuFeatures C.llgoCPUFeatures
+func cpuFeatures() c.Int
+
+// -----------------------------------------------------------------------------
+
+//go:linkname sha256Block C.llgoSHA256Block
+func sha256Block(h *[8]uint32, p *byte, n uintptr)
+
+// SHA256Block hashes the blocks of p, whose length is a multiple of 64, into
+// the state h.
+func SHA256Block(h *[8]uint32, p []byte) {
+	sha256Block(h, unsafe.SliceData(p), uintptr(len(p)))
+}
+
+// -----------------------------------------------------------------------------
+
+// AES holds the round keys of an AES key.
+type AES struct {
+	enc    [240]byte
+	dec    [240]byte
+	rounds int32
+}
+
+//go:linkname aesExpand C.llgoAESExpand
+func aesExpand(k *AES, key *byte, n c.Int)
+
+//go:linkname aesEncrypt C.llgoAESEncrypt
+func aesEncrypt(k *AES, dst, src *byte, n uintptr)
+
+//go:linkname aesDecrypt C.llgoAESDecrypt
+func aesDecrypt(k *AES, dst, src *byte, n uintptr)
+
+//go:linkname aesCTR32 C.llgoAESCTR32
+func aesCTR32(k *AES, ctr *[16]byte, dst, src *byte, n uintptr)
+
+// Init expands key, of 16, 24 or 32 bytes.
+func (k *AES) Init(key []byte) {
+	aesExpand(k, unsafe.SliceData(key), c.Int(len(key)))
+}
+
+// Encrypt encrypts the blocks of src into dst, len(src) being a multiple of
+// 16.
+func (k *AES) Encrypt(dst, src []byte) {
+	aesEncrypt(k, unsafe.SliceData(dst), unsafe.SliceData(src), uintptr(len(src)))
+}
+
+// Decrypt decrypts the blocks of src into dst, len(src) being a multiple of
+// 16.
+func (k *AES) Decrypt(dst, src []byte) {
+	aesDecrypt(k, unsafe.SliceData(dst), unsafe.SliceData(src), uintptr(len(src)))
+}
+
+// CTR32 XORs src into dst with the key stream of the counter block ctr, whose
+// last 4 bytes are a big-endian counter, like in GCM. ctr is advanced past
+// the blocks used.
+func (k *AES) CTR32(ctr *[16]byte, dst, src []byte) {
+	aesCTR32(k, ctr, unsafe.SliceData(dst), unsafe.SliceData(src), uintptr(len(src)))
+}
+
+//go:linkname ghash C.llgoGHASH
+func ghash(h, y *[16]byte, p *byte, n uintptr)
+
+// GHASH updates the hash y of GHASH with the key h by the blocks of p, whose
+// length is a multiple of 16.
+func GHASH(h, y *[16]byte, p []byte) {
+	ghash(h, y, unsafe.SliceData(p), uintptr(len(p)))
+}
+
+// -----------------------------------------------------------------------------
+
+//go:linkname chacha20 C.llgoChaCha20
+func chacha20(key *[32]byte, nonce *[12]byte, counter uint32, dst, src *byte, n uintptr)
+
+//go:linkname hchacha20 C.llgoHChaCha20
+func hchacha20(out, key *[32]byte, nonce *[16]byte)
+
+//go:linkname poly1305AEAD C.llgoPoly1305AEAD
+func poly1305AEAD(tag *[16]byte, key *[32]byte, ad *byte, nad uintptr, ct *byte, nct uintptr)
+
+// ChaCha20 XORs src into dst with the key stream of ChaCha20 (RFC 8439)
+// from the block counter.
+func ChaCha20(key *[32]byte, nonce *[12]byte, counter uint32, dst, src []byte) {
+	chacha20(key, nonce, counter, unsafe.SliceData(dst), unsafe.SliceData(src), uintptr(len(src)))
+}
+
+// HChaCha20 derives the subkey of XChaCha20 from key and the first 16 bytes
+// of its nonce.
+func HChaCha20(out, key *[32]byte, nonce *[16]byte) {
+	hchacha20(out, key, nonce)
+}
+
+// Poly1305AEAD computes the tag of ChaCha20-Poly1305 (RFC 8439) over the
+// additional data ad and the ciphertext ct, with the one-time key.
+func Poly1305AEAD(tag *[16]byte, key *[32]byte, ad, ct []byte) {
+	poly1305AEAD(tag, key, unsafe.SliceData(ad), uintptr(len(ad)), unsafe.SliceData(ct), uintptr(len(ct)))
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package accel
+
+import (
+	"encoding/binary"
+	"errors"
+	"unsafe"
+)
+
+// -----------------------------------------------------------------------------
+
+// Block is a block cipher of 16-byte blocks.
+type Block interface {
+	Encrypt(dst, src []byte)
+}
+
+// GCM is a block cipher in Galois/Counter Mode, as returned by
+// crypto/cipher.NewGCM. It encrypts with AES.CTR32 when the block cipher is
+// AES, and authenticates with GHASH, both accelerated.
+type GCM struct {
+	block     Block
+	aes       *AES // or nil
+	h         [16]byte
+	nonceSize int
+	tagSize   int
+}
+
+const (
+	gcmBlockSize         = 16
+	gcmStandardNonceSize = 12
+)
+
+var errOpen = errors.New("cipher: message authentication failed")
+
+// NewGCM returns the GCM of block, whose AES keys are k if it's AES. The
+// sizes are those checked by crypto/cipher.
+func NewGCM(block Block, k *AES, nonceSize, tagSize int) *GCM {
+	g := &GCM{block: block, aes: k, nonceSize: nonceSize, tagSize: tagSize}
+	g.encrypt(g.h[:], g.h[:])
+	return g
+}
+
+func (g *GCM) NonceSize() int {
+	return g.nonceSize
+}
+
+func (g *GCM) Overhead() int {
+	return g.tagSize
+}
+
+func (g *GCM) Seal(dst, nonce, plaintext, data []byte) []byte {
+	if len(nonce) != g.nonceSize {
+		panic("crypto/cipher: incorrect nonce length given to GCM")
+	}
+	if uint64(len(plaintext)) > ((1<<32)-2)*uint64(gcmBlockSize) {
+		panic("crypto/cipher: message too large for GCM")
+	}
+
+	ret, out := sliceForAppend(dst, len(plaintext)+g.tagSize)
+	if inexactOverlap(out, plaintext) {
+		panic("crypto/cipher: invalid buffer overlap")
+	}
+
+	var counter, tagMask [gcmBlockSize]byte
+	g.deriveCounter(&counter, nonce)
+	g.encrypt(tagMask[:], counter[:])
+	gcmInc32(&counter)
+
+	g.counterCrypt(&counter, out, plaintext)
+
+	var tag [gcmBlockSize]byte
+	g.auth(&tag, out[:len(plaintext)], data, &tagMask)
+	copy(out[len(plaintext):], tag[:g.tagSize])
+	return ret
+}
+
+func (g *GCM) Open(dst, nonce, ciphertext, data []byte) ([]byte, error) {
+	if len(nonce) != g.nonceSize {
+		panic("crypto/cipher: incorrect nonce length given to GCM")
+	}
+	if len(ciphertext) < g.tagSize {
+		return nil, errOpen
+	}
+	if uint64(len(ciphertext)) > ((1<<32)-2)*uint64(gcmBlockSize)+uint64(g.tagSize) {
+		return nil, errOpen
+	}
+
+	tag := ciphertext[len(ciphertext)-g.tagSize:]
+	ciphertext = ciphertext[:len(ciphertext)-g.tagSize]
+
+	var counter, tagMask [gcmBlockSize]byte
+	g.deriveCounter(&counter, nonce)
+	g.encrypt(tagMask[:], counter[:])
+	gcmInc32(&counter)
+
+	var expectedTag [gcmBlockSize]byte
+	g.auth(&expectedTag, ciphertext, data, &tagMask)
+
+	ret, out := sliceForAppend(dst, len(ciphertext))
+	if inexactOverlap(out, ciphertext) {
+		panic("crypto/cipher: invalid buffer overlap")
+	}
+
+	var v byte
+	for i := range tag {
+		v |= expectedTag[i] ^ tag[i]
+	}
+	if v != 0 {
+		// The AESNI code decrypts and authenticates concurrently in Go, and
+		// so overwrites dst in the event of a tag mismatch. That behavior is
+		// mimicked here in order to be consistent across platforms.
+		for i := range out {
+			out[i] = 0
+		}
+		return nil, errOpen
+	}
+
+	g.counterCrypt(&counter, out, ciphertext)
+	return ret, nil
+}
+
+func (g *GCM) encrypt(dst, src []byte) {
+	if g.aes != nil {
+		g.aes.Encrypt(dst[:gcmBlockSize], src[:gcmBlockSize])
+	} else {
+		g.block.Encrypt(dst, src)
+	}
+}
+
+// counterCrypt XORs in with the key stream from counter into out.
+func (g *GCM) counterCrypt(counter *[gcmBlockSize]byte, out, in []byte) {
+	if g.aes != nil {
+		g.aes.CTR32(counter, out, in)
+		return
+	}
+	var mask [gcmBlockSize]byte
+	for len(in) > 0 {
+		g.block.Encrypt(mask[:], counter[:])
+		gcmInc32(counter)
+		n := len(in)
+		if n > gcmBlockSize {
+			n = gcmBlockSize
+		}
+		for i := 0; i < n; i++ {
+			out[i] = in[i] ^ mask[i]
+		}
+		out, in = out[n:], in[n:]
+	}
+}
+
+// deriveCounter computes the initial GCM counter state from the nonce:
+// the nonce followed by 1 if it has the standard size, its hash otherwise.
+func (g *GCM) deriveCounter(counter *[gcmBlockSize]byte, nonce []byte) {
+	if len(nonce) == gcmStandardNonceSize {
+		copy(counter[:], nonce)
+		counter[gcmBlockSize-1] = 1
+		return
+	}
+	var lens [gcmBlockSize]byte
+	binary.BigEndian.PutUint64(lens[8:], uint64(len(nonce))*8)
+	g.update(counter, nonce)
+	GHASH(&g.h, counter, lens[:])
+}
+
+// auth calculates GHASH(ciphertext, additionalData), masks the result with
+// tagMask and writes the result to out.
+func (g *GCM) auth(out *[gcmBlockSize]byte, ciphertext, additionalData []byte, tagMask *[gcmBlockSize]byte) {
+	var y, lens [gcmBlockSize]byte
+	g.update(&y, additionalData)
+	g.update(&y, ciphertext)
+	binary.BigEndian.PutUint64(lens[:8], uint64(len(additionalData))*8)
+	binary.BigEndian.PutUint64(lens[8:], uint64(len(ciphertext))*8)
+	GHASH(&g.h, &y, lens[:])
+	for i := range out {
+		out[i] = y[i] ^ tagMask[i]
+	}
+}
+
+// update extends y with more polynomial terms from data. If data is not a
+// multiple of gcmBlockSize bytes long then the remainder is zero padded.
+func (g *GCM) update(y *[gcmBlockSize]byte, data []byte) {
+	full := len(data) &^ (gcmBlockSize - 1)
+	GHASH(&g.h, y, data[:full])
+	if full != len(data) {
+		var partial [gcmBlockSize]byte
+		copy(partial[:], data[full:])
+		GHASH(&g.h, y, partial[:])
+	}
+}
+
+// gcmInc32 treats the final four bytes of counterBlock as a big-endian value
+// and increments it.
+func gcmInc32(counterBlock *[gcmBlockSize]byte) {
+	ctr := counterBlock[len(counterBlock)-4:]
+	binary.BigEndian.PutUint32(ctr, binary.BigEndian.Uint32(ctr)+1)
+}
+
+// sliceForAppend takes a slice and a requested number of bytes. It returns a
+// slice with the contents of the given slice followed by that many bytes and
+// a second slice that aliases into it and contains only the extra bytes. If
+// the original slice has sufficient capacity then no allocation is performed.
+func sliceForAppend(in []byte, n int) (head, tail []byte) {
+	if total := len(in) + n; cap(in) >= total {
+		head = in[:total]
+	} else {
+		head = make([]byte, total)
+		copy(head, in)
+	}
+	tail = head[len(in):]
+	return
+}
+
+// inexactOverlap reports whether x and y share memory at any non-corresponding
+// index.
+func inexactOverlap(x, y []byte) bool {
+	if len(x) == 0 || len(y) == 0 || &x[0] == &y[0] {
+		return false
+	}
+	return uintptr(unsafe.Pointer(&x[0])) <= uintptr(unsafe.Pointer(&y[len(y)-1])) &&
+		uintptr(unsafe.Pointer(&y[0])) <= uintptr(unsafe.Pointer(&x[len(x)-1]))
+}
+
+// -----------------------------------------------------------------------------
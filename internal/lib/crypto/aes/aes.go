@@ -0,0 +1,87 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package aes implements AES encryption (formerly Rijndael), as defined in
+// U.S. Federal Information Processing Standards Publication 197.
+//
+// The blocks are encrypted by package accel of llgo, with AES-NI or the AES
+// instructions of ARMv8 when the CPU has them, which makes them
+// constant-time. The AES operations of the portable code are not. When the
+// result of NewCipher is passed to cipher.NewGCM, it counts blocks four at
+// a time, and GHASH uses carry-less multiplication when the CPU has it.
+package aes
+
+// llgo:skipall
+import (
+	"crypto/cipher"
+	"strconv"
+
+	"github.com/goplus/llgo/internal/crypto/accel"
+)
+
+// The AES block size in bytes.
+const BlockSize = 16
+
+type KeySizeError int
+
+func (k KeySizeError) Error() string {
+	return "crypto/aes: invalid key size " + strconv.Itoa(int(k))
+}
+
+// A aesCipher is an instance of AES encryption using a particular key.
+type aesCipher struct {
+	key accel.AES
+}
+
+// NewCipher creates and returns a new [cipher.Block].
+// The key argument must be the AES key,
+// either 16, 24, or 32 bytes to select
+// AES-128, AES-192, or AES-256.
+func NewCipher(key []byte) (cipher.Block, error) {
+	k := len(key)
+	switch k {
+	default:
+		return nil, KeySizeError(k)
+	case 16, 24, 32:
+		break
+	}
+	c := new(aesCipher)
+	c.key.Init(key)
+	return c, nil
+}
+
+func (c *aesCipher) BlockSize() int { return BlockSize }
+
+func (c *aesCipher) Encrypt(dst, src []byte) {
+	if len(src) < BlockSize {
+		panic("crypto/aes: input not full block")
+	}
+	if len(dst) < BlockSize {
+		panic("crypto/aes: output not full block")
+	}
+	if inexactOverlap(dst[:BlockSize], src[:BlockSize]) {
+		panic("crypto/aes: invalid buffer overlap")
+	}
+	c.key.Encrypt(dst[:BlockSize], src[:BlockSize])
+}
+
+func (c *aesCipher) Decrypt(dst, src []byte) {
+	if len(src) < BlockSize {
+		panic("crypto/aes: input not full block")
+	}
+	if len(dst) < BlockSize {
+		panic("crypto/aes: output not full block")
+	}
+	if inexactOverlap(dst[:BlockSize], src[:BlockSize]) {
+		panic("crypto/aes: invalid buffer overlap")
+	}
+	c.key.Decrypt(dst[:BlockSize], src[:BlockSize])
+}
+
+// NewGCM returns the AES cipher wrapped in Galois Counter Mode. This is only
+// called by crypto/cipher.NewGCM via the gcmAble interface, once the sizes
+// are checked.
+func (c *aesCipher) NewGCM(nonceSize, tagSize int) (cipher.AEAD, error) {
+	return accel.NewGCM(c, &c.key, nonceSize, tagSize), nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2010 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package rand implements a cryptographically secure
+// random number generator.
+package rand
+
+// llgo:skipall
+import (
+	"io"
+	"strconv"
+	"unsafe"
+
+	"github.com/goplus/llgo/internal/runtime"
+	llrand "github.com/goplus/llgo/internal/runtime/rand"
+)
+
+// Reader is a global, shared instance of a cryptographically
+// secure random number generator. It is safe for concurrent use.
+//
+//   - On Linux, Reader uses getrandom(2).
+//   - On legacy Linux (< 3.17), Reader opens /dev/urandom on first use.
+//   - On macOS and iOS, Reader uses getentropy(2).
+//   - On Windows, Reader uses the BCryptGenRandom API.
+//   - On other systems, Reader reads /dev/urandom.
+var Reader io.Reader = reader{}
+
+type reader struct{}
+
+// Read fills b with random bytes of the system. It never fails, like the
+// default Reader of Go: the program is stopped if the system does.
+func (reader) Read(b []byte) (n int, err error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+	if e := llrand.GetRandom(unsafe.Pointer(unsafe.SliceData(b)), uintptr(len(b))); e != 0 {
+		runtime.Fatal("crypto/rand: failed to read random data (see https://go.dev/issue/66821): errno " + strconv.Itoa(int(e)))
+	}
+	return len(b), nil
+}
+
+// Read fills b with cryptographically secure random bytes. It never returns an
+// error, and always fills b entirely.
+//
+// Read calls [io.ReadFull] on [Reader] and crashes the program irrecoverably if
+// an error is returned. The default Reader uses operating system APIs that are
+// documented to never return an error on all but legacy Linux systems.
+func Read(b []byte) (n int, err error) {
+	if _, ok := Reader.(reader); ok {
+		return reader{}.Read(b)
+	}
+	bb := make([]byte, len(b))
+	if _, err = io.ReadFull(Reader, bb); err != nil {
+		runtime.Fatal("crypto/rand: failed to read random data (see https://go.dev/issue/66821): " + err.Error())
+	}
+	copy(b, bb)
+	return len(b), nil
+}
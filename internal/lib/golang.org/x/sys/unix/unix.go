@@ -0,0 +1,67 @@
+//go:build unix
+// +build unix
+
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package unix
+
+// llgo:skip Syscall Syscall6 RawSyscall RawSyscall6 SyscallNoError RawSyscallNoError
+import (
+	"syscall"
+)
+
+const (
+	LLGoPackage = true
+)
+
+// -----------------------------------------------------------------------------
+
+// The raw system calls of golang.org/x/sys/unix are assembly jumping to
+// those of package syscall: they are calls of them here. On Darwin, its
+// other system calls are made by syscall.syscall and friends, by linkname,
+// with the addresses of libSystem functions its trampolines store, which the
+// compiler stores instead.
+
+func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.Syscall(trap, a1, a2, a3)
+}
+
+func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.Syscall6(trap, a1, a2, a3, a4, a5, a6)
+}
+
+func RawSyscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.RawSyscall(trap, a1, a2, a3)
+}
+
+func RawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err syscall.Errno) {
+	return syscall.RawSyscall6(trap, a1, a2, a3, a4, a5, a6)
+}
+
+// SyscallNoError and RawSyscallNoError are for the system calls which never
+// fail, on Linux.
+func SyscallNoError(trap, a1, a2, a3 uintptr) (r1, r2 uintptr) {
+	r1, r2, _ = syscall.Syscall(trap, a1, a2, a3)
+	return
+}
+
+func RawSyscallNoError(trap, a1, a2, a3 uintptr) (r1, r2 uintptr) {
+	r1, r2, _ = syscall.RawSyscall(trap, a1, a2, a3)
+	return
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,72 @@
+// Copyright 2021 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package godebug makes the settings in the $GODEBUG environment variable
+// available to other packages. These settings are often used for compatibility
+// tweaks, when we need to change a default behavior but want to let users
+// opt back in to the original. For example GODEBUG=http2server=0 disables
+// HTTP/2 support in the net/http server.
+//
+// The settings are looked up in the runtime (see runtime.GODEBUG) the first
+// time they're used. Unlike in Go, they default to the behavior of the
+// latest Go, whatever the go version of the main module, and changing
+// $GODEBUG after they're used has no effect.
+package godebug
+
+// llgo:skipall
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+// A Setting is a single setting in the $GODEBUG environment variable.
+type Setting struct {
+	name       string
+	once       sync.Once
+	value      string
+	nonDefault atomic.Uint64
+}
+
+// New returns a new Setting for the $GODEBUG setting with the given name.
+//
+// The name of an undocumented setting is prefixed with a #, as in
+// godebug.New("#gofsystrace"). The # is a signal to New but not part of the
+// key used in $GODEBUG.
+func New(name string) *Setting {
+	return &Setting{name: name}
+}
+
+// Name returns the name of the setting.
+func (s *Setting) Name() string {
+	if s.name != "" && s.name[0] == '#' {
+		return s.name[1:]
+	}
+	return s.name
+}
+
+// Undocumented reports whether this is an undocumented setting.
+func (s *Setting) Undocumented() bool {
+	return s.name != "" && s.name[0] == '#'
+}
+
+// String returns a printable form for the setting: name=value.
+func (s *Setting) String() string {
+	return s.Name() + "=" + s.Value()
+}
+
+// IncNonDefault increments the non-default behavior counter
+// associated with the given setting.
+func (s *Setting) IncNonDefault() {
+	s.nonDefault.Add(1)
+}
+
+// Value returns the current value for the GODEBUG setting s.
+func (s *Setting) Value() string {
+	s.once.Do(func() {
+		s.value, _ = runtime.GODEBUG(s.Name())
+	})
+	return s.value
+}
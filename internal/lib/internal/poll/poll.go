@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package poll
+
+// llgo:skip runtimeNano runtime_Semacquire runtime_Semrelease runtime_pollServerInit runtime_pollOpen runtime_pollClose runtime_pollWait runtime_pollWaitCanceled runtime_pollReset runtime_pollSetDeadline runtime_pollUnblock runtime_isPollServerDescriptor
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+const (
+	LLGoPackage = true
+)
+
+// -----------------------------------------------------------------------------
+
+func runtimeNano() int64 {
+	return runtime.Nanotime()
+}
+
+func runtime_Semacquire(sema *uint32) {
+	runtime.Semacquire(sema, false)
+}
+
+func runtime_Semrelease(sema *uint32) {
+	runtime.Semrelease(sema, false)
+}
+
+// -----------------------------------------------------------------------------
+
+// The pollDesc of FD is backed by the network poller of the runtime.
+
+func runtime_pollServerInit() {
+	runtime.PollServerInit()
+}
+
+func runtime_pollOpen(fd uintptr) (uintptr, int) {
+	return runtime.PollOpen(fd)
+}
+
+func runtime_pollClose(ctx uintptr) {
+	runtime.PollClose(ctx)
+}
+
+func runtime_pollWait(ctx uintptr, mode int) int {
+	return runtime.PollWait(ctx, mode)
+}
+
+// runtime_pollWaitCanceled is only called on Windows, which has no poller (see
+// poll_windows.go).
+func runtime_pollWaitCanceled(ctx uintptr, mode int) {
+}
+
+func runtime_pollReset(ctx uintptr, mode int) int {
+	return runtime.PollReset(ctx, mode)
+}
+
+func runtime_pollSetDeadline(ctx uintptr, d int64, mode int) {
+	runtime.PollSetDeadline(ctx, d, mode)
+}
+
+func runtime_pollUnblock(ctx uintptr) {
+	runtime.PollUnblock(ctx)
+}
+
+func runtime_isPollServerDescriptor(fd uintptr) bool {
+	return runtime.IsPollServerDescriptor(fd)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package race contains helper functions for manually instrumenting code for
+// the race detector, which reports to the runtime of llgo: they do nothing
+// unless the program is built with -race.
+package race
+
+// llgo:skipall
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/internal/abi"
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+const Enabled = runtime.RaceEnabled
+
+func Acquire(addr unsafe.Pointer) {
+	runtime.RaceAcquire(addr)
+}
+
+func Release(addr unsafe.Pointer) {
+	runtime.RaceRelease(addr)
+}
+
+// ReleaseMerge is Release: ThreadSanitizer always merges the releases on an
+// address.
+func ReleaseMerge(addr unsafe.Pointer) {
+	runtime.RaceRelease(addr)
+}
+
+func Disable() {
+	runtime.RaceDisable()
+}
+
+func Enable() {
+	runtime.RaceEnable()
+}
+
+func Read(addr unsafe.Pointer) {
+	runtime.RaceReadRange(addr, 1)
+}
+
+func ReadPC(addr unsafe.Pointer, callerpc, pc uintptr) {
+	runtime.RaceReadRange(addr, 1)
+}
+
+func ReadObjectPC(t *abi.Type, addr unsafe.Pointer, callerpc, pc uintptr) {
+	runtime.RaceReadRange(addr, t.Size_)
+}
+
+func Write(addr unsafe.Pointer) {
+	runtime.RaceWriteRange(addr, 1)
+}
+
+func WritePC(addr unsafe.Pointer, callerpc, pc uintptr) {
+	runtime.RaceWriteRange(addr, 1)
+}
+
+func WriteObjectPC(t *abi.Type, addr unsafe.Pointer, callerpc, pc uintptr) {
+	runtime.RaceWriteRange(addr, t.Size_)
+}
+
+func ReadRange(addr unsafe.Pointer, len int) {
+	runtime.RaceReadRange(addr, uintptr(len))
+}
+
+func WriteRange(addr unsafe.Pointer, len int) {
+	runtime.RaceWriteRange(addr, uintptr(len))
+}
+
+// Errors returns 0: ThreadSanitizer doesn't count its reports, but exits
+// with status 66 if there were some.
+func Errors() int {
+	return 0
+}
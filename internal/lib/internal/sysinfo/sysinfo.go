@@ -0,0 +1,28 @@
+// Copyright 2020 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sysinfo implements high level hardware information gathering
+// that can be used for debugging or information purposes.
+//
+// The name of the CPU comes from the system only, not from CPUID like in Go:
+// package internal/cpu isn't compiled by llgo.
+package sysinfo
+
+// llgo:skipall
+import (
+	"sync"
+)
+
+var (
+	cpuNameOnce sync.Once
+	cpuName     string
+)
+
+// CPUName returns the name of the CPU, or "" if it's unknown.
+func CPUName() string {
+	cpuNameOnce.Do(func() {
+		cpuName = osCPUInfoName()
+	})
+	return cpuName
+}
@@ -0,0 +1,76 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package os
+
+import (
+	"io/fs"
+	"sort"
+	"syscall"
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/os"
+)
+
+// A DirEntry is an entry read from a directory
+// (using the ReadDir function or a File.ReadDir method).
+type DirEntry = fs.DirEntry
+
+// ReadDir reads the named directory,
+// returning all its directory entries sorted by filename.
+// If an error occurs reading the directory,
+// ReadDir returns the entries it was able to read before the error,
+// along with the error.
+func ReadDir(name string) ([]DirEntry, error) {
+	dir := os.Opendir(c.AllocaCStr(name))
+	if dir == nil {
+		return nil, &PathError{Op: "open", Path: name, Err: syscall.Errno(os.Errno)}
+	}
+	defer os.Closedir(dir)
+
+	var dirs []DirEntry
+	for {
+		d := os.Readdir(dir)
+		if d == nil {
+			break
+		}
+		n := c.GoString((*c.Char)(unsafe.Pointer(&d.Name[0])))
+		if n == "." || n == ".." {
+			continue
+		}
+		de, err := newUnixDirent(name, n, dtToType(d.Type))
+		if IsNotExist(err) {
+			// File disappeared between readdir and lstat.
+			// Treat as if it didn't exist.
+			continue
+		}
+		if err != nil {
+			return dirs, err
+		}
+		dirs = append(dirs, de)
+	}
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i].Name() < dirs[j].Name() })
+	return dirs, nil
+}
+
+func dtToType(typ uint8) FileMode {
+	switch typ {
+	case syscall.DT_BLK:
+		return ModeDevice
+	case syscall.DT_CHR:
+		return ModeDevice | ModeCharDevice
+	case syscall.DT_DIR:
+		return ModeDir
+	case syscall.DT_FIFO:
+		return ModeNamedPipe
+	case syscall.DT_LNK:
+		return ModeSymlink
+	case syscall.DT_REG:
+		return 0
+	case syscall.DT_SOCK:
+		return ModeSocket
+	}
+	return ^FileMode(0)
+}
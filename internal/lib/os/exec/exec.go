@@ -29,6 +29,8 @@ import (
 	"strings"
 	"syscall"
 	"time"
+
+	"github.com/goplus/llgo/internal/lib/internal/syscall/execenv"
 )
 
 // Error is returned by LookPath when it fails to classify a file as an
@@ -416,7 +418,6 @@ func (c *Cmd) argv() []string {
 }
 
 func (c *Cmd) childStdin() (*os.File, error) {
-	/* TODO(xsw):
 	if c.Stdin == nil {
 		f, err := os.Open(os.DevNull)
 		if err != nil {
@@ -448,8 +449,6 @@ func (c *Cmd) childStdin() (*os.File, error) {
 		return err
 	})
 	return pr, nil
-	*/
-	panic("todo: exec.Cmd.childStdin")
 }
 
 func (c *Cmd) childStdout() (*os.File, error) {
@@ -1092,7 +1091,6 @@ func minInt(a, b int) int {
 // would be run as it is currently configured. If an error occurs in computing
 // the environment, it is returned alongside the best-effort copy.
 func (c *Cmd) environ() ([]string, error) {
-	/* TODO(xsw):
 	var err error
 
 	env := c.Env
@@ -1131,8 +1129,6 @@ func (c *Cmd) environ() ([]string, error) {
 		err = dedupErr
 	}
 	return addCriticalEnv(env), err
-	*/
-	panic("todo: exec.Cmd.environ")
 }
 
 // Environ returns a copy of the environment in which the command would be run
@@ -69,13 +69,8 @@ func LookPath(file string) (string, error) {
 		path := filepath.Join(dir, file)
 		if err := findExecutable(path); err == nil {
 			if !filepath.IsAbs(path) {
-				/* TODO(xsw):
-				if execerrdot.Value() != "0" {
-					return path, &Error{file, ErrDot}
-				}
-				execerrdot.IncNonDefault()
-				*/
-				panic("todo: exec.LookPath: !filepath.IsAbs(path)")
+				// TODO(xsw): execerrdot=0
+				return path, &Error{file, ErrDot}
 			}
 			return path, nil
 		}
@@ -96,7 +96,6 @@ func (p *ProcessState) sysUsage() any {
 }
 
 func (p *ProcessState) String() string {
-	/* TODO(xsw):
 	if p == nil {
 		return "<nil>"
 	}
@@ -104,18 +103,13 @@ func (p *ProcessState) String() string {
 	res := ""
 	switch {
 	case status.Exited():
-		code := status.ExitStatus()
-		if runtime.GOOS == "windows" && uint(code) >= 1<<16 { // windows uses large hex numbers
-			res = "exit status " + uitox(uint(code))
-		} else { // unix systems use small decimal integers
-			res = "exit status " + itoa.Itoa(code) // unix
-		}
+		res = "exit status " + itoa(status.ExitStatus())
 	case status.Signaled():
 		res = "signal: " + status.Signal().String()
 	case status.Stopped():
 		res = "stop signal: " + status.StopSignal().String()
 		if status.StopSignal() == syscall.SIGTRAP && status.TrapCause() != 0 {
-			res += " (trap " + itoa.Itoa(status.TrapCause()) + ")"
+			res += " (trap " + itoa(status.TrapCause()) + ")"
 		}
 	case status.Continued():
 		res = "continued"
@@ -124,8 +118,6 @@ func (p *ProcessState) String() string {
 		res += " (core dumped)"
 	}
 	return res
-	*/
-	panic("todo: os.ProcessState.String")
 }
 
 // ExitCode returns the exit code of the exited process, or -1
@@ -137,3 +129,29 @@ func (p *ProcessState) ExitCode() int {
 	}
 	return p.status.ExitStatus()
 }
+
+// itoa converts val to a decimal string, like internal/itoa.
+func itoa(val int) string {
+	if val < 0 {
+		return "-" + uitoa(uint(-val))
+	}
+	return uitoa(uint(val))
+}
+
+// uitoa converts val to a decimal string.
+func uitoa(val uint) string {
+	if val == 0 { // avoid string allocation
+		return "0"
+	}
+	var buf [20]byte // big enough for 64bit value base 10
+	i := len(buf) - 1
+	for val >= 10 { // val = val/10*10 + val%10
+		q := val / 10
+		buf[i] = byte('0' + val - q*10)
+		i--
+		val = q
+	}
+	// val < 10
+	buf[i] = byte('0' + val)
+	return string(buf[i:])
+}
@@ -92,17 +92,17 @@ func (f *File) ReadAt(b []byte, off int64) (n int, err error) {
 
 // ReadFrom implements io.ReaderFrom.
 func (f *File) ReadFrom(r io.Reader) (n int64, err error) {
-	/*
-		if err := f.checkValid("write"); err != nil {
-			return 0, err
-		}
-		n, handled, e := f.readFrom(r)
-		if !handled {
-			return genericReadFrom(f, r) // without wrapping
-		}
-		return n, f.wrapErr("write", e)
+	if err := f.checkValid("write"); err != nil {
+		return 0, err
+	}
+	/* TODO(xsw):
+	n, handled, e := f.readFrom(r)
+	if !handled {
+		return genericReadFrom(f, r) // without wrapping
+	}
+	return n, f.wrapErr("write", e)
 	*/
-	panic("todo")
+	return genericReadFrom(f, r) // without wrapping
 }
 
 func genericReadFrom(f *File, r io.Reader) (int64, error) {
@@ -130,8 +130,7 @@ func (f *File) Write(b []byte) (n int, err error) {
 	}
 	n, e := f.write(b)
 
-	// TODO(xsw):
-	// epipecheck(f, e)
+	epipecheck(f, e)
 
 	if e != nil {
 		err = f.wrapErr("write", e)
@@ -288,7 +287,6 @@ func (f *File) wrapErr(op string, err error) error {
 	return &PathError{Op: op, Path: f.name, Err: err}
 }
 
-/*
 // TempDir returns the default directory to use for temporary files.
 //
 // On Unix systems, it returns $TMPDIR if non-empty, else /tmp.
@@ -301,7 +299,6 @@ func (f *File) wrapErr(op string, err error) error {
 func TempDir() string {
 	return tempDir()
 }
-*/
 
 // Chmod changes the mode of the file to mode.
 // If there is an error, it will be of type *PathError.
@@ -469,6 +466,7 @@ func (dir dirFS) join(name string) (string, error) {
 	}
 	return string(dir) + string(PathSeparator) + name, nil
 }
+*/
 
 // ReadFile reads the named file and returns the contents.
 // A successful call returns err == nil, not err == EOF.
@@ -531,4 +529,3 @@ func WriteFile(name string, data []byte, perm FileMode) error {
 	}
 	return err
 }
-*/
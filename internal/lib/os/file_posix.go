@@ -16,13 +16,18 @@ import (
 // be canceled and return immediately with an ErrClosed error.
 // Close will return an error if it has already been called.
 func (f *File) Close() error {
-	/*
-		if f == nil {
-			return ErrInvalid
-		}
-		return f.file.close()
-	*/
-	panic("todo: os.(*File).Close")
+	if f == nil {
+		return ErrInvalid
+	}
+	fd := f.fd
+	if fd == ^uintptr(0) {
+		return &PathError{Op: "close", Path: f.name, Err: ErrClosed}
+	}
+	f.fd = ^uintptr(0)
+	if e := syscall.Close(int(fd)); e != nil {
+		return &PathError{Op: "close", Path: f.name, Err: e}
+	}
+	return nil
 }
 
 // pread reads len(b) bytes from the File starting at byte offset off.
@@ -48,7 +53,6 @@ func (f *File) pwrite(b []byte, off int64) (n int, err error) {
 	panic("todo: os.(*File).pwrite")
 }
 
-/*
 // syscallMode returns the syscall-specific mode bits from Go's portable mode bits.
 func syscallMode(i FileMode) (o uint32) {
 	o |= uint32(i.Perm())
@@ -65,6 +69,7 @@ func syscallMode(i FileMode) (o uint32) {
 	return
 }
 
+/*
 // See docs in file.go:Chmod.
 func chmod(name string, mode FileMode) error {
 	longName := fixLongPath(name)
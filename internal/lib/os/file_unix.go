@@ -7,7 +7,10 @@
 package os
 
 import (
+	"io/fs"
 	"runtime"
+	"syscall"
+	_ "unsafe"
 )
 
 // Fd returns the integer Unix file descriptor referencing the open file.
@@ -113,19 +116,16 @@ const (
 	kindNoPoll
 )
 
-// TODO(xsw):
-// func sigpipe() // implemented in package runtime
+//go:linkname sigpipe github.com/goplus/llgo/internal/runtime.Sigpipe
+func sigpipe() // implemented in package runtime
 
 // epipecheck raises SIGPIPE if we get an EPIPE error on standard
 // output or standard error. See the SIGPIPE docs in os/signal, and
 // issue 11845.
 func epipecheck(file *File, e error) {
-	/* TODO(xsw):
-	if e == syscall.EPIPE && file.stdoutOrErr {
+	if e == syscall.EPIPE && (file.fd == 1 || file.fd == 2) {
 		sigpipe()
 	}
-	*/
-	panic("todo: os.epipecheck")
 }
 
 // DevNull is the name of the operating system's “null device.”
@@ -135,7 +135,22 @@ const DevNull = "/dev/null"
 // openFileNolog is the Unix implementation of OpenFile.
 // Changes here should be reflected in openFdAt, if relevant.
 func openFileNolog(name string, flag int, perm FileMode) (*File, error) {
-	panic("todo: os.openFileNolog")
+	var r int
+	for {
+		var e error
+		r, e = syscall.Open(name, flag|syscall.O_CLOEXEC, syscallMode(perm))
+		if e == nil {
+			break
+		}
+
+		// We have to check EINTR here, per issues 11180 and 39237.
+		if e == syscall.EINTR {
+			continue
+		}
+
+		return nil, &PathError{Op: "open", Path: name, Err: e}
+	}
+	return NewFile(uintptr(r), name), nil
 }
 
 func tempDir() string {
@@ -162,34 +177,27 @@ func (d *unixDirent) IsDir() bool    { return d.typ.IsDir() }
 func (d *unixDirent) Type() FileMode { return d.typ }
 
 func (d *unixDirent) Info() (FileInfo, error) {
-	/* TODO(xsw):
 	if d.info != nil {
 		return d.info, nil
 	}
-	return lstat(d.parent + "/" + d.name)
-	*/
-	panic("todo: os.unixDirent.Info")
+	return Lstat(d.parent + "/" + d.name)
 }
 
 func (d *unixDirent) String() string {
-	/* TODO(xsw):
 	return fs.FormatDirEntry(d)
-	*/
-	panic("todo: os.unixDirent.String")
 }
 
-/* TODO(xsw):
 func newUnixDirent(parent, name string, typ FileMode) (DirEntry, error) {
 	ude := &unixDirent{
 		parent: parent,
 		name:   name,
 		typ:    typ,
 	}
-	if typ != ^FileMode(0) && !testingForceReadDirLstat {
+	if typ != ^FileMode(0) {
 		return ude, nil
 	}
 
-	info, err := lstat(parent + "/" + name)
+	info, err := Lstat(parent + "/" + name)
 	if err != nil {
 		return nil, err
 	}
@@ -198,4 +206,3 @@ func newUnixDirent(parent, name string, typ FileMode) (DirEntry, error) {
 	ude.info = info
 	return ude, nil
 }
-*/
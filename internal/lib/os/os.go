@@ -49,7 +49,7 @@ func (e *LinkError) Unwrap() error {
 }
 
 func toMode(mode FileMode) os.ModeT {
-	panic("todo: toMode")
+	return os.ModeT(syscallMode(mode))
 }
 
 func toPathErr(op, path string, errno c.Int) error {
@@ -61,7 +61,7 @@ func Chdir(dir string) error {
 	if ret == 0 {
 		return nil
 	}
-	return toPathErr("chdir", dir, ret)
+	return toPathErr("chdir", dir, os.Errno)
 }
 
 /* TODO(xsw):
@@ -87,7 +87,7 @@ func Chmod(name string, mode FileMode) error {
 	if ret == 0 {
 		return nil
 	}
-	return toPathErr("chmod", name, ret)
+	return toPathErr("chmod", name, os.Errno)
 }
 
 /* TODO(xsw):
@@ -117,7 +117,7 @@ func Chown(name string, uid, gid int) error {
 	if ret == 0 {
 		return nil
 	}
-	return toPathErr("chown", name, ret)
+	return toPathErr("chown", name, os.Errno)
 }
 
 /* TODO(xsw):
@@ -149,7 +149,7 @@ func Clearenv()
 // func DirFS(dir string) fs.FS
 
 func Environ() []string {
-	panic("todo: os.Environ")
+	return syscall.Environ()
 }
 
 // func Executable() (string, error)
@@ -210,7 +210,7 @@ func Lchown(name string, uid, gid int) error {
 	if ret == 0 {
 		return nil
 	}
-	return toPathErr("lchown", name, ret)
+	return toPathErr("lchown", name, os.Errno)
 }
 
 /* TODO(xsw):
@@ -236,18 +236,28 @@ func Link(oldname, newname string) error {
 	if ret == 0 {
 		return nil
 	}
-	return &LinkError{"link", oldname, newname, syscall.Errno(ret)}
+	return &LinkError{"link", oldname, newname, syscall.Errno(os.Errno)}
+}
+
+// LookupEnv retrieves the value of the environment variable named
+// by the key. If the variable is present in the environment the
+// value (which may be empty) is returned and the boolean is true.
+// Otherwise the returned value will be empty and the boolean will
+// be false.
+func LookupEnv(key string) (string, bool) {
+	v := os.Getenv(c.AllocaCStr(key))
+	if v == nil {
+		return "", false
+	}
+	return c.GoString(v), true
 }
 
-// TODO(xsw):
-// func LookupEnv(key string) (string, bool)
-
 func Mkdir(name string, perm FileMode) error {
 	ret := os.Mkdir(c.AllocaCStr(name), toMode(perm))
 	if ret == 0 {
 		return nil
 	}
-	return toPathErr("mkdir", name, ret)
+	return toPathErr("mkdir", name, os.Errno)
 }
 
 /* TODO(xsw):
@@ -278,12 +288,18 @@ func Mkdir(name string, perm FileMode) error {
 }
 */
 
-// TODO(xsw):
-// func MkdirAll(path string, perm FileMode) error
-// func MkdirTemp(dir, pattern string) (string, error)
-// func NewSyscallError(syscall string, err error) error
 func Pipe() (r *File, w *File, err error) {
-	panic("todo: os.Pipe")
+	var p [2]c.Int
+	// See ../syscall/exec_unix.go for description of lock.
+	syscall.ForkLock.RLock()
+	if os.Pipe(&p) != 0 {
+		syscall.ForkLock.RUnlock()
+		return nil, nil, NewSyscallError("pipe", syscall.Errno(os.Errno))
+	}
+	syscall.CloseOnExec(int(p[0]))
+	syscall.CloseOnExec(int(p[1]))
+	syscall.ForkLock.RUnlock()
+	return NewFile(uintptr(p[0]), "|0"), NewFile(uintptr(p[1]), "|1"), nil
 }
 
 // func ReadFile(name string) ([]byte, error)
@@ -302,18 +318,15 @@ func Remove(name string) error {
 	if ret == 0 {
 		return nil
 	}
-	return toPathErr("remove", name, ret)
+	return toPathErr("remove", name, os.Errno)
 }
 
-// TODO(xsw):
-// func RemoveAll(path string) error
-
 func Rename(oldpath, newpath string) error {
 	ret := os.Rename(c.AllocaCStr(oldpath), c.AllocaCStr(newpath))
 	if ret == 0 {
 		return nil
 	}
-	return &LinkError{"rename", oldpath, newpath, syscall.Errno(ret)}
+	return &LinkError{"rename", oldpath, newpath, syscall.Errno(os.Errno)}
 }
 
 /* TODO(xsw):
@@ -335,7 +348,7 @@ func Setenv(key, value string) error {
 	if ret == 0 {
 		return nil
 	}
-	return &SyscallError{"setenv", syscall.Errno(ret)}
+	return &SyscallError{"setenv", syscall.Errno(os.Errno)}
 }
 
 func Symlink(oldname, newname string) error {
@@ -343,18 +356,15 @@ func Symlink(oldname, newname string) error {
 	if ret == 0 {
 		return nil
 	}
-	return &LinkError{"symlink", oldname, newname, syscall.Errno(ret)}
+	return &LinkError{"symlink", oldname, newname, syscall.Errno(os.Errno)}
 }
 
-// TODO(xsw):
-// func TempDir() string
-
 func Truncate(name string, size int64) error {
 	ret := os.Truncate(c.AllocaCStr(name), os.OffT(size))
 	if ret == 0 {
 		return nil
 	}
-	return toPathErr("truncate", name, ret)
+	return toPathErr("truncate", name, os.Errno)
 }
 
 func Unsetenv(key string) error {
@@ -362,7 +372,7 @@ func Unsetenv(key string) error {
 	if ret == 0 {
 		return nil
 	}
-	return syscall.Errno(ret)
+	return syscall.Errno(os.Errno)
 }
 
 // UserCacheDir returns the default root directory to use for user-specific
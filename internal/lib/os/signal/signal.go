@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package signal
+
+// llgo:skip signal_disable signal_enable signal_ignore signal_ignored signal_recv signalWaitUntilIdle
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+const (
+	LLGoPackage = true
+)
+
+// -----------------------------------------------------------------------------
+
+func signal_disable(sig uint32) {
+	runtime.SignalDisable(sig)
+}
+
+func signal_enable(sig uint32) {
+	runtime.SignalEnable(sig)
+}
+
+func signal_ignore(sig uint32) {
+	runtime.SignalIgnore(sig)
+}
+
+func signal_ignored(sig uint32) bool {
+	return runtime.SignalIgnored(sig)
+}
+
+// signal_recv blocks until a notified signal is caught, in the goroutine
+// started by watchSignalLoop.
+func signal_recv() uint32 {
+	return runtime.SignalRecv()
+}
+
+func signalWaitUntilIdle() {
+	runtime.SignalWaitIdle()
+}
+
+// -----------------------------------------------------------------------------
@@ -6,24 +6,26 @@
 
 package os
 
-import "syscall"
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/os"
+)
 
 // Stat returns the FileInfo structure describing file.
 // If there is an error, it will be of type *PathError.
 func (f *File) Stat() (FileInfo, error) {
-	/* TODO(xsw):
 	if f == nil {
 		return nil, ErrInvalid
 	}
 	var fs fileStat
-	err := os.Fstat(c.Int(f.fd), &fs.sys)
-	if err != 0 {
-		return nil, &PathError{Op: "stat", Path: f.name, Err: syscall.Errno(err)}
+	if os.Fstat(c.Int(f.fd), (*os.StatT)(unsafe.Pointer(&fs.sys))) != 0 {
+		return nil, &PathError{Op: "stat", Path: f.name, Err: syscall.Errno(os.Errno)}
 	}
 	fillFileStatFromSys(&fs, f.name)
 	return &fs, nil
-	*/
-	panic("todo: os.File.Stat")
 }
 
 // statNolog stats a file with no test logging.
@@ -28,7 +28,9 @@ type File struct {
 // write writes len(b) bytes to the File.
 // It returns the number of bytes written and an error, if any.
 func (f *File) write(b []byte) (int, error) {
+	runtime_entersyscall()
 	ret := os.Write(c.Int(f.fd), unsafe.Pointer(unsafe.SliceData(b)), uintptr(len(b)))
+	runtime_exitsyscall()
 	if ret >= 0 {
 		return int(ret), nil
 	}
@@ -48,7 +50,9 @@ func (f *File) write(b []byte) (n int, err error) {
 // read reads up to len(b) bytes from the File.
 // It returns the number of bytes read and an error, if any.
 func (f *File) read(b []byte) (int, error) {
+	runtime_entersyscall()
 	ret := os.Read(c.Int(f.fd), unsafe.Pointer(unsafe.SliceData(b)), uintptr(len(b)))
+	runtime_exitsyscall()
 	if ret > 0 {
 		return int(ret), nil
 	}
@@ -68,6 +72,15 @@ func (f *File) read(b []byte) (n int, err error) {
 }
 */
 
+// Reads and writes block in the kernel: they show in execution traces as
+// syscalls if they take long.
+
+//go:linkname runtime_entersyscall github.com/goplus/llgo/internal/runtime.Entersyscall
+func runtime_entersyscall()
+
+//go:linkname runtime_exitsyscall github.com/goplus/llgo/internal/runtime.Exitsyscall
+func runtime_exitsyscall()
+
 // A FileInfo describes a file and is returned by Stat and Lstat.
 type FileInfo = fs.FileInfo
 
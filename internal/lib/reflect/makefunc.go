@@ -22,18 +22,19 @@
 
 package reflect
 
-/*
 import (
+	"sync"
 	"unsafe"
+
+	"github.com/goplus/llgo/internal/abi"
+	"github.com/goplus/llgo/internal/runtime"
 )
 
-// makeFuncImpl is the closure value implementing the function
-// returned by MakeFunc.
-// The first three words of this type must be kept in sync with
-// methodValue and runtime.reflectMethodValue.
-// Any changes should be reflected in all three.
+// makeFuncImpl is the context of the closures returned by MakeFunc. Their
+// function is the MakeFunc thunk of their type (see abi.FuncType), which
+// lays out its arguments in a frame and calls call, the first field.
 type makeFuncImpl struct {
-	makeFuncCtxt
+	call func(args, results unsafe.Pointer)
 	ftyp *funcType
 	fn   func([]Value) []Value
 }
@@ -63,136 +64,131 @@ func MakeFunc(typ Type, fn func(args []Value) (results []Value)) Value {
 	if typ.Kind() != Func {
 		panic("reflect: call of MakeFunc with non-Func type")
 	}
-
 	t := typ.common()
 	ftyp := (*funcType)(unsafe.Pointer(t))
+	if ftyp.MakeFunc == nil {
+		panic("reflect: MakeFunc of function type without MakeFunc thunk: " + typ.String())
+	}
+	impl := &makeFuncImpl{ftyp: ftyp, fn: fn}
+	impl.call = impl.callReflect
+	fv := &closure{ftyp.MakeFunc, unsafe.Pointer(impl)}
+	return Value{&closureType(ftyp).Type, unsafe.Pointer(fv), flagIndir | flag(Func)}
+}
 
-	code := abi.FuncPCABI0(makeFuncStub)
+// callReflect is called by the MakeFunc thunk with the frames of the
+// arguments and the results of a call of the function made by MakeFunc.
+func (impl *makeFuncImpl) callReflect(args, results unsafe.Pointer) {
+	ftyp := impl.ftyp
 
-	// makeFuncImpl contains a stack map for use by the runtime
-	_, _, abid := funcLayout(ftyp, nil)
+	// Copy arguments into Values, as the frame is on the stack of the thunk.
+	offs, _ := frameLayout(ftyp.In)
+	in := make([]Value, len(ftyp.In))
+	for i, typ := range ftyp.In {
+		in[i] = copyVal(typ, flag(typ.Kind()), add(args, offs[i], "offs[i] < size"))
+	}
 
-	impl := &makeFuncImpl{
-		makeFuncCtxt: makeFuncCtxt{
-			fn:      code,
-			stack:   abid.stackPtrs,
-			argLen:  abid.stackCallArgsSize,
-			regPtrs: abid.inRegPtrs,
-		},
-		ftyp: ftyp,
-		fn:   fn,
+	// Call underlying function.
+	out := impl.fn(in)
+	if len(out) != len(ftyp.Out) {
+		panic("reflect: wrong return count from function created by MakeFunc")
 	}
 
-	return Value{t, unsafe.Pointer(impl), flag(Func)}
+	// Copy results back into the result frame.
+	offs, _ = frameLayout(ftyp.Out)
+	for i, typ := range ftyp.Out {
+		v := out[i]
+		if v.typ() == nil {
+			panic("reflect: function created by MakeFunc returned zero Value")
+		}
+		if v.flag&flagRO != 0 {
+			panic("reflect: function created by MakeFunc returned value obtained from unexported field")
+		}
+		// Convert v to type typ if v is assignable to a variable
+		// of type t in the language spec.
+		// See issue 28761.
+		v = v.assignTo("reflect.MakeFunc", typ, nil)
+		typedmemmove(typ, add(results, offs[i], "offs[i] < size"), v.data())
+	}
 }
 
-// makeFuncStub is an assembly function that is the code half of
-// the function returned from MakeFunc. It expects a *callReflectFunc
-// as its context register, and its job is to invoke callReflect(ctxt, frame)
-// where ctxt is the context register and frame is a pointer to the first
-// word in the passed-in argument frame.
-func makeFuncStub()
-
-// The first 3 words of this type must be kept in sync with
-// makeFuncImpl and runtime.reflectMethodValue.
-// Any changes should be reflected in all three.
-type methodValue struct {
-	makeFuncCtxt
-	method int
-	rcvr   Value
-}
-*/
+// closureTypes is the cache for closureType.
+var closureTypes sync.Map // map[*funcType]*funcType
 
-// makeMethodValue converts v from the rcvr+method index representation
-// of a method value to an actual method func value, which is
-// basically the receiver value with a special bit set, into a true
-// func value - a value holding an actual func. The output is
-// semantically equivalent to the input as far as the user of package
-// reflect can tell, but the true func representation can be handled
-// by code like Convert and Interface and Assign.
-func makeMethodValue(op string, v Value) Value {
-	/*
-		if v.flag&flagMethod == 0 {
-			panic("reflect: internal error: invalid use of makeMethodValue")
-		}
+// closureType returns the type of func values of the function type ft. It's
+// ft unless ft is the type of raw functions, like the types of methods.
+func closureType(ft *funcType) *funcType {
+	if ft.IfaceIndir() {
+		return ft
+	}
+	if t, ok := closureTypes.Load(ft); ok {
+		return t.(*funcType)
+	}
+	t := new(funcType)
+	*t = *ft
+	t.Kind_ &^= abi.KindDirectIface
+	ti, _ := closureTypes.LoadOrStore(ft, runtime.Closure(t))
+	return ti.(*funcType)
+}
 
-		// Ignoring the flagMethod bit, v describes the receiver, not the method type.
-		fl := v.flag & (flagRO | flagAddr | flagIndir)
-		fl |= flag(v.typ().Kind())
-		rcvr := Value{v.typ(), v.ptr, fl}
-
-		// v.Type returns the actual type of the method value.
-		ftyp := (*funcType)(unsafe.Pointer(v.Type().(*rtype)))
-
-		code := methodValueCallCodePtr()
-
-		// methodValue contains a stack map for use by the runtime
-		_, _, abid := funcLayout(ftyp, nil)
-		fv := &methodValue{
-			makeFuncCtxt: makeFuncCtxt{
-				fn:      code,
-				stack:   abid.stackPtrs,
-				argLen:  abid.stackCallArgsSize,
-				regPtrs: abid.inRegPtrs,
-			},
-			method: int(v.flag) >> flagMethodShift,
-			rcvr:   rcvr,
-		}
+// methodFunc is the context of the Func of a method (see rtype.Method): the
+// method index of typ, called with the receiver as the first argument.
+type methodFunc struct {
+	typ   *abi.Type
+	index int
+}
 
-		// Cause panic if method is not appropriate.
-		// The panic would still happen during the call if we omit this,
-		// but we want Interface() and other operations to fail early.
-		methodReceiver(op, fv.rcvr, fv.method)
+// methodFuncFn is the function of the Func of methods. Their types are made
+// at run time, so they have no thunks: Value.call recognizes them by it and
+// calls the method of their receiver, and calling them directly panics.
+var methodFuncFn = func() unsafe.Pointer {
+	f := callMethodFunc
+	return (*closure)(unsafe.Pointer(&f)).fn
+}()
 
-		return Value{ftyp.Common(), unsafe.Pointer(fv), v.flag&flagRO | flag(Func)}
-	*/
-	panic("todo")
+func callMethodFunc() {
+	panic("reflect: call of the Func of a method, which only Value.Call supports")
 }
 
-/*
-func methodValueCallCodePtr() uintptr {
-	return abi.FuncPCABI0(methodValueCall)
+// makeMethodFunc returns the Func of the method index of typ, of type ftyp.
+func makeMethodFunc(ftyp *funcType, typ *abi.Type, index int) Value {
+	fv := &closure{methodFuncFn, unsafe.Pointer(&methodFunc{typ, index})}
+	return Value{&ftyp.Type, unsafe.Pointer(fv), flagIndir | flag(Func)}
 }
 
-// methodValueCall is an assembly function that is the code half of
-// the function returned from makeMethodValue. It expects a *methodValue
-// as its context register, and its job is to invoke callMethod(ctxt, frame)
-// where ctxt is the context register and frame is a pointer to the first
-// word in the passed-in argument frame.
-func methodValueCall()
-
-// This structure must be kept in sync with runtime.reflectMethodValue.
-// Any changes should be reflected in all both.
-type makeFuncCtxt struct {
-	fn      uintptr
-	stack   *bitVector // ptrmap for both stack args and results
-	argLen  uintptr    // just args
-	regPtrs abi.IntArgRegBitmap
+// call calls the method with the receiver in[0] and the arguments in[1:].
+func (m *methodFunc) call(op string, in []Value) []Value {
+	if len(in) == 0 {
+		panic("reflect: " + op + " with too few input arguments")
+	}
+	if in[0].Kind() == Invalid {
+		panic("reflect: " + op + " using zero Value argument")
+	}
+	rcvr := in[0].assignTo("reflect.Value."+op, m.typ, nil)
+	return rcvr.Method(m.index).call(op, in[1:])
 }
 
-// moveMakeFuncArgPtrs uses ctxt.regPtrs to copy integer pointer arguments
-// in args.Ints to args.Ptrs where the GC can see them.
-//
-// This is similar to what reflectcallmove does in the runtime, except
-// that happens on the return path, whereas this happens on the call path.
-//
-// nosplit because pointers are being held in uintptr slots in args, so
-// having our stack scanned now could lead to accidentally freeing
-// memory.
-//
-//go:nosplit
-func moveMakeFuncArgPtrs(ctxt *makeFuncCtxt, args *abi.RegArgs) {
-	for i, arg := range args.Ints {
-		// Avoid write barriers! Because our write barrier enqueues what
-		// was there before, we might enqueue garbage.
-		if ctxt.regPtrs.Get(i) {
-			*(*uintptr)(unsafe.Pointer(&args.Ptrs[i])) = arg
-		} else {
-			// We *must* zero this space ourselves because it's defined in
-			// assembly code and the GC will scan these pointers. Otherwise,
-			// there will be garbage here.
-			*(*uintptr)(unsafe.Pointer(&args.Ptrs[i])) = 0
-		}
+// makeMethodValue converts v from the rcvr+method index representation
+// of a method value to an actual method func value, which is
+// basically the receiver value with a special bit set, into a true
+// func value - a value holding an actual func. The output is
+// semantically equivalent to the input as far as the user of package
+// reflect can tell, but the true func representation can be handled
+// by code like Convert and Interface and Assign.
+func makeMethodValue(op string, v Value) Value {
+	if v.flag&flagMethod == 0 {
+		panic("reflect: internal error: invalid use of makeMethodValue")
 	}
+
+	// Ignoring the flagMethod bit, v describes the receiver, not the method type.
+	fl := v.flag & (flagRO | flagAddr | flagIndir)
+	fl |= flag(v.typ().Kind())
+	rcvr := Value{v.typ(), v.ptr, fl}
+
+	// The function of a method in interfaces takes the receiver as it's
+	// stored in interface values as its first argument, like closures take
+	// their context: so the method value is a closure of it.
+	// methodReceiver panics if the method is not appropriate.
+	ftyp, fn := methodReceiver(op, rcvr, int(v.flag)>>flagMethodShift)
+	fv := &closure{fn.fn, fn.ctx}
+	return Value{&closureType(ftyp).Type, unsafe.Pointer(fv), v.flag&flagRO | flagIndir | flag(Func)}
 }
-*/
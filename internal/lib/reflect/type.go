@@ -328,6 +328,40 @@ func (t *interfaceType) uncommon() *abi.UncommonType {
 	return t.Uncommon()
 }
 
+// Method returns the i'th method in the type's method set.
+func (t *interfaceType) Method(i int) (m Method) {
+	if i < 0 || i >= len(t.Methods) {
+		return
+	}
+	p := &t.Methods[i]
+	m.Name = p.Name()
+	if !p.Exported() {
+		m.PkgPath = p.PkgPath()
+		if m.PkgPath == "" {
+			m.PkgPath = t.PkgPath_
+		}
+	}
+	m.Type = toType(&p.Typ_.Type)
+	m.Index = i
+	return
+}
+
+// NumMethod returns the number of interface methods in the type's method set.
+func (t *interfaceType) NumMethod() int { return len(t.Methods) }
+
+// MethodByName method with the given name in the type's method set.
+func (t *interfaceType) MethodByName(name string) (m Method, ok bool) {
+	if t == nil {
+		return
+	}
+	for i := range t.Methods {
+		if t.Methods[i].Name() == name {
+			return t.Method(i), true
+		}
+	}
+	return
+}
+
 // mapType represents a map type.
 type mapType struct {
 	abi.MapType
@@ -351,6 +385,174 @@ type structType struct {
 	abi.StructType
 }
 
+// Field returns the i'th struct field.
+func (t *structType) Field(i int) (f StructField) {
+	if i < 0 || i >= len(t.Fields) {
+		panic("reflect: Field index out of bounds")
+	}
+	p := &t.Fields[i]
+	f.Type = toType(p.Typ)
+	f.Name = p.Name_
+	f.Anonymous = p.Embedded()
+	if !p.Exported() {
+		f.PkgPath = t.PkgPath_
+	}
+	f.Tag = StructTag(p.Tag_)
+	f.Offset = p.Offset
+	f.Index = []int{i}
+	return
+}
+
+// FieldByIndex returns the nested field corresponding to index.
+func (t *structType) FieldByIndex(index []int) (f StructField) {
+	f.Type = toType(&t.Type)
+	for i, x := range index {
+		if i > 0 {
+			ft := f.Type
+			if ft.Kind() == Pointer && ft.Elem().Kind() == Struct {
+				ft = ft.Elem()
+			}
+			f.Type = ft
+		}
+		f = f.Type.Field(x)
+	}
+	return
+}
+
+// A fieldScan represents an item on the fieldByNameFunc scan work list.
+type fieldScan struct {
+	typ   *structType
+	index []int
+}
+
+// FieldByNameFunc returns the struct field with a name that satisfies the
+// match function and a boolean to indicate if the field was found.
+func (t *structType) FieldByNameFunc(match func(string) bool) (result StructField, ok bool) {
+	// This uses the same condition that the Go language does: there must be a unique instance
+	// of the match at a given depth level. If there are multiple instances of a match at the
+	// same depth, they annihilate each other and inhibit any possible match at a lower level.
+	// The algorithm is breadth first search, one depth level at a time.
+
+	// The current and next slices are work queues:
+	// current lists the fields to visit on this depth level,
+	// and next lists the fields on the next lower level.
+	current := []fieldScan{}
+	next := []fieldScan{{typ: t}}
+
+	// nextCount records the number of times an embedded type has been
+	// encountered and considered for queueing in the 'next' slice.
+	// We only queue the first one, but we increment the count on each.
+	// If a struct type T can be reached more than once at a given depth level,
+	// then it annihilates itself and need not be considered at all when we
+	// process that next depth level.
+	var nextCount map[*structType]int
+
+	// visited records the structs that have been considered already.
+	// Embedded pointer fields can create cycles in the graph of
+	// reachable embedded types; visited avoids following those cycles.
+	// It also avoids duplicated effort: if we didn't find the field in an
+	// embedded type T at level 2, we won't find it in one at level 4 either.
+	visited := map[*structType]bool{}
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count := nextCount
+		nextCount = nil
+
+		// Process all the fields at this depth, now listed in 'current'.
+		// The loop queues embedded fields found in 'next', for processing during the next
+		// iteration. The multiplicity of the 'current' field counts is recorded
+		// in 'count'; the multiplicity of the 'next' field counts is recorded in 'nextCount'.
+		for _, scan := range current {
+			t := scan.typ
+			if visited[t] {
+				// We've looked through this type before, at a higher level.
+				// That higher level would shadow the lower level we're now at,
+				// so this one can't be useful to us. Ignore it.
+				continue
+			}
+			visited[t] = true
+			for i := range t.Fields {
+				f := &t.Fields[i]
+				// Find name and (for embedded field) type for field f.
+				fname := f.Name_
+				var ntyp *abi.Type
+				if f.Embedded() {
+					// Embedded field of type T or *T.
+					ntyp = f.Typ
+					if ntyp.Kind() == abi.Pointer {
+						ntyp = ntyp.Elem()
+					}
+				}
+
+				// Does it match?
+				if match(fname) {
+					// Potential match
+					if count[t] > 1 || ok {
+						// Name appeared multiple times at this level: annihilate.
+						return StructField{}, false
+					}
+					result = t.Field(i)
+					result.Index = nil
+					result.Index = append(result.Index, scan.index...)
+					result.Index = append(result.Index, i)
+					ok = true
+					continue
+				}
+
+				// Queue embedded struct fields for processing with next level,
+				// but only if we haven't seen a match yet at this level and only
+				// if the embedded types haven't already been queued.
+				if ok || ntyp == nil || ntyp.Kind() != abi.Struct {
+					continue
+				}
+				styp := (*structType)(unsafe.Pointer(ntyp))
+				if nextCount[styp] > 0 {
+					nextCount[styp] = 2 // exact multiple doesn't matter
+					continue
+				}
+				if nextCount == nil {
+					nextCount = map[*structType]int{}
+				}
+				nextCount[styp] = 1
+				if count[t] > 1 {
+					nextCount[styp] = 2 // exact multiple doesn't matter
+				}
+				var index []int
+				index = append(index, scan.index...)
+				index = append(index, i)
+				next = append(next, fieldScan{styp, index})
+			}
+		}
+		if ok {
+			break
+		}
+	}
+	return
+}
+
+// FieldByName returns the struct field with the given name
+// and a boolean to indicate if the field was found.
+func (t *structType) FieldByName(name string) (f StructField, present bool) {
+	// Quick check for top-level name, or struct without embedded fields.
+	hasEmbeds := false
+	if name != "" {
+		for i := range t.Fields {
+			tf := &t.Fields[i]
+			if tf.Name_ == name {
+				return t.Field(i), true
+			}
+			if tf.Embedded() {
+				hasEmbeds = true
+			}
+		}
+	}
+	if !hasEmbeds {
+		return
+	}
+	return t.FieldByNameFunc(func(s string) bool { return s == name })
+}
+
 /*
  * The compiler knows the exact layout of all the data structures above.
  * The compiler does not know about the data structures and methods below.
@@ -454,92 +656,78 @@ func (t *rtype) exportedMethods() []abi.Method {
 }
 
 func (t *rtype) NumMethod() int {
-	/*
-		if t.Kind() == Interface {
-			tt := (*interfaceType)(unsafe.Pointer(t))
-			return tt.NumMethod()
-		}
-		return len(t.exportedMethods())
-	*/
-	panic("todo")
+	if t.Kind() == Interface {
+		tt := (*interfaceType)(unsafe.Pointer(t))
+		return tt.NumMethod()
+	}
+	return len(t.exportedMethods())
 }
 
 func (t *rtype) Method(i int) (m Method) {
-	/*
-		if t.Kind() == Interface {
-			tt := (*interfaceType)(unsafe.Pointer(t))
-			return tt.Method(i)
-		}
-		methods := t.exportedMethods()
-		if i < 0 || i >= len(methods) {
-			panic("reflect: Method index out of range")
-		}
-		p := methods[i]
-		pname := t.nameOff(p.Name)
-		m.Name = pname.Name()
-		fl := flag(Func)
-		mtyp := t.typeOff(p.Mtyp)
-		ft := (*funcType)(unsafe.Pointer(mtyp))
-		in := make([]Type, 0, 1+ft.NumIn())
-		in = append(in, t)
-		for _, arg := range ft.InSlice() {
-			in = append(in, toRType(arg))
-		}
-		out := make([]Type, 0, ft.NumOut())
-		for _, ret := range ft.OutSlice() {
-			out = append(out, toRType(ret))
-		}
-		mt := FuncOf(in, out, ft.IsVariadic())
-		m.Type = mt
-		tfn := t.textOff(p.Tfn)
-		fn := unsafe.Pointer(&tfn)
-		m.Func = Value{&mt.(*rtype).t, fn, fl}
-
-		m.Index = i
-		return m
-	*/
-	panic("todo")
+	if t.Kind() == Interface {
+		tt := (*interfaceType)(unsafe.Pointer(t))
+		return tt.Method(i)
+	}
+	methods := t.exportedMethods()
+	if i < 0 || i >= len(methods) {
+		panic("reflect: Method index out of range")
+	}
+	p := &methods[i]
+	m.Name = p.Name()
+	ft := p.Mtyp_
+	in := make([]*abi.Type, 0, 1+len(ft.In))
+	in = append(in, &t.t)
+	in = append(in, ft.In...)
+	mt := runtime.Closure(runtime.Func(in, ft.Out, ft.Variadic(), nil, nil))
+	m.Type = toType(&mt.Type)
+	m.Func = makeMethodFunc(mt, &t.t, i)
+	m.Index = i
+	return m
 }
 
 func (t *rtype) MethodByName(name string) (m Method, ok bool) {
-	/*
-		if t.Kind() == Interface {
-			tt := (*interfaceType)(unsafe.Pointer(t))
-			return tt.MethodByName(name)
-		}
-		ut := t.uncommon()
-		if ut == nil {
-			return Method{}, false
-		}
+	if t.Kind() == Interface {
+		tt := (*interfaceType)(unsafe.Pointer(t))
+		return tt.MethodByName(name)
+	}
+	ut := t.uncommon()
+	if ut == nil {
+		return Method{}, false
+	}
 
-		methods := ut.ExportedMethods()
-
-		// We are looking for the first index i where the string becomes >= s.
-		// This is a copy of sort.Search, with f(h) replaced by (t.nameOff(methods[h].name).name() >= name).
-		i, j := 0, len(methods)
-		for i < j {
-			h := int(uint(i+j) >> 1) // avoid overflow when computing h
-			// i ≤ h < j
-			if !(t.nameOff(methods[h].Name).Name() >= name) {
-				i = h + 1 // preserves f(i-1) == false
-			} else {
-				j = h // preserves f(j) == true
-			}
-		}
-		// i == j, f(i-1) == false, and f(j) (= f(i)) == true  =>  answer is i.
-		if i < len(methods) && name == t.nameOff(methods[i].Name).Name() {
-			return t.Method(i), true
+	methods := ut.ExportedMethods()
+
+	// We are looking for the first index i where the string becomes >= s.
+	// This is a copy of sort.Search, with f(h) replaced by (methods[h].Name() >= name).
+	i, j := 0, len(methods)
+	for i < j {
+		h := int(uint(i+j) >> 1) // avoid overflow when computing h
+		// i ≤ h < j
+		if !(methods[h].Name() >= name) {
+			i = h + 1 // preserves f(i-1) == false
+		} else {
+			j = h // preserves f(j) == true
 		}
+	}
+	// i == j, f(i-1) == false, and f(j) (= f(i)) == true  =>  answer is i.
+	if i < len(methods) && name == methods[i].Name() {
+		return t.Method(i), true
+	}
 
-		return Method{}, false
-	*/
-	panic("todo")
+	return Method{}, false
 }
 
 func (t *rtype) PkgPath() string {
 	if t.t.TFlag&abi.TFlagNamed == 0 {
 		return ""
 	}
+	if t.Kind() == Interface {
+		// named interfaces have no uncommon data, see runtime.Interface
+		if !hasDot(t.t.Str_) { // predeclared, like error
+			return ""
+		}
+		return (*interfaceType)(unsafe.Pointer(t)).PkgPath_
+	}
 	ut := t.uncommon()
 	if ut == nil {
 		return ""
@@ -547,30 +735,36 @@ func (t *rtype) PkgPath() string {
 	return ut.PkgPath_
 }
 
+func hasDot(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			return true
+		}
+	}
+	return false
+}
+
 func pkgPathFor(t *abi.Type) string {
 	return toRType(t).PkgPath()
 }
 
 func (t *rtype) Name() string {
-	/*
-		if !t.t.HasName() {
-			return ""
-		}
-		s := t.String()
-		i := len(s) - 1
-		sqBrackets := 0
-		for i >= 0 && (s[i] != '.' || sqBrackets != 0) {
-			switch s[i] {
-			case ']':
-				sqBrackets++
-			case '[':
-				sqBrackets--
-			}
-			i--
+	if !t.t.HasName() {
+		return ""
+	}
+	s := t.String()
+	i := len(s) - 1
+	sqBrackets := 0
+	for i >= 0 && (s[i] != '.' || sqBrackets != 0) {
+		switch s[i] {
+		case ']':
+			sqBrackets++
+		case '[':
+			sqBrackets--
 		}
-		return s[i+1:]
-	*/
-	panic("todo")
+		i--
+	}
+	return s[i+1:]
 }
 
 func nameFor(t *abi.Type) string {
@@ -594,9 +788,7 @@ func elem(t *abi.Type) *abi.Type {
 	if et != nil {
 		return et
 	}
-	// TODO(xsw):
-	// panic("reflect: Elem of invalid type " + stringFor(t))
-	panic("todo")
+	panic("reflect: Elem of invalid type " + t.String())
 }
 
 func (t *rtype) Elem() Type {
@@ -604,47 +796,35 @@ func (t *rtype) Elem() Type {
 }
 
 func (t *rtype) Field(i int) StructField {
-	/*
-		if t.Kind() != Struct {
-			panic("reflect: Field of non-struct type " + t.String())
-		}
-		tt := (*structType)(unsafe.Pointer(t))
-		return tt.Field(i)
-	*/
-	panic("todo")
+	if t.Kind() != Struct {
+		panic("reflect: Field of non-struct type " + t.String())
+	}
+	tt := (*structType)(unsafe.Pointer(t))
+	return tt.Field(i)
 }
 
 func (t *rtype) FieldByIndex(index []int) StructField {
-	/*
-		if t.Kind() != Struct {
-			panic("reflect: FieldByIndex of non-struct type " + t.String())
-		}
-		tt := (*structType)(unsafe.Pointer(t))
-		return tt.FieldByIndex(index)
-	*/
-	panic("todo")
+	if t.Kind() != Struct {
+		panic("reflect: FieldByIndex of non-struct type " + t.String())
+	}
+	tt := (*structType)(unsafe.Pointer(t))
+	return tt.FieldByIndex(index)
 }
 
 func (t *rtype) FieldByName(name string) (StructField, bool) {
-	/*
-		if t.Kind() != Struct {
-			panic("reflect: FieldByName of non-struct type " + t.String())
-		}
-		tt := (*structType)(unsafe.Pointer(t))
-		return tt.FieldByName(name)
-	*/
-	panic("todo")
+	if t.Kind() != Struct {
+		panic("reflect: FieldByName of non-struct type " + t.String())
+	}
+	tt := (*structType)(unsafe.Pointer(t))
+	return tt.FieldByName(name)
 }
 
 func (t *rtype) FieldByNameFunc(match func(string) bool) (StructField, bool) {
-	/*
-		if t.Kind() != Struct {
-			panic("reflect: FieldByNameFunc of non-struct type " + t.String())
-		}
-		tt := (*structType)(unsafe.Pointer(t))
-		return tt.FieldByNameFunc(match)
-	*/
-	panic("todo")
+	if t.Kind() != Struct {
+		panic("reflect: FieldByNameFunc of non-struct type " + t.String())
+	}
+	tt := (*structType)(unsafe.Pointer(t))
+	return tt.FieldByNameFunc(match)
 }
 
 func (t *rtype) Key() Type {
@@ -672,58 +852,43 @@ func (t *rtype) NumField() int {
 }
 
 func (t *rtype) In(i int) Type {
-	/*
-		if t.Kind() != Func {
-			panic("reflect: In of non-func type " + t.String())
-		}
-		tt := (*abi.FuncType)(unsafe.Pointer(t))
-		return toType(tt.InSlice()[i])
-	*/
-	panic("todo")
+	if t.Kind() != Func {
+		panic("reflect: In of non-func type " + t.String())
+	}
+	tt := (*abi.FuncType)(unsafe.Pointer(t))
+	return toType(tt.In[i])
 }
 
 func (t *rtype) NumIn() int {
-	/*
-		if t.Kind() != Func {
-			panic("reflect: NumIn of non-func type " + t.String())
-		}
-		tt := (*abi.FuncType)(unsafe.Pointer(t))
-		return tt.NumIn()
-	*/
-	panic("todo")
+	if t.Kind() != Func {
+		panic("reflect: NumIn of non-func type " + t.String())
+	}
+	tt := (*abi.FuncType)(unsafe.Pointer(t))
+	return len(tt.In)
 }
 
 func (t *rtype) NumOut() int {
-	/*
-		if t.Kind() != Func {
-			panic("reflect: NumOut of non-func type " + t.String())
-		}
-		tt := (*abi.FuncType)(unsafe.Pointer(t))
-		return tt.NumOut()
-	*/
-	panic("todo")
+	if t.Kind() != Func {
+		panic("reflect: NumOut of non-func type " + t.String())
+	}
+	tt := (*abi.FuncType)(unsafe.Pointer(t))
+	return len(tt.Out)
 }
 
 func (t *rtype) Out(i int) Type {
-	/*
-		if t.Kind() != Func {
-			panic("reflect: Out of non-func type " + t.String())
-		}
-		tt := (*abi.FuncType)(unsafe.Pointer(t))
-		return toType(tt.OutSlice()[i])
-	*/
-	panic("todo")
+	if t.Kind() != Func {
+		panic("reflect: Out of non-func type " + t.String())
+	}
+	tt := (*abi.FuncType)(unsafe.Pointer(t))
+	return toType(tt.Out[i])
 }
 
 func (t *rtype) IsVariadic() bool {
-	/*
-		if t.Kind() != Func {
-			panic("reflect: IsVariadic of non-func type " + t.String())
-		}
-		tt := (*abi.FuncType)(unsafe.Pointer(t))
-		return tt.IsVariadic()
-	*/
-	panic("todo")
+	if t.Kind() != Func {
+		panic("reflect: IsVariadic of non-func type " + t.String())
+	}
+	tt := (*abi.FuncType)(unsafe.Pointer(t))
+	return tt.Variadic()
 }
 
 // add returns p+x.
@@ -873,50 +1038,7 @@ func PointerTo(t Type) Type {
 }
 
 func (t *rtype) ptrTo() *abi.Type {
-	/*
-		at := &t.t
-		if at.PtrToThis != 0 {
-			return t.typeOff(at.PtrToThis)
-		}
-
-		// Check the cache.
-		if pi, ok := ptrMap.Load(t); ok {
-			return &pi.(*ptrType).Type
-		}
-
-		// Look in known types.
-		s := "*" + t.String()
-		for _, tt := range typesByString(s) {
-			p := (*ptrType)(unsafe.Pointer(tt))
-			if p.Elem != &t.t {
-				continue
-			}
-			pi, _ := ptrMap.LoadOrStore(t, p)
-			return &pi.(*ptrType).Type
-		}
-
-		// Create a new ptrType starting with the description
-		// of an *unsafe.Pointer.
-		var iptr any = (*unsafe.Pointer)(nil)
-		prototype := *(**ptrType)(unsafe.Pointer(&iptr))
-		pp := *prototype
-
-		pp.Str = resolveReflectName(newName(s, "", false, false))
-		pp.PtrToThis = 0
-
-		// For the type structures linked into the binary, the
-		// compiler provides a good hash of the string.
-		// Create a good hash for the new string by using
-		// the FNV-1 hash's mixing function to combine the
-		// old hash and the new "*".
-		pp.Hash = fnv1(t.t.Hash, '*')
-
-		pp.Elem = at
-
-		pi, _ := ptrMap.LoadOrStore(t, &pp)
-		return &pi.(*ptrType).Type
-	*/
-	panic("todo")
+	return runtime.PointerTo(&t.t)
 }
 
 func ptrTo(t *abi.Type) *abi.Type {
@@ -933,37 +1055,28 @@ func fnv1(x uint32, list ...byte) uint32 {
 }
 
 func (t *rtype) Implements(u Type) bool {
-	/*
-		if u == nil {
-			panic("reflect: nil type passed to Type.Implements")
-		}
-		if u.Kind() != Interface {
-			panic("reflect: non-interface type passed to Type.Implements")
-		}
-		return implements(u.common(), t.common())
-	*/
-	panic("todo")
+	if u == nil {
+		panic("reflect: nil type passed to Type.Implements")
+	}
+	if u.Kind() != Interface {
+		panic("reflect: non-interface type passed to Type.Implements")
+	}
+	return implements(u.common(), t.common())
 }
 
 func (t *rtype) AssignableTo(u Type) bool {
-	/*
-		if u == nil {
-			panic("reflect: nil type passed to Type.AssignableTo")
-		}
-		uu := u.common()
-		return directlyAssignable(uu, t.common()) || implements(uu, t.common())
-	*/
-	panic("todo")
+	if u == nil {
+		panic("reflect: nil type passed to Type.AssignableTo")
+	}
+	uu := u.common()
+	return directlyAssignable(uu, t.common()) || implements(uu, t.common())
 }
 
 func (t *rtype) ConvertibleTo(u Type) bool {
-	/*
-		if u == nil {
-			panic("reflect: nil type passed to Type.ConvertibleTo")
-		}
-		return convertOp(u.common(), t.common()) != nil
-	*/
-	panic("todo")
+	if u == nil {
+		panic("reflect: nil type passed to Type.ConvertibleTo")
+	}
+	return convertible(u.common(), t.common())
 }
 
 func (t *rtype) Comparable() bool {
@@ -980,82 +1093,48 @@ func implements(T, V *abi.Type) bool {
 		return true
 	}
 
-	/*
-		// The same algorithm applies in both cases, but the
-		// method tables for an interface type and a concrete type
-		// are different, so the code is duplicated.
-		// In both cases the algorithm is a linear scan over the two
-		// lists - T's methods and V's methods - simultaneously.
-		// Since method tables are stored in a unique sorted order
-		// (alphabetical, with no duplicate method names), the scan
-		// through V's methods must hit a match for each of T's
-		// methods along the way, or else V does not implement T.
-		// This lets us run the scan in overall linear time instead of
-		// the quadratic time  a naive search would require.
-		// See also ../runtime/iface.go.
-		if V.Kind() == abi.Interface {
-			v := (*interfaceType)(unsafe.Pointer(V))
-			i := 0
-			for j := 0; j < len(v.Methods); j++ {
-				tm := &t.Methods[i]
-				tmName := t.nameOff(tm.Name)
-				vm := &v.Methods[j]
-				vmName := nameOffFor(V, vm.Name)
-				if vmName.Name() == tmName.Name() && typeOffFor(V, vm.Typ) == t.typeOff(tm.Typ) {
-					if !tmName.IsExported() {
-						tmPkgPath := pkgPath(tmName)
-						if tmPkgPath == "" {
-							tmPkgPath = t.PkgPath.Name()
-						}
-						vmPkgPath := pkgPath(vmName)
-						if vmPkgPath == "" {
-							vmPkgPath = v.PkgPath.Name()
-						}
-						if tmPkgPath != vmPkgPath {
-							continue
-						}
-					}
-					if i++; i >= len(t.Methods) {
-						return true
-					}
-				}
-			}
-			return false
-		}
-
-		v := V.Uncommon()
-		if v == nil {
-			return false
-		}
+	// The same algorithm applies in both cases, but the
+	// method tables for an interface type and a concrete type
+	// are different, so the code is duplicated.
+	// In both cases the algorithm is a linear scan over the two
+	// lists - T's methods and V's methods - simultaneously.
+	// Since method tables are stored in a unique sorted order
+	// (alphabetical, with no duplicate method names), the scan
+	// through V's methods must hit a match for each of T's
+	// methods along the way, or else V does not implement T.
+	// Names of unexported methods are qualified by their package
+	// paths, so comparing names is enough.
+	if V.Kind() == abi.Interface {
+		v := (*interfaceType)(unsafe.Pointer(V))
 		i := 0
-		vmethods := v.Methods()
-		for j := 0; j < int(v.Mcount); j++ {
+		for j := 0; j < len(v.Methods); j++ {
 			tm := &t.Methods[i]
-			tmName := t.nameOff(tm.Name)
-			vm := vmethods[j]
-			vmName := nameOffFor(V, vm.Name)
-			if vmName.Name() == tmName.Name() && typeOffFor(V, vm.Mtyp) == t.typeOff(tm.Typ) {
-				if !tmName.IsExported() {
-					tmPkgPath := pkgPath(tmName)
-					if tmPkgPath == "" {
-						tmPkgPath = t.PkgPath.Name()
-					}
-					vmPkgPath := pkgPath(vmName)
-					if vmPkgPath == "" {
-						vmPkgPath = nameOffFor(V, v.PkgPath).Name()
-					}
-					if tmPkgPath != vmPkgPath {
-						continue
-					}
-				}
+			vm := &v.Methods[j]
+			if vm.Name_ == tm.Name_ && haveIdenticalType(&vm.Typ_.Type, &tm.Typ_.Type, true) {
 				if i++; i >= len(t.Methods) {
 					return true
 				}
 			}
 		}
 		return false
-	*/
-	panic("todo")
+	}
+
+	v := V.Uncommon()
+	if v == nil {
+		return false
+	}
+	i := 0
+	vmethods := v.Methods()
+	for j := 0; j < int(v.Mcount); j++ {
+		tm := &t.Methods[i]
+		vm := &vmethods[j]
+		if vm.Name_ == tm.Name_ && haveIdenticalType(&vm.Mtyp_.Type, &tm.Typ_.Type, true) {
+			if i++; i >= len(t.Methods) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // specialChannelAssignability reports whether a value x of channel type V
@@ -1063,14 +1142,15 @@ func implements(T, V *abi.Type) bool {
 // https://golang.org/doc/go_spec.html#Assignability
 // T and V must be both of Chan kind.
 func specialChannelAssignability(T, V *abi.Type) bool {
-	/*
-		// Special case:
-		// x is a bidirectional channel value, T is a channel type,
-		// x's type V and T have identical element types,
-		// and at least one of V or T is not a defined type.
-		return V.ChanDir() == abi.BothDir && (nameFor(T) == "" || nameFor(V) == "") && haveIdenticalType(T.Elem(), V.Elem(), true)
-	*/
-	panic("todo")
+	// Special case:
+	// x is a bidirectional channel value, T is a channel type,
+	// x's type V and T have identical element types,
+	// and at least one of V or T is not a defined type.
+	return chanDir(V) == abi.BothDir && (nameFor(T) == "" || nameFor(V) == "") && haveIdenticalType(T.Elem(), V.Elem(), true)
+}
+
+func chanDir(t *abi.Type) abi.ChanDir {
+	return (*abi.ChanType)(unsafe.Pointer(t)).Dir
 }
 
 // directlyAssignable reports whether a value x of type V can be directly
@@ -1099,15 +1179,17 @@ func directlyAssignable(T, V *abi.Type) bool {
 }
 
 func haveIdenticalType(T, V *abi.Type, cmpTags bool) bool {
-	if cmpTags {
-		return T == V
+	if T == V {
+		return true
 	}
 
-	if nameFor(T) != nameFor(V) || T.Kind() != V.Kind() || pkgPathFor(T) != pkgPathFor(V) {
+	// Unlike gc, llgo doesn't make unnamed types unique (types made at run
+	// time, like by SliceOf, aren't), so they are compared structurally.
+	if T.HasName() || V.HasName() || T.Kind() != V.Kind() {
 		return false
 	}
 
-	return haveIdenticalUnderlyingType(T, V, false)
+	return haveIdenticalUnderlyingType(T, V, cmpTags)
 }
 
 func haveIdenticalUnderlyingType(T, V *abi.Type, cmpTags bool) bool {
@@ -1126,83 +1208,152 @@ func haveIdenticalUnderlyingType(T, V *abi.Type, cmpTags bool) bool {
 		return true
 	}
 
-	/*
-		// Composite types.
-		switch kind {
-		case Array:
-			return T.Len() == V.Len() && haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
+	// Composite types.
+	switch kind {
+	case Array:
+		return T.Len() == V.Len() && haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
 
-		case Chan:
-			return V.ChanDir() == T.ChanDir() && haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
+	case Chan:
+		return chanDir(V) == chanDir(T) && haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
 
-		case Func:
-			t := (*funcType)(unsafe.Pointer(T))
-			v := (*funcType)(unsafe.Pointer(V))
-			if t.OutCount != v.OutCount || t.InCount != v.InCount {
+	case Func:
+		t := (*funcType)(unsafe.Pointer(T))
+		v := (*funcType)(unsafe.Pointer(V))
+		if len(t.Out) != len(v.Out) || len(t.In) != len(v.In) || t.Variadic() != v.Variadic() {
+			return false
+		}
+		for i, in := range t.In {
+			if !haveIdenticalType(in, v.In[i], cmpTags) {
 				return false
 			}
-			for i := 0; i < t.NumIn(); i++ {
-				if !haveIdenticalType(t.In(i), v.In(i), cmpTags) {
-					return false
-				}
-			}
-			for i := 0; i < t.NumOut(); i++ {
-				if !haveIdenticalType(t.Out(i), v.Out(i), cmpTags) {
-					return false
-				}
+		}
+		for i, out := range t.Out {
+			if !haveIdenticalType(out, v.Out[i], cmpTags) {
+				return false
 			}
-			return true
+		}
+		return true
 
-		case Interface:
-			t := (*interfaceType)(unsafe.Pointer(T))
-			v := (*interfaceType)(unsafe.Pointer(V))
-			if len(t.Methods) == 0 && len(v.Methods) == 0 {
-				return true
-			}
-			// Might have the same methods but still
-			// need a run time conversion.
-			return false
+	case Interface:
+		t := (*interfaceType)(unsafe.Pointer(T))
+		v := (*interfaceType)(unsafe.Pointer(V))
+		if len(t.Methods) == 0 && len(v.Methods) == 0 {
+			return true
+		}
+		// Might have the same methods but still
+		// need a run time conversion.
+		return false
 
-		case Map:
-			return haveIdenticalType(T.Key(), V.Key(), cmpTags) && haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
+	case Map:
+		return haveIdenticalType(T.MapType().Key, V.MapType().Key, cmpTags) && haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
 
-		case Pointer, Slice:
-			return haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
+	case Pointer, Slice:
+		return haveIdenticalType(T.Elem(), V.Elem(), cmpTags)
 
-		case Struct:
-			t := (*structType)(unsafe.Pointer(T))
-			v := (*structType)(unsafe.Pointer(V))
-			if len(t.Fields) != len(v.Fields) {
+	case Struct:
+		t := (*structType)(unsafe.Pointer(T))
+		v := (*structType)(unsafe.Pointer(V))
+		if len(t.Fields) != len(v.Fields) {
+			return false
+		}
+		if t.PkgPath_ != v.PkgPath_ {
+			return false
+		}
+		for i := range t.Fields {
+			tf := &t.Fields[i]
+			vf := &v.Fields[i]
+			if tf.Name_ != vf.Name_ {
 				return false
 			}
-			if t.PkgPath.Name() != v.PkgPath.Name() {
+			if !haveIdenticalType(tf.Typ, vf.Typ, cmpTags) {
 				return false
 			}
-			for i := range t.Fields {
-				tf := &t.Fields[i]
-				vf := &v.Fields[i]
-				if tf.Name.Name() != vf.Name.Name() {
-					return false
-				}
-				if !haveIdenticalType(tf.Typ, vf.Typ, cmpTags) {
-					return false
-				}
-				if cmpTags && tf.Name.Tag() != vf.Name.Tag() {
-					return false
-				}
-				if tf.Offset != vf.Offset {
-					return false
-				}
-				if tf.Embedded() != vf.Embedded() {
-					return false
-				}
+			if cmpTags && tf.Tag_ != vf.Tag_ {
+				return false
+			}
+			if tf.Offset != vf.Offset {
+				return false
+			}
+			if tf.Embedded() != vf.Embedded() {
+				return false
 			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// convertible reports whether a value of type src can be converted to type
+// dst, like the conversion rules of Go.
+func convertible(dst, src *abi.Type) bool {
+	switch Kind(src.Kind()) {
+	case Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr:
+		switch Kind(dst.Kind()) {
+		case Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr,
+			Float32, Float64, String:
 			return true
 		}
 
-		return false
-	*/
-	panic("todo")
+	case Float32, Float64:
+		switch Kind(dst.Kind()) {
+		case Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr,
+			Float32, Float64:
+			return true
+		}
+
+	case Complex64, Complex128:
+		switch Kind(dst.Kind()) {
+		case Complex64, Complex128:
+			return true
+		}
+
+	case String:
+		if dst.Kind() == abi.Slice && pkgPathFor(dst.Elem()) == "" {
+			switch Kind(dst.Elem().Kind()) {
+			case Uint8, Int32:
+				return true
+			}
+		}
+
+	case Slice:
+		if dst.Kind() == abi.String && pkgPathFor(src.Elem()) == "" {
+			switch Kind(src.Elem().Kind()) {
+			case Uint8, Int32:
+				return true
+			}
+		}
+		// "x is a slice, T is a pointer-to-array type,
+		// and the slice and array types have identical element types."
+		if dst.Kind() == abi.Pointer && dst.Elem().Kind() == abi.Array &&
+			haveIdenticalType(src.Elem(), dst.Elem().Elem(), true) {
+			return true
+		}
+		// "x is a slice, T is an array type,
+		// and the slice and array types have identical element types."
+		if dst.Kind() == abi.Array && haveIdenticalType(src.Elem(), dst.Elem(), true) {
+			return true
+		}
+
+	case Chan:
+		if dst.Kind() == abi.Chan && specialChannelAssignability(dst, src) {
+			return true
+		}
+	}
+
+	// dst and src have same underlying type.
+	if haveIdenticalUnderlyingType(dst, src, false) {
+		return true
+	}
+
+	// dst and src are non-defined pointer types with same underlying base type.
+	if dst.Kind() == abi.Pointer && nameFor(dst) == "" &&
+		src.Kind() == abi.Pointer && nameFor(src) == "" &&
+		haveIdenticalUnderlyingType(elem(dst), elem(src), false) {
+		return true
+	}
+
+	return implements(dst, src)
 }
 
 // SliceOf returns the slice type with element type t.
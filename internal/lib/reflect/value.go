@@ -22,6 +22,7 @@ package reflect
 
 import (
 	"errors"
+	"math"
 	"unsafe"
 
 	"github.com/goplus/llgo/internal/abi"
@@ -119,16 +120,23 @@ func (v Value) typ() *abi.Type {
 // v.Kind() must be Pointer, Map, Chan, Func, or UnsafePointer
 // if v.Kind() == Pointer, the base type must not be not-in-heap.
 func (v Value) pointer() unsafe.Pointer {
-	/*
-		if v.typ().Size() != goarch.PtrSize || !v.typ().Pointers() {
-			panic("can't call pointer on a non-pointer Value")
-		}
-		if v.flag&flagIndir != 0 {
-			return *(*unsafe.Pointer)(v.ptr)
-		}
-		return v.ptr
-	*/
-	panic("todo")
+	if v.typ().Size() != unsafe.Sizeof(uintptr(0)) {
+		panic("can't call pointer on a non-pointer Value")
+	}
+	if v.flag&flagIndir != 0 {
+		return *(*unsafe.Pointer)(v.ptr)
+	}
+	return v.ptr
+}
+
+// data returns the address of the data of v. Unlike gc, llgo stores
+// integers and floats directly in interface words, so the data of a
+// direct value is the word v.ptr itself.
+func (v *Value) data() unsafe.Pointer {
+	if v.flag&flagIndir == 0 {
+		return unsafe.Pointer(&v.ptr)
+	}
+	return v.ptr
 }
 
 // packEface converts v to the empty interface.
@@ -154,8 +162,9 @@ func packEface(v Value) any {
 		e.word = ptr
 	case v.flag&flagIndir != 0:
 		// Value is indirect, but interface is direct. We need
-		// to load the data at v.ptr into the interface data word.
-		e.word = *(*unsafe.Pointer)(v.ptr)
+		// to load the data at v.ptr into the interface data word,
+		// which may be larger than the data.
+		memmove(unsafe.Pointer(&e.word), v.ptr, t.Size())
 	default:
 		// Value is direct, and so is the interface.
 		e.word = v.ptr
@@ -313,7 +322,7 @@ func (v Value) Bool() bool {
 	if v.kind() != Bool {
 		v.panicNotBool()
 	}
-	return *(*bool)(v.ptr)
+	return *(*bool)(v.data())
 }
 
 func (v Value) panicNotBool() {
@@ -334,28 +343,25 @@ func (v Value) Bytes() []byte {
 }
 
 func (v Value) bytesSlow() []byte {
-	/*
-		switch v.kind() {
-		case Slice:
-			if v.typ().Elem().Kind() != abi.Uint8 {
-				panic("reflect.Value.Bytes of non-byte slice")
-			}
-			// Slice is always bigger than a word; assume flagIndir.
-			return *(*[]byte)(v.ptr)
-		case Array:
-			if v.typ().Elem().Kind() != abi.Uint8 {
-				panic("reflect.Value.Bytes of non-byte array")
-			}
-			if !v.CanAddr() {
-				panic("reflect.Value.Bytes of unaddressable byte array")
-			}
-			p := (*byte)(v.ptr)
-			n := int((*arrayType)(unsafe.Pointer(v.typ())).Len)
-			return unsafe.Slice(p, n)
+	switch v.kind() {
+	case Slice:
+		if v.typ().Elem().Kind() != abi.Uint8 {
+			panic("reflect.Value.Bytes of non-byte slice")
 		}
-		panic(&ValueError{"reflect.Value.Bytes", v.kind()})
-	*/
-	panic("todo")
+		// Slice is always bigger than a word; assume flagIndir.
+		return *(*[]byte)(v.ptr)
+	case Array:
+		if v.typ().Elem().Kind() != abi.Uint8 {
+			panic("reflect.Value.Bytes of non-byte array")
+		}
+		if !v.CanAddr() {
+			panic("reflect.Value.Bytes of unaddressable byte array")
+		}
+		p := (*byte)(v.ptr)
+		n := int((*arrayType)(unsafe.Pointer(v.typ())).Len)
+		return unsafe.Slice(p, n)
+	}
+	panic(&ValueError{"reflect.Value.Bytes", v.kind()})
 }
 
 // runes returns v's underlying value.
@@ -387,21 +393,235 @@ func (v Value) CanSet() bool {
 	return v.flag&(flagAddr|flagRO) == flagAddr
 }
 
+// Call calls the function v with the input arguments in.
+// For example, if len(in) == 3, v.Call(in) represents the Go call v(in[0], in[1], in[2]).
+// Call panics if v's Kind is not Func.
+// It returns the output results as Values.
+// As in Go, each input argument must be assignable to the
+// type of the function's corresponding input parameter.
+// If v is a variadic function, Call creates the variadic slice parameter
+// itself, copying in the corresponding values.
+func (v Value) Call(in []Value) []Value {
+	v.mustBe(Func)
+	v.mustBeExported()
+	return v.call("Call", in)
+}
+
+// CallSlice calls the variadic function v with the input arguments in,
+// assigning the slice in[len(in)-1] to v's final variadic argument.
+// For example, if len(in) == 3, v.CallSlice(in) represents the Go call v(in[0], in[1], in[2]...).
+// CallSlice panics if v's Kind is not Func or if v is not variadic.
+// It returns the output results as Values.
+// As in Go, each input argument must be assignable to the
+// type of the function's corresponding input parameter.
+func (v Value) CallSlice(in []Value) []Value {
+	v.mustBe(Func)
+	v.mustBeExported()
+	return v.call("CallSlice", in)
+}
+
+// closure is the representation of func values in llgo: fn is called with
+// ctx as its first argument.
+type closure struct {
+	fn  unsafe.Pointer
+	ctx unsafe.Pointer
+}
+
+func (v Value) call(op string, in []Value) []Value {
+	// Get function pointer, type.
+	t := (*funcType)(unsafe.Pointer(v.typ()))
+	var fn closure
+	if v.flag&flagMethod != 0 {
+		t, fn = methodReceiver(op, v, int(v.flag)>>flagMethodShift)
+	} else if v.typ().IfaceIndir() {
+		fn = *(*closure)(v.ptr)
+	} else {
+		panic("reflect: " + op + " of raw function " + toRType(v.typ()).String())
+	}
+	if fn.fn == nil {
+		panic("reflect: call of nil function")
+	}
+	if fn.fn == methodFuncFn {
+		return (*methodFunc)(fn.ctx).call(op, in)
+	}
+
+	isSlice := op == "CallSlice"
+	n := len(t.In)
+	isVariadic := t.Variadic()
+	if isSlice {
+		if !isVariadic {
+			panic("reflect: CallSlice of non-variadic function")
+		}
+		if len(in) < n {
+			panic("reflect: CallSlice with too few input arguments")
+		}
+		if len(in) > n {
+			panic("reflect: CallSlice with too many input arguments")
+		}
+	} else {
+		if isVariadic {
+			n--
+		}
+		if len(in) < n {
+			panic("reflect: Call with too few input arguments")
+		}
+		if !isVariadic && len(in) > n {
+			panic("reflect: Call with too many input arguments")
+		}
+	}
+	for _, x := range in {
+		if x.Kind() == Invalid {
+			panic("reflect: " + op + " using zero Value argument")
+		}
+	}
+	for i := 0; i < n; i++ {
+		if xt, targ := in[i].Type(), t.In[i]; !xt.AssignableTo(toRType(targ)) {
+			panic("reflect: " + op + " using " + xt.String() + " as type " + toRType(targ).String())
+		}
+	}
+	if !isSlice && isVariadic {
+		// prepare slice for remaining values
+		m := len(in) - n
+		slice := MakeSlice(toRType(t.In[n]), m, m)
+		elem := toRType(t.In[n]).Elem()
+		for i := 0; i < m; i++ {
+			x := in[n+i]
+			if xt := x.Type(); !xt.AssignableTo(elem) {
+				panic("reflect: cannot use " + xt.String() + " as type " + elem.String() + " in " + op)
+			}
+			slice.Index(i).Set(x)
+		}
+		origIn := in
+		in = make([]Value, n+1)
+		copy(in[:n], origIn)
+		in[n] = slice
+	}
+
+	nin := len(in)
+	if nin != len(t.In) {
+		panic("reflect.Value.Call: wrong argument count")
+	}
+	if t.Call == nil {
+		panic("reflect: " + op + " of function type without call thunk: " + toRType(&t.Type).String())
+	}
+
+	// Copy inputs into the argument frame.
+	offs, size := frameLayout(t.In)
+	args := newFrame(size)
+	for i, x := range in {
+		targ := t.In[i]
+		x = x.assignTo("reflect.Value.Call", targ, nil)
+		typedmemmove(targ, add(args, offs[i], "offs[i] < size"), x.data())
+	}
+
+	offs, size = frameLayout(t.Out)
+	results := newFrame(size)
+	callThunk(t.Call, &fn, args, results)
+
+	// Wrap Values around the results, which are not shared: the frame is
+	// allocated for this call.
+	ret := make([]Value, len(t.Out))
+	for i, tv := range t.Out {
+		ret[i] = Value{tv, add(results, offs[i], "offs[i] < size"), flagIndir | flag(tv.Kind())}
+	}
+	return ret
+}
+
+// frameLayout returns the offsets of values of the types typs laid out like
+// struct fields in a frame, and the size of the frame (see abi.FuncType).
+func frameLayout(typs []*abi.Type) (offs []uintptr, size uintptr) {
+	offs = make([]uintptr, len(typs))
+	for i, t := range typs {
+		size = align(size, uintptr(t.Align_))
+		offs[i] = size
+		size += t.Size_
+	}
+	return
+}
+
+// newFrame allocates a zeroed frame of size bytes, aligned for any value.
+func newFrame(size uintptr) unsafe.Pointer {
+	const ptrSize = unsafe.Sizeof(uintptr(0))
+	n := (size + ptrSize - 1) / ptrSize
+	if n == 0 {
+		n = 1 // the thunks address the frame even if it's empty
+	}
+	buf := make([]unsafe.Pointer, n)
+	return unsafe.Pointer(&buf[0])
+}
+
+// align returns the result of rounding x up to a multiple of n.
+// n must be a power of two.
+func align(x, n uintptr) uintptr {
+	return (x + n - 1) &^ (n - 1)
+}
+
+// callThunk calls the closure fn through the call thunk of its type, which
+// reads the arguments from the frame args and stores the results into the
+// frame results (see abi.FuncType).
+func callThunk(call unsafe.Pointer, fn *closure, args, results unsafe.Pointer) {
+	thunk := closure{fn: call}
+	f := *(*func(fn, args, results unsafe.Pointer))(unsafe.Pointer(&thunk))
+	f(unsafe.Pointer(fn), args, results)
+}
+
+// methodReceiver returns information about the receiver
+// described by v. The Value v may or may not have the
+// flagMethod bit set, so the kind cached in v.flag should
+// not be used.
+// The return value t gives the method type, and fn the method as a
+// closure, whose context is the receiver as stored in interface values.
+func methodReceiver(op string, v Value, i int) (t *funcType, fn closure) {
+	if v.typ().Kind() == abi.Interface {
+		tt := (*interfaceType)(unsafe.Pointer(v.typ()))
+		if uint(i) >= uint(len(tt.Methods)) {
+			panic("reflect: internal error: invalid method index")
+		}
+		m := &tt.Methods[i]
+		if !m.Exported() {
+			panic("reflect: " + op + " of unexported method")
+		}
+		iface := (*nonEmptyInterface)(v.ptr)
+		if iface.itab == nil {
+			panic("reflect: " + op + " of method on nil interface value")
+		}
+		t = m.Typ_
+		fn = closure{iface.itab.fun[i], iface.word}
+	} else {
+		ms := toRType(v.typ()).exportedMethods()
+		if uint(i) >= uint(len(ms)) {
+			panic("reflect: internal error: invalid method index")
+		}
+		m := &ms[i]
+		if !m.Exported() {
+			panic("reflect: " + op + " of unexported method")
+		}
+		t = m.Mtyp_
+		fn = closure{m.Ifn_, storeRcvr(v)}
+	}
+	return
+}
+
+// storeRcvr returns the receiver v as it's stored in the data word of
+// interface values, which is the receiver the Ifn_ of methods expect.
+func storeRcvr(v Value) unsafe.Pointer {
+	fl := v.flag & (flagRO | flagAddr | flagIndir)
+	fl |= flag(v.typ().Kind())
+	i := packEface(Value{v.typ(), v.ptr, fl})
+	return (*emptyInterface)(unsafe.Pointer(&i)).word
+}
+
 // Cap returns v's capacity.
 // It panics if v's Kind is not Array, Chan, Slice or pointer to Array.
 func (v Value) Cap() int {
-	/* TODO(xsw):
 	// capNonSlice is split out to keep Cap inlineable for slice kinds.
 	if v.kind() == Slice {
-		return (*unsafeheader.Slice)(v.ptr).Cap
+		return (*unsafeheaderSlice)(v.ptr).Cap
 	}
 	return v.capNonSlice()
-	*/
-	panic("todo: reflect.Value.Cap")
 }
 
 func (v Value) capNonSlice() int {
-	/* TODO(xsw):
 	k := v.kind()
 	switch k {
 	case Array:
@@ -415,19 +635,14 @@ func (v Value) capNonSlice() int {
 		panic("reflect: call of reflect.Value.Cap on ptr to non-array Value")
 	}
 	panic(&ValueError{"reflect.Value.Cap", v.kind()})
-	*/
-	panic("todo: reflect.Value.capNonSlice")
 }
 
 // Close closes the channel v.
 // It panics if v's Kind is not Chan.
 func (v Value) Close() {
-	/* TODO(xsw):
 	v.mustBe(Chan)
 	v.mustBeExported()
 	chanclose(v.pointer())
-	*/
-	panic("todo: reflect.Value.Close")
 }
 
 // CanComplex reports whether Complex can be used without panicking.
@@ -446,9 +661,9 @@ func (v Value) Complex() complex128 {
 	k := v.kind()
 	switch k {
 	case Complex64:
-		return complex128(*(*complex64)(v.ptr))
+		return complex128(*(*complex64)(v.data()))
 	case Complex128:
-		return *(*complex128)(v.ptr)
+		return *(*complex128)(v.data())
 	}
 	panic(&ValueError{"reflect.Value.Complex", v.kind()})
 }
@@ -458,12 +673,11 @@ func (v Value) Complex() complex128 {
 // It panics if v's Kind is not Interface or Pointer.
 // It returns the zero Value if v is nil.
 func (v Value) Elem() Value {
-	/* TODO(xsw):
 	k := v.kind()
 	switch k {
 	case Interface:
 		var eface any
-		if v.typ().NumMethod() == 0 {
+		if toRType(v.typ()).NumMethod() == 0 {
 			eface = *(*any)(v.ptr)
 		} else {
 			eface = (any)(*(*interface {
@@ -478,21 +692,6 @@ func (v Value) Elem() Value {
 	case Pointer:
 		ptr := v.ptr
 		if v.flag&flagIndir != 0 {
-			if ifaceIndir(v.typ()) {
-				// This is a pointer to a not-in-heap object. ptr points to a uintptr
-				// in the heap. That uintptr is the address of a not-in-heap object.
-				// In general, pointers to not-in-heap objects can be total junk.
-				// But Elem() is asking to dereference it, so the user has asserted
-				// that at least it is a valid pointer (not just an integer stored in
-				// a pointer slot). So let's check, to make sure that it isn't a pointer
-				// that the runtime will crash on if it sees it during GC or write barriers.
-				// Since it is a not-in-heap pointer, all pointers to the heap are
-				// forbidden! That makes the test pretty easy.
-				// See issue 48399.
-				if !verifyNotInHeapPtr(*(*uintptr)(ptr)) {
-					panic("reflect: reflect.Value.Elem on an invalid notinheap pointer")
-				}
-			}
 			ptr = *(*unsafe.Pointer)(ptr)
 		}
 		// The returned value's address is v's value.
@@ -506,14 +705,11 @@ func (v Value) Elem() Value {
 		return Value{typ, ptr, fl}
 	}
 	panic(&ValueError{"reflect.Value.Elem", v.kind()})
-	*/
-	panic("todo: reflect.Value.Elem")
 }
 
 // Field returns the i'th field of the struct v.
 // It panics if v's Kind is not Struct or i is out of range.
 func (v Value) Field(i int) Value {
-	/* TODO(xsw):
 	if v.kind() != Struct {
 		panic(&ValueError{"reflect.Value.Field", v.kind()})
 	}
@@ -527,7 +723,7 @@ func (v Value) Field(i int) Value {
 	// Inherit permission bits from v, but clear flagEmbedRO.
 	fl := v.flag&(flagStickyRO|flagIndir|flagAddr) | flag(typ.Kind())
 	// Using an unexported field forces flagRO.
-	if !field.Name.IsExported() {
+	if !field.Exported() {
 		if field.Embedded() {
 			fl |= flagEmbedRO
 		} else {
@@ -541,8 +737,6 @@ func (v Value) Field(i int) Value {
 	// so v.ptr + field.offset is still the correct address.
 	ptr := add(v.ptr, field.Offset, "same as non-reflect &v.field")
 	return Value{typ, ptr, fl}
-	*/
-	panic("todo: reflect.Value.Field")
 }
 
 // FieldByIndex returns the nested field corresponding to index.
@@ -628,9 +822,9 @@ func (v Value) Float() float64 {
 	k := v.kind()
 	switch k {
 	case Float32:
-		return float64(*(*float32)(v.ptr))
+		return float64(*(*float32)(v.data()))
 	case Float64:
-		return *(*float64)(v.ptr)
+		return *(*float64)(v.data())
 	}
 	panic(&ValueError{"reflect.Value.Float", v.kind()})
 }
@@ -696,33 +890,18 @@ func (v Value) CanInt() bool {
 // Int returns v's underlying value, as an int64.
 // It panics if v's Kind is not Int, Int8, Int16, Int32, or Int64.
 func (v Value) Int() int64 {
-	f := v.flag
-	k := f.kind()
-	p := v.ptr
-	if f&flagAddr != 0 {
-		switch k {
-		case Int:
-			return int64(*(*int)(p))
-		case Int8:
-			return int64(*(*int8)(p))
-		case Int16:
-			return int64(*(*int16)(p))
-		case Int32:
-			return int64(*(*int32)(p))
-		case Int64:
-			return *(*int64)(p)
-		}
-	} else if unsafe.Sizeof(uintptr(0)) == 8 {
-		if k >= Int && k <= Int64 {
-			return int64(uintptr(p))
-		}
-	} else {
-		if k >= Int && k <= Int32 {
-			return int64(uintptr(p))
-		}
-		if k == Int64 {
-			return *(*int64)(p)
-		}
+	p := v.data()
+	switch v.kind() {
+	case Int:
+		return int64(*(*int)(p))
+	case Int8:
+		return int64(*(*int8)(p))
+	case Int16:
+		return int64(*(*int16)(p))
+	case Int32:
+		return int64(*(*int32)(p))
+	case Int64:
+		return *(*int64)(p)
 	}
 	panic(&ValueError{"reflect.Value.Int", v.kind()})
 }
@@ -747,36 +926,33 @@ func (v Value) Interface() (i any) {
 }
 
 func valueInterface(v Value, safe bool) any {
-	/*
-		if v.flag == 0 {
-			panic(&ValueError{"reflect.Value.Interface", Invalid})
-		}
-		if safe && v.flag&flagRO != 0 {
-			// Do not allow access to unexported values via Interface,
-			// because they might be pointers that should not be
-			// writable or methods or function that should not be callable.
-			panic("reflect.Value.Interface: cannot return value obtained from unexported field or method")
-		}
-		if v.flag&flagMethod != 0 {
-			v = makeMethodValue("Interface", v)
-		}
+	if v.flag == 0 {
+		panic(&ValueError{"reflect.Value.Interface", Invalid})
+	}
+	if safe && v.flag&flagRO != 0 {
+		// Do not allow access to unexported values via Interface,
+		// because they might be pointers that should not be
+		// writable or methods or function that should not be callable.
+		panic("reflect.Value.Interface: cannot return value obtained from unexported field or method")
+	}
+	if v.flag&flagMethod != 0 {
+		v = makeMethodValue("Interface", v)
+	}
 
-		if v.kind() == Interface {
-			// Special case: return the element inside the interface.
-			// Empty interface has one layout, all interfaces with
-			// methods have a second layout.
-			if v.NumMethod() == 0 {
-				return *(*any)(v.ptr)
-			}
-			return *(*interface {
-				M()
-			})(v.ptr)
+	if v.kind() == Interface {
+		// Special case: return the element inside the interface.
+		// Empty interface has one layout, all interfaces with
+		// methods have a second layout.
+		if v.NumMethod() == 0 {
+			return *(*any)(v.ptr)
 		}
+		return *(*interface {
+			M()
+		})(v.ptr)
+	}
 
-		// TODO: pass safe to packEface so we don't need to copy if safe==true?
-		return packEface(v)
-	*/
-	panic("todo")
+	// TODO: pass safe to packEface so we don't need to copy if safe==true?
+	return packEface(v)
 }
 
 // InterfaceData returns a pair of unspecified uintptr values.
@@ -789,18 +965,13 @@ func valueInterface(v Value, safe bool) any {
 // Deprecated: The memory representation of interface values is not
 // compatible with InterfaceData.
 func (v Value) InterfaceData() [2]uintptr {
-	/*
-		v.mustBe(Interface)
-		// The compiler loses track as it converts to uintptr. Force escape.
-		escapes(v.ptr)
-		// We treat this as a read operation, so we allow
-		// it even for unexported data, because the caller
-		// has to import "unsafe" to turn it into something
-		// that can be abused.
-		// Interface value is always bigger than a word; assume flagIndir.
-		return *(*[2]uintptr)(v.ptr)
-	*/
-	panic("todo")
+	v.mustBe(Interface)
+	// We treat this as a read operation, so we allow
+	// it even for unexported data, because the caller
+	// has to import "unsafe" to turn it into something
+	// that can be abused.
+	// Interface value is always bigger than a word; assume flagIndir.
+	return *(*[2]uintptr)(v.ptr)
 }
 
 // IsNil reports whether its argument v is nil. The argument must be
@@ -842,123 +1013,113 @@ func (v Value) IsValid() bool {
 // IsZero reports whether v is the zero value for its type.
 // It panics if the argument is invalid.
 func (v Value) IsZero() bool {
-	/*
-		switch v.kind() {
-		case Bool:
-			return !v.Bool()
-		case Int, Int8, Int16, Int32, Int64:
-			return v.Int() == 0
-		case Uint, Uint8, Uint16, Uint32, Uint64, Uintptr:
-			return v.Uint() == 0
-		case Float32, Float64:
-			return math.Float64bits(v.Float()) == 0
-		case Complex64, Complex128:
-			c := v.Complex()
-			return math.Float64bits(real(c)) == 0 && math.Float64bits(imag(c)) == 0
-		case Array:
-			// If the type is comparable, then compare directly with zero.
-			if v.typ().Equal != nil && v.typ().Size() <= maxZero {
-				if v.flag&flagIndir == 0 {
-					return v.ptr == nil
-				}
-				// v.ptr doesn't escape, as Equal functions are compiler generated
-				// and never escape. The escape analysis doesn't know, as it is a
-				// function pointer call.
-				return v.typ().Equal(noescape(v.ptr), unsafe.Pointer(&zeroVal[0]))
+	switch v.kind() {
+	case Bool:
+		return !v.Bool()
+	case Int, Int8, Int16, Int32, Int64:
+		return v.Int() == 0
+	case Uint, Uint8, Uint16, Uint32, Uint64, Uintptr:
+		return v.Uint() == 0
+	case Float32, Float64:
+		return math.Float64bits(v.Float()) == 0
+	case Complex64, Complex128:
+		c := v.Complex()
+		return math.Float64bits(real(c)) == 0 && math.Float64bits(imag(c)) == 0
+	case Array:
+		// If the type is comparable, then compare directly with zero.
+		if v.typ().Equal != nil && v.typ().Size() <= maxZero {
+			if v.flag&flagIndir == 0 {
+				return v.ptr == nil
 			}
+			return v.typ().Equal(v.ptr, unsafe.Pointer(&runtime.ZeroVal[0]))
+		}
 
-			n := v.Len()
-			for i := 0; i < n; i++ {
-				if !v.Index(i).IsZero() {
-					return false
-				}
+		n := v.Len()
+		for i := 0; i < n; i++ {
+			if !v.Index(i).IsZero() {
+				return false
 			}
-			return true
-		case Chan, Func, Interface, Map, Pointer, Slice, UnsafePointer:
-			return v.IsNil()
-		case String:
-			return v.Len() == 0
-		case Struct:
-			// If the type is comparable, then compare directly with zero.
-			if v.typ().Equal != nil && v.typ().Size() <= maxZero {
-				if v.flag&flagIndir == 0 {
-					return v.ptr == nil
-				}
-				// See noescape justification above.
-				return v.typ().Equal(noescape(v.ptr), unsafe.Pointer(&zeroVal[0]))
+		}
+		return true
+	case Chan, Func, Interface, Map, Pointer, Slice, UnsafePointer:
+		return v.IsNil()
+	case String:
+		return v.Len() == 0
+	case Struct:
+		// If the type is comparable, then compare directly with zero.
+		if v.typ().Equal != nil && v.typ().Size() <= maxZero {
+			if v.flag&flagIndir == 0 {
+				return v.ptr == nil
 			}
+			return v.typ().Equal(v.ptr, unsafe.Pointer(&runtime.ZeroVal[0]))
+		}
 
-			n := v.NumField()
-			for i := 0; i < n; i++ {
-				if !v.Field(i).IsZero() {
-					return false
-				}
+		n := v.NumField()
+		for i := 0; i < n; i++ {
+			if !v.Field(i).IsZero() {
+				return false
 			}
-			return true
-		default:
-			// This should never happen, but will act as a safeguard for later,
-			// as a default value doesn't makes sense here.
-			panic(&ValueError{"reflect.Value.IsZero", v.Kind()})
 		}
-	*/
-	panic("todo")
+		return true
+	default:
+		// This should never happen, but will act as a safeguard for later,
+		// as a default value doesn't makes sense here.
+		panic(&ValueError{"reflect.Value.IsZero", v.Kind()})
+	}
 }
 
 // SetZero sets v to be the zero value of v's type.
 // It panics if CanSet returns false.
 func (v Value) SetZero() {
-	/*
-		v.mustBeAssignable()
-		switch v.kind() {
-		case Bool:
-			*(*bool)(v.ptr) = false
-		case Int:
-			*(*int)(v.ptr) = 0
-		case Int8:
-			*(*int8)(v.ptr) = 0
-		case Int16:
-			*(*int16)(v.ptr) = 0
-		case Int32:
-			*(*int32)(v.ptr) = 0
-		case Int64:
-			*(*int64)(v.ptr) = 0
-		case Uint:
-			*(*uint)(v.ptr) = 0
-		case Uint8:
-			*(*uint8)(v.ptr) = 0
-		case Uint16:
-			*(*uint16)(v.ptr) = 0
-		case Uint32:
-			*(*uint32)(v.ptr) = 0
-		case Uint64:
-			*(*uint64)(v.ptr) = 0
-		case Uintptr:
-			*(*uintptr)(v.ptr) = 0
-		case Float32:
-			*(*float32)(v.ptr) = 0
-		case Float64:
-			*(*float64)(v.ptr) = 0
-		case Complex64:
-			*(*complex64)(v.ptr) = 0
-		case Complex128:
-			*(*complex128)(v.ptr) = 0
-		case String:
-			*(*string)(v.ptr) = ""
-		case Slice:
-			*(*unsafeheader.Slice)(v.ptr) = unsafeheader.Slice{}
-		case Interface:
-			*(*[2]unsafe.Pointer)(v.ptr) = [2]unsafe.Pointer{}
-		case Chan, Func, Map, Pointer, UnsafePointer:
-			*(*unsafe.Pointer)(v.ptr) = nil
-		case Array, Struct:
-			typedmemclr(v.typ(), v.ptr)
-		default:
-			// This should never happen, but will act as a safeguard for later,
-			// as a default value doesn't makes sense here.
-			panic(&ValueError{"reflect.Value.SetZero", v.Kind()})
-		}
-	*/
-	panic("todo")
+	v.mustBeAssignable()
+	switch v.kind() {
+	case Bool:
+		*(*bool)(v.ptr) = false
+	case Int:
+		*(*int)(v.ptr) = 0
+	case Int8:
+		*(*int8)(v.ptr) = 0
+	case Int16:
+		*(*int16)(v.ptr) = 0
+	case Int32:
+		*(*int32)(v.ptr) = 0
+	case Int64:
+		*(*int64)(v.ptr) = 0
+	case Uint:
+		*(*uint)(v.ptr) = 0
+	case Uint8:
+		*(*uint8)(v.ptr) = 0
+	case Uint16:
+		*(*uint16)(v.ptr) = 0
+	case Uint32:
+		*(*uint32)(v.ptr) = 0
+	case Uint64:
+		*(*uint64)(v.ptr) = 0
+	case Uintptr:
+		*(*uintptr)(v.ptr) = 0
+	case Float32:
+		*(*float32)(v.ptr) = 0
+	case Float64:
+		*(*float64)(v.ptr) = 0
+	case Complex64:
+		*(*complex64)(v.ptr) = 0
+	case Complex128:
+		*(*complex128)(v.ptr) = 0
+	case String:
+		*(*string)(v.ptr) = ""
+	case Slice:
+		*(*unsafeheaderSlice)(v.ptr) = unsafeheaderSlice{}
+	case Interface:
+		*(*[2]unsafe.Pointer)(v.ptr) = [2]unsafe.Pointer{}
+	case Chan, Func, Map, Pointer, UnsafePointer:
+		*(*unsafe.Pointer)(v.ptr) = nil
+	case Array, Struct:
+		typedmemclr(v.typ(), v.ptr)
+	default:
+		// This should never happen, but will act as a safeguard for later,
+		// as a default value doesn't makes sense here.
+		panic(&ValueError{"reflect.Value.SetZero", v.Kind()})
+	}
 }
 
 // Kind returns v's Kind.
@@ -978,27 +1139,312 @@ func (v Value) Len() int {
 }
 
 func (v Value) lenNonSlice() int {
-	/*
-		switch k := v.kind(); k {
-		case Array:
-			tt := (*arrayType)(unsafe.Pointer(v.typ()))
-			return int(tt.Len)
-		case Chan:
-			return chanlen(v.pointer())
-		case Map:
-			return maplen(v.pointer())
-		case String:
-			// String is bigger than a word; assume flagIndir.
-			return (*unsafeheader.String)(v.ptr).Len
-		case Ptr:
-			if v.typ().Elem().Kind() == abi.Array {
-				return v.typ().Elem().Len()
-			}
-			panic("reflect: call of reflect.Value.Len on ptr to non-array Value")
+	switch k := v.kind(); k {
+	case Array:
+		tt := (*arrayType)(unsafe.Pointer(v.typ()))
+		return int(tt.Len)
+	case Chan:
+		return chanlen(v.pointer())
+	case Map:
+		return maplen(v.pointer())
+	case String:
+		// String is bigger than a word; assume flagIndir.
+		return (*unsafeheaderString)(v.ptr).Len
+	case Ptr:
+		if v.typ().Elem().Kind() == abi.Array {
+			return v.typ().Elem().Len()
 		}
-		panic(&ValueError{"reflect.Value.Len", v.kind()})
-	*/
-	panic("todo")
+		panic("reflect: call of reflect.Value.Len on ptr to non-array Value")
+	}
+	panic(&ValueError{"reflect.Value.Len", v.kind()})
+}
+
+// MapIndex returns the value associated with key in the map v.
+// It panics if v's Kind is not Map.
+// It returns the zero Value if key is not found in the map or if v represents a nil map.
+// As in Go, the key's value must be assignable to the map's key type.
+func (v Value) MapIndex(key Value) Value {
+	v.mustBe(Map)
+	tt := (*mapType)(unsafe.Pointer(v.typ()))
+
+	// Do not require key to be exported, so that DeepEqual
+	// and other programs can use all the keys returned by
+	// MapKeys as arguments to MapIndex. If either the map
+	// or the key is unexported, though, the result will be
+	// considered unexported. This is consistent with the
+	// behavior for structs, which allow read but not write
+	// of unexported fields.
+
+	key = key.assignTo("reflect.Value.MapIndex", tt.Key, nil)
+	e := mapaccess(v.typ(), v.pointer(), key.data())
+	if e == nil {
+		return Value{}
+	}
+	typ := tt.Elem
+	fl := (v.flag | key.flag).ro()
+	fl |= flag(typ.Kind())
+	return copyVal(typ, fl, e)
+}
+
+// MapKeys returns a slice containing all the keys present in the map,
+// in unspecified order.
+// It panics if v's Kind is not Map.
+// It returns an empty slice if v represents a nil map.
+func (v Value) MapKeys() []Value {
+	v.mustBe(Map)
+	tt := (*mapType)(unsafe.Pointer(v.typ()))
+	keyType := tt.Key
+
+	fl := v.flag.ro() | flag(keyType.Kind())
+
+	m := v.pointer()
+	mlen := int(0)
+	if m != nil {
+		mlen = maplen(m)
+	}
+	if mlen == 0 {
+		return []Value{}
+	}
+	it := mapiterinit(v.typ(), m)
+	a := make([]Value, 0, mlen)
+	for {
+		ok, key, _ := mapiternext(it)
+		if !ok {
+			break
+		}
+		a = append(a, copyVal(keyType, fl, key))
+	}
+	return a
+}
+
+// A MapIter is an iterator for ranging over a map.
+// See Value.MapRange.
+type MapIter struct {
+	m    Value
+	it   unsafe.Pointer // *runtime.hiter, nil before the first call to Next
+	key  unsafe.Pointer
+	elem unsafe.Pointer
+}
+
+// Key returns the key of iter's current map entry.
+func (iter *MapIter) Key() Value {
+	if iter.it == nil {
+		panic("MapIter.Key called before Next")
+	}
+	if iter.key == nil {
+		panic("MapIter.Key called on exhausted iterator")
+	}
+
+	t := (*mapType)(unsafe.Pointer(iter.m.typ()))
+	ktype := t.Key
+	return copyVal(ktype, iter.m.flag.ro()|flag(ktype.Kind()), iter.key)
+}
+
+// Value returns the value of iter's current map entry.
+func (iter *MapIter) Value() Value {
+	if iter.it == nil {
+		panic("MapIter.Value called before Next")
+	}
+	if iter.key == nil {
+		panic("MapIter.Value called on exhausted iterator")
+	}
+
+	t := (*mapType)(unsafe.Pointer(iter.m.typ()))
+	vtype := t.Elem
+	return copyVal(vtype, iter.m.flag.ro()|flag(vtype.Kind()), iter.elem)
+}
+
+// Next advances the map iterator and reports whether there is another
+// entry. It returns false when iter is exhausted; subsequent
+// calls to Key, Value, or Next will panic.
+func (iter *MapIter) Next() bool {
+	if !iter.m.IsValid() {
+		panic("MapIter.Next called on an iterator that does not have an associated map Value")
+	}
+	if iter.it == nil {
+		iter.it = mapiterinit(iter.m.typ(), iter.m.pointer())
+	} else if iter.key == nil {
+		panic("MapIter.Next called on exhausted iterator")
+	}
+	var ok bool
+	ok, iter.key, iter.elem = mapiternext(iter.it)
+	return ok
+}
+
+// Reset modifies iter to iterate over v.
+// It panics if v's Kind is not Map and v is not the zero Value.
+// Reset(Value{}) causes iter to not to refer to any map,
+// which may allow the previously iterated-over map to be garbage collected.
+func (iter *MapIter) Reset(v Value) {
+	if v.IsValid() {
+		v.mustBe(Map)
+	}
+	*iter = MapIter{m: v}
+}
+
+// MapRange returns a range iterator for a map.
+// It panics if v's Kind is not Map.
+//
+// Call Next to advance the iterator, and Key/Value to access each entry.
+// Next returns false when the iterator is exhausted.
+// MapRange follows the same iteration semantics as a range statement.
+//
+// Example:
+//
+//	iter := reflect.ValueOf(m).MapRange()
+//	for iter.Next() {
+//		k := iter.Key()
+//		v := iter.Value()
+//		...
+//	}
+func (v Value) MapRange() *MapIter {
+	// This is inlinable to take advantage of "function outlining".
+	// The allocation of MapIter can be stack allocated if the caller
+	// does not allow it to escape.
+	// See https://blog.filippo.io/efficient-go-apis-with-the-inliner/
+	if v.kind() != Map {
+		v.panicNotMap()
+	}
+	return &MapIter{m: v}
+}
+
+// Force slow panicking path not inlined, so it won't add to the
+// inlining budget of the caller.
+// TODO: undo when the inliner is no longer bottom-up only.
+//
+//go:noinline
+func (f flag) panicNotMap() {
+	f.mustBe(Map)
+}
+
+// copyVal returns a Value containing the map key or value at ptr,
+// allocating a new variable as needed. Direct values in llgo may be
+// smaller than a word, so they are copied like indirect ones.
+func copyVal(typ *abi.Type, fl flag, ptr unsafe.Pointer) Value {
+	c := unsafe_New(typ)
+	typedmemmove(typ, c, ptr)
+	return Value{typ, c, fl | flagIndir}
+}
+
+// Method returns a function value corresponding to v's i'th method.
+// The arguments to a Call on the returned function should not include
+// a receiver; the returned function will always use v as the receiver.
+// Method panics if i is out of range or if v is a nil interface value.
+func (v Value) Method(i int) Value {
+	if v.typ() == nil {
+		panic(&ValueError{"reflect.Value.Method", Invalid})
+	}
+	if v.flag&flagMethod != 0 || uint(i) >= uint(toRType(v.typ()).NumMethod()) {
+		panic("reflect: Method index out of range")
+	}
+	if v.typ().Kind() == abi.Interface && v.IsNil() {
+		panic("reflect: Method on nil interface value")
+	}
+	fl := v.flag.ro() | (v.flag & flagIndir)
+	fl |= flag(Func)
+	fl |= flag(i)<<flagMethodShift | flagMethod
+	return Value{v.typ(), v.ptr, fl}
+}
+
+// NumMethod returns the number of methods in the value's method set.
+//
+// For a non-interface type, it returns the number of exported methods.
+//
+// For an interface type, it returns the number of exported and unexported methods.
+func (v Value) NumMethod() int {
+	if v.typ() == nil {
+		panic(&ValueError{"reflect.Value.NumMethod", Invalid})
+	}
+	if v.flag&flagMethod != 0 {
+		return 0
+	}
+	return toRType(v.typ()).NumMethod()
+}
+
+// MethodByName returns a function value corresponding to the method
+// of v with the given name.
+// The arguments to a Call on the returned function should not include
+// a receiver; the returned function will always use v as the receiver.
+// It returns the zero Value if no method was found.
+func (v Value) MethodByName(name string) Value {
+	if v.typ() == nil {
+		panic(&ValueError{"reflect.Value.MethodByName", Invalid})
+	}
+	if v.flag&flagMethod != 0 {
+		panic("reflect: MethodByName of method value")
+	}
+	m, ok := toRType(v.typ()).MethodByName(name)
+	if !ok {
+		return Value{}
+	}
+	return v.Method(m.Index)
+}
+
+// NumField returns the number of fields in the struct v.
+// It panics if v's Kind is not Struct.
+func (v Value) NumField() int {
+	v.mustBe(Struct)
+	tt := (*structType)(unsafe.Pointer(v.typ()))
+	return len(tt.Fields)
+}
+
+// OverflowComplex reports whether the complex128 x cannot be represented by v's type.
+// It panics if v's Kind is not Complex64 or Complex128.
+func (v Value) OverflowComplex(x complex128) bool {
+	k := v.kind()
+	switch k {
+	case Complex64:
+		return overflowFloat32(real(x)) || overflowFloat32(imag(x))
+	case Complex128:
+		return false
+	}
+	panic(&ValueError{"reflect.Value.OverflowComplex", v.kind()})
+}
+
+// OverflowFloat reports whether the float64 x cannot be represented by v's type.
+// It panics if v's Kind is not Float32 or Float64.
+func (v Value) OverflowFloat(x float64) bool {
+	k := v.kind()
+	switch k {
+	case Float32:
+		return overflowFloat32(x)
+	case Float64:
+		return false
+	}
+	panic(&ValueError{"reflect.Value.OverflowFloat", v.kind()})
+}
+
+func overflowFloat32(x float64) bool {
+	if x < 0 {
+		x = -x
+	}
+	return math.MaxFloat32 < x && x <= math.MaxFloat64
+}
+
+// OverflowInt reports whether the int64 x cannot be represented by v's type.
+// It panics if v's Kind is not Int, Int8, Int16, Int32, or Int64.
+func (v Value) OverflowInt(x int64) bool {
+	k := v.kind()
+	switch k {
+	case Int, Int8, Int16, Int32, Int64:
+		bitSize := v.typ().Size() * 8
+		trunc := (x << (64 - bitSize)) >> (64 - bitSize)
+		return x != trunc
+	}
+	panic(&ValueError{"reflect.Value.OverflowInt", v.kind()})
+}
+
+// OverflowUint reports whether the uint64 x cannot be represented by v's type.
+// It panics if v's Kind is not Uint, Uintptr, Uint8, Uint16, Uint32, or Uint64.
+func (v Value) OverflowUint(x uint64) bool {
+	k := v.kind()
+	switch k {
+	case Uint, Uintptr, Uint8, Uint16, Uint32, Uint64:
+		bitSize := v.typ_.Size() * 8 // ok to use v.typ_ directly as Size doesn't escape
+		trunc := (x << (64 - bitSize)) >> (64 - bitSize)
+		return x != trunc
+	}
+	panic(&ValueError{"reflect.Value.OverflowUint", v.kind()})
 }
 
 // Pointer returns v's value as a uintptr.
@@ -1012,52 +1458,10 @@ func (v Value) lenNonSlice() int {
 // If v's Kind is Slice, the returned pointer is to the first
 // element of the slice. If the slice is nil the returned value
 // is 0.  If the slice is empty but non-nil the return value is non-zero.
-//
-// It's preferred to use uintptr(Value.UnsafePointer()) to get the equivalent result.
-func (v Value) Pointer() uintptr {
-	/* TODO(xsw):
-	// The compiler loses track as it converts to uintptr. Force escape.
-	escapes(v.ptr)
-
-	k := v.kind()
-	switch k {
-	case Pointer:
-		if v.typ().PtrBytes == 0 {
-			val := *(*uintptr)(v.ptr)
-			// Since it is a not-in-heap pointer, all pointers to the heap are
-			// forbidden! See comment in Value.Elem and issue #48399.
-			if !verifyNotInHeapPtr(val) {
-				panic("reflect: reflect.Value.Pointer on an invalid notinheap pointer")
-			}
-			return val
-		}
-		fallthrough
-	case Chan, Map, UnsafePointer:
-		return uintptr(v.pointer())
-	case Func:
-		if v.flag&flagMethod != 0 {
-			// As the doc comment says, the returned pointer is an
-			// underlying code pointer but not necessarily enough to
-			// identify a single function uniquely. All method expressions
-			// created via reflect have the same underlying code pointer,
-			// so their Pointers are equal. The function used here must
-			// match the one used in makeMethodValue.
-			return methodValueCallCodePtr()
-		}
-		p := v.pointer()
-		// Non-nil func value points at data block.
-		// First word of data block is actual code.
-		if p != nil {
-			p = *(*unsafe.Pointer)(p)
-		}
-		return uintptr(p)
-
-	case Slice:
-		return uintptr((*unsafeheader.Slice)(v.ptr).Data)
-	}
-	panic(&ValueError{"reflect.Value.Pointer", v.kind()})
-	*/
-	panic("todo: reflect.Value.Pointer")
+//
+// It's preferred to use uintptr(Value.UnsafePointer()) to get the equivalent result.
+func (v Value) Pointer() uintptr {
+	return uintptr(v.UnsafePointer())
 }
 
 // Recv receives and returns a value from the channel v.
@@ -1074,7 +1478,6 @@ func (v Value) Recv() (x Value, ok bool) {
 // internal recv, possibly non-blocking (nb).
 // v is known to be a channel.
 func (v Value) recv(nb bool) (val Value, ok bool) {
-	/* TODO(xsw):
 	tt := (*chanType)(unsafe.Pointer(v.typ()))
 	if ChanDir(tt.Dir)&RecvDir == 0 {
 		panic("reflect: recv on send-only channel")
@@ -1082,20 +1485,18 @@ func (v Value) recv(nb bool) (val Value, ok bool) {
 	t := tt.Elem
 	val = Value{t, nil, flag(t.Kind())}
 	var p unsafe.Pointer
-	if ifaceIndir(t) {
+	if t.IfaceIndir() {
 		p = unsafe_New(t)
 		val.ptr = p
 		val.flag |= flagIndir
 	} else {
 		p = unsafe.Pointer(&val.ptr)
 	}
-	selected, ok := chanrecv(v.pointer(), nb, p)
+	selected, ok := chanrecv(v.pointer(), nb, p, t.Size())
 	if !selected {
 		val = Value{}
 	}
 	return
-	*/
-	panic("todo: reflect.Value.recv")
 }
 
 // Send sends x on the channel v.
@@ -1110,22 +1511,13 @@ func (v Value) Send(x Value) {
 // internal send, possibly non-blocking.
 // v is known to be a channel.
 func (v Value) send(x Value, nb bool) (selected bool) {
-	/* TODO(xsw):
 	tt := (*chanType)(unsafe.Pointer(v.typ()))
 	if ChanDir(tt.Dir)&SendDir == 0 {
 		panic("reflect: send on recv-only channel")
 	}
 	x.mustBeExported()
 	x = x.assignTo("reflect.Value.Send", tt.Elem, nil)
-	var p unsafe.Pointer
-	if x.flag&flagIndir != 0 {
-		p = x.ptr
-	} else {
-		p = unsafe.Pointer(&x.ptr)
-	}
-	return chansend(v.pointer(), p, nb)
-	*/
-	panic("todo: reflect.Value.send")
+	return chansend(v.pointer(), x.data(), nb, tt.Elem.Size())
 }
 
 // Set assigns x to the value v.
@@ -1140,14 +1532,10 @@ func (v Value) Set(x Value) {
 		target = v.ptr
 	}
 	x = x.assignTo("reflect.Set", v.typ(), target)
-	if x.flag&flagIndir != 0 {
-		if x.ptr == unsafe.Pointer(&runtime.ZeroVal[0]) {
-			typedmemclr(v.typ(), v.ptr)
-		} else {
-			typedmemmove(v.typ(), v.ptr, x.ptr)
-		}
+	if x.ptr == unsafe.Pointer(&runtime.ZeroVal[0]) {
+		typedmemclr(v.typ(), v.ptr)
 	} else {
-		*(*unsafe.Pointer)(v.ptr) = x.ptr
+		typedmemmove(v.typ(), v.ptr, x.data())
 	}
 }
 
@@ -1233,32 +1621,26 @@ func (v Value) SetInt(x int64) {
 // It panics if v's Kind is not Slice or if n is negative or
 // greater than the capacity of the slice.
 func (v Value) SetLen(n int) {
-	/* TODO(xsw):
 	v.mustBeAssignable()
 	v.mustBe(Slice)
-	s := (*unsafeheader.Slice)(v.ptr)
+	s := (*unsafeheaderSlice)(v.ptr)
 	if uint(n) > uint(s.Cap) {
 		panic("reflect: slice length out of range in SetLen")
 	}
 	s.Len = n
-	*/
-	panic("todo: reflect.Value.SetLen")
 }
 
 // SetCap sets v's capacity to n.
 // It panics if v's Kind is not Slice or if n is smaller than the length or
 // greater than the capacity of the slice.
 func (v Value) SetCap(n int) {
-	/* TODO(xsw):
 	v.mustBeAssignable()
 	v.mustBe(Slice)
-	s := (*unsafeheader.Slice)(v.ptr)
+	s := (*unsafeheaderSlice)(v.ptr)
 	if n < s.Len || n > s.Cap {
 		panic("reflect: slice capacity out of range in SetCap")
 	}
 	s.Cap = n
-	*/
-	panic("todo: reflect.Value.SetCap")
 }
 
 // SetMapIndex sets the element associated with key in the map v to elem.
@@ -1268,52 +1650,20 @@ func (v Value) SetCap(n int) {
 // As in Go, key's elem must be assignable to the map's key type,
 // and elem's value must be assignable to the map's elem type.
 func (v Value) SetMapIndex(key, elem Value) {
-	/* TODO(xsw):
 	v.mustBe(Map)
 	v.mustBeExported()
 	key.mustBeExported()
 	tt := (*mapType)(unsafe.Pointer(v.typ()))
 
-	if (tt.Key == stringType || key.kind() == String) && tt.Key == key.typ() && tt.Elem.Size() <= maxValSize {
-		k := *(*string)(key.ptr)
-		if elem.typ() == nil {
-			mapdelete_faststr(v.typ(), v.pointer(), k)
-			return
-		}
-		elem.mustBeExported()
-		elem = elem.assignTo("reflect.Value.SetMapIndex", tt.Elem, nil)
-		var e unsafe.Pointer
-		if elem.flag&flagIndir != 0 {
-			e = elem.ptr
-		} else {
-			e = unsafe.Pointer(&elem.ptr)
-		}
-		mapassign_faststr(v.typ(), v.pointer(), k, e)
-		return
-	}
-
 	key = key.assignTo("reflect.Value.SetMapIndex", tt.Key, nil)
-	var k unsafe.Pointer
-	if key.flag&flagIndir != 0 {
-		k = key.ptr
-	} else {
-		k = unsafe.Pointer(&key.ptr)
-	}
+	k := key.data()
 	if elem.typ() == nil {
 		mapdelete(v.typ(), v.pointer(), k)
 		return
 	}
 	elem.mustBeExported()
 	elem = elem.assignTo("reflect.Value.SetMapIndex", tt.Elem, nil)
-	var e unsafe.Pointer
-	if elem.flag&flagIndir != 0 {
-		e = elem.ptr
-	} else {
-		e = unsafe.Pointer(&elem.ptr)
-	}
-	mapassign(v.typ(), v.pointer(), k, e)
-	*/
-	panic("todo: reflect.Value.SetMapIndex")
+	mapassign(v.typ(), v.pointer(), k, elem.data())
 }
 
 // SetUint sets v's underlying value to x.
@@ -1358,7 +1708,6 @@ func (v Value) SetString(x string) {
 // It panics if v's Kind is not Array, Slice or String, or if v is an unaddressable array,
 // or if the indexes are out of bounds.
 func (v Value) Slice(i, j int) Value {
-	/* TODO(xsw):
 	var (
 		cap  int
 		typ  *sliceType
@@ -1379,20 +1728,20 @@ func (v Value) Slice(i, j int) Value {
 
 	case Slice:
 		typ = (*sliceType)(unsafe.Pointer(v.typ()))
-		s := (*unsafeheader.Slice)(v.ptr)
+		s := (*unsafeheaderSlice)(v.ptr)
 		base = s.Data
 		cap = s.Cap
 
 	case String:
-		s := (*unsafeheader.String)(v.ptr)
+		s := (*unsafeheaderString)(v.ptr)
 		if i < 0 || j < i || j > s.Len {
 			panic("reflect.Value.Slice: string slice index out of bounds")
 		}
-		var t unsafeheader.String
+		t := new(unsafeheaderString)
 		if i < s.Len {
-			t = unsafeheader.String{Data: arrayAt(s.Data, i, 1, "i < s.Len"), Len: j - i}
+			*t = unsafeheaderString{Data: arrayAt(s.Data, i, 1, "i < s.Len"), Len: j - i}
 		}
-		return Value{v.typ(), unsafe.Pointer(&t), v.flag}
+		return Value{v.typ(), unsafe.Pointer(t), v.flag}
 	}
 
 	if i < 0 || j < i || j > cap {
@@ -1400,10 +1749,10 @@ func (v Value) Slice(i, j int) Value {
 	}
 
 	// Declare slice so that gc can see the base pointer in it.
-	var x []unsafe.Pointer
+	x := new([]unsafe.Pointer)
 
-	// Reinterpret as *unsafeheader.Slice to edit.
-	s := (*unsafeheader.Slice)(unsafe.Pointer(&x))
+	// Reinterpret as *unsafeheaderSlice to edit.
+	s := (*unsafeheaderSlice)(unsafe.Pointer(x))
 	s.Len = j - i
 	s.Cap = cap - i
 	if cap-i > 0 {
@@ -1414,16 +1763,13 @@ func (v Value) Slice(i, j int) Value {
 	}
 
 	fl := v.flag.ro() | flagIndir | flag(Slice)
-	return Value{typ.Common(), unsafe.Pointer(&x), fl}
-	*/
-	panic("todo: reflect.Value.Slice")
+	return Value{typ.Common(), unsafe.Pointer(x), fl}
 }
 
 // Slice3 is the 3-index form of the slice operation: it returns v[i:j:k].
 // It panics if v's Kind is not Array or Slice, or if v is an unaddressable array,
 // or if the indexes are out of bounds.
 func (v Value) Slice3(i, j, k int) Value {
-	/* TODO(xsw):
 	var (
 		cap  int
 		typ  *sliceType
@@ -1444,7 +1790,7 @@ func (v Value) Slice3(i, j, k int) Value {
 
 	case Slice:
 		typ = (*sliceType)(unsafe.Pointer(v.typ()))
-		s := (*unsafeheader.Slice)(v.ptr)
+		s := (*unsafeheaderSlice)(v.ptr)
 		base = s.Data
 		cap = s.Cap
 	}
@@ -1455,10 +1801,10 @@ func (v Value) Slice3(i, j, k int) Value {
 
 	// Declare slice so that the garbage collector
 	// can see the base pointer in it.
-	var x []unsafe.Pointer
+	x := new([]unsafe.Pointer)
 
-	// Reinterpret as *unsafeheader.Slice to edit.
-	s := (*unsafeheader.Slice)(unsafe.Pointer(&x))
+	// Reinterpret as *unsafeheaderSlice to edit.
+	s := (*unsafeheaderSlice)(unsafe.Pointer(x))
 	s.Len = j - i
 	s.Cap = k - i
 	if k-i > 0 {
@@ -1469,9 +1815,7 @@ func (v Value) Slice3(i, j, k int) Value {
 	}
 
 	fl := v.flag.ro() | flagIndir | flag(Slice)
-	return Value{typ.Common(), unsafe.Pointer(&x), fl}
-	*/
-	panic("todo: reflect.Value.Slice3")
+	return Value{typ.Common(), unsafe.Pointer(x), fl}
 }
 
 // String returns the string v's underlying value, as a string.
@@ -1503,12 +1847,9 @@ func (v Value) stringNonString() string {
 // If the receive cannot finish without blocking, x is the zero Value and ok is false.
 // If the channel is closed, x is the zero value for the channel's element type and ok is false.
 func (v Value) TryRecv() (x Value, ok bool) {
-	/* TODO(xsw):
 	v.mustBe(Chan)
 	v.mustBeExported()
 	return v.recv(true)
-	*/
-	panic("todo: reflect.Value.TryRecv")
 }
 
 // TrySend attempts to send x on the channel v but will not block.
@@ -1516,12 +1857,9 @@ func (v Value) TryRecv() (x Value, ok bool) {
 // It reports whether the value was sent.
 // As in Go, x's value must be assignable to the channel's element type.
 func (v Value) TrySend(x Value) bool {
-	/* TODO(xsw):
 	v.mustBe(Chan)
 	v.mustBeExported()
 	return v.send(x, true)
-	*/
-	panic("todo: reflect.Value.TrySend")
 }
 
 // Type returns v's type.
@@ -1533,7 +1871,6 @@ func (v Value) Type() Type {
 }
 
 func (v Value) typeSlow() Type {
-	/* TODO(xsw):
 	if v.flag == 0 {
 		panic(&ValueError{"reflect.Value.Type", Invalid})
 	}
@@ -1553,17 +1890,15 @@ func (v Value) typeSlow() Type {
 			panic("reflect: internal error: invalid method index")
 		}
 		m := &tt.Methods[i]
-		return toRType(typeOffFor(typ, m.Typ))
+		return toRType(&closureType(m.Typ_).Type)
 	}
 	// Method on concrete type.
-	ms := typ.ExportedMethods()
+	ms := toRType(typ).exportedMethods()
 	if uint(i) >= uint(len(ms)) {
 		panic("reflect: internal error: invalid method index")
 	}
-	m := ms[i]
-	return toRType(typeOffFor(typ, m.Mtyp))
-	*/
-	panic("todo: reflect.Value.Type")
+	m := &ms[i]
+	return toRType(&closureType(m.Mtyp_).Type)
 }
 
 // CanUint reports whether Uint can be used without panicking.
@@ -1580,7 +1915,7 @@ func (v Value) CanUint() bool {
 // It panics if v's Kind is not Uint, Uintptr, Uint8, Uint16, Uint32, or Uint64.
 func (v Value) Uint() uint64 {
 	k := v.kind()
-	p := v.ptr
+	p := v.data()
 	switch k {
 	case Uint:
 		return uint64(*(*uint)(p))
@@ -1629,46 +1964,28 @@ func (v Value) UnsafeAddr() uintptr {
 // element of the slice. If the slice is nil the returned value
 // is nil.  If the slice is empty but non-nil the return value is non-nil.
 func (v Value) UnsafePointer() unsafe.Pointer {
-	/* TODO(xsw):
 	k := v.kind()
 	switch k {
-	case Pointer:
-		if v.typ().PtrBytes == 0 {
-			// Since it is a not-in-heap pointer, all pointers to the heap are
-			// forbidden! See comment in Value.Elem and issue #48399.
-			if !verifyNotInHeapPtr(*(*uintptr)(v.ptr)) {
-				panic("reflect: reflect.Value.UnsafePointer on an invalid notinheap pointer")
-			}
-			return *(*unsafe.Pointer)(v.ptr)
-		}
-		fallthrough
-	case Chan, Map, UnsafePointer:
+	case Pointer, Chan, Map, UnsafePointer:
 		return v.pointer()
 	case Func:
 		if v.flag&flagMethod != 0 {
-			// As the doc comment says, the returned pointer is an
-			// underlying code pointer but not necessarily enough to
-			// identify a single function uniquely. All method expressions
-			// created via reflect have the same underlying code pointer,
-			// so their Pointers are equal. The function used here must
-			// match the one used in makeMethodValue.
-			code := methodValueCallCodePtr()
-			return *(*unsafe.Pointer)(unsafe.Pointer(&code))
-		}
-		p := v.pointer()
-		// Non-nil func value points at data block.
-		// First word of data block is actual code.
-		if p != nil {
+			// The code pointer of a method value is the function of the
+			// method taking the receiver as its context (see makeMethodValue).
+			_, fn := methodReceiver("UnsafePointer", v, int(v.flag)>>flagMethodShift)
+			return fn.fn
+		}
+		// A func value starts with its code pointer.
+		p := v.ptr
+		if v.flag&flagIndir != 0 {
 			p = *(*unsafe.Pointer)(p)
 		}
 		return p
 
 	case Slice:
-		return (*unsafeheader.Slice)(v.ptr).Data
+		return (*unsafeheaderSlice)(v.ptr).Data
 	}
 	panic(&ValueError{"reflect.Value.UnsafePointer", v.kind()})
-	*/
-	panic("todo: reflect.Value.UnsafePointer")
 }
 
 //go:linkname unsafe_New github.com/goplus/llgo/internal/runtime.New
@@ -1677,6 +1994,138 @@ func unsafe_New(*abi.Type) unsafe.Pointer
 //go:linkname unsafe_NewArray github.com/goplus/llgo/internal/runtime.NewArray
 func unsafe_NewArray(*abi.Type, int) unsafe.Pointer
 
+// Copy copies the contents of src into dst until either
+// dst has been filled or src has been exhausted.
+// It returns the number of elements copied.
+// Dst and src each must have kind Slice or Array, and
+// dst and src must have the same element type.
+//
+// As a special case, src can be a String if the element type of dst is kind Uint8.
+func Copy(dst, src Value) int {
+	dk := dst.kind()
+	if dk != Array && dk != Slice {
+		panic(&ValueError{"reflect.Copy", dk})
+	}
+	if dk == Array {
+		dst.mustBeAssignable()
+	}
+	dst.mustBeExported()
+
+	sk := src.kind()
+	var stringCopy bool
+	if sk != Array && sk != Slice {
+		stringCopy = sk == String && dst.typ().Elem().Kind() == abi.Uint8
+		if !stringCopy {
+			panic(&ValueError{"reflect.Copy", sk})
+		}
+	}
+	src.mustBeExported()
+
+	de := dst.typ().Elem()
+	if !stringCopy {
+		se := src.typ().Elem()
+		typesMustMatch("reflect.Copy", toType(de), toType(se))
+	}
+
+	var ds, ss unsafeheaderSlice
+	if dk == Array {
+		ds.Data = dst.ptr
+		ds.Len = dst.Len()
+		ds.Cap = ds.Len
+	} else {
+		ds = *(*unsafeheaderSlice)(dst.ptr)
+	}
+	if sk == Array {
+		ss.Data = src.ptr
+		ss.Len = src.Len()
+		ss.Cap = ss.Len
+	} else if sk == Slice {
+		ss = *(*unsafeheaderSlice)(src.ptr)
+	} else {
+		sh := *(*unsafeheaderString)(src.ptr)
+		ss.Data = sh.Data
+		ss.Len = sh.Len
+		ss.Cap = sh.Len
+	}
+
+	n := ds.Len
+	if ss.Len < n {
+		n = ss.Len
+	}
+	if n > 0 {
+		memmove(ds.Data, ss.Data, uintptr(n)*de.Size())
+	}
+	return n
+}
+
+func typesMustMatch(what string, t1, t2 Type) {
+	if t1 != t2 {
+		panic(what + ": " + t1.String() + " != " + t2.String())
+	}
+}
+
+// Indirect returns the value that v points to.
+// If v is a nil pointer, Indirect returns a zero Value.
+// If v is not a pointer, Indirect returns v.
+func Indirect(v Value) Value {
+	if v.Kind() != Pointer {
+		return v
+	}
+	return v.Elem()
+}
+
+// MakeSlice creates a new zero-initialized slice value
+// for the specified slice type, length, and capacity.
+func MakeSlice(typ Type, len, cap int) Value {
+	if typ.Kind() != Slice {
+		panic("reflect.MakeSlice of non-slice type")
+	}
+	if len < 0 {
+		panic("reflect.MakeSlice: negative len")
+	}
+	if cap < 0 {
+		panic("reflect.MakeSlice: negative cap")
+	}
+	if len > cap {
+		panic("reflect.MakeSlice: len > cap")
+	}
+
+	s := &unsafeheaderSlice{Data: unsafe_NewArray(&(typ.Elem().(*rtype).t), cap), Len: len, Cap: cap}
+	return Value{&typ.(*rtype).t, unsafe.Pointer(s), flagIndir | flag(Slice)}
+}
+
+// MakeChan creates a new channel with the specified type and buffer size.
+func MakeChan(typ Type, buffer int) Value {
+	if typ.Kind() != Chan {
+		panic("reflect.MakeChan of non-chan type")
+	}
+	if buffer < 0 {
+		panic("reflect.MakeChan: negative buffer size")
+	}
+	if typ.ChanDir() != BothDir {
+		panic("reflect.MakeChan: unidirectional channel type")
+	}
+	t := typ.common()
+	ch := makechan(int(t.Elem().Size()), buffer)
+	return Value{t, ch, flag(Chan)}
+}
+
+// MakeMap creates a new map with the specified type.
+func MakeMap(typ Type) Value {
+	return MakeMapWithSize(typ, 0)
+}
+
+// MakeMapWithSize creates a new map with the specified type
+// and initial space for approximately n elements.
+func MakeMapWithSize(typ Type, n int) Value {
+	if typ.Kind() != Map {
+		panic("reflect.MakeMapWithSize of non-map type")
+	}
+	t := typ.common()
+	m := makemap(t, n)
+	return Value{t, m, flag(Map)}
+}
+
 // ValueOf returns a new Value initialized to the concrete value
 // stored in the interface i. ValueOf(nil) returns the zero Value.
 func ValueOf(i any) Value {
@@ -1762,17 +2211,14 @@ func Append(s Value, x ...Value) Value {
 // AppendSlice appends a slice t to a slice s and returns the resulting slice.
 // The slices s and t must have the same element type.
 func AppendSlice(s, t Value) Value {
-	/*
-		s.mustBe(Slice)
-		t.mustBe(Slice)
-		typesMustMatch("reflect.AppendSlice", s.Type().Elem(), t.Type().Elem())
-		ns := s.Len()
-		nt := t.Len()
-		s = s.extendSlice(nt)
-		Copy(s.Slice(ns, ns+nt), t)
-		return s
-	*/
-	panic("todo")
+	s.mustBe(Slice)
+	t.mustBe(Slice)
+	typesMustMatch("reflect.AppendSlice", s.Type().Elem(), t.Type().Elem())
+	ns := s.Len()
+	nt := t.Len()
+	s = s.extendSlice(nt)
+	Copy(s.Slice(ns, ns+nt), t)
+	return s
 }
 
 // Zero returns a Value representing the zero value for the specified type.
@@ -1799,27 +2245,20 @@ func Zero(typ Type) Value {
 }
 
 // TODO(xsw): check this
-// must match declarations in runtime/map.go.
+// must match declarations in runtime/map_swiss.go.
 const maxZero = runtime.MaxZero
 
 // New returns a Value representing a pointer to a new zero value
 // for the specified type. That is, the returned Value's Type is PointerTo(typ).
 func New(typ Type) Value {
-	/*
-		if typ == nil {
-			panic("reflect: New(nil)")
-		}
-		t := &typ.(*rtype).t
-		pt := ptrTo(t)
-		if ifaceIndir(pt) {
-			// This is a pointer to a not-in-heap type.
-			panic("reflect: New of type that may not be allocated in heap (possibly undefined cgo C type)")
-		}
-		ptr := unsafe_New(t)
-		fl := flag(Pointer)
-		return Value{pt, ptr, fl}
-	*/
-	panic("todo")
+	if typ == nil {
+		panic("reflect: New(nil)")
+	}
+	t := &typ.(*rtype).t
+	pt := ptrTo(t)
+	ptr := unsafe_New(t)
+	fl := flag(Pointer)
+	return Value{pt, ptr, fl}
 }
 
 // NewAt returns a Value representing a pointer to a value of the
@@ -1855,24 +2294,410 @@ func (v Value) assignTo(context string, dst *abi.Type, target unsafe.Pointer) Va
 			// Avoid the panic by returning a nil dst (e.g., Reader) explicitly.
 			return Value{dst, nil, flag(Interface)}
 		}
-		/* TODO(xsw):
 		x := valueInterface(v, false)
 		if target == nil {
 			target = unsafe_New(dst)
 		}
-		if dst.NumMethod() == 0 {
+		if toRType(dst).NumMethod() == 0 {
 			*(*any)(target) = x
 		} else {
 			ifaceE2I(dst, x, target)
 		}
 		return Value{dst, target, flagIndir | flag(Interface)}
-		*/
 	}
 
 	// Failed.
-	// TODO(xsw):
-	// panic(context + ": value of type " + stringFor(v.typ()) + " is not assignable to type " + stringFor(dst))
-	panic("todo")
+	panic(context + ": value of type " + v.typ().String() + " is not assignable to type " + dst.String())
+}
+
+// Convert returns the value v converted to type t.
+// If the usual Go conversion rules do not allow conversion
+// of the value v to type t, or if converting v to type t panics, Convert panics.
+func (v Value) Convert(t Type) Value {
+	if v.flag&flagMethod != 0 {
+		v = makeMethodValue("Convert", v)
+	}
+	op := convertOp(t.common(), v.typ())
+	if op == nil {
+		panic("reflect.Value.Convert: value of type " + v.typ().String() + " cannot be converted to type " + t.String())
+	}
+	return op(v, t)
+}
+
+// CanConvert reports whether the value v can be converted to type t.
+// If v.CanConvert(t) returns true then v.Convert(t) will not panic.
+func (v Value) CanConvert(t Type) bool {
+	vt := v.Type()
+	if !vt.ConvertibleTo(t) {
+		return false
+	}
+	// Converting from slice to array or to pointer-to-array can panic
+	// depending on the value.
+	switch {
+	case vt.Kind() == Slice && t.Kind() == Array:
+		if t.Len() > v.Len() {
+			return false
+		}
+	case vt.Kind() == Slice && t.Kind() == Pointer && t.Elem().Kind() == Array:
+		n := t.Elem().Len()
+		if n > v.Len() {
+			return false
+		}
+	}
+	return true
+}
+
+// convertOp returns the function to convert a value of type src
+// to a value of type dst. If the conversion is illegal, convertOp returns nil.
+func convertOp(dst, src *abi.Type) func(Value, Type) Value {
+	switch Kind(src.Kind()) {
+	case Int, Int8, Int16, Int32, Int64:
+		switch Kind(dst.Kind()) {
+		case Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr:
+			return cvtInt
+		case Float32, Float64:
+			return cvtIntFloat
+		case String:
+			return cvtIntString
+		}
+
+	case Uint, Uint8, Uint16, Uint32, Uint64, Uintptr:
+		switch Kind(dst.Kind()) {
+		case Int, Int8, Int16, Int32, Int64, Uint, Uint8, Uint16, Uint32, Uint64, Uintptr:
+			return cvtUint
+		case Float32, Float64:
+			return cvtUintFloat
+		case String:
+			return cvtUintString
+		}
+
+	case Float32, Float64:
+		switch Kind(dst.Kind()) {
+		case Int, Int8, Int16, Int32, Int64:
+			return cvtFloatInt
+		case Uint, Uint8, Uint16, Uint32, Uint64, Uintptr:
+			return cvtFloatUint
+		case Float32, Float64:
+			return cvtFloat
+		}
+
+	case Complex64, Complex128:
+		switch Kind(dst.Kind()) {
+		case Complex64, Complex128:
+			return cvtComplex
+		}
+
+	case String:
+		if dst.Kind() == abi.Slice && pkgPathFor(dst.Elem()) == "" {
+			switch Kind(dst.Elem().Kind()) {
+			case Uint8:
+				return cvtStringBytes
+			case Int32:
+				return cvtStringRunes
+			}
+		}
+
+	case Slice:
+		if dst.Kind() == abi.String && pkgPathFor(src.Elem()) == "" {
+			switch Kind(src.Elem().Kind()) {
+			case Uint8:
+				return cvtBytesString
+			case Int32:
+				return cvtRunesString
+			}
+		}
+		// "x is a slice, T is a pointer-to-array type,
+		// and the slice and array types have identical element types."
+		if dst.Kind() == abi.Pointer && dst.Elem().Kind() == abi.Array && haveIdenticalType(src.Elem(), dst.Elem().Elem(), true) {
+			return cvtSliceArrayPtr
+		}
+		// "x is a slice, T is an array type,
+		// and the slice and array types have identical element types."
+		if dst.Kind() == abi.Array && haveIdenticalType(src.Elem(), dst.Elem(), true) {
+			return cvtSliceArray
+		}
+
+	case Chan:
+		if dst.Kind() == abi.Chan && specialChannelAssignability(dst, src) {
+			return cvtDirect
+		}
+	}
+
+	// dst and src have same underlying type.
+	if haveIdenticalUnderlyingType(dst, src, false) {
+		return cvtDirect
+	}
+
+	// dst and src are non-defined pointer types with same underlying base type.
+	if dst.Kind() == abi.Pointer && nameFor(dst) == "" &&
+		src.Kind() == abi.Pointer && nameFor(src) == "" &&
+		haveIdenticalUnderlyingType(elem(dst), elem(src), false) {
+		return cvtDirect
+	}
+
+	if implements(dst, src) {
+		if src.Kind() == abi.Interface {
+			return cvtI2I
+		}
+		return cvtT2I
+	}
+
+	return nil
+}
+
+// makeInt returns a Value of type t equal to bits (possibly truncated),
+// where t is a signed or unsigned int type.
+func makeInt(f flag, bits uint64, t Type) Value {
+	typ := t.common()
+	ptr := unsafe_New(typ)
+	switch typ.Size() {
+	case 1:
+		*(*uint8)(ptr) = uint8(bits)
+	case 2:
+		*(*uint16)(ptr) = uint16(bits)
+	case 4:
+		*(*uint32)(ptr) = uint32(bits)
+	case 8:
+		*(*uint64)(ptr) = bits
+	}
+	return Value{typ, ptr, f | flagIndir | flag(typ.Kind())}
+}
+
+// makeFloat returns a Value of type t equal to v (possibly truncated to float32),
+// where t is a float32 or float64 type.
+func makeFloat(f flag, v float64, t Type) Value {
+	typ := t.common()
+	ptr := unsafe_New(typ)
+	switch typ.Size() {
+	case 4:
+		*(*float32)(ptr) = float32(v)
+	case 8:
+		*(*float64)(ptr) = v
+	}
+	return Value{typ, ptr, f | flagIndir | flag(typ.Kind())}
+}
+
+// makeComplex returns a Value of type t equal to v (possibly truncated to complex64),
+// where t is a complex64 or complex128 type.
+func makeComplex(f flag, v complex128, t Type) Value {
+	typ := t.common()
+	ptr := unsafe_New(typ)
+	switch typ.Size() {
+	case 8:
+		*(*complex64)(ptr) = complex64(v)
+	case 16:
+		*(*complex128)(ptr) = v
+	}
+	return Value{typ, ptr, f | flagIndir | flag(typ.Kind())}
+}
+
+func makeString(f flag, v string, t Type) Value {
+	ret := New(t).Elem()
+	ret.SetString(v)
+	ret.flag = ret.flag&^flagAddr | f
+	return ret
+}
+
+func makeBytes(f flag, v []byte, t Type) Value {
+	ret := New(t).Elem()
+	ret.SetBytes(v)
+	ret.flag = ret.flag&^flagAddr | f
+	return ret
+}
+
+func makeRunes(f flag, v []rune, t Type) Value {
+	ret := New(t).Elem()
+	ret.setRunes(v)
+	ret.flag = ret.flag&^flagAddr | f
+	return ret
+}
+
+// These conversion functions are returned by convertOp
+// for classes of conversions. For example, the first function, cvtInt,
+// takes any value v of signed int type and returns the value converted
+// to type t, where t is any signed or unsigned int type.
+
+// convertOp: intXX -> [u]intXX
+func cvtInt(v Value, t Type) Value {
+	return makeInt(v.flag.ro(), uint64(v.Int()), t)
+}
+
+// convertOp: uintXX -> [u]intXX
+func cvtUint(v Value, t Type) Value {
+	return makeInt(v.flag.ro(), v.Uint(), t)
+}
+
+// convertOp: floatXX -> intXX
+func cvtFloatInt(v Value, t Type) Value {
+	return makeInt(v.flag.ro(), uint64(int64(v.Float())), t)
+}
+
+// convertOp: floatXX -> uintXX
+func cvtFloatUint(v Value, t Type) Value {
+	return makeInt(v.flag.ro(), uint64(v.Float()), t)
+}
+
+// convertOp: intXX -> floatXX
+func cvtIntFloat(v Value, t Type) Value {
+	return makeFloat(v.flag.ro(), float64(v.Int()), t)
+}
+
+// convertOp: uintXX -> floatXX
+func cvtUintFloat(v Value, t Type) Value {
+	return makeFloat(v.flag.ro(), float64(v.Uint()), t)
+}
+
+// convertOp: floatXX -> floatXX
+func cvtFloat(v Value, t Type) Value {
+	if v.Type().Kind() == Float32 && t.Kind() == Float32 {
+		// Don't do any conversion if both types have underlying type float32.
+		// This avoids converting to float64 and back, which will
+		// convert a signaling NaN to a quiet NaN. See issue 36400.
+		return makeFloat32(v.flag.ro(), *(*float32)(v.data()), t)
+	}
+	return makeFloat(v.flag.ro(), v.Float(), t)
+}
+
+// makeFloat32 returns a Value of type t equal to v, where t is a float32 type.
+func makeFloat32(f flag, v float32, t Type) Value {
+	typ := t.common()
+	ptr := unsafe_New(typ)
+	*(*float32)(ptr) = v
+	return Value{typ, ptr, f | flagIndir | flag(typ.Kind())}
+}
+
+// convertOp: complexXX -> complexXX
+func cvtComplex(v Value, t Type) Value {
+	return makeComplex(v.flag.ro(), v.Complex(), t)
+}
+
+// convertOp: intXX -> string
+func cvtIntString(v Value, t Type) Value {
+	s := "�"
+	if x := v.Int(); int64(rune(x)) == x {
+		s = string(rune(x))
+	}
+	return makeString(v.flag.ro(), s, t)
+}
+
+// convertOp: uintXX -> string
+func cvtUintString(v Value, t Type) Value {
+	s := "�"
+	if x := v.Uint(); uint64(rune(x)) == x {
+		s = string(rune(x))
+	}
+	return makeString(v.flag.ro(), s, t)
+}
+
+// convertOp: []byte -> string
+func cvtBytesString(v Value, t Type) Value {
+	return makeString(v.flag.ro(), string(v.Bytes()), t)
+}
+
+// convertOp: string -> []byte
+func cvtStringBytes(v Value, t Type) Value {
+	return makeBytes(v.flag.ro(), []byte(v.String()), t)
+}
+
+// convertOp: []rune -> string
+func cvtRunesString(v Value, t Type) Value {
+	return makeString(v.flag.ro(), string(v.runes()), t)
+}
+
+// convertOp: string -> []rune
+func cvtStringRunes(v Value, t Type) Value {
+	return makeRunes(v.flag.ro(), []rune(v.String()), t)
+}
+
+// convertOp: []T -> *[N]T
+func cvtSliceArrayPtr(v Value, t Type) Value {
+	n := t.Elem().Len()
+	if n > v.Len() {
+		panic("reflect: cannot convert slice with length " + itoa(v.Len()) + " to pointer to array with length " + itoa(n))
+	}
+	h := (*unsafeheaderSlice)(v.ptr)
+	return Value{t.common(), h.Data, v.flag&^(flagIndir|flagAddr|flagKindMask) | flag(Pointer)}
+}
+
+// convertOp: []T -> [N]T
+func cvtSliceArray(v Value, t Type) Value {
+	n := t.Len()
+	if n > v.Len() {
+		panic("reflect: cannot convert slice with length " + itoa(v.Len()) + " to array with length " + itoa(n))
+	}
+	h := (*unsafeheaderSlice)(v.ptr)
+	typ := t.common()
+	ptr := h.Data
+	c := unsafe_New(typ)
+	typedmemmove(typ, c, ptr)
+	ptr = c
+
+	return Value{typ, ptr, v.flag&^(flagAddr|flagKindMask) | flagIndir | flag(Array)}
+}
+
+// convertOp: direct copy
+func cvtDirect(v Value, typ Type) Value {
+	f := v.flag
+	t := typ.common()
+	ptr := v.ptr
+	if f&flagAddr != 0 {
+		// indirect, mutable word - make a copy
+		c := unsafe_New(t)
+		typedmemmove(t, c, ptr)
+		ptr = c
+		f &^= flagAddr
+	}
+	return Value{t, ptr, v.flag.ro() | f} // v.flag.ro()|f == f?
+}
+
+// convertOp: concrete -> interface
+func cvtT2I(v Value, typ Type) Value {
+	target := unsafe_New(typ.common())
+	x := valueInterface(v, false)
+	if typ.NumMethod() == 0 {
+		*(*any)(target) = x
+	} else {
+		ifaceE2I(typ.common(), x, target)
+	}
+	return Value{typ.common(), target, v.flag.ro() | flagIndir | flag(Interface)}
+}
+
+// convertOp: interface -> interface
+func cvtI2I(v Value, typ Type) Value {
+	if v.IsNil() {
+		ret := Zero(typ)
+		ret.flag |= v.flag.ro()
+		return ret
+	}
+	return cvtT2I(v.Elem(), typ)
+}
+
+// ifaceE2I stores the interface value of type t converted from the
+// empty interface src into dst.
+func ifaceE2I(t *abi.Type, src any, dst unsafe.Pointer) {
+	e := (*emptyInterface)(unsafe.Pointer(&src))
+	d := (*[2]unsafe.Pointer)(dst)
+	d[0] = newItab((*abi.InterfaceType)(unsafe.Pointer(t)), e.typ)
+	d[1] = e.word
+}
+
+func itoa(v int) string {
+	if v < 0 {
+		return "-" + uitoa(uint(-v))
+	}
+	return uitoa(uint(v))
+}
+
+func uitoa(v uint) string {
+	var buf [20]byte
+	i := len(buf) - 1
+	for v >= 10 {
+		buf[i] = byte(v%10 + '0')
+		v /= 10
+		i--
+	}
+	buf[i] = byte(v + '0')
+	return string(buf[i:])
 }
 
 // memmove copies size bytes to dst from src. No write barriers are used.
@@ -1890,6 +2715,90 @@ func typedmemmove(t *abi.Type, dst, src unsafe.Pointer)
 //go:linkname typedmemclr github.com/goplus/llgo/internal/runtime.Typedmemclr
 func typedmemclr(t *abi.Type, ptr unsafe.Pointer)
 
+//go:linkname chancap github.com/goplus/llgo/internal/runtime.ChanCap
+func chancap(ch unsafe.Pointer) int
+
+//go:linkname chanclose github.com/goplus/llgo/internal/runtime.ChanClose
+func chanclose(ch unsafe.Pointer)
+
+//go:linkname chanlen github.com/goplus/llgo/internal/runtime.ChanLen
+func chanlen(ch unsafe.Pointer) int
+
+//go:linkname makechan github.com/goplus/llgo/internal/runtime.NewChan
+func makechan(eltSize, cap int) unsafe.Pointer
+
+//go:linkname runtime_ChanRecv github.com/goplus/llgo/internal/runtime.ChanRecv
+func runtime_ChanRecv(ch unsafe.Pointer, val unsafe.Pointer, eltSize int) bool
+
+//go:linkname runtime_ChanTryRecv github.com/goplus/llgo/internal/runtime.ChanTryRecv
+func runtime_ChanTryRecv(ch unsafe.Pointer, val unsafe.Pointer, eltSize int) (recvOK bool, tryOK bool)
+
+//go:linkname runtime_ChanSend github.com/goplus/llgo/internal/runtime.ChanSend
+func runtime_ChanSend(ch unsafe.Pointer, val unsafe.Pointer, eltSize int) bool
+
+//go:linkname runtime_ChanTrySend github.com/goplus/llgo/internal/runtime.ChanTrySend
+func runtime_ChanTrySend(ch unsafe.Pointer, val unsafe.Pointer, eltSize int) bool
+
+// chanrecv receives a value of size bytes from ch into val. If nb is true,
+// it doesn't block and selected reports whether a value was received.
+func chanrecv(ch unsafe.Pointer, nb bool, val unsafe.Pointer, size uintptr) (selected, received bool) {
+	if nb {
+		received, selected = runtime_ChanTryRecv(ch, val, int(size))
+		return
+	}
+	return true, runtime_ChanRecv(ch, val, int(size))
+}
+
+// chansend sends the value of size bytes at val on ch. If nb is true, it
+// doesn't block and reports whether the value was sent.
+func chansend(ch unsafe.Pointer, val unsafe.Pointer, nb bool, size uintptr) bool {
+	if nb {
+		return runtime_ChanTrySend(ch, val, int(size))
+	}
+	return runtime_ChanSend(ch, val, int(size))
+}
+
+//go:linkname makemap github.com/goplus/llgo/internal/runtime.MakeMap
+func makemap(t *abi.Type, cap int) (m unsafe.Pointer)
+
+//go:linkname mapaccess2 github.com/goplus/llgo/internal/runtime.MapAccess2
+func mapaccess2(t *abi.Type, m unsafe.Pointer, key unsafe.Pointer) (val unsafe.Pointer, ok bool)
+
+// mapaccess returns the address of the value of key in m, or nil if key
+// isn't found.
+func mapaccess(t *abi.Type, m unsafe.Pointer, key unsafe.Pointer) (val unsafe.Pointer) {
+	val, ok := mapaccess2(t, m, key)
+	if !ok {
+		return nil
+	}
+	return val
+}
+
+//go:linkname mapassign0 github.com/goplus/llgo/internal/runtime.MapAssign
+func mapassign0(t *abi.Type, m unsafe.Pointer, key unsafe.Pointer) unsafe.Pointer
+
+func mapassign(t *abi.Type, m unsafe.Pointer, key, val unsafe.Pointer) {
+	p := mapassign0(t, m, key)
+	typedmemmove(t.Elem(), p, val)
+}
+
+//go:linkname mapdelete github.com/goplus/llgo/internal/runtime.MapDelete
+func mapdelete(t *abi.Type, m unsafe.Pointer, key unsafe.Pointer)
+
+// mapiterinit returns a *runtime.hiter iterating over m.
+//
+//go:linkname mapiterinit github.com/goplus/llgo/internal/runtime.NewMapIter
+func mapiterinit(t *abi.Type, m unsafe.Pointer) unsafe.Pointer
+
+//go:linkname mapiternext github.com/goplus/llgo/internal/runtime.MapIterNext
+func mapiternext(it unsafe.Pointer) (ok bool, key unsafe.Pointer, elem unsafe.Pointer)
+
+//go:linkname maplen github.com/goplus/llgo/internal/runtime.MapLen
+func maplen(m unsafe.Pointer) int
+
+//go:linkname newItab github.com/goplus/llgo/internal/runtime.NewItab
+func newItab(inter *abi.InterfaceType, typ *abi.Type) unsafe.Pointer
+
 /* TODO(xsw):
 // typedmemclrpartial is like typedmemclr but assumes that
 // dst points off bytes into the value and only clears size bytes.
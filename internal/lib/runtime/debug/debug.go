@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+// llgo:skip setMaxStack setMaxThreads setGCPercent setMemoryLimit freeOSMemory readGCStats SetTraceback
+import (
+	"time"
+	_ "unsafe"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+const (
+	LLGoPackage = true
+)
+
+// -----------------------------------------------------------------------------
+
+func setMaxStack(n int) int {
+	return runtime.SetMaxStack(n)
+}
+
+func setMaxThreads(n int) int {
+	return runtime.SetMaxThreads(n)
+}
+
+func setGCPercent(percent int32) int32 {
+	return int32(runtime.SetGCPercent(int(percent)))
+}
+
+func setMemoryLimit(limit int64) int64 {
+	return runtime.SetMemoryLimit(limit)
+}
+
+func freeOSMemory() {
+	runtime.FreeOSMemory()
+}
+
+// readGCStats stores the recent pauses into *pauses, the most recent first,
+// then their end times, then the time of the last collection, the number of
+// collections, and the total pause time, like Go's runtime.
+func readGCStats(pauses *[]time.Duration) {
+	var s runtime.GCStats
+	runtime.ReadGCStats(&s)
+	n := s.NumGC
+	if n > uint32(len(s.PauseNs)) {
+		n = uint32(len(s.PauseNs))
+	}
+	p := (*pauses)[:cap(*pauses)]
+	for i := uint32(0); i < n; i++ {
+		j := (s.NumGC - 1 - i) % uint32(len(s.PauseNs))
+		p[i] = time.Duration(s.PauseNs[j])
+		p[n+i] = time.Duration(s.PauseEnd[j])
+	}
+	p[n+n] = time.Duration(s.LastGC)
+	p[n+n+1] = time.Duration(s.NumGC)
+	p[n+n+2] = time.Duration(s.PauseTotalNs)
+	*pauses = p[:n+n+3]
+}
+
+// SetTraceback sets the amount of detail printed by the runtime in the
+// traceback it prints before exiting due to an unrecovered panic or an
+// internal runtime error.
+//
+// An uncaught panic always prints the stack of its goroutine: the level is
+// ignored.
+func SetTraceback(level string) {
+}
+
+// -----------------------------------------------------------------------------
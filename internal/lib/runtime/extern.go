@@ -4,6 +4,50 @@
 
 package runtime
 
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/prof"
+)
+
+// Caller reports file and line number information about function invocations on
+// the calling goroutine's stack. The argument skip is the number of stack frames
+// to ascend, with 0 identifying the caller of Caller.  (For historical reasons the
+// meaning of skip differs between Caller and Callers.) The return values report the
+// program counter, file name, and line number within the file of the corresponding
+// call. The boolean ok is false if it was not possible to recover the information.
+//
+//go:noinline
 func Caller(skip int) (pc uintptr, file string, line int, ok bool) {
-	panic("todo: runtime.Caller")
+	var rpc [1]uintptr
+	if skip < 0 || prof.Callers(c.Int(skip), &rpc[0], 1) == 0 {
+		return
+	}
+	frame, _ := CallersFrames(rpc[:]).Next()
+	return frame.PC, frame.File, frame.Line, frame.PC != 0
+}
+
+// Callers fills the slice pc with the return program counters of function invocations
+// on the calling goroutine's stack. The argument skip is the number of stack frames
+// to skip before recording in pc, with 0 identifying the frame for Callers itself and
+// 1 identifying the caller of Callers.
+// It returns the number of entries written to pc.
+//
+// To translate these PCs into symbolic information such as function
+// names and line numbers, use CallersFrames. CallersFrames accounts
+// for inlined functions and adjusts the return program counters into
+// call program counters. Iterating over the returned slice of PCs
+// directly is discouraged, as is using FuncForPC on any of the
+// returned PCs, since these cannot account for inlining or return
+// program counter adjustment.
+//
+// At most 64 frames are recorded.
+//
+//go:noinline
+func Callers(skip int, pc []uintptr) int {
+	if len(pc) == 0 || skip < 0 {
+		return 0
+	}
+	return int(prof.Callers(c.Int(skip-1), &pc[0], c.Int(len(pc))))
 }
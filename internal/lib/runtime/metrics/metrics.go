@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package metrics
+
+// llgo:skipall
+import (
+	"math"
+	"unsafe"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+const (
+	LLGoPackage = true
+)
+
+// -----------------------------------------------------------------------------
+
+// The metrics are read from the counters of the runtime, those of the
+// collector first, with a single call for all the samples. The heap is that
+// of bdwgc: its objects, its free memory, and what it returned to the
+// system. The scheduler latencies are those of runtime.ReadSchedLatencies.
+
+// timeHistBuckets are the bucket boundaries of the time histograms, in
+// seconds: 0, then powers of 2 in nanoseconds, then +Inf.
+var timeHistBuckets = func() []float64 {
+	b := make([]float64, runtime.TimeHistBuckets+1)
+	for i := 1; i < runtime.TimeHistBuckets; i++ {
+		b[i] = float64(uint64(1)<<(i-1)) / 1e9
+	}
+	b[runtime.TimeHistBuckets] = math.Inf(1)
+	return b
+}()
+
+// statAggregate reads the statistics of the runtime the first time they're
+// needed, once for all the samples of a Read.
+type statAggregate struct {
+	gc        runtime.GCStats
+	gcRead    bool
+	sched     runtime.TimeHist
+	schedRead bool
+}
+
+func (a *statAggregate) gcStats() *runtime.GCStats {
+	if !a.gcRead {
+		runtime.ReadGCStats(&a.gc)
+		a.gcRead = true
+	}
+	return &a.gc
+}
+
+func (a *statAggregate) schedLatencies() *runtime.TimeHist {
+	if !a.schedRead {
+		runtime.ReadSchedLatencies(&a.sched)
+		a.schedRead = true
+	}
+	return &a.sched
+}
+
+func readMetrics(m []Sample) {
+	var a statAggregate
+	for i := range m {
+		v := &m[i].Value
+		switch m[i].Name {
+		case "/gc/cycles/automatic:gc-cycles":
+			gc := a.gcStats()
+			v.setUint64(uint64(gc.NumGC - gc.NumForcedGC))
+		case "/gc/cycles/forced:gc-cycles":
+			v.setUint64(uint64(a.gcStats().NumForcedGC))
+		case "/gc/cycles/total:gc-cycles":
+			v.setUint64(uint64(a.gcStats().NumGC))
+		case "/gc/gogc:percent":
+			v.setUint64(uint64(runtime.GCPercent()))
+		case "/gc/gomemlimit:bytes":
+			v.setUint64(uint64(runtime.SetMemoryLimit(-1)))
+		case "/gc/heap/allocs:bytes":
+			v.setUint64(a.gcStats().TotalAlloc)
+		case "/gc/heap/allocs:objects":
+			v.setUint64(a.gcStats().Mallocs)
+		case "/gc/heap/goal:bytes":
+			v.setUint64(a.gcStats().NextGC)
+		case "/gc/heap/live:bytes":
+			v.setUint64(a.gcStats().HeapLive)
+		case "/gc/pauses:seconds", "/sched/pauses/total/gc:seconds":
+			v.setTimeHist(&a.gcStats().PauseHist)
+		case "/memory/classes/heap/free:bytes":
+			gc := a.gcStats()
+			v.setUint64(gc.HeapIdle - gc.HeapReleased)
+		case "/memory/classes/heap/objects:bytes":
+			v.setUint64(a.gcStats().HeapAlloc)
+		case "/memory/classes/heap/released:bytes":
+			v.setUint64(a.gcStats().HeapReleased)
+		case "/memory/classes/total:bytes":
+			v.setUint64(a.gcStats().HeapSys)
+		case "/sched/gomaxprocs:threads":
+			v.setUint64(uint64(runtime.NumProcs()))
+		case "/sched/goroutines:goroutines":
+			v.setUint64(uint64(runtime.NumGoroutine()))
+		case "/sched/latencies:seconds":
+			v.setTimeHist(a.schedLatencies())
+		case "/sched/threads/total:threads":
+			v.setUint64(uint64(runtime.NumThreads()))
+		default:
+			*v = Value{} // KindBad: unknown
+		}
+	}
+}
+
+func (v *Value) setUint64(x uint64) {
+	v.kind = KindUint64
+	v.scalar = x
+	v.pointer = nil
+}
+
+// setTimeHist stores h into v, reusing the histogram of v if it has one.
+func (v *Value) setTimeHist(h *runtime.TimeHist) {
+	var hist *Float64Histogram
+	if v.kind == KindFloat64Histogram && v.pointer != nil {
+		hist = (*Float64Histogram)(v.pointer)
+	} else {
+		hist = new(Float64Histogram)
+		v.kind = KindFloat64Histogram
+		v.pointer = unsafe.Pointer(hist)
+	}
+	hist.Buckets = timeHistBuckets
+	if len(hist.Counts) != len(h) {
+		hist.Counts = make([]uint64, len(h))
+	}
+	copy(hist.Counts, h[:])
+}
+
+// -----------------------------------------------------------------------------
@@ -6,6 +6,23 @@
 
 package runtime
 
+import (
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+// SetFinalizer sets the finalizer associated with obj to the provided
+// finalizer function. When the garbage collector finds an unreachable block
+// with an associated finalizer, it clears the association and runs
+// finalizer(obj) in a separate goroutine. See the documentation of the Go
+// runtime for the details.
 func SetFinalizer(obj any, finalizer any) {
-	panic("todo: runtime.SetFinalizer")
+	runtime.SetFinalizer(obj, finalizer)
+}
+
+// KeepAlive marks its argument as currently reachable. This ensures that the
+// object is not freed, and its finalizer is not run, before the point in the
+// program where KeepAlive is called.
+//
+//go:noinline
+func KeepAlive(x any) {
 }
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pprof
+
+import (
+	"context"
+	"sort"
+)
+
+// -----------------------------------------------------------------------------
+
+// Labels are kept in contexts, like in Go, but llgo doesn't attach them to the
+// samples of profiles: SetGoroutineLabels has no effect.
+
+type labelPair struct {
+	key   string
+	value string
+}
+
+// LabelSet is a set of labels.
+type LabelSet struct {
+	list []labelPair
+}
+
+// labelContextKey is the type of contextKeys used for profiler labels.
+type labelContextKey struct{}
+
+// labelMap is the set of labels of a context, copied when labels are added.
+type labelMap map[string]string
+
+func labelValue(ctx context.Context) labelMap {
+	labels, _ := ctx.Value(labelContextKey{}).(labelMap)
+	return labels
+}
+
+// WithLabels returns a new context.Context with the given labels added.
+// A label overwrites a prior label with the same key.
+func WithLabels(ctx context.Context, labels LabelSet) context.Context {
+	parent := labelValue(ctx)
+	m := make(labelMap, len(parent)+len(labels.list))
+	for k, v := range parent {
+		m[k] = v
+	}
+	for _, l := range labels.list {
+		m[l.key] = l.value
+	}
+	return context.WithValue(ctx, labelContextKey{}, m)
+}
+
+// Labels takes an even number of strings representing key-value pairs
+// and makes a LabelSet containing them.
+// A label overwrites a prior label with the same key.
+func Labels(args ...string) LabelSet {
+	if len(args)%2 != 0 {
+		panic("uneven number of arguments to pprof.Labels")
+	}
+	list := make([]labelPair, 0, len(args)/2)
+	for i := 0; i+1 < len(args); i += 2 {
+		list = append(list, labelPair{key: args[i], value: args[i+1]})
+	}
+	// the last value of a key wins, and keys are sorted, like in Go
+	sort.SliceStable(list, func(i, j int) bool { return list[i].key < list[j].key })
+	deduped := list[:0]
+	for _, l := range list {
+		if n := len(deduped); n > 0 && deduped[n-1].key == l.key {
+			deduped[n-1] = l
+		} else {
+			deduped = append(deduped, l)
+		}
+	}
+	return LabelSet{list: deduped}
+}
+
+// Label returns the value of the label with the given key on ctx, and a
+// boolean indicating whether that label exists.
+func Label(ctx context.Context, key string) (string, bool) {
+	v, ok := labelValue(ctx)[key]
+	return v, ok
+}
+
+// ForLabels invokes f with each label set on the context. The function f
+// should return true to continue iteration or false to stop iteration early.
+func ForLabels(ctx context.Context, f func(key, value string) bool) {
+	for k, v := range labelValue(ctx) {
+		if !f(k, v) {
+			break
+		}
+	}
+}
+
+// SetGoroutineLabels sets the current goroutine's labels to match ctx. llgo
+// doesn't record the labels of goroutines.
+func SetGoroutineLabels(ctx context.Context) {
+}
+
+// Do calls f with a copy of the parent context with the given labels added
+// to the parent's label map. Goroutines spawned while executing f will
+// inherit the augmented label-set.
+func Do(ctx context.Context, labels LabelSet, f func(context.Context)) {
+	f(WithLabels(ctx, labels))
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,545 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pprof
+
+// llgo:skipall
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	goruntime "runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+const (
+	LLGoPackage = true
+)
+
+// -----------------------------------------------------------------------------
+
+// A Profile is a collection of records of some kind. Custom profiles created
+// by NewProfile count the values added to them; llgo doesn't record the call
+// stacks of Add.
+type Profile struct {
+	name  string
+	mu    sync.Mutex
+	m     map[any]none
+	count func() int
+	write func(io.Writer, int) error
+}
+
+type none = struct{}
+
+var profiles struct {
+	mu sync.Mutex
+	m  map[string]*Profile
+}
+
+// schedLatencyProfile records how long goroutines wait to be scheduled. As
+// goroutines are OS threads in llgo, it reports the delay before each
+// goroutine starts running and the time it spends runnable in the run queue
+// of the OS scheduler, together with the CPU time it actually used. This
+// tells CPU starvation apart from actual work.
+var schedLatencyProfile = &Profile{
+	name:  "schedlatency",
+	count: countSchedLatency,
+	write: writeSchedLatency,
+}
+
+var heapProfile = &Profile{
+	name:  "heap",
+	count: countHeap,
+	write: writeHeap,
+}
+
+var allocsProfile = &Profile{
+	name:  "allocs",
+	count: countHeap, // identical to heap profile
+	write: writeAlloc,
+}
+
+// The goroutine and threadcreate profiles only count their records: llgo
+// doesn't record their stacks. The block and mutex profiles are empty:
+// blocking events and contended mutexes aren't recorded.
+var (
+	goroutineProfile    = countProfile("goroutine", runtime.NumGoroutine)
+	threadcreateProfile = countProfile("threadcreate", runtime.NumThreads)
+	blockProfile        = contentionProfile("block")
+	mutexProfile        = contentionProfile("mutex")
+)
+
+// countProfile returns a built-in profile of the records counted by count.
+func countProfile(name string, count func() int) *Profile {
+	return &Profile{
+		name:  name,
+		count: count,
+		write: func(w io.Writer, debug int) error {
+			return writeCount(w, name, count(), debug)
+		},
+	}
+}
+
+func lockProfiles() {
+	profiles.mu.Lock()
+	if profiles.m == nil {
+		profiles.m = map[string]*Profile{
+			"schedlatency": schedLatencyProfile,
+			"goroutine":    goroutineProfile,
+			"threadcreate": threadcreateProfile,
+			"heap":         heapProfile,
+			"allocs":       allocsProfile,
+			"block":        blockProfile,
+			"mutex":        mutexProfile,
+		}
+	}
+}
+
+func unlockProfiles() {
+	profiles.mu.Unlock()
+}
+
+// NewProfile creates a new profile with the given name. If a profile with
+// that name already exists, NewProfile panics.
+func NewProfile(name string) *Profile {
+	lockProfiles()
+	defer unlockProfiles()
+	if name == "" {
+		panic("pprof: NewProfile with empty name")
+	}
+	if profiles.m[name] != nil {
+		panic("pprof: NewProfile name already in use: " + name)
+	}
+	p := &Profile{
+		name: name,
+		m:    map[any]none{},
+	}
+	profiles.m[name] = p
+	return p
+}
+
+// Lookup returns the profile with the given name, or nil if no such profile
+// exists.
+func Lookup(name string) *Profile {
+	lockProfiles()
+	defer unlockProfiles()
+	return profiles.m[name]
+}
+
+// Profiles returns a slice of all the known profiles, sorted by name.
+func Profiles() []*Profile {
+	lockProfiles()
+	defer unlockProfiles()
+	all := make([]*Profile, 0, len(profiles.m))
+	for _, p := range profiles.m {
+		all = append(all, p)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].name < all[j].name })
+	return all
+}
+
+// Name returns this profile's name.
+func (p *Profile) Name() string {
+	return p.name
+}
+
+// Count returns the number of records in the profile.
+func (p *Profile) Count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.count != nil {
+		return p.count()
+	}
+	return len(p.m)
+}
+
+// Add adds value to the profile. Add panics if the profile already contains
+// value or if it is a built-in profile.
+func (p *Profile) Add(value any, skip int) {
+	if p.name == "" {
+		panic("pprof: use of uninitialized Profile")
+	}
+	if p.write != nil {
+		panic("pprof: Add called on built-in Profile " + p.name)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.m[value]; ok {
+		panic("pprof: Profile.Add of duplicate value")
+	}
+	p.m[value] = none{}
+}
+
+// Remove removes the value from the profile. It is a no-op if the value is
+// not in the profile.
+func (p *Profile) Remove(value any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.m, value)
+}
+
+// WriteTo writes a pprof-formatted snapshot of the profile to w. With
+// debug=0 it writes the protocol buffer format, otherwise a legacy text
+// format meant for people to read.
+func (p *Profile) WriteTo(w io.Writer, debug int) error {
+	if p.name == "" {
+		panic("pprof: use of zero Profile")
+	}
+	if p.write != nil {
+		return p.write(w, debug)
+	}
+	return writeCount(w, p.name, p.Count(), debug)
+}
+
+// writeCount writes a profile of n records named name, without their stacks.
+func writeCount(w io.Writer, name string, n, debug int) error {
+	if debug > 0 {
+		_, err := fmt.Fprintf(w, "%s profile: total %d\n", name, n)
+		return err
+	}
+	b := newProfileBuilder(w)
+	b.valueType(tagProfile_PeriodType, name, "count")
+	b.int64(tagProfile_Period, 1)
+	b.valueType(tagProfile_SampleType, name, "count")
+	b.sample([]int64{int64(n)}, nil, nil)
+	return b.build()
+}
+
+// contentionProfile returns the empty block or mutex profile.
+func contentionProfile(name string) *Profile {
+	return &Profile{
+		name:  name,
+		count: func() int { return 0 },
+		write: func(w io.Writer, debug int) error {
+			if debug > 0 {
+				// delays are in nanoseconds, not in CPU cycles
+				if name == "mutex" {
+					fmt.Fprintf(w, "--- mutex:\ncycles/second=%d\n", int64(time.Second))
+					fmt.Fprintf(w, "sampling period=%d\n", goruntime.SetMutexProfileFraction(-1))
+				} else {
+					fmt.Fprintf(w, "--- contention:\ncycles/second=%d\n", int64(time.Second))
+				}
+				return nil
+			}
+			b := newProfileBuilder(w)
+			b.valueType(tagProfile_PeriodType, "contentions", "count")
+			b.int64(tagProfile_Period, 1)
+			b.valueType(tagProfile_SampleType, "contentions", "count")
+			b.valueType(tagProfile_SampleType, "delay", "nanoseconds")
+			return b.build()
+		},
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+func countSchedLatency() int {
+	return len(runtime.SchedLatency())
+}
+
+func writeSchedLatency(w io.Writer, debug int) error {
+	recs := runtime.SchedLatency()
+	sort.Slice(recs, func(i, j int) bool { return recs[i].Goid < recs[j].Goid })
+	if debug > 0 {
+		fmt.Fprintf(w, "schedlatency profile: total %d\n", len(recs))
+		fmt.Fprintf(w, "# goroutine\tstate\tstart-delay\trunq-wait\trun-time\n")
+		for _, rec := range recs {
+			state := "exited"
+			if rec.Running != 0 {
+				state = "running"
+			}
+			_, err := fmt.Fprintf(w, "%d\t%s\t%v\t%v\t%v\n", rec.Goid, state,
+				time.Duration(rec.StartDelay), time.Duration(rec.RunqWait), time.Duration(rec.RunTime))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	b := newProfileBuilder(w)
+	b.valueType(tagProfile_PeriodType, "latency", "nanoseconds")
+	b.int64(tagProfile_Period, 1)
+	b.valueType(tagProfile_SampleType, "goroutines", "count")
+	b.valueType(tagProfile_SampleType, "delay", "nanoseconds")
+	b.valueType(tagProfile_SampleType, "cpu", "nanoseconds")
+	for _, rec := range recs {
+		// every goroutine is shown as a function named after it, so that
+		// pprof -top lists the goroutines that waited the most.
+		name := fmt.Sprintf("goroutine %d", rec.Goid)
+		if rec.Running == 0 {
+			name += " (exited)"
+		}
+		loc := b.location(name)
+		values := []int64{1, int64(rec.StartDelay + rec.RunqWait), int64(rec.RunTime)}
+		b.sample(values, []uint64{loc}, []label{
+			{key: "goroutine", num: int64(rec.Goid)},
+			{key: "start-delay", num: int64(rec.StartDelay), unit: "nanoseconds"},
+			{key: "runq-wait", num: int64(rec.RunqWait), unit: "nanoseconds"},
+		})
+	}
+	return b.build()
+}
+
+// -----------------------------------------------------------------------------
+
+func countHeap() int {
+	n, _ := runtime.MemProfile(nil, true)
+	return n
+}
+
+// writeHeap writes the current runtime heap profile to w.
+func writeHeap(w io.Writer, debug int) error {
+	return writeHeapInternal(w, debug, "")
+}
+
+// writeAlloc writes the current runtime heap profile to w
+// with the total allocation space as the default sample type.
+func writeAlloc(w io.Writer, debug int) error {
+	return writeHeapInternal(w, debug, "alloc_space")
+}
+
+func writeHeapInternal(w io.Writer, debug int, defaultSampleType string) error {
+	// Find out how many records there are (MemProfile(nil, true)),
+	// allocate that many records, and get the data.
+	// There's a race—more records might be added between
+	// the two calls—so allocate a few extra records for safety
+	// and also try again if we're very unlucky.
+	var p []runtime.MemProfileRecord
+	n, ok := runtime.MemProfile(nil, true)
+	for {
+		p = make([]runtime.MemProfileRecord, n+50)
+		n, ok = runtime.MemProfile(p, true)
+		if ok {
+			p = p[0:n]
+			break
+		}
+	}
+	rate := int64(runtime.MemProfileRate)
+
+	if debug != 0 {
+		return writeHeapText(w, p, rate)
+	}
+	b := newProfileBuilder(w)
+	b.valueType(tagProfile_PeriodType, "space", "bytes")
+	b.int64(tagProfile_Period, rate)
+	b.valueType(tagProfile_SampleType, "alloc_objects", "count")
+	b.valueType(tagProfile_SampleType, "alloc_space", "bytes")
+	b.valueType(tagProfile_SampleType, "inuse_objects", "count")
+	b.valueType(tagProfile_SampleType, "inuse_space", "bytes")
+	if defaultSampleType != "" {
+		b.int64(tagProfile_DefaultSample, b.stringIndex(defaultSampleType))
+	}
+	b.readMapping()
+	for i := range p {
+		r := &p[i]
+		allocObjects, allocBytes := scaleHeapSample(r.AllocObjects, r.AllocBytes, rate)
+		inuseObjects, inuseBytes := scaleHeapSample(r.AllocObjects-r.FreeObjects, r.AllocBytes-r.FreeBytes, rate)
+		values := []int64{allocObjects, allocBytes, inuseObjects, inuseBytes}
+		b.sample(values, b.pcLocations(recordStack(r), false), nil)
+	}
+	return b.build()
+}
+
+// writeHeapText writes the heap profile in the legacy text format, with the
+// addresses of the stacks left unsymbolized.
+func writeHeapText(w io.Writer, p []runtime.MemProfileRecord, rate int64) error {
+	var total runtime.MemProfileRecord
+	for i := range p {
+		r := &p[i]
+		total.AllocBytes += r.AllocBytes
+		total.AllocObjects += r.AllocObjects
+		total.FreeBytes += r.FreeBytes
+		total.FreeObjects += r.FreeObjects
+	}
+	fmt.Fprintf(w, "heap profile: %d: %d [%d: %d] @ heap/%d\n",
+		total.AllocObjects-total.FreeObjects, total.AllocBytes-total.FreeBytes,
+		total.AllocObjects, total.AllocBytes, 2*rate)
+	for i := range p {
+		r := &p[i]
+		fmt.Fprintf(w, "%d: %d [%d: %d] @",
+			r.AllocObjects-r.FreeObjects, r.AllocBytes-r.FreeBytes,
+			r.AllocObjects, r.AllocBytes)
+		for _, pc := range recordStack(r) {
+			fmt.Fprintf(w, " %#x", pc)
+		}
+		if _, err := fmt.Fprintf(w, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordStack returns the stack of r, a prefix of r.Stack0.
+func recordStack(r *runtime.MemProfileRecord) []uintptr {
+	for i, pc := range r.Stack0 {
+		if pc == 0 {
+			return r.Stack0[:i]
+		}
+	}
+	return r.Stack0[:]
+}
+
+// scaleHeapSample adjusts the data from a heap Sample to
+// account for its probability of appearing in the collected
+// data. heap profiles are a sampling of the memory allocations
+// requests in a program. We estimate the unsampled value by dividing
+// each collected sample by its probability of appearing in the
+// profile. heap profiles rely on a poisson process to determine
+// which samples to collect, based on the desired average collection
+// rate R. The probability of a sample of size S to appear in that
+// profile is 1-exp(-S/R).
+func scaleHeapSample(count, size, rate int64) (int64, int64) {
+	if count == 0 || size == 0 {
+		return 0, 0
+	}
+
+	if rate <= 1 {
+		// if rate==1 all samples were collected so no adjustment is needed.
+		// if rate<1 treat as unknown and skip scaling.
+		return count, size
+	}
+
+	avgSize := float64(size) / float64(count)
+	scale := 1 / (1 - math.Exp(-avgSize/float64(rate)))
+
+	return int64(float64(count) * scale), int64(float64(size) * scale)
+}
+
+// WriteHeapProfile is shorthand for Lookup("heap").WriteTo(w, 0).
+func WriteHeapProfile(w io.Writer) error {
+	return writeHeap(w, 0)
+}
+
+// -----------------------------------------------------------------------------
+
+// The CPU profile is read by a goroutine every 100ms while it runs, counting
+// the samples of each stack, and written once it stops.
+
+const (
+	cpuProfileHz    = 100
+	cpuProfileDepth = 64
+)
+
+var cpu struct {
+	sync.Mutex
+	profiling bool
+	stop      chan bool
+	done      chan bool
+}
+
+// StartCPUProfile enables CPU profiling for the current process. While
+// profiling, the profile will be buffered and written to w. StartCPUProfile
+// returns an error if profiling is already enabled.
+//
+// The profile samples the stacks of the running goroutines 100 times per
+// second of CPU time, with the profiling timer of the process.
+func StartCPUProfile(w io.Writer) error {
+	cpu.Lock()
+	defer cpu.Unlock()
+	if cpu.done == nil {
+		cpu.stop = make(chan bool)
+		cpu.done = make(chan bool)
+	}
+	// Double-check.
+	if cpu.profiling {
+		return fmt.Errorf("cpu profiling already in use")
+	}
+	if !runtime.SetCPUProfileRate(cpuProfileHz) {
+		return errors.New("pprof: can't set the profiling timer")
+	}
+	cpu.profiling = true
+	go profileWriter(w)
+	return nil
+}
+
+func profileWriter(w io.Writer) {
+	start := time.Now()
+	stk := make([]uintptr, cpuProfileDepth)
+	counts := make(map[[cpuProfileDepth]uintptr]int64)
+	read := func() {
+		for {
+			n := runtime.ReadCPUProfile(stk)
+			if n < 0 {
+				return
+			}
+			var key [cpuProfileDepth]uintptr
+			copy(key[:], stk[:n])
+			counts[key]++
+		}
+	}
+	ticker := time.NewTicker(100 * time.Millisecond)
+	for running := true; running; {
+		select {
+		case <-ticker.C:
+		case <-cpu.stop:
+			running = false
+		}
+		read()
+	}
+	ticker.Stop()
+	writeCPUProfile(w, counts, time.Since(start))
+	cpu.done <- true
+}
+
+func writeCPUProfile(w io.Writer, counts map[[cpuProfileDepth]uintptr]int64, d time.Duration) error {
+	const period = 1e9 / cpuProfileHz
+	b := newProfileBuilder(w)
+	b.int64(tagProfile_DurationNanos, int64(d))
+	b.valueType(tagProfile_PeriodType, "cpu", "nanoseconds")
+	b.int64(tagProfile_Period, period)
+	b.valueType(tagProfile_SampleType, "samples", "count")
+	b.valueType(tagProfile_SampleType, "cpu", "nanoseconds")
+	b.readMapping()
+	for key, n := range counts {
+		stk := key[:]
+		for i, pc := range key {
+			if pc == 0 {
+				stk = key[:i]
+				break
+			}
+		}
+		b.sample([]int64{n, n * period}, b.pcLocations(stk, true), nil)
+	}
+	if lost := runtime.CPUProfileLost(); lost > 0 {
+		// samples dropped as the buffer was full, like in Go
+		loc := b.location("runtime/pprof.lostProfileEvent")
+		b.sample([]int64{lost, lost * period}, []uint64{loc}, nil)
+	}
+	return b.build()
+}
+
+// StopCPUProfile stops the current CPU profile, if any.
+// StopCPUProfile only returns after all the writes for the
+// profile have completed.
+func StopCPUProfile() {
+	cpu.Lock()
+	defer cpu.Unlock()
+
+	if !cpu.profiling {
+		return
+	}
+	cpu.profiling = false
+	runtime.SetCPUProfileRate(0)
+	cpu.stop <- true
+	<-cpu.done
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pprof
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+
+// Field numbers of the profile.proto messages, see
+// https://github.com/google/pprof/blob/main/proto/profile.proto.
+const (
+	tagProfile_SampleType    = 1  // repeated ValueType
+	tagProfile_Sample        = 2  // repeated Sample
+	tagProfile_Mapping       = 3  // repeated Mapping
+	tagProfile_Location      = 4  // repeated Location
+	tagProfile_Function      = 5  // repeated Function
+	tagProfile_StringTable   = 6  // repeated string
+	tagProfile_TimeNanos     = 9  // int64
+	tagProfile_DurationNanos = 10 // int64
+	tagProfile_PeriodType    = 11 // ValueType
+	tagProfile_Period        = 12 // int64
+	tagProfile_DefaultSample = 14 // int64 (string table index)
+	tagValueType_Type        = 1  // int64 (string table index)
+	tagValueType_Unit        = 2  // int64 (string table index)
+	tagSample_Location       = 1  // repeated uint64
+	tagSample_Value          = 2  // repeated int64
+	tagSample_Label          = 3  // repeated Label
+	tagLabel_Key             = 1  // int64 (string table index)
+	tagLabel_Str             = 2  // int64 (string table index)
+	tagLabel_Num             = 3  // int64
+	tagLabel_NumUnit         = 4  // int64 (string table index)
+	tagMapping_ID            = 1  // uint64
+	tagMapping_Start         = 2  // uint64
+	tagMapping_Limit         = 3  // uint64
+	tagMapping_Offset        = 4  // uint64
+	tagMapping_Filename      = 5  // int64 (string table index)
+	tagLocation_ID           = 1  // uint64
+	tagLocation_MappingID    = 2  // uint64
+	tagLocation_Address      = 3  // uint64
+	tagLocation_Line         = 4  // repeated Line
+	tagLine_FunctionID       = 1  // uint64
+	tagFunction_ID           = 1  // uint64
+	tagFunction_Name         = 2  // int64 (string table index)
+	tagFunction_SystemName   = 3  // int64 (string table index)
+	wireVarint, wireBytes    = 0, 2
+	stringTableInitialLength = 16
+)
+
+// A label is a numeric or string label of a sample.
+type label struct {
+	key  string
+	str  string
+	num  int64
+	unit string
+}
+
+// A profileBuilder writes a profile in the protocol buffer format. Only the
+// parts of the format used by llgo's profiles are supported.
+type profileBuilder struct {
+	w       io.Writer
+	buf     []byte
+	strings []string
+	stringM map[string]int64
+	nlocs   uint64
+	nfuncs  uint64
+	locs    map[uint64]uint64 // ids of the address locations
+	mapping []memMap
+}
+
+// A memMap is a mapping of an executable file in memory.
+type memMap struct {
+	id, start, limit uint64
+}
+
+func newProfileBuilder(w io.Writer) *profileBuilder {
+	b := &profileBuilder{
+		w:       w,
+		strings: make([]string, 0, stringTableInitialLength),
+		stringM: make(map[string]int64, stringTableInitialLength),
+		locs:    make(map[uint64]uint64),
+	}
+	b.stringIndex("")
+	b.int64(tagProfile_TimeNanos, time.Now().UnixNano())
+	return b
+}
+
+func (b *profileBuilder) stringIndex(s string) int64 {
+	id, ok := b.stringM[s]
+	if !ok {
+		id = int64(len(b.strings))
+		b.strings = append(b.strings, s)
+		b.stringM[s] = id
+	}
+	return id
+}
+
+func appendVarint(buf []byte, x uint64) []byte {
+	for x >= 0x80 {
+		buf = append(buf, byte(x)|0x80)
+		x >>= 7
+	}
+	return append(buf, byte(x))
+}
+
+func appendTag(buf []byte, tag, wire int) []byte {
+	return appendVarint(buf, uint64(tag)<<3|uint64(wire))
+}
+
+func appendUint64(buf []byte, tag int, x uint64) []byte {
+	return appendVarint(appendTag(buf, tag, wireVarint), x)
+}
+
+func appendBytes(buf []byte, tag int, data []byte) []byte {
+	buf = appendVarint(appendTag(buf, tag, wireBytes), uint64(len(data)))
+	return append(buf, data...)
+}
+
+func appendPacked(buf []byte, tag int, xs []uint64) []byte {
+	var data []byte
+	for _, x := range xs {
+		data = appendVarint(data, x)
+	}
+	return appendBytes(buf, tag, data)
+}
+
+func (b *profileBuilder) int64(tag int, x int64) {
+	b.buf = appendUint64(b.buf, tag, uint64(x))
+}
+
+func (b *profileBuilder) valueType(tag int, typ, unit string) {
+	var msg []byte
+	msg = appendUint64(msg, tagValueType_Type, uint64(b.stringIndex(typ)))
+	msg = appendUint64(msg, tagValueType_Unit, uint64(b.stringIndex(unit)))
+	b.buf = appendBytes(b.buf, tag, msg)
+}
+
+// location adds a location made of a single function with the given name, and
+// returns its id.
+func (b *profileBuilder) location(name string) uint64 {
+	b.nlocs++
+	b.nfuncs++
+	id, fnID := b.nlocs, b.nfuncs
+	var fn []byte
+	fn = appendUint64(fn, tagFunction_ID, fnID)
+	fn = appendUint64(fn, tagFunction_Name, uint64(b.stringIndex(name)))
+	fn = appendUint64(fn, tagFunction_SystemName, uint64(b.stringIndex(name)))
+	b.buf = appendBytes(b.buf, tagProfile_Function, fn)
+
+	var line, loc []byte
+	line = appendUint64(line, tagLine_FunctionID, fnID)
+	loc = appendUint64(loc, tagLocation_ID, id)
+	loc = appendBytes(loc, tagLocation_Line, line)
+	b.buf = appendBytes(b.buf, tagProfile_Location, loc)
+	return id
+}
+
+// pcLocations returns the locations of the program counters of a stack, leaf
+// first. They are return addresses, whose calls are at the address before,
+// but the leaf if it's the PC of an interrupted instruction.
+func (b *profileBuilder) pcLocations(stk []uintptr, exactLeaf bool) []uint64 {
+	locs := make([]uint64, len(stk))
+	for i, pc := range stk {
+		addr := uint64(pc)
+		if i > 0 || !exactLeaf {
+			addr--
+		}
+		locs[i] = b.addressLocation(addr)
+	}
+	return locs
+}
+
+// addressLocation returns the id of the location of an address, adding it
+// the first time. Addresses are symbolized by pprof tools from the binary.
+func (b *profileBuilder) addressLocation(addr uint64) uint64 {
+	if id, ok := b.locs[addr]; ok {
+		return id
+	}
+	b.nlocs++
+	id := b.nlocs
+	var loc []byte
+	loc = appendUint64(loc, tagLocation_ID, id)
+	for _, m := range b.mapping {
+		if m.start <= addr && addr < m.limit {
+			loc = appendUint64(loc, tagLocation_MappingID, m.id)
+			break
+		}
+	}
+	loc = appendUint64(loc, tagLocation_Address, addr)
+	b.buf = appendBytes(b.buf, tagProfile_Location, loc)
+	b.locs[addr] = id
+	return id
+}
+
+// readMapping adds the mappings of the executable files of the process,
+// from /proc/self/maps: the executable comes first, as pprof tools expect.
+// Elsewhere, there are no mappings, and pprof tools can't symbolize the
+// addresses.
+func (b *profileBuilder) readMapping() {
+	data, _ := os.ReadFile("/proc/self/maps")
+	for _, line := range strings.Split(string(data), "\n") {
+		// 55d0c0a00000-55d0c0a23000 r-xp 00002000 08:01 1234 /path/to/file
+		f := strings.Fields(line)
+		if len(f) < 6 || len(f[1]) < 3 || f[1][2] != 'x' || !strings.HasPrefix(f[5], "/") {
+			continue
+		}
+		lo, hi, _ := strings.Cut(f[0], "-")
+		start, err1 := strconv.ParseUint(lo, 16, 64)
+		limit, err2 := strconv.ParseUint(hi, 16, 64)
+		offset, err3 := strconv.ParseUint(f[2], 16, 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+		m := memMap{id: uint64(len(b.mapping) + 1), start: start, limit: limit}
+		b.mapping = append(b.mapping, m)
+
+		var msg []byte
+		msg = appendUint64(msg, tagMapping_ID, m.id)
+		msg = appendUint64(msg, tagMapping_Start, start)
+		msg = appendUint64(msg, tagMapping_Limit, limit)
+		msg = appendUint64(msg, tagMapping_Offset, offset)
+		msg = appendUint64(msg, tagMapping_Filename, uint64(b.stringIndex(strings.Join(f[5:], " "))))
+		b.buf = appendBytes(b.buf, tagProfile_Mapping, msg)
+	}
+}
+
+func (b *profileBuilder) sample(values []int64, locs []uint64, labels []label) {
+	var msg []byte
+	if len(locs) > 0 {
+		msg = appendPacked(msg, tagSample_Location, locs)
+	}
+	vals := make([]uint64, len(values))
+	for i, v := range values {
+		vals[i] = uint64(v)
+	}
+	msg = appendPacked(msg, tagSample_Value, vals)
+	for _, l := range labels {
+		var lbl []byte
+		lbl = appendUint64(lbl, tagLabel_Key, uint64(b.stringIndex(l.key)))
+		if l.str != "" {
+			lbl = appendUint64(lbl, tagLabel_Str, uint64(b.stringIndex(l.str)))
+		} else {
+			lbl = appendUint64(lbl, tagLabel_Num, uint64(l.num))
+		}
+		if l.unit != "" {
+			lbl = appendUint64(lbl, tagLabel_NumUnit, uint64(b.stringIndex(l.unit)))
+		}
+		msg = appendBytes(msg, tagSample_Label, lbl)
+	}
+	b.buf = appendBytes(b.buf, tagProfile_Sample, msg)
+}
+
+// build appends the string table and writes the profile to the writer. The
+// profile is not gzipped: pprof tools accept both forms.
+func (b *profileBuilder) build() error {
+	for _, s := range b.strings {
+		b.buf = appendBytes(b.buf, tagProfile_StringTable, []byte(s))
+	}
+	_, err := b.w.Write(b.buf)
+	return err
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,16 @@
+// Copyright 2023 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	llrand "github.com/goplus/llgo/internal/runtime/rand"
+)
+
+// rand returns a pseudo-random uint64, from the ChaCha8Rand generator of the
+// calling thread, seeded from the system. It's the source of math/rand and
+// math/rand/v2 (by linkname).
+func rand() uint64 {
+	return llrand.Uint64()
+}
@@ -19,6 +19,9 @@ package runtime
 // llgo:skipall
 import (
 	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime"
 )
 
 // GOROOT returns the root of the Go tree. It uses the
@@ -34,3 +37,30 @@ func GOROOT() string {
 	*/
 	panic("todo: GOROOT")
 }
+
+// Gosched yields the processor, allowing other goroutines to run. It does not
+// suspend the current goroutine, so execution resumes automatically.
+//
+// Goroutines run on threads of their own, which the system schedules and
+// preempts (see CreateThread in internal/runtime): Gosched yields the thread.
+// TODO: preemption checks at loop back-edges and asynchronous preemption by
+// signals, which goroutines multiplexed on threads will need.
+func Gosched() {
+	schedYield()
+}
+
+//go:linkname schedYield C.sched_yield
+func schedYield() c.Int
+
+// Goexit terminates the goroutine that calls it. No other goroutine is
+// affected. Goexit runs all deferred calls before terminating the goroutine.
+// Because Goexit is not a panic, any recover calls in those deferred
+// functions will return nil.
+//
+// Calling Goexit from the main goroutine terminates that goroutine without
+// func main returning. Since func main has not returned, the program
+// continues execution of other goroutines. If all other goroutines exit, the
+// program crashes.
+func Goexit() {
+	runtime.Goexit()
+}
@@ -4,15 +4,15 @@
 
 package runtime
 
+import (
+	"github.com/goplus/llgo/internal/runtime"
+)
+
 // Frames may be used to get function/file/line information for a
 // slice of PC values returned by Callers.
 type Frames struct {
 	// callers is a slice of PCs that have not yet been expanded to frames.
 	callers []uintptr
-
-	// frames is a slice of Frames that have yet to be returned.
-	frames     []Frame
-	frameStore [2]Frame
 }
 
 // Frame is the information returned by Frames for each call frame.
@@ -62,20 +62,82 @@ type Frame struct {
 	funcInfo funcInfo
 }
 
+// Next returns a Frame representing the next call frame in the slice
+// of PC values, and reports whether there are more frames. Frames of C
+// functions and of the runtime are skipped: functions are not inlined
+// into their callers in the line tables, so each PC is one frame.
 func (ci *Frames) Next() (frame Frame, more bool) {
-	panic("todo: runtime.Frames.Next")
+	for len(ci.callers) > 0 {
+		pc := ci.callers[0]
+		ci.callers = ci.callers[1:]
+		name, file, line, entry, ok := runtime.FuncInfo(pc - 1)
+		if !ok {
+			continue
+		}
+		if pc > entry {
+			pc-- // the call, not the return address
+		}
+		frame = Frame{
+			PC:       pc,
+			Func:     &Func{name: name, entry: entry},
+			Function: name,
+			File:     file,
+			Line:     line,
+			Entry:    entry,
+		}
+		return frame, len(ci.callers) > 0
+	}
+	return
 }
 
 // CallersFrames takes a slice of PC values returned by Callers and
 // prepares to return function/file/line information.
 // Do not change the slice until you are done with the Frames.
 func CallersFrames(callers []uintptr) *Frames {
-	panic("todo: runtime.CallersFrames")
+	return &Frames{callers: callers}
 }
 
 // A Func represents a Go function in the running binary.
 type Func struct {
 	opaque struct{} // unexported field to disallow conversions
+
+	name  string
+	entry uintptr
+}
+
+// FuncForPC returns a *Func describing the function that contains the
+// given program counter address, or else nil.
+func FuncForPC(pc uintptr) *Func {
+	name, _, _, entry, ok := runtime.FuncInfo(pc)
+	if !ok {
+		return nil
+	}
+	return &Func{name: name, entry: entry}
+}
+
+// Name returns the name of the function.
+func (f *Func) Name() string {
+	if f == nil {
+		return ""
+	}
+	return f.name
+}
+
+// Entry returns the entry address of the function.
+func (f *Func) Entry() uintptr {
+	if f == nil {
+		return 0
+	}
+	return f.entry
+}
+
+// FileLine returns the file name and line number of the
+// source code corresponding to the program counter pc.
+// The result will not be accurate if pc is not a program
+// counter within f.
+func (f *Func) FileLine(pc uintptr) (file string, line int) {
+	_, file, line, _, _ = runtime.FuncInfo(pc)
+	return
 }
 
 // moduledata records information about the layout of the executable
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trace
+
+// llgo:skipall
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+const (
+	LLGoPackage = true
+)
+
+// -----------------------------------------------------------------------------
+
+// Traces are written in the format of Go 1.21, which go tool trace reads.
+// They record the creation of goroutines, where they block and unblock,
+// the syscalls blocking them, the collections, and the user annotations of
+// this package. Goroutines are threads in llgo: each one runs on a P of its
+// own in the trace, and waits for a CPU in the OS scheduler rather than in
+// the run queue of a P. Stacks aren't recorded.
+
+var tracing struct {
+	sync.Mutex // gate mutators (Start, Stop)
+	enabled    atomic.Bool
+}
+
+// Start enables tracing for the current program.
+// While tracing, the trace will be buffered and written to w.
+// Start returns an error if tracing is already enabled.
+func Start(w io.Writer) error {
+	tracing.Lock()
+	defer tracing.Unlock()
+
+	if err := runtime.StartTrace(); err != nil {
+		return err
+	}
+	go func() {
+		for {
+			data := runtime.ReadTrace()
+			if data == nil {
+				break
+			}
+			w.Write(data)
+		}
+	}()
+	tracing.enabled.Store(true)
+	return nil
+}
+
+// Stop stops the current tracing, if any.
+// Stop only returns after all the writes for the trace have completed.
+func Stop() {
+	tracing.Lock()
+	defer tracing.Unlock()
+	tracing.enabled.Store(false)
+
+	runtime.StopTrace()
+}
+
+// -----------------------------------------------------------------------------
@@ -14,6 +14,9 @@
  * limitations under the License.
  */
 
+// Atomic operations are synchronization for the race detector, not accesses.
+//llgo:build norace
+
 package atomic
 
 import (
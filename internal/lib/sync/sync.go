@@ -14,15 +14,17 @@
  * limitations under the License.
  */
 
+// Like in Go, the race detector doesn't check the plain accesses of package
+// sync, which race with its atomics by design.
+//llgo:build norace
+
 package sync
 
 // llgo:skipall
 import (
-	gosync "sync"
-	"unsafe"
+	_ "unsafe"
 
-	"github.com/goplus/llgo/c"
-	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/internal/runtime"
 )
 
 const (
@@ -31,142 +33,75 @@ const (
 
 // -----------------------------------------------------------------------------
 
-type Mutex sync.Mutex
+// The primitives of this package are Go's: they spin shortly, then wait on a
+// semaphore of the runtime, rather than wrap pthread mutexes which must be
+// initialized, and can't be copied before their first use. The wait still
+// blocks the thread of the goroutine (see runtime.Semacquire).
 
-func (m *Mutex) Lock() {
-	if *(*c.Long)(unsafe.Pointer(m)) == 0 {
-		(*sync.Mutex)(m).Init(nil) // TODO(xsw): finalize
-	}
-	(*sync.Mutex)(m).Lock()
+func runtime_SemacquireMutex(s *uint32, lifo bool, skipframes int) {
+	runtime.Semacquire(s, lifo)
 }
 
-func (m *Mutex) TryLock() bool {
-	if *(*c.Long)(unsafe.Pointer(m)) == 0 {
-		(*sync.Mutex)(m).Init(nil)
-	}
-	return (*sync.Mutex)(m).TryLock() == 0
+func runtime_Semacquire(s *uint32) {
+	runtime.Semacquire(s, false)
 }
 
-// llgo:link (*Mutex).Unlock C.pthread_mutex_unlock
-func (m *Mutex) Unlock() {}
-
-// -----------------------------------------------------------------------------
-
-type RWMutex sync.RWLock
-
-func (rw *RWMutex) RLock() {
-	if *(*c.Long)(unsafe.Pointer(rw)) == 0 {
-		(*sync.RWLock)(rw).Init(nil)
-	}
-	(*sync.RWLock)(rw).RLock()
+func runtime_Semrelease(s *uint32, handoff bool, skipframes int) {
+	runtime.Semrelease(s, handoff)
 }
 
-func (rw *RWMutex) TryRLock() bool {
-	if *(*c.Long)(unsafe.Pointer(rw)) == 0 {
-		(*sync.RWLock)(rw).Init(nil)
-	}
-	return (*sync.RWLock)(rw).TryRLock() == 0
+func runtime_canSpin(i int) bool {
+	return runtime.CanSpin(i)
 }
 
-// llgo:link (*RWMutex).RUnlock C.pthread_rwlock_unlock
-func (rw *RWMutex) RUnlock() {}
-
-func (rw *RWMutex) Lock() {
-	if *(*c.Long)(unsafe.Pointer(rw)) == 0 {
-		(*sync.RWLock)(rw).Init(nil)
-	}
-	(*sync.RWLock)(rw).Lock()
+func runtime_doSpin() {
+	runtime.DoSpin()
 }
 
-func (rw *RWMutex) TryLock() bool {
-	if *(*c.Long)(unsafe.Pointer(rw)) == 0 {
-		(*sync.RWLock)(rw).Init(nil)
-	}
-	return (*sync.RWLock)(rw).TryLock() == 0
+func runtime_nanotime() int64 {
+	return runtime.Nanotime()
 }
 
-// llgo:link (*RWMutex).Unlock C.pthread_rwlock_unlock
-func (rw *RWMutex) Unlock() {}
-
-// -----------------------------------------------------------------------------
+type notifyList = runtime.NotifyList
 
-type Once struct {
-	m    Mutex
-	done bool
+func runtime_notifyListAdd(l *notifyList) uint32 {
+	return runtime.NotifyListAdd(l)
 }
 
-func (o *Once) Do(f func()) {
-	if !o.done {
-		o.m.Lock()
-		defer o.m.Unlock()
-		if !o.done {
-			o.done = true
-			f()
-		}
-	}
+func runtime_notifyListWait(l *notifyList, t uint32) {
+	runtime.NotifyListWait(l, t)
 }
 
-// -----------------------------------------------------------------------------
-
-type Cond struct {
-	cond sync.Cond
-	m    *sync.Mutex
+func runtime_notifyListNotifyAll(l *notifyList) {
+	runtime.NotifyListNotifyAll(l)
 }
 
-func NewCond(l gosync.Locker) *Cond {
-	ret := &Cond{m: l.(*sync.Mutex)}
-	ret.cond.Init(nil) // TODO(xsw): finalize
-	return ret
+func runtime_notifyListNotifyOne(l *notifyList) {
+	runtime.NotifyListNotifyOne(l)
 }
 
-// llgo:link (*Cond).Signal C.pthread_cond_signal
-func (c *Cond) Signal() {}
-
-// llgo:link (*Cond).Broadcast C.pthread_cond_broadcast
-func (c *Cond) Broadcast() {}
-
-func (c *Cond) Wait() {
-	c.cond.Wait(c.m)
+func runtime_procPin() int {
+	return runtime.ProcPin()
 }
 
-// -----------------------------------------------------------------------------
-
-type WaitGroup struct {
-	mutex sync.Mutex
-	cond  sync.Cond
-	count int
+func runtime_procUnpin() {
+	runtime.ProcUnpin()
 }
 
-func (wg *WaitGroup) doInit() {
-	wg.mutex.Init(nil)
-	wg.cond.Init(nil) // TODO(xsw): finalize
+func runtime_procCount() int {
+	return runtime.NumProcs()
 }
 
-func (wg *WaitGroup) Add(delta int) {
-	if *(*c.Long)(unsafe.Pointer(wg)) == 0 {
-		wg.doInit()
-	}
-	wg.mutex.Lock()
-	wg.count += delta
-	if wg.count <= 0 {
-		wg.cond.Broadcast()
-	}
-	wg.mutex.Unlock()
+func runtime_registerPoolCleanup(cleanup func()) {
+	runtime.RegisterPoolCleanup(cleanup)
 }
 
-func (wg *WaitGroup) Done() {
-	wg.Add(-1)
+func throw(s string) {
+	runtime.Fatal(s)
 }
 
-func (wg *WaitGroup) Wait() {
-	if *(*c.Long)(unsafe.Pointer(wg)) == 0 {
-		wg.doInit()
-	}
-	wg.mutex.Lock()
-	for wg.count > 0 {
-		wg.cond.Wait(&wg.mutex)
-	}
-	wg.mutex.Unlock()
+func fatal(s string) {
+	runtime.Fatal(s)
 }
 
 // -----------------------------------------------------------------------------
@@ -30,6 +30,15 @@ type SysProcAttr struct {
 	Pgid       int // Child's process group ID if Setpgid.
 }
 
+const _POSIX_SPAWN_SETSID = 0x400
+
+// spawnUnsupported reports whether sys has attributes which posix_spawn
+// can't set up.
+func spawnUnsupported(sys *SysProcAttr) bool {
+	return sys.Chroot != "" || sys.Credential != nil || sys.Ptrace || sys.Setctty || sys.Noctty ||
+		sys.Foreground
+}
+
 /* TODO(xsw):
 // Implemented in runtime package.
 func runtime_BeforeFork()
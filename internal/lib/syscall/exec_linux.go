@@ -97,6 +97,16 @@ type SysProcAttr struct {
 	CgroupFD                   int       // File descriptor of a cgroup to put the new process into.
 }
 
+const _POSIX_SPAWN_SETSID = 0x80
+
+// spawnUnsupported reports whether sys has attributes which posix_spawn
+// can't set up.
+func spawnUnsupported(sys *SysProcAttr) bool {
+	return sys.Chroot != "" || sys.Credential != nil || sys.Ptrace || sys.Setctty || sys.Noctty ||
+		sys.Foreground || sys.Pdeathsig != 0 || sys.Cloneflags != 0 || sys.Unshareflags != 0 ||
+		sys.UidMappings != nil || sys.GidMappings != nil || sys.AmbientCaps != nil || sys.UseCgroupFD
+}
+
 var (
 	none  = [...]byte{'n', 'o', 'n', 'e', 0}
 	slash = [...]byte{'/', 0}
@@ -0,0 +1,179 @@
+//go:build unix
+// +build unix
+
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syscall
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+// Processes are started by posix_spawn, not by fork and exec: goroutines are
+// threads, and the child of a fork could only make async-signal-safe calls,
+// which compiled Go code can't promise. posix_spawn sets up the child itself
+// and reports the failure of exec. The attributes it can't set up, like
+// Chroot or Credential, are not supported.
+
+// spawnAttrs holds a posix_spawn_file_actions_t or a posix_spawnattr_t. Both
+// are opaque, and larger in glibc than in other libcs.
+type spawnAttrs [64]uintptr
+
+// sigset holds a sigset_t.
+type sigset [16]uint64
+
+const (
+	_POSIX_SPAWN_SETPGROUP  = 0x02
+	_POSIX_SPAWN_SETSIGMASK = 0x08
+)
+
+//go:linkname posixSpawn C.posix_spawn
+func posixSpawn(pid *c.Int, path *c.Char, fileActions, attr *spawnAttrs, argv, envp **c.Char) c.Int
+
+//go:linkname posixSpawnFileActionsInit C.posix_spawn_file_actions_init
+func posixSpawnFileActionsInit(fa *spawnAttrs) c.Int
+
+//go:linkname posixSpawnFileActionsDestroy C.posix_spawn_file_actions_destroy
+func posixSpawnFileActionsDestroy(fa *spawnAttrs) c.Int
+
+//go:linkname posixSpawnFileActionsAdddup2 C.posix_spawn_file_actions_adddup2
+func posixSpawnFileActionsAdddup2(fa *spawnAttrs, fd, newfd c.Int) c.Int
+
+//go:linkname posixSpawnFileActionsAddclose C.posix_spawn_file_actions_addclose
+func posixSpawnFileActionsAddclose(fa *spawnAttrs, fd c.Int) c.Int
+
+//go:linkname posixSpawnFileActionsAddchdir C.posix_spawn_file_actions_addchdir_np
+func posixSpawnFileActionsAddchdir(fa *spawnAttrs, path *c.Char) c.Int
+
+//go:linkname posixSpawnattrInit C.posix_spawnattr_init
+func posixSpawnattrInit(attr *spawnAttrs) c.Int
+
+//go:linkname posixSpawnattrDestroy C.posix_spawnattr_destroy
+func posixSpawnattrDestroy(attr *spawnAttrs) c.Int
+
+//go:linkname posixSpawnattrSetflags C.posix_spawnattr_setflags
+func posixSpawnattrSetflags(attr *spawnAttrs, flags int16) c.Int
+
+//go:linkname posixSpawnattrSetpgroup C.posix_spawnattr_setpgroup
+func posixSpawnattrSetpgroup(attr *spawnAttrs, pgid c.Int) c.Int
+
+//go:linkname posixSpawnattrSetsigmask C.posix_spawnattr_setsigmask
+func posixSpawnattrSetsigmask(attr *spawnAttrs, mask *sigset) c.Int
+
+//go:linkname sigemptyset C.sigemptyset
+func sigemptyset(set *sigset) c.Int
+
+// spawn starts argv0 with posix_spawn, and returns its pid.
+func spawn(argv0 string, argv []string, attr *ProcAttr, sys *SysProcAttr) (pid int, err error) {
+	if spawnUnsupported(sys) {
+		return 0, ENOTSUP
+	}
+	if hasNUL(argv0) || hasNUL(attr.Dir) || anyNUL(argv) || anyNUL(attr.Env) {
+		return 0, EINVAL
+	}
+
+	var fa, sa spawnAttrs
+	if e := posixSpawnFileActionsInit(&fa); e != 0 {
+		return 0, Errno(e)
+	}
+	defer posixSpawnFileActionsDestroy(&fa)
+	if e := posixSpawnattrInit(&sa); e != 0 {
+		return 0, Errno(e)
+	}
+	defer posixSpawnattrDestroy(&sa)
+
+	var e c.Int
+	check := func(ret c.Int) {
+		if e == 0 {
+			e = ret
+		}
+	}
+	if attr.Dir != "" {
+		check(posixSpawnFileActionsAddchdir(&fa, c.AllocaCStr(attr.Dir)))
+	}
+
+	// The files are duplicated above all of them first, then onto their
+	// places, so that none is overwritten before it's duplicated. The
+	// descriptors left out are closed, unless exec closes them anyway.
+	fd := attr.Files
+	next := len(fd)
+	for _, f := range fd {
+		if int(f) >= next {
+			next = int(f) + 1
+		}
+	}
+	for i, f := range fd {
+		if int(f) >= 0 {
+			check(posixSpawnFileActionsAdddup2(&fa, c.Int(f), c.Int(next+i)))
+		}
+	}
+	for i, f := range fd {
+		if int(f) >= 0 {
+			check(posixSpawnFileActionsAdddup2(&fa, c.Int(next+i), c.Int(i)))
+			check(posixSpawnFileActionsAddclose(&fa, c.Int(next+i)))
+		} else if flags, err := fcntl(i, F_GETFD, 0); err == nil && flags&FD_CLOEXEC == 0 {
+			check(posixSpawnFileActionsAddclose(&fa, c.Int(i)))
+		}
+	}
+
+	// The child unblocks all signals, like after a fork in Go: threads of the
+	// runtime may block some.
+	flags := int16(_POSIX_SPAWN_SETSIGMASK)
+	var mask sigset
+	sigemptyset(&mask)
+	check(posixSpawnattrSetsigmask(&sa, &mask))
+	if sys.Setsid {
+		flags |= _POSIX_SPAWN_SETSID
+	}
+	if sys.Setpgid {
+		flags |= _POSIX_SPAWN_SETPGROUP
+		check(posixSpawnattrSetpgroup(&sa, c.Int(sys.Pgid)))
+	}
+	check(posixSpawnattrSetflags(&sa, flags))
+	if e != 0 {
+		return 0, Errno(e)
+	}
+
+	var cpid c.Int
+	ForkLock.Lock()
+	e = posixSpawn(&cpid, c.AllocaCStr(argv0), &fa, &sa, c.AllocaCStrs(argv, true), c.AllocaCStrs(attr.Env, true))
+	ForkLock.Unlock()
+	if e != 0 {
+		return 0, Errno(e)
+	}
+	return int(cpid), nil
+}
+
+func hasNUL(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func anyNUL(a []string) bool {
+	for _, s := range a {
+		if hasNUL(s) {
+			return true
+		}
+	}
+	return false
+}
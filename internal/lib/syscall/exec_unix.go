@@ -10,7 +10,6 @@ package syscall
 
 import (
 	"sync"
-	"syscall"
 
 	"github.com/goplus/llgo/c"
 	"github.com/goplus/llgo/c/os"
@@ -64,15 +63,9 @@ import (
 //     does not block, so use ForkLock.
 var ForkLock sync.RWMutex
 
-func CloseOnExec(fd int) {
-	/* TODO(xsw):
-	fcntl(fd, F_SETFD, FD_CLOEXEC)
-	*/
-	panic("todo: syscall.CloseOnExec")
-}
+func CloseOnExec(fd int) { fcntl(fd, F_SETFD, FD_CLOEXEC) }
 
 func SetNonblock(fd int, nonblocking bool) (err error) {
-	/* TODO(xsw):
 	flag, err := fcntl(fd, F_GETFL, 0)
 	if err != nil {
 		return err
@@ -84,8 +77,6 @@ func SetNonblock(fd int, nonblocking bool) (err error) {
 	}
 	_, err = fcntl(fd, F_SETFL, flag)
 	return err
-	*/
-	panic("todo: syscall.SetNonblock")
 }
 
 // Credential holds user and group identities to be assumed
@@ -106,18 +97,10 @@ type ProcAttr struct {
 	Sys   *SysProcAttr
 }
 
-/* TODO(xsw):
 var zeroProcAttr ProcAttr
 var zeroSysProcAttr SysProcAttr
-*/
 
 func forkExec(argv0 string, argv []string, attr *ProcAttr) (pid int, err error) {
-	/* TODO(xsw):
-	var p [2]int
-	var n int
-	var err1 Errno
-	var wstatus WaitStatus
-
 	if attr == nil {
 		attr = &zeroProcAttr
 	}
@@ -125,97 +108,7 @@ func forkExec(argv0 string, argv []string, attr *ProcAttr) (pid int, err error)
 	if sys == nil {
 		sys = &zeroSysProcAttr
 	}
-
-	// Convert args to C form.
-	argv0p, err := BytePtrFromString(argv0)
-	if err != nil {
-		return 0, err
-	}
-	argvp, err := SlicePtrFromStrings(argv)
-	if err != nil {
-		return 0, err
-	}
-	envvp, err := SlicePtrFromStrings(attr.Env)
-	if err != nil {
-		return 0, err
-	}
-
-	if (runtime.GOOS == "freebsd" || runtime.GOOS == "dragonfly") && len(argv) > 0 && len(argv[0]) > len(argv0) {
-		argvp[0] = argv0p
-	}
-
-	var chroot *byte
-	if sys.Chroot != "" {
-		chroot, err = BytePtrFromString(sys.Chroot)
-		if err != nil {
-			return 0, err
-		}
-	}
-	var dir *byte
-	if attr.Dir != "" {
-		dir, err = BytePtrFromString(attr.Dir)
-		if err != nil {
-			return 0, err
-		}
-	}
-
-	// Both Setctty and Foreground use the Ctty field,
-	// but they give it slightly different meanings.
-	if sys.Setctty && sys.Foreground {
-		return 0, errorspkg.New("both Setctty and Foreground set in SysProcAttr")
-	}
-	if sys.Setctty && sys.Ctty >= len(attr.Files) {
-		return 0, errorspkg.New("Setctty set but Ctty not valid in child")
-	}
-
-	acquireForkLock()
-
-	// Allocate child status pipe close on exec.
-	if err = forkExecPipe(p[:]); err != nil {
-		releaseForkLock()
-		return 0, err
-	}
-
-	// Kick off child.
-	pid, err1 = forkAndExecInChild(argv0p, argvp, envvp, chroot, dir, attr, sys, p[1])
-	if err1 != 0 {
-		Close(p[0])
-		Close(p[1])
-		releaseForkLock()
-		return 0, Errno(err1)
-	}
-	releaseForkLock()
-
-	// Read child error status from pipe.
-	Close(p[1])
-	for {
-		n, err = readlen(p[0], (*byte)(unsafe.Pointer(&err1)), int(unsafe.Sizeof(err1)))
-		if err != EINTR {
-			break
-		}
-	}
-	Close(p[0])
-	if err != nil || n != 0 {
-		if n == int(unsafe.Sizeof(err1)) {
-			err = Errno(err1)
-		}
-		if err == nil {
-			err = EPIPE
-		}
-
-		// Child failed; wait for it to exit, to make sure
-		// the zombies don't accumulate.
-		_, err1 := Wait4(pid, &wstatus, 0, nil)
-		for err1 == EINTR {
-			_, err1 = Wait4(pid, &wstatus, 0, nil)
-		}
-		return 0, err
-	}
-
-	// Read got EOF, so pipe closed on exec, so exec succeeded.
-	return pid, nil
-	*/
-	panic("todo: syscall.forkExec")
+	return spawn(argv0, argv, attr, sys)
 }
 
 // Combination of fork and exec, careful to be thread safe.
@@ -247,5 +140,5 @@ func Exec(argv0 string, argv []string, envv []string) (err error) {
 	if ret == 0 {
 		return nil
 	}
-	return syscall.Errno(ret)
+	return Errno(os.Errno)
 }
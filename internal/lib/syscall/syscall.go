@@ -22,11 +22,11 @@ import (
 
 	"github.com/goplus/llgo/c"
 	"github.com/goplus/llgo/c/os"
-	"github.com/goplus/llgo/c/syscall"
+	csyscall "github.com/goplus/llgo/c/syscall"
 )
 
-type Timespec syscall.Timespec
-type Timeval syscall.Timeval
+type Timespec csyscall.Timespec
+type Timeval csyscall.Timeval
 
 // Unix returns the time stored in ts as seconds plus nanoseconds.
 func (ts *Timespec) Unix() (sec int64, nsec int64) {
@@ -48,8 +48,11 @@ func (tv *Timeval) Nano() int64 {
 	return int64(tv.Sec)*1e9 + int64(tv.Usec)*1000
 }
 
+//go:linkname libcGetpagesize C.getpagesize
+func libcGetpagesize() c.Int
+
 func Getpagesize() int {
-	panic("todo: syscall.Getpagesize")
+	return int(libcGetpagesize())
 }
 
 func Exit(code int) {
@@ -81,6 +84,20 @@ func Getenv(key string) (value string, found bool) {
 	return "", false
 }
 
+// Environ returns a copy of the environment of the process, which is read
+// from the environment of libc: changes by C code are seen.
+func Environ() []string {
+	n := 0
+	for c.Index(os.Environ, n) != nil {
+		n++
+	}
+	env := make([]string, n)
+	for i := range env {
+		env[i] = c.GoString(c.Index(os.Environ, i))
+	}
+	return env
+}
+
 func Getpid() (pid int) {
 	return int(os.Getpid())
 }
@@ -110,13 +127,21 @@ func Seek(fd int, offset int64, whence int) (newoffset int64, err error) {
 }
 
 func Read(fd int, p []byte) (n int, err error) {
+	runtime_entersyscall()
 	ret := os.Read(c.Int(fd), unsafe.Pointer(unsafe.SliceData(p)), uintptr(len(p)))
+	runtime_exitsyscall()
 	if ret >= 0 {
 		return ret, nil // TODO(xsw): confirm err == nil (not io.EOF) when ret == 0
 	}
 	return 0, Errno(os.Errno)
 }
 
+//go:linkname runtime_entersyscall github.com/goplus/llgo/internal/runtime.Entersyscall
+func runtime_entersyscall()
+
+//go:linkname runtime_exitsyscall github.com/goplus/llgo/internal/runtime.Exitsyscall
+func runtime_exitsyscall()
+
 func Close(fd int) (err error) {
 	ret := os.Close(c.Int(fd))
 	if ret == 0 {
@@ -125,7 +150,9 @@ func Close(fd int) (err error) {
 	return Errno(os.Errno)
 }
 
-type Stat_t = syscall.Stat_t
+type Stat_t = csyscall.Stat_t
+
+type Rusage = csyscall.Rusage
 
 func Lstat(path string, stat *Stat_t) (err error) {
 	ret := os.Lstat(c.AllocaCStr(path), stat)
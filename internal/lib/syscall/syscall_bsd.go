@@ -12,6 +12,10 @@
 
 package syscall
 
+import (
+	"github.com/goplus/llgo/c"
+)
+
 func Getgroups() (gids []int, err error) {
 	/* TODO(xsw):
 	n, err := getgroups(0, nil)
@@ -119,9 +123,8 @@ func (w WaitStatus) StopSignal() Signal {
 
 func (w WaitStatus) TrapCause() int { return -1 }
 
-/* TODO(xsw):
 func Wait4(pid int, wstatus *WaitStatus, options int, rusage *Rusage) (wpid int, err error) {
-	var status _C_int
+	var status c.Int
 	wpid, err = wait4(pid, &status, options, rusage)
 	if wstatus != nil {
 		*wstatus = WaitStatus(status)
@@ -129,6 +132,8 @@ func Wait4(pid int, wstatus *WaitStatus, options int, rusage *Rusage) (wpid int,
 	return
 }
 
+/* TODO(xsw):
+
 func (sa *SockaddrInet4) sockaddr() (unsafe.Pointer, _Socklen, error) {
 	if sa.Port < 0 || sa.Port > 0xFFFF {
 		return nil, 0, EINVAL
@@ -0,0 +1,122 @@
+// Copyright 2009 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syscall
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/os"
+)
+
+// System calls on Darwin are calls of the functions of libSystem, by their
+// addresses fn, like from golang.org/x/sys/unix (see its trampolines). They
+// return -1, or NULL for syscallPtr, and set errno on failure: r2 is always
+// 0.
+
+//llgo:type C
+type libcFunc func(a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) uintptr
+
+//go:linkname libcSyscall C.syscall
+func libcSyscall(trap c.Int, __llgo_va_list ...any) c.Int
+
+func callLibc(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) uintptr {
+	f := *(*libcFunc)(unsafe.Pointer(&fn))
+	return f(a1, a2, a3, a4, a5, a6, a7, a8, a9)
+}
+
+// libcResult returns the result r of a libc function, which fails if r is
+// bad, with errno.
+func libcResult(r, bad uintptr) (r1, r2 uintptr, err Errno) {
+	if r == bad {
+		return r, 0, Errno(os.Errno)
+	}
+	return r, 0, 0
+}
+
+// libcResult32 is libcResult for the functions returning an int, whose
+// upper bits may be garbage.
+func libcResult32(r uintptr) (r1, r2 uintptr, err Errno) {
+	if int32(r) == -1 {
+		return r, 0, Errno(os.Errno)
+	}
+	return r, 0, 0
+}
+
+func syscall(fn, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	runtime_entersyscall()
+	r1, r2, err = libcResult32(callLibc(fn, a1, a2, a3, 0, 0, 0, 0, 0, 0))
+	runtime_exitsyscall()
+	return
+}
+
+func syscallX(fn, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	runtime_entersyscall()
+	r1, r2, err = libcResult(callLibc(fn, a1, a2, a3, 0, 0, 0, 0, 0, 0), ^uintptr(0))
+	runtime_exitsyscall()
+	return
+}
+
+func syscall6(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	runtime_entersyscall()
+	r1, r2, err = libcResult32(callLibc(fn, a1, a2, a3, a4, a5, a6, 0, 0, 0))
+	runtime_exitsyscall()
+	return
+}
+
+func syscall6X(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	runtime_entersyscall()
+	r1, r2, err = libcResult(callLibc(fn, a1, a2, a3, a4, a5, a6, 0, 0, 0), ^uintptr(0))
+	runtime_exitsyscall()
+	return
+}
+
+func syscall9(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2 uintptr, err Errno) {
+	runtime_entersyscall()
+	r1, r2, err = libcResult32(callLibc(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9))
+	runtime_exitsyscall()
+	return
+}
+
+func syscallPtr(fn, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	runtime_entersyscall()
+	r1, r2, err = libcResult(callLibc(fn, a1, a2, a3, 0, 0, 0, 0, 0, 0), 0)
+	runtime_exitsyscall()
+	return
+}
+
+func rawSyscall(fn, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	return libcResult32(callLibc(fn, a1, a2, a3, 0, 0, 0, 0, 0, 0))
+}
+
+func rawSyscall6(fn, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	return libcResult32(callLibc(fn, a1, a2, a3, a4, a5, a6, 0, 0, 0))
+}
+
+func rawSyscall9(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9 uintptr) (r1, r2 uintptr, err Errno) {
+	return libcResult32(callLibc(fn, a1, a2, a3, a4, a5, a6, a7, a8, a9))
+}
+
+// Syscall makes the system call trap by syscall(2) of libSystem, which is
+// deprecated, but still used by golang.org/x/sys/unix.
+func Syscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	return Syscall6(trap, a1, a2, a3, 0, 0, 0)
+}
+
+func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	runtime_entersyscall()
+	r1, r2, err = RawSyscall6(trap, a1, a2, a3, a4, a5, a6)
+	runtime_exitsyscall()
+	return
+}
+
+func RawSyscall(trap, a1, a2, a3 uintptr) (r1, r2 uintptr, err Errno) {
+	return RawSyscall6(trap, a1, a2, a3, 0, 0, 0)
+}
+
+func RawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2 uintptr, err Errno) {
+	ret := libcSyscall(c.Int(trap), a1, a2, a3, a4, a5, a6)
+	return libcResult32(uintptr(ret))
+}
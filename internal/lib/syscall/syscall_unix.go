@@ -7,9 +7,10 @@
 package syscall
 
 import (
-	"unsafe"
+	_ "unsafe"
 
 	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/os"
 	"github.com/goplus/llgo/internal/lib/internal/oserror"
 )
 
@@ -22,8 +23,13 @@ var (
 type Errno uintptr
 
 func (e Errno) Error() string {
-	ret := c.Strerror(c.Int(e))
-	return unsafe.String((*byte)(unsafe.Pointer(ret)), c.Strlen(ret))
+	if 0 <= int(e) && int(e) < len(errors) {
+		s := errors[e]
+		if s != "" {
+			return s
+		}
+	}
+	return "errno " + itoa(int(e))
 }
 
 func (e Errno) Is(target error) bool {
@@ -56,14 +62,54 @@ type Signal int
 func (s Signal) Signal() {}
 
 func (s Signal) String() string {
-	/*
-		if 0 <= s && int(s) < len(signals) {
-			str := signals[s]
-			if str != "" {
-				return str
-			}
+	if 0 <= s && int(s) < len(signals) {
+		str := signals[s]
+		if str != "" {
+			return str
+		}
+	}
+	return "signal " + itoa(int(s))
+}
+
+func fcntl(fd int, cmd int, arg int) (val int, err error) {
+	ret := os.Fcntl(c.Int(fd), c.Int(cmd), c.Int(arg))
+	if ret == -1 {
+		return -1, Errno(os.Errno)
+	}
+	return int(ret), nil
+}
+
+//go:linkname libcWait4 C.wait4
+func libcWait4(pid c.Int, status *c.Int, options c.Int, rusage *Rusage) c.Int
+
+// wait4 blocks in the kernel: it shows in execution traces as a syscall.
+func wait4(pid int, wstatus *c.Int, options int, rusage *Rusage) (wpid int, err error) {
+	runtime_entersyscall()
+	ret := libcWait4(c.Int(pid), wstatus, c.Int(options), rusage)
+	runtime_exitsyscall()
+	if ret == -1 {
+		return -1, Errno(os.Errno)
+	}
+	return int(ret), nil
+}
+
+func itoa(v int) string {
+	var buf [20]byte
+	i := len(buf)
+	u := uint(v)
+	if v < 0 {
+		u = uint(-v)
+	}
+	for {
+		i--
+		buf[i] = byte('0' + u%10)
+		if u /= 10; u == 0 {
+			break
 		}
-		return "signal " + itoa.Itoa(int(s))
-	*/
-	panic("todo: syscall.Signal.String")
+	}
+	if v < 0 {
+		i--
+		buf[i] = '-'
+	}
+	return string(buf[i:])
 }
@@ -0,0 +1,201 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package testdeps provides access to dependencies needed by test execution.
+//
+// This package is imported by the generated main package, which passes
+// TestDeps into testing.Main. This allows tests to use packages at run time
+// without making those packages direct dependencies of package testing.
+// Direct dependencies of package testing are harder to write tests for.
+//
+// Unlike in Go, it doesn't depend on internal/fuzz and internal/testlog,
+// which llgo doesn't compile: fuzzing is done by libFuzzer (see fuzz.go),
+// and the test log (-test.testlogfile) stays empty.
+package testdeps
+
+// llgo:skipall
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"runtime/pprof"
+	_ "unsafe"
+)
+
+// Cover indicates whether coverage is enabled.
+var Cover bool
+
+// TestDeps is an implementation of the testing.testDeps interface,
+// suitable for passing to [testing.MainStart].
+type TestDeps struct{}
+
+var matchPat string
+var matchRe *regexp.Regexp
+
+func (TestDeps) MatchString(pat, str string) (result bool, err error) {
+	if matchRe == nil || matchPat != pat {
+		matchPat = pat
+		matchRe, err = regexp.Compile(matchPat)
+		if err != nil {
+			return
+		}
+	}
+	return matchRe.MatchString(str), nil
+}
+
+func (TestDeps) StartCPUProfile(w io.Writer) error {
+	return pprof.StartCPUProfile(w)
+}
+
+func (TestDeps) StopCPUProfile() {
+	pprof.StopCPUProfile()
+}
+
+func (TestDeps) WriteProfileTo(name string, w io.Writer, debug int) error {
+	return pprof.Lookup(name).WriteTo(w, debug)
+}
+
+// ImportPath is the import path of the testing binary, set by the generated main function.
+var ImportPath string
+
+func (TestDeps) ImportPath() string {
+	return ImportPath
+}
+
+var ModulePath string
+
+func (TestDeps) ModulePath() string {
+	return ModulePath
+}
+
+var testLog io.Writer
+
+func (TestDeps) StartTestLog(w io.Writer) {
+	if testLog == nil {
+		io.WriteString(w, "# test log\n") // known to cmd/go/internal/test/test.go
+	}
+	testLog = w
+}
+
+func (TestDeps) StopTestLog() error {
+	return nil
+}
+
+// SetPanicOnExit0 tells the os package whether to panic on os.Exit(0).
+//
+// Package os of llgo always exits: the setting is ignored.
+func (TestDeps) SetPanicOnExit0(v bool) {
+}
+
+// corpusEntry is an alias of the struct type of testing and internal/fuzz.
+type corpusEntry = struct {
+	Parent     string
+	Path       string
+	Data       []byte
+	Values     []any
+	Generation int
+	IsSeed     bool
+}
+
+func (TestDeps) CheckCorpus(vals []any, types []reflect.Type) error {
+	if len(vals) != len(types) {
+		return errors.New("wrong number of values in call to (*testing.F).Add")
+	}
+	for i := range types {
+		if reflect.TypeOf(vals[i]) != types[i] {
+			return errors.New("mismatched types in call to (*testing.F).Add")
+		}
+	}
+	return nil
+}
+
+func (TestDeps) ResetCoverage() {
+}
+
+func (TestDeps) SnapshotCoverage() {
+}
+
+var CoverMode string
+var Covered string
+var CoverSelectedPackages []string
+
+// These variables are set by testmain with -cover in Go. llgo test doesn't
+// pass -cover to go list: the coverage counters are the runtime's.
+var (
+	CoverSnapshotFunc           func() float64
+	CoverProcessTestDirFunc     func(dir string, cfile string, cm string, cpkg string, w io.Writer, selpkgs []string) error
+	CoverMarkProfileEmittedFunc func(val bool)
+)
+
+//go:linkname coverMode github.com/goplus/llgo/internal/runtime.CoverMode
+func coverMode() string
+
+//go:linkname coverBlocks github.com/goplus/llgo/internal/runtime.CoverBlocks
+func coverBlocks(f func(pos string, stmts int, count uint32))
+
+// InitRuntimeCoverage returns the mode of the coverage counters of the
+// packages compiled with them, or "" if there are none.
+func (TestDeps) InitRuntimeCoverage() (mode string, tearDown func(string, string) (string, error), snapcov func() float64) {
+	if mode = coverMode(); mode == "" {
+		return
+	}
+	return mode, coverTearDown, coverSnapshot
+}
+
+// coverSnapshot returns the fraction of the statements run.
+func coverSnapshot() float64 {
+	covered, total := coverStmts()
+	if total == 0 {
+		return 0
+	}
+	return float64(covered) / float64(total)
+}
+
+// coverStmts returns the number of statements run, and of all statements.
+func coverStmts() (covered, total int64) {
+	coverBlocks(func(pos string, stmts int, count uint32) {
+		total += int64(stmts)
+		if count > 0 {
+			covered += int64(stmts)
+		}
+	})
+	return
+}
+
+// coverTearDown writes the coverage profile to coverprofile, if it isn't
+// empty, and prints the coverage. gocoverdir isn't supported.
+func coverTearDown(coverprofile string, gocoverdir string) (string, error) {
+	if coverprofile != "" {
+		if err := writeCoverProfile(coverprofile); err != nil {
+			return "error writing coverage profile", err
+		}
+	}
+	if covered, total := coverStmts(); total == 0 {
+		fmt.Println("coverage: [no statements]")
+	} else {
+		fmt.Printf("coverage: %.1f%% of statements\n", 100*float64(covered)/float64(total))
+	}
+	return "", nil
+}
+
+func writeCoverProfile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "mode: %s\n", coverMode())
+	coverBlocks(func(pos string, stmts int, count uint32) {
+		fmt.Fprintf(w, "%s %d %d\n", pos, stmts, count)
+	})
+	err = w.Flush()
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
@@ -0,0 +1,569 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// The bridge to libFuzzer isn't instrumented: it's not the code fuzzed.
+//llgo:build nosanitize
+
+package testdeps
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	llfuzz "github.com/goplus/llgo/internal/runtime/fuzz"
+)
+
+// Fuzzing is done by libFuzzer, in a worker process. llgo test -fuzz builds
+// the test binary with SanitizerCoverage and links libFuzzer, and the test
+// process, the coordinator, runs it again with -test.fuzzworker. The worker
+// calls the fuzzing loop of libFuzzer, which mutates raw bytes: they are
+// decoded into the arguments of the fuzz function (see fuzzValues). An input
+// failing makes the worker exit, and libFuzzer saves it as a crash artifact,
+// which the coordinator adds to the seed corpus in testdata/fuzz, in the
+// format of go test.
+//
+// The coordinator runs a single worker, with one goroutine fuzzing: -parallel
+// and the minimization of failing inputs are ignored.
+
+// fuzzDirEnv names the directory shared by the coordinator and its worker:
+// it holds the types of the arguments (types), the arguments of libFuzzer
+// (args), the seed inputs (seed), the crash artifacts and the message of
+// the failure (failure).
+const fuzzDirEnv = "LLGO_FUZZ_DIR"
+
+// fuzzCrashError is the error of an input failing, implementing the
+// fuzzCrashError interface of testing.
+type fuzzCrashError struct {
+	msg  string
+	path string
+}
+
+func (e *fuzzCrashError) Error() string     { return e.msg }
+func (e *fuzzCrashError) CrashPath() string { return e.path }
+
+func (TestDeps) CoordinateFuzzing(
+	timeout time.Duration,
+	limit int64,
+	minimizeTimeout time.Duration,
+	minimizeLimit int64,
+	parallel int,
+	seed []corpusEntry,
+	types []reflect.Type,
+	corpusDir,
+	cacheDir string) (err error) {
+	dir, err := os.MkdirTemp("", "llgo-fuzz")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	seedDir := filepath.Join(dir, "seed")
+	if err = os.Mkdir(seedDir, 0777); err != nil {
+		return err
+	}
+	for i, e := range seed {
+		vals := e.Values
+		if vals == nil { // read from testdata, and zeroed by testing
+			if vals, err = readCorpusFile(e.Path, types); err != nil {
+				return err
+			}
+		}
+		name := filepath.Join(seedDir, strconv.Itoa(i))
+		if err = os.WriteFile(name, fuzzInput(vals), 0666); err != nil {
+			return err
+		}
+	}
+	// The inputs libFuzzer finds interesting are kept in cacheDir, and start
+	// the next run. SIGSEGV, SIGBUS and SIGFPE are left to the runtime, which
+	// turns them into panics.
+	if err = os.MkdirAll(cacheDir, 0777); err != nil {
+		return err
+	}
+	args := []string{
+		"-artifact_prefix=" + dir + string(filepath.Separator),
+		"-handle_segv=0", "-handle_bus=0", "-handle_fpe=0",
+	}
+	if timeout > 0 {
+		secs := (timeout + time.Second - 1) / time.Second
+		args = append(args, "-max_total_time="+strconv.FormatInt(int64(secs), 10))
+	}
+	if limit > 0 {
+		args = append(args, "-runs="+strconv.FormatInt(limit, 10))
+	}
+	args = append(args, cacheDir, seedDir)
+	if err = writeLines(filepath.Join(dir, "args"), args); err != nil {
+		return err
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = t.String()
+	}
+	if err = writeLines(filepath.Join(dir, "types"), names); err != nil {
+		return err
+	}
+
+	cmd := exec.Command(os.Args[0], append(os.Args[1:len(os.Args):len(os.Args)], "-test.fuzzworker")...)
+	cmd.Env = append(os.Environ(), fuzzDirEnv+"="+dir)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err = cmd.Run(); err == nil {
+		return nil
+	}
+	if _, ok := err.(*exec.ExitError); !ok {
+		return err
+	}
+	artifact := crashArtifact(dir)
+	if artifact == "" {
+		return fmt.Errorf("fuzzing process terminated unexpectedly: %v", err)
+	}
+	data, err := os.ReadFile(artifact)
+	if err != nil {
+		return err
+	}
+	content := marshalCorpusFile(fuzzValues(data, types))
+	sum := sha256.Sum256(content)
+	path := filepath.Join(corpusDir, fmt.Sprintf("%x", sum)[:16])
+	if err = os.MkdirAll(corpusDir, 0777); err != nil {
+		return err
+	}
+	if err = os.WriteFile(path, content, 0666); err != nil {
+		return err
+	}
+	msg, err := os.ReadFile(filepath.Join(dir, "failure"))
+	if err != nil { // a panic, or a crash of the worker
+		msg = []byte("fuzzing process terminated unexpectedly while running the fuzz target")
+	}
+	return &fuzzCrashError{strings.TrimSuffix(string(msg), "\n"), path}
+}
+
+// crashArtifact returns the input libFuzzer saved in dir when the worker
+// crashed, hung or ran out of memory, or "".
+func crashArtifact(dir string) string {
+	entries, _ := os.ReadDir(dir)
+	for _, e := range entries {
+		name := e.Name()
+		for _, prefix := range []string{"crash-", "timeout-", "oom-", "leak-"} {
+			if strings.HasPrefix(name, prefix) {
+				return filepath.Join(dir, name)
+			}
+		}
+	}
+	return ""
+}
+
+// The state of the worker, read by fuzzOne.
+var (
+	fuzzFn    func(corpusEntry) error
+	fuzzTypes []reflect.Type
+	fuzzDir   string
+)
+
+func (TestDeps) RunFuzzWorker(fn func(corpusEntry) error) error {
+	dir := os.Getenv(fuzzDirEnv)
+	if dir == "" {
+		return errors.New("fuzz worker not started by the coordinator")
+	}
+	names, err := readLines(filepath.Join(dir, "types"))
+	if err != nil {
+		return err
+	}
+	types := make([]reflect.Type, len(names))
+	for i, name := range names {
+		if types[i] = fuzzTypeOf(name); types[i] == nil {
+			return fmt.Errorf("unsupported type for fuzzing %s", name)
+		}
+	}
+	args, err := readLines(filepath.Join(dir, "args"))
+	if err != nil {
+		return err
+	}
+	fuzzFn, fuzzTypes, fuzzDir = fn, types, dir
+	args = append([]string{os.Args[0]}, args...)
+	argv := c.AllocaCStrs(args, true)
+	if llfuzz.RunDriver(c.Int(len(args)), argv, fuzzOne) < 0 {
+		return errors.New("test binary not built for fuzzing (llgo test -fuzz)")
+	}
+	return errors.New("libFuzzer failed to start")
+}
+
+// fuzzOne is called by libFuzzer for each input. A failing input exits the
+// worker, after its failure is written for the coordinator: libFuzzer sees
+// that the process exits while the fuzz function runs, like when it panics,
+// and saves the input as a crash artifact.
+func fuzzOne(data *byte, size uintptr) c.Int {
+	vals := fuzzValues(unsafe.Slice(data, size), fuzzTypes)
+	if err := fuzzFn(corpusEntry{Values: vals}); err != nil {
+		os.WriteFile(filepath.Join(fuzzDir, "failure"), []byte(err.Error()), 0666)
+		os.Exit(1)
+	}
+	return 0
+}
+
+// supportedTypes are the types of the arguments of fuzz functions.
+var supportedTypes = []reflect.Type{
+	reflect.TypeOf([]byte(nil)),
+	reflect.TypeOf(""),
+	reflect.TypeOf(false),
+	reflect.TypeOf(byte(0)),
+	reflect.TypeOf(rune(0)),
+	reflect.TypeOf(float32(0)),
+	reflect.TypeOf(float64(0)),
+	reflect.TypeOf(int(0)),
+	reflect.TypeOf(int8(0)),
+	reflect.TypeOf(int16(0)),
+	reflect.TypeOf(int64(0)),
+	reflect.TypeOf(uint(0)),
+	reflect.TypeOf(uint16(0)),
+	reflect.TypeOf(uint32(0)),
+	reflect.TypeOf(uint64(0)),
+}
+
+func fuzzTypeOf(name string) reflect.Type {
+	for _, t := range supportedTypes {
+		if t.String() == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// fuzzValues decodes the input data of libFuzzer into values of types, so
+// that any input is valid: a number takes the bytes of its size, in little
+// endian order, a string or a byte slice takes the rest of data if it's the
+// last value, or a length of 4 bytes then its bytes. Missing bytes are 0.
+func fuzzValues(data []byte, types []reflect.Type) []any {
+	vals := make([]any, len(types))
+	for i, t := range types {
+		var b []byte
+		switch t.Kind() {
+		case reflect.String, reflect.Slice:
+			if i == len(types)-1 {
+				b, data = data, nil
+			} else {
+				n, rest := fuzzBits(data, 4)
+				if n > uint64(len(rest)) {
+					n = uint64(len(rest))
+				}
+				b, data = rest[:n], rest[n:]
+			}
+			if t.Kind() == reflect.String {
+				vals[i] = string(b)
+			} else {
+				vals[i] = append([]byte{}, b...)
+			}
+			continue
+		}
+		bits, rest := fuzzBits(data, int(t.Size()))
+		data = rest
+		v := reflect.New(t).Elem()
+		switch t.Kind() {
+		case reflect.Bool:
+			v.SetBool(bits&1 != 0)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			shift := 64 - 8*t.Size()
+			v.SetInt(int64(bits<<shift) >> shift)
+		case reflect.Float32:
+			v.SetFloat(float64(math.Float32frombits(uint32(bits))))
+		case reflect.Float64:
+			v.SetFloat(math.Float64frombits(bits))
+		default:
+			v.SetUint(bits)
+		}
+		vals[i] = v.Interface()
+	}
+	return vals
+}
+
+// fuzzBits decodes a little endian number of n bytes from data, and returns
+// the bytes left.
+func fuzzBits(data []byte, n int) (bits uint64, rest []byte) {
+	var buf [8]byte
+	if n > len(data) {
+		n = len(data)
+	}
+	copy(buf[:], data[:n])
+	return binary.LittleEndian.Uint64(buf[:]), data[n:]
+}
+
+// fuzzInput encodes vals into an input of libFuzzer, the inverse of
+// fuzzValues.
+func fuzzInput(vals []any) []byte {
+	var b []byte
+	for i, val := range vals {
+		v := reflect.ValueOf(val)
+		var bits uint64
+		switch v.Kind() {
+		case reflect.String, reflect.Slice:
+			var s []byte
+			if v.Kind() == reflect.String {
+				s = []byte(v.String())
+			} else {
+				s = v.Bytes()
+			}
+			if i < len(vals)-1 {
+				b = binary.LittleEndian.AppendUint32(b, uint32(len(s)))
+			}
+			b = append(b, s...)
+			continue
+		case reflect.Bool:
+			if v.Bool() {
+				bits = 1
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			bits = uint64(v.Int())
+		case reflect.Float32:
+			bits = uint64(math.Float32bits(float32(v.Float())))
+		case reflect.Float64:
+			bits = math.Float64bits(v.Float())
+		default:
+			bits = v.Uint()
+		}
+		n := len(b) + int(v.Type().Size())
+		b = binary.LittleEndian.AppendUint64(b, bits)[:n]
+	}
+	return b
+}
+
+// ReadCorpus reads the seed corpus of testdata/fuzz/FuzzXxx, dir, whose
+// files are in the format of go test. There's none if dir doesn't exist.
+func (TestDeps) ReadCorpus(dir string, types []reflect.Type) ([]corpusEntry, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("reading seed corpus from testdata: %v", err)
+	}
+	var corpus []corpusEntry
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := unmarshalCorpusFile(data, types)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", path, err)
+		}
+		corpus = append(corpus, corpusEntry{Path: path, Data: data, Values: vals})
+	}
+	return corpus, nil
+}
+
+func readCorpusFile(path string, types []reflect.Type) ([]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	vals, err := unmarshalCorpusFile(data, types)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %v", path, err)
+	}
+	return vals, nil
+}
+
+const corpusHeader = "go test fuzz v1"
+
+// marshalCorpusFile encodes vals in the format of the corpus files of go
+// test: a header line, then a line per value, like string("a").
+func marshalCorpusFile(vals []any) []byte {
+	b := bytes.NewBufferString(corpusHeader + "\n")
+	for _, val := range vals {
+		switch t := val.(type) {
+		case float32:
+			if math.IsNaN(float64(t)) && math.Float32bits(t) != math.Float32bits(float32(math.NaN())) {
+				fmt.Fprintf(b, "math.Float32frombits(0x%x)\n", math.Float32bits(t))
+			} else {
+				fmt.Fprintf(b, "%T(%v)\n", t, t)
+			}
+		case float64:
+			if math.IsNaN(t) && math.Float64bits(t) != math.Float64bits(math.NaN()) {
+				fmt.Fprintf(b, "math.Float64frombits(0x%x)\n", math.Float64bits(t))
+			} else {
+				fmt.Fprintf(b, "%T(%v)\n", t, t)
+			}
+		case rune:
+			if utf8.ValidRune(t) {
+				fmt.Fprintf(b, "rune(%q)\n", t)
+			} else {
+				fmt.Fprintf(b, "int32(%v)\n", t)
+			}
+		case byte:
+			fmt.Fprintf(b, "byte(%q)\n", t)
+		case []byte:
+			fmt.Fprintf(b, "[]byte(%q)\n", t)
+		case string:
+			fmt.Fprintf(b, "string(%q)\n", t)
+		default:
+			fmt.Fprintf(b, "%T(%v)\n", t, t)
+		}
+	}
+	return b.Bytes()
+}
+
+// unmarshalCorpusFile decodes the values of a corpus file of go test, which
+// must be of types.
+func unmarshalCorpusFile(data []byte, types []reflect.Type) ([]any, error) {
+	lines := strings.Split(string(data), "\n")
+	if strings.TrimSpace(lines[0]) != corpusHeader {
+		return nil, errors.New("must include version and at least one value")
+	}
+	var vals []any
+	for _, line := range lines[1:] {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		val, err := parseCorpusValue(line)
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) != len(types) {
+		return nil, fmt.Errorf("wrong number of values in corpus entry: %d, want %d", len(vals), len(types))
+	}
+	for i, t := range types {
+		if reflect.TypeOf(vals[i]) != t {
+			return nil, fmt.Errorf("mismatched types in corpus entry: %v, want %v", reflect.TypeOf(vals[i]), t)
+		}
+	}
+	return vals, nil
+}
+
+// parseCorpusValue parses a value of a corpus file, a conversion of a
+// literal to its type.
+func parseCorpusValue(line string) (any, error) {
+	i := strings.IndexByte(line, '(')
+	if i < 0 || !strings.HasSuffix(line, ")") {
+		return nil, fmt.Errorf("malformed line %q", line)
+	}
+	typ, lit := line[:i], strings.TrimSpace(line[i+1:len(line)-1])
+	switch typ {
+	case "string", "[]byte":
+		s, err := strconv.Unquote(lit)
+		if err != nil {
+			return nil, fmt.Errorf("malformed line %q: %v", line, err)
+		}
+		if typ == "string" {
+			return s, nil
+		}
+		return []byte(s), nil
+	case "bool":
+		v, err := strconv.ParseBool(lit)
+		if err != nil {
+			return nil, fmt.Errorf("malformed line %q: %v", line, err)
+		}
+		return v, nil
+	case "float32", "float64":
+		bits := 64
+		if typ == "float32" {
+			bits = 32
+		}
+		v, err := strconv.ParseFloat(lit, bits)
+		if err != nil {
+			return nil, fmt.Errorf("malformed line %q: %v", line, err)
+		}
+		if bits == 32 {
+			return float32(v), nil
+		}
+		return v, nil
+	case "math.Float32frombits", "math.Float64frombits":
+		if typ == "math.Float32frombits" {
+			v, err := strconv.ParseUint(lit, 0, 32)
+			if err != nil {
+				return nil, fmt.Errorf("malformed line %q: %v", line, err)
+			}
+			return math.Float32frombits(uint32(v)), nil
+		}
+		v, err := strconv.ParseUint(lit, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed line %q: %v", line, err)
+		}
+		return math.Float64frombits(v), nil
+	}
+	t := fuzzTypeOf(typ)
+	switch typ {
+	case "rune":
+		t = reflect.TypeOf(rune(0))
+	case "byte":
+		t = reflect.TypeOf(byte(0))
+	}
+	if t == nil {
+		return nil, fmt.Errorf("unsupported type %s in line %q", typ, line)
+	}
+	v := reflect.New(t).Elem()
+	var err error
+	if len(lit) > 0 && lit[0] == '\'' { // a character literal
+		var s string
+		if s, err = strconv.Unquote(lit); err == nil {
+			r, n := rune(s[0]), 1 // a byte of an escape like '\xff'
+			if len(s) > 1 {
+				r, n = utf8.DecodeRuneInString(s)
+			}
+			if n != len(s) {
+				err = errors.New("invalid character literal")
+			}
+			lit = strconv.Itoa(int(r))
+		}
+	}
+	if err == nil {
+		bits := int(t.Size()) * 8
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var n int64
+			if n, err = strconv.ParseInt(lit, 0, bits); err == nil {
+				v.SetInt(n)
+			}
+		default:
+			var n uint64
+			if n, err = strconv.ParseUint(lit, 0, bits); err == nil {
+				v.SetUint(n)
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("malformed line %q: %v", line, err)
+	}
+	return v.Interface(), nil
+}
+
+func readLines(name string) ([]string, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n"), nil
+}
+
+func writeLines(name string, lines []string) error {
+	return os.WriteFile(name, []byte(strings.Join(lines, "\n")+"\n"), 0666)
+}
@@ -4,12 +4,6 @@
 
 package time
 
-// Sleep pauses the current goroutine for at least the duration d.
-// A negative or zero duration causes Sleep to return immediately.
-func Sleep(d Duration) {
-	panic("todo: time.Sleep")
-}
-
 // Interface to timers implemented in package runtime.
 // Must be in sync with ../runtime/time.go:/^type timer
 type runtimeTimer struct {
@@ -40,16 +34,6 @@ func when(d Duration) int64 {
 	return t
 }
 
-func startTimer(*runtimeTimer)             { panic("todo: time.startTimer") }
-func stopTimer(*runtimeTimer) bool         { panic("todo: time.stopTimer") }
-func resetTimer(*runtimeTimer, int64) bool { panic("todo: time.resetTimer") }
-
-/* TODO(xsw):
-func modTimer(t *runtimeTimer, when, period int64, f func(any, uintptr), arg any, seq uintptr) {
-	panic("todo: time.modTimer")
-}
-*/
-
 // The Timer type represents a single event.
 // When the Timer expires, the current time will be sent on C,
 // unless the Timer was created by AfterFunc.
@@ -406,7 +406,7 @@ func now() (sec int64, nsec int32, mono int64) {
 func runtimeNano() int64 {
 	tv := (*time.Timespec)(c.Alloca(unsafe.Sizeof(time.Timespec{})))
 	time.ClockGettime(time.CLOCK_MONOTONIC, tv)
-	return int64(tv.Sec)<<nsecShift | int64(tv.Nsec)
+	return int64(tv.Sec)*1e9 + int64(tv.Nsec)
 }
 
 // Monotonic times are reported as offsets from startNano.
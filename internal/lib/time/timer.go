@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package time
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/c/time"
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+// -----------------------------------------------------------------------------
+
+// Sleep pauses the current goroutine for at least the duration d.
+// A negative or zero duration causes Sleep to return immediately.
+//
+// The goroutine waits on a semaphore, which a timer in the heap releases when
+// it's due.
+func Sleep(d Duration) {
+	if d <= 0 {
+		return
+	}
+	var sema uint32
+	t := &runtimeTimer{when: when(d), f: wakeSleep, arg: &sema}
+	startTimer(t)
+	runtime.Semsleep(&sema)
+}
+
+// wakeSleep is the f of the timer of Sleep.
+func wakeSleep(arg any, seq uintptr) {
+	runtime.Semrelease(arg.(*uint32), false)
+}
+
+// -----------------------------------------------------------------------------
+
+// Timers, including those of Sleep, are in a heap ordered by when, run by a
+// goroutine started with the first timer: it waits on a condition variable
+// until the earliest one is due, and calls its f.
+//
+// Goroutines run on threads of their own, so Sleep and the timer goroutine
+// block their threads, on a semaphore and on a condition variable: timers
+// driven by the scheduler, parking goroutines, need the M:N scheduler.
+
+const (
+	timerIdle   = 0 // not in the heap
+	timerActive = 1 // in the heap, at index pp
+)
+
+var (
+	timersMu   sync.Mutex
+	timersCond sync.Cond
+	timers     []*runtimeTimer
+	timersRun  bool // the goroutine running timers is started
+)
+
+func init() {
+	timersMu.Init(nil)
+	timersCond.Init(nil)
+}
+
+func startTimer(t *runtimeTimer) {
+	timersMu.Lock()
+	addTimer(t)
+	timersMu.Unlock()
+}
+
+func stopTimer(t *runtimeTimer) bool {
+	timersMu.Lock()
+	active := t.status == timerActive
+	if active {
+		delTimer(t)
+	}
+	timersMu.Unlock()
+	return active
+}
+
+func resetTimer(t *runtimeTimer, when int64) bool {
+	timersMu.Lock()
+	active := t.status == timerActive
+	if active {
+		delTimer(t)
+	}
+	t.when = when
+	addTimer(t)
+	timersMu.Unlock()
+	return active
+}
+
+func modTimer(t *runtimeTimer, when, period int64, f func(any, uintptr), arg any, seq uintptr) {
+	timersMu.Lock()
+	if t.status == timerActive {
+		delTimer(t)
+	}
+	t.when = when
+	t.period = period
+	t.f = f
+	t.arg = arg
+	t.seq = seq
+	addTimer(t)
+	timersMu.Unlock()
+}
+
+// addTimer adds t to the heap. timersMu must be held.
+func addTimer(t *runtimeTimer) {
+	t.status = timerActive
+	t.pp = uintptr(len(timers))
+	timers = append(timers, t)
+	siftupTimer(int(t.pp))
+	if t.pp == 0 {
+		if !timersRun {
+			timersRun = true
+			go runTimers()
+		}
+		timersCond.Signal() // the earliest timer changed
+	}
+}
+
+// delTimer removes t from the heap. timersMu must be held.
+func delTimer(t *runtimeTimer) {
+	i := int(t.pp)
+	last := len(timers) - 1
+	if i != last {
+		timers[i] = timers[last]
+		timers[i].pp = uintptr(i)
+	}
+	timers[last] = nil
+	timers = timers[:last]
+	if i != last {
+		moved := timers[i]
+		siftupTimer(i)
+		siftdownTimer(int(moved.pp))
+	}
+	t.status = timerIdle
+}
+
+func siftupTimer(i int) {
+	t := timers[i]
+	for i > 0 {
+		p := (i - 1) / 2
+		if timers[p].when <= t.when {
+			break
+		}
+		timers[i] = timers[p]
+		timers[i].pp = uintptr(i)
+		i = p
+	}
+	timers[i] = t
+	t.pp = uintptr(i)
+}
+
+func siftdownTimer(i int) {
+	n := len(timers)
+	t := timers[i]
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if child+1 < n && timers[child+1].when < timers[child].when {
+			child++
+		}
+		if t.when <= timers[child].when {
+			break
+		}
+		timers[i] = timers[child]
+		timers[i].pp = uintptr(i)
+		i = child
+	}
+	timers[i] = t
+	t.pp = uintptr(i)
+}
+
+// runTimers calls the f of timers when they are due. Periodic timers are
+// added back for their next period, skipping the periods already elapsed.
+func runTimers() {
+	timersMu.Lock()
+	for {
+		if len(timers) == 0 {
+			runtime.TraceBlock(runtime.TraceBlockCond)
+			timersCond.Wait(&timersMu)
+			runtime.TraceResume()
+			continue
+		}
+		t := timers[0]
+		now := runtimeNano()
+		if delta := t.when - now; delta > 0 {
+			waitTimer(delta)
+			continue
+		}
+		delTimer(t)
+		if t.period > 0 {
+			t.when += t.period * (1 + (now-t.when)/t.period)
+			if t.when < 0 { // overflow
+				t.when = 1<<63 - 1
+			}
+			addTimer(t)
+		}
+		f, arg, seq := t.f, t.arg, t.seq
+		timersMu.Unlock()
+		f(arg, seq)
+		timersMu.Lock()
+	}
+}
+
+// waitTimer waits for delta nanoseconds at most, or until the earliest timer
+// changes. timersMu must be held. Condition variables wait until a time of the
+// realtime clock, which may be set: the caller checks the timer again.
+func waitTimer(delta int64) {
+	ts := (*time.Timespec)(c.Alloca(unsafe.Sizeof(time.Timespec{})))
+	time.ClockGettime(time.CLOCK_REALTIME, ts)
+	abs := int64(ts.Sec)*1e9 + int64(ts.Nsec) + delta
+	if abs < 0 { // overflow
+		abs = 1<<63 - 1
+	}
+	ts.Sec = time.TimeT(abs / 1e9)
+	ts.Nsec = c.Long(abs % 1e9)
+	runtime.TraceBlock(runtime.TraceBlockSleep) // not asleep: a timer is due
+	timersCond.TimedWait(&timersMu, ts)
+	runtime.TraceResume()
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package weak
+
+// llgo:skipall
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/internal/runtime"
+)
+
+// Pointer is a weak pointer to a value of type T: it doesn't keep the value
+// alive. Two Pointer values compare equal if the pointers they were created
+// from compare equal, even once the value is reclaimed.
+type Pointer[T any] struct {
+	_ [0]*T
+	u unsafe.Pointer // weak handle, see runtime.RegisterWeakPointer
+}
+
+// Make creates a weak pointer from a pointer to some value of type T.
+func Make[T any](ptr *T) Pointer[T] {
+	var u unsafe.Pointer
+	if ptr != nil {
+		u = runtime.RegisterWeakPointer(unsafe.Pointer(ptr))
+	}
+	return Pointer[T]{u: u}
+}
+
+// Value returns the original pointer used to create the weak pointer. It
+// returns nil if the value pointed to by the original pointer was reclaimed
+// by the garbage collector.
+func (p Pointer[T]) Value() *T {
+	if p.u == nil {
+		return nil
+	}
+	return (*T)(runtime.MakeStrongFromWeak(p.u))
+}
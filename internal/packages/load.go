@@ -151,6 +151,16 @@ func versionsInitFileVersions(*types.Info)
 //go:linkname typesinternalSetUsesCgo golang.org/x/tools/internal/typesinternal.SetUsesCgo
 func typesinternalSetUsesCgo(conf *types.Config) bool
 
+// dedupKey returns the key of lpkg in a Deduper: its path, or its ID for the
+// variants of packages compiled for tests (like "p [p.test]"), which have the
+// path of the package.
+func dedupKey(lpkg *loaderPackage) string {
+	if strings.HasSuffix(lpkg.ID, ".test]") {
+		return lpkg.ID
+	}
+	return lpkg.PkgPath
+}
+
 // An importFunc is an implementation of the single-method
 // types.Importer interface based on a function value.
 type importerFunc func(path string) (*types.Package, error)
@@ -169,7 +179,8 @@ func loadPackageEx(dedup Deduper, ld *loader, lpkg *loaderPackage) {
 	}
 
 	if dedup != nil {
-		if cp := dedup.Check(lpkg.PkgPath); cp != nil {
+		key := dedupKey(lpkg)
+		if cp := dedup.Check(key); cp != nil {
 			lpkg.Types = cp.Types
 			lpkg.Fset = ld.Fset
 			lpkg.TypesInfo = cp.TypesInfo
@@ -179,7 +190,7 @@ func loadPackageEx(dedup Deduper, ld *loader, lpkg *loaderPackage) {
 		}
 		defer func() {
 			if !lpkg.IllTyped && lpkg.needtypes && lpkg.needsrc {
-				dedup.set(lpkg.PkgPath, &Cached{
+				dedup.set(key, &Cached{
 					Types:     lpkg.Types,
 					TypesInfo: lpkg.TypesInfo,
 					Syntax:    lpkg.Syntax,
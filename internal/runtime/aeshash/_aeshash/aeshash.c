@@ -0,0 +1,195 @@
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+
+#if defined(__x86_64__) || defined(__i386__)
+#include <cpuid.h>
+#include <immintrin.h>
+#define AESHASH_X86 1
+#elif defined(__aarch64__) && (defined(__linux__) || defined(__APPLE__))
+#include <arm_neon.h>
+#if defined(__linux__)
+#include <sys/auxv.h>
+#endif
+#define AESHASH_ARM64 1
+#endif
+
+// -----------------------------------------------------------------------------
+
+// The memory hash of the runtime, like aeshash of Go: the data is scrambled
+// by rounds of AES with keys which are random bytes, different from run to
+// run, in up to 8 lanes at a time. It's much faster than the fallback, in Go,
+// but it needs AES-NI on x86 or the AES instructions of ARMv8, and can be
+// turned off by GODEBUG=cpu.aes=off or cpu.all=off, like in Go.
+
+#if AESHASH_X86
+
+#define AES_TARGET __attribute__((target("aes,sse4.1")))
+
+typedef __m128i v128;
+
+// enc is a round of AES with the round key k, like AESENC.
+static inline AES_TARGET v128 enc(v128 s, v128 k) {
+    return _mm_aesenc_si128(s, k);
+}
+
+static inline AES_TARGET v128 load(const uint8_t *p) {
+    return _mm_loadu_si128((const __m128i *)p);
+}
+
+static inline AES_TARGET v128 xor128(v128 a, v128 b) {
+    return _mm_xor_si128(a, b);
+}
+
+static inline AES_TARGET uintptr_t low(v128 v) {
+#if defined(__x86_64__)
+    return (uintptr_t)_mm_cvtsi128_si64(v);
+#else
+    return (uintptr_t)_mm_cvtsi128_si32(v);
+#endif
+}
+
+static inline AES_TARGET v128 seedOf(uintptr_t h, uintptr_t s) {
+    return _mm_set_epi64x((long long)s, (long long)h);
+}
+
+static int hasAES(void) {
+    unsigned a, b, c, d;
+    return __get_cpuid(1, &a, &b, &c, &d) && ((c >> 25) & 1) && ((c >> 19) & 1);
+}
+
+#elif AESHASH_ARM64
+
+#define AES_TARGET __attribute__((target("aes")))
+
+typedef uint8x16_t v128;
+
+// enc is a round of AES with the round key k, like AESENC on x86: AESE
+// xors its key before the round, which is zero here.
+static inline AES_TARGET v128 enc(v128 s, v128 k) {
+    return veorq_u8(vaesmcq_u8(vaeseq_u8(s, vdupq_n_u8(0))), k);
+}
+
+static inline AES_TARGET v128 load(const uint8_t *p) {
+    return vld1q_u8(p);
+}
+
+static inline AES_TARGET v128 xor128(v128 a, v128 b) {
+    return veorq_u8(a, b);
+}
+
+static inline AES_TARGET uintptr_t low(v128 v) {
+    return (uintptr_t)vgetq_lane_u64(vreinterpretq_u64_u8(v), 0);
+}
+
+static inline AES_TARGET v128 seedOf(uintptr_t h, uintptr_t s) {
+    return vreinterpretq_u8_u64(vcombine_u64(vcreate_u64(h), vcreate_u64(s)));
+}
+
+static int hasAES(void) {
+#if defined(__APPLE__)
+    return 1;
+#else
+    return (getauxval(AT_HWCAP) >> 3) & 1;
+#endif
+}
+
+#endif
+
+#if AESHASH_X86 || AESHASH_ARM64
+
+static v128 keysched[8]; // random round keys, one per lane
+
+// cpuOff reports whether GODEBUG turns the AES instructions off.
+static int cpuOff(void) {
+    const char *p = getenv("GODEBUG");
+    while (p && *p) {
+        const char *end = strchr(p, ',');
+        size_t len = end ? (size_t)(end - p) : strlen(p);
+        if ((len == 11 && strncmp(p, "cpu.aes=off", 11) == 0) || (len == 11 && strncmp(p, "cpu.all=off", 11) == 0)) {
+            return 1;
+        }
+        p = end ? end + 1 : NULL;
+    }
+    return 0;
+}
+
+// llgoAESHashInit reports whether the runtime hashes with AES, and then
+// makes key its round keys.
+int llgoAESHashInit(const uint8_t key[128]) {
+    if (!hasAES() || cpuOff()) {
+        return 0;
+    }
+    memcpy(keysched, key, sizeof(keysched));
+    return 1;
+}
+
+// scramble3 is the end of the hash of a lane: 3 rounds of AES keyed by the
+// lane itself.
+static inline AES_TARGET v128 scramble3(v128 x) {
+    x = enc(x, x);
+    x = enc(x, x);
+    return enc(x, x);
+}
+
+// llgoAESHash returns the hash of the n bytes at p with the seed h.
+AES_TARGET uintptr_t llgoAESHash(const void *ptr, uintptr_t h, uintptr_t n) {
+    const uint8_t *p = ptr;
+    v128 base = seedOf(h, n);
+    v128 seed[8];
+    seed[0] = enc(xor128(base, keysched[0]), xor128(base, keysched[0]));
+    if (n == 0) {
+        return low(enc(seed[0], seed[0]));
+    }
+    if (n <= 16) {
+        uint8_t buf[16] = {0};
+        memcpy(buf, p, n);
+        return low(scramble3(xor128(load(buf), seed[0])));
+    }
+
+    // a lane per 16 bytes: the first half from the start, the second half
+    // from the end, which overlap if n isn't a power of 2
+    int lanes = n <= 32 ? 2 : n <= 64 ? 4 : 8;
+    for (int i = 1; i < lanes; i++) {
+        v128 s = xor128(base, keysched[i]);
+        seed[i] = enc(s, s);
+    }
+    v128 x[8];
+    if (n <= 128) {
+        for (int i = 0; i < lanes / 2; i++) {
+            x[i] = xor128(load(p + 16 * i), seed[i]);
+            x[lanes / 2 + i] = xor128(load(p + n - 16 * (lanes / 2 - i)), seed[lanes / 2 + i]);
+        }
+    } else {
+        // the last 128 bytes start the lanes, then every block of 128 bytes
+        // from the start is mixed in as the round keys of two rounds
+        for (int i = 0; i < 8; i++) {
+            x[i] = enc(xor128(load(p + n - 128 + 16 * i), seed[i]), seed[i]);
+        }
+        for (uintptr_t left = (n - 1) / 128; left > 0; left--, p += 128) {
+            for (int i = 0; i < 8; i++) {
+                v128 d = load(p + 16 * i);
+                x[i] = enc(enc(x[i], d), d);
+            }
+        }
+    }
+    v128 ret = scramble3(x[0]);
+    for (int i = 1; i < lanes; i++) {
+        ret = xor128(ret, scramble3(x[i]));
+    }
+    return low(ret);
+}
+
+#else
+
+int llgoAESHashInit(const uint8_t key[128]) {
+    return 0;
+}
+
+uintptr_t llgoAESHash(const void *p, uintptr_t h, uintptr_t n) {
+    abort(); // not called: llgoAESHashInit reports no AES
+}
+
+#endif
+
+// -----------------------------------------------------------------------------
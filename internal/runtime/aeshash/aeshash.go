@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package aeshash is the memory hash of the runtime on CPUs with AES
+// instructions, like aeshash of Go.
+package aeshash
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+const (
+	LLGoFiles   = "_aeshash/aeshash.c"
+	LLGoPackage = "link"
+)
+
+// -----------------------------------------------------------------------------
+
+// Init reports whether the CPU has the AES instructions Hash needs, with
+// AES-NI on x86 or ARMv8 AES, unless GODEBUG=cpu.aes=off or cpu.all=off.
+// Then key, which should be random, holds the round keys of Hash.
+//
+//go:linkname Init C.llgoAESHashInit
+func Init(key *[128]byte) c.Int
+
+// Hash returns the hash of the n bytes at p with seed. Init must have
+// reported the AES instructions.
+//
+//go:linkname Hash C.llgoAESHash
+func Hash(p unsafe.Pointer, seed, n uintptr) uintptr
+
+// -----------------------------------------------------------------------------
@@ -4,7 +4,9 @@ import (
 	"unsafe"
 
 	"github.com/goplus/llgo/internal/abi"
+	"github.com/goplus/llgo/internal/runtime/aeshash"
 	"github.com/goplus/llgo/internal/runtime/goarch"
+	"github.com/goplus/llgo/internal/runtime/rand"
 )
 
 const (
@@ -28,6 +30,32 @@ func memhash128(p unsafe.Pointer, h uintptr) uintptr {
 	return memhash(p, h, 16)
 }
 
+// useAeshash reports whether the memory hash uses the AES instructions of
+// the CPU, like in Go. Otherwise it's the wyhash fallback, of hash32.go or
+// hash64.go.
+var useAeshash bool
+
+func memhash(p unsafe.Pointer, h, s uintptr) uintptr {
+	if useAeshash {
+		return aeshash.Hash(p, h, s)
+	}
+	return memhashFallback(p, h, s)
+}
+
+func memhash32(p unsafe.Pointer, h uintptr) uintptr {
+	if useAeshash {
+		return aeshash.Hash(p, h, 4)
+	}
+	return memhash32Fallback(p, h)
+}
+
+func memhash64(p unsafe.Pointer, h uintptr) uintptr {
+	if useAeshash {
+		return aeshash.Hash(p, h, 8)
+	}
+	return memhash64Fallback(p, h)
+}
+
 //go:nosplit
 // func memhash_varlen(p unsafe.Pointer, h uintptr) uintptr {
 // 	ptr := getclosureptr()
@@ -35,17 +63,16 @@ func memhash128(p unsafe.Pointer, h uintptr) uintptr {
 // 	return memhash(p, h, size)
 // }
 
-// in asm_*.s
-// func memhash(p unsafe.Pointer, h, s uintptr) uintptr
-// func memhash32(p unsafe.Pointer, h uintptr) uintptr
-// func memhash64(p unsafe.Pointer, h uintptr) uintptr
-// func strhash(p unsafe.Pointer, h uintptr) uintptr
-
 func strhash(a unsafe.Pointer, h uintptr) uintptr {
 	x := (*String)(a)
 	return memhash(x.data, h, uintptr(x.len))
 }
 
+// MemHash is memhash, for hash/maphash.
+func MemHash(p unsafe.Pointer, h, s uintptr) uintptr {
+	return memhash(p, h, s)
+}
+
 // NOTE: Because NaN != NaN, a map can contain any
 // number of (mostly useless) entries keyed with NaNs.
 // To avoid long hash chains, we assign a random number
@@ -223,7 +250,11 @@ func strequal(p, q unsafe.Pointer) bool {
 func interequal(p, q unsafe.Pointer) bool {
 	x := *(*iface)(p)
 	y := *(*iface)(q)
-	return x.tab == y.tab && ifaceeq(x.tab, x.data, y.data)
+	// Itabs are not unique (see NewItab), so compare their dynamic types.
+	if x.tab == nil || y.tab == nil {
+		return x.tab == y.tab
+	}
+	return x.tab._type == y.tab._type && ifaceeq(x.tab, x.data, y.data)
 }
 func nilinterequal(p, q unsafe.Pointer) bool {
 	x := *(*eface)(p)
@@ -236,7 +267,7 @@ func efaceeq(t *_type, x, y unsafe.Pointer) bool {
 	}
 	eq := t.Equal
 	if eq == nil {
-		panic(errorString("comparing uncomparable type " + t.Str_).Error())
+		panic(errorString("comparing uncomparable type " + t.Str_))
 	}
 	if isDirectIface(t) {
 		// Direct interface types are ptr, chan, map, func, and single-element structs/arrays thereof.
@@ -253,7 +284,7 @@ func ifaceeq(tab *itab, x, y unsafe.Pointer) bool {
 	t := tab._type
 	eq := t.Equal
 	if eq == nil {
-		panic(errorString("comparing uncomparable type " + t.Str_).Error())
+		panic(errorString("comparing uncomparable type " + t.Str_))
 	}
 	if isDirectIface(t) {
 		// See comment in efaceeq.
@@ -292,6 +323,24 @@ func ifaceHash(i interface {
 
 var hashkey [4]uintptr
 
+// alginit seeds the memory hash with random bytes of the system, different
+// from run to run, and selects the AES hash if the CPU has it.
+func alginit() {
+	var key [128]byte
+	if rand.GetRandom(unsafe.Pointer(&key), uintptr(len(key))) != 0 {
+		for i := 0; i < len(key); i += 8 {
+			*(*uint64)(unsafe.Pointer(&key[i])) = rand.Uint64()
+		}
+	}
+	if aeshash.Init(&key) != 0 {
+		useAeshash = true
+		return
+	}
+	for i := range hashkey {
+		hashkey[i] = uintptr(fastrand()) | 1 // make sure these numbers are odd
+	}
+}
+
 // Note: These routines perform the read with a native endianness.
 func readUnaligned32(p unsafe.Pointer) uint32 {
 	q := (*[4]byte)(p)
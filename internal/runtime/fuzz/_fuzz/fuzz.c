@@ -0,0 +1,25 @@
+#include <stddef.h>
+#include <stdint.h>
+
+// -----------------------------------------------------------------------------
+
+// LLVMFuzzerRunDriver is the entry of libFuzzer without its main function
+// (libclang_rt.fuzzer_no_main), which is only linked by llgo test -fuzz. The
+// reference is weak, so other test binaries link without it: it's resolved
+// at run time on macOS, where undefined symbols are allowed (see -U).
+extern int LLVMFuzzerRunDriver(int *argc, char ***argv,
+                               int (*cb)(const uint8_t *data, size_t size))
+    __attribute__((weak));
+
+// llgoFuzzDriver runs the fuzzing loop of libFuzzer with the arguments argv,
+// calling cb for each input. libFuzzer exits when the loop ends, so it only
+// returns if libFuzzer fails to start, or -1 if it isn't linked.
+__attribute__((no_sanitize("coverage")))
+int llgoFuzzDriver(int argc, char **argv, int (*cb)(const uint8_t *, size_t)) {
+    if (!LLVMFuzzerRunDriver) {
+        return -1;
+    }
+    return LLVMFuzzerRunDriver(&argc, &argv, cb);
+}
+
+// -----------------------------------------------------------------------------
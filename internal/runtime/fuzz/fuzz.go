@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fuzz binds libFuzzer, which drives the fuzzing of the test
+// binaries built by llgo test -fuzz with SanitizerCoverage.
+package fuzz
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+const (
+	LLGoFiles   = "_fuzz/fuzz.c"
+	LLGoPackage = "link"
+)
+
+// -----------------------------------------------------------------------------
+
+// RunDriver runs the fuzzing loop of libFuzzer with the command line argv of
+// argc arguments, the program name first, calling fn for each input, which
+// returns 0. libFuzzer exits when the loop ends: RunDriver only returns if
+// it fails to start, or -1 if the binary isn't linked with libFuzzer.
+//
+//go:linkname RunDriver C.llgoFuzzDriver
+func RunDriver(argc c.Int, argv **c.Char, fn func(data *byte, size uintptr) c.Int) c.Int
+
+// -----------------------------------------------------------------------------
@@ -11,7 +11,7 @@ package runtime
 
 import "unsafe"
 
-func memhash32(p unsafe.Pointer, seed uintptr) uintptr {
+func memhash32Fallback(p unsafe.Pointer, seed uintptr) uintptr {
 	a, b := mix32(uint32(seed), uint32(4^hashkey[0]))
 	t := readUnaligned32(p)
 	a ^= t
@@ -21,7 +21,7 @@ func memhash32(p unsafe.Pointer, seed uintptr) uintptr {
 	return uintptr(a ^ b)
 }
 
-func memhash64(p unsafe.Pointer, seed uintptr) uintptr {
+func memhash64Fallback(p unsafe.Pointer, seed uintptr) uintptr {
 	a, b := mix32(uint32(seed), uint32(8^hashkey[0]))
 	a ^= readUnaligned32(p)
 	b ^= readUnaligned32(add(p, 4))
@@ -30,7 +30,7 @@ func memhash64(p unsafe.Pointer, seed uintptr) uintptr {
 	return uintptr(a ^ b)
 }
 
-func memhash(p unsafe.Pointer, seed, s uintptr) uintptr {
+func memhashFallback(p unsafe.Pointer, seed, s uintptr) uintptr {
 	a, b := mix32(uint32(seed), uint32(s^hashkey[0]))
 	if s == 0 {
 		return uintptr(a ^ b)
@@ -23,7 +23,7 @@ const (
 	m5 = 0x1d8e4e27c47d124f
 )
 
-func memhash(p unsafe.Pointer, seed, s uintptr) uintptr {
+func memhashFallback(p unsafe.Pointer, seed, s uintptr) uintptr {
 	var a, b uintptr
 	seed ^= hashkey[0] ^ m1
 	switch {
@@ -69,12 +69,12 @@ func memhash(p unsafe.Pointer, seed, s uintptr) uintptr {
 	return mix(m5^s, mix(a^m2, b^seed))
 }
 
-func memhash32(p unsafe.Pointer, seed uintptr) uintptr {
+func memhash32Fallback(p unsafe.Pointer, seed uintptr) uintptr {
 	a := r4(p)
 	return mix(m5^4, mix(a^m2, a^seed^hashkey[0]^m1))
 }
 
-func memhash64(p unsafe.Pointer, seed uintptr) uintptr {
+func memhash64Fallback(p unsafe.Pointer, seed uintptr) uintptr {
 	a := r8(p)
 	return mix(m5^8, mix(a^m2, a^seed^hashkey[0]^m1))
 }
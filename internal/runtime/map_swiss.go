@@ -0,0 +1,686 @@
+// Copyright 2024 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/goarch"
+	"github.com/goplus/llgo/internal/runtime/math"
+)
+
+// This file contains the implementation of Go's map type, a swiss table
+// laid out like the maps of Go 1.24 (see internal/runtime/maps).
+//
+// A map is an array of groups. A group holds 8 slots, each with a key and
+// an elem, and a control word of one control byte per slot: the byte is
+// empty, deleted, or the low 7 bits of the hash of the key in the slot
+// (h2). The other bits of the hash (h1) select the group a lookup starts
+// at, from which it probes groups in a quadratic sequence. The control
+// bytes of a group are matched against h2 all at once, as a word (SWAR,
+// which is what Go does where it has no SIMD instructions for it): only
+// the slots matching are compared to the key. A probe stops at the first
+// group with an empty slot.
+//
+// A map of at most 8 entries is a single group, searched without probing.
+// A larger one is a table, loaded to at most 7/8 of its slots: then it is
+// rehashed into a table twice as large, or of the same size if half of
+// the slots it uses are tombstones of deleted entries.
+//
+// Unlike Go, a map is a single table rather than a directory of tables
+// which split one at a time, so a rehash copies all of the map. The layout
+// of slots is computed from the key and elem types, as the map type the
+// compiler emits describes the buckets of the former implementation.
+//
+// Iterators walk the groups the map had when they started, from a random
+// group and slot. If the map has been rehashed since, these groups aren't
+// changed anymore: each key they hold is looked up in the map, to return
+// its current elem, or to skip it if deleted.
+
+const (
+	groupSlots = 8 // slots in a group
+	ctrlSize   = 8 // size of the control word of a group
+
+	// Maximum load of a table, in slots per group.
+	maxAvgGroupLoad = 7
+
+	ctrlEmpty   = 0b10000000
+	ctrlDeleted = 0b11111110
+
+	bitsetLSB = 0x0101010101010101
+	bitsetMSB = 0x8080808080808080
+
+	ctrlGroupEmpty = bitsetLSB * ctrlEmpty
+
+	// flags
+	hashWriting = 4 // a goroutine is writing to the map
+)
+
+// A header for a Go map.
+type hmap struct {
+	count int // # live cells == size of map.  Must be first (used by len() builtin)
+	flags uint8
+	seed  uintptr // hash seed
+
+	groups     unsafe.Pointer // groupMask+1 groups, nil before the first entry
+	groupMask  uintptr        // 0 for a small map
+	growthLeft uintptr        // # entries a table can get before it's rehashed
+	clearSeq   uint64         // # of clears, which end iterators
+
+	slotSize uintptr // size of a slot: a key and an elem, or pointers to them
+	elemOff  uintptr // offset of the elem in a slot
+}
+
+// A hash iteration structure.
+type hiter struct {
+	key  unsafe.Pointer // Must be in first position.  Write nil to indicate iteration end.
+	elem unsafe.Pointer // Must be in second position.
+	t    *maptype
+	h    *hmap
+
+	groups      unsafe.Pointer // groups at the iterator initialization
+	groupMask   uintptr
+	clearSeq    uint64
+	groupOffset uintptr // group the iteration started at
+	entryOffset uintptr // slot of each group the iteration starts at
+	entryIdx    uintptr // # slots visited
+}
+
+// ctrlGroup is the control word of a group: byte i is the control byte of
+// slot i.
+type ctrlGroup uint64
+
+func (g ctrlGroup) get(i uintptr) uint8 {
+	return uint8(g >> (8 * i))
+}
+
+func (g *ctrlGroup) set(i uintptr, c uint8) {
+	*g = *g&^(0xff<<(8*i)) | ctrlGroup(c)<<(8*i)
+}
+
+// matchH2 returns the slots whose control byte is h2. It may report false
+// positives, only for a slot following a true match, of control byte h2^1:
+// the keys of the slots must be compared anyway.
+func (g ctrlGroup) matchH2(h2 uintptr) bitset {
+	v := uint64(g) ^ (bitsetLSB * uint64(h2))
+	return bitset(((v - bitsetLSB) &^ v) & bitsetMSB)
+}
+
+// matchEmpty returns the empty slots.
+func (g ctrlGroup) matchEmpty() bitset {
+	// empty is the only control byte with bit 7 set and bit 1 unset.
+	v := uint64(g)
+	return bitset((v &^ (v << 6)) & bitsetMSB)
+}
+
+// matchEmptyOrDeleted returns the empty or deleted slots.
+func (g ctrlGroup) matchEmptyOrDeleted() bitset {
+	return bitset(uint64(g) & bitsetMSB)
+}
+
+// matchFull returns the slots holding an entry.
+func (g ctrlGroup) matchFull() bitset {
+	return bitset(^uint64(g) & bitsetMSB)
+}
+
+// bitset is a set of slots of a group, the bit 7 of byte i standing for
+// slot i.
+type bitset uint64
+
+// first returns the first slot of a non-empty set.
+func (b bitset) first() uintptr {
+	// The lowest bit, shifted to bit 0 of byte i, moves byte 7-i of the
+	// multiplier, i, into the top byte.
+	return uintptr((uint64(b&-b) >> 7 * 0x0001020304050607) >> 56)
+}
+
+func (b bitset) removeFirst() bitset {
+	return b & (b - 1)
+}
+
+// probeSeq is the sequence of groups a lookup probes: offsets by 1, 2, 3...
+// groups, which visit all of them as their number is a power of 2.
+type probeSeq struct {
+	mask   uintptr
+	offset uintptr
+	index  uintptr
+}
+
+func makeProbeSeq(hash uintptr, mask uintptr) probeSeq {
+	return probeSeq{mask: mask, offset: h1(hash) & mask}
+}
+
+func (s probeSeq) next() probeSeq {
+	s.index++
+	s.offset = (s.offset + s.index) & s.mask
+	return s
+}
+
+func h1(hash uintptr) uintptr {
+	return hash >> 7
+}
+
+func h2(hash uintptr) uintptr {
+	return hash & 0x7f
+}
+
+// initLayout computes the layout of the slots of h for map type t.
+func (h *hmap) initLayout(t *maptype) {
+	keySize, keyAlign := t.Key.Size_, uintptr(t.Key.Align_)
+	if t.IndirectKey() {
+		keySize, keyAlign = goarch.PtrSize, goarch.PtrSize
+	}
+	elemSize, elemAlign := t.Elem.Size_, uintptr(t.Elem.Align_)
+	if t.IndirectElem() {
+		elemSize, elemAlign = goarch.PtrSize, goarch.PtrSize
+	}
+	slotAlign := keyAlign
+	if elemAlign > slotAlign {
+		slotAlign = elemAlign
+	}
+	h.elemOff = alignUp(keySize, elemAlign)
+	h.slotSize = alignUp(h.elemOff+elemSize, slotAlign)
+}
+
+func alignUp(n, a uintptr) uintptr {
+	return (n + a - 1) &^ (a - 1)
+}
+
+func (h *hmap) small() bool {
+	return h.groupMask == 0
+}
+
+// group returns group i of groups.
+func (h *hmap) group(groups unsafe.Pointer, i uintptr) unsafe.Pointer {
+	return add(groups, i*(ctrlSize+groupSlots*h.slotSize))
+}
+
+// slot returns slot i of group g.
+func (h *hmap) slot(g unsafe.Pointer, i uintptr) unsafe.Pointer {
+	return add(g, ctrlSize+i*h.slotSize)
+}
+
+func (h *hmap) key(t *maptype, slot unsafe.Pointer) unsafe.Pointer {
+	if t.IndirectKey() {
+		return *(*unsafe.Pointer)(slot)
+	}
+	return slot
+}
+
+func (h *hmap) elem(t *maptype, slot unsafe.Pointer) unsafe.Pointer {
+	e := add(slot, h.elemOff)
+	if t.IndirectElem() {
+		return *(*unsafe.Pointer)(e)
+	}
+	return e
+}
+
+// newGroups allocates n empty groups.
+func (h *hmap) newGroups(n uintptr) unsafe.Pointer {
+	size := ctrlSize + groupSlots*h.slotSize
+	mem, overflow := math.MulUintptr(n, size)
+	if overflow || mem > maxAlloc {
+		panic(plainError("runtime: allocation size out of range"))
+	}
+	groups := AllocZ(mem)
+	for i := uintptr(0); i < n; i++ {
+		*(*ctrlGroup)(add(groups, i*size)) = ctrlGroupEmpty
+	}
+	return groups
+}
+
+// makemap_small implements Go map creation for make(map[k]v) and
+// make(map[k]v, hint) when hint is known to be at most 8 at compile
+// time: the map has no groups until its first entry.
+func makemap_small() *hmap {
+	h := new(hmap)
+	h.seed = uintptr(fastrand64())
+	return h
+}
+
+// makemap implements Go map creation for make(map[k]v, hint).
+// If h != nil, the map can be created directly in h.
+func makemap(t *maptype, hint int, h *hmap) *hmap {
+	if h == nil {
+		h = new(hmap)
+	}
+	h.seed = uintptr(fastrand64())
+	h.initLayout(t)
+
+	mem, overflow := math.MulUintptr(uintptr(hint), h.slotSize+1)
+	if hint <= groupSlots || overflow || mem > maxAlloc {
+		return h // small, allocated lazily in mapassign
+	}
+	capacity := uintptr(2 * groupSlots)
+	for capacity*maxAvgGroupLoad/groupSlots < uintptr(hint) {
+		capacity *= 2
+	}
+	h.groups = h.newGroups(capacity / groupSlots)
+	h.groupMask = capacity/groupSlots - 1
+	h.growthLeft = capacity * maxAvgGroupLoad / groupSlots
+	return h
+}
+
+// mapzero returns a pointer to a zero elem of map type t.
+func mapzero(t *maptype) unsafe.Pointer {
+	if t.Elem.Size_ > maxZero {
+		return newobject(t.Elem)
+	}
+	return unsafe.Pointer(&zeroVal[0])
+}
+
+const maxZero = 1024 // must match value in reflect/value.go:maxZero cmd/compile/internal/gc/walk.go:zeroValSize
+var zeroVal [maxZero]byte
+
+// matchKey returns the slot of group g holding key, if any.
+func (h *hmap) matchKey(t *maptype, g unsafe.Pointer, key unsafe.Pointer, hash uintptr) (uintptr, bool) {
+	for match := (*ctrlGroup)(g).matchH2(h2(hash)); match != 0; match = match.removeFirst() {
+		i := match.first()
+		if t.Key.Equal(key, h.key(t, h.slot(g, i))) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// find returns the group and the slot holding key, or a nil group. h must
+// have groups.
+func (h *hmap) find(t *maptype, key unsafe.Pointer, hash uintptr) (unsafe.Pointer, uintptr) {
+	if h.small() {
+		if i, ok := h.matchKey(t, h.groups, key, hash); ok {
+			return h.groups, i
+		}
+		return nil, 0
+	}
+	for seq := makeProbeSeq(hash, h.groupMask); ; seq = seq.next() {
+		g := h.group(h.groups, seq.offset)
+		if i, ok := h.matchKey(t, g, key, hash); ok {
+			return g, i
+		}
+		if (*ctrlGroup)(g).matchEmpty() != 0 {
+			return nil, 0
+		}
+	}
+}
+
+// mapaccess1 returns a pointer to h[key].  Never returns nil, instead
+// it will return a reference to the zero object for the elem type if
+// the key is not in the map.
+// NOTE: The returned pointer may keep the whole map live, so don't
+// hold onto it for very long.
+func mapaccess1(t *maptype, h *hmap, key unsafe.Pointer) unsafe.Pointer {
+	e, _ := mapaccess2(t, h, key)
+	return e
+}
+
+func mapaccess2(t *maptype, h *hmap, key unsafe.Pointer) (unsafe.Pointer, bool) {
+	if h == nil || h.count == 0 {
+		if t.HashMightPanic() {
+			t.Hasher(key, 0) // see issue 23734
+		}
+		return mapzero(t), false
+	}
+	if h.flags&hashWriting != 0 {
+		fatal("concurrent map read and map write")
+	}
+	g, i := h.find(t, key, t.Hasher(key, h.seed))
+	if g == nil {
+		return mapzero(t), false
+	}
+	return h.elem(t, h.slot(g, i)), true
+}
+
+// Like mapaccess, but allocates a slot for the key if it is not present in the map.
+func mapassign(t *maptype, h *hmap, key unsafe.Pointer) unsafe.Pointer {
+	if h == nil {
+		panic(plainError("assignment to entry in nil map"))
+	}
+	if h.flags&hashWriting != 0 {
+		fatal("concurrent map writes")
+	}
+	hash := t.Hasher(key, h.seed)
+
+	// Set hashWriting after calling t.hasher, since t.hasher may panic,
+	// in which case we have not actually done a write.
+	h.flags ^= hashWriting
+
+	if h.groups == nil {
+		h.initLayout(t)
+		h.groups = h.newGroups(1)
+	}
+	var slot unsafe.Pointer
+	if h.small() {
+		slot = h.assignSmall(t, key, hash)
+	} else {
+		slot = h.assignTable(t, key, hash)
+	}
+
+	if h.flags&hashWriting == 0 {
+		fatal("concurrent map writes")
+	}
+	h.flags &^= hashWriting
+	return h.elem(t, slot)
+}
+
+func (h *hmap) assignSmall(t *maptype, key unsafe.Pointer, hash uintptr) unsafe.Pointer {
+	g := h.groups
+	if i, ok := h.matchKey(t, g, key, hash); ok {
+		return h.updateKey(t, h.slot(g, i), key)
+	}
+	if h.count < groupSlots {
+		return h.newEntry(t, g, (*ctrlGroup)(g).matchEmpty().first(), key, hash)
+	}
+	h.grow(t)
+	return h.insertNew(t, key, hash)
+}
+
+func (h *hmap) assignTable(t *maptype, key unsafe.Pointer, hash uintptr) unsafe.Pointer {
+	var insertGroup unsafe.Pointer
+	var insertIdx uintptr
+	for seq := makeProbeSeq(hash, h.groupMask); ; seq = seq.next() {
+		g := h.group(h.groups, seq.offset)
+		if i, ok := h.matchKey(t, g, key, hash); ok {
+			return h.updateKey(t, h.slot(g, i), key)
+		}
+		ctrls := *(*ctrlGroup)(g)
+		if insertGroup == nil {
+			if match := ctrls.matchEmptyOrDeleted(); match != 0 {
+				insertGroup, insertIdx = g, match.first()
+			}
+		}
+		if ctrls.matchEmpty() != 0 {
+			break
+		}
+	}
+
+	// Did not find mapping for key: reuse the first tombstone on its
+	// probe sequence, or take an empty slot if the table can grow.
+	if (*ctrlGroup)(insertGroup).get(insertIdx) == ctrlDeleted {
+		return h.newEntry(t, insertGroup, insertIdx, key, hash)
+	}
+	if h.growthLeft == 0 {
+		h.grow(t)
+		return h.insertNew(t, key, hash)
+	}
+	h.growthLeft--
+	return h.newEntry(t, insertGroup, insertIdx, key, hash)
+}
+
+// updateKey overwrites the key in slot with an equal one, if it may differ
+// (eg. +0.0 and -0.0).
+func (h *hmap) updateKey(t *maptype, slot unsafe.Pointer, key unsafe.Pointer) unsafe.Pointer {
+	if t.NeedKeyUpdate() {
+		typedmemmove(t.Key, h.key(t, slot), key)
+	}
+	return slot
+}
+
+// newEntry stores key in slot i of group g, which is free, and returns the
+// slot.
+func (h *hmap) newEntry(t *maptype, g unsafe.Pointer, i uintptr, key unsafe.Pointer, hash uintptr) unsafe.Pointer {
+	slot := h.slot(g, i)
+	k := slot
+	if t.IndirectKey() {
+		k = newobject(t.Key)
+		*(*unsafe.Pointer)(slot) = k
+	}
+	if t.IndirectElem() {
+		*(*unsafe.Pointer)(add(slot, h.elemOff)) = newobject(t.Elem)
+	}
+	typedmemmove(t.Key, k, key)
+	(*ctrlGroup)(g).set(i, uint8(h2(hash)))
+	h.count++
+	return slot
+}
+
+// insertNew adds key, which is not in the map, to a table without
+// tombstones, which can grow.
+func (h *hmap) insertNew(t *maptype, key unsafe.Pointer, hash uintptr) unsafe.Pointer {
+	g, i := h.emptySlot(hash)
+	h.growthLeft--
+	return h.newEntry(t, g, i, key, hash)
+}
+
+// emptySlot returns the first empty slot on the probe sequence of hash.
+func (h *hmap) emptySlot(hash uintptr) (unsafe.Pointer, uintptr) {
+	for seq := makeProbeSeq(hash, h.groupMask); ; seq = seq.next() {
+		g := h.group(h.groups, seq.offset)
+		if match := (*ctrlGroup)(g).matchEmpty(); match != 0 {
+			return g, match.first()
+		}
+	}
+}
+
+// grow makes room for one more entry in a full map: it rehashes it into a
+// table twice as large, or of the same size if at least half of the slots
+// it uses are tombstones.
+func (h *hmap) grow(t *maptype) {
+	capacity := (h.groupMask + 1) * groupSlots
+	if h.small() || uintptr(h.count) > capacity*maxAvgGroupLoad/groupSlots/2 {
+		capacity *= 2
+	}
+	h.rehash(t, capacity)
+}
+
+// rehash moves the entries of h into new groups, for capacity slots. The
+// former groups are left unchanged, for iterators.
+func (h *hmap) rehash(t *maptype, capacity uintptr) {
+	oldGroups, oldMask := h.groups, h.groupMask
+	n := capacity / groupSlots
+	h.groups = h.newGroups(n)
+	h.groupMask = n - 1
+	h.growthLeft = capacity*maxAvgGroupLoad/groupSlots - uintptr(h.count)
+	for j := uintptr(0); j <= oldMask; j++ {
+		old := h.group(oldGroups, j)
+		for match := (*ctrlGroup)(old).matchFull(); match != 0; match = match.removeFirst() {
+			slot := h.slot(old, match.first())
+			hash := t.Hasher(h.key(t, slot), h.seed)
+			g, i := h.emptySlot(hash)
+			c.Memcpy(h.slot(g, i), slot, h.slotSize)
+			(*ctrlGroup)(g).set(i, uint8(h2(hash)))
+		}
+	}
+}
+
+func mapdelete(t *maptype, h *hmap, key unsafe.Pointer) {
+	if h == nil || h.count == 0 {
+		if t.HashMightPanic() {
+			t.Hasher(key, 0) // see issue 23734
+		}
+		return
+	}
+	if h.flags&hashWriting != 0 {
+		fatal("concurrent map writes")
+	}
+
+	hash := t.Hasher(key, h.seed)
+
+	// Set hashWriting after calling t.hasher, since t.hasher may panic,
+	// in which case we have not actually done a write (delete).
+	h.flags ^= hashWriting
+
+	if g, i := h.find(t, key, hash); g != nil {
+		c.Memset(h.slot(g, i), 0, h.slotSize)
+		// A probe sequence never went past a group with an empty slot: if
+		// there is one, the slot can be empty too, else it's a tombstone.
+		ctrls := (*ctrlGroup)(g)
+		if h.small() || ctrls.matchEmpty() != 0 {
+			ctrls.set(i, ctrlEmpty)
+			h.growthLeft++
+		} else {
+			ctrls.set(i, ctrlDeleted)
+		}
+		h.count--
+		// Reset the hash seed to make it more difficult for attackers to
+		// repeatedly trigger hash collisions. See issue 25237.
+		if h.count == 0 {
+			h.seed = uintptr(fastrand64())
+		}
+	}
+
+	if h.flags&hashWriting == 0 {
+		fatal("concurrent map writes")
+	}
+	h.flags &^= hashWriting
+}
+
+// mapiterinit initializes the hiter struct used for ranging over maps.
+// The hiter struct pointed to by 'it' must be zeroed.
+func mapiterinit(t *maptype, h *hmap, it *hiter) {
+	it.t = t
+	if h == nil || h.count == 0 {
+		return
+	}
+	it.h = h
+
+	// grab snapshot of the groups
+	it.groups = h.groups
+	it.groupMask = h.groupMask
+	it.clearSeq = h.clearSeq
+
+	// decide where to start
+	r := uintptr(fastrand64())
+	it.entryOffset = r & (groupSlots - 1)
+	it.groupOffset = (r >> 3) & h.groupMask
+
+	mapiternext(it)
+}
+
+func mapiternext(it *hiter) {
+	h := it.h
+	if h.flags&hashWriting != 0 {
+		fatal("concurrent map iteration and map write")
+	}
+	t := it.t
+	if it.clearSeq != h.clearSeq {
+		// The map has been cleared: there is nothing left to return.
+		it.key = nil
+		it.elem = nil
+		return
+	}
+	for n := (it.groupMask + 1) * groupSlots; it.entryIdx < n; it.entryIdx++ {
+		g := h.group(it.groups, (it.entryIdx/groupSlots+it.groupOffset)&it.groupMask)
+		i := (it.entryIdx + it.entryOffset) & (groupSlots - 1)
+		if (*ctrlGroup)(g).get(i)&ctrlEmpty != 0 {
+			continue // empty or deleted
+		}
+		slot := h.slot(g, i)
+		k := h.key(t, slot)
+		if it.groups != h.groups && (t.ReflexiveKey() || t.Key.Equal(k, k)) {
+			// The map has been rehashed since the iterator was started.
+			// Look the key up in it, as it may have been updated to an
+			// equal but not identical key (eg. +0.0 and -0.0), deleted,
+			// or deleted and reinserted.
+			// A key != key (NaN) can't be updated or deleted: just return
+			// it, as it can't be looked up.
+			g, i = h.find(t, k, t.Hasher(k, h.seed))
+			if g == nil {
+				continue // key has been deleted
+			}
+			slot = h.slot(g, i)
+			k = h.key(t, slot)
+		}
+		it.key = k
+		it.elem = h.elem(t, slot)
+		it.entryIdx++
+		return
+	}
+	it.key = nil
+	it.elem = nil
+}
+
+// mapclear deletes all keys from a map.
+func mapclear(t *maptype, h *hmap) {
+	if h == nil || h.groups == nil {
+		return
+	}
+
+	if h.flags&hashWriting != 0 {
+		fatal("concurrent map writes")
+	}
+
+	h.flags ^= hashWriting
+
+	// Empty the groups in place, and end the iterators using them.
+	size := ctrlSize + groupSlots*h.slotSize
+	for i := uintptr(0); i <= h.groupMask; i++ {
+		g := add(h.groups, i*size)
+		*(*ctrlGroup)(g) = ctrlGroupEmpty
+		c.Memset(add(g, ctrlSize), 0, size-ctrlSize)
+	}
+	h.count = 0
+	h.growthLeft = (h.groupMask + 1) * maxAvgGroupLoad
+	h.clearSeq++
+
+	// Reset the hash seed to make it more difficult for attackers to
+	// repeatedly trigger hash collisions. See issue 25237.
+	h.seed = uintptr(fastrand64())
+
+	if h.flags&hashWriting == 0 {
+		fatal("concurrent map writes")
+	}
+	h.flags &^= hashWriting
+}
+
+// mapclone for implementing maps.Clone
+//
+//go:linkname mapclone maps.clone
+func mapclone(m any) any {
+	e := efaceOf(&m)
+	e.data = unsafe.Pointer(mapclone2((*maptype)(unsafe.Pointer(e._type)), (*hmap)(e.data)))
+	return m
+}
+
+func mapclone2(t *maptype, src *hmap) *hmap {
+	dst := makemap(t, src.count, nil)
+	if src.count == 0 {
+		return dst
+	}
+	if src.flags&hashWriting != 0 {
+		fatal("concurrent map clone and map write")
+	}
+	var it hiter
+	for mapiterinit(t, src, &it); it.key != nil; mapiternext(&it) {
+		typedmemmove(t.Elem, mapassign(t, dst, it.key), it.elem)
+	}
+	return dst
+}
+
+// keys for implementing maps.keys
+//
+//go:linkname keys maps.keys
+func keys(m any, p unsafe.Pointer) {
+	e := efaceOf(&m)
+	t := (*maptype)(unsafe.Pointer(e._type))
+	h := (*hmap)(e.data)
+	s := (*slice)(p)
+	var it hiter
+	for mapiterinit(t, h, &it); it.key != nil; mapiternext(&it) {
+		if s.len >= s.cap {
+			fatal("concurrent map read and map write")
+		}
+		typedmemmove(t.Key, add(s.array, uintptr(s.len)*t.Key.Size_), it.key)
+		s.len++
+	}
+}
+
+// values for implementing maps.values
+//
+//go:linkname values maps.values
+func values(m any, p unsafe.Pointer) {
+	e := efaceOf(&m)
+	t := (*maptype)(unsafe.Pointer(e._type))
+	h := (*hmap)(e.data)
+	s := (*slice)(p)
+	var it hiter
+	for mapiterinit(t, h, &it); it.key != nil; mapiternext(&it) {
+		if s.len >= s.cap {
+			fatal("concurrent map read and map write")
+		}
+		typedmemmove(t.Elem, add(s.array, uintptr(s.len)*t.Elem.Size_), it.elem)
+		s.len++
+	}
+}
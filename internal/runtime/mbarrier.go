@@ -141,6 +141,20 @@ import (
 // remove the deletion barrier, we'll have to work out a new way to
 // handle the profile logging.
 
+// WriteBarrier is called by compiled code before the pointer ptr is stored at
+// slot, if slot may be in the heap and write barriers are enabled (see
+// llssa.Program.SetWriteBarrier). It's the writePointer barrier above without
+// the store, for GC backends that need it: bdwgc is conservative, so it does
+// nothing.
+func WriteBarrier(slot, ptr unsafe.Pointer) {
+}
+
+// bulkBarrier is called before size bytes holding pointers are copied to dst
+// from src, by SliceAppendWB and SliceCopyWB. It's bulkBarrierPreWrite for GC
+// backends that need it: bdwgc is conservative, so it does nothing.
+func bulkBarrier(dst, src unsafe.Pointer, size uintptr) {
+}
+
 // typedmemmove copies a value of type typ to dst from src.
 // Must be nosplit, see #16026.
 //
@@ -0,0 +1,237 @@
+#define _GNU_SOURCE
+#include <errno.h>
+#include <signal.h>
+#include <stdatomic.h>
+#include <stdint.h>
+#include <string.h>
+#include <sys/time.h>
+#if defined(__APPLE__)
+#include <sys/ucontext.h>
+#else
+#include <ucontext.h>
+#endif
+#if defined(__APPLE__) || defined(__GLIBC__)
+#include <execinfo.h>
+#define HAVE_BACKTRACE 1
+#endif
+
+// -----------------------------------------------------------------------------
+
+// Stacks are unwound with backtrace: through the DWARF unwind tables with
+// glibc, which also describe the signal frame, and through frame pointers on
+// macOS. Without it, only the program counter of a CPU sample is recorded.
+
+#define MAX_DEPTH 64
+
+static inline __attribute__((always_inline)) int callers(void **buf, int max) {
+#if defined(HAVE_BACKTRACE)
+    return backtrace(buf, max);
+#else
+    return 0;
+#endif
+}
+
+// llgoCallers stores the return addresses of the callers of its caller into
+// buf, skipping skip frames more, and returns their number.
+int llgoCallers(int skip, uintptr_t *buf, int max) {
+    void *stk[MAX_DEPTH * 2];
+    skip += 2; // llgoCallers and its caller
+    if (max > MAX_DEPTH) {
+        max = MAX_DEPTH;
+    }
+    if (skip > MAX_DEPTH) {
+        skip = MAX_DEPTH;
+    }
+    int n = callers(stk, skip + max) - skip;
+    if (n <= 0) {
+        return 0;
+    }
+    memcpy(buf, stk + skip, n * sizeof(uintptr_t));
+    return n;
+}
+
+// -----------------------------------------------------------------------------
+
+// CPU samples are taken by the SIGPROF handler, at the rate of the profiling
+// timer, which counts the CPU time of the process, and signals a thread
+// using it. The handler writes the stack of the interrupted thread into a
+// ring of slots, and the profile writer reads them: a slot is reserved by
+// moving the head, and published by setting its sequence number.
+
+#define PROF_SLOTS 1024
+
+typedef struct {
+    atomic_ulong seq; // index of the sample + 1 once written
+    int n;
+    uintptr_t pc[MAX_DEPTH];
+} profSlot;
+
+static profSlot profSlots[PROF_SLOTS];
+static atomic_ulong profHead; // next slot to write
+static atomic_ulong profTail; // next slot to read
+static atomic_long profLost;  // samples dropped while the ring is full
+static atomic_int profOn;
+
+static uintptr_t contextPC(void *ctx) {
+    ucontext_t *uc = ctx;
+#if defined(__APPLE__) && defined(__x86_64__)
+    return uc->uc_mcontext->__ss.__rip;
+#elif defined(__APPLE__) && defined(__aarch64__)
+    return uc->uc_mcontext->__ss.__pc;
+#elif defined(__linux__) && defined(__x86_64__)
+    return uc->uc_mcontext.gregs[REG_RIP];
+#elif defined(__linux__) && defined(__aarch64__)
+    return uc->uc_mcontext.pc;
+#else
+    (void)uc;
+    return 0;
+#endif
+}
+
+static void onProf(int sig, siginfo_t *info, void *ctx) {
+    if (!atomic_load(&profOn)) {
+        return; // a signal still pending when profiling stopped
+    }
+    int saved = errno;
+    void *stk[MAX_DEPTH + 4];
+    int n = callers(stk, MAX_DEPTH + 4);
+    uintptr_t pc = contextPC(ctx);
+
+    // drop the frames of the handler and of the signal trampoline
+    int skip = 2;
+    for (int i = 0; i < n && i < 6; i++) {
+        if ((uintptr_t)stk[i] == pc) {
+            skip = i;
+            break;
+        }
+    }
+    unsigned long head = atomic_load(&profHead);
+    do {
+        if (head - atomic_load(&profTail) >= PROF_SLOTS) {
+            atomic_fetch_add(&profLost, 1);
+            errno = saved;
+            return;
+        }
+    } while (!atomic_compare_exchange_weak(&profHead, &head, head + 1));
+
+    profSlot *s = &profSlots[head % PROF_SLOTS];
+    if (n - skip > 0) {
+        s->n = n - skip > MAX_DEPTH ? MAX_DEPTH : n - skip;
+        memcpy(s->pc, stk + skip, s->n * sizeof(uintptr_t));
+    } else {
+        s->n = 1;
+        s->pc[0] = pc;
+    }
+    atomic_store_explicit(&s->seq, head + 1, memory_order_release);
+    errno = saved;
+}
+
+// llgoProfStart starts taking hz samples per second of CPU time. It returns
+// -1 if the timer can't be set.
+int llgoProfStart(int hz) {
+    static int installed;
+    if (!installed) {
+        void *stk[1];
+        callers(stk, 1); // load the unwinder outside of the handler
+        struct sigaction sa;
+        memset(&sa, 0, sizeof(sa));
+        sa.sa_sigaction = onProf;
+        sa.sa_flags = SA_SIGINFO | SA_RESTART;
+        sigemptyset(&sa.sa_mask);
+        if (sigaction(SIGPROF, &sa, NULL) != 0) {
+            return -1;
+        }
+        installed = 1;
+    }
+    atomic_store(&profLost, 0);
+    atomic_store(&profOn, 1);
+    struct itimerval it;
+    it.it_interval.tv_sec = 0;
+    it.it_interval.tv_usec = 1000000 / hz;
+    it.it_value = it.it_interval;
+    if (setitimer(ITIMER_PROF, &it, NULL) != 0) {
+        atomic_store(&profOn, 0);
+        return -1;
+    }
+    return 0;
+}
+
+// llgoProfStop stops the timer. The handler stays installed, to ignore the
+// signals still pending, whose default action would kill the process.
+void llgoProfStop(void) {
+    struct itimerval it;
+    memset(&it, 0, sizeof(it));
+    setitimer(ITIMER_PROF, &it, NULL);
+    atomic_store(&profOn, 0);
+}
+
+// llgoProfRead stores the stack of the next sample into buf, and returns its
+// depth, or -1 if there is no sample to read. There must be a single reader.
+int llgoProfRead(uintptr_t *buf, int max) {
+    unsigned long tail = atomic_load(&profTail);
+    if (tail == atomic_load(&profHead)) {
+        return -1;
+    }
+    profSlot *s = &profSlots[tail % PROF_SLOTS];
+    if (atomic_load_explicit(&s->seq, memory_order_acquire) != tail + 1) {
+        return -1; // still being written
+    }
+    int n = s->n < max ? s->n : max;
+    memcpy(buf, s->pc, n * sizeof(uintptr_t));
+    atomic_store(&profTail, tail + 1);
+    return n;
+}
+
+// llgoProfLost returns the number of samples dropped since profiling started.
+long llgoProfLost(void) {
+    return atomic_load(&profLost);
+}
+
+// -----------------------------------------------------------------------------
+
+// Allocations are sampled like in Go: the distance between two samples is
+// drawn from an exponential distribution whose mean is the sampling rate,
+// so that each byte allocated has the same chance to be sampled.
+
+static __thread uint64_t sampleRand;
+static __thread long nextSample;
+
+static uint64_t nextRand(void) {
+    if (sampleRand == 0) {
+        sampleRand = (uintptr_t)&sampleRand ^ 0x9e3779b97f4a7c15;
+    }
+    sampleRand ^= sampleRand >> 12; // xorshift64*
+    sampleRand ^= sampleRand << 25;
+    sampleRand ^= sampleRand >> 27;
+    return sampleRand * 0x2545f4914f6cdd1d;
+}
+
+// expSample returns a distance drawn from an exponential distribution of
+// mean rate. -ln(u) is computed from log2(u), approximated by a quadratic
+// between powers of 2, so that it needs no libm.
+static long expSample(long rate) {
+    uint64_t r = nextRand() >> 11 | 1; // u = r / 2^53 in (0, 1)
+    int e = 63 - __builtin_clzll(r);
+    double f = (double)r / (double)(1ULL << e) - 1;
+    double log2u = e + f + 0.346607 * f * (1 - f) - 53;
+    return (long)(-log2u * 0.6931471805599453 * rate) + 1;
+}
+
+// llgoMemSample reports whether an allocation of size bytes is sampled, at
+// the given rate.
+int llgoMemSample(long size, long rate) {
+    if (rate == 1) {
+        return 1;
+    }
+    if (nextSample == 0) {
+        nextSample = expSample(rate);
+    }
+    nextSample -= size;
+    if (nextSample > 0) {
+        return 0;
+    }
+    nextSample = expSample(rate);
+    return 1;
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,382 @@
+#define _GNU_SOURCE
+#include <stddef.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+#include <pthread.h>
+#if defined(__APPLE__)
+#include <dlfcn.h>
+#elif defined(__linux__)
+#include <elf.h>
+#include <fcntl.h>
+#include <link.h>
+#include <sys/mman.h>
+#include <sys/stat.h>
+#include <unistd.h>
+#define HAVE_ELF 1
+#endif
+
+// -----------------------------------------------------------------------------
+
+// Program counters are symbolized from the executable itself: functions are
+// found in its symbol table, and source positions in the DWARF line tables
+// llgo emits for Go functions (versions 2 to 4, the others are skipped). On
+// macOS, where the DWARF of a program stays in its object files, only the
+// names of exported functions are found, by dladdr.
+
+#if defined(HAVE_ELF)
+
+typedef struct {
+    uintptr_t entry;
+    uintptr_t size;
+    const char *name;
+} funcSym;
+
+static pthread_once_t symOnce = PTHREAD_ONCE_INIT;
+static funcSym *syms; // sorted by entry
+static size_t nsyms;
+static uintptr_t loadBias; // runtime address - link time address
+static const uint8_t *lineData;
+static size_t lineSize;
+
+static int findBias(struct dl_phdr_info *info, size_t size, void *data) {
+    (void)size;
+    (void)data;
+    loadBias = info->dlpi_addr; // the executable is the first object
+    return 1;
+}
+
+static int cmpSym(const void *a, const void *b) {
+    uintptr_t x = ((const funcSym *)a)->entry, y = ((const funcSym *)b)->entry;
+    return x < y ? -1 : x > y;
+}
+
+static void loadSyms(const uint8_t *img, const ElfW(Shdr) *symtab, const ElfW(Shdr) *strtab) {
+    const ElfW(Sym) *sym = (const void *)(img + symtab->sh_offset);
+    size_t n = symtab->sh_size / sizeof(ElfW(Sym));
+    const char *str = (const char *)img + strtab->sh_offset;
+    syms = malloc(n * sizeof(funcSym));
+    if (syms == NULL) {
+        return;
+    }
+    for (size_t i = 0; i < n; i++) {
+        if (ELF64_ST_TYPE(sym[i].st_info) == STT_FUNC && sym[i].st_shndx != SHN_UNDEF && sym[i].st_value != 0) {
+            syms[nsyms++] = (funcSym){sym[i].st_value, sym[i].st_size, str + sym[i].st_name};
+        }
+    }
+    qsort(syms, nsyms, sizeof(funcSym), cmpSym);
+    for (size_t i = 0; i < nsyms; i++) { // functions of assembly may have no size
+        if (syms[i].size == 0 && i + 1 < nsyms) {
+            syms[i].size = syms[i + 1].entry - syms[i].entry;
+        }
+    }
+}
+
+static void loadSymtab(void) {
+    dl_iterate_phdr(findBias, NULL);
+    int fd = open("/proc/self/exe", O_RDONLY | O_CLOEXEC);
+    if (fd < 0) {
+        return;
+    }
+    struct stat st;
+    const uint8_t *img = MAP_FAILED;
+    if (fstat(fd, &st) == 0 && (size_t)st.st_size >= sizeof(ElfW(Ehdr))) {
+        img = mmap(NULL, st.st_size, PROT_READ, MAP_PRIVATE, fd, 0);
+    }
+    close(fd);
+    if (img == MAP_FAILED) {
+        return;
+    }
+    const ElfW(Ehdr) *eh = (const void *)img;
+    if (memcmp(eh->e_ident, ELFMAG, SELFMAG) != 0 || eh->e_shoff == 0 || eh->e_shstrndx == SHN_UNDEF ||
+        eh->e_shoff + (size_t)eh->e_shnum * sizeof(ElfW(Shdr)) > (size_t)st.st_size) {
+        munmap((void *)img, st.st_size);
+        return;
+    }
+    const ElfW(Shdr) *sh = (const void *)(img + eh->e_shoff);
+    const char *shstr = (const char *)img + sh[eh->e_shstrndx].sh_offset;
+    const ElfW(Shdr) *symtab = NULL;
+    for (int i = 0; i < eh->e_shnum; i++) {
+        if (sh[i].sh_type == SHT_SYMTAB || (sh[i].sh_type == SHT_DYNSYM && symtab == NULL)) {
+            symtab = &sh[i]; // the dynamic symbols only if stripped
+        } else if (strcmp(shstr + sh[i].sh_name, ".debug_line") == 0 && !(sh[i].sh_flags & SHF_COMPRESSED)) {
+            lineData = img + sh[i].sh_offset;
+            lineSize = sh[i].sh_size;
+        }
+    }
+    if (symtab != NULL) {
+        loadSyms(img, symtab, &sh[symtab->sh_link]);
+    }
+}
+
+// -----------------------------------------------------------------------------
+
+typedef struct {
+    const uint8_t *p;
+    const uint8_t *end;
+} reader;
+
+static uint64_t readN(reader *r, int n) {
+    uint64_t v = 0;
+    if (r->end - r->p < n) {
+        r->p = r->end;
+        return 0;
+    }
+    for (int i = 0; i < n; i++) { // little endian
+        v |= (uint64_t)r->p[i] << (8 * i);
+    }
+    r->p += n;
+    return v;
+}
+
+static uint64_t uleb(reader *r) {
+    uint64_t v = 0;
+    for (int shift = 0; r->p < r->end; shift += 7) {
+        uint8_t b = *r->p++;
+        if (shift < 64) {
+            v |= (uint64_t)(b & 0x7f) << shift;
+        }
+        if (!(b & 0x80)) {
+            break;
+        }
+    }
+    return v;
+}
+
+static int64_t sleb(reader *r) {
+    int64_t v = 0;
+    int shift = 0;
+    uint8_t b = 0;
+    while (r->p < r->end) {
+        b = *r->p++;
+        if (shift < 64) {
+            v |= (int64_t)(b & 0x7f) << shift;
+        }
+        shift += 7;
+        if (!(b & 0x80)) {
+            break;
+        }
+    }
+    if (shift < 64 && (b & 0x40)) {
+        v |= -((int64_t)1 << shift);
+    }
+    return v;
+}
+
+static const char *readStr(reader *r) {
+    const char *s = (const char *)r->p;
+    size_t n = strnlen(s, r->end - r->p);
+    r->p += n < (size_t)(r->end - r->p) ? n + 1 : n;
+    return s;
+}
+
+// nthStr returns the ith string, from 1, of a list ended by an empty one.
+static const char *nthStr(reader r, uint64_t i) {
+    for (uint64_t k = 1; r.p < r.end && *r.p; k++) {
+        const char *s = readStr(&r);
+        if (k == i) {
+            return s;
+        }
+    }
+    return NULL;
+}
+
+// fileOf finds the ith entry, from 1, of the file names of a line table.
+static void fileOf(reader files, reader dirs, uint64_t i, const char **file, const char **dir) {
+    for (uint64_t k = 1; files.p < files.end && *files.p; k++) {
+        const char *name = readStr(&files);
+        uint64_t d = uleb(&files);
+        uleb(&files); // modification time
+        uleb(&files); // length
+        if (k == i) {
+            *file = name;
+            *dir = d ? nthStr(dirs, d) : NULL;
+            return;
+        }
+    }
+}
+
+// lineOf runs the line program of a unit, to find the row covering addr.
+static int lineOf(reader r, int offSize, uintptr_t addr, const char **file, const char **dir, int *line) {
+    uint16_t version = readN(&r, 2);
+    if (version < 2 || version > 4) {
+        return 0;
+    }
+    uint64_t hlen = readN(&r, offSize);
+    if (hlen > (uint64_t)(r.end - r.p)) {
+        return 0;
+    }
+    reader prog = {r.p + hlen, r.end};
+    uint8_t minInst = readN(&r, 1);
+    if (version >= 4) {
+        readN(&r, 1); // maximum operations per instruction
+    }
+    readN(&r, 1); // default is_stmt
+    int8_t lineBase = (int8_t)readN(&r, 1);
+    uint8_t lineRange = readN(&r, 1);
+    uint8_t opBase = readN(&r, 1);
+    if (lineRange == 0 || opBase == 0) {
+        return 0;
+    }
+    const uint8_t *stdLens = r.p;
+    r.p += opBase - 1;
+    if (r.p > prog.p) {
+        return 0;
+    }
+    reader dirs = {r.p, prog.p};
+    while (r.p < prog.p && *r.p) {
+        readStr(&r);
+    }
+    r.p++;
+    reader files = {r.p, prog.p};
+
+    uintptr_t address = 0;
+    uint64_t fileIdx = 1;
+    int64_t ln = 1;
+    int found = 0, havePrev = 0;
+    uintptr_t prevAddr = 0;
+    uint64_t prevFile = 0;
+    int64_t prevLine = 0;
+    while (prog.p < prog.end && !found) {
+        int row = 0, endSeq = 0;
+        uint8_t op = *prog.p++;
+        if (op >= opBase) {
+            uint8_t adj = op - opBase;
+            address += (adj / lineRange) * minInst;
+            ln += lineBase + adj % lineRange;
+            row = 1;
+        } else if (op == 0) { // extended opcode
+            uint64_t n = uleb(&prog);
+            if (n == 0 || n > (uint64_t)(prog.end - prog.p)) {
+                break;
+            }
+            const uint8_t *next = prog.p + n;
+            switch (*prog.p++) {
+            case 1: // DW_LNE_end_sequence
+                row = endSeq = 1;
+                break;
+            case 2: // DW_LNE_set_address
+                address = readN(&prog, n - 1 > 8 ? 8 : (int)(n - 1));
+                break;
+            }
+            prog.p = next;
+        } else {
+            switch (op) {
+            case 1: // DW_LNS_copy
+                row = 1;
+                break;
+            case 2: // DW_LNS_advance_pc
+                address += uleb(&prog) * minInst;
+                break;
+            case 3: // DW_LNS_advance_line
+                ln += sleb(&prog);
+                break;
+            case 4: // DW_LNS_set_file
+                fileIdx = uleb(&prog);
+                break;
+            case 8: // DW_LNS_const_add_pc
+                address += ((255 - opBase) / lineRange) * minInst;
+                break;
+            case 9: // DW_LNS_fixed_advance_pc
+                address += readN(&prog, 2);
+                break;
+            default: // operands of other opcodes are skipped
+                for (int i = 0; i < stdLens[op - 1]; i++) {
+                    uleb(&prog);
+                }
+            }
+        }
+        if (!row) {
+            continue;
+        }
+        if (havePrev && prevAddr <= addr && addr < address) {
+            found = 1;
+            break;
+        }
+        havePrev = !endSeq;
+        prevAddr = address, prevFile = fileIdx, prevLine = ln;
+        if (endSeq) {
+            address = 0, fileIdx = 1, ln = 1;
+        }
+    }
+    if (!found || prevLine <= 0) {
+        return 0;
+    }
+    *file = NULL;
+    fileOf(files, dirs, prevFile, file, dir);
+    *line = (int)prevLine;
+    return *file != NULL;
+}
+
+static int findLine(uintptr_t addr, const char **file, const char **dir, int *line) {
+    reader r = {lineData, lineData + lineSize};
+    while (r.p < r.end) {
+        int offSize = 4;
+        uint64_t len = readN(&r, 4);
+        if (len == 0xffffffff) { // 64-bit DWARF
+            offSize = 8;
+            len = readN(&r, 8);
+        }
+        if (len == 0 || len > (uint64_t)(r.end - r.p)) {
+            break;
+        }
+        reader unit = {r.p, r.p + len};
+        if (lineOf(unit, offSize, addr, file, dir, line)) {
+            return 1;
+        }
+        r.p += len;
+    }
+    return 0;
+}
+
+// llgoFuncInfo symbolizes pc: it returns the name of the function containing
+// pc, or NULL, and stores its entry, and the source position of pc into file,
+// dir and line. file is NULL if the position is unknown, and dir if file has
+// no directory.
+const char *llgoFuncInfo(uintptr_t pc, uintptr_t *entry, const char **file, const char **dir, int *line) {
+    pthread_once(&symOnce, loadSymtab);
+    *file = NULL, *dir = NULL, *line = 0;
+    uintptr_t addr = pc - loadBias;
+    size_t lo = 0, hi = nsyms;
+    while (lo < hi) { // the first function after addr
+        size_t mid = lo + (hi - lo) / 2;
+        if (syms[mid].entry <= addr) {
+            lo = mid + 1;
+        } else {
+            hi = mid;
+        }
+    }
+    if (lo == 0 || addr - syms[lo - 1].entry >= syms[lo - 1].size) {
+        return NULL;
+    }
+    const funcSym *s = &syms[lo - 1];
+    *entry = s->entry + loadBias;
+    if (lineData != NULL && !findLine(addr, file, dir, line)) {
+        *file = NULL, *dir = NULL, *line = 0;
+    }
+    return s->name;
+}
+
+#elif defined(__APPLE__)
+
+const char *llgoFuncInfo(uintptr_t pc, uintptr_t *entry, const char **file, const char **dir, int *line) {
+    Dl_info info;
+    *file = NULL, *dir = NULL, *line = 0;
+    if (dladdr((void *)pc, &info) == 0 || info.dli_sname == NULL) {
+        return NULL;
+    }
+    *entry = (uintptr_t)info.dli_saddr;
+    return info.dli_sname;
+}
+
+#else
+
+const char *llgoFuncInfo(uintptr_t pc, uintptr_t *entry, const char **file, const char **dir, int *line) {
+    (void)pc, (void)entry;
+    *file = NULL, *dir = NULL, *line = 0;
+    return NULL;
+}
+
+#endif
+
+// -----------------------------------------------------------------------------
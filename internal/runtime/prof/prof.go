@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package prof
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+const (
+	LLGoFiles   = "_prof/prof.c; _prof/symtab.c"
+	LLGoPackage = "link"
+)
+
+// -----------------------------------------------------------------------------
+
+// Callers stores the return addresses of the callers of its caller into buf,
+// skipping skip frames more, at most 64, and returns their number, at most
+// 64. With skip = -1, its caller is the first.
+//
+//go:linkname Callers C.llgoCallers
+func Callers(skip c.Int, buf *uintptr, max c.Int) c.Int
+
+// FuncInfo symbolizes pc: it returns the name of the function containing pc,
+// or nil, and stores its entry, and the source position of pc into file, dir
+// and line. file is nil if the position is unknown, and dir if file has no
+// directory. The position of a call is found from its return address - 1.
+//
+//go:linkname FuncInfo C.llgoFuncInfo
+func FuncInfo(pc uintptr, entry *uintptr, file, dir **c.Char, line *c.Int) *c.Char
+
+// -----------------------------------------------------------------------------
+
+// Start starts taking hz samples per second of CPU time. It returns -1 if the
+// profiling timer can't be set.
+//
+//go:linkname Start C.llgoProfStart
+func Start(hz c.Int) c.Int
+
+// Stop stops taking samples.
+//
+//go:linkname Stop C.llgoProfStop
+func Stop()
+
+// Read stores the stack of the next sample into buf, the interrupted program
+// counter first, and returns its depth, or -1 if there is no sample to read.
+// There must be a single reader.
+//
+//go:linkname Read C.llgoProfRead
+func Read(buf *uintptr, max c.Int) c.Int
+
+// Lost returns the number of samples dropped since Start, as the samples not
+// read yet filled the buffer.
+//
+//go:linkname Lost C.llgoProfLost
+func Lost() c.Long
+
+// -----------------------------------------------------------------------------
+
+// MemSample reports whether an allocation of size bytes is sampled, when an
+// allocation is sampled every rate bytes on average.
+//
+//go:linkname MemSample C.llgoMemSample
+func MemSample(size, rate c.Long) c.Int
+
+// -----------------------------------------------------------------------------
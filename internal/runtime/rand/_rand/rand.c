@@ -0,0 +1,236 @@
+#if defined(__linux__)
+#define _GNU_SOURCE
+#endif
+#include <errno.h>
+#include <fcntl.h>
+#include <pthread.h>
+#include <stdint.h>
+#include <string.h>
+#include <time.h>
+#include <unistd.h>
+#if defined(__linux__)
+#include <sys/syscall.h>
+#elif defined(__APPLE__)
+#include <sys/random.h>
+#elif defined(_WIN32)
+#include <windows.h>
+#include <bcrypt.h>
+#endif
+
+// -----------------------------------------------------------------------------
+
+// llgoGetRandom fills buf with n random bytes of the system, and returns 0 or
+// an error number: getrandom on Linux, getentropy on Apple systems and
+// BCryptGenRandom on Windows. /dev/urandom is read if getrandom is missing,
+// before Linux 3.17, and on other systems.
+
+static int readURandom(uint8_t *buf, size_t n) {
+    int fd;
+    do {
+        fd = open("/dev/urandom", O_RDONLY | O_CLOEXEC);
+    } while (fd < 0 && errno == EINTR);
+    if (fd < 0) {
+        return errno;
+    }
+    int err = 0;
+    while (n > 0) {
+        ssize_t r = read(fd, buf, n);
+        if (r < 0 && errno == EINTR) {
+            continue;
+        }
+        if (r <= 0) {
+            err = r < 0 ? errno : EIO;
+            break;
+        }
+        buf += r, n -= r;
+    }
+    close(fd);
+    return err;
+}
+
+int llgoGetRandom(void *p, size_t n) {
+    uint8_t *buf = p;
+#if defined(__linux__) && defined(SYS_getrandom)
+    while (n > 0) {
+        long r = syscall(SYS_getrandom, buf, n, 0);
+        if (r < 0 && errno == EINTR) {
+            continue;
+        }
+        if (r < 0 && errno == ENOSYS) {
+            return readURandom(buf, n);
+        }
+        if (r <= 0) {
+            return r < 0 ? errno : EIO;
+        }
+        buf += r, n -= r;
+    }
+    return 0;
+#elif defined(__APPLE__)
+    for (; n > 0;) {
+        size_t m = n < 256 ? n : 256; // the limit of getentropy
+        if (getentropy(buf, m) != 0) {
+            return errno;
+        }
+        buf += m, n -= m;
+    }
+    return 0;
+#elif defined(_WIN32)
+    for (; n > 0;) {
+        ULONG m = n < (1u << 30) ? (ULONG)n : 1u << 30;
+        if (BCryptGenRandom(NULL, buf, m, BCRYPT_USE_SYSTEM_PREFERRED_RNG) != 0) {
+            return EIO;
+        }
+        buf += m, n -= m;
+    }
+    return 0;
+#else
+    return readURandom(buf, n);
+#endif
+}
+
+// -----------------------------------------------------------------------------
+
+// ChaCha8Rand is the generator of Go (see https://c2sp.org/chacha8rand and
+// internal/chacha8rand): from a seed of 4 words, a block computes 16 blocks
+// of ChaCha8, four at a time interlaced word by word, which give 32 words.
+// The generator of each thread is seeded from a global one, which is seeded
+// from the system.
+
+typedef uint32_t u32x4 __attribute__((vector_size(16)));
+
+#define ROTL(x, n) ((x) << (n) | (x) >> (32 - (n)))
+
+#define QR(a, b, c, d)               \
+    a += b, d ^= a, d = ROTL(d, 16); \
+    c += d, b ^= c, b = ROTL(b, 12); \
+    a += b, d ^= a, d = ROTL(d, 8);  \
+    c += d, b ^= c, b = ROTL(b, 7)
+
+// llgoChaCha8Block computes the 4 blocks from counter of the seed into buf,
+// like block of internal/chacha8rand.
+void llgoChaCha8Block(const uint64_t seed[4], uint64_t buf[32], uint32_t counter) {
+    u32x4 x[16];
+    x[0] = (u32x4){0, 0, 0, 0} + 0x61707865;
+    x[1] = (u32x4){0, 0, 0, 0} + 0x3320646e;
+    x[2] = (u32x4){0, 0, 0, 0} + 0x79622d32;
+    x[3] = (u32x4){0, 0, 0, 0} + 0x6b206574;
+    for (int i = 0; i < 4; i++) {
+        x[4 + 2 * i] = (u32x4){0, 0, 0, 0} + (uint32_t)seed[i];
+        x[5 + 2 * i] = (u32x4){0, 0, 0, 0} + (uint32_t)(seed[i] >> 32);
+    }
+    x[12] = (u32x4){counter, counter + 1, counter + 2, counter + 3};
+    x[13] = x[14] = x[15] = (u32x4){0, 0, 0, 0};
+
+    u32x4 key[8];
+    memcpy(key, &x[4], sizeof(key));
+    for (int round = 0; round < 4; round++) {
+        QR(x[0], x[4], x[8], x[12]);
+        QR(x[1], x[5], x[9], x[13]);
+        QR(x[2], x[6], x[10], x[14]);
+        QR(x[3], x[7], x[11], x[15]);
+        QR(x[0], x[5], x[10], x[15]);
+        QR(x[1], x[6], x[11], x[12]);
+        QR(x[2], x[7], x[8], x[13]);
+        QR(x[3], x[4], x[9], x[14]);
+    }
+    // like in ChaCha20, the key is added back to avoid trivial
+    // invertibility: the other words have no entropy.
+    for (int i = 0; i < 8; i++) {
+        x[4 + i] += key[i];
+    }
+
+#if __BYTE_ORDER__ == __ORDER_BIG_ENDIAN__
+    // buf holds the words in little-endian order
+    for (int i = 0; i < 16; i++) {
+        x[i] = (u32x4){x[i][1], x[i][0], x[i][3], x[i][2]};
+    }
+#endif
+    memcpy(buf, x, sizeof(x));
+}
+
+enum {
+    ctrInc = 4,  // increment counter by 4 between block calls
+    ctrMax = 16, // reseed when counter reaches 16
+    chunk = 32,  // each chunk produced by block is 32 words
+    reseed = 4,  // reseed with 4 words
+};
+
+typedef struct {
+    uint64_t buf[chunk];
+    uint64_t seed[4];
+    uint32_t i, n, c;
+} chacha8;
+
+static void chacha8Init(chacha8 *s, const uint64_t seed[4]) {
+    memcpy(s->seed, seed, sizeof(s->seed));
+    llgoChaCha8Block(s->seed, s->buf, 0);
+    s->c = 0;
+    s->i = 0;
+    s->n = chunk;
+}
+
+static uint64_t chacha8Next(chacha8 *s) {
+    if (s->i >= s->n) {
+        s->c += ctrInc;
+        if (s->c == ctrMax) {
+            // reseed with generated words for forward secrecy
+            memcpy(s->seed, &s->buf[chunk - reseed], sizeof(s->seed));
+            s->c = 0;
+        }
+        llgoChaCha8Block(s->seed, s->buf, s->c);
+        s->i = 0;
+        s->n = s->c == ctrMax - ctrInc ? chunk - reseed : chunk;
+    }
+    return s->buf[s->i++];
+}
+
+static struct {
+    pthread_mutex_t mu;
+    chacha8 state;
+    int init;
+} globalRand = {PTHREAD_MUTEX_INITIALIZER};
+
+static __thread chacha8 threadRand;
+static __thread int threadRandInit;
+
+// timeSeed stretches the entropy of the time into seed, which should not be
+// needed: the system only fails to give random bytes if it's broken.
+static void timeSeed(uint64_t seed[4]) {
+    struct timespec ts;
+    clock_gettime(CLOCK_REALTIME, &ts);
+    uint64_t v = (uint64_t)ts.tv_sec * 1000000000 + ts.tv_nsec;
+    v ^= (uint64_t)getpid() << 32;
+    for (int i = 0; i < 4; i++) {
+        v ^= 0xa0761d6478bd642f;
+        v *= 0xe7037ed1a0b428db;
+        seed[i] ^= v;
+        v = v >> 32 | v << 32;
+    }
+}
+
+// llgoRand returns a random word from the generator of the thread, like
+// runtime.rand of Go.
+uint64_t llgoRand(void) {
+    if (!threadRandInit) {
+        uint64_t seed[4];
+        pthread_mutex_lock(&globalRand.mu);
+        if (!globalRand.init) {
+            memset(seed, 0, sizeof(seed));
+            if (llgoGetRandom(seed, sizeof(seed)) != 0) {
+                timeSeed(seed);
+            }
+            chacha8Init(&globalRand.state, seed);
+            globalRand.init = 1;
+        }
+        for (int i = 0; i < 4; i++) {
+            seed[i] = chacha8Next(&globalRand.state);
+        }
+        pthread_mutex_unlock(&globalRand.mu);
+        chacha8Init(&threadRand, seed);
+        memset(seed, 0, sizeof(seed));
+        threadRandInit = 1;
+    }
+    return chacha8Next(&threadRand);
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package rand is the random number generation of the runtime: random bytes
+// of the system for crypto/rand, and the ChaCha8Rand generators of Go for
+// runtime.rand and math/rand/v2.
+package rand
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+const (
+	LLGoFiles   = "_rand/rand.c"
+	LLGoPackage = "link"
+)
+
+// -----------------------------------------------------------------------------
+
+// GetRandom fills buf with n random bytes of the system, and returns 0 or an
+// error number: it uses getrandom on Linux, getentropy on Apple systems and
+// BCryptGenRandom on Windows.
+//
+//go:linkname GetRandom C.llgoGetRandom
+func GetRandom(buf c.Pointer, n uintptr) c.Int
+
+// Uint64 returns a random word from the ChaCha8Rand generator of the calling
+// thread, seeded from GetRandom.
+//
+//go:linkname Uint64 C.llgoRand
+func Uint64() uint64
+
+// ChaCha8Block computes the 4 ChaCha8 blocks from counter of seed into buf,
+// interlaced like by the block function of internal/chacha8rand.
+//
+//go:linkname ChaCha8Block C.llgoChaCha8Block
+func ChaCha8Block(seed *[4]uint64, buf *[32]uint64, counter uint32)
+
+// -----------------------------------------------------------------------------
@@ -8,29 +8,36 @@ import (
 	"unsafe"
 
 	"github.com/goplus/llgo/c/sync/atomic"
-	"github.com/goplus/llgo/c/time"
 	"github.com/goplus/llgo/internal/runtime/math"
+	"github.com/goplus/llgo/internal/runtime/rand"
 )
 
-//go:linkname fastrand C.rand
-func fastrand() uint32
+// fastrandState is the state of fastrand and fastrand64, a wyrand generator
+// shared by all threads: each call advances it atomically.
+var fastrandState uint64
 
-//go:linkname srand C.srand
-func srand(uint32)
+func fastrand() uint32 {
+	return uint32(fastrand64())
+}
 
 func fastrand64() uint64 {
-	n := uint64(fastrand())
-	n += 0xa0761d6478bd642f
+	// Implement wyrand: https://github.com/wangyi-fudan/wyhash
+	const inc = 0xa0761d6478bd642f
+	n := atomic.Add(&fastrandState, inc) + inc
 	hi, lo := math.Mul64(n, n^0xe7037ed1a0b428db)
 	return hi ^ lo
 }
 
+// fastrandinit seeds fastrand from the generator of the runtime, itself
+// seeded from the system, so that map iteration orders and hash seeds differ
+// from run to run.
+func fastrandinit() {
+	fastrandState = rand.Uint64()
+}
+
 func init() {
-	srand(uint32(time.Time(nil)))
-	hashkey[0] = uintptr(fastrand()) | 1
-	hashkey[1] = uintptr(fastrand()) | 1
-	hashkey[2] = uintptr(fastrand()) | 1
-	hashkey[3] = uintptr(fastrand()) | 1
+	fastrandinit()
+	alginit()
 }
 
 /* TODO(xsw):
@@ -0,0 +1,324 @@
+#define _GNU_SOURCE
+#include <errno.h>
+#include <fcntl.h>
+#include <pthread.h>
+#include <stdint.h>
+#include <stdlib.h>
+#include <time.h>
+#include <unistd.h>
+#if defined(__linux__)
+#include <sys/epoll.h>
+#elif defined(__APPLE__)
+#include <sys/event.h>
+#endif
+
+// -----------------------------------------------------------------------------
+
+// The network poller waits for the descriptors of internal/poll to be ready,
+// on a thread of its own blocked in epoll_wait or kevent: they are registered
+// edge-triggered, for reads and writes, once. The thread marks a descriptor
+// ready and wakes the goroutines waiting for it, on its condition variable.
+// Deadlines are absolute times of the monotonic clock, and goroutines wait
+// until them at most.
+//
+// Poll descriptors are never freed, but reused, so that the thread may still
+// mark one whose descriptor was just closed: it only wakes goroutines which
+// find their descriptor not ready yet, and wait again.
+
+#define POLL_NO_ERROR 0    // like pollNoError of internal/poll
+#define POLL_ERR_CLOSING 1 // pollErrClosing
+#define POLL_ERR_TIMEOUT 2 // pollErrTimeout
+
+#define POLL_EVENTS 128
+
+typedef struct pollDesc {
+    struct pollDesc *next; // in the free list
+    pthread_mutex_t mu;
+    pthread_cond_t cond;
+    int fd;
+    int closing;
+    int rready, wready;
+    int64_t rd, wd; // deadlines: 0 if none, -1 if expired
+} pollDesc;
+
+static pthread_mutex_t pollMu = PTHREAD_MUTEX_INITIALIZER;
+static pollDesc *pollFree;
+static int pollFd = -1; // the epoll or kqueue descriptor
+
+static int64_t pollNow(void) {
+    struct timespec ts;
+    clock_gettime(CLOCK_MONOTONIC, &ts);
+    return (int64_t)ts.tv_sec * 1000000000 + ts.tv_nsec;
+}
+
+static void pollReady(pollDesc *pd, int r, int w) {
+    pthread_mutex_lock(&pd->mu);
+    pd->rready |= r;
+    pd->wready |= w;
+    pthread_cond_broadcast(&pd->cond);
+    pthread_mutex_unlock(&pd->mu);
+}
+
+#if defined(__linux__)
+
+static int pollCreate(void) {
+    return epoll_create1(EPOLL_CLOEXEC);
+}
+
+static int pollAdd(pollDesc *pd) {
+    struct epoll_event ev;
+    ev.events = EPOLLIN | EPOLLOUT | EPOLLRDHUP | EPOLLET;
+    ev.data.ptr = pd;
+    return epoll_ctl(pollFd, EPOLL_CTL_ADD, pd->fd, &ev) == 0 ? 0 : errno;
+}
+
+static void pollDel(pollDesc *pd) {
+    struct epoll_event ev; // not NULL for kernels before 2.6.9
+    epoll_ctl(pollFd, EPOLL_CTL_DEL, pd->fd, &ev);
+}
+
+static void *pollLoop(void *arg) {
+    struct epoll_event evs[POLL_EVENTS];
+    for (;;) {
+        int n = epoll_wait(pollFd, evs, POLL_EVENTS, -1);
+        for (int i = 0; i < n; i++) {
+            uint32_t e = evs[i].events;
+            int r = (e & (EPOLLIN | EPOLLRDHUP | EPOLLHUP | EPOLLERR)) != 0;
+            int w = (e & (EPOLLOUT | EPOLLHUP | EPOLLERR)) != 0;
+            pollReady(evs[i].data.ptr, r, w);
+        }
+    }
+    return NULL;
+}
+
+#elif defined(__APPLE__)
+
+static int pollCreate(void) {
+    int fd = kqueue();
+    if (fd >= 0) {
+        fcntl(fd, F_SETFD, FD_CLOEXEC);
+    }
+    return fd;
+}
+
+static int pollAdd(pollDesc *pd) {
+    struct kevent ev[2];
+    EV_SET(&ev[0], pd->fd, EVFILT_READ, EV_ADD | EV_CLEAR, 0, 0, pd);
+    EV_SET(&ev[1], pd->fd, EVFILT_WRITE, EV_ADD | EV_CLEAR, 0, 0, pd);
+    return kevent(pollFd, ev, 2, NULL, 0, NULL) == 0 ? 0 : errno;
+}
+
+static void pollDel(pollDesc *pd) {
+    // closing the descriptor removes its events
+}
+
+static void *pollLoop(void *arg) {
+    struct kevent evs[POLL_EVENTS];
+    for (;;) {
+        int n = kevent(pollFd, NULL, 0, evs, POLL_EVENTS, NULL);
+        for (int i = 0; i < n; i++) {
+            int r = evs[i].filter == EVFILT_READ || (evs[i].flags & EV_ERROR);
+            int w = evs[i].filter == EVFILT_WRITE || (evs[i].flags & EV_ERROR);
+            pollReady(evs[i].udata, r, w);
+        }
+    }
+    return NULL;
+}
+
+#else
+
+static int pollCreate(void) {
+    errno = ENOSYS;
+    return -1;
+}
+
+static int pollAdd(pollDesc *pd) {
+    return ENOSYS;
+}
+
+static void pollDel(pollDesc *pd) {
+}
+
+static void *pollLoop(void *arg) {
+    return NULL;
+}
+
+#endif
+
+// llgoNetpollInit starts the poller thread. It's called once.
+void llgoNetpollInit(void) {
+    pthread_mutex_lock(&pollMu);
+    if (pollFd < 0 && (pollFd = pollCreate()) >= 0) {
+        pthread_t th;
+        pthread_attr_t attr;
+        pthread_attr_init(&attr);
+        pthread_attr_setdetachstate(&attr, PTHREAD_CREATE_DETACHED);
+        if (pthread_create(&th, &attr, pollLoop, NULL) != 0) {
+            close(pollFd);
+            pollFd = -1;
+        }
+        pthread_attr_destroy(&attr);
+    }
+    pthread_mutex_unlock(&pollMu);
+}
+
+// llgoNetpollOpen registers fd, and stores its poll descriptor into *pdp. It
+// returns an errno, like EPERM for regular files, which can't be polled.
+int llgoNetpollOpen(int fd, void **pdp) {
+    if (pollFd < 0) {
+        return ENOSYS;
+    }
+    pthread_mutex_lock(&pollMu);
+    pollDesc *pd = pollFree;
+    if (pd != NULL) {
+        pollFree = pd->next;
+    }
+    pthread_mutex_unlock(&pollMu);
+    if (pd == NULL) {
+        if ((pd = calloc(1, sizeof(pollDesc))) == NULL) {
+            return ENOMEM;
+        }
+        pthread_mutex_init(&pd->mu, NULL);
+#if !defined(__APPLE__)
+        pthread_condattr_t attr;
+        pthread_condattr_init(&attr);
+        pthread_condattr_setclock(&attr, CLOCK_MONOTONIC);
+        pthread_cond_init(&pd->cond, &attr);
+        pthread_condattr_destroy(&attr);
+#else
+        pthread_cond_init(&pd->cond, NULL);
+#endif
+    }
+    pthread_mutex_lock(&pd->mu);
+    pd->fd = fd;
+    pd->closing = 0;
+    pd->rready = pd->wready = 0;
+    pd->rd = pd->wd = 0;
+    pthread_mutex_unlock(&pd->mu);
+    int err = pollAdd(pd);
+    if (err != 0) {
+        pthread_mutex_lock(&pollMu);
+        pd->next = pollFree;
+        pollFree = pd;
+        pthread_mutex_unlock(&pollMu);
+        return err;
+    }
+    *pdp = pd;
+    return 0;
+}
+
+// llgoNetpollClose unregisters the descriptor of pd, which must be unblocked,
+// and frees pd for reuse.
+void llgoNetpollClose(void *p) {
+    pollDesc *pd = p;
+    pollDel(pd);
+    pthread_mutex_lock(&pollMu);
+    pd->next = pollFree;
+    pollFree = pd;
+    pthread_mutex_unlock(&pollMu);
+}
+
+// pollCheck returns the error of waiting for pd in mode, 'r' or 'w'. pd->mu
+// must be held.
+static int pollCheck(pollDesc *pd, int mode) {
+    if (pd->closing) {
+        return POLL_ERR_CLOSING;
+    }
+    int64_t d = mode == 'r' ? pd->rd : pd->wd;
+    if (d < 0 || (d > 0 && pollNow() >= d)) {
+        return POLL_ERR_TIMEOUT;
+    }
+    return POLL_NO_ERROR;
+}
+
+// llgoNetpollReset prepares pd for a read or a write, in mode 'r' or 'w',
+// which may have to wait.
+int llgoNetpollReset(void *p, int mode) {
+    pollDesc *pd = p;
+    pthread_mutex_lock(&pd->mu);
+    int err = pollCheck(pd, mode);
+    if (err == POLL_NO_ERROR) {
+        if (mode == 'r') {
+            pd->rready = 0;
+        } else {
+            pd->wready = 0;
+        }
+    }
+    pthread_mutex_unlock(&pd->mu);
+    return err;
+}
+
+// llgoNetpollWait waits until pd is ready for mode, 'r' or 'w', its deadline
+// passed, or it's unblocked.
+int llgoNetpollWait(void *p, int mode) {
+    pollDesc *pd = p;
+    pthread_mutex_lock(&pd->mu);
+    int err;
+    for (;;) {
+        if ((err = pollCheck(pd, mode)) != POLL_NO_ERROR) {
+            break;
+        }
+        int *ready = mode == 'r' ? &pd->rready : &pd->wready;
+        if (*ready) {
+            *ready = 0;
+            break;
+        }
+        int64_t d = mode == 'r' ? pd->rd : pd->wd;
+        if (d == 0) {
+            pthread_cond_wait(&pd->cond, &pd->mu);
+            continue;
+        }
+        struct timespec ts;
+#if defined(__APPLE__)
+        int64_t rel = d - pollNow();
+        ts.tv_sec = rel / 1000000000;
+        ts.tv_nsec = rel % 1000000000;
+        pthread_cond_timedwait_relative_np(&pd->cond, &pd->mu, &ts);
+#else
+        ts.tv_sec = d / 1000000000;
+        ts.tv_nsec = d % 1000000000;
+        pthread_cond_timedwait(&pd->cond, &pd->mu, &ts);
+#endif
+    }
+    pthread_mutex_unlock(&pd->mu);
+    return err;
+}
+
+// llgoNetpollSetDeadline sets the deadline of pd for mode, 'r', 'w' or both,
+// to d nanoseconds from now: none if d is 0, and expired if it's negative.
+void llgoNetpollSetDeadline(void *p, int64_t d, int mode) {
+    pollDesc *pd = p;
+    if (d > 0) {
+        d += pollNow();
+        if (d <= 0) { // overflow
+            d = INT64_MAX;
+        }
+    } else if (d < 0) {
+        d = -1;
+    }
+    pthread_mutex_lock(&pd->mu);
+    if (mode == 'r' || mode == 'r' + 'w') {
+        pd->rd = d;
+    }
+    if (mode == 'w' || mode == 'r' + 'w') {
+        pd->wd = d;
+    }
+    pthread_cond_broadcast(&pd->cond); // waiters wait until the new deadlines
+    pthread_mutex_unlock(&pd->mu);
+}
+
+// llgoNetpollUnblock makes the waits for pd fail with POLL_ERR_CLOSING.
+void llgoNetpollUnblock(void *p) {
+    pollDesc *pd = p;
+    pthread_mutex_lock(&pd->mu);
+    pd->closing = 1;
+    pthread_cond_broadcast(&pd->cond);
+    pthread_mutex_unlock(&pd->mu);
+}
+
+// llgoIsNetpollDescriptor reports whether fd is used by the poller itself.
+int llgoIsNetpollDescriptor(int fd) {
+    return fd >= 0 && fd == pollFd;
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,272 @@
+#define _GNU_SOURCE
+#include <errno.h>
+#include <fcntl.h>
+#include <pthread.h>
+#include <sched.h>
+#include <signal.h>
+#include <stdatomic.h>
+#include <stdint.h>
+#include <string.h>
+#include <unistd.h>
+
+// -----------------------------------------------------------------------------
+
+// Signals notified to Go (see os/signal) are caught by a handler which only
+// records them in a mask and wakes the goroutine receiving them, by writing
+// to a pipe: both are async-signal-safe. The actions set before, like by C
+// libraries, are saved when a signal is first notified or ignored, and are
+// restored when Go stops wanting it. SIGSEGV and SIGBUS stay handled by
+// onFault, which delivers those sent by kill. SIGPROF is the profiler's, and
+// the signals stopping the world for the collector are the collector's.
+
+#define NUM_SIG 65 // like numSig of os/signal
+#define SIG_WORDS ((NUM_SIG + 31) / 32)
+
+static atomic_uint sigWanted[SIG_WORDS];  // notified to Go
+static atomic_uint sigIgnored[SIG_WORDS]; // ignored by signal.Ignore
+static atomic_uint sigPending[SIG_WORDS]; // caught, not received yet
+static atomic_int sigDelivering;          // handlers running
+static atomic_int sigReceiving;           // 1 while llgoSignalRecv waits
+
+static pthread_mutex_t sigMu = PTHREAD_MUTEX_INITIALIZER;
+static struct sigaction sigSaved[NUM_SIG]; // actions before Go changed them
+static unsigned char sigChanged[NUM_SIG];  // 1 if the action is Go's
+static int sigPipe[2] = {-1, -1};
+
+static inline int sigBit(atomic_uint *mask, int sig) {
+    return (atomic_load(&mask[sig / 32]) >> (sig % 32)) & 1;
+}
+
+static inline void sigSet(atomic_uint *mask, int sig, int on) {
+    if (on) {
+        atomic_fetch_or(&mask[sig / 32], 1u << (sig % 32));
+    } else {
+        atomic_fetch_and(&mask[sig / 32], ~(1u << (sig % 32)));
+    }
+}
+
+static int isReserved(int sig) {
+    switch (sig) {
+    case SIGPROF:
+#if defined(__linux__)
+    case SIGPWR:  // suspends threads for the collector
+    case SIGXCPU: // resumes them
+#endif
+        return 1;
+    }
+    return sig <= 0 || sig >= NUM_SIG || sig == SIGKILL || sig == SIGSTOP;
+}
+
+static int isFault(int sig) {
+    return sig == SIGSEGV || sig == SIGBUS;
+}
+
+// llgoSignalNotify delivers sig to Go if it's notified, and reports whether
+// it's notified or ignored. It's async-signal-safe.
+int llgoSignalNotify(int sig) {
+    if (sig <= 0 || sig >= NUM_SIG) {
+        return 0;
+    }
+    if (sigBit(sigIgnored, sig)) {
+        return 1;
+    }
+    if (!sigBit(sigWanted, sig)) {
+        return 0;
+    }
+    sigSet(sigPending, sig, 1);
+    char b = 0;
+    (void)!write(sigPipe[1], &b, 1); // the pipe is full only if a wakeup is pending
+    return 1;
+}
+
+static void onSignal(int sig, siginfo_t *info, void *ctx) {
+    int saved = errno;
+    atomic_fetch_add(&sigDelivering, 1);
+    if (!llgoSignalNotify(sig)) {
+        // raced with llgoSignalDisable: forward to the action before Go's
+        struct sigaction *sa = &sigSaved[sig];
+        if (sa->sa_flags & SA_SIGINFO) {
+            sa->sa_sigaction(sig, info, ctx);
+        } else if (sa->sa_handler == SIG_DFL) {
+            sigaction(sig, sa, NULL);
+            raise(sig); // delivered once the handler returns
+        } else if (sa->sa_handler != SIG_IGN) {
+            sa->sa_handler(sig);
+        }
+    }
+    atomic_fetch_sub(&sigDelivering, 1);
+    errno = saved;
+}
+
+// setAction sets the action of sig to handler, saving the action before Go
+// changed it. sigMu must be held.
+static void setAction(int sig, void (*handler)(int, siginfo_t *, void *), int ignore) {
+    struct sigaction sa;
+    memset(&sa, 0, sizeof(sa));
+    sigfillset(&sa.sa_mask);
+    if (ignore) {
+        sa.sa_handler = SIG_IGN;
+    } else {
+        sa.sa_sigaction = handler;
+        sa.sa_flags = SA_SIGINFO | SA_RESTART | SA_ONSTACK;
+    }
+    if (sigaction(sig, &sa, sigChanged[sig] ? NULL : &sigSaved[sig]) == 0) {
+        sigChanged[sig] = 1;
+    }
+}
+
+static void restoreAction(int sig) {
+    if (sigChanged[sig]) {
+        sigaction(sig, &sigSaved[sig], NULL);
+        sigChanged[sig] = 0;
+    }
+}
+
+static void initPipe(void) {
+    if (sigPipe[0] >= 0 || pipe(sigPipe) != 0) {
+        return;
+    }
+    for (int i = 0; i < 2; i++) {
+        fcntl(sigPipe[i], F_SETFD, FD_CLOEXEC);
+    }
+    fcntl(sigPipe[1], F_SETFL, fcntl(sigPipe[1], F_GETFL) | O_NONBLOCK);
+}
+
+// llgoSignalEnable starts delivering sig to llgoSignalRecv.
+void llgoSignalEnable(uint32_t sig) {
+    if (isReserved(sig)) {
+        return;
+    }
+    pthread_mutex_lock(&sigMu);
+    initPipe();
+    sigSet(sigIgnored, sig, 0);
+    sigSet(sigWanted, sig, 1);
+    if (!isFault(sig)) {
+        setAction(sig, onSignal, 0);
+    }
+    pthread_mutex_unlock(&sigMu);
+}
+
+// llgoSignalDisable stops delivering sig, and restores its action before Go,
+// unless it's ignored: like in Go, only llgoSignalEnable undoes
+// llgoSignalIgnore.
+void llgoSignalDisable(uint32_t sig) {
+    if (isReserved(sig)) {
+        return;
+    }
+    pthread_mutex_lock(&sigMu);
+    sigSet(sigWanted, sig, 0);
+    if (!isFault(sig) && !sigBit(sigIgnored, sig)) {
+        restoreAction(sig);
+    }
+    pthread_mutex_unlock(&sigMu);
+}
+
+// llgoSignalIgnore stops delivering sig, and ignores it.
+void llgoSignalIgnore(uint32_t sig) {
+    if (isReserved(sig)) {
+        return;
+    }
+    pthread_mutex_lock(&sigMu);
+    sigSet(sigWanted, sig, 0);
+    sigSet(sigIgnored, sig, 1);
+    if (!isFault(sig)) {
+        setAction(sig, NULL, 1);
+    }
+    pthread_mutex_unlock(&sigMu);
+}
+
+// llgoSignalIgnored reports whether sig is ignored, by llgoSignalIgnore or
+// since the program started, like SIGHUP under nohup.
+int llgoSignalIgnored(uint32_t sig) {
+    if (sig == 0 || sig >= NUM_SIG) {
+        return 0;
+    }
+    if (sigBit(sigIgnored, sig)) {
+        return 1;
+    }
+    if (sigBit(sigWanted, sig)) {
+        return 0;
+    }
+    struct sigaction sa;
+    return sigaction(sig, NULL, &sa) == 0 && !(sa.sa_flags & SA_SIGINFO) && sa.sa_handler == SIG_IGN;
+}
+
+// llgoSignalRecv waits for a notified signal to be caught, and returns it.
+// There must be a single receiver.
+uint32_t llgoSignalRecv(void) {
+    for (;;) {
+        for (int i = 0; i < SIG_WORDS; i++) {
+            unsigned m = atomic_load(&sigPending[i]);
+            while (m != 0) {
+                unsigned bit = m & -m;
+                if (atomic_compare_exchange_weak(&sigPending[i], &m, m & ~bit)) {
+                    return i * 32 + __builtin_ctz(bit);
+                }
+            }
+        }
+        // a signal caught after the scan writes to the pipe after its bit
+        atomic_store(&sigReceiving, 1);
+        char buf[16];
+        while (read(sigPipe[0], buf, sizeof(buf)) < 0 && errno == EINTR) {
+        }
+        atomic_store(&sigReceiving, 0);
+    }
+}
+
+static int sigPendingAny(void) {
+    for (int i = 0; i < SIG_WORDS; i++) {
+        if (atomic_load(&sigPending[i]) != 0) {
+            return 1;
+        }
+    }
+    return 0;
+}
+
+// llgoSignalWaitIdle waits until the signals being caught are received, and
+// the receiver waits for more: then no signal disabled before is delivered
+// to Go anymore.
+void llgoSignalWaitIdle(void) {
+    while (atomic_load(&sigDelivering) != 0 || sigPendingAny() || !atomic_load(&sigReceiving)) {
+        sched_yield();
+    }
+}
+
+// Writes to a pipe or a socket closed by its reader fail with EPIPE, which
+// the write to stdout or stderr turns into SIGPIPE by llgoSigpipe, like in
+// Go: a handler is set for it, which does nothing, unless it was ignored when
+// the program started. Unlike SIG_IGN, it's reset by exec.
+
+static void onSigpipe(int sig) {
+}
+
+__attribute__((constructor))
+static void sigpipeInit(void) {
+    struct sigaction sa;
+    if (sigaction(SIGPIPE, NULL, &sa) != 0 || (sa.sa_flags & SA_SIGINFO) || sa.sa_handler != SIG_DFL) {
+        return;
+    }
+    memset(&sa, 0, sizeof(sa));
+    sa.sa_handler = onSigpipe;
+    sa.sa_flags = SA_RESTART;
+    sigaction(SIGPIPE, &sa, NULL);
+}
+
+// llgoSigpipe kills the process by SIGPIPE, unless it's notified to Go or
+// ignored.
+void llgoSigpipe(void) {
+    if (llgoSignalNotify(SIGPIPE) || llgoSignalIgnored(SIGPIPE)) {
+        return;
+    }
+    struct sigaction sa;
+    memset(&sa, 0, sizeof(sa));
+    sa.sa_handler = SIG_DFL;
+    sigaction(SIGPIPE, &sa, NULL);
+    sigset_t set;
+    sigemptyset(&set);
+    sigaddset(&set, SIGPIPE);
+    pthread_sigmask(SIG_UNBLOCK, &set, NULL);
+    raise(SIGPIPE);
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,1456 @@
+#define _GNU_SOURCE
+#include <pthread.h>
+#include <setjmp.h>
+#include <signal.h>
+#include <stdatomic.h>
+#include <stdint.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include <sys/mman.h>
+#include <time.h>
+#include <unistd.h>
+#if defined(__APPLE__) || defined(__GLIBC__)
+#include <execinfo.h>
+#define HAVE_BACKTRACE 1
+#endif
+#if defined(__linux__)
+#include <fcntl.h>
+#include <sys/syscall.h>
+#endif
+#if defined(__APPLE__)
+#include <sys/ucontext.h>
+#else
+#include <ucontext.h>
+#endif
+
+// -----------------------------------------------------------------------------
+
+#define GUARD_SIZE (64 << 10)
+#define STACK_GUARD_GAP_PAGES 256 // the default stack_guard_gap of Linux
+#define ALTSTACK_SIZE (64 << 10)
+
+// LLGO_ELIMIT is returned by llgoThreadCreate when the goroutine limit set by
+// llgoSetMaxGoroutines is reached. It must match thread.ErrLimit.
+#define LLGO_ELIMIT (-1)
+
+// maxStack is the stack limit of goroutines, 1GB on 64-bit systems and 250MB
+// on 32-bit ones like in Go.
+#define DEFAULT_MAX_STACK (sizeof(void *) == 8 ? 1000000000L : 250000000L)
+
+static atomic_long maxThreads = 10000;
+static atomic_long nThreads = 1;
+static atomic_long maxStack = DEFAULT_MAX_STACK;
+static atomic_long maxGoroutines = 0; // 0 means unlimited, see llgoSetMaxGoroutines
+
+static __thread uintptr_t stackLo;    // lowest address of the stack of the thread
+static __thread uintptr_t stackHi;    // highest address of the stack of the thread
+static __thread uintptr_t stackLimit; // lowest address allowed by maxStack, with a guard below
+static __thread uintptr_t stackGuard; // guard mapped below stackLimit, if not in the stack
+
+static void writeStr(const char *s) {
+    write(2, s, strlen(s));
+}
+
+static void writeUint(unsigned long v) {
+    char buf[24];
+    char *p = buf + sizeof(buf);
+    *--p = 0;
+    do {
+        *--p = '0' + v % 10;
+        v /= 10;
+    } while (v);
+    writeStr(p);
+}
+
+// llgoSigpanic turns a fault of the current thread into a run-time panic or
+// a fatal error (see runtime.sigpanic). It doesn't return.
+extern void llgoSigpanic(int bus, int code, uintptr_t addr, uintptr_t pc, int isNil) __attribute__((weak));
+
+// llgoSignalNotify delivers sig to Go if it's notified (see signal.c).
+int llgoSignalNotify(int sig);
+
+static uintptr_t contextPC(void *ctx) {
+    ucontext_t *uc = ctx;
+#if defined(__APPLE__) && defined(__x86_64__)
+    return uc->uc_mcontext->__ss.__rip;
+#elif defined(__APPLE__) && defined(__aarch64__)
+    return uc->uc_mcontext->__ss.__pc;
+#elif defined(__linux__) && defined(__x86_64__)
+    return uc->uc_mcontext.gregs[REG_RIP];
+#elif defined(__linux__) && defined(__aarch64__)
+    return uc->uc_mcontext.pc;
+#else
+    (void)uc;
+    return 0;
+#endif
+}
+
+// onFault handles SIGSEGV and SIGBUS. A fault is a stack overflow, or else
+// turns into a panic like in Go, with the signal unblocked (SA_NODEFER) as
+// the panic leaves the handler by siglongjmp. The signals sent by kill are
+// delivered to os/signal if notified, or take their default action.
+static void onFault(int sig, siginfo_t *info, void *ctx) {
+    uintptr_t addr = (uintptr_t)info->si_addr;
+    if (info->si_code <= 0) { // SI_USER, SI_QUEUE, ...: not a fault
+        if (!llgoSignalNotify(sig)) {
+            signal(sig, SIG_DFL);
+            raise(sig);
+        }
+        return;
+    }
+    uintptr_t lo = stackLimit != 0 ? stackLimit : stackLo;
+    uintptr_t gap = stackGuard != 0 ? STACK_GUARD_GAP_PAGES * (uintptr_t)sysconf(_SC_PAGESIZE) : 0;
+    if (lo != 0 && addr < lo + gap + GUARD_SIZE && addr + GUARD_SIZE >= lo) {
+        writeStr("runtime: goroutine stack exceeds ");
+        writeUint(stackHi - lo);
+        writeStr("-byte limit\nfatal error: stack overflow\n");
+        _exit(2);
+    }
+    if (llgoSigpanic != NULL) {
+        int bus = sig == SIGBUS, code = info->si_code;
+        int isNil = addr < 0x1000 && (bus ? code == BUS_ADRERR : (code == SEGV_MAPERR || code == SEGV_ACCERR));
+        llgoSigpanic(bus, code, addr, contextPC(ctx), isNil);
+    }
+    // no runtime: restore the default action and fault again.
+    signal(sig, SIG_DFL);
+}
+
+static void initStackBounds(void) {
+#if defined(__APPLE__)
+    pthread_t self = pthread_self();
+    stackHi = (uintptr_t)pthread_get_stackaddr_np(self);
+    stackLo = stackHi - pthread_get_stacksize_np(self);
+#elif defined(__linux__)
+    pthread_attr_t attr;
+    void *addr;
+    size_t size;
+    if (pthread_getattr_np(pthread_self(), &attr) == 0) {
+        if (pthread_attr_getstack(&attr, &addr, &size) == 0) {
+            stackLo = (uintptr_t)addr;
+            stackHi = stackLo + size;
+        }
+        pthread_attr_destroy(&attr);
+    }
+#endif
+}
+
+// clearStackLimit removes the guard pages of setStackLimit, if any.
+static void clearStackLimit(void) {
+    if (stackLimit != 0) {
+        if (stackGuard != 0) {
+            munmap((void *)stackGuard, GUARD_SIZE);
+        } else {
+            mprotect((void *)(stackLimit - GUARD_SIZE), GUARD_SIZE, PROT_READ | PROT_WRITE);
+        }
+        stackLimit = 0;
+        stackGuard = 0;
+    }
+}
+
+// setStackLimit enforces maxStack on the stack of the current thread, if it's
+// larger: the guard pages below the limit make an overflow fault, which
+// onFault reports. The stack keeps its size, so the limit can be raised.
+static void setStackLimit(void) {
+    clearStackLimit();
+    long max = atomic_load(&maxStack);
+    long page = sysconf(_SC_PAGESIZE);
+    if (stackLo == 0 || max <= 0 || (uintptr_t)max >= stackHi - stackLo) {
+        return;
+    }
+    uintptr_t limit = (stackHi - (uintptr_t)max + page - 1) & ~(uintptr_t)(page - 1);
+    if (limit - GUARD_SIZE < stackLo + GUARD_SIZE || (uintptr_t)&limit < limit) {
+        return; // no room for the guard, or the limit is exceeded already
+    }
+    if (mprotect((void *)(limit - GUARD_SIZE), GUARD_SIZE, PROT_NONE) == 0) {
+        stackLimit = limit;
+        return;
+    }
+    // The stack of the main thread is mapped as it grows: the guard is mapped
+    // below the limit. Older kernels keep a gap between the stack and it.
+    void *want = (void *)(limit - GUARD_SIZE);
+    int flags = MAP_PRIVATE | MAP_ANONYMOUS | MAP_NORESERVE;
+#if defined(MAP_FIXED_NOREPLACE)
+    flags |= MAP_FIXED_NOREPLACE;
+#endif
+    void *p = mmap(want, GUARD_SIZE, PROT_NONE, flags, -1, 0);
+    if (p == MAP_FAILED) {
+        return;
+    }
+    if (p != want) {
+        munmap(p, GUARD_SIZE);
+        return;
+    }
+    stackLimit = limit;
+    stackGuard = (uintptr_t)p;
+}
+
+// initThread sets up the alternate signal stack of the current thread, on
+// which onFault runs when the thread stack overflows. It's large enough for
+// an uncaught panic to print the stack of the goroutine.
+static void *initThread(void) {
+    stack_t ss;
+    size_t size = SIGSTKSZ < ALTSTACK_SIZE ? ALTSTACK_SIZE : SIGSTKSZ;
+    ss.ss_sp = malloc(size);
+    if (ss.ss_sp == NULL) {
+        return NULL;
+    }
+    ss.ss_size = size;
+    ss.ss_flags = 0;
+    if (sigaltstack(&ss, NULL) != 0) {
+        free(ss.ss_sp);
+        return NULL;
+    }
+    initStackBounds();
+    return ss.ss_sp;
+}
+
+static void exitThread(void *altstack) {
+    if (altstack != NULL) {
+        stack_t ss;
+        memset(&ss, 0, sizeof(ss));
+        ss.ss_flags = SS_DISABLE;
+        sigaltstack(&ss, NULL);
+        free(altstack);
+    }
+}
+
+__attribute__((constructor))
+static void llgoStackGuardInit(void) {
+    struct sigaction sa;
+    memset(&sa, 0, sizeof(sa));
+    sa.sa_sigaction = onFault;
+    sa.sa_flags = SA_SIGINFO | SA_ONSTACK | SA_NODEFER;
+    sigemptyset(&sa.sa_mask);
+    sigaction(SIGSEGV, &sa, NULL);
+    sigaction(SIGBUS, &sa, NULL);
+    initThread();
+    setStackLimit();
+
+    const char *max = getenv("LLGO_MAXGOROUTINES");
+    if (max != NULL) {
+        atomic_store(&maxGoroutines, atol(max));
+    }
+}
+
+// -----------------------------------------------------------------------------
+
+// llgoSchedRecord must match thread.SchedRecord.
+typedef struct {
+    long goid;
+    long startDelay; // ns from the go statement until the goroutine runs
+    long runqWait;   // ns spent runnable but waiting for a CPU
+    long runTime;    // ns spent running on a CPU
+    long running;    // 1 if the goroutine has not exited
+} llgoSchedRecord;
+
+typedef struct goroutine {
+    void *(*routine)(void *);
+    void *arg;
+    long goid;
+    long created;
+    long startDelay;
+    long tid;
+    long locked;    // calls of llgoLockOSThread not undone
+    pthread_t th;   // thread running the goroutine, once started
+    int asleep;     // 1 if blocked until another goroutine unblocks it
+    int waitReason; // block reason of llgoTraceBlock, if asleep
+    struct goroutine *prev;
+    struct goroutine *next;
+    struct {
+        long gen;   // trace the state is of, stale if not the current one
+        long seq;   // sequence number of the next start or unblock event
+        int status; // traceRunnable, traceRunning or traceWaiting
+        int pid;    // P the goroutine runs on, 0 if none yet
+    } trace;
+} goroutine;
+
+static __thread goroutine *curG; // goroutine of the thread
+
+static void traceGoCreate(goroutine *g);
+static void traceGoEnd(goroutine *g);
+void llgoTraceResume(void);
+static void deadBlock(int reason);
+static void deadResume(void);
+static void checkDead(void);
+
+#define SCHED_HISTORY 1024
+
+static pthread_mutex_t schedMu = PTHREAD_MUTEX_INITIALIZER;
+static goroutine mainG = {.goid = 1, .prev = &mainG, .next = &mainG}; // list of running goroutines
+static llgoSchedRecord schedHistory[SCHED_HISTORY];                 // exited goroutines
+static long schedExited;
+static uint64_t schedLatencies[65]; // start delays, by bit length in ns
+static atomic_long nextGoid = 2;
+
+__attribute__((constructor))
+static void schedInit(void) {
+    curG = &mainG; // constructors run on the main thread
+    mainG.th = pthread_self();
+}
+
+static long nanotime(void) {
+    struct timespec ts;
+    clock_gettime(CLOCK_MONOTONIC, &ts);
+    return (long)ts.tv_sec * 1000000000 + ts.tv_nsec;
+}
+
+static long threadID(void) {
+#if defined(__linux__)
+    return (long)syscall(SYS_gettid);
+#else
+    return 0;
+#endif
+}
+
+// readSchedStat reads the CPU time and run-queue wait time of a thread from
+// /proc/self/task/<tid>/schedstat. They are zero if the kernel doesn't
+// provide them.
+static void readSchedStat(long tid, llgoSchedRecord *rec) {
+    rec->runTime = 0;
+    rec->runqWait = 0;
+#if defined(__linux__)
+    char path[64];
+    char buf[128];
+    snprintf(path, sizeof(path), "/proc/self/task/%ld/schedstat", tid);
+    int fd = open(path, O_RDONLY);
+    if (fd < 0) {
+        return;
+    }
+    ssize_t n = read(fd, buf, sizeof(buf) - 1);
+    close(fd);
+    if (n > 0) {
+        unsigned long long run, wait;
+        buf[n] = 0;
+        if (sscanf(buf, "%llu %llu", &run, &wait) == 2) {
+            rec->runTime = (long)run;
+            rec->runqWait = (long)wait;
+        }
+    }
+#endif
+}
+
+static void schedRecord(goroutine *g, llgoSchedRecord *rec) {
+    rec->goid = g->goid;
+    if (g == &mainG) {
+        rec->startDelay = 0;
+        readSchedStat((long)getpid(), rec);
+    } else if (g->tid == 0) { // not started yet
+        rec->startDelay = nanotime() - g->created;
+        rec->runqWait = 0;
+        rec->runTime = 0;
+    } else {
+        rec->startDelay = g->startDelay;
+        readSchedStat(g->tid, rec);
+    }
+}
+
+static void schedCreate(goroutine *g) {
+    pthread_mutex_lock(&schedMu);
+    g->prev = mainG.prev;
+    g->next = &mainG;
+    mainG.prev->next = g;
+    mainG.prev = g;
+    traceGoCreate(g);
+    pthread_mutex_unlock(&schedMu);
+}
+
+static void schedStart(goroutine *g) {
+    pthread_mutex_lock(&schedMu);
+    g->startDelay = nanotime() - g->created;
+    schedLatencies[g->startDelay > 0 ? 64 - __builtin_clzl((unsigned long)g->startDelay) : 0]++;
+    g->tid = threadID();
+    g->th = pthread_self();
+    pthread_mutex_unlock(&schedMu);
+}
+
+static void schedExit(goroutine *g, int ran) {
+    llgoSchedRecord rec;
+    if (ran) {
+        schedRecord(g, &rec);
+        rec.running = 0;
+    }
+    pthread_mutex_lock(&schedMu);
+    if (ran) {
+        traceGoEnd(g);
+    }
+    g->prev->next = g->next;
+    g->next->prev = g->prev;
+    if (ran) {
+        schedHistory[schedExited++ % SCHED_HISTORY] = rec;
+    }
+    pthread_mutex_unlock(&schedMu);
+}
+
+// llgoSchedLatency stores the scheduling records of running goroutines and
+// of the most recently exited ones into recs, and returns the number of
+// records available. Nothing is stored if it is greater than n.
+int llgoSchedLatency(llgoSchedRecord *recs, int n) {
+    pthread_mutex_lock(&schedMu);
+    long nhist = schedExited < SCHED_HISTORY ? schedExited : SCHED_HISTORY;
+    int total = (int)nhist;
+    goroutine *g = &mainG;
+    do {
+        total++;
+        g = g->next;
+    } while (g != &mainG);
+    if (total <= n) {
+        int i = 0;
+        g = &mainG;
+        do {
+            schedRecord(g, &recs[i]);
+            recs[i++].running = 1;
+            g = g->next;
+        } while (g != &mainG);
+        for (long j = schedExited - nhist; j < schedExited; j++) {
+            recs[i++] = schedHistory[j % SCHED_HISTORY];
+        }
+    }
+    pthread_mutex_unlock(&schedMu);
+    return total;
+}
+
+// llgoSchedLatencies stores the distribution of the delays before goroutines
+// start running into n buckets of counts: bucket 0 for delays under 1ns,
+// bucket i for delays in [2^(i-1), 2^i) ns, and the last one for the longer
+// ones.
+void llgoSchedLatencies(uint64_t *counts, int n) {
+    memset(counts, 0, n * sizeof(uint64_t));
+    pthread_mutex_lock(&schedMu);
+    for (int i = 0; i < 65; i++) {
+        counts[i < n ? i : n - 1] += schedLatencies[i];
+    }
+    pthread_mutex_unlock(&schedMu);
+}
+
+// -----------------------------------------------------------------------------
+
+// Functions compiled with stack maps (see llssa.Program.SetStackMaps) push
+// their frames on llvm_gc_root_chain, the shadow stack of their thread. The
+// threads running goroutines report their chain to the hook set by
+// llgoSetRootChainHook when they start and exit, for the collector to scan
+// the chains of all threads.
+
+__thread void *llvm_gc_root_chain; // see llssa.NameRootChain
+
+static void (*rootChainHook)(void **chain, int add);
+
+// llgoSetRootChainHook sets the function called with the address of the root
+// chain of each thread running a goroutine, and add set when it starts or 0
+// when it exits. The chain of the main thread isn't reported.
+void llgoSetRootChainHook(void (*fn)(void **chain, int add)) {
+    rootChainHook = fn;
+}
+
+// llgoRootChain returns the address of the root chain of the current thread.
+void **llgoRootChain(void) {
+    return &llvm_gc_root_chain;
+}
+
+static void rootChainReport(int add) {
+    if (rootChainHook != NULL) {
+        rootChainHook(&llvm_gc_root_chain, add);
+    }
+}
+
+// -----------------------------------------------------------------------------
+
+static __thread sigjmp_buf *exitJmp; // where llgoGoexit ends the goroutine
+
+static void *threadEntry(void *data) {
+    goroutine *g = (goroutine *)data;
+    void *altstack = initThread();
+    sigjmp_buf jb;
+    curG = g;
+    setStackLimit();
+    schedStart(g);
+    rootChainReport(1);
+    llgoTraceResume();
+    exitJmp = &jb;
+    if (sigsetjmp(jb, 0) == 0) {
+        g->routine(g->arg);
+    }
+    exitJmp = NULL;
+    llvm_gc_root_chain = NULL; // Goexit left the frames of the goroutine
+    rootChainReport(0);
+    schedExit(g, 1);
+    curG = NULL;
+    free(g);
+    atomic_fetch_sub(&nThreads, 1);
+    checkDead(); // the goroutines left may all be asleep
+    clearStackLimit(); // the stack may be cached for new threads
+    exitThread(altstack);
+    return NULL;
+}
+
+int llgoThreadCreate(pthread_t *th, void *(*routine)(void *), void *arg) {
+    long n = atomic_fetch_add(&nThreads, 1) + 1;
+    long soft = atomic_load(&maxGoroutines);
+    if (soft > 0 && n > soft) {
+        atomic_fetch_sub(&nThreads, 1);
+        return LLGO_ELIMIT;
+    }
+    long max = atomic_load(&maxThreads);
+    if (n > max) {
+        writeStr("runtime: program exceeds ");
+        writeUint(max);
+        writeStr("-thread limit\nfatal error: thread exhaustion\n");
+        _exit(2);
+    }
+    goroutine *g = malloc(sizeof(goroutine));
+    g->routine = routine;
+    g->arg = arg;
+    g->goid = atomic_fetch_add(&nextGoid, 1);
+    g->tid = 0;
+    g->locked = 0;
+    g->asleep = 0;
+    g->created = nanotime();
+    g->trace.gen = 0;
+    schedCreate(g);
+
+    pthread_attr_t attr;
+    pthread_attr_init(&attr);
+    int ret = pthread_create(th, &attr, threadEntry, g);
+    pthread_attr_destroy(&attr);
+    if (ret != 0) {
+        schedExit(g, 0);
+        free(g);
+        atomic_fetch_sub(&nThreads, 1);
+    }
+    return ret;
+}
+
+// llgoGoexit ends the current goroutine, whose deferred calls have run, as
+// if its function returned. It returns on a thread not created by
+// llgoThreadCreate, like the main one.
+void llgoGoexit(void) {
+    if (exitJmp != NULL) {
+        siglongjmp(*exitJmp, 1);
+    }
+}
+
+// llgoNumGoroutine returns the number of goroutines that currently exist.
+long llgoNumGoroutine(void) {
+    return atomic_load(&nThreads);
+}
+
+// llgoGoid returns the ID of the current goroutine, or 0 on a thread not
+// created by llgo.
+long llgoGoid(void) {
+    return curG ? curG->goid : 0;
+}
+
+// llgoNumThreads returns the number of threads running goroutines.
+long llgoNumThreads(void) {
+    return atomic_load(&nThreads);
+}
+
+long llgoSetMaxThreads(long n) {
+    return atomic_exchange(&maxThreads, n);
+}
+
+// llgoSetMaxStack sets the stack limit of goroutines, enforced at once on the
+// current one, and returns the previous setting.
+long llgoSetMaxStack(long n) {
+    long old = atomic_exchange(&maxStack, n);
+    if (exitJmp != NULL || curG == &mainG) { // not a thread of C
+        setStackLimit();
+    }
+    return old;
+}
+
+long llgoSetMaxGoroutines(long n) {
+    return atomic_exchange(&maxGoroutines, n);
+}
+
+// -----------------------------------------------------------------------------
+
+// Threads not created by llgo, like those of C libraries, run Go code when C
+// calls exported Go functions. Such a thread is a goroutine for the time of
+// the outermost call, like in Go: it gets a goroutine ID, and counts in
+// llgoNumGoroutine. It gets an alternate signal stack and its stack bounds
+// the first time, unless it has an alternate stack already, which it keeps
+// until it exits.
+
+static __thread goroutine *cbG; // goroutine of a foreign thread
+static __thread long cbDepth;   // nested calls of cbG
+static pthread_key_t cbKey;     // alternate stack of a foreign thread
+static pthread_once_t cbOnce = PTHREAD_ONCE_INIT;
+
+static void cbInit(void) {
+    pthread_key_create(&cbKey, exitThread);
+}
+
+static void cbInitThread(void) {
+    pthread_once(&cbOnce, cbInit);
+    if (stackHi != 0) {
+        return; // done by an earlier callback
+    }
+    stack_t ss;
+    if (sigaltstack(NULL, &ss) == 0 && !(ss.ss_flags & SS_DISABLE)) {
+        initStackBounds();
+        return;
+    }
+    void *altstack = initThread();
+    if (altstack != NULL) {
+        pthread_setspecific(cbKey, altstack);
+    }
+}
+
+// llgoCallbackEnter is called when C calls an exported Go function, and
+// reports whether the thread is a foreign one, which becomes a goroutine.
+int llgoCallbackEnter(void) {
+    if (curG != NULL) {
+        if (curG == cbG) {
+            cbDepth++;
+        }
+        return 0;
+    }
+    cbInitThread();
+    goroutine *g = malloc(sizeof(goroutine));
+    if (g == NULL) {
+        writeStr("fatal error: out of memory in a callback from C\n");
+        _exit(2);
+    }
+    g->routine = NULL;
+    g->arg = NULL;
+    g->goid = atomic_fetch_add(&nextGoid, 1);
+    g->tid = 0;
+    g->locked = 0;
+    g->asleep = 0;
+    g->created = nanotime();
+    g->trace.gen = 0;
+    atomic_fetch_add(&nThreads, 1);
+    schedCreate(g);
+    curG = cbG = g;
+    cbDepth = 1;
+    schedStart(g);
+    rootChainReport(1);
+    llgoTraceResume();
+    return 1;
+}
+
+// llgoCallbackExit is called when an exported Go function returns to C, and
+// reports whether the foreign thread stops being a goroutine.
+int llgoCallbackExit(void) {
+    goroutine *g = curG;
+    if (g == NULL || g != cbG || --cbDepth > 0) {
+        return 0;
+    }
+    rootChainReport(0);
+    schedExit(g, 1);
+    curG = cbG = NULL;
+    free(g);
+    atomic_fetch_sub(&nThreads, 1);
+    return 1;
+}
+
+// -----------------------------------------------------------------------------
+
+// Goroutines are threads, so a goroutine always runs on the same thread, and
+// the main goroutine on the main thread: LockOSThread only counts the locks,
+// as the thread never runs other goroutines, and exits with its goroutine.
+
+// llgoLockOSThread locks the current goroutine to its thread.
+void llgoLockOSThread(void) {
+    if (curG != NULL) {
+        curG->locked++;
+    }
+}
+
+// llgoUnlockOSThread undoes a call of llgoLockOSThread, if any.
+void llgoUnlockOSThread(void) {
+    if (curG != NULL && curG->locked > 0) {
+        curG->locked--;
+    }
+}
+
+// -----------------------------------------------------------------------------
+
+// Like in Go, the program dies when all goroutines are asleep: blocked on a
+// channel, a select, a semaphore or a condition, which only another goroutine
+// could unblock. Goroutines sleeping, like the one running timers while some
+// are pending, or in syscalls or in C, are not asleep. A goroutine is asleep
+// from llgoTraceBlock until llgoTraceResume, but a woken one only resumes a
+// bit later: when all seem asleep, a watchdog thread confirms that none
+// resumes for a while before reporting. Programs exporting Go functions to C
+// never die so, like programs using cgo in Go: a thread of C may call them
+// at any time.
+
+#define TRACE_BLOCK_SLEEP 7 // thread.TraceBlockSleep
+#define DEAD_CHECKS 5
+#define DEAD_CHECK_US 20000
+#define DEAD_STACK_DEPTH 64
+
+static atomic_int hasExports; // set by llgoEnableCallbacks
+static atomic_long nAsleep;
+static atomic_ulong asleepEpoch; // changes when a goroutine falls asleep or wakes
+static pthread_mutex_t deadMu = PTHREAD_MUTEX_INITIALIZER;
+static pthread_cond_t deadCond = PTHREAD_COND_INITIALIZER;
+static int deadStarted; // the watchdog is started
+static int deadSuspect; // all goroutines seemed asleep
+static void (*deadHandler)(void);
+
+// llgoDeadGoroutine must match thread.DeadGoroutine.
+typedef struct {
+    long goid;
+    int reason;
+    int nstk;
+    uintptr_t stk[DEAD_STACK_DEPTH];
+} llgoDeadGoroutine;
+
+static goroutine *volatile dumpG;       // goroutine whose stack is requested
+static llgoDeadGoroutine *volatile dumpRec;
+static atomic_int dumpDone;
+
+static void deadBlock(int reason) {
+    goroutine *g = curG;
+    if (g == NULL || g->asleep || reason == TRACE_BLOCK_SLEEP) {
+        return;
+    }
+    g->asleep = 1;
+    g->waitReason = reason;
+    atomic_fetch_add(&asleepEpoch, 1);
+    atomic_fetch_add(&nAsleep, 1);
+    checkDead();
+}
+
+static void deadResume(void) {
+    goroutine *g = curG;
+    if (g == NULL || !g->asleep) {
+        return;
+    }
+    g->asleep = 0;
+    atomic_fetch_add(&asleepEpoch, 1);
+    atomic_fetch_sub(&nAsleep, 1);
+}
+
+static int allAsleep(void) {
+    return atomic_load(&nAsleep) >= atomic_load(&nThreads);
+}
+
+// onDump stores the stack of the goroutine requested by llgoDeadGoroutineAt.
+static void onDump(int sig) {
+    if (curG != NULL && curG == dumpG) {
+#if defined(HAVE_BACKTRACE)
+        dumpRec->nstk = backtrace((void **)dumpRec->stk, DEAD_STACK_DEPTH);
+#endif
+        atomic_store(&dumpDone, 1);
+    }
+}
+
+static void deadReport(void) {
+#if defined(HAVE_BACKTRACE)
+    void *pc[1];
+    backtrace(pc, 1); // loads the unwinder, outside of signal handlers
+#endif
+    struct sigaction sa;
+    memset(&sa, 0, sizeof(sa));
+    sa.sa_handler = onDump;
+    sa.sa_flags = SA_RESTART | SA_ONSTACK;
+    sigfillset(&sa.sa_mask);
+    sigaction(SIGURG, &sa, NULL); // the program is dying: SIGURG is borrowed
+    deadHandler();
+    _exit(2);
+}
+
+static void *deadWatch(void *arg) {
+    pthread_mutex_lock(&deadMu);
+    for (;;) {
+        while (!deadSuspect) {
+            pthread_cond_wait(&deadCond, &deadMu);
+        }
+        deadSuspect = 0;
+        pthread_mutex_unlock(&deadMu);
+        unsigned long epoch = atomic_load(&asleepEpoch);
+        int dead = 1;
+        for (int i = 0; i < DEAD_CHECKS && dead; i++) {
+            usleep(DEAD_CHECK_US);
+            dead = allAsleep() && atomic_load(&asleepEpoch) == epoch;
+        }
+        if (dead) {
+            deadReport();
+        }
+        pthread_mutex_lock(&deadMu);
+    }
+    return NULL;
+}
+
+// checkDead wakes the watchdog if all goroutines seem asleep.
+static void checkDead(void) {
+    if (atomic_load(&hasExports) || deadHandler == NULL || !allAsleep()) {
+        return;
+    }
+    pthread_mutex_lock(&deadMu);
+    if (!deadStarted) {
+        pthread_t th;
+        pthread_attr_t attr;
+        pthread_attr_init(&attr);
+        pthread_attr_setdetachstate(&attr, PTHREAD_CREATE_DETACHED);
+        deadStarted = pthread_create(&th, &attr, deadWatch, NULL) == 0;
+        pthread_attr_destroy(&attr);
+    }
+    deadSuspect = 1;
+    pthread_cond_signal(&deadCond);
+    pthread_mutex_unlock(&deadMu);
+}
+
+// llgoEnableCallbacks is called before main if the program exports Go
+// functions to C (see ssa.Package.NewExport).
+void llgoEnableCallbacks(void) {
+    atomic_store(&hasExports, 1);
+}
+
+// llgoSetDeadlockHandler sets the function reporting that all goroutines are
+// asleep, by llgoDeadGoroutineAt. The program exits with status 2 after it.
+void llgoSetDeadlockHandler(void (*fn)(void)) {
+    deadHandler = fn;
+}
+
+// llgoDeadGoroutineAt stores the i-th goroutine into rec, with its stack, when
+// the deadlock handler runs, and reports whether there is one.
+int llgoDeadGoroutineAt(long i, llgoDeadGoroutine *rec) {
+    pthread_mutex_lock(&schedMu);
+    goroutine *g = &mainG;
+    for (; i > 0; i--) {
+        g = g->next;
+        if (g == &mainG) {
+            pthread_mutex_unlock(&schedMu);
+            return 0;
+        }
+    }
+    rec->goid = g->goid;
+    rec->reason = g->waitReason;
+    rec->nstk = 0;
+    dumpRec = rec;
+    dumpG = g;
+    atomic_store(&dumpDone, 0);
+    if (pthread_kill(g->th, SIGURG) == 0) {
+        for (int n = 0; n < 1000 && !atomic_load(&dumpDone); n++) {
+            usleep(1000);
+        }
+    }
+    dumpG = NULL;
+    pthread_mutex_unlock(&schedMu);
+    return 1;
+}
+
+// -----------------------------------------------------------------------------
+
+long llgoNumCPU(void) {
+    long n = sysconf(_SC_NPROCESSORS_ONLN);
+    return n > 0 ? n : 1;
+}
+
+void llgoProcYield(int cycles) {
+    for (int i = 0; i < cycles; i++) {
+#if defined(__x86_64__) || defined(__i386__)
+        __builtin_ia32_pause();
+#elif defined(__aarch64__) || defined(__arm__)
+        __asm__ __volatile__("yield");
+#else
+        atomic_signal_fence(memory_order_seq_cst);
+#endif
+    }
+}
+
+// -----------------------------------------------------------------------------
+
+// The execution tracer writes events in the format of Go 1.21 traces, which
+// go tool trace reads. Goroutines are threads, so each one has a P of its
+// own, from its first event until it exits; P 0 is where the events of
+// threads that aren't goroutines go. Events are written with traceMu held,
+// in order, into chunks that llgoTraceRead hands out, and a batch starts
+// whenever the P changes. Stacks aren't recorded.
+
+#define TRACE_CHUNK (64 << 10)   // must match thread.TraceChunkSize
+#define TRACE_MAX_STRING 1024    // longer strings are truncated
+#define TRACE_SYSCALL_BLOCK 20000 // ns a syscall runs before it counts as blocking, like in Go
+
+enum {
+    evBatch = 1,
+    evFrequency = 2,
+    evGomaxprocs = 4,
+    evProcStart = 5,
+    evProcStop = 6,
+    evGCStart = 7,
+    evGCDone = 8,
+    evSTWStart = 9,
+    evSTWDone = 10,
+    evGoCreate = 13,
+    evGoStart = 14,
+    evGoEnd = 15,
+    evGoStop = 16,
+    evGoSleep = 19,
+    evGoBlock = 20,
+    evGoUnblock = 21,
+    evGoBlockSend = 22,
+    evGoBlockRecv = 23,
+    evGoBlockSelect = 24,
+    evGoBlockSync = 25,
+    evGoBlockCond = 26,
+    evGoSysCall = 28,
+    evGoSysExit = 29,
+    evGoSysBlock = 30,
+    evString = 37,
+    evUserTaskCreate = 45,
+    evUserTaskEnd = 46,
+    evUserRegion = 47,
+    evUserLog = 48,
+};
+
+enum { traceRunnable, traceRunning, traceWaiting };
+
+// traceBlockEvents are the events of the block reasons of llgoTraceBlock,
+// which must match thread.TraceBlock*.
+static const unsigned char traceBlockEvents[] = {
+    evGoBlock, evGoStop, evGoBlockSend, evGoBlockRecv,
+    evGoBlockSelect, evGoBlockSync, evGoBlockCond, evGoSleep,
+};
+
+typedef struct traceChunk {
+    struct traceChunk *next;
+    long len;
+    unsigned char data[TRACE_CHUNK];
+} traceChunk;
+
+typedef struct {
+    char *s;
+    long len;
+    uint64_t id;
+} traceString;
+
+static pthread_mutex_t traceMu = PTHREAD_MUTEX_INITIALIZER;
+static pthread_cond_t traceReadCond = PTHREAD_COND_INITIALIZER; // chunks to read, or stopping
+static pthread_cond_t traceDoneCond = PTHREAD_COND_INITIALIZER; // the reader is done
+static atomic_int traceOn;    // also read without traceMu, to skip tracing fast
+static int traceStopping;     // llgoTraceStop waits for the reader
+static int traceReaderDone;   // the reader has read everything
+static long traceGen;         // current trace
+static long traceStartTs;
+static traceChunk *traceCur;  // chunk being written
+static traceChunk *traceFull; // chunks to read
+static traceChunk **traceFullTail = &traceFull;
+static int traceBatchP;       // P of the current batch, -1 if none
+static long traceLastTs;      // time of the last event of the batch
+static int *traceFreePs;      // Ps of exited goroutines, to reuse
+static int traceNumFree, traceCapFree, traceNextP;
+static uint64_t traceGCSeq;
+static int traceInGC, traceInSTW;
+static traceString *traceStrings; // open addressing table of the strings written
+static long traceStringsCap, traceStringsLen;
+
+static void traceFlush(void) {
+    if (traceCur != NULL && traceCur->len > 0) {
+        traceCur->next = NULL;
+        *traceFullTail = traceCur;
+        traceFullTail = &traceCur->next;
+        traceCur = NULL;
+        pthread_cond_signal(&traceReadCond);
+    }
+}
+
+// traceReserve makes room for n contiguous bytes in the current chunk. The
+// chunks are read in order, so events may span two of them.
+static void traceReserve(long n) {
+    if (traceCur != NULL && traceCur->len + n > TRACE_CHUNK) {
+        traceFlush();
+    }
+    if (traceCur == NULL) {
+        traceCur = malloc(sizeof(traceChunk));
+        traceCur->len = 0;
+    }
+}
+
+static void tracePutByte(unsigned char b) {
+    traceCur->data[traceCur->len++] = b;
+}
+
+static void tracePutVarint(uint64_t v) {
+    for (; v >= 0x80; v >>= 7) {
+        tracePutByte((unsigned char)v | 0x80);
+    }
+    tracePutByte((unsigned char)v);
+}
+
+static void tracePutBytes(const void *b, long n) {
+    memcpy(traceCur->data + traceCur->len, b, n);
+    traceCur->len += n;
+}
+
+// traceEvent writes an event of P pid at time ts, with the given arguments
+// after its timestamp. The stack of an event taking one is passed as 0: no
+// stack.
+static void traceEvent(int pid, int ev, long ts, const uint64_t *args, int nargs) {
+    traceReserve(128);
+    if (traceBatchP != pid) {
+        tracePutByte(evBatch | 1 << 6);
+        tracePutVarint((uint64_t)pid);
+        tracePutVarint((uint64_t)ts);
+        traceBatchP = pid;
+        traceLastTs = ts;
+    }
+    if (ts < traceLastTs) {
+        ts = traceLastTs;
+    }
+    unsigned char buf[96];
+    int n = 0;
+    uint64_t v = (uint64_t)(ts - traceLastTs);
+    traceLastTs = ts;
+    for (int i = -1; i < nargs; i++) {
+        if (i >= 0) {
+            v = args[i];
+        }
+        for (; v >= 0x80; v >>= 7) {
+            buf[n++] = (unsigned char)v | 0x80;
+        }
+        buf[n++] = (unsigned char)v;
+    }
+    // up to 2 arguments are counted in the event byte, more are sized.
+    int narg = nargs < 3 ? nargs : 3;
+    tracePutByte((unsigned char)(ev | narg << 6));
+    if (narg == 3) {
+        tracePutVarint((uint64_t)n);
+    }
+    tracePutBytes(buf, n);
+}
+
+// traceStringID returns the id of the string s of n bytes, writing it the
+// first time. The empty string is 0.
+static uint64_t traceStringID(const char *s, long n) {
+    if (n <= 0) {
+        return 0;
+    }
+    if (n > TRACE_MAX_STRING) {
+        n = TRACE_MAX_STRING;
+    }
+    if (2 * (traceStringsLen + 1) > traceStringsCap) {
+        long cap = traceStringsCap ? 2 * traceStringsCap : 64;
+        traceString *tab = calloc(cap, sizeof(traceString));
+        for (long i = 0; i < traceStringsCap; i++) {
+            traceString *e = &traceStrings[i];
+            if (e->s != NULL) {
+                uint64_t h = 14695981039346656037ULL;
+                for (long j = 0; j < e->len; j++) {
+                    h = (h ^ (unsigned char)e->s[j]) * 1099511628211ULL;
+                }
+                long k = (long)(h & (cap - 1));
+                while (tab[k].s != NULL) {
+                    k = (k + 1) & (cap - 1);
+                }
+                tab[k] = *e;
+            }
+        }
+        free(traceStrings);
+        traceStrings = tab;
+        traceStringsCap = cap;
+    }
+    uint64_t h = 14695981039346656037ULL; // FNV-1a
+    for (long j = 0; j < n; j++) {
+        h = (h ^ (unsigned char)s[j]) * 1099511628211ULL;
+    }
+    long k = (long)(h & (traceStringsCap - 1));
+    for (; traceStrings[k].s != NULL; k = (k + 1) & (traceStringsCap - 1)) {
+        traceString *e = &traceStrings[k];
+        if (e->len == n && memcmp(e->s, s, n) == 0) {
+            return e->id;
+        }
+    }
+    traceString *e = &traceStrings[k];
+    e->s = malloc(n);
+    memcpy(e->s, s, n);
+    e->len = n;
+    e->id = (uint64_t)++traceStringsLen;
+    traceReserve(32 + n);
+    tracePutByte(evString);
+    tracePutVarint(e->id);
+    tracePutVarint((uint64_t)n);
+    tracePutBytes(s, n);
+    return e->id;
+}
+
+static void traceResetStrings(void) {
+    for (long i = 0; i < traceStringsCap; i++) {
+        free(traceStrings[i].s);
+    }
+    free(traceStrings);
+    traceStrings = NULL;
+    traceStringsCap = 0;
+    traceStringsLen = 0;
+}
+
+// traceLock locks the trace and returns 1 if it's on, storing the current
+// goroutine into *gp if it's traced, or NULL.
+static int traceLock(goroutine **gp) {
+    if (!atomic_load_explicit(&traceOn, memory_order_relaxed)) {
+        return 0;
+    }
+    pthread_mutex_lock(&traceMu);
+    if (!atomic_load(&traceOn)) {
+        pthread_mutex_unlock(&traceMu);
+        return 0;
+    }
+    goroutine *g = curG;
+    *gp = g != NULL && g->trace.gen == traceGen ? g : NULL;
+    return 1;
+}
+
+// traceRun makes the current goroutine g, which is traced, run on its P,
+// starting the P at its first event. A goroutine woken without an unblock event, like by a
+// channel, is unblocked by itself.
+static void traceRun(goroutine *g, long ts) {
+    if (g->trace.pid == 0) {
+        g->trace.pid = traceNumFree > 0 ? traceFreePs[--traceNumFree] : ++traceNextP;
+        long tid = threadID(); // of g, which is the current goroutine
+        uint64_t a[] = {(uint64_t)(tid ? tid : g->goid)};
+        traceEvent(g->trace.pid, evProcStart, ts, a, 1);
+    }
+    if (g->trace.status == traceWaiting) {
+        uint64_t a[] = {(uint64_t)g->goid, (uint64_t)g->trace.seq++, 0};
+        traceEvent(g->trace.pid, evGoUnblock, ts, a, 3);
+        g->trace.status = traceRunnable;
+    }
+    if (g->trace.status == traceRunnable) {
+        uint64_t a[] = {(uint64_t)g->goid, (uint64_t)g->trace.seq++};
+        traceEvent(g->trace.pid, evGoStart, ts, a, 2);
+        g->trace.status = traceRunning;
+    }
+}
+
+// traceP returns the P to write the events of the current goroutine on, g
+// if it's traced, running it at ts.
+static int traceP(goroutine *g, long ts) {
+    if (g == NULL) {
+        return 0;
+    }
+    traceRun(g, ts);
+    return g->trace.pid;
+}
+
+// traceGoCreate is called with schedMu held.
+static void traceGoCreate(goroutine *ng) {
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    long ts = nanotime();
+    uint64_t a[] = {(uint64_t)ng->goid, 0, 0};
+    traceEvent(traceP(g, ts), evGoCreate, ts, a, 3);
+    ng->trace.gen = traceGen;
+    ng->trace.seq = 1;
+    ng->trace.status = traceRunnable;
+    ng->trace.pid = 0;
+    pthread_mutex_unlock(&traceMu);
+}
+
+// traceGoEnd is called with schedMu held, by the exiting goroutine g.
+static void traceGoEnd(goroutine *g) {
+    goroutine *self;
+    if (!traceLock(&self)) {
+        return;
+    }
+    if (self == g) {
+        long ts = nanotime();
+        int pid = traceP(g, ts);
+        traceEvent(pid, evGoEnd, ts, NULL, 0);
+        traceEvent(pid, evProcStop, ts, NULL, 0);
+        if (traceNumFree == traceCapFree) {
+            traceCapFree = traceCapFree ? 2 * traceCapFree : 64;
+            traceFreePs = realloc(traceFreePs, traceCapFree * sizeof(int));
+        }
+        traceFreePs[traceNumFree++] = pid;
+    }
+    g->trace.gen = 0;
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceStart starts a trace: the goroutines running are created at
+// once, and are started at their first event. It returns -1 if a trace is
+// already running, or still being read.
+int llgoTraceStart(void) {
+    pthread_mutex_lock(&schedMu);
+    pthread_mutex_lock(&traceMu);
+    if (atomic_load(&traceOn) || traceStopping || traceFull != NULL) {
+        pthread_mutex_unlock(&traceMu);
+        pthread_mutex_unlock(&schedMu);
+        return -1;
+    }
+    traceGen++;
+    traceResetStrings();
+    traceNumFree = 0;
+    traceNextP = 0;
+    traceGCSeq = 0;
+    traceInGC = 0;
+    traceInSTW = 0;
+    traceBatchP = -1;
+    long ts = nanotime();
+    traceStartTs = ts;
+    traceReserve(16);
+    tracePutBytes("go 1.21 trace\0\0\0", 16);
+    uint64_t a[] = {(uint64_t)threadID(), 0, 0};
+    traceEvent(0, evProcStart, ts, a, 1);
+    tracePutByte(evFrequency);
+    tracePutVarint(1000000000); // timestamps are in ns
+    goroutine *g = &mainG;
+    do {
+        a[0] = (uint64_t)g->goid;
+        traceEvent(0, evGoCreate, ts, a, 3);
+        g->trace.gen = traceGen;
+        g->trace.seq = 1;
+        g->trace.status = traceRunnable;
+        g->trace.pid = 0;
+        g = g->next;
+    } while (g != &mainG);
+    a[0] = (uint64_t)llgoNumCPU();
+    traceEvent(0, evGomaxprocs, ts, a, 2);
+    atomic_store(&traceOn, 1);
+    pthread_mutex_unlock(&traceMu);
+    pthread_mutex_unlock(&schedMu);
+    llgoTraceResume();
+    return 0;
+}
+
+// llgoTraceStop stops the trace, and waits until it has been read.
+void llgoTraceStop(void) {
+    pthread_mutex_lock(&traceMu);
+    if (atomic_load(&traceOn)) {
+        atomic_store(&traceOn, 0);
+        traceFlush();
+        traceStopping = 1;
+        pthread_cond_broadcast(&traceReadCond);
+        while (!traceReaderDone) {
+            pthread_cond_wait(&traceDoneCond, &traceMu);
+        }
+        traceStopping = 0;
+        traceReaderDone = 0;
+    }
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceRead blocks until a chunk of the trace is written, copies it into
+// buf, of TRACE_CHUNK bytes, and returns its size. It returns 0 once the
+// trace is stopped and read, or if there is none. There must be a single
+// reader.
+long llgoTraceRead(void *buf) {
+    pthread_mutex_lock(&traceMu);
+    for (;;) {
+        traceChunk *c = traceFull;
+        if (c != NULL) {
+            traceFull = c->next;
+            if (traceFull == NULL) {
+                traceFullTail = &traceFull;
+            }
+            pthread_mutex_unlock(&traceMu);
+            long n = c->len;
+            memcpy(buf, c->data, n);
+            free(c);
+            return n;
+        }
+        if (traceStopping) {
+            traceReaderDone = 1;
+            pthread_cond_broadcast(&traceDoneCond);
+            pthread_mutex_unlock(&traceMu);
+            return 0;
+        }
+        if (!atomic_load(&traceOn)) {
+            pthread_mutex_unlock(&traceMu);
+            return 0;
+        }
+        goroutine *g = curG;
+        if (g != NULL && g->trace.gen == traceGen) {
+            long ts = nanotime();
+            uint64_t a[] = {0};
+            traceEvent(traceP(g, ts), evGoBlock, ts, a, 1);
+            g->trace.status = traceWaiting;
+        }
+        pthread_cond_wait(&traceReadCond, &traceMu);
+        if (g != NULL && g->trace.gen == traceGen && atomic_load(&traceOn)) {
+            traceRun(g, nanotime());
+        }
+    }
+}
+
+// llgoTraceSelf returns the current goroutine, for llgoTraceUnblock.
+void *llgoTraceSelf(void) {
+    return curG;
+}
+
+// llgoTraceBlock records that the current goroutine blocks, for the given
+// reason.
+void llgoTraceBlock(int reason) {
+    deadBlock(reason);
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    if (g != NULL) {
+        long ts = nanotime();
+        uint64_t a[] = {0};
+        traceEvent(traceP(g, ts), traceBlockEvents[reason], ts, a, 1);
+        g->trace.status = traceWaiting;
+    }
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceUnblock records that the current goroutine unblocks target, which
+// must be blocked and not exit meanwhile.
+void llgoTraceUnblock(void *target) {
+    goroutine *g, *t = target;
+    if (t == NULL || !traceLock(&g)) {
+        return;
+    }
+    if (t->trace.gen == traceGen && t->trace.status == traceWaiting) {
+        long ts = nanotime();
+        uint64_t a[] = {(uint64_t)t->goid, (uint64_t)t->trace.seq++, 0};
+        traceEvent(traceP(g, ts), evGoUnblock, ts, a, 3);
+        t->trace.status = traceRunnable;
+    }
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceResume records that the current goroutine runs again, after it
+// blocked.
+void llgoTraceResume(void) {
+    deadResume();
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    if (g != NULL) {
+        traceRun(g, nanotime());
+    }
+    pthread_mutex_unlock(&traceMu);
+}
+
+static __thread long traceSyscallStart;
+
+void llgoTraceSyscallEnter(void) {
+    traceSyscallStart = atomic_load_explicit(&traceOn, memory_order_relaxed) ? nanotime() : 0;
+}
+
+// llgoTraceSyscallExit records the syscall the current goroutine returns
+// from if it blocked: only those show in traces.
+void llgoTraceSyscallExit(void) {
+    long t0 = traceSyscallStart;
+    traceSyscallStart = 0;
+    if (t0 == 0 || nanotime() - t0 < TRACE_SYSCALL_BLOCK) {
+        return;
+    }
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    if (g != NULL) {
+        if (t0 < traceStartTs) {
+            t0 = traceStartTs;
+        }
+        long ts = nanotime();
+        int pid = traceP(g, t0);
+        uint64_t a[] = {0};
+        traceEvent(pid, evGoSysCall, t0, a, 1);
+        traceEvent(pid, evGoSysBlock, t0, NULL, 0);
+        uint64_t b[] = {(uint64_t)g->goid, (uint64_t)g->trace.seq++, 0};
+        traceEvent(pid, evGoSysExit, ts, b, 3);
+        uint64_t c[] = {(uint64_t)g->goid, (uint64_t)g->trace.seq++};
+        traceEvent(pid, evGoStart, ts, c, 2);
+    }
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceGC records a phase of the collector: 0 and 1 for its start and
+// end, 2 and 3 for the world stopping and starting again, like thread.TraceGC*.
+// It must not be called with the world stopped.
+void llgoTraceGC(int phase) {
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    long ts = nanotime();
+    switch (phase) {
+    case 0: {
+        uint64_t a[] = {traceGCSeq++, 0};
+        traceEvent(0, evGCStart, ts, a, 2);
+        traceInGC = 1;
+        break;
+    }
+    case 1:
+        if (traceInGC) {
+            traceEvent(0, evGCDone, ts, NULL, 0);
+            traceInGC = 0;
+        }
+        break;
+    case 2: {
+        uint64_t a[] = {1}; // the world is stopped to mark
+        traceEvent(0, evSTWStart, ts, a, 1);
+        traceInSTW = 1;
+        break;
+    }
+    case 3:
+        if (traceInSTW) {
+            traceEvent(0, evSTWDone, ts, NULL, 0);
+            traceInSTW = 0;
+        }
+        break;
+    }
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceUserTaskCreate records the creation of the task id, in the task
+// parent.
+void llgoTraceUserTaskCreate(uint64_t id, uint64_t parent, const char *name, long n) {
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    long ts = nanotime();
+    uint64_t a[] = {id, parent, traceStringID(name, n), 0};
+    traceEvent(traceP(g, ts), evUserTaskCreate, ts, a, 4);
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceUserTaskEnd records the end of the task id.
+void llgoTraceUserTaskEnd(uint64_t id) {
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    long ts = nanotime();
+    uint64_t a[] = {id, 0};
+    traceEvent(traceP(g, ts), evUserTaskEnd, ts, a, 2);
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceUserRegion records the start (mode 0) or end (mode 1) of a
+// region of the current goroutine, in the task id.
+void llgoTraceUserRegion(uint64_t id, uint64_t mode, const char *name, long n) {
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    long ts = nanotime();
+    int pid = traceP(g, ts);
+    uint64_t a[] = {id, mode, traceStringID(name, n), 0};
+    traceEvent(pid, evUserRegion, ts, a, 4);
+    pthread_mutex_unlock(&traceMu);
+}
+
+// llgoTraceUserLog records a message of the given category, in the task id.
+void llgoTraceUserLog(uint64_t id, const char *cat, long ncat, const char *msg, long nmsg) {
+    goroutine *g;
+    if (!traceLock(&g)) {
+        return;
+    }
+    if (nmsg > TRACE_MAX_STRING) {
+        nmsg = TRACE_MAX_STRING;
+    }
+    long ts = nanotime();
+    int pid = traceP(g, ts);
+    uint64_t a[] = {id, traceStringID(cat, ncat), 0};
+    traceReserve(160 + nmsg); // the event and its message
+    traceEvent(pid, evUserLog, ts, a, 3);
+    tracePutVarint((uint64_t)nmsg);
+    tracePutBytes(msg, nmsg);
+    pthread_mutex_unlock(&traceMu);
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package thread
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+// -----------------------------------------------------------------------------
+
+// NetpollInit starts the thread of the network poller, on epoll or kqueue.
+//
+//go:linkname NetpollInit C.llgoNetpollInit
+func NetpollInit()
+
+// NetpollOpen registers fd with the poller, and stores its poll descriptor
+// into *pd. It returns an errno if fd can't be polled.
+//
+//go:linkname NetpollOpen C.llgoNetpollOpen
+func NetpollOpen(fd c.Int, pd *uintptr) c.Int
+
+// NetpollClose unregisters the descriptor of pd, which can't be used anymore.
+//
+//go:linkname NetpollClose C.llgoNetpollClose
+func NetpollClose(pd uintptr)
+
+// NetpollReset prepares pd for a read or a write, in mode 'r' or 'w'.
+//
+//go:linkname NetpollReset C.llgoNetpollReset
+func NetpollReset(pd uintptr, mode c.Int) c.Int
+
+// NetpollWait waits until pd is ready for mode, 'r' or 'w', its deadline
+// passed, or it's unblocked.
+//
+//go:linkname NetpollWait C.llgoNetpollWait
+func NetpollWait(pd uintptr, mode c.Int) c.Int
+
+// NetpollSetDeadline sets the deadline of pd for mode, 'r', 'w' or 'r'+'w',
+// to d nanoseconds from now: none if d is 0, and expired if it's negative.
+//
+//go:linkname NetpollSetDeadline C.llgoNetpollSetDeadline
+func NetpollSetDeadline(pd uintptr, d int64, mode c.Int)
+
+// NetpollUnblock makes the waits for pd fail, as it's being closed.
+//
+//go:linkname NetpollUnblock C.llgoNetpollUnblock
+func NetpollUnblock(pd uintptr)
+
+// IsNetpollDescriptor reports whether fd is used by the poller itself.
+//
+//go:linkname IsNetpollDescriptor C.llgoIsNetpollDescriptor
+func IsNetpollDescriptor(fd c.Int) c.Int
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package thread
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+// -----------------------------------------------------------------------------
+
+// SignalEnable starts delivering sig to SignalRecv. The action of sig set
+// before, like by a C library, is restored by SignalDisable. The signals of
+// the profiler and of the collector can't be delivered.
+//
+//go:linkname SignalEnable C.llgoSignalEnable
+func SignalEnable(sig uint32)
+
+// SignalDisable stops delivering sig, and restores its action unless it's
+// ignored.
+//
+//go:linkname SignalDisable C.llgoSignalDisable
+func SignalDisable(sig uint32)
+
+// SignalIgnore stops delivering sig, and ignores it.
+//
+//go:linkname SignalIgnore C.llgoSignalIgnore
+func SignalIgnore(sig uint32)
+
+// SignalIgnored reports whether sig is ignored.
+//
+//go:linkname SignalIgnored C.llgoSignalIgnored
+func SignalIgnored(sig uint32) c.Int
+
+// SignalRecv waits for a delivered signal, and returns it.
+//
+//go:linkname SignalRecv C.llgoSignalRecv
+func SignalRecv() uint32
+
+// SignalWaitIdle waits until the signals caught so far are received.
+//
+//go:linkname SignalWaitIdle C.llgoSignalWaitIdle
+func SignalWaitIdle()
+
+// Sigpipe kills the process by SIGPIPE, unless it's notified or ignored.
+//
+//go:linkname Sigpipe C.llgoSigpipe
+func Sigpipe()
+
+// -----------------------------------------------------------------------------
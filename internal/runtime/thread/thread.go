@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package thread
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/pthread"
+)
+
+const (
+	LLGoFiles   = "_thread/thread.c; _thread/signal.c; _thread/netpoll.c"
+	LLGoPackage = "link"
+)
+
+// -----------------------------------------------------------------------------
+
+// Create starts a new thread like pthread_create. It returns ErrLimit if
+// the goroutine limit set by SetMaxGoroutines is reached, and aborts the
+// program if the thread limit set by SetMaxThreads is exceeded. The goroutine
+// may use its thread stack up to the limit set by SetMaxStack, and overflowing
+// it aborts the program with a "stack overflow" error rather than an
+// unexplained segmentation fault.
+//
+// TODO: small goroutine stacks which grow, by morestack checks in function
+// prologues. It needs goroutines with stacks of their own, rather than the
+// stacks of their threads (see runtime.CreateThread).
+//
+//go:linkname Create C.llgoThreadCreate
+func Create(th *pthread.Thread, routine, arg c.Pointer) c.Int
+
+// SetMaxThreads sets the maximum number of threads and returns the previous
+// setting.
+//
+//go:linkname SetMaxThreads C.llgoSetMaxThreads
+func SetMaxThreads(n c.Long) c.Long
+
+// SetMaxStack sets the maximum stack size of goroutines and returns the
+// previous setting, 1 GB on 64-bit systems by default like in Go. It doesn't
+// change the size of thread stacks: a limit above it has no effect.
+//
+//go:linkname SetMaxStack C.llgoSetMaxStack
+func SetMaxStack(n c.Long) c.Long
+
+// NumGoroutine returns the number of goroutines that currently exist.
+//
+//go:linkname NumGoroutine C.llgoNumGoroutine
+func NumGoroutine() c.Long
+
+// Goid returns the ID of the current goroutine, or 0 on a thread not created
+// by llgo, unless it's in a call from C to Go (see CallbackEnter).
+//
+//go:linkname Goid C.llgoGoid
+func Goid() c.Long
+
+// Goexit ends the current goroutine, whose deferred calls have run, as if its
+// function returned. It returns on a thread not created by Create, like the
+// main one.
+//
+//go:linkname Goexit C.llgoGoexit
+func Goexit()
+
+// NumThreads returns the number of threads running goroutines.
+//
+//go:linkname NumThreads C.llgoNumThreads
+func NumThreads() c.Long
+
+// SetRootChainHook sets the function called with the address of the root
+// chain of each thread running a goroutine (see llssa.NameRootChain), and add
+// set when the goroutine starts or 0 when it exits, on its thread. The chain
+// of the main thread isn't reported (see RootChain).
+//
+//go:linkname SetRootChainHook C.llgoSetRootChainHook
+func SetRootChainHook(fn func(chain *c.Pointer, add c.Int))
+
+// RootChain returns the address of the root chain of the current thread.
+//
+//go:linkname RootChain C.llgoRootChain
+func RootChain() *c.Pointer
+
+// ErrLimit is returned by Create when the goroutine limit is reached.
+const ErrLimit = -1
+
+// SetMaxGoroutines sets the maximum number of running goroutines, including
+// the main one, and returns the previous setting. Zero means unlimited. The
+// initial setting comes from the LLGO_MAXGOROUTINES environment variable.
+//
+//go:linkname SetMaxGoroutines C.llgoSetMaxGoroutines
+func SetMaxGoroutines(n c.Long) c.Long
+
+// CallbackEnter is called when C calls an exported Go function. A thread not
+// created by llgo becomes a goroutine until the outermost call returns: it
+// reports whether the thread is such a one.
+//
+//go:linkname CallbackEnter C.llgoCallbackEnter
+func CallbackEnter() c.Int
+
+// CallbackExit is called when an exported Go function returns to C, and
+// reports whether the thread stops being a goroutine.
+//
+//go:linkname CallbackExit C.llgoCallbackExit
+func CallbackExit() c.Int
+
+// LockOSThread locks the current goroutine to its thread. Threads run a
+// single goroutine, and exit with it.
+//
+//go:linkname LockOSThread C.llgoLockOSThread
+func LockOSThread()
+
+// UnlockOSThread undoes a call of LockOSThread, if any.
+//
+//go:linkname UnlockOSThread C.llgoUnlockOSThread
+func UnlockOSThread()
+
+// DeadGoroutine is a goroutine when all are asleep: its ID, the block reason
+// of TraceBlock, and its stack.
+type DeadGoroutine struct {
+	Goid   c.Long
+	Reason c.Int
+	Nstk   c.Int
+	Stk    [64]uintptr
+}
+
+// SetDeadlockHandler sets the function reporting that all goroutines are
+// asleep. It's called by a thread which is not a goroutine, and the program
+// exits with status 2 after it.
+//
+//go:linkname SetDeadlockHandler C.llgoSetDeadlockHandler
+func SetDeadlockHandler(fn func())
+
+// DeadGoroutineAt stores the i-th goroutine into g, in the deadlock handler,
+// and reports whether there is one.
+//
+//go:linkname DeadGoroutineAt C.llgoDeadGoroutineAt
+func DeadGoroutineAt(i c.Long, g *DeadGoroutine) c.Int
+
+// -----------------------------------------------------------------------------
+
+// NumCPU returns the number of online processors.
+//
+//go:linkname NumCPU C.llgoNumCPU
+func NumCPU() c.Long
+
+// ProcYield hints the processor that the thread is spinning, cycles times.
+//
+//go:linkname ProcYield C.llgoProcYield
+func ProcYield(cycles c.Int)
+
+// -----------------------------------------------------------------------------
+
+// SchedRecord records how a goroutine has been scheduled. Durations are in
+// nanoseconds.
+type SchedRecord struct {
+	Goid       c.Long
+	StartDelay c.Long // from the go statement until the goroutine runs
+	RunqWait   c.Long // runnable but waiting for a CPU (Linux only)
+	RunTime    c.Long // running on a CPU (Linux only)
+	Running    c.Long // 1 if the goroutine has not exited
+}
+
+// SchedLatency stores the records of running goroutines and of the most
+// recently exited ones into recs, and returns the number of records
+// available. Nothing is stored if it is greater than n.
+//
+//go:linkname SchedLatency C.llgoSchedLatency
+func SchedLatency(recs *SchedRecord, n c.Int) c.Int
+
+// SchedLatencies stores the distribution of the delays before goroutines
+// start running into n buckets of counts: bucket 0 for delays under 1ns,
+// bucket i for delays in [2^(i-1), 2^i) ns, and the last one for the longer
+// ones.
+//
+//go:linkname SchedLatencies C.llgoSchedLatencies
+func SchedLatencies(counts *uint64, n c.Int)
+
+// -----------------------------------------------------------------------------
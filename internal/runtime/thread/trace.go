@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package thread
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+)
+
+// -----------------------------------------------------------------------------
+
+// TraceChunkSize is the size of the chunks TraceRead reads.
+const TraceChunkSize = 64 << 10
+
+// Block reasons of TraceBlock.
+const (
+	TraceBlockGeneric = iota
+	TraceBlockForever // never unblocked, like on a nil channel
+	TraceBlockSend
+	TraceBlockRecv
+	TraceBlockSelect
+	TraceBlockSync // on a semaphore, like a sync.Mutex
+	TraceBlockCond
+	TraceBlockSleep
+)
+
+// TraceStart starts writing an execution trace in the format of Go 1.21. It
+// returns -1 if a trace is already running, or still being read.
+//
+//go:linkname TraceStart C.llgoTraceStart
+func TraceStart() c.Int
+
+// TraceStop stops the trace, and waits until it has been read.
+//
+//go:linkname TraceStop C.llgoTraceStop
+func TraceStop()
+
+// TraceRead blocks until a chunk of the trace is written, copies it into
+// buf, of TraceChunkSize bytes, and returns its size. It returns 0 once the
+// trace is stopped and read. There must be a single reader.
+//
+//go:linkname TraceRead C.llgoTraceRead
+func TraceRead(buf c.Pointer) c.Long
+
+// TraceSelf returns the current goroutine, for TraceUnblock.
+//
+//go:linkname TraceSelf C.llgoTraceSelf
+func TraceSelf() c.Pointer
+
+// TraceBlock records that the current goroutine blocks, for reason.
+//
+//go:linkname TraceBlock C.llgoTraceBlock
+func TraceBlock(reason c.Int)
+
+// TraceUnblock records that the current goroutine unblocks g, returned by
+// TraceSelf, which must be blocked and not exit meanwhile.
+//
+//go:linkname TraceUnblock C.llgoTraceUnblock
+func TraceUnblock(g c.Pointer)
+
+// TraceResume records that the current goroutine runs again, after it
+// blocked.
+//
+//go:linkname TraceResume C.llgoTraceResume
+func TraceResume()
+
+// TraceSyscallEnter and TraceSyscallExit surround a syscall, which shows in
+// the trace if it blocked.
+//
+//go:linkname TraceSyscallEnter C.llgoTraceSyscallEnter
+func TraceSyscallEnter()
+
+//go:linkname TraceSyscallExit C.llgoTraceSyscallExit
+func TraceSyscallExit()
+
+// Phases of the collector of TraceGC.
+const (
+	TraceGCStart = iota
+	TraceGCDone
+	TraceSTWStart // the world is being stopped
+	TraceSTWDone  // the world has started again
+)
+
+// TraceGC records a phase of the collector. It must not be called with the
+// world stopped.
+//
+//go:linkname TraceGC C.llgoTraceGC
+func TraceGC(phase c.Int)
+
+// TraceUserTaskCreate records the creation of the task id, in the task
+// parent.
+//
+//go:linkname TraceUserTaskCreate C.llgoTraceUserTaskCreate
+func TraceUserTaskCreate(id, parent uint64, name *byte, n c.Long)
+
+// TraceUserTaskEnd records the end of the task id.
+//
+//go:linkname TraceUserTaskEnd C.llgoTraceUserTaskEnd
+func TraceUserTaskEnd(id uint64)
+
+// TraceUserRegion records the start (mode 0) or end (mode 1) of a region of
+// the current goroutine, in the task id.
+//
+//go:linkname TraceUserRegion C.llgoTraceUserRegion
+func TraceUserRegion(id, mode uint64, name *byte, n c.Long)
+
+// TraceUserLog records a message of the given category, in the task id.
+//
+//go:linkname TraceUserLog C.llgoTraceUserLog
+func TraceUserLog(id uint64, cat *byte, ncat c.Long, msg *byte, nmsg c.Long)
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,62 @@
+//go:build asan
+// +build asan
+
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/bdwgc"
+)
+
+// -----------------------------------------------------------------------------
+
+// With -asan, the program is linked with the AddressSanitizer runtime, which
+// intercepts malloc. The objects of the heap of bdwgc are poisoned past their
+// end instead, up to the end of their block, which leaves a redzone of at
+// least asanRedzone bytes: accessing it is an overflow.
+
+// AsanEnabled reports whether AddressSanitizer is enabled.
+const AsanEnabled = true
+
+const asanRedzone = 16
+
+//go:linkname asanPoison C.__asan_poison_memory_region
+func asanPoison(addr unsafe.Pointer, size uintptr)
+
+//go:linkname asanUnpoison C.__asan_unpoison_memory_region
+func asanUnpoison(addr unsafe.Pointer, size uintptr)
+
+// asanAlloc poisons the redzone of the object of size bytes at p, in a block
+// which may hold the poisoned redzone of a freed object.
+func asanAlloc(p unsafe.Pointer, size uintptr) {
+	asanUnpoison(p, size)
+	asanPoison(c.Advance(p, int(size)), bdwgc.Size(p)-size)
+}
+
+// bdwgc allocates its heap with mmap, where LeakSanitizer doesn't look for
+// pointers: the C memory only referenced from Go objects would leak.
+//
+//export __asan_default_options
+func asanDefaultOptions() *c.Char {
+	return c.Str("detect_leaks=0")
+}
+
+// -----------------------------------------------------------------------------
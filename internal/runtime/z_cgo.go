@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+// functions referenced by code generated by cgo
+
+var cgoAlwaysFalse bool
+
+func cgoUse(any)               {}
+func cgoKeepAlive(any)         {}
+func cgoNoCallback(bool)       {}
+func cgoCheckPointer(any, any) {}
+func cgoCheckResult(any)       {}
+
+// gostring converts the C string p into a Go string (see C.GoString).
+func gostring(p *int8) String {
+	if p == nil {
+		return String{}
+	}
+	return StringFromCStr(p)
+}
+
+// gostringn converts the C data p with explicit length l into a Go string
+// (see C.GoStringN).
+func gostringn(p *int8, l int) String {
+	return StringFrom(unsafe.Pointer(p), l)
+}
+
+// gobytes converts the C data p with explicit length n into a Go []byte
+// (see C.GoBytes).
+func gobytes(p unsafe.Pointer, n int) Slice {
+	if n == 0 {
+		return Slice{}
+	}
+	data := AllocU(uintptr(n))
+	c.Memcpy(data, p, uintptr(n))
+	return Slice{data, n, n}
+}
+
+// -----------------------------------------------------------------------------
+
+// Exported Go functions are called by C through wrappers (see //export),
+// which call CgocallbackEnter before and CgocallbackExit after. A thread not
+// created by llgo becomes a goroutine for the time of the outermost call, and
+// is registered to the collector unless it's registered already. Deferred
+// calls of the C caller's goroutine are hidden from the callback by the
+// wrapper, so a panic not recovered in the callback ends the program, like
+// in Go, rather than unwinding through C frames.
+
+// CgocallbackEnter is called when C calls an exported Go function. It must
+// not allocate before the thread is registered.
+func CgocallbackEnter() {
+	if thread.CallbackEnter() != 0 && registerThread() {
+		g.cgo = true
+	}
+}
+
+// CgocallbackExit is called when an exported Go function returns to C.
+func CgocallbackExit() {
+	if thread.CallbackExit() != 0 && g.cgo {
+		g.cgo = false
+		unregisterThread()
+	}
+}
+
+// -----------------------------------------------------------------------------
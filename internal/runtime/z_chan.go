@@ -21,6 +21,7 @@ import (
 
 	"github.com/goplus/llgo/c"
 	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/internal/runtime/thread"
 )
 
 // -----------------------------------------------------------------------------
@@ -53,6 +54,9 @@ func NewChan(eltSize, cap int) *Chan {
 }
 
 func ChanLen(p *Chan) (n int) {
+	if p == nil {
+		return 0
+	}
 	p.mutex.Lock()
 	n = p.len
 	p.mutex.Unlock()
@@ -60,9 +64,34 @@ func ChanLen(p *Chan) (n int) {
 }
 
 func ChanCap(p *Chan) int {
+	if p == nil {
+		return 0
+	}
 	return p.cap
 }
 
+// blockForever blocks the current goroutine forever, like an operation on a
+// nil channel.
+func blockForever() {
+	var mutex sync.Mutex
+	var cond sync.Cond
+	mutex.Init(nil)
+	cond.Init(nil)
+	mutex.Lock()
+	thread.TraceBlock(thread.TraceBlockForever)
+	for {
+		cond.Wait(&mutex)
+	}
+}
+
+// wait waits for p to change, with p.mutex held, recording the goroutine as
+// blocked for reason in traces.
+func (p *Chan) wait(reason c.Int) {
+	thread.TraceBlock(reason)
+	p.cond.Wait(&p.mutex)
+	thread.TraceResume()
+}
+
 func notifyOps(p *Chan) {
 	for sop := p.sops; sop != nil; sop = sop.next {
 		sop.notify()
@@ -70,18 +99,35 @@ func notifyOps(p *Chan) {
 }
 
 func ChanClose(p *Chan) {
+	if p == nil {
+		panic(plainError("close of nil channel"))
+	}
 	p.mutex.Lock()
+	if p.close {
+		p.mutex.Unlock()
+		panic(plainError("close of closed channel"))
+	}
 	p.close = true
+	if RaceEnabled {
+		RaceRelease(unsafe.Pointer(p))
+	}
 	notifyOps(p)
 	p.mutex.Unlock()
 	p.cond.Broadcast()
 }
 
 func ChanTrySend(p *Chan, v unsafe.Pointer, eltSize int) bool {
+	if p == nil {
+		return false
+	}
 	n := p.cap
 	p.mutex.Lock()
+	if p.close {
+		p.mutex.Unlock()
+		panic(plainError("send on closed channel"))
+	}
 	if n == 0 {
-		if p.getp != chanHasRecv || p.close {
+		if p.getp != chanHasRecv {
 			p.mutex.Unlock()
 			return false
 		}
@@ -90,7 +136,7 @@ func ChanTrySend(p *Chan, v unsafe.Pointer, eltSize int) bool {
 		}
 		p.getp = chanNoSendRecv
 	} else {
-		if p.len == n || p.close {
+		if p.len == n {
 			p.mutex.Unlock()
 			return false
 		}
@@ -98,6 +144,7 @@ func ChanTrySend(p *Chan, v unsafe.Pointer, eltSize int) bool {
 		c.Memcpy(c.Advance(p.data, off*eltSize), v, uintptr(eltSize))
 		p.len++
 	}
+	p.raceSend(v, eltSize)
 	notifyOps(p)
 	p.mutex.Unlock()
 	p.cond.Broadcast()
@@ -105,34 +152,38 @@ func ChanTrySend(p *Chan, v unsafe.Pointer, eltSize int) bool {
 }
 
 func ChanSend(p *Chan, v unsafe.Pointer, eltSize int) bool {
+	if p == nil {
+		blockForever()
+	}
 	n := p.cap
 	p.mutex.Lock()
 	if n == 0 {
 		for p.getp != chanHasRecv && !p.close {
 			p.sends++
-			p.cond.Wait(&p.mutex)
+			p.wait(thread.TraceBlockSend)
 			p.sends--
 		}
 		if p.close {
 			p.mutex.Unlock()
-			return false
+			panic(plainError("send on closed channel"))
 		}
 		if p.data != nil {
 			c.Memcpy(p.data, v, uintptr(eltSize))
 		}
 		p.getp = chanNoSendRecv
 	} else {
-		for p.len == n {
-			p.cond.Wait(&p.mutex)
+		for p.len == n && !p.close {
+			p.wait(thread.TraceBlockSend)
 		}
 		if p.close {
 			p.mutex.Unlock()
-			return false
+			panic(plainError("send on closed channel"))
 		}
 		off := (p.getp + p.len) % n
 		c.Memcpy(c.Advance(p.data, off*eltSize), v, uintptr(eltSize))
 		p.len++
 	}
+	p.raceSend(v, eltSize)
 	notifyOps(p)
 	p.mutex.Unlock()
 	p.cond.Broadcast()
@@ -140,12 +191,18 @@ func ChanSend(p *Chan, v unsafe.Pointer, eltSize int) bool {
 }
 
 func ChanTryRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool, tryOK bool) {
+	if p == nil {
+		return
+	}
 	n := p.cap
 	p.mutex.Lock()
 	if n == 0 {
 		if p.sends == 0 || p.getp == chanHasRecv || p.close {
 			tryOK = p.close
 			p.mutex.Unlock()
+			if tryOK {
+				p.raceRecv(v, eltSize, false) // closed
+			}
 			return
 		}
 		p.getp = chanHasRecv
@@ -154,6 +211,9 @@ func ChanTryRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool, tryOK boo
 		if p.len == 0 {
 			tryOK = p.close
 			p.mutex.Unlock()
+			if tryOK {
+				p.raceRecv(v, eltSize, false) // closed
+			}
 			return
 		}
 		if v != nil {
@@ -168,7 +228,7 @@ func ChanTryRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool, tryOK boo
 	if n == 0 {
 		p.mutex.Lock()
 		for p.getp == chanHasRecv && !p.close {
-			p.cond.Wait(&p.mutex)
+			p.wait(thread.TraceBlockRecv)
 		}
 		recvOK = !p.close
 		tryOK = recvOK
@@ -176,18 +236,23 @@ func ChanTryRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool, tryOK boo
 	} else {
 		recvOK, tryOK = true, true
 	}
+	p.raceRecv(v, eltSize, recvOK)
 	return
 }
 
 func ChanRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool) {
+	if p == nil {
+		blockForever()
+	}
 	n := p.cap
 	p.mutex.Lock()
 	if n == 0 {
 		for p.getp == chanHasRecv && !p.close {
-			p.cond.Wait(&p.mutex)
+			p.wait(thread.TraceBlockRecv)
 		}
 		if p.close {
 			p.mutex.Unlock()
+			p.raceRecv(v, eltSize, false)
 			return false
 		}
 		p.getp = chanHasRecv
@@ -196,9 +261,10 @@ func ChanRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool) {
 		for p.len == 0 {
 			if p.close {
 				p.mutex.Unlock()
+				p.raceRecv(v, eltSize, false)
 				return false
 			}
-			p.cond.Wait(&p.mutex)
+			p.wait(thread.TraceBlockRecv)
 		}
 		if v != nil {
 			c.Memcpy(v, c.Advance(p.data, p.getp*eltSize), uintptr(eltSize))
@@ -212,16 +278,37 @@ func ChanRecv(p *Chan, v unsafe.Pointer, eltSize int) (recvOK bool) {
 	if n == 0 {
 		p.mutex.Lock()
 		for p.getp == chanHasRecv && !p.close {
-			p.cond.Wait(&p.mutex)
+			p.wait(thread.TraceBlockRecv)
 		}
 		recvOK = !p.close
 		p.mutex.Unlock()
 	} else {
 		recvOK = true
 	}
+	p.raceRecv(v, eltSize, recvOK)
 	return
 }
 
+// raceSend annotates a send for the race detector: v is read, and the
+// accesses before it happen before the ones after the receive.
+func (p *Chan) raceSend(v unsafe.Pointer, eltSize int) {
+	if RaceEnabled {
+		RaceReadRange(v, uintptr(eltSize))
+		RaceRelease(unsafe.Pointer(p))
+	}
+}
+
+// raceRecv annotates a receive, or a failed try, for the race detector: v is
+// written if a value is received.
+func (p *Chan) raceRecv(v unsafe.Pointer, eltSize int, recvOK bool) {
+	if RaceEnabled {
+		RaceAcquire(unsafe.Pointer(p))
+		if recvOK && v != nil {
+			RaceWriteRange(v, uintptr(eltSize))
+		}
+	}
+}
+
 // -----------------------------------------------------------------------------
 
 type selectOp struct {
@@ -249,7 +336,9 @@ func (p *selectOp) notify() {
 func (p *selectOp) wait() {
 	p.mutex.Lock()
 	if !p.sem {
+		thread.TraceBlock(thread.TraceBlockSelect)
 		p.cond.Wait(&p.mutex)
+		thread.TraceResume()
 	}
 	p.sem = false
 	p.mutex.Unlock()
@@ -265,9 +354,17 @@ type ChanOp struct {
 	Send bool
 }
 
-// TrySelect executes a non-blocking select operation.
+// TrySelect executes a non-blocking select operation. Operations are tried
+// from a random one, so that a ready operation isn't always preferred to the
+// next ones. Operations on nil channels are never ready.
 func TrySelect(ops ...ChanOp) (isel int, recvOK, tryOK bool) {
-	for isel = range ops {
+	n := len(ops)
+	if n == 0 {
+		return
+	}
+	start := int(fastrand() % uint32(n))
+	for i := 0; i < n; i++ {
+		isel = (start + i) % n
 		op := ops[isel]
 		if op.Send {
 			if tryOK = ChanTrySend(op.C, op.Val, int(op.Size)); tryOK {
@@ -303,6 +400,9 @@ func Select(ops ...ChanOp) (isel int, recvOK bool) {
 }
 
 func prepareSelect(c *Chan, selOp *selectOp) {
+	if c == nil {
+		return
+	}
 	c.mutex.Lock()
 	selOp.next = c.sops
 	c.sops = selOp
@@ -310,6 +410,9 @@ func prepareSelect(c *Chan, selOp *selectOp) {
 }
 
 func endSelect(c *Chan, selOp *selectOp) {
+	if c == nil {
+		return
+	}
 	c.mutex.Lock()
 	pp := &c.sops
 	for *pp != selOp {
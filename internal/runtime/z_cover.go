@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/sync/atomic"
+)
+
+// -----------------------------------------------------------------------------
+
+// The packages compiled with coverage counters (llgo test -cover) count the
+// runs of their blocks in arrays of uint32, which a constructor of each
+// package registers before main, with the blocks counted: a line per counter,
+// "file:line.col,line.col stmts", the position and the number of statements
+// of the block like in a Go coverage profile.
+
+// coverUnit is the coverage data of a package. Units are allocated with
+// malloc, as the heap isn't initialized yet by the constructors.
+type coverUnit struct {
+	next     *coverUnit
+	blocks   string
+	counters *uint32
+	n        int
+}
+
+var (
+	coverMode  string
+	coverUnits *coverUnit
+	coverLast  *coverUnit
+)
+
+// CoverRegister registers the n counters of the blocks of a package, with
+// mode, the mode of the counters: set, count or atomic. It's called by the
+// constructor of the package, before main.
+func CoverRegister(mode, blocks string, counters *uint32, n int) {
+	u := (*coverUnit)(c.Malloc(unsafe.Sizeof(coverUnit{})))
+	*u = coverUnit{blocks: blocks, counters: counters, n: n}
+	if coverLast == nil {
+		coverUnits = u
+	} else {
+		coverLast.next = u
+	}
+	coverLast = u
+	coverMode = mode
+}
+
+// CoverMode returns the mode of the coverage counters, or "" if no package
+// is covered.
+func CoverMode() string {
+	return coverMode
+}
+
+// CoverBlocks calls f for each block counted, in the order of registration,
+// with its position, number of statements and count.
+func CoverBlocks(f func(pos string, stmts int, count uint32)) {
+	for u := coverUnits; u != nil; u = u.next {
+		counters := unsafe.Slice(u.counters, u.n)
+		blocks := u.blocks
+		for i := range counters {
+			line := blocks
+			if j := indexByte(blocks, '\n'); j >= 0 {
+				line, blocks = blocks[:j], blocks[j+1:]
+			} else {
+				blocks = ""
+			}
+			pos, stmts := line, uint64(0)
+			if j := lastIndexByte(line, ' '); j >= 0 {
+				pos = line[:j]
+				stmts, _ = parseUint(line[j+1:])
+			}
+			f(pos, int(stmts), atomic.Load(&counters[i]))
+		}
+	}
+}
+
+func indexByte(s string, b byte) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == b {
+			return i
+		}
+	}
+	return -1
+}
+
+func lastIndexByte(s string, b byte) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == b {
+			return i
+		}
+	}
+	return -1
+}
+
+// -----------------------------------------------------------------------------
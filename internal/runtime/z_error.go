@@ -31,27 +31,84 @@ func (e errorString) Error() string {
 	return "runtime error: " + string(e)
 }
 
+// A plainError is a runtime error whose message has no "runtime error: "
+// prefix, like the one of a write to a nil map.
 type plainError string
 
+func (e plainError) RuntimeError() {}
+
 func (e plainError) Error() string {
 	return string(e)
 }
 
 func AssertRuntimeError(b bool, msg string) {
 	if b {
-		panic(errorString(msg).Error())
+		panic(errorString(msg))
 	}
 }
 
 func AssertNegativeShift(b bool) {
 	if b {
-		panic(errorString("negative shift amount").Error())
+		panic(errorString("negative shift amount"))
+	}
+}
+
+func AssertDivideByZero(b bool) {
+	if b {
+		panic(errorString("integer divide by zero"))
 	}
 }
 
 func AssertIndexRange(b bool) {
 	if b {
-		panic(errorString("index out of range").Error())
+		panic(errorString("index out of range"))
+	}
+}
+
+// A TypeAssertionError explains a failed type assertion. The static type of
+// the interface isn't known, so it is called "interface".
+type TypeAssertionError struct {
+	concrete      *abi.Type
+	asserted      *abi.Type
+	missingMethod string // one method needed by asserted, missing from concrete
+}
+
+func (*TypeAssertionError) RuntimeError() {}
+
+func (e *TypeAssertionError) Error() string {
+	as := e.asserted.String()
+	if e.concrete == nil {
+		return "interface conversion: interface is nil, not " + as
+	}
+	cs := e.concrete.String()
+	if e.missingMethod == "" {
+		return "interface conversion: interface is " + cs + ", not " + as
+	}
+	return "interface conversion: " + cs + " is not " + as +
+		": missing method " + e.missingMethod
+}
+
+// PanicTypeAssert panics on the failed type assertion of an interface value,
+// whose dynamic type is concrete, to the type asserted.
+func PanicTypeAssert(concrete, asserted *abi.Type) {
+	var missing string
+	if concrete != nil && asserted.Kind() == abi.Interface {
+		missing = missingMethod(asserted, concrete)
+	}
+	panic(&TypeAssertionError{concrete, asserted, missing})
+}
+
+// AssertNilWrap panics if b is true, on calling the value method typ.meth by
+// a wrapper of the method set of *typ with a nil pointer.
+func AssertNilWrap(b bool, typ, meth string) {
+	if b {
+		name := typ
+		for i := 0; i < len(typ) && typ[i] != '['; i++ {
+			if typ[i] == '.' {
+				name = typ[i+1:]
+			}
+		}
+		panic(plainError("value method " + typ + "." + meth + " called using nil *" + name + " pointer"))
 	}
 }
 
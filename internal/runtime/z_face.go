@@ -126,6 +126,19 @@ func pkgName(path string) string {
 	return path[i+1:]
 }
 
+// shortName returns the name qualified by the package path in the form
+// qualified by the package name: github.com/x/y.T[a/b.E] => y.T[a/b.E].
+func shortName(name string) string {
+	n := len(name)
+	for i := 0; i < n; i++ {
+		if name[i] == '[' {
+			n = i
+			break
+		}
+	}
+	return name[lastSlash(name[:n])+1:]
+}
+
 // InitNamed initializes an uninitialized named type.
 func InitNamed(ret *Type, pkgPath, name string, underlying *Type, methods, ptrMethods []Method) {
 	ptr := ret.PtrToThis_
@@ -135,7 +148,7 @@ func InitNamed(ret *Type, pkgPath, name string, underlying *Type, methods, ptrMe
 	doInitNamed(ret, pkgPath, name, underlying, methods)
 	doInitNamed(ptr, pkgPath, name, newPointer(ret), ptrMethods)
 	ret.PtrToThis_ = ptr
-	ptr.TFlag |= abi.TFlagExtraStar
+	ptr.TFlag = ptr.TFlag&^abi.TFlagNamed | abi.TFlagExtraStar // *T has no name
 }
 
 func newUninitedNamed(kind abi.Kind, methods int) *Type {
@@ -184,8 +197,9 @@ func doInitNamed(ret *Type, pkgPath, fullName string, underlying *Type, methods
 	copy(unsafe.Slice(data, n), methods)
 }
 
-// Func returns a function type.
-func Func(in, out []*Type, variadic bool) *FuncType {
+// Func returns a function type. call and makefunc are its reflect thunks
+// (see abi.FuncType).
+func Func(in, out []*Type, variadic bool, call, makefunc unsafe.Pointer) *FuncType {
 	ret := &FuncType{
 		Type: Type{
 			Size_:       unsafe.Sizeof(uintptr(0)),
@@ -193,10 +207,12 @@ func Func(in, out []*Type, variadic bool) *FuncType {
 			Align_:      uint8(pointerAlign),
 			FieldAlign_: uint8(pointerAlign),
 			Kind_:       uint8(abi.Func),
-			Str_:        "func(...)",
+			Str_:        "func" + funcStr(in, out, variadic),
 		},
-		In:  in,
-		Out: out,
+		In:       in,
+		Out:      out,
+		Call:     call,
+		MakeFunc: makefunc,
 	}
 	if variadic {
 		ret.TFlag |= abi.TFlagVariadic
@@ -204,6 +220,13 @@ func Func(in, out []*Type, variadic bool) *FuncType {
 	return ret
 }
 
+// Closure turns the function type ft into the type of Go func values, which
+// are closures {fn, ctx} in llgo.
+func Closure(ft *FuncType) *FuncType {
+	ft.Size_ = 2 * unsafe.Sizeof(uintptr(0))
+	return ft
+}
+
 // Interface returns an interface type.
 // Don't call NewNamed for named interface type.
 func Interface(pkgPath, name string, methods []Imethod) *InterfaceType {
@@ -214,11 +237,17 @@ func Interface(pkgPath, name string, methods []Imethod) *InterfaceType {
 			Align_:      uint8(pointerAlign),
 			FieldAlign_: uint8(pointerAlign),
 			Kind_:       uint8(abi.Interface),
-			Str_:        name,
 		},
 		PkgPath_: pkgPath,
 		Methods:  methods,
 	}
+	if name == "" {
+		ret.Str_ = interfaceStr(methods)
+	} else {
+		// name is qualified by the package path, like github.com/x/y.T.
+		ret.TFlag = abi.TFlagNamed
+		ret.Str_ = shortName(name)
+	}
 	if len(methods) == 0 {
 		ret.Equal = nilinterequal
 	} else {
@@ -248,8 +277,8 @@ func NewItab(inter *InterfaceType, typ *Type) *Itab {
 		data := (*uintptr)(c.Advance(ptr, int(itabHdrSize)))
 		mthds := methods(u, inter.PkgPath_)
 		for i, m := range inter.Methods {
-			fn := findMethod(mthds, m)
-			if fn == nil {
+			fn, ok := findMethod(mthds, m)
+			if !ok {
 				ret.fun[0] = 0
 				break
 			}
@@ -259,18 +288,20 @@ func NewItab(inter *InterfaceType, typ *Type) *Itab {
 	return ret
 }
 
-func findMethod(mthds []abi.Method, im abi.Imethod) abi.Text {
+// findMethod returns the method im of mthds. Its fn is nil if the method is
+// never called (see cl.LiveMethods), but the type still implements im.
+func findMethod(mthds []abi.Method, im abi.Imethod) (fn abi.Text, ok bool) {
 	imName := im.Name_
 	for _, m := range mthds {
 		mName := m.Name_
 		if mName >= imName {
 			if mName == imName && m.Mtyp_ == im.Typ_ {
-				return m.Ifn_
+				return m.Ifn_, true
 			}
 			break
 		}
 	}
-	return nil
+	return nil, false
 }
 
 func methods(u *abi.UncommonType, from string) []abi.Method {
@@ -289,7 +320,7 @@ func IfaceType(i iface) *abi.Type {
 
 func IfacePtrData(i iface) unsafe.Pointer {
 	if i.tab == nil {
-		panic(errorString("invalid memory address or nil pointer dereference").Error())
+		panic(errorString("invalid memory address or nil pointer dereference"))
 	}
 	switch i.tab._type.Kind() {
 	case abi.Bool, abi.Int, abi.Int8, abi.Int16, abi.Int32, abi.Int64,
@@ -361,6 +392,39 @@ func Implements(T, V *abi.Type) bool {
 	return false
 }
 
+// missingMethod returns the name of the first method of the interface type T
+// which the type V doesn't have, like Implements does the check.
+func missingMethod(T, V *abi.Type) string {
+	t := (*abi.InterfaceType)(unsafe.Pointer(T))
+	for i := range t.Methods {
+		tm := &t.Methods[i]
+		if !hasMethod(V, tm) {
+			return tm.Name()
+		}
+	}
+	return ""
+}
+
+func hasMethod(V *abi.Type, tm *abi.Imethod) bool {
+	if V.Kind() == abi.Interface {
+		v := (*abi.InterfaceType)(unsafe.Pointer(V))
+		for j := range v.Methods {
+			if vm := &v.Methods[j]; vm.Name_ == tm.Name_ && vm.Typ_ == tm.Typ_ {
+				return true
+			}
+		}
+		return false
+	}
+	if v := V.Uncommon(); v != nil {
+		for _, vm := range v.Methods() {
+			if vm.Name_ == tm.Name_ && vm.Mtyp_ == tm.Typ_ {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func EfaceEqual(v, u eface) bool {
 	if v._type == nil || u._type == nil {
 		return v._type == u._type
@@ -374,7 +438,7 @@ func EfaceEqual(v, u eface) bool {
 	if equal := v._type.Equal; equal != nil {
 		return equal(v.data, u.data)
 	}
-	panic(errorString("comparing uncomparable type " + v._type.String()).Error())
+	panic(errorString("comparing uncomparable type " + v._type.String()))
 }
 
 func (v eface) Kind() abi.Kind {
@@ -24,15 +24,449 @@ import (
 
 	"github.com/goplus/llgo/c"
 	"github.com/goplus/llgo/c/bdwgc"
+	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/c/time"
+	"github.com/goplus/llgo/internal/runtime/thread"
 )
 
+// The collector is bdwgc: it scans stacks, and the objects not allocated as
+// noscan, conservatively. TODO: a precise collector of llgo, with size
+// classes and pointer bitmaps from type metadata, stacks scanned only by
+// their stack maps, and a concurrent mark phase.
+
 // AllocU allocates uninitialized memory.
 func AllocU(size uintptr) unsafe.Pointer {
-	return bdwgc.Malloc(size)
+	checkAlloc(size)
+	ret := bdwgc.Malloc(size + asanRedzone)
+	if AsanEnabled {
+		asanAlloc(ret, size)
+	}
+	if MsanEnabled {
+		msanMalloc(ret, size)
+	}
+	memProfileAlloc(ret, size)
+	return ret
 }
 
 // AllocZ allocates zero-initialized memory.
 func AllocZ(size uintptr) unsafe.Pointer {
-	ret := bdwgc.Malloc(size)
+	checkAlloc(size)
+	ret := bdwgc.Malloc(size + asanRedzone)
+	if AsanEnabled {
+		asanAlloc(ret, size)
+	}
+	c.Memset(ret, 0, size)
+	if MsanEnabled {
+		msanUnpoison(ret, size)
+	}
+	memProfileAlloc(ret, size)
+	return ret
+}
+
+// AllocUNoscan allocates uninitialized memory which holds no pointers: the
+// collector doesn't scan it, so that integers and bytes in it never retain
+// other objects.
+func AllocUNoscan(size uintptr) unsafe.Pointer {
+	checkAlloc(size)
+	return bdwgc.MallocAtomic(size)
+}
+
+// AllocZNoscan allocates zero-initialized memory which holds no pointers.
+func AllocZNoscan(size uintptr) unsafe.Pointer {
+	checkAlloc(size)
+	ret := bdwgc.MallocAtomic(size)
 	return c.Memset(ret, 0, size)
 }
+
+// allocRoot allocates zero-initialized memory which the collector scans but
+// never frees, for the runtime data only reachable from memory it doesn't
+// scan, like the G of threads. freeRoot frees it.
+func allocRoot(size uintptr) unsafe.Pointer {
+	return bdwgc.MallocUncollectable(size)
+}
+
+func freeRoot(p unsafe.Pointer) {
+	bdwgc.Free(p)
+}
+
+// -----------------------------------------------------------------------------
+
+// Finalizers are called by bdwgc on demand: it notifies the finalizer
+// goroutine when some are ready, like Go runs them in their own goroutine,
+// not in the goroutine which happens to allocate.
+
+var (
+	finMutex   sync.Mutex
+	finCond    sync.Cond
+	finReady   bool
+	finStarted bool
+)
+
+func init() {
+	bdwgc.Init() // before GOMEMLIMIT sets the maximum heap size
+	bdwgc.AllowRegisterThreads()
+	bdwgc.SetOnCollectionEvent(gcEvent)
+	pushOtherRoots = bdwgc.GetPushOtherRoots()
+	bdwgc.SetPushOtherRoots(pushRoots)
+	thread.SetRootChainHook(rootChainHook)
+	rootChainHook(thread.RootChain(), 1) // the main thread
+	finMutex.Init(nil)
+	finCond.Init(nil)
+}
+
+// registerThread registers the current thread, not created by llgo, to the
+// collector, so that its stack is scanned. It reports false if the thread is
+// registered already, like by the C code which created it.
+func registerThread() bool {
+	var sb bdwgc.StackBase
+	if bdwgc.GetStackBase(&sb) != bdwgc.SUCCESS {
+		return false
+	}
+	return bdwgc.RegisterMyThread(&sb) == bdwgc.SUCCESS
+}
+
+// unregisterThread unregisters the current thread registered by
+// registerThread.
+func unregisterThread() {
+	bdwgc.UnregisterMyThread()
+}
+
+// -----------------------------------------------------------------------------
+
+// The shadow stacks of the threads (see ScanStack) are roots of the collector:
+// their chains are in a list updated with the allocation lock, which bdwgc
+// holds while it marks, and pushRoots pushes their pointers.
+
+// rootChain is the root chain of a thread, in the list of rootChains. It's
+// allocated by malloc, as the collector can't allocate with its lock held.
+type rootChain struct {
+	chain **stackEntry
+	next  *rootChain
+}
+
+var (
+	rootChains     *rootChain
+	pushOtherRoots bdwgc.PushOtherRootsProc // the hook of bdwgc before pushRoots
+)
+
+func rootChainHook(chain *c.Pointer, add c.Int) {
+	if add != 0 {
+		r := (*rootChain)(c.Malloc(unsafe.Sizeof(rootChain{})))
+		r.chain = (**stackEntry)(unsafe.Pointer(chain))
+		bdwgc.CallWithAllocLock(addRootChain, unsafe.Pointer(r))
+	} else if r := bdwgc.CallWithAllocLock(removeRootChain, unsafe.Pointer(chain)); r != nil {
+		c.Free(r)
+	}
+}
+
+func addRootChain(r c.Pointer) c.Pointer {
+	(*rootChain)(r).next = rootChains
+	rootChains = (*rootChain)(r)
+	return nil
+}
+
+func removeRootChain(chain c.Pointer) c.Pointer {
+	for p := &rootChains; *p != nil; p = &(*p).next {
+		if r := *p; unsafe.Pointer(r.chain) == chain {
+			*p = r.next
+			return unsafe.Pointer(r)
+		}
+	}
+	return nil
+}
+
+// pushRoots pushes the pointers of the shadow stacks of all threads, with the
+// world stopped, after the roots pushed by the previous hook, like the thread
+// stacks.
+func pushRoots() {
+	if pushOtherRoots != nil {
+		pushOtherRoots()
+	}
+	for r := rootChains; r != nil; r = r.next {
+		scanChain(*r.chain, pushRoot)
+	}
+}
+
+func pushRoot(slot *unsafe.Pointer) {
+	bdwgc.PushAll(unsafe.Pointer(slot), unsafe.Add(unsafe.Pointer(slot), unsafe.Sizeof(*slot)))
+}
+
+// -----------------------------------------------------------------------------
+
+// finalizer is the client data of the finalizers registered to bdwgc.
+type finalizer struct {
+	fn func(obj unsafe.Pointer)
+}
+
+// setFinalizer sets fn as the finalizer of the object at p, or removes its
+// finalizer if fn is nil. It reports false, and keeps the finalizer, if the
+// object already has one.
+func setFinalizer(p unsafe.Pointer, fn func(obj unsafe.Pointer)) bool {
+	if fn == nil {
+		bdwgc.RegisterFinalizer(p, nil, nil, nil, nil)
+		return true
+	}
+	finMutex.Lock()
+	if !finStarted {
+		finStarted = true
+		bdwgc.SetFinalizeOnDemand(1)
+		bdwgc.SetFinalizerNotifier(notifyFinalizers)
+		go runFinalizers()
+	}
+	finMutex.Unlock()
+	f := (*finalizer)(AllocU(unsafe.Sizeof(finalizer{})))
+	f.fn = fn
+	var oldFn func(c.Pointer, c.Pointer)
+	var oldCd c.Pointer
+	bdwgc.RegisterFinalizer(p, callFinalizer, unsafe.Pointer(f), &oldFn, &oldCd)
+	if oldCd != nil { // put the finalizer back
+		bdwgc.RegisterFinalizer(p, oldFn, oldCd, nil, nil)
+		return false
+	}
+	return true
+}
+
+func callFinalizer(obj, cd c.Pointer) {
+	(*finalizer)(cd).fn(obj)
+}
+
+func notifyFinalizers() {
+	finMutex.Lock()
+	finReady = true
+	finMutex.Unlock()
+	finCond.Signal()
+}
+
+func runFinalizers() {
+	for {
+		finMutex.Lock()
+		for !finReady {
+			thread.TraceBlock(thread.TraceBlockCond)
+			finCond.Wait(&finMutex)
+			thread.TraceResume()
+		}
+		finReady = false
+		finMutex.Unlock()
+		bdwgc.InvokeFinalizers()
+	}
+}
+
+// weakLink registers the hidden pointer at link to be cleared when the object
+// at p becomes unreachable.
+func weakLink(link *uintptr, p unsafe.Pointer) {
+	bdwgc.GeneralRegisterDisappearingLink((*c.Pointer)(unsafe.Pointer(link)), bdwgc.Base(p))
+}
+
+// weakLoad returns the pointer hidden at link, or nil if it's cleared. The
+// collector may clear it concurrently, so it's read with the allocation lock.
+func weakLoad(link *uintptr) unsafe.Pointer {
+	return bdwgc.CallWithAllocLock(revealLink, unsafe.Pointer(link))
+}
+
+func revealLink(link c.Pointer) c.Pointer {
+	v := ^*(*uintptr)(link)
+	if v == ^uintptr(0) { // cleared
+		return nil
+	}
+	return *(*unsafe.Pointer)(unsafe.Pointer(&v))
+}
+
+// -----------------------------------------------------------------------------
+
+// GOGC sets the free space divisor of bdwgc: it collects after allocating
+// about heap size / divisor bytes, so GOGC=100 lets the heap double between
+// collections, like Go. Percentages above 100 collect like 100. GOMEMLIMIT
+// sets the maximum heap size: bdwgc collects rather than grow the heap beyond
+// it, and if the live heap doesn't fit, outOfMemory raises the maximum, so
+// that the limit is soft. It has no effect with GOGC=off.
+
+var (
+	gcDisabled  bool    // bdwgc.Disable was called, by GOGC=off
+	heapLimit   uintptr // maximum heap size, 0 means no limit
+	numForcedGC uint32
+
+	// statistics updated by gcEvent, with the allocation lock held
+	gcStart     int64
+	gcStartHeap uintptr // heap in use when the collection started
+	gcStats     GCStats
+)
+
+// gcSetPercent applies GOGC. gcMutex must be held.
+func gcSetPercent(percent int) {
+	if percent < 0 {
+		if !gcDisabled {
+			gcDisabled = true
+			bdwgc.Disable()
+		}
+		return
+	}
+	if gcDisabled {
+		gcDisabled = false
+		bdwgc.Enable()
+	}
+	divisor := 100
+	if percent > 0 {
+		divisor = 100 / percent
+	}
+	if divisor < 1 {
+		divisor = 1
+	}
+	bdwgc.SetFreeSpaceDivisor(uintptr(divisor))
+}
+
+// gcSetLimit applies GOMEMLIMIT. gcMutex must be held.
+func gcSetLimit(limit int64) {
+	switch {
+	case limit == maxMemoryLimit || uint64(limit) > uint64(^uintptr(0)):
+		heapLimit = 0
+	case limit == 0:
+		heapLimit = 1 // collect as often as possible
+	default:
+		heapLimit = uintptr(limit)
+	}
+	bdwgc.SetMaxHeapSize(heapLimit)
+	bdwgc.SetOomFn(outOfMemory)
+}
+
+// outOfMemory is called by bdwgc when it fails to allocate size bytes. If
+// the heap is at the memory limit, it raises the limit and allocates again.
+func outOfMemory(size uintptr) c.Pointer {
+	gcMutex.Lock()
+	heap := bdwgc.GetHeapSize()
+	raise := heapLimit != 0 && heap+size > heapLimit
+	if raise {
+		heapLimit = heap + size + heap/8
+		bdwgc.SetMaxHeapSize(heapLimit)
+	}
+	gcMutex.Unlock()
+	if !raise {
+		return nil
+	}
+	return bdwgc.Malloc(size)
+}
+
+// GC runs a full collection, even if GOGC=off, and blocks until it's done.
+func GC() {
+	gcCollect(false)
+}
+
+// FreeOSMemory runs a full collection and returns as much memory as possible
+// to the system.
+func FreeOSMemory() {
+	gcCollect(true)
+}
+
+func gcCollect(unmap bool) {
+	gcMutex.Lock()
+	numForcedGC++
+	if gcDisabled {
+		bdwgc.Enable()
+	}
+	if unmap {
+		bdwgc.GcollectAndUnmap()
+	} else {
+		bdwgc.Gcollect()
+	}
+	if gcDisabled {
+		bdwgc.Disable()
+	}
+	gcMutex.Unlock()
+	cleanupPools()
+}
+
+// gcEvent records the collections, and has the caches of pools cleared after
+// them. bdwgc stops the world from their start to their end, unless it
+// collects incrementally. Traces get the phases with the world running.
+func gcEvent(ev bdwgc.EventType) {
+	switch ev {
+	case bdwgc.EVENT_START:
+		gcStart = nanotime()
+		if debug.gctrace > 0 {
+			gcStartHeap = heapInUse(nil)
+		}
+		thread.TraceGC(thread.TraceGCStart)
+	case bdwgc.EVENT_PRE_STOP_WORLD:
+		thread.TraceGC(thread.TraceSTWStart)
+	case bdwgc.EVENT_POST_START_WORLD:
+		thread.TraceGC(thread.TraceSTWDone)
+	case bdwgc.EVENT_END:
+		thread.TraceGC(thread.TraceGCDone)
+		pause := uint64(nanotime() - gcStart)
+		end := uint64(walltime())
+		i := gcStats.NumGC % uint32(len(gcStats.PauseNs))
+		gcStats.PauseNs[i] = pause
+		gcStats.PauseEnd[i] = end
+		gcStats.PauseTotalNs += pause
+		gcStats.PauseHist.record(int64(pause))
+		gcStats.LastGC = end
+		gcStats.NumGC++
+		if debug.gctrace > 0 {
+			gcTrace(pause)
+		}
+		notifyPoolCleanup()
+	}
+}
+
+// heapInUse returns the heap in use, and stores the statistics of bdwgc into
+// s if it's not nil. The allocation lock must be held.
+func heapInUse(s *bdwgc.ProfStats) uintptr {
+	var st bdwgc.ProfStats
+	if s == nil {
+		s = &st
+	}
+	bdwgc.GetProfStatsUnsafe(s, unsafe.Sizeof(*s))
+	return s.HeapsizeFull - s.FreeBytesFull
+}
+
+// gcTrace prints the line of GODEBUG=gctrace=1 at the end of a collection,
+// like Go's: its number, when it started since the program did, its pause,
+// the heap in use before and after, and the goal of the next one. It must
+// not allocate, as the allocation lock is held.
+func gcTrace(pause uint64) {
+	var s bdwgc.ProfStats
+	heap := heapInUse(&s)
+	goal := heap + s.HeapsizeFull/bdwgc.GetFreeSpaceDivisor()
+	print("gc ", gcStats.NumGC, " @")
+	printFixed3((gcStart - runtimeInitTime) / 1e6)
+	print("s: ")
+	printFixed3(int64(pause) / 1e3)
+	print(" ms clock, ", gcStartHeap>>20, "->", heap>>20, " MB, ", goal>>20, " MB goal, ",
+		NumProcs(), " P\n")
+}
+
+// printFixed3 prints v/1000 with 3 decimals.
+func printFixed3(v int64) {
+	print(v/1000, ".", v/100%10, v/10%10, v%10)
+}
+
+// ReadGCStats stores the statistics of the collector into s.
+func ReadGCStats(s *GCStats) {
+	var heap, free, unmapped, sinceGC uintptr
+	bdwgc.GetHeapUsageSafe(&heap, &free, &unmapped, &sinceGC, nil)
+	bdwgc.CallWithAllocLock(copyGCStats, unsafe.Pointer(s))
+	s.HeapAlloc = uint64(heap - free)
+	s.HeapSys = uint64(heap + unmapped)
+	s.HeapIdle = uint64(free + unmapped)
+	s.HeapReleased = uint64(unmapped)
+	s.Mallocs, s.TotalAlloc = readAllocStats()
+	s.HeapLive = uint64(heap - free - sinceGC)
+	gcMutex.Lock()
+	s.NumForcedGC = numForcedGC
+	s.EnableGC = true // even with GOGC=off, like Go
+	if !gcDisabled {
+		// live heap after the last collection, plus the growth
+		s.NextGC = s.HeapLive + uint64(heap/bdwgc.GetFreeSpaceDivisor())
+	}
+	gcMutex.Unlock()
+}
+
+func copyGCStats(s c.Pointer) c.Pointer {
+	*(*GCStats)(s) = gcStats
+	return nil
+}
+
+func walltime() int64 {
+	var ts time.Timespec
+	time.ClockGettime(time.CLOCK_REALTIME, &ts)
+	return int64(ts.Sec)*1e9 + int64(ts.Nsec)
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/pthread"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// GODEBUG configures the runtime and the standard library like in Go: it's a
+// comma-separated list of name=value settings, the last one of a name
+// winning, read when they're looked up. Parts of the runtime read theirs at
+// startup with RegisterDebugVar, and internal/godebug reads the others with
+// GODEBUG.
+//
+// The runtime reads:
+//
+//	gctrace=1        print a line to stderr at the end of each collection
+//	schedtrace=N     print the state of the goroutines to stderr every N ms
+//	madvdontneed=0|1 accepted, no effect: bdwgc returns memory its own way
+//	invalidptr=0|1   accepted, no effect: the collector is conservative, so
+//	                 bad pointers are never reported
+
+// runtimeInitTime is when the runtime started, for the times of the traces.
+var runtimeInitTime = nanotime()
+
+var debug struct {
+	gctrace      int32
+	schedtrace   int32
+	madvdontneed int32
+	invalidptr   int32
+}
+
+func init() {
+	debug.madvdontneed = 1
+	debug.invalidptr = 1
+	RegisterDebugVar("gctrace", &debug.gctrace)
+	RegisterDebugVar("schedtrace", &debug.schedtrace)
+	RegisterDebugVar("madvdontneed", &debug.madvdontneed)
+	RegisterDebugVar("invalidptr", &debug.invalidptr)
+	if debug.schedtrace > 0 {
+		startSchedTrace()
+	}
+}
+
+// GODEBUG returns the value of the GODEBUG setting name, and reports whether
+// it's set.
+func GODEBUG(name string) (string, bool) {
+	s := getenv(c.Str("GODEBUG"))
+	if s == nil {
+		return "", false
+	}
+	env := c.GoString(s)
+	value, found := "", false
+	for len(env) > 0 {
+		i := 0
+		for i < len(env) && env[i] != ',' {
+			i++
+		}
+		kv := env[:i]
+		if i < len(env) {
+			i++
+		}
+		env = env[i:]
+		if n := len(name); len(kv) > n && kv[n] == '=' && kv[:n] == name {
+			value, found = kv[n+1:], true
+		}
+	}
+	return value, found
+}
+
+// RegisterDebugVar sets *value to the GODEBUG setting name, if it's set to a
+// number. It's how the parts of the runtime read their settings.
+func RegisterDebugVar(name string, value *int32) {
+	if s, ok := GODEBUG(name); ok {
+		if v, ok := parseUint(s); ok && v <= 1<<31-1 {
+			*value = int32(v)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// The scheduler trace is printed by a thread of its own, which isn't a
+// goroutine, so that it isn't counted in the goroutines it reports.
+
+func startSchedTrace() {
+	var th pthread.Thread
+	pthread.Create(&th, nil, schedTrace, nil)
+}
+
+func schedTrace(c.Pointer) c.Pointer {
+	for {
+		for ms := debug.schedtrace; ms > 0; ms -= 500 { // usleep takes < 1s
+			if ms < 500 {
+				c.Usleep(c.Uint(ms) * 1000)
+			} else {
+				c.Usleep(500 * 1000)
+			}
+		}
+		n := thread.NumGoroutine()
+		t := thread.NumThreads()
+		print("SCHED ", (nanotime()-runtimeInitTime)/1e6, "ms: gomaxprocs=", NumProcs(),
+			" threads=", t, " idlethreads=", t-n, " goroutines=", n, "\n")
+	}
+}
+
+// -----------------------------------------------------------------------------
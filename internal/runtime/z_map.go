@@ -63,6 +63,14 @@ func MapDelete(t *maptype, h *hmap, key unsafe.Pointer) {
 	mapdelete(t, h, key)
 }
 
+// MapLen returns the number of entries in map h, which may be nil.
+func MapLen(h *hmap) int {
+	if h == nil {
+		return 0
+	}
+	return h.count
+}
+
 func MapClear(t *maptype, h *hmap) {
 	mapclear(t, h)
 }
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// TimeHistBuckets is the number of buckets of a TimeHist.
+const TimeHistBuckets = 40
+
+// TimeHist is a distribution of durations: bucket 0 counts the durations
+// under 1ns, bucket i those in [2^(i-1), 2^i) ns, and the last one also the
+// longer ones, from about 4.6 minutes.
+type TimeHist [TimeHistBuckets]uint64
+
+// record counts a duration of d ns. It must not allocate: pauses are
+// recorded with the allocation lock held.
+func (h *TimeHist) record(d int64) {
+	i := 0
+	for ; d > 0 && i < TimeHistBuckets-1; d >>= 1 {
+		i++
+	}
+	h[i]++
+}
+
+// ReadSchedLatencies stores the distribution of the delays before goroutines
+// start running into h. They are the only waits for a CPU the runtime sees,
+// the later ones are in the run queue of the OS.
+func ReadSchedLatencies(h *TimeHist) {
+	thread.SchedLatencies(&h[0], c.Int(len(h)))
+}
+
+// NumGoroutine returns the number of goroutines that currently exist.
+func NumGoroutine() int {
+	return int(thread.NumGoroutine())
+}
+
+// NumThreads returns the number of threads of goroutines.
+func NumThreads() int {
+	return int(thread.NumThreads())
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/internal/abi"
+)
+
+// -----------------------------------------------------------------------------
+
+// SetFinalizer implements runtime.SetFinalizer: the finalizer is called with
+// obj in its own goroutine, once obj is unreachable. Objects reachable from
+// obj stay alive until the finalizer has run, and so does obj if the
+// finalizer stores it somewhere.
+func SetFinalizer(obj any, finalizer any) {
+	e := unpackEface(obj)
+	etyp := e._type
+	if etyp == nil {
+		panic("runtime.SetFinalizer: first argument is nil")
+	}
+	if etyp.Kind() != abi.Pointer {
+		panic("runtime.SetFinalizer: first argument is " + etyp.String() + ", not pointer")
+	}
+	p := e.data
+	if p == nil {
+		return
+	}
+	f := unpackEface(finalizer)
+	ftyp := f._type
+	if ftyp == nil {
+		setFinalizer(p, nil)
+		return
+	}
+	if ftyp.Kind() != abi.Func {
+		panic("runtime.SetFinalizer: second argument is " + ftyp.String() + ", not a function")
+	}
+	ft := ftyp.FuncType()
+	if len(ft.In) != 1 || ft.Variadic() {
+		panic("runtime.SetFinalizer: cannot pass " + etyp.String() + " to finalizer " + ftyp.String())
+	}
+	fint := ft.In[0]
+	var iface bool
+	var tab *itab // itab of the argument if it's a non-empty interface
+	switch {
+	case fint == etyp:
+	case fint.Kind() == abi.Pointer && fint.Elem() == etyp.Elem() && (fint.Uncommon() == nil || etyp.Uncommon() == nil):
+	case fint.Kind() == abi.Interface && Implements(fint, etyp):
+		if ityp := fint.InterfaceType(); len(ityp.Methods) > 0 {
+			tab = NewItab(ityp, etyp)
+		}
+		iface = true
+	default:
+		panic("runtime.SetFinalizer: cannot pass " + etyp.String() + " to finalizer " + ftyp.String())
+	}
+	if ft.Call == nil {
+		panic("runtime.SetFinalizer: finalizer " + ftyp.String() + " has no call thunk")
+	}
+	fn := *(*closure)(f.data)
+	outs := ft.Out
+	ok := setFinalizer(p, func(obj unsafe.Pointer) {
+		var arg [2]unsafe.Pointer // obj, or the interface of obj
+		if !iface {
+			arg[0] = obj
+		} else if tab != nil {
+			arg[0], arg[1] = unsafe.Pointer(tab), obj
+		} else {
+			arg[0], arg[1] = unsafe.Pointer(etyp), obj
+		}
+		var size uintptr
+		for _, t := range outs {
+			size = (size+uintptr(t.Align_)-1)&^(uintptr(t.Align_)-1) + t.Size_
+		}
+		results := AllocZ(size + 1) // the thunk addresses it even if it's empty
+		callFunc(ft.Call, &fn, unsafe.Pointer(&arg), results)
+	})
+	if !ok {
+		panic("runtime.SetFinalizer: finalizer already set")
+	}
+}
+
+// closure is the representation of func values: fn is called with ctx as
+// its first argument.
+type closure struct {
+	fn  unsafe.Pointer
+	ctx unsafe.Pointer
+}
+
+// callFunc calls the closure fn through the call thunk of its type, which
+// reads the arguments from the frame args and stores the results into the
+// frame results (see abi.FuncType).
+func callFunc(call unsafe.Pointer, fn *closure, args, results unsafe.Pointer) {
+	thunk := closure{fn: call}
+	f := *(*func(fn, args, results unsafe.Pointer))(unsafe.Pointer(&thunk))
+	f(unsafe.Pointer(fn), args, results)
+}
+
+// -----------------------------------------------------------------------------
+
+// Weak pointers point to a weak handle, unique for each pointer: it holds the
+// pointer hidden from the collector, which clears it when the object becomes
+// unreachable, before its finalizer runs.
+
+type weakHandle struct {
+	link uintptr // ^ptr, or 0 once cleared
+	next *weakHandle
+}
+
+const weakBuckets = 1024
+
+var (
+	weakMutex   sync.Mutex
+	weakHandles [weakBuckets]*weakHandle
+)
+
+func init() {
+	weakMutex.Init(nil)
+}
+
+// RegisterWeakPointer returns the weak handle of p.
+func RegisterWeakPointer(p unsafe.Pointer) unsafe.Pointer {
+	hidden := ^uintptr(p)
+	bucket := &weakHandles[(uintptr(p)>>4)%weakBuckets]
+	weakMutex.Lock()
+	for h := bucket; *h != nil; {
+		switch link := weakLoad(&(*h).link); {
+		case link == p:
+			ret := unsafe.Pointer(*h)
+			weakMutex.Unlock()
+			return ret
+		case link == nil: // cleared: drop it
+			*h = (*h).next
+		default:
+			h = &(*h).next
+		}
+	}
+	h := (*weakHandle)(AllocZ(unsafe.Sizeof(weakHandle{})))
+	h.link = hidden
+	h.next = *bucket
+	*bucket = h
+	weakLink(&h.link, p)
+	weakMutex.Unlock()
+	return unsafe.Pointer(h)
+}
+
+// MakeStrongFromWeak returns the pointer of the weak handle h, or nil if its
+// object is unreachable.
+func MakeStrongFromWeak(h unsafe.Pointer) unsafe.Pointer {
+	return weakLoad(&(*weakHandle)(h).link)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/pthread/sync"
+)
+
+// -----------------------------------------------------------------------------
+
+// The collector is tuned like Go's: GOGC is the percentage the heap grows by
+// between collections ("off" disables them), and GOMEMLIMIT a soft limit of
+// the heap size, eg. "512MiB" ("off" means no limit). They are read at
+// startup, and changed by SetGCPercent and SetMemoryLimit.
+
+const maxMemoryLimit = 1<<63 - 1
+
+var (
+	gcMutex     sync.Mutex
+	gcPercent   = 100
+	memoryLimit = int64(maxMemoryLimit)
+)
+
+func init() {
+	gcMutex.Init(nil)
+	if s := getenv(c.Str("GOGC")); s != nil {
+		if v, ok := parseGCPercent(c.GoString(s)); ok {
+			gcPercent = v
+		}
+	}
+	if s := getenv(c.Str("GOMEMLIMIT")); s != nil {
+		if v, ok := parseByteCount(c.GoString(s)); ok {
+			memoryLimit = v
+		}
+	}
+	gcSetPercent(gcPercent)
+	gcSetLimit(memoryLimit)
+}
+
+// SetGCPercent sets the GOGC percentage and returns the previous setting. A
+// negative percentage disables the collector.
+func SetGCPercent(percent int) int {
+	if percent < 0 {
+		percent = -1
+	}
+	gcMutex.Lock()
+	old := gcPercent
+	gcPercent = percent
+	gcSetPercent(percent)
+	gcMutex.Unlock()
+	return old
+}
+
+// SetMemoryLimit sets the soft memory limit in bytes and returns the previous
+// setting. A negative limit only returns the current one.
+func SetMemoryLimit(limit int64) int64 {
+	gcMutex.Lock()
+	old := memoryLimit
+	if limit >= 0 {
+		memoryLimit = limit
+		gcSetLimit(limit)
+	}
+	gcMutex.Unlock()
+	return old
+}
+
+// GCPercent returns the GOGC percentage, negative if the collector is off.
+func GCPercent() int {
+	gcMutex.Lock()
+	percent := gcPercent
+	gcMutex.Unlock()
+	return percent
+}
+
+// GCStats are the statistics of the collector runtime.ReadMemStats reports.
+// Sizes are in bytes, times in nanoseconds.
+type GCStats struct {
+	HeapAlloc    uint64 // allocated heap objects, reachable or not yet freed
+	HeapSys      uint64 // heap obtained from the system
+	HeapIdle     uint64 // free heap, including the released one
+	HeapReleased uint64 // free heap returned to the system
+	TotalAlloc   uint64 // cumulative bytes of heap objects allocated
+	Mallocs      uint64 // cumulative count of heap objects allocated
+	NextGC       uint64 // target heap size of the next collection
+	HeapLive     uint64 // heap reachable at the end of the last collection
+	LastGC       uint64 // end of the last collection, since 1970
+	PauseTotalNs uint64
+	PauseNs      [256]uint64 // recent pauses, the last one at (NumGC+255)%256
+	PauseEnd     [256]uint64 // end of recent pauses, since 1970
+	PauseHist    TimeHist    // distribution of all the pauses
+	NumGC        uint32
+	NumForcedGC  uint32
+	EnableGC     bool // there is a collector
+}
+
+// parseGCPercent parses a GOGC value: a percentage, or "off".
+func parseGCPercent(s string) (int, bool) {
+	if s == "off" {
+		return -1, true
+	}
+	v, ok := parseUint(s)
+	if !ok || v > 1<<31-1 {
+		return 0, false
+	}
+	return int(v), true
+}
+
+// parseByteCount parses a GOMEMLIMIT value: a number of bytes, with an
+// optional unit B, KiB, MiB, GiB or TiB, or "off".
+func parseByteCount(s string) (int64, bool) {
+	if s == "off" {
+		return maxMemoryLimit, true
+	}
+	shift := 0
+	for i, unit := range [...]string{"B", "KiB", "MiB", "GiB", "TiB"} {
+		if n := len(s) - len(unit); n > 0 && s[n:] == unit {
+			s, shift = s[:n], i*10
+			break
+		}
+	}
+	v, ok := parseUint(s)
+	if !ok || v > maxMemoryLimit>>shift {
+		return 0, false
+	}
+	return int64(v << shift), true
+}
+
+func parseUint(s string) (uint64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	var v uint64
+	for i := 0; i < len(s); i++ {
+		d := s[i] - '0'
+		if d > 9 || v > (1<<64-1-uint64(d))/10 {
+			return 0, false
+		}
+		v = v*10 + uint64(d)
+	}
+	return v, true
+}
+
+// -----------------------------------------------------------------------------
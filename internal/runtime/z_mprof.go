@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/c/sync/atomic"
+	"github.com/goplus/llgo/internal/runtime/prof"
+)
+
+// -----------------------------------------------------------------------------
+
+// The heap profile samples about one allocation every MemProfileRate bytes,
+// and counts it in the bucket of its stack. A sampled object is remembered
+// by a hidden pointer, which the collector clears once it's unreachable:
+// its free is counted when the profile is read. Buckets and records are
+// allocated with malloc, so that sampling never allocates from the heap.
+
+// MemProfileRate controls the fraction of memory allocations that are
+// recorded and reported in the memory profile: one per MemProfileRate bytes
+// on average, 512 KiB by default. 0 disables the profile, 1 records every
+// allocation.
+var MemProfileRate int
+
+const (
+	memProfDepth    = 32
+	memBuckHashSize = 1 << 12
+)
+
+// memBucket counts the sampled allocations of a stack.
+type memBucket struct {
+	next       *memBucket // next bucket of the same hash entry
+	allnext    *memBucket // next bucket of memBuckets
+	hash       uintptr
+	nstk       int
+	stk        [memProfDepth]uintptr
+	allocs     uintptr
+	frees      uintptr
+	allocBytes uintptr
+	freeBytes  uintptr
+}
+
+// memRecord is a sampled object not known to be freed yet.
+type memRecord struct {
+	next *memRecord
+	b    *memBucket
+	size uintptr
+	link uintptr // hidden pointer to the object, cleared when it's freed
+}
+
+var (
+	memProfMutex sync.Mutex // never held while locking the allocator
+	memBuckHash  *[memBuckHashSize]*memBucket
+	memBuckets   *memBucket
+	memRecords   *memRecord
+)
+
+// Every allocation is counted, sampled or not: these are the cumulative
+// Mallocs and TotalAlloc of the memory statistics.
+var (
+	numMallocs  uint64
+	totalAllocs uint64
+)
+
+func init() {
+	memProfMutex.Init(nil)
+	n := unsafe.Sizeof(*memBuckHash)
+	memBuckHash = (*[memBuckHashSize]*memBucket)(c.Memset(c.Malloc(n), 0, n))
+	MemProfileRate = 512 * 1024 // sample from now on
+}
+
+// memProfileAlloc counts the allocation of size bytes at p, and samples it.
+//
+//go:noinline
+func memProfileAlloc(p unsafe.Pointer, size uintptr) {
+	atomic.Add(&numMallocs, 1)
+	atomic.Add(&totalAllocs, uint64(size))
+	rate := MemProfileRate
+	if rate <= 0 || prof.MemSample(c.Long(size), c.Long(rate)) == 0 {
+		return
+	}
+	stk := (*[memProfDepth]uintptr)(c.Alloca(unsafe.Sizeof([memProfDepth]uintptr{})))
+	n := prof.Callers(0, &stk[0], memProfDepth)
+	r := (*memRecord)(c.Malloc(unsafe.Sizeof(memRecord{})))
+	r.size = size
+	r.link = ^uintptr(p)
+	weakLink(&r.link, p)
+
+	memProfMutex.Lock()
+	b := memBucketOf(stk[:n])
+	b.allocs++
+	b.allocBytes += size
+	r.b = b
+	r.next = memRecords
+	memRecords = r
+	memProfMutex.Unlock()
+}
+
+// readAllocStats returns the number of heap objects allocated since the
+// program started, and their total size in bytes.
+func readAllocStats() (mallocs, bytes uint64) {
+	return atomic.Load(&numMallocs), atomic.Load(&totalAllocs)
+}
+
+// memBucketOf returns the bucket of stk, creating it if needed. memProfMutex
+// must be held.
+func memBucketOf(stk []uintptr) *memBucket {
+	var h uintptr
+	for _, pc := range stk {
+		h += pc
+		h += h << 10
+		h ^= h >> 6
+	}
+	h += h << 3
+	h ^= h >> 11
+
+	i := h % memBuckHashSize
+	for b := memBuckHash[i]; b != nil; b = b.next {
+		if b.hash == h && b.nstk == len(stk) && equalStack(b.stk[:b.nstk], stk) {
+			return b
+		}
+	}
+	n := unsafe.Sizeof(memBucket{})
+	b := (*memBucket)(c.Memset(c.Malloc(n), 0, n))
+	b.hash = h
+	b.nstk = copy(b.stk[:], stk)
+	b.next = memBuckHash[i]
+	memBuckHash[i] = b
+	b.allnext = memBuckets
+	memBuckets = b
+	return b
+}
+
+func equalStack(a, b []uintptr) bool {
+	for i, pc := range a {
+		if b[i] != pc {
+			return false
+		}
+	}
+	return true
+}
+
+// memProfFlush counts the frees of the sampled objects the collector has
+// freed. memProfMutex must be held.
+func memProfFlush() {
+	prev := &memRecords
+	for r := *prev; r != nil; r = *prev {
+		if weakLoad(&r.link) != nil {
+			prev = &r.next
+			continue
+		}
+		r.b.frees++
+		r.b.freeBytes += r.size
+		*prev = r.next
+		c.Free(unsafe.Pointer(r))
+	}
+}
+
+// A MemProfileRecord describes the live objects allocated by a particular
+// call sequence (stack trace).
+type MemProfileRecord struct {
+	AllocBytes, FreeBytes     int64                 // number of bytes allocated, freed
+	AllocObjects, FreeObjects int64                 // number of objects allocated, freed
+	Stack0                    [memProfDepth]uintptr // stack trace for this record; ends at first 0 entry
+}
+
+// MemProfile returns the number of records of the heap profile, and, if p
+// is large enough, stores them into p and reports true. If inuseZero, it
+// includes the stacks whose objects are all freed. The frees are counted up
+// to the last collection: use GC before for an up to date profile.
+func MemProfile(p []MemProfileRecord, inuseZero bool) (n int, ok bool) {
+	memProfMutex.Lock()
+	memProfFlush()
+	for b := memBuckets; b != nil; b = b.allnext {
+		if inuseZero || b.allocBytes != b.freeBytes {
+			n++
+		}
+	}
+	if n <= len(p) {
+		ok = true
+		i := 0
+		for b := memBuckets; b != nil; b = b.allnext {
+			if inuseZero || b.allocBytes != b.freeBytes {
+				r := &p[i]
+				r.AllocBytes = int64(b.allocBytes)
+				r.FreeBytes = int64(b.freeBytes)
+				r.AllocObjects = int64(b.allocs)
+				r.FreeObjects = int64(b.frees)
+				r.Stack0 = b.stk
+				i++
+			}
+		}
+	}
+	memProfMutex.Unlock()
+	return
+}
+
+// -----------------------------------------------------------------------------
+
+// CPU profiles are sampled by a SIGPROF handler, hz times per second of CPU
+// time used by the process, into a buffer runtime/pprof reads from.
+
+var (
+	cpuProfMutex sync.Mutex
+	cpuProfRate  int
+)
+
+func init() {
+	cpuProfMutex.Init(nil)
+}
+
+// SetCPUProfileRate starts sampling the stacks of running goroutines hz
+// times per second, or stops if hz <= 0. It reports false if a profile is
+// already running, or if the profiling timer can't be set.
+func SetCPUProfileRate(hz int) bool {
+	if hz > 1000000 {
+		hz = 1000000
+	}
+	ok := true
+	cpuProfMutex.Lock()
+	switch {
+	case hz > 0 && cpuProfRate != 0:
+		ok = false
+	case hz > 0:
+		if ok = prof.Start(c.Int(hz)) == 0; ok {
+			cpuProfRate = hz
+		}
+	case cpuProfRate != 0:
+		prof.Stop()
+		cpuProfRate = 0
+	}
+	cpuProfMutex.Unlock()
+	return ok
+}
+
+// ReadCPUProfile stores the stack of the next CPU sample into stk, leaf
+// first, and returns its depth, or -1 if there is no sample to read. There
+// must be a single reader.
+func ReadCPUProfile(stk []uintptr) int {
+	if len(stk) == 0 {
+		return -1
+	}
+	return int(prof.Read(&stk[0], c.Int(len(stk))))
+}
+
+// CPUProfileLost returns the number of samples dropped since the profile
+// started, as the buffer was full.
+func CPUProfileLost() int64 {
+	return int64(prof.Lost())
+}
+
+// -----------------------------------------------------------------------------
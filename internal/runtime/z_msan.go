@@ -0,0 +1,41 @@
+//go:build msan
+// +build msan
+
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+)
+
+// -----------------------------------------------------------------------------
+
+// With -msan, the program is linked with the MemorySanitizer runtime, which
+// intercepts malloc. The objects of the heap of bdwgc are told to it like
+// the ones of a custom allocator: uninitialized, unless they are zeroed.
+
+// MsanEnabled reports whether MemorySanitizer is enabled.
+const MsanEnabled = true
+
+//go:linkname msanMalloc C.__msan_allocated_memory
+func msanMalloc(addr unsafe.Pointer, size uintptr)
+
+//go:linkname msanUnpoison C.__msan_unpoison
+func msanUnpoison(addr unsafe.Pointer, size uintptr)
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// The network poller backs the pollDesc of internal/poll: descriptors are
+// non-blocking, and a goroutine whose read or write would block waits until
+// the poller thread sees the descriptor ready, its deadline passes, or it's
+// closed. The wait is like a syscall: a goroutine waiting for the network
+// isn't asleep, since data may come at any time. Poll descriptors live in C
+// memory, and are passed to internal/poll as uintptr.
+//
+// The waiting goroutine blocks its thread, so programs with many connections
+// still use a thread for each. TODO: park waiting goroutines on the M:N
+// scheduler, to serve connections with a small thread count, and a backend
+// on I/O completion ports for Windows.
+
+// PollServerInit starts the poller. It's called once.
+func PollServerInit() {
+	thread.NetpollInit()
+}
+
+// PollOpen registers fd, and returns its poll descriptor, or an errno if fd
+// can't be polled.
+func PollOpen(fd uintptr) (uintptr, int) {
+	var pd uintptr
+	if errno := thread.NetpollOpen(c.Int(fd), &pd); errno != 0 {
+		return 0, int(errno)
+	}
+	return pd, 0
+}
+
+// PollClose unregisters the descriptor of pd, before it's closed.
+func PollClose(pd uintptr) {
+	thread.NetpollClose(pd)
+}
+
+// PollReset prepares pd for a read or a write, in mode 'r' or 'w'. It
+// returns an error code of internal/poll if pd is closing or timed out.
+func PollReset(pd uintptr, mode int) int {
+	return int(thread.NetpollReset(pd, c.Int(mode)))
+}
+
+// PollWait waits until pd is ready for mode, 'r' or 'w', and returns an
+// error code of internal/poll if it's closing or timed out.
+func PollWait(pd uintptr, mode int) int {
+	thread.TraceSyscallEnter()
+	errcode := thread.NetpollWait(pd, c.Int(mode))
+	thread.TraceSyscallExit()
+	return int(errcode)
+}
+
+// PollSetDeadline sets the deadline of pd for mode, 'r', 'w' or 'r'+'w', to
+// d nanoseconds from now: none if d is 0, and expired if it's negative.
+func PollSetDeadline(pd uintptr, d int64, mode int) {
+	thread.NetpollSetDeadline(pd, d, c.Int(mode))
+}
+
+// PollUnblock wakes the goroutines waiting for pd, which is being closed.
+func PollUnblock(pd uintptr) {
+	thread.NetpollUnblock(pd)
+}
+
+// IsPollServerDescriptor reports whether fd is used by the poller itself.
+func IsPollServerDescriptor(fd uintptr) bool {
+	return thread.IsNetpollDescriptor(c.Int(fd)) != 0
+}
+
+// -----------------------------------------------------------------------------
@@ -27,11 +27,89 @@ import (
 
 // AllocU allocates uninitialized memory.
 func AllocU(size uintptr) unsafe.Pointer {
-	return c.Malloc(size)
+	checkAlloc(size)
+	ret := c.Malloc(size)
+	memProfileAlloc(ret, size)
+	return ret
 }
 
 // AllocZ allocates zero-initialized memory.
 func AllocZ(size uintptr) unsafe.Pointer {
+	checkAlloc(size)
 	ret := c.Malloc(size)
+	memProfileAlloc(ret, size)
 	return c.Memset(ret, 0, size)
 }
+
+// AllocUNoscan is AllocU: without a collector, no memory is scanned.
+func AllocUNoscan(size uintptr) unsafe.Pointer {
+	return AllocU(size)
+}
+
+// AllocZNoscan is AllocZ.
+func AllocZNoscan(size uintptr) unsafe.Pointer {
+	return AllocZ(size)
+}
+
+// allocRoot allocates zero-initialized memory freed by freeRoot.
+func allocRoot(size uintptr) unsafe.Pointer {
+	return c.Memset(c.Malloc(size), 0, size)
+}
+
+func freeRoot(p unsafe.Pointer) {
+	c.Free(p)
+}
+
+// -----------------------------------------------------------------------------
+
+// setFinalizer does nothing: objects are never freed, so finalizers never run.
+func setFinalizer(p unsafe.Pointer, fn func(obj unsafe.Pointer)) bool {
+	return true
+}
+
+// weakLink does nothing: objects are never freed, so weak pointers are never
+// cleared.
+func weakLink(link *uintptr, p unsafe.Pointer) {
+}
+
+// weakLoad returns the pointer hidden at link.
+func weakLoad(link *uintptr) unsafe.Pointer {
+	v := ^*link
+	return *(*unsafe.Pointer)(unsafe.Pointer(&v))
+}
+
+// -----------------------------------------------------------------------------
+
+// registerThread does nothing: there are no stacks to scan.
+func registerThread() bool {
+	return false
+}
+
+// unregisterThread does nothing: threads are never registered.
+func unregisterThread() {
+}
+
+// -----------------------------------------------------------------------------
+
+// gcSetPercent does nothing: there is no collector.
+func gcSetPercent(percent int) {
+}
+
+// gcSetLimit does nothing: there is no collector.
+func gcSetLimit(limit int64) {
+}
+
+// GC does nothing: there is no collector.
+func GC() {
+}
+
+// FreeOSMemory does nothing: there is no collector.
+func FreeOSMemory() {
+}
+
+// ReadGCStats stores the allocation counts into s, and zero statistics of
+// the collector: there is none.
+func ReadGCStats(s *GCStats) {
+	*s = GCStats{}
+	s.Mallocs, s.TotalAlloc = readAllocStats()
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/c/sync/atomic"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// There are no Ps until goroutines are multiplexed on threads by an M:N
+// scheduler (see CreateThread): processor slots stand for them, one per CPU.
+// A goroutine pinned to a slot holds it until it unpins, so that per-P data
+// like the caches of sync.Pool is only used by one goroutine at a time.
+// Pinned sections must be short and must not block. procFree is a semaphore
+// counting the free slots: a goroutine finding none waits on it rather than
+// spin while the holders are descheduled.
+
+var (
+	procSlots []uint32   // 1 if the slot is held
+	procFree  uint32     // number of free slots, with no goroutine taking them
+	stopMutex sync.Mutex // held by the goroutine stopping the slots
+)
+
+// procState is the slot a thread holds, and the slot it held last, to pin
+// it to the same one next time. It's in the G of the thread.
+type procState struct {
+	slot int
+	last int
+	init bool // last is set
+}
+
+func init() {
+	procSlots = make([]uint32, ncpu)
+	procFree = uint32(ncpu)
+	stopMutex.Init(nil)
+}
+
+// NumProcs returns the number of processor slots.
+func NumProcs() int {
+	return len(procSlots)
+}
+
+// ProcPin pins the goroutine to a free processor slot, and returns it.
+func ProcPin() int {
+	st := &g.proc
+	if !st.init {
+		st.last = int(uintptr(unsafe.Pointer(st))>>4) % len(procSlots)
+		st.init = true
+	}
+	// a slot is free for each goroutine taking procFree: it finds one
+	Semacquire(&procFree, false)
+	for i := 0; ; i++ {
+		slot := (st.last + i) % len(procSlots)
+		if _, ok := atomic.CompareAndExchange(&procSlots[slot], 0, 1); ok {
+			st.slot, st.last = slot, slot
+			return slot
+		}
+	}
+}
+
+// ProcUnpin releases the slot the goroutine is pinned to.
+func ProcUnpin() {
+	st := &g.proc
+	atomic.Store(&procSlots[st.slot], 0)
+	Semrelease(&procFree, false)
+}
+
+// stopProcs takes all the slots for the calling goroutine, to run with no
+// goroutine pinned, like with the world stopped.
+func stopProcs() {
+	stopMutex.Lock()
+	for range procSlots {
+		Semacquire(&procFree, false)
+	}
+}
+
+func startProcs() {
+	for range procSlots {
+		Semrelease(&procFree, false)
+	}
+	stopMutex.Unlock()
+}
+
+// -----------------------------------------------------------------------------
+
+// The cleanup of sync.Pool runs after each collection, in a goroutine of its
+// own, or in the goroutine calling GC, with the slots stopped: the collector
+// itself runs with the allocation lock held, where pools can't be changed.
+
+var (
+	poolMutex   sync.Mutex
+	poolCond    sync.Cond
+	poolCleanup func()
+	poolReady   bool
+)
+
+func init() {
+	poolMutex.Init(nil)
+	poolCond.Init(nil)
+}
+
+// RegisterPoolCleanup sets the function clearing the caches of sync.Pool.
+func RegisterPoolCleanup(cleanup func()) {
+	poolMutex.Lock() // never held while allocating: see notifyPoolCleanup
+	started := poolCleanup != nil
+	poolCleanup = cleanup
+	poolMutex.Unlock()
+	if !started {
+		go runPoolCleanup()
+	}
+}
+
+// notifyPoolCleanup is called at the end of each collection, with the
+// allocation lock held.
+func notifyPoolCleanup() {
+	poolMutex.Lock()
+	if poolCleanup != nil {
+		poolReady = true
+		poolCond.Signal()
+	}
+	poolMutex.Unlock()
+}
+
+func runPoolCleanup() {
+	for {
+		poolMutex.Lock()
+		for !poolReady {
+			thread.TraceBlock(thread.TraceBlockCond)
+			poolCond.Wait(&poolMutex)
+			thread.TraceResume()
+		}
+		poolMutex.Unlock()
+		cleanupPools()
+	}
+}
+
+// cleanupPools runs the cleanup of pools if a collection notified it, and
+// waits for a cleanup already running to complete, so that runtime.GC
+// clears the caches like in Go.
+func cleanupPools() {
+	poolMutex.Lock()
+	ready := poolReady
+	poolReady = false
+	cleanup := poolCleanup
+	poolMutex.Unlock()
+	stopProcs()
+	if ready {
+		cleanup()
+	}
+	startProcs()
+}
+
+// -----------------------------------------------------------------------------
+
+// LockOSThread locks the calling goroutine to its thread: goroutines are
+// threads already, but a thread whose goroutine exits locked to it exits
+// too, rather than running other goroutines.
+func LockOSThread() {
+	thread.LockOSThread()
+}
+
+// UnlockOSThread undoes a call of LockOSThread, if any.
+func UnlockOSThread() {
+	thread.UnlockOSThread()
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,68 @@
+//go:build race
+// +build race
+
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+)
+
+// -----------------------------------------------------------------------------
+
+// With -race, the program is linked with the ThreadSanitizer runtime, and the
+// compiler reports the memory accesses of Go code to it (see llssa.RaceDetector).
+// The runtime itself isn't instrumented: it annotates the synchronization of
+// channels and semaphores, and the accesses of the values it copies.
+
+// RaceEnabled reports whether the race detector is enabled.
+const RaceEnabled = true
+
+// RaceAcquire makes the accesses after it happen after the ones before the
+// RaceRelease calls on addr.
+//
+//go:linkname RaceAcquire C.__tsan_acquire
+func RaceAcquire(addr unsafe.Pointer)
+
+// RaceRelease makes the accesses before it happen before the ones after the
+// next RaceAcquire calls on addr.
+//
+//go:linkname RaceRelease C.__tsan_release
+func RaceRelease(addr unsafe.Pointer)
+
+// RaceReadRange reports a read of the size bytes at addr.
+//
+//go:linkname RaceReadRange C.__tsan_read_range
+func RaceReadRange(addr unsafe.Pointer, size uintptr)
+
+// RaceWriteRange reports a write of the size bytes at addr.
+//
+//go:linkname RaceWriteRange C.__tsan_write_range
+func RaceWriteRange(addr unsafe.Pointer, size uintptr)
+
+// RaceDisable stops reporting the accesses of the goroutine until RaceEnable.
+//
+//go:linkname RaceDisable C.__tsan_ignore_thread_begin
+func RaceDisable()
+
+// RaceEnable reports the accesses of the goroutine again.
+//
+//go:linkname RaceEnable C.__tsan_ignore_thread_end
+func RaceEnable()
+
+// -----------------------------------------------------------------------------
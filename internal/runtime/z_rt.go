@@ -14,6 +14,9 @@
  * limitations under the License.
  */
 
+// The runtime itself is never instrumented by sanitizers.
+//llgo:build nosanitize
+
 package runtime
 
 import (
@@ -21,63 +24,171 @@ import (
 
 	"github.com/goplus/llgo/c"
 	"github.com/goplus/llgo/c/pthread"
+	"github.com/goplus/llgo/internal/runtime/prof"
+	"github.com/goplus/llgo/internal/runtime/thread"
 )
 
 // -----------------------------------------------------------------------------
 
 // Defer presents defer statements in a function.
 type Defer struct {
-	Addr unsafe.Pointer // sigjmpbuf
-	Bits uintptr
-	Link *Defer
-	Rund unsafe.Pointer // block address after RunDefers
-}
-
-// Recover recovers a panic.
-func Recover() (ret any) {
-	ptr := excepKey.Get()
-	if ptr != nil {
-		excepKey.Set(nil)
-		ret = *(*any)(ptr)
-		c.Free(ptr)
+	Addr  unsafe.Pointer // sigjmpbuf
+	Bits  uintptr
+	Link  *Defer
+	Procs unsafe.Pointer // list of defer statements executed in loops
+	Rund  unsafe.Pointer // block address after RunDefers
+}
+
+// G is the state of the goroutine running on a thread. It's the thread-local
+// global g, whose address code compiled by llgo computes from the thread
+// pointer without a call (see ssa.NameG), e.g. to link the frames with
+// deferred calls. A thread running goroutines one after another reuses it.
+// The collector doesn't scan it: its panics in progress, which hold the
+// values of panics, are allocated by allocRoot.
+type G struct {
+	Defer      *Defer         // frames with deferred calls, innermost first
+	deferFn    unsafe.Pointer // code of the deferred call being made
+	deferFrame *Defer         // the frame making it
+	panicking  *panicking     // panics in progress, latest first
+	proc       procState      // processor slot (see ProcPin)
+	cgo        bool           // registered to the collector by CgocallbackEnter
+}
+
+//go:linkname g __llgo_g
+var g G
+
+// A panicking presents a panic in progress.
+type panicking struct {
+	arg    any
+	link   *panicking // earlier panic
+	defer_ *Defer     // the frame running deferred calls for this panic
+	sig    sigInfo    // the fault turned into this panic, if any
+	goexit bool       // runtime.Goexit, which recover doesn't stop
+
+	nstk int                     // depth of stk
+	stk  [tracebackDepth]uintptr // stack of the goroutine when it panicked
+}
+
+// Recover recovers a panic. The compiler passes the frame whose deferred call
+// invoked the function calling recover, or nil if it wasn't called that way;
+// only the panic running that frame's deferred calls is stopped.
+func Recover(frame *Defer) (ret any) {
+	p := g.panicking
+	if p != nil && !p.goexit && frame != nil && p.defer_ == frame {
+		g.panicking = p.link
+		ret = p.arg
+		freeRoot(unsafe.Pointer(p))
 	}
 	return
 }
 
 // Panic panics with a value.
+//
+//go:noinline
 func Panic(v any) {
-	switch e := v.(type) {
-	case error:
-		v = e.Error()
-	case interface{ String() string }:
-		v = e.String()
+	p := newPanic(v)
+	p.nstk = int(prof.Callers(0, &p.stk[0], tracebackDepth))
+	startPanic(p)
+}
+
+// newPanic allocates a panic with the value v, which recover returns as is.
+func newPanic(v any) *panicking {
+	p := (*panicking)(allocRoot(unsafe.Sizeof(panicking{})))
+	p.arg = v
+	return p
+}
+
+// Goexit runs the deferred calls of the goroutine like a panic which can't be
+// recovered, and ends the goroutine. On the main goroutine, it blocks forever
+// then: the program dies when the other goroutines are asleep or exited.
+func Goexit() {
+	p := newPanic(nil)
+	p.goexit = true
+	p.nstk = 0
+	startPanic(p)
+}
+
+// startPanic starts the panic p in the current goroutine, once its stack is
+// captured.
+func startPanic(p *panicking) {
+	p.link = g.panicking
+	g.panicking = p
+
+	unwind(p, g.Defer)
+}
+
+// Fatal reports an unrecoverable error, like an unlock of an unlocked mutex:
+// it prints s and exits without running deferred calls.
+func Fatal(s string) {
+	print("fatal error: ", s, "\n")
+	c.Exit(2)
+}
+
+// Rethrow rethrows a panic after running deferred calls of the frame d, if
+// the panic isn't recovered by them.
+func Rethrow(d *Defer) {
+	if p := g.panicking; p != nil && p.defer_ == d {
+		unwind(p, d.Link)
+	}
+}
+
+// unwind unwinds to the frame d to run its deferred calls. An earlier panic
+// whose deferred calls are run by the frame d is aborted by this one. Past
+// the outermost frame, a panic kills the program, and a Goexit ends the
+// goroutine.
+func unwind(p *panicking, d *Defer) {
+	if d == nil && p.goexit {
+		g.panicking = p.link
+		freeRoot(unsafe.Pointer(p))
+		thread.Goexit()
+		blockForever()
 	}
-	ptr := c.Malloc(unsafe.Sizeof(v))
-	*(*any)(ptr) = v
-	excepKey.Set(ptr)
-
-	Rethrow((*Defer)(c.GoDeferData()))
-}
-
-// Rethrow rethrows a panic.
-func Rethrow(link *Defer) {
-	if ptr := excepKey.Get(); ptr != nil {
-		if link == nil {
-			TracePanic(*(*any)(ptr))
-			c.Free(ptr)
-			c.Exit(2)
-		} else {
-			c.Siglongjmp(link.Addr, 1)
+	if d == nil {
+		tracePanics(p)
+		if p.sig.fault {
+			p.sig.print()
 		}
+		println()
+		traceback(p.stk[:p.nstk])
+		c.Exit(2)
 	}
+	for q := p.link; q != nil && q.defer_ == d; q = p.link {
+		p.link = q.link
+		freeRoot(unsafe.Pointer(q))
+	}
+	p.defer_ = d
+	c.Siglongjmp(d.Addr, 1)
 }
 
-var (
-	excepKey pthread.Key
-)
+// tracePanics prints the panics in progress, the earliest first, and reports
+// whether it printed some: a Goexit isn't one.
+func tracePanics(p *panicking) (printed bool) {
+	if p.link != nil {
+		printed = tracePanics(p.link)
+	}
+	if p.goexit {
+		return
+	}
+	if printed {
+		print("\t")
+	}
+	print("panic: ")
+	printpanicval(p.arg)
+	println()
+	return true
+}
 
-func init() {
-	excepKey.Create(nil)
+// printpanicval prints the value of a panic: errors and Stringers print
+// their strings, like in Go.
+func printpanicval(v any) {
+	switch e := v.(type) {
+	case error:
+		print(e.Error())
+	case interface{ String() string }:
+		print(e.String())
+	default:
+		printany(v)
+	}
 }
 
 // -----------------------------------------------------------------------------
@@ -86,11 +197,16 @@ func unpackEface(i any) *eface {
 	return (*eface)(unsafe.Pointer(&i))
 }
 
-// TracePanic prints panic message.
+// TracePanic prints panic message, and the stack of its caller.
+//
+//go:noinline
 func TracePanic(v any) {
 	print("panic: ")
-	printany(v)
+	printpanicval(v)
 	println("\n")
+	var stk [tracebackDepth]uintptr
+	n := prof.Callers(0, &stk[0], tracebackDepth)
+	traceback(stk[:n])
 }
 
 /*
@@ -119,8 +235,54 @@ func NewArray(t *Type, n int) unsafe.Pointer {
 
 // -----------------------------------------------------------------------------
 
+// CreateThread starts a new goroutine. It panics if the goroutine limit is
+// reached (see SetMaxGoroutines), and aborts the program if the thread limit
+// is exceeded (see SetMaxThreads).
+//
+// Each goroutine runs on a thread of its own, scheduled by the system.
+// TODO: an M:N scheduler, multiplexing goroutines on GOMAXPROCS threads with
+// Ps, run queues and work stealing, on which blocked goroutines park rather
+// than block their threads.
+func CreateThread(th *pthread.Thread, routine, arg c.Pointer) c.Int {
+	ret := thread.Create(th, routine, arg)
+	if ret == thread.ErrLimit {
+		panic(plainError("runtime: goroutine limit exceeded"))
+	}
+	return ret
+}
+
+// SetMaxThreads sets the maximum number of threads the program can use and
+// returns the previous setting.
+func SetMaxThreads(n int) int {
+	return int(thread.SetMaxThreads(c.Long(n)))
+}
+
+// SetMaxStack sets the maximum stack size of goroutines and returns the
+// previous setting (see runtime/debug.SetMaxStack).
+func SetMaxStack(n int) int {
+	return int(thread.SetMaxStack(c.Long(n)))
+}
+
+// SchedRecord records how a goroutine has been scheduled.
+type SchedRecord = thread.SchedRecord
+
+// SchedLatency returns the scheduling records of running goroutines and of
+// the most recently exited ones.
+func SchedLatency() []SchedRecord {
+	var recs []SchedRecord
+	for {
+		n := thread.SchedLatency(unsafe.SliceData(recs), c.Int(len(recs)))
+		if int(n) <= len(recs) {
+			return recs[:n]
+		}
+		recs = make([]SchedRecord, n+8) // leave room for new goroutines
+	}
+}
+
+// -----------------------------------------------------------------------------
+
 // TODO(xsw): check this
-// must match declarations in runtime/map.go.
+// must match declarations in runtime/map_swiss.go.
 const MaxZero = 1024
 
 var ZeroVal [MaxZero]byte
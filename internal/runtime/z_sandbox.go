@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	_ "unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/sync/atomic"
+	"github.com/goplus/llgo/c/time"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// Resource limits for running untrusted or generated code, e.g. in a fuzzing
+// harness or a plugin sandbox. Exceeding a limit panics, so the harness can
+// recover and report the failure. The limits are initially set from the
+// LLGO_MAXGOROUTINES and LLGO_MAXALLOCRATE environment variables.
+
+var (
+	maxAllocRate int64 // bytes per second, 0 means unlimited
+	allocStart   int64 // start of the current one-second window, in ns
+	allocBytes   int64 // bytes allocated in the current window
+)
+
+//go:linkname getenv C.getenv
+func getenv(name *c.Char) *c.Char
+
+//go:linkname atol C.atol
+func atol(s *c.Char) c.Long
+
+func init() {
+	if s := getenv(c.Str("LLGO_MAXALLOCRATE")); s != nil {
+		maxAllocRate = int64(atol(s))
+	}
+}
+
+// SetMaxGoroutines sets the maximum number of running goroutines, including
+// the main one, and returns the previous setting. Zero means unlimited.
+// Starting a goroutine beyond the limit panics.
+func SetMaxGoroutines(n int) int {
+	return int(thread.SetMaxGoroutines(c.Long(n)))
+}
+
+// SetMaxAllocRate sets the maximum number of bytes the program can allocate
+// per second, and returns the previous setting. Zero means unlimited.
+// Allocating beyond the limit panics.
+func SetMaxAllocRate(n int64) int64 {
+	return atomic.Exchange(&maxAllocRate, n)
+}
+
+func nanotime() int64 {
+	var ts time.Timespec
+	time.ClockGettime(time.CLOCK_MONOTONIC, &ts)
+	return int64(ts.Sec)*1e9 + int64(ts.Nsec)
+}
+
+// checkAlloc panics if allocating size bytes exceeds the allocation rate.
+func checkAlloc(size uintptr) {
+	max := atomic.Load(&maxAllocRate)
+	if max <= 0 {
+		return
+	}
+	now := nanotime()
+	start := atomic.Load(&allocStart)
+	if now-start >= 1e9 {
+		if _, ok := atomic.CompareAndExchange(&allocStart, start, now); ok {
+			atomic.Store(&allocBytes, 0)
+		}
+	}
+	if atomic.Add(&allocBytes, int64(size))+int64(size) > max {
+		// restart the window so that panicking can allocate
+		atomic.Store(&allocBytes, 0)
+		panic(plainError("runtime: allocation rate limit exceeded"))
+	}
+}
+
+// -----------------------------------------------------------------------------
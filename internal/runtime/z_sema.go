@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/c/pthread/sync"
+	"github.com/goplus/llgo/c/sync/atomic"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// Semaphores and notify lists are the blocking primitives of package sync,
+// like in Go: its zero values need no initialization, and only a goroutine
+// which has to wait blocks its thread, on a condition variable of its own.
+// Waiters are queued in buckets hashed by address. TODO: park waiters on the
+// M:N scheduler rather than block their threads.
+
+const semTabSize = 251
+
+type semaRoot struct {
+	lock  sync.Mutex
+	head  *semaWaiter
+	tail  *semaWaiter
+	nwait uint32 // number of waiters, read without the lock
+}
+
+type semaWaiter struct {
+	addr   unsafe.Pointer
+	ticket uint32 // semaphore: 1 if handed off; notify list: its ticket
+	woken  bool
+	cond   sync.Cond
+	next   *semaWaiter
+	g      c.Pointer // goroutine, for traces
+}
+
+var semtable [semTabSize]semaRoot
+
+var ncpu = int(thread.NumCPU())
+
+func init() {
+	for i := range semtable {
+		semtable[i].lock.Init(nil)
+	}
+}
+
+func semroot(addr unsafe.Pointer) *semaRoot {
+	return &semtable[(uintptr(addr)>>3)%semTabSize]
+}
+
+// queue adds w at the tail of the queue, or at its head if lifo.
+func (root *semaRoot) queue(w *semaWaiter, lifo bool) {
+	switch {
+	case root.head == nil:
+		root.head, root.tail = w, w
+	case lifo:
+		w.next = root.head
+		root.head = w
+	default:
+		root.tail.next = w
+		root.tail = w
+	}
+}
+
+// dequeue removes the first waiter for addr whose ticket is ticket, or for
+// any ticket if ticket is negative. It returns nil if there is none.
+func (root *semaRoot) dequeue(addr unsafe.Pointer, ticket int64) *semaWaiter {
+	var prev *semaWaiter
+	for w := root.head; w != nil; prev, w = w, w.next {
+		if w.addr != addr || (ticket >= 0 && int64(w.ticket) != ticket) {
+			continue
+		}
+		if prev == nil {
+			root.head = w.next
+		} else {
+			prev.next = w.next
+		}
+		if root.tail == w {
+			root.tail = prev
+		}
+		w.next = nil
+		return w
+	}
+	return nil
+}
+
+// park waits until w is woken, recording the goroutine as blocked for reason
+// in traces. root.lock must be held.
+func (root *semaRoot) park(w *semaWaiter, lifo bool, reason c.Int) {
+	w.cond.Init(nil)
+	w.g = thread.TraceSelf()
+	root.queue(w, lifo)
+	thread.TraceBlock(reason)
+	for !w.woken {
+		w.cond.Wait(&root.lock)
+	}
+	thread.TraceResume()
+	w.cond.Destroy()
+}
+
+// wake wakes w, which is dequeued. root.lock must be held.
+func (w *semaWaiter) wake() {
+	thread.TraceUnblock(w.g)
+	w.woken = true
+	w.cond.Signal()
+}
+
+// -----------------------------------------------------------------------------
+
+// Semacquire waits until *addr is greater than 0, and decrements it. Waiters
+// are woken in FIFO order, or this one first if lifo.
+func Semacquire(addr *uint32, lifo bool) {
+	semacquire(addr, lifo, thread.TraceBlockSync)
+	if RaceEnabled {
+		RaceAcquire(unsafe.Pointer(addr))
+	}
+}
+
+// Semsleep waits like Semacquire, recording the goroutine as sleeping in
+// traces: time.Sleep waits on a semaphore released by a timer.
+func Semsleep(addr *uint32) {
+	semacquire(addr, false, thread.TraceBlockSleep)
+}
+
+func semacquire(addr *uint32, lifo bool, reason c.Int) {
+	if cansemacquire(addr) {
+		return
+	}
+	root := semroot(unsafe.Pointer(addr))
+	w := &semaWaiter{addr: unsafe.Pointer(addr)}
+	for {
+		root.lock.Lock()
+		atomic.Add(&root.nwait, 1)
+		if cansemacquire(addr) { // released meanwhile
+			atomic.Add(&root.nwait, ^uint32(0))
+			root.lock.Unlock()
+			return
+		}
+		root.park(w, lifo, reason)
+		root.lock.Unlock()
+		if w.ticket != 0 || cansemacquire(addr) {
+			return
+		}
+		w.woken = false // beaten by another goroutine: wait again
+	}
+}
+
+// Semrelease increments *addr and wakes a goroutine waiting in Semacquire, if
+// any. If handoff, the count goes directly to the woken goroutine, which then
+// runs before others can take it.
+func Semrelease(addr *uint32, handoff bool) {
+	if RaceEnabled {
+		RaceRelease(unsafe.Pointer(addr))
+	}
+	root := semroot(unsafe.Pointer(addr))
+	atomic.Add(addr, 1)
+	if atomic.Load(&root.nwait) == 0 {
+		return
+	}
+	root.lock.Lock()
+	if atomic.Load(&root.nwait) == 0 { // woken by another Semrelease
+		root.lock.Unlock()
+		return
+	}
+	w := root.dequeue(unsafe.Pointer(addr), -1)
+	if w != nil {
+		atomic.Add(&root.nwait, ^uint32(0))
+		if handoff && cansemacquire(addr) {
+			w.ticket = 1
+		}
+		w.wake()
+	}
+	root.lock.Unlock()
+	if w != nil && handoff {
+		schedYield()
+	}
+}
+
+func cansemacquire(addr *uint32) bool {
+	for {
+		v := atomic.Load(addr)
+		if v == 0 {
+			return false
+		}
+		if _, ok := atomic.CompareAndExchange(addr, v, v-1); ok {
+			return true
+		}
+	}
+}
+
+// CanSpin reports whether a goroutine waiting for a lock should spin at its
+// iteration i rather than block: only a few times, and on a multiprocessor.
+func CanSpin(i int) bool {
+	return i < 4 && ncpu > 1
+}
+
+// DoSpin spins shortly.
+func DoSpin() {
+	thread.ProcYield(30)
+}
+
+// Nanotime returns the time of the monotonic clock, in nanoseconds.
+func Nanotime() int64 {
+	return nanotime()
+}
+
+//go:linkname schedYield C.sched_yield
+func schedYield() c.Int
+
+// -----------------------------------------------------------------------------
+
+// NotifyList is the wait queue of sync.Cond: waiters take a ticket with
+// NotifyListAdd before unlocking the lock, and are notified in ticket order,
+// so that a notification is never lost.
+type NotifyList struct {
+	wait   uint32 // ticket of the next waiter
+	notify uint32 // ticket of the next waiter to notify
+}
+
+// NotifyListAdd returns the ticket of a new waiter.
+func NotifyListAdd(l *NotifyList) uint32 {
+	return atomic.Add(&l.wait, 1)
+}
+
+// NotifyListWait waits until the waiter of ticket t is notified.
+func NotifyListWait(l *NotifyList, t uint32) {
+	root := semroot(unsafe.Pointer(l))
+	root.lock.Lock()
+	if less(t, atomic.Load(&l.notify)) { // already notified
+		root.lock.Unlock()
+		return
+	}
+	w := &semaWaiter{addr: unsafe.Pointer(l), ticket: t}
+	root.park(w, false, thread.TraceBlockCond)
+	root.lock.Unlock()
+}
+
+// NotifyListNotifyAll notifies all the waiters.
+func NotifyListNotifyAll(l *NotifyList) {
+	if atomic.Load(&l.wait) == atomic.Load(&l.notify) {
+		return
+	}
+	root := semroot(unsafe.Pointer(l))
+	root.lock.Lock()
+	atomic.Store(&l.notify, atomic.Load(&l.wait))
+	for {
+		w := root.dequeue(unsafe.Pointer(l), -1)
+		if w == nil {
+			break
+		}
+		w.wake()
+	}
+	root.lock.Unlock()
+}
+
+// NotifyListNotifyOne notifies the earliest waiter not notified yet. If it
+// isn't waiting yet, NotifyListWait returns at once.
+func NotifyListNotifyOne(l *NotifyList) {
+	if atomic.Load(&l.wait) == atomic.Load(&l.notify) {
+		return
+	}
+	root := semroot(unsafe.Pointer(l))
+	root.lock.Lock()
+	t := atomic.Load(&l.notify)
+	if t == atomic.Load(&l.wait) {
+		root.lock.Unlock()
+		return
+	}
+	atomic.Store(&l.notify, t+1)
+	if w := root.dequeue(unsafe.Pointer(l), int64(t)); w != nil {
+		w.wake()
+	}
+	root.lock.Unlock()
+}
+
+// less reports whether ticket a is before b, allowing for wraparound.
+func less(a, b uint32) bool {
+	return int32(a-b) < 0
+}
+
+// -----------------------------------------------------------------------------
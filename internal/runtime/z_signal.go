@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/prof"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// Signals notified by os/signal are caught by a C handler, which only marks
+// them pending and wakes the goroutine receiving them (see signal_recv of
+// os/signal), so that Go code never runs in a signal handler. The handlers
+// set by C libraries before are restored when the signals aren't notified
+// anymore.
+
+// SignalEnable starts delivering sig to SignalRecv.
+func SignalEnable(sig uint32) {
+	thread.SignalEnable(sig)
+}
+
+// SignalDisable stops delivering sig, and restores its action unless it's
+// ignored.
+func SignalDisable(sig uint32) {
+	thread.SignalDisable(sig)
+}
+
+// SignalIgnore stops delivering sig, and ignores it.
+func SignalIgnore(sig uint32) {
+	thread.SignalIgnore(sig)
+}
+
+// SignalIgnored reports whether sig is ignored.
+func SignalIgnored(sig uint32) bool {
+	return thread.SignalIgnored(sig) != 0
+}
+
+// SignalRecv waits for a notified signal, and returns it. It waits in a
+// syscall, like in Go: a signal may come at any time, so the goroutine isn't
+// asleep, even if all the others are.
+func SignalRecv() uint32 {
+	thread.TraceSyscallEnter()
+	sig := thread.SignalRecv()
+	thread.TraceSyscallExit()
+	return sig
+}
+
+// SignalWaitIdle waits until the signals caught so far are received.
+func SignalWaitIdle() {
+	thread.SignalWaitIdle()
+}
+
+// Sigpipe is called by os when a write to stdout or stderr fails with
+// EPIPE: it kills the process by SIGPIPE, unless SIGPIPE is notified or
+// ignored. Writes to other files just fail.
+func Sigpipe() {
+	thread.Sigpipe()
+}
+
+// -----------------------------------------------------------------------------
+
+// A fault, a SIGSEGV or a SIGBUS raised by an access, turns into a run-time
+// panic if it's a nil pointer dereference, and is a fatal error otherwise,
+// like in Go. The fault handler calls sigpanic on the alternate stack of the
+// thread, which the panic leaves if it's recovered.
+
+// sigInfo describes the fault of a panic.
+type sigInfo struct {
+	fault bool // false if the panic isn't a fault
+	bus   bool // SIGBUS, or else SIGSEGV
+	code  c.Int
+	addr  uintptr
+	pc    uintptr
+}
+
+func (s *sigInfo) print() {
+	if s.bus {
+		print("[signal SIGBUS: bus error")
+	} else {
+		print("[signal SIGSEGV: segmentation violation")
+	}
+	c.Fprintf(c.Stderr, c.Str(" code=0x%x addr=0x%lx pc=0x%lx]\n"), s.code, s.addr, s.pc)
+}
+
+//export llgoSigpanic
+func sigpanic(bus, code c.Int, addr, pc uintptr, isNil c.Int) {
+	sig := sigInfo{fault: true, bus: bus != 0, code: code, addr: addr, pc: pc}
+	if isNil == 0 {
+		c.Fprintf(c.Stderr, c.Str("unexpected fault address 0x%lx\n"), addr)
+		print("fatal error: fault\n")
+		sig.print()
+		println()
+		var stk [tracebackDepth]uintptr
+		n := prof.Callers(0, &stk[0], tracebackDepth)
+		traceback(stk[:n])
+		c.Exit(2)
+	}
+	p := newPanic(errorString("invalid memory address or nil pointer dereference"))
+	p.sig = sig
+	p.nstk = int(prof.Callers(0, &p.stk[0], tracebackDepth))
+	startPanic(p)
+}
+
+// -----------------------------------------------------------------------------
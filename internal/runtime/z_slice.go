@@ -34,7 +34,7 @@ type Slice struct {
 
 func NewSlice3(base unsafe.Pointer, eltSize, cap, i, j, k int) (s Slice) {
 	if i < 0 || j < i || k < j || k > cap {
-		panic("slice index out of bounds")
+		panic(errorString("slice bounds out of range"))
 	}
 	s.len = j - i
 	s.cap = k - i
@@ -57,6 +57,21 @@ func SliceAppend(src Slice, data unsafe.Pointer, num, etSize int) Slice {
 	return src
 }
 
+// SliceAppendWB is SliceAppend for elements which hold pointers, if write
+// barriers are enabled (see llssa.Program.SetWriteBarrier): it calls the bulk
+// barrier before copying them.
+func SliceAppendWB(src Slice, data unsafe.Pointer, num, etSize int) Slice {
+	if etSize == 0 {
+		return src
+	}
+	oldLen := src.len
+	src = GrowSlice(src, num, etSize)
+	dst := c.Advance(src.data, oldLen*etSize)
+	bulkBarrier(dst, data, uintptr(num*etSize))
+	c.Memcpy(dst, data, uintptr(num*etSize))
+	return src
+}
+
 // GrowSlice grows slice and returns the grown slice.
 func GrowSlice(src Slice, num, etSize int) Slice {
 	oldLen := src.len
@@ -109,6 +124,13 @@ func nextslicecap(newLen, oldCap int) int {
 	return newcap
 }
 
+// SliceClear zeroes the elements of slice s.
+func SliceClear(s Slice, etSize int) {
+	if s.len > 0 {
+		c.Memset(s.data, 0, uintptr(s.len*etSize))
+	}
+}
+
 // SliceCopy copy data to slice and returns a slice.
 func SliceCopy(dst Slice, data unsafe.Pointer, num int, etSize int) int {
 	n := dst.len
@@ -121,7 +143,30 @@ func SliceCopy(dst Slice, data unsafe.Pointer, num int, etSize int) int {
 	return n
 }
 
+// SliceCopyWB is SliceCopy for elements which hold pointers, if write barriers
+// are enabled: it calls the bulk barrier before copying them.
+func SliceCopyWB(dst Slice, data unsafe.Pointer, num int, etSize int) int {
+	n := dst.len
+	if n > num {
+		n = num
+	}
+	if n > 0 {
+		bulkBarrier(dst.data, data, uintptr(n*etSize))
+		c.Memmove(dst.data, data, uintptr(n*etSize))
+	}
+	return n
+}
+
 func MakeSlice(len, cap int, etSize int) Slice {
+	return Slice{AllocZ(makeSliceSize(len, cap, etSize)), len, cap}
+}
+
+// MakeSliceNoscan is MakeSlice for elements which hold no pointers.
+func MakeSliceNoscan(len, cap int, etSize int) Slice {
+	return Slice{AllocZNoscan(makeSliceSize(len, cap, etSize)), len, cap}
+}
+
+func makeSliceSize(len, cap int, etSize int) uintptr {
 	mem, overflow := math.MulUintptr(uintptr(etSize), uintptr(cap))
 	if overflow || mem > maxAlloc || len < 0 || len > cap {
 		mem, overflow := math.MulUintptr(uintptr(etSize), uintptr(len))
@@ -130,7 +175,27 @@ func MakeSlice(len, cap int, etSize int) Slice {
 		}
 		panicmakeslicecap()
 	}
-	return Slice{AllocZ(mem), len, cap}
+	return mem
+}
+
+// CheckUnsafeSlice checks the arguments of unsafe.Slice(ptr, len), whose
+// elements are of size etSize.
+func CheckUnsafeSlice(ptr unsafe.Pointer, len int, etSize int) {
+	if len < 0 {
+		panic(errorString("unsafe.Slice: len out of range"))
+	}
+	if etSize == 0 {
+		if ptr == nil && len > 0 {
+			panic(errorString("unsafe.Slice: ptr is nil and len is not zero"))
+		}
+	}
+	mem, overflow := math.MulUintptr(uintptr(etSize), uintptr(len))
+	if overflow || mem > -uintptr(ptr) {
+		if ptr == nil {
+			panic(errorString("unsafe.Slice: ptr is nil and len is not zero"))
+		}
+		panic(errorString("unsafe.Slice: len out of range"))
+	}
 }
 
 func panicmakeslicelen() {
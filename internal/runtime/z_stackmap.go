@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// Stack maps of functions compiled with stack maps (see
+// llssa.Program.SetStackMaps): each call of such a function pushes a
+// stackEntry on the shadow stack of the thread, and pops it when it returns.
+
+// frameMap is the frame map of a function, emitted by LLVM.
+type frameMap struct {
+	numRoots int32
+	numMeta  int32 // roots from numMeta on have no meta data
+	meta     [1 << 20]*ptrMap
+}
+
+// stackEntry is the frame of a call on the shadow stack.
+type stackEntry struct {
+	next  *stackEntry
+	fmap  *frameMap
+	roots [1 << 20]unsafe.Pointer
+}
+
+// ptrMap is the pointer map of a stack object: bit i of bits is set if word
+// i of the object may be a pointer.
+type ptrMap struct {
+	nwords int32
+	bits   [1 << 20]uint8
+}
+
+// ScanStack calls f with each pointer of the shadow stack of the current
+// thread, from the innermost frame: the pointers in values live across calls,
+// and in stack objects. Pointers may be nil, or not point to the heap.
+func ScanStack(f func(p unsafe.Pointer)) {
+	scanChain(*(**stackEntry)(unsafe.Pointer(thread.RootChain())), func(slot *unsafe.Pointer) {
+		f(*slot)
+	})
+}
+
+// scanChain calls f with the address of each pointer of the shadow stack
+// whose innermost frame is e.
+func scanChain(e *stackEntry, f func(slot *unsafe.Pointer)) {
+	const ptrSize = unsafe.Sizeof(uintptr(0))
+	for ; e != nil; e = e.next {
+		fmap := e.fmap
+		for i := int32(0); i < fmap.numRoots; i++ {
+			if i >= fmap.numMeta || fmap.meta[i] == nil {
+				f(&e.roots[i])
+				continue
+			}
+			root := e.roots[i]
+			m := fmap.meta[i]
+			for w := int32(0); w < m.nwords; w++ {
+				if m.bits[w/8]&(1<<(w%8)) != 0 {
+					f((*unsafe.Pointer)(unsafe.Add(root, uintptr(w)*ptrSize)))
+				}
+			}
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
@@ -38,7 +38,7 @@ type String struct {
 // StringCat concatenates two strings.
 func StringCat(a, b String) String {
 	n := a.len + b.len
-	dest := AllocU(uintptr(n))
+	dest := AllocUNoscan(uintptr(n))
 	c.Memcpy(dest, a.data, uintptr(a.len))
 	c.Memcpy(c.Advance(dest, a.len), b.data, uintptr(b.len))
 	return String{dest, n}
@@ -55,13 +55,13 @@ func CStrCopy(dest unsafe.Pointer, s String) *int8 {
 }
 
 func CStrDup(s String) *int8 {
-	dest := AllocU(uintptr(s.len + 1))
+	dest := AllocUNoscan(uintptr(s.len + 1))
 	return CStrCopy(dest, s)
 }
 
 func StringSlice(base String, i, j int) String {
 	if i < 0 || j < i || j > base.len {
-		panic("string slice index out of bounds")
+		panic(errorString("slice bounds out of range"))
 	}
 	if i < base.len {
 		return String{c.Advance(base.data, i), j - i}
@@ -69,6 +69,19 @@ func StringSlice(base String, i, j int) String {
 	return String{nil, 0}
 }
 
+// CheckUnsafeString checks the arguments of unsafe.String(ptr, len).
+func CheckUnsafeString(ptr unsafe.Pointer, len int) {
+	if len < 0 {
+		panic(errorString("unsafe.String: len out of range"))
+	}
+	if uintptr(len) > -uintptr(ptr) {
+		if ptr == nil {
+			panic(errorString("unsafe.String: ptr is nil and len is not zero"))
+		}
+		panic(errorString("unsafe.String: len out of range"))
+	}
+}
+
 type StringIter struct {
 	s   string
 	pos int
@@ -133,7 +146,7 @@ func StringFrom(data unsafe.Pointer, n int) (s String) {
 		return
 	}
 	s.len = n
-	s.data = AllocU(uintptr(n))
+	s.data = AllocUNoscan(uintptr(n))
 	c.Memcpy(s.data, data, uintptr(n))
 	return
 }
@@ -175,6 +188,17 @@ func StringEqual(x, y String) bool {
 	return true
 }
 
+// StringHash returns the FNV-1a hash of s. It is used by string switches and
+// must be kept in sync with stringHash of package ssa.
+func StringHash(s String) uint32 {
+	h := uint32(2166136261)
+	for i := 0; i < s.len; i++ {
+		h ^= uint32(*(*byte)(c.Advance(s.data, i)))
+		h *= 16777619
+	}
+	return h
+}
+
 func StringLess(x, y String) bool {
 	n := x.len
 	if n > y.len {
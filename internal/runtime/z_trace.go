@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// Execution traces are written by package thread, which runs goroutines, in
+// the format of Go 1.21 that go tool trace reads. The runtime records where
+// its goroutines block; packages blocking in calls of their own, like time
+// and syscall, record them with the functions below.
+
+// Block reasons of TraceBlock.
+const (
+	TraceBlockGeneric = thread.TraceBlockGeneric
+	TraceBlockCond    = thread.TraceBlockCond
+	TraceBlockSleep   = thread.TraceBlockSleep
+)
+
+var traceBuf *[thread.TraceChunkSize]byte // returned by ReadTrace
+
+// StartTrace enables tracing for the current process. While tracing, the
+// data will be buffered and available via ReadTrace. StartTrace returns an
+// error if tracing is already enabled.
+func StartTrace() error {
+	if thread.TraceStart() != 0 {
+		return errorString("tracing is already enabled")
+	}
+	return nil
+}
+
+// StopTrace stops tracing, if it was previously enabled. StopTrace only
+// returns after all the reads for the trace have completed.
+func StopTrace() {
+	thread.TraceStop()
+}
+
+// ReadTrace returns the next chunk of binary tracing data, blocking until
+// data is available. If tracing is turned off and all the data accumulated
+// while it was on has been returned, ReadTrace returns nil. The caller must
+// copy the returned data before calling ReadTrace again.
+func ReadTrace() []byte {
+	if traceBuf == nil {
+		traceBuf = (*[thread.TraceChunkSize]byte)(c.Malloc(thread.TraceChunkSize))
+	}
+	n := thread.TraceRead(unsafe.Pointer(traceBuf))
+	if n == 0 {
+		return nil
+	}
+	return traceBuf[:n]
+}
+
+// TraceBlock records that the current goroutine blocks for reason, until
+// TraceResume.
+func TraceBlock(reason int) {
+	thread.TraceBlock(c.Int(reason))
+}
+
+// TraceResume records that the current goroutine runs again.
+func TraceResume() {
+	thread.TraceResume()
+}
+
+// Entersyscall and Exitsyscall surround a syscall, which shows in traces if
+// it blocks.
+func Entersyscall() {
+	thread.TraceSyscallEnter()
+}
+
+func Exitsyscall() {
+	thread.TraceSyscallExit()
+}
+
+// -----------------------------------------------------------------------------
+
+// TraceUserTaskCreate records the creation of a task of runtime/trace.
+func TraceUserTaskCreate(id, parentID uint64, taskType string) {
+	thread.TraceUserTaskCreate(id, parentID, unsafe.StringData(taskType), c.Long(len(taskType)))
+}
+
+// TraceUserTaskEnd records the end of a task of runtime/trace.
+func TraceUserTaskEnd(id uint64) {
+	thread.TraceUserTaskEnd(id)
+}
+
+// TraceUserRegion records the start (mode 0) or end (mode 1) of a region of
+// runtime/trace, in the task id.
+func TraceUserRegion(id, mode uint64, regionType string) {
+	thread.TraceUserRegion(id, mode, unsafe.StringData(regionType), c.Long(len(regionType)))
+}
+
+// TraceUserLog records a log message of runtime/trace, in the task id.
+func TraceUserLog(id uint64, category, message string) {
+	thread.TraceUserLog(id, unsafe.StringData(category), c.Long(len(category)),
+		unsafe.StringData(message), c.Long(len(message)))
+}
+
+// -----------------------------------------------------------------------------
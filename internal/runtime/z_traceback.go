@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package runtime
+
+import (
+	"unsafe"
+
+	"github.com/goplus/llgo/c"
+	"github.com/goplus/llgo/internal/runtime/prof"
+	"github.com/goplus/llgo/internal/runtime/thread"
+)
+
+// -----------------------------------------------------------------------------
+
+// An uncaught panic prints the stack of its goroutine like in Go, as it was
+// when the panic started, before deferred calls unwound it. Frames are
+// symbolized by the line tables of the executable (see llgo -nolines), like
+// for runtime.Caller and runtime.CallersFrames: those of the runtime, and of
+// C functions, which have no package path, are omitted.
+
+const (
+	tracebackDepth = 64
+	runtimePrefix  = "github.com/goplus/llgo/internal/runtime."
+)
+
+// traceback prints stk, the return addresses of the current goroutine, leaf
+// first.
+func traceback(stk []uintptr) {
+	print("goroutine ", thread.Goid(), " [running]:\n")
+	printFrames(stk)
+}
+
+// printFrames prints the Go functions of the return addresses stk, leaf
+// first, with their source positions.
+func printFrames(stk []uintptr) {
+	for _, pc := range stk {
+		name, file, line, entry, ok := FuncInfo(pc - 1)
+		if !ok {
+			continue
+		}
+		print(name, "(...)\n\t")
+		if file == "" {
+			print("?")
+		} else {
+			print(file, ":", line)
+		}
+		c.Fprintf(c.Stderr, c.Str(" +0x%lx\n"), pc-entry)
+	}
+}
+
+// Stack formats the stack of the current goroutine into buf like an uncaught
+// panic prints it, from the caller of its caller, and returns the number of
+// bytes written, at most len(buf).
+func Stack(buf []byte) int {
+	var stk [tracebackDepth]uintptr
+	n := prof.Callers(1, &stk[0], tracebackDepth)
+	s := "goroutine " + uitoa(uint64(thread.Goid())) + " [running]:\n"
+	for _, pc := range stk[:n] {
+		name, file, line, entry, ok := FuncInfo(pc - 1)
+		if !ok {
+			continue
+		}
+		s += name + "(...)\n\t"
+		if file == "" {
+			s += "?"
+		} else {
+			s += file + ":" + uitoa(uint64(line))
+		}
+		s += " +0x" + hex(uint64(pc-entry)) + "\n"
+	}
+	return copy(buf, s)
+}
+
+func hex(v uint64) string {
+	const digits = "0123456789abcdef"
+	var buf [16]byte
+	i := len(buf) - 1
+	for v >= 16 {
+		buf[i] = digits[v%16]
+		v /= 16
+		i--
+	}
+	buf[i] = digits[v]
+	return string(buf[i:])
+}
+
+// FuncInfo symbolizes pc, if it's in a Go function out of the runtime: it
+// returns the name of the function, the source position of pc, with an empty
+// file if it's unknown, and the entry of the function. The position of a
+// call is found from its return address - 1.
+func FuncInfo(pc uintptr) (name, file string, line int, entry uintptr, ok bool) {
+	var cfile, cdir *c.Char
+	var cline c.Int
+	if name, ok = goFuncName(prof.FuncInfo(pc, &entry, &cfile, &cdir, &cline)); !ok {
+		return
+	}
+	if cfile != nil {
+		file = cstring(cfile)
+		if cdir != nil && file[0] != '/' {
+			file = cstring(cdir) + "/" + file
+		}
+		line = int(cline)
+	}
+	return
+}
+
+// cstring returns s as a string, without copying it: symbols and line tables
+// are never unmapped.
+func cstring(s *c.Char) string {
+	return unsafe.String((*byte)(unsafe.Pointer(s)), c.Strlen(s))
+}
+
+// goFuncName returns the Go name of the function of symbol sym, and reports
+// whether it's a Go function out of the runtime. Stubs generated by llgo,
+// like those of closures, are not.
+func goFuncName(sym *c.Char) (string, bool) {
+	if sym == nil {
+		return "", false
+	}
+	s := cstring(sym)
+	if s == "main" { // main.main is the C main (see cl)
+		return "main.main", true
+	}
+	if hasPrefix(s, runtimePrefix) || hasPrefix(s, "__llgo_") {
+		return "", false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+func hasPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+}
+
+// -----------------------------------------------------------------------------
+
+// When all goroutines are asleep, the program dies like in Go, printing the
+// stack of each goroutine, from a thread detecting it (see
+// thread.SetDeadlockHandler).
+
+func init() {
+	thread.SetDeadlockHandler(deadlock)
+}
+
+// waitReasons are the reasons printed for the block reasons of
+// thread.TraceBlock.
+var waitReasons = [...]string{
+	thread.TraceBlockGeneric: "waiting",
+	thread.TraceBlockForever: "forever",
+	thread.TraceBlockSend:    "chan send",
+	thread.TraceBlockRecv:    "chan receive",
+	thread.TraceBlockSelect:  "select",
+	thread.TraceBlockSync:    "semacquire",
+	thread.TraceBlockCond:    "sync.Cond.Wait",
+	thread.TraceBlockSleep:   "sleep",
+}
+
+func deadlock() {
+	print("fatal error: all goroutines are asleep - deadlock!\n")
+	var g thread.DeadGoroutine
+	for i := 0; thread.DeadGoroutineAt(c.Long(i), &g) != 0; i++ {
+		reason := "waiting"
+		if int(g.Reason) < len(waitReasons) {
+			reason = waitReasons[g.Reason]
+		}
+		print("\ngoroutine ", g.Goid, " [", reason, "]:\n")
+		printFrames(g.Stk[:g.Nstk])
+	}
+}
+
+// -----------------------------------------------------------------------------
@@ -63,9 +63,9 @@ func basicEqual(kind Kind, size uintptr) func(a, b unsafe.Pointer) bool {
 func basicFlags(kind Kind) abi.TFlag {
 	switch kind {
 	case abi.Float32, abi.Float64, abi.Complex64, abi.Complex128, abi.String:
-		return 0
+		return abi.TFlagNamed
 	}
-	return abi.TFlagRegularMemory
+	return abi.TFlagNamed | abi.TFlagRegularMemory
 }
 
 func Basic(_kind Kind) *Type {
@@ -148,7 +148,7 @@ func Struct(pkgPath string, size uintptr, fields ...abi.StructField) *Type {
 			Size_: size,
 			Hash:  uint32(abi.Struct), // TODO(xsw): hash
 			Kind_: uint8(abi.Struct),
-			Str_:  "struct {...}",
+			Str_:  structStr(fields),
 		},
 		PkgPath_: pkgPath,
 		Fields:   fields,
@@ -170,6 +170,9 @@ func Struct(pkgPath string, size uintptr, fields ...abi.StructField) *Type {
 		} else {
 			ret.Equal = func(p, q unsafe.Pointer) bool {
 				for _, ft := range fields {
+					if ft.Name_ == "_" {
+						continue // blank fields are not compared
+					}
 					pi := add(p, ft.Offset)
 					qi := add(q, ft.Offset)
 					if !ft.Typ.Equal(pi, qi) {
@@ -210,7 +213,7 @@ func newPointer(elem *Type) *Type {
 			Hash:        uint32(abi.Pointer), // TODO(xsw): hash
 			Align_:      pointerAlign,
 			FieldAlign_: pointerAlign,
-			Kind_:       uint8(abi.Pointer),
+			Kind_:       uint8(abi.Pointer) | abi.KindDirectIface,
 			Equal:       memequalptr,
 			TFlag:       abi.TFlagRegularMemory,
 		},
@@ -250,7 +253,7 @@ func ArrayOf(length uintptr, elem *Type) *Type {
 			Align_:      elem.Align_,
 			FieldAlign_: elem.FieldAlign_,
 			Kind_:       uint8(abi.Array),
-			Str_:        "[...]" + elem.String(), // TODO(xsw): itoa
+			Str_:        "[" + uitoa(uint64(length)) + "]" + elem.String(),
 		},
 		Elem:  elem,
 		Slice: SliceOf(elem),
@@ -289,7 +292,7 @@ func ChanOf(dir int, strChan string, elem *Type) *Type {
 			Align_:      pointerAlign,
 			TFlag:       abi.TFlagRegularMemory,
 			FieldAlign_: pointerAlign,
-			Kind_:       uint8(abi.Chan),
+			Kind_:       uint8(abi.Chan) | abi.KindDirectIface,
 			Equal:       memequalptr,
 			Str_:        strChan + " " + elem.String(),
 		},
@@ -306,7 +309,7 @@ func MapOf(key, elem *Type, bucket *Type, flags int) *Type {
 			Hash:        uint32(abi.Map),
 			Align_:      pointerAlign,
 			FieldAlign_: pointerAlign,
-			Kind_:       uint8(abi.Map),
+			Kind_:       uint8(abi.Map) | abi.KindDirectIface,
 			Str_:        "map[" + key.String() + "]" + elem.String(),
 		},
 		Key:        key,
@@ -323,6 +326,120 @@ func MapOf(key, elem *Type, bucket *Type, flags int) *Type {
 	return &ret.Type
 }
 
+// -----------------------------------------------------------------------------
+
+// structStr returns the string form of a struct type, like reflect does.
+func structStr(fields []abi.StructField) string {
+	if len(fields) == 0 {
+		return "struct {}"
+	}
+	s := "struct {"
+	for i, f := range fields {
+		if i > 0 {
+			s += ";"
+		}
+		s += " "
+		if !f.Embedded_ {
+			s += f.Name_ + " "
+		}
+		s += f.Typ.String()
+		if f.Tag_ != "" {
+			s += " " + quote(f.Tag_)
+		}
+	}
+	return s + " }"
+}
+
+// funcStr returns the string form of a function type without the leading
+// "func", which is also the form of a method in an interface type.
+func funcStr(in, out []*Type, variadic bool) string {
+	s := "("
+	for i, t := range in {
+		if i > 0 {
+			s += ", "
+		}
+		if variadic && i == len(in)-1 {
+			s += "..." + t.Elem().String()
+		} else {
+			s += t.String()
+		}
+	}
+	s += ")"
+	switch len(out) {
+	case 0:
+	case 1:
+		s += " " + out[0].String()
+	default:
+		s += " ("
+		for i, t := range out {
+			if i > 0 {
+				s += ", "
+			}
+			s += t.String()
+		}
+		s += ")"
+	}
+	return s
+}
+
+// interfaceStr returns the string form of an unnamed interface type.
+func interfaceStr(methods []abi.Imethod) string {
+	if len(methods) == 0 {
+		return "interface {}"
+	}
+	s := "interface {"
+	for i := range methods {
+		m := &methods[i]
+		if i > 0 {
+			s += ";"
+		}
+		ft := m.Typ_
+		s += " " + m.Name() + funcStr(ft.In, ft.Out, ft.Variadic())
+	}
+	return s + " }"
+}
+
+func uitoa(v uint64) string {
+	var buf [20]byte
+	i := len(buf) - 1
+	for v >= 10 {
+		buf[i] = byte(v%10 + '0')
+		v /= 10
+		i--
+	}
+	buf[i] = byte(v + '0')
+	return string(buf[i:])
+}
+
+// quote returns s as a double-quoted Go string literal.
+func quote(s string) string {
+	const hex = "0123456789abcdef"
+	buf := make([]byte, 0, len(s)+2)
+	buf = append(buf, '"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\\':
+			buf = append(buf, '\\', c)
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		default:
+			if c < ' ' || c == 0x7f {
+				buf = append(buf, '\\', 'x', hex[c>>4], hex[c&0xf])
+			} else {
+				buf = append(buf, c)
+			}
+		}
+	}
+	buf = append(buf, '"')
+	return string(buf)
+}
+
+// -----------------------------------------------------------------------------
+
 func isRegularMemory(t *_type) bool {
 	switch t.Kind() {
 	case abi.Func, abi.Map, abi.Slice, abi.String, abi.Interface:
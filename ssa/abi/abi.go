@@ -23,6 +23,7 @@ import (
 	"go/types"
 	"hash"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/goplus/llgo/internal/abi"
@@ -278,6 +279,20 @@ func (b *Builder) interfaceHash(t *types.Interface) (ret []byte, private bool) {
 	return
 }
 
+// ItabName returns the name of the itab of the interface intf and the
+// concrete type t.
+func (b *Builder) ItabName(intf *types.Interface, t types.Type) (ret string, pub bool) {
+	iname, ipub := b.TypeName(intf)
+	tname, tpub := b.TypeName(t)
+	h := sha256.New()
+	fmt.Fprintln(h, "itab", iname, tname)
+	hashStr := base64.RawURLEncoding.EncodeToString(h.Sum(b.buf[:0]))
+	if !ipub || !tpub {
+		return b.Pkg + ".itab$" + hashStr, false
+	}
+	return "_llgo_itab$" + hashStr, true
+}
+
 // StructName returns the ABI type name for the specified struct type.
 func (b *Builder) StructName(t *types.Struct) (ret string, pub bool) {
 	hash, private := b.structHash(t)
@@ -302,7 +317,11 @@ func (b *Builder) structHash(t *types.Struct) (ret []byte, private bool) {
 			name = "-"
 		}
 		ft, _ := b.TypeName(f.Type())
-		fmt.Fprintln(h, name, ft)
+		if tag := t.Tag(i); tag != "" {
+			fmt.Fprintln(h, name, ft, strconv.Quote(tag))
+		} else {
+			fmt.Fprintln(h, name, ft)
+		}
 	}
 	ret = h.Sum(b.buf[:0])
 	return
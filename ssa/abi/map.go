@@ -12,7 +12,8 @@ import (
 // the given map type. This type is not visible to users -
 // we include only enough information to generate a correct GC
 // program for it.
-// Make sure this stays in sync with runtime/map.go.
+// The map runtime no longer uses it: it lays out its groups of slots from
+// the key and elem types (see runtime/map_swiss.go).
 //
 //	A "bucket" is a "struct" {
 //	      tophash [BUCKETSIZE]uint8
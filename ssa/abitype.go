@@ -59,6 +59,9 @@ func (b Builder) abiTypeOf(t types.Type) func() Expr {
 	case *types.Pointer:
 		return b.abiPointerOf(t)
 	case *types.Struct:
+		if isClosure(t) {
+			return b.abiClosureOf(t)
+		}
 		return b.abiStructOf(t)
 	case *types.Named:
 		if iface, ok := t.Underlying().(*types.Interface); ok {
@@ -95,7 +98,7 @@ func (b Builder) abiTupleOf(t *types.Tuple) func() Expr {
 	}
 }
 
-// func Func(in, out []*Type, variadic bool)
+// func Func(in, out []*Type, variadic bool, call, makefunc unsafe.Pointer)
 func (b Builder) abiFuncOf(sig *types.Signature) func() Expr {
 	params := b.abiTupleOf(sig.Params())
 	results := b.abiTupleOf(sig.Results())
@@ -103,8 +106,18 @@ func (b Builder) abiFuncOf(sig *types.Signature) func() Expr {
 		prog := b.Prog
 		pkg := b.Pkg
 		fn := pkg.rtFunc("Func")
+		in, out := params(), results()
 		variadic := prog.Val(sig.Variadic())
-		return b.Call(fn, params(), results(), variadic)
+		call, makefunc := pkg.closureThunks(sig)
+		return b.Call(fn, in, out, variadic, call.Expr, makefunc.Expr)
+	}
+}
+
+// func Closure(ft *FuncType) *FuncType
+func (b Builder) abiClosureOf(t *types.Struct) func() Expr {
+	fn := b.abiFuncOf(closureSig(t))
+	return func() Expr {
+		return b.Call(b.Pkg.rtFunc("Closure"), fn())
 	}
 }
 
@@ -158,7 +171,12 @@ func (b Builder) abiMthd(mPkg *types.Package, mName string, mSig *types.Signatur
 	if ifn.IsNil() {
 		ifn = tfn
 	}
-	ret = b.aggregateValue(b.Prog.rtType("Method"), name, abiTyp, ifn, tfn)
+	fn := tfn
+	if live := b.Prog.live; live != nil && !live(mSig.Recv().Type(), types.Id(mPkg, mName)) {
+		ifn = llvm.ConstNull(b.Prog.tyVoidPtr()) // dead method: keep the entry for itabs only
+		fn = ifn
+	}
+	ret = b.aggregateValue(b.Prog.rtType("Method"), name, abiTyp, ifn, fn)
 	return
 }
 
@@ -206,6 +224,9 @@ func (b Builder) abiNamed(t *types.Named) Expr {
 	pkg := b.Pkg
 	tunder := t.Underlying()
 	kind := int(abi.UnderlyingKind(tunder))
+	if t, ok := tunder.(*types.Struct); ok && isClosure(t) {
+		kind = int(abi.UnderlyingKind(closureSig(t))) // func types are converted to closures
+	}
 	numMethods, numPtrMethods := b.abiMethods(t)
 	newNamed := pkg.rtFunc("NewNamed")
 	expr := b.Call(newNamed, b.Prog.Val(kind), b.Prog.Val(numMethods), b.Prog.Val(numPtrMethods))
@@ -425,4 +446,48 @@ func (b Builder) loadType(t types.Type) Global {
 	return g
 }
 
+// loadItab returns the global holding the itab of the interface rawIntf and the
+// concrete type t. It's built once by the package initialization, and shared
+// by all packages (see linkonce_odr) if both types are public.
+//
+// TODO: emit itabs and type descriptors as constant globals. The runtime
+// completes type descriptors in place (InitNamed, PtrToThis_), so it must
+// first take them complete from the compiler.
+func (b Builder) loadItab(rawIntf *types.Interface, t types.Type) Global {
+	pkg := b.Pkg
+	name, pub := pkg.abi.ItabName(rawIntf, t)
+	g := pkg.VarOf(name)
+	if g == nil {
+		prog := b.Prog
+		itab := prog.rawType(types.NewPointer(prog.rtNamed("Itab")))
+		g = pkg.doNewVar(name, prog.Pointer(itab))
+		g.InitNil()
+		if pub {
+			g.impl.SetLinkage(llvm.LinkOnceODRLinkage)
+		}
+		pkg.itabInit(g, rawIntf, t, pub)
+	}
+	return g
+}
+
+func (p Package) itabInit(g Global, rawIntf *types.Interface, t types.Type, pub bool) {
+	b := p.afterBuilder()
+	tintf := b.abiType(rawIntf)
+	typ := b.abiType(t)
+	expr := g.Expr
+	var blks []BasicBlock
+	if pub {
+		eq := b.BinOp(token.EQL, b.Load(expr), b.Prog.Nil(b.Prog.Elem(expr.Type)))
+		blks = b.Func.MakeBlocks(2)
+		b.If(eq, blks[0], blks[1])
+		b.SetBlockEx(blks[0], AtEnd, false)
+	}
+	b.Store(expr, b.newItab(tintf, typ))
+	if pub {
+		b.Jump(blks[1])
+		b.SetBlockEx(blks[1], AtEnd, false)
+		b.blk.last = blks[1].last
+	}
+}
+
 // -----------------------------------------------------------------------------
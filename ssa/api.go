@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ssa builds LLVM IR from Go SSA. It is the code generation layer of
+// llgo, and can also be used by other compilers built on top of llgo.
+//
+// # Stable API
+//
+// The public API of package ssa is versioned by APIVersion. The stable surface
+// is Program, Package, Builder, Function, BasicBlock, Global, Type, Expr and
+// the package level functions, as recorded in api/v<APIVersion>.txt (checked
+// by TestAPICompat). Within one API version:
+//
+//   - a listed signature is never changed or removed;
+//   - new methods and functions may be added;
+//   - a superseded API is kept as a shim that calls its replacement, and is
+//     marked by a "Deprecated:" paragraph naming the replacement.
+//
+// Deprecated APIs are removed only when APIVersion is bumped. Exported names
+// that are not listed (for example the Python helpers Py*) are experimental
+// and may change at any time.
+package ssa
+
+// APIVersion is the version of the stable public API of package ssa.
+const APIVersion = 1
@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// SetWriteBarrier enables or disables write barriers: a call of
+// runtime.WriteBarrier(slot, ptr) before each pointer ptr is stored at slot,
+// if slot may be in the heap. They are for GC backends which must know the
+// pointer stores of the mutator, like a concurrent precise collector, and are
+// disabled by default: bdwgc is conservative and doesn't need them.
+func (p Program) SetWriteBarrier(b bool) {
+	p.wbarrier = b
+}
+
+// WriteBarrier reports whether write barriers are enabled.
+func (p Program) WriteBarrier() bool {
+	return p.wbarrier
+}
+
+// SetNoWriteBarrier disables write barriers in the function (see
+// //go:nowritebarrierrec), like in the write barrier itself.
+func (p Function) SetNoWriteBarrier() {
+	p.noWB = true
+}
+
+// writeBarrier emits the write barriers of storing val at ptr, one for each
+// pointer in val. They are elided if ptr is a stack slot, or if the store
+// initializes a new allocation (see isInitStore).
+func (b Builder) writeBarrier(ptr, val Expr) {
+	if !b.Prog.wbarrier || b.Func.noWB || !llvmHasPointers(val.ll) {
+		return
+	}
+	if isStackAddr(ptr.impl) || b.isInitStore(ptr.impl) {
+		return
+	}
+	b.barriers(ptr.impl, val.impl, val.ll)
+}
+
+func (b Builder) barriers(slot, val llvm.Value, t llvm.Type) {
+	switch t.TypeKind() {
+	case llvm.PointerTypeKind:
+		prog := b.Prog
+		tptr := prog.VoidPtr()
+		b.Call(b.Pkg.rtFunc("WriteBarrier"), Expr{slot, tptr}, Expr{val, tptr})
+	case llvm.StructTypeKind:
+		for i, ft := range t.StructElementTypes() {
+			if llvmHasPointers(ft) {
+				fslot := llvm.CreateStructGEP(b.impl, t, slot, i)
+				b.barriers(fslot, llvm.CreateExtractValue(b.impl, val, i), ft)
+			}
+		}
+	case llvm.ArrayTypeKind:
+		et := t.ElementType()
+		if !llvmHasPointers(et) {
+			return
+		}
+		zero := llvm.ConstInt(b.Prog.tyInt32(), 0, false)
+		for i, n := 0, t.ArrayLength(); i < n; i++ {
+			idx := llvm.ConstInt(b.Prog.tyInt32(), uint64(i), false)
+			eslot := llvm.CreateInBoundsGEP(b.impl, t, slot, []llvm.Value{zero, idx})
+			b.barriers(eslot, llvm.CreateExtractValue(b.impl, val, i), et)
+		}
+	}
+}
+
+// bulkBarrier reports whether copying elements of type telem in bulk, by copy
+// or append, needs a bulk write barrier: the runtime functions for them are
+// then SliceCopyWB and SliceAppendWB.
+func (b Builder) bulkBarrier(telem Type) bool {
+	return b.Prog.wbarrier && !b.Func.noWB && llvmHasPointers(telem.ll)
+}
+
+// isStackAddr reports whether v is the address of a stack slot, or of a part
+// of it.
+func isStackAddr(v llvm.Value) bool {
+	for {
+		switch {
+		case !v.IsAAllocaInst().IsNil():
+			return true
+		case !v.IsAGetElementPtrInst().IsNil(), !v.IsABitCastInst().IsNil():
+			v = v.Operand(0)
+		case !v.IsACallInst().IsNil() && v.CalledValue().Name() == PkgRuntime+".Zeroinit":
+			v = v.Operand(0) // Zeroinit returns its first argument
+		default:
+			return false
+		}
+	}
+}
+
+// isInitStore reports whether storing at ptr initializes a new allocation:
+// ptr is in memory allocated in the current block, and there is no call since
+// the allocation, so the collector can't have seen it.
+func (b Builder) isInitStore(ptr llvm.Value) bool {
+	alloc := ptr
+	for alloc.IsACallInst().IsNil() {
+		if alloc.IsAGetElementPtrInst().IsNil() && alloc.IsABitCastInst().IsNil() {
+			return false
+		}
+		alloc = alloc.Operand(0)
+	}
+	switch alloc.CalledValue().Name() {
+	case PkgRuntime + ".AllocU", PkgRuntime + ".AllocZ",
+		PkgRuntime + ".AllocUNoscan", PkgRuntime + ".AllocZNoscan":
+	default:
+		return false
+	}
+	for v := b.impl.GetInsertBlock().LastInstruction(); !v.IsNil(); v = llvm.PrevInstruction(v) {
+		if v == alloc {
+			return true
+		}
+		if !v.IsACallInst().IsNil() && v.CalledValue().Name() != PkgRuntime+".WriteBarrier" {
+			return false
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
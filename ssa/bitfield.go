@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// BitField describes the layout of a C bitfield, as reported by clang's
+// -fdump-record-layouts. The field lives in a storage unit, an integer of
+// type Unit at byte Offset of the struct.
+type BitField struct {
+	Offset uint64 // offset of the storage unit in the struct, in bytes
+	Unit   Type   // type of the storage unit, e.g. Uint32
+	Bit    int    // offset of the field in the storage unit, in bits
+	Width  int    // width of the field, in bits
+	Signed bool   // the field is a signed integer
+}
+
+// bitFieldShift returns the number of bits to shift the storage unit right to move
+// the field to its low-order bits. On big-endian targets bit offsets count
+// from the most significant bit.
+func (p Program) bitFieldShift(bf *BitField) (shift, bits int) {
+	bits = bf.Unit.ll.IntTypeWidth()
+	if bf.Width <= 0 || bf.Bit < 0 || bf.Bit+bf.Width > bits {
+		panic(fmt.Sprintf("invalid bitfield: bits %d-%d of i%d", bf.Bit, bf.Bit+bf.Width, bits))
+	}
+	shift = bf.Bit
+	if p.td.ByteOrder() == llvm.BigEndian {
+		shift = bits - bf.Bit - bf.Width
+	}
+	return
+}
+
+func (b Builder) bitFieldUnit(ptr Expr, bf *BitField) llvm.Value {
+	prog := b.Prog
+	offset := llvm.ConstInt(prog.tyInt32(), bf.Offset, false)
+	return llvm.CreateInBoundsGEP(b.impl, prog.tyInt8(), ptr.impl, []llvm.Value{offset})
+}
+
+// ExtractBitField loads the bitfield bf of the struct that ptr points to, and
+// returns it as an integer of type typ.
+func (b Builder) ExtractBitField(ptr Expr, bf BitField, typ Type) Expr {
+	if debugInstr {
+		log.Printf("ExtractBitField %v, %+v, %v\n", ptr.impl, bf, typ.raw.Type)
+	}
+	shift, bits := b.Prog.bitFieldShift(&bf)
+	tunit := bf.Unit.ll
+	addr := b.bitFieldUnit(ptr, &bf)
+	v := llvm.CreateLoad(b.impl, tunit, addr)
+	if bf.Signed {
+		if n := bits - shift - bf.Width; n != 0 {
+			v = b.impl.CreateShl(v, llvm.ConstInt(tunit, uint64(n), false), "")
+		}
+		if n := bits - bf.Width; n != 0 {
+			v = b.impl.CreateAShr(v, llvm.ConstInt(tunit, uint64(n), false), "")
+		}
+	} else {
+		if shift != 0 {
+			v = b.impl.CreateLShr(v, llvm.ConstInt(tunit, uint64(shift), false), "")
+		}
+		if bf.Width != bits {
+			v = b.impl.CreateAnd(v, llvm.ConstInt(tunit, 1<<bf.Width-1, false), "")
+		}
+	}
+	switch n := typ.ll.IntTypeWidth(); {
+	case n < bits:
+		v = b.impl.CreateTrunc(v, typ.ll, "")
+	case n > bits && bf.Signed:
+		v = b.impl.CreateSExt(v, typ.ll, "")
+	case n > bits:
+		v = b.impl.CreateZExt(v, typ.ll, "")
+	}
+	return Expr{v, typ}
+}
+
+// InsertBitField stores val into the bitfield bf of the struct that ptr
+// points to, leaving the other bits of its storage unit unchanged. The
+// high-order bits of val that don't fit in the field are discarded.
+func (b Builder) InsertBitField(ptr Expr, bf BitField, val Expr) {
+	if debugInstr {
+		log.Printf("InsertBitField %v, %+v, %v\n", ptr.impl, bf, val.impl)
+	}
+	shift, bits := b.Prog.bitFieldShift(&bf)
+	tunit := bf.Unit.ll
+	v := val.impl
+	switch n := v.Type().IntTypeWidth(); {
+	case n < bits:
+		v = b.impl.CreateZExt(v, tunit, "")
+	case n > bits:
+		v = b.impl.CreateTrunc(v, tunit, "")
+	}
+	mask := uint64(1)<<bf.Width - 1
+	if bf.Width != bits {
+		v = b.impl.CreateAnd(v, llvm.ConstInt(tunit, mask, false), "")
+	}
+	if shift != 0 {
+		v = b.impl.CreateShl(v, llvm.ConstInt(tunit, uint64(shift), false), "")
+	}
+	addr := b.bitFieldUnit(ptr, &bf)
+	if bf.Width != bits {
+		old := llvm.CreateLoad(b.impl, tunit, addr)
+		old = b.impl.CreateAnd(old, llvm.ConstInt(tunit, ^(mask<<shift), false), "")
+		v = b.impl.CreateOr(old, v, "")
+	}
+	b.impl.CreateStore(v, addr)
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"errors"
+	"go/types"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// CoverMode is the mode of coverage counters, like the -covermode option of
+// go test: whether a block ran (set), how many times it ran (count), or how
+// many times counted by atomic additions, correct in concurrent code (atomic).
+type CoverMode int
+
+const (
+	CoverNone CoverMode = iota
+	CoverSet
+	CoverCount
+	CoverAtomic
+)
+
+var coverModeNames = [...]string{"", "set", "count", "atomic"}
+
+// ParseCoverMode parses a coverage mode: set, count or atomic.
+func ParseCoverMode(s string) (CoverMode, error) {
+	for i, name := range coverModeNames {
+		if i > 0 && name == s {
+			return CoverMode(i), nil
+		}
+	}
+	return CoverNone, errors.New("unknown coverage mode: " + s)
+}
+
+func (m CoverMode) String() string {
+	return coverModeNames[m]
+}
+
+// NewCoverCounters creates the n coverage counters of the package, uint32s
+// counting the runs of its blocks with mode, and a constructor registering
+// them to the runtime before main (see runtime.CoverRegister) with blocks,
+// the source blocks they count: a line per counter, in the format of a Go
+// coverage profile without the count.
+func (p Package) NewCoverCounters(mode CoverMode, blocks string, n int) Global {
+	prog := p.Prog
+	t := prog.Type(types.NewArray(types.Typ[types.Uint32], int64(n)), InGo)
+	counters := p.doNewVar(p.Path()+".cover$counters", prog.Pointer(t))
+	counters.InitNil()
+	counters.impl.SetLinkage(llvm.InternalLinkage)
+
+	fn := p.NewFunc(p.Path()+".cover$init", NoArgsNoRet, InC)
+	b := fn.MakeBody(1)
+	ptr := Expr{counters.impl, prog.Pointer(prog.Uint32())}
+	b.Call(p.rtFunc("CoverRegister"), p.ConstStr(mode.String()), p.ConstStr(blocks), ptr, prog.Val(n))
+	b.Return()
+	fn.SetInternal()
+	p.AddCtor(fn, PriorityProfile)
+	return counters
+}
+
+// CoverCount counts a run of the block of counter i of counters (see
+// NewCoverCounters). Like the counters of Go, the accesses aren't seen by
+// the sanitizers and the race detector.
+func (b Builder) CoverCount(counters Global, i int, mode CoverMode) {
+	prog := b.Prog
+	t := prog.Elem(counters.Type).ll
+	i32 := prog.Uint32().ll
+	idx := []llvm.Value{llvm.ConstInt(prog.tyInt(), 0, false), llvm.ConstInt(prog.tyInt(), uint64(i), false)}
+	ptr := llvm.CreateInBoundsGEP(b.impl, t, counters.impl, idx)
+	one := llvm.ConstInt(i32, 1, false)
+	switch mode {
+	case CoverSet:
+		b.impl.CreateStore(one, ptr)
+	case CoverCount:
+		v := llvm.CreateLoad(b.impl, i32, ptr)
+		b.impl.CreateStore(b.impl.CreateAdd(v, one, ""), ptr)
+	case CoverAtomic:
+		b.impl.CreateAtomicRMW(OpAdd, ptr, one, llvm.AtomicOrderingMonotonic, false)
+	}
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"go/types"
+	"sort"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// Priorities of global constructors and destructors. Constructors with lower
+// priorities run first, destructors with lower priorities run last.
+const (
+	PriorityReserved = 100   // priorities 0-100 are reserved by the C runtime
+	PriorityRuntime  = 101   // llgo runtime bootstrapping
+	PriorityProfile  = 200   // profiling and instrumentation setup
+	PriorityDefault  = 65535 // default priority (same as a C constructor)
+)
+
+type ctorEntry struct {
+	fn       Function
+	priority int
+}
+
+// AddCtor registers fn (a func() with no results) into @llvm.global_ctors
+// with the specified priority, so it is called before main. The order of
+// constructors with the same priority is the order of registration within a
+// module, and is unspecified across linked objects.
+func (p Package) AddCtor(fn Function, priority int) {
+	p.ctors = addCtor(p.ctors, fn, priority)
+	p.updateCtors("llvm.global_ctors", p.ctors)
+}
+
+// AddDtor registers fn (a func() with no results) into @llvm.global_dtors
+// with the specified priority, so it is called at program exit.
+func (p Package) AddDtor(fn Function, priority int) {
+	p.dtors = addCtor(p.dtors, fn, priority)
+	p.updateCtors("llvm.global_dtors", p.dtors)
+}
+
+func addCtor(ctors []ctorEntry, fn Function, priority int) []ctorEntry {
+	if priority < 0 || priority > PriorityDefault {
+		panic("invalid constructor priority")
+	}
+	if sig := fn.raw.Type.(*types.Signature); sig.Params().Len() != 0 || sig.Results().Len() != 0 {
+		panic("constructor must be a func() with no results")
+	}
+	ctors = append(ctors, ctorEntry{fn, priority})
+	sort.SliceStable(ctors, func(i, j int) bool {
+		return ctors[i].priority < ctors[j].priority
+	})
+	return ctors
+}
+
+// updateCtors (re)creates the global named name holding ctors.
+func (p Package) updateCtors(name string, ctors []ctorEntry) {
+	if old := p.mod.NamedGlobal(name); !old.IsNil() {
+		old.EraseFromParentAsGlobal()
+	}
+	prog := p.Prog
+	ctx := prog.ctx
+	i32 := ctx.Int32Type()
+	ptr := prog.VoidPtr().ll
+	t := ctx.StructType([]llvm.Type{i32, ptr, ptr}, false)
+	vals := make([]llvm.Value, len(ctors))
+	for i, ctor := range ctors {
+		priority := llvm.ConstInt(i32, uint64(ctor.priority), false)
+		vals[i] = llvm.ConstNamedStruct(t, []llvm.Value{priority, ctor.fn.impl, llvm.ConstNull(ptr)})
+	}
+	g := llvm.AddGlobal(p.mod, llvm.ArrayType(t, len(ctors)), name)
+	g.SetLinkage(llvm.AppendingLinkage)
+	g.SetInitializer(llvm.ConstArray(t, vals))
+}
+
+// -----------------------------------------------------------------------------
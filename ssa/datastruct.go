@@ -50,6 +50,48 @@ func (b Builder) FieldAddr(x Expr, idx int) Expr {
 	return Expr{llvm.CreateStructGEP(b.impl, tstruc.ll, x.impl, idx), pt}
 }
 
+// FieldAddrPath yields the address of a nested field or array element of
+// *X. Each index of path selects a field of a struct or an element of an
+// array, e.g. path (1, 3) of *struct{a int; b [4]T} yields &x.b[3]. Every
+// step is checked against the type being indexed, and a mismatch panics with
+// an error naming the offending field.
+func (b Builder) FieldAddrPath(x Expr, path ...int) Expr {
+	if debugInstr {
+		log.Printf("FieldAddrPath %v, %v\n", x.impl, path)
+	}
+	prog := b.Prog
+	tbase := prog.Elem(x.Type)
+	t := tbase.raw.Type
+	name := "(" + t.String() + ")"
+	indices := make([]llvm.Value, 0, len(path)+1)
+	indices = append(indices, llvm.ConstInt(prog.tyInt32(), 0, false))
+	for i, idx := range path {
+		switch u := t.Underlying().(type) {
+		case *types.Struct:
+			if idx < 0 || idx >= u.NumFields() {
+				panic(fmt.Sprintf("FieldAddrPath: %s (type %v) has no field #%d (path[%d])", name, t, idx, i))
+			}
+			fld := u.Field(idx)
+			name += "." + fld.Name()
+			t = fld.Type()
+		case *types.Array:
+			if idx < 0 || int64(idx) >= u.Len() {
+				panic(fmt.Sprintf("FieldAddrPath: index %d out of range of %s (type %v, path[%d])", idx, name, t, i))
+			}
+			name += fmt.Sprintf("[%d]", idx)
+			t = u.Elem()
+		default:
+			panic(fmt.Sprintf("FieldAddrPath: %s (type %v) is neither a struct nor an array (path[%d])", name, t, i))
+		}
+		indices = append(indices, llvm.ConstInt(prog.tyInt32(), uint64(idx), false))
+	}
+	pt := prog.Pointer(prog.rawType(t))
+	if len(path) == 0 {
+		return Expr{x.impl, pt}
+	}
+	return Expr{llvm.CreateInBoundsGEP(b.impl, tbase.ll, x.impl, indices), pt}
+}
+
 // The Field instruction yields the value of Field of struct X.
 func (b Builder) Field(x Expr, idx int) Expr {
 	if debugInstr {
@@ -153,8 +195,7 @@ func (b Builder) MapLen(x Expr) Expr {
 	if x.impl.IsNull() {
 		return prog.Val(0)
 	}
-	x.Type = prog.Pointer(prog.Int())
-	return b.Load(x)
+	return b.InlineCall(b.Pkg.rtFunc("MapLen"), x)
 }
 
 // -----------------------------------------------------------------------------
@@ -172,8 +213,14 @@ func (b Builder) MapLen(x Expr) Expr {
 //
 //	t2 = &t0[t1]
 func (b Builder) IndexAddr(x, idx Expr) Expr {
+	return b.IndexAddrEx(x, idx, false)
+}
+
+// IndexAddrEx is like IndexAddr, but it doesn't check the bounds if the index
+// is known to be in the bounds of x.
+func (b Builder) IndexAddrEx(x, idx Expr, inBounds bool) Expr {
 	if debugInstr {
-		log.Printf("IndexAddr %v, %v\n", x.impl, idx.impl)
+		log.Printf("IndexAddr %v, %v, %v\n", x.impl, idx.impl, inBounds)
 	}
 	prog := b.Prog
 	telem := prog.Index(x.Type)
@@ -182,13 +229,13 @@ func (b Builder) IndexAddr(x, idx Expr) Expr {
 	case *types.Slice:
 		ptr := b.SliceData(x)
 		max := b.SliceLen(x)
-		idx = b.checkIndex(idx, max)
+		idx = b.checkIndex(idx, max, inBounds)
 		indices := []llvm.Value{idx.impl}
 		return Expr{llvm.CreateInBoundsGEP(b.impl, telem.ll, ptr.impl, indices), pt}
 	case *types.Pointer:
 		ar := t.Elem().Underlying().(*types.Array)
 		max := prog.IntVal(uint64(ar.Len()), prog.Int())
-		idx = b.checkIndex(idx, max)
+		idx = b.checkIndex(idx, max, inBounds)
 	}
 	indices := []llvm.Value{idx.impl}
 	return Expr{llvm.CreateInBoundsGEP(b.impl, telem.ll, x.impl, indices), pt}
@@ -243,11 +290,14 @@ func checkRange(idx Expr, max Expr) (checkMin, checkMax bool) {
 	return
 }
 
-// check index >= 0 && index < max and size to uint
-func (b Builder) checkIndex(idx Expr, max Expr) Expr {
+// check index >= 0 && index < max (unless inBounds) and size to uint
+func (b Builder) checkIndex(idx Expr, max Expr, inBounds bool) Expr {
 	prog := b.Prog
 	// check range
-	checkMin, checkMax := checkRange(idx, max)
+	var checkMin, checkMax bool
+	if !inBounds {
+		checkMin, checkMax = checkRange(idx, max)
+	}
 	// fit size
 	var typ Type
 	if idx.kind == vkSigned {
@@ -287,8 +337,14 @@ func (b Builder) checkIndex(idx Expr, max Expr) Expr {
 //
 //	t2 = t0[t1]
 func (b Builder) Index(x, idx Expr, takeAddr func() (addr Expr, zero bool)) Expr {
+	return b.IndexEx(x, idx, false, takeAddr)
+}
+
+// IndexEx is like Index, but it doesn't check the bounds if the index is known
+// to be in the bounds of x.
+func (b Builder) IndexEx(x, idx Expr, inBounds bool, takeAddr func() (addr Expr, zero bool)) Expr {
 	if debugInstr {
-		log.Printf("Index %v, %v\n", x.impl, idx.impl)
+		log.Printf("Index %v, %v, %v\n", x.impl, idx.impl, inBounds)
 	}
 	prog := b.Prog
 	var telem Type
@@ -308,7 +364,7 @@ func (b Builder) Index(x, idx Expr, takeAddr func() (addr Expr, zero bool)) Expr
 		ptr, zero = takeAddr()
 		max = prog.IntVal(uint64(t.Len()), prog.Int())
 	}
-	idx = b.checkIndex(idx, max)
+	idx = b.checkIndex(idx, max, inBounds)
 	if zero {
 		return prog.Zero(telem)
 	}
@@ -429,7 +485,11 @@ func (b Builder) MakeSlice(t Type, len, cap Expr) (ret Expr) {
 	len = b.fitIntSize(len)
 	cap = b.fitIntSize(cap)
 	telem := prog.Index(t)
-	ret = b.InlineCall(b.Pkg.rtFunc("MakeSlice"), len, cap, prog.IntVal(prog.SizeOf(telem), prog.Int()))
+	fn := b.Pkg.rtFunc("MakeSlice")
+	if prog.noscan && !llvmHasPointers(telem.ll) {
+		fn = b.Pkg.rtFunc("MakeSliceNoscan")
+	}
+	ret = b.InlineCall(fn, len, cap, prog.IntVal(prog.SizeOf(telem), prog.Int()))
 	ret.Type = t
 	return
 }
@@ -19,6 +19,7 @@ package ssa
 import (
 	"go/types"
 	"log"
+	"runtime"
 	"strconv"
 
 	"github.com/goplus/llvm"
@@ -89,6 +90,9 @@ func (p Package) doNewVar(name string, t Type) Global {
 	gbl := llvm.AddGlobal(p.mod, typ, name)
 	alignment := p.Prog.td.ABITypeAlignment(typ)
 	gbl.SetAlignment(alignment)
+	if name == NameRootChain || name == NameG {
+		initThreadLocal(gbl)
+	}
 	ret := &aGlobal{Expr{gbl, t}}
 	p.vars[name] = ret
 	return ret
@@ -166,13 +170,28 @@ type aFunction struct {
 
 	blks []BasicBlock
 
-	defer_ *aDefer
-	recov  BasicBlock
+	defer_     *aDefer
+	recov      BasicBlock
+	recovFrame llvm.Value // see Builder.InitRecover
 
 	params   []Type
 	freeVars Expr
 	base     int // base = 1 if hasFreeVars; base = 0 otherwise
 	hasVArg  bool
+	sret     Expr // hidden out-pointer parameter of results (see NewFuncSRet)
+
+	noSanitize bool
+	noSplit    bool
+	noWB       bool // see SetNoWriteBarrier
+	noRace     bool // see SetNoRace
+	hardenSet  bool // hardening options are set by SetHardening
+
+	posFile string        // see SetPos
+	posLine int           // see SetPos
+	diSP    llvm.Metadata // subprogram of line tables (see Program.SetLineTables)
+
+	notes map[llvm.Value][]string // see Builder.Note
+	frame *FrameLayout            // see Package.ReadFrameLayouts
 }
 
 // Function represents a function or method.
@@ -198,6 +217,169 @@ func (p Package) NewFuncEx(name string, sig *types.Signature, bg Background, has
 	return ret
 }
 
+// NewFuncSRet creates a new function like NewFunc. But if the function has
+// multiple results larger than two pointers, they are returned through a
+// hidden out-pointer parameter (sret) rather than as an aggregate value, which
+// is the C ABI for big structs.
+//
+// A sret function can only be called directly (see Builder.Call), not through
+// a function pointer or a closure.
+func (p Package) NewFuncSRet(name string, sig *types.Signature, bg Background) Function {
+	if v, ok := p.fns[name]; ok {
+		return v
+	}
+	prog := p.Prog
+	tret := prog.retType(sig)
+	if tret.kind != vkTuple || prog.SizeOf(tret) <= uint64(prog.PointerSize()*2) {
+		return p.NewFunc(name, sig, bg)
+	}
+	t := prog.FuncDecl(sig, bg)
+	ft := t.ll
+	params := append([]llvm.Type{prog.tyVoidPtr()}, ft.ParamTypes()...)
+	t = &aType{llvm.FunctionType(prog.tyVoid(), params, ft.IsFunctionVarArg()), t.raw, vkFuncDecl}
+	if debugInstr {
+		log.Println("NewFuncSRet", name, t.raw.Type)
+	}
+	fn := llvm.AddFunction(p.mod, name, t.ll)
+	fn.AddAttributeAtIndex(1, prog.sretAttr(tret))
+	ret := newFunction(fn, t, p, prog, false)
+	ret.sret = Expr{fn.Param(0), prog.VoidPtr()}
+	p.fns[name] = ret
+	return ret
+}
+
+func (p Program) sretAttr(tret Type) llvm.Attribute {
+	return p.ctx.CreateTypeAttribute(llvm.AttributeKindID("sret"), tret.ll)
+}
+
+// isSRet checks if ft is a function type created by NewFuncSRet.
+func isSRet(ft llvm.Type, sig *types.Signature) bool {
+	return sig.Results().Len() > 1 && ft.ReturnType().TypeKind() == llvm.VoidTypeKind
+}
+
+// markExports tells the runtime, before main, that C may call Go functions
+// on threads of its own, so that it doesn't report all goroutines asleep.
+func (p Package) markExports() {
+	if p.exported {
+		return
+	}
+	p.exported = true
+	p.AddCtor(p.NewFunc("llgoEnableCallbacks", NoArgsNoRet, InC), PriorityDefault)
+}
+
+// NewExport defines the C function name calling the Go function fn, so that C
+// code can call it (see //export). Arguments and results of aggregate types
+// bigger than two registers are passed in memory, which is the C ABI for big
+// structs: such an argument by a pointer to a copy of it (byval on amd64), and
+// the results through a hidden out-pointer parameter (sret).
+//
+// The call is made between runtime.CgocallbackEnter and CgocallbackExit, so
+// that a thread not created by llgo runs it as a goroutine, and with no
+// deferred calls, so that a panic not recovered by fn ends the program rather
+// than unwinding through C frames. The exports of the runtime itself are
+// called by its own C code, like the fault handler, and are not wrapped.
+func (p Package) NewExport(name string, fn Function) Function {
+	if v, ok := p.fns[name]; ok {
+		return v
+	}
+	prog := p.Prog
+	maxReg, byval := prog.cABI()
+	inMem := func(t llvm.Type) bool {
+		switch t.TypeKind() {
+		case llvm.StructTypeKind, llvm.ArrayTypeKind:
+			return prog.td.TypeAllocSize(t) > maxReg
+		}
+		return false
+	}
+	ft := fn.ll
+	tret := ft.ReturnType()
+	sret := inMem(tret)
+	params := ft.ParamTypes()
+	cparams := make([]llvm.Type, 0, len(params)+1)
+	cret := tret
+	if sret {
+		cparams = append(cparams, prog.tyVoidPtr())
+		cret = prog.tyVoid()
+	}
+	for _, t := range params {
+		if inMem(t) {
+			t = prog.tyVoidPtr()
+		}
+		cparams = append(cparams, t)
+	}
+	t := &aType{llvm.FunctionType(cret, cparams, false), fn.raw, vkFuncDecl}
+	if debugInstr {
+		log.Println("NewExport", name, t.raw.Type)
+	}
+	impl := llvm.AddFunction(p.mod, name, t.ll)
+	ret := newFunction(impl, t, p, prog, false)
+	p.fns[name] = ret
+
+	b := ret.MakeBody(1)
+	off := 0
+	if sret {
+		impl.AddAttributeAtIndex(1, prog.sretAttr(&aType{ll: tret}))
+		ret.sret = Expr{impl.Param(0), prog.VoidPtr()}
+		off = 1
+	}
+	args := make([]llvm.Value, len(params))
+	for i, t := range params {
+		arg := impl.Param(off + i)
+		if inMem(t) {
+			if byval {
+				attr := prog.ctx.CreateTypeAttribute(llvm.AttributeKindID("byval"), t)
+				impl.AddAttributeAtIndex(off+i+1, attr)
+			}
+			arg = llvm.CreateLoad(b.impl, t, arg)
+		}
+		args[i] = arg
+	}
+	wrap := p.Path() != PkgRuntime
+	var saved Expr
+	if wrap {
+		p.markExports()
+		b.Call(p.rtFunc("CgocallbackEnter"))
+		saved = b.loadDefer()
+		b.storeDefer(prog.Nil(prog.DeferPtr()))
+	}
+	call := llvm.CreateCall(b.impl, ft, fn.impl, args)
+	if wrap {
+		b.storeDefer(saved)
+		b.Call(p.rtFunc("CgocallbackExit"))
+	}
+	switch {
+	case sret:
+		b.impl.CreateStore(call, ret.sret.impl)
+		b.impl.CreateRetVoid()
+	case tret.TypeKind() == llvm.VoidTypeKind:
+		b.impl.CreateRetVoid()
+	default:
+		b.impl.CreateRet(call)
+	}
+	return ret
+}
+
+// cABI returns the max size of an aggregate passed in registers, and whether a
+// bigger one is passed on the stack (byval) rather than by a pointer to a copy.
+func (p Program) cABI() (maxReg uint64, byval bool) {
+	goos, goarch := p.target.GOOS, p.target.GOARCH
+	if goos == "" {
+		goos = runtime.GOOS
+	}
+	if goarch == "" {
+		goarch = runtime.GOARCH
+	}
+	switch {
+	case goos == "windows":
+		return 8, false
+	case goarch == "amd64":
+		return 16, true
+	case goarch == "386":
+		return 0, true
+	}
+	return uint64(p.PointerSize() * 2), false
+}
+
 // FuncOf returns a function by name.
 func (p Package) FuncOf(name string) Function {
 	return p.fns[name]
@@ -242,7 +424,11 @@ func (p Function) Name() string {
 // Params returns the function's ith parameter.
 func (p Function) Param(i int) Expr {
 	i += p.base // skip if hasFreeVars
-	return Expr{p.impl.Param(i), p.params[i]}
+	idx := i
+	if !p.sret.IsNil() { // skip sret
+		idx++
+	}
+	return Expr{p.impl.Param(idx), p.params[i]}
 }
 
 func (p Function) closureCtx(b Builder) Expr {
@@ -258,6 +444,10 @@ func (p Function) closureCtx(b Builder) Expr {
 
 // FreeVar returns the function's ith free variable.
 func (p Function) FreeVar(b Builder, i int) Expr {
+	tctx := p.params[0].raw.Type.Underlying().(*types.Pointer).Elem().Underlying().(*types.Struct)
+	if t, ok := p.Prog.ctxWord(tctx); ok {
+		return Expr{p.impl.Param(0), t}
+	}
 	ctx := p.closureCtx(b)
 	return b.getField(ctx, i)
 }
@@ -268,7 +458,8 @@ func (p Function) NewBuilder() Builder {
 	b := prog.ctx.NewBuilder()
 	// TODO(xsw): Finalize may cause panic, so comment it.
 	// b.Finalize()
-	return &aBuilder{b, nil, p, p.Pkg, prog}
+	p.setDebugLoc(b)
+	return &aBuilder{impl: b, Func: p, Pkg: p.Pkg, Prog: prog}
 }
 
 // HasBody reports whether the function has a body.
@@ -291,6 +482,15 @@ func (p Function) MakeBlocks(nblk int) []BasicBlock {
 	n := len(p.blks)
 	if n == 0 {
 		p.blks = make([]BasicBlock, 0, nblk)
+		if !p.hardenSet {
+			p.setHardening(p.Pkg.harden)
+		}
+		if s := p.Prog.sanitizers; s != 0 && !p.noSanitize {
+			p.setSanitizers(s)
+		}
+		if p.Prog.lineTables && p.posFile != "" {
+			p.setSubprogram()
+		}
 	}
 	for i := 0; i < nblk; i++ {
 		p.addBlock(n + i)
@@ -322,3 +522,65 @@ func (p Function) SetRecover(blk BasicBlock) {
 }
 
 // -----------------------------------------------------------------------------
+
+// addFnAttr adds an enum attribute to the function. Attributes unknown to
+// the LLVM in use are ignored.
+func (p Function) addFnAttr(name string) {
+	if kind := llvm.AttributeKindID(name); kind != 0 {
+		p.impl.AddFunctionAttr(p.Prog.ctx.CreateEnumAttribute(kind, 0))
+	}
+}
+
+// SetNoSanitize excludes the function from sanitizer and coverage
+// instrumentation. With MemorySanitizer, the function still propagates the
+// shadow of the values it passes, without checking them, like a C function
+// with no_sanitize("memory"): its callers would see stale shadow otherwise.
+func (p Function) SetNoSanitize() {
+	p.noSanitize = true
+	if p.Prog.sanitizers&SanitizeMemory == 0 {
+		p.addFnAttr("disable_sanitizer_instrumentation")
+	}
+	p.addFnAttr("nosanitize_coverage")
+}
+
+// NoSanitize reports whether the function is excluded from sanitizer and
+// coverage instrumentation.
+func (p Function) NoSanitize() bool {
+	return p.noSanitize
+}
+
+// SetNoInline prevents the function from being inlined (see //go:noinline).
+func (p Function) SetNoInline() {
+	p.addFnAttr("noinline")
+}
+
+// SetAlwaysInline makes the function to be inlined into its callers whenever
+// possible.
+func (p Function) SetAlwaysInline() {
+	p.addFnAttr("alwaysinline")
+}
+
+// SetNoSplit marks the function as not needing a stack growth check in its
+// prologue (see //go:nosplit). Goroutine stacks don't grow for now, so it's
+// only recorded.
+func (p Function) SetNoSplit() {
+	p.noSplit = true
+}
+
+// NoSplit reports whether the function is marked by SetNoSplit.
+func (p Function) NoSplit() bool {
+	return p.noSplit
+}
+
+// SetInternal makes the function invisible outside of its module.
+func (p Function) SetInternal() {
+	p.impl.SetLinkage(llvm.InternalLinkage)
+}
+
+// SetLinkOnceODR makes the function a definition that may be in several
+// modules, all the same: the linker keeps one of them.
+func (p Function) SetLinkOnceODR() {
+	p.impl.SetLinkage(llvm.LinkOnceODRLinkage)
+}
+
+// -----------------------------------------------------------------------------
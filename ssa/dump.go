@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"strings"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// String returns the LLVM text of the expression followed by its Go type, e.g.
+// "%3 = add i64 %1, %2 ; int". Functions and globals are shown by name.
+func (v Expr) String() string {
+	if v.IsNil() {
+		return "<nil>"
+	}
+	var s string
+	switch impl := v.impl; {
+	case impl.IsNil():
+		s = "<" + v.raw.Type.String() + ">"
+	case !impl.IsAFunction().IsNil(), !impl.IsAGlobalVariable().IsNil():
+		s = "@" + impl.Name()
+	default:
+		s = strings.TrimSpace(impl.String())
+	}
+	return s + " ; " + v.raw.Type.String()
+}
+
+// -----------------------------------------------------------------------------
+
+// Note attaches text (usually the Go SSA instruction being compiled) to the
+// instructions emitted after it in the current block. Notes are shown by
+// Function.String.
+func (b Builder) Note(text string) {
+	fn := b.Func
+	if fn.notes == nil {
+		fn.notes = make(map[llvm.Value][]string)
+	}
+	blk := b.impl.GetInsertBlock()
+	at := blk.LastInstruction()
+	if at.IsNil() {
+		at = blk.AsValue()
+	}
+	fn.notes[at] = append(fn.notes[at], text)
+}
+
+// String returns the LLVM text of the function, in which notes (see
+// Builder.Note) are interleaved with the instructions as comments.
+func (p Function) String() string {
+	fn := p.impl
+	text := fn.String()
+	if fn.BasicBlocksCount() == 0 || len(p.notes) == 0 {
+		return text
+	}
+	var b strings.Builder
+	if pos := strings.Index(text, "{\n"); pos >= 0 { // function header
+		b.WriteString(text[:pos+2])
+	}
+	writeNotes := func(at llvm.Value) {
+		for _, note := range p.notes[at] {
+			b.WriteString("  ; ")
+			b.WriteString(note)
+			b.WriteByte('\n')
+		}
+	}
+	for bb := fn.FirstBasicBlock(); !bb.IsNil(); bb = llvm.NextBasicBlock(bb) {
+		if bb != fn.FirstBasicBlock() {
+			b.WriteByte('\n')
+		}
+		b.WriteString(bb.AsValue().Name())
+		b.WriteString(":\n")
+		writeNotes(bb.AsValue())
+		for instr := bb.FirstInstruction(); !instr.IsNil(); instr = llvm.NextInstruction(instr) {
+			b.WriteString(instr.String())
+			b.WriteByte('\n')
+			writeNotes(instr)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// -----------------------------------------------------------------------------
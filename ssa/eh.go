@@ -23,6 +23,7 @@ import (
 	"go/token"
 	"go/types"
 	"log"
+	"strconv"
 	"unsafe"
 
 	"github.com/goplus/llvm"
@@ -75,9 +76,9 @@ func (b Builder) Siglongjmp(jb, retval Expr) {
 
 // -----------------------------------------------------------------------------
 
-const (
-	deferKey = "__llgo_defer"
-)
+// deferArenaSize is the size in pointers of the arena of a frame, where the
+// nodes of defer statements in loops are allocated until it's full.
+const deferArenaSize = 32
 
 func (p Function) deferInitBuilder() (b Builder, next BasicBlock) {
 	b = p.NewBuilder()
@@ -87,81 +88,90 @@ func (p Function) deferInitBuilder() (b Builder, next BasicBlock) {
 
 type aDefer struct {
 	nextBit  int          // next defer bit
-	key      Expr         // pthread TLS key
 	data     Expr         // pointer to runtime.Defer
 	bitsPtr  Expr         // pointer to defer bits
 	rundPtr  Expr         // pointer to RunDefers index
+	procsPtr Expr         // pointer to the list of defers in loops
+	arena    Expr         // pointer to the arena of defers in loops
+	arenaOff Expr         // pointer to the offset of free space in arena
 	procBlk  BasicBlock   // deferProc block
 	runsNext []BasicBlock // next blocks of RunDefers
-	stmts    []func(bits Expr)
+	stmts    []func()
+	procs    bool // has the list of defers in loops
 }
 
-func (p Package) keyInit(name string) {
-	keyVar := p.VarOf(name)
-	if keyVar == nil {
-		return
-	}
-	prog := p.Prog
-	keyVar.InitNil()
-	keyVar.impl.SetLinkage(llvm.LinkOnceAnyLinkage)
-
-	b := p.afterBuilder()
-	eq := b.BinOp(token.EQL, b.Load(keyVar.Expr), prog.IntVal(0, prog.CInt()))
-	b.IfThen(eq, func() {
-		b.pthreadKeyCreate(keyVar.Expr, prog.Nil(prog.VoidPtr()))
-	})
+// loadDefer returns the head of the list of runtime.Defer of the goroutine.
+func (b Builder) loadDefer() Expr {
+	head := b.FieldAddr(b.G(), gDefer)
+	t := b.Prog.DeferPtr()
+	return Expr{llvm.CreateLoad(b.impl, t.ll, head.impl), t}
 }
 
-func (p Package) newKey(name string) Global {
-	return p.NewVarEx(name, p.Prog.CIntPtr())
-}
-
-func (b Builder) deferKey() Expr {
-	return b.Load(b.Pkg.newKey(deferKey).Expr)
+// storeDefer sets the head of the list of runtime.Defer of the goroutine. It
+// needs no write barrier: the list is of frames on the stack.
+func (b Builder) storeDefer(v Expr) {
+	b.impl.CreateStore(v.impl, b.FieldAddr(b.G(), gDefer).impl)
 }
 
 const (
 	// 0: addr sigjmpbuf
 	// 1: bits uintptr
 	// 2: link *Defer
-	// 3: rund voidptr
+	// 3: procs voidptr
+	// 4: rund voidptr
 	deferSigjmpbuf = iota
 	deferBits
 	deferLink
+	deferProcs
 	deferRund
 )
 
+const (
+	// 0: link voidptr
+	// 1: thunk func(node voidptr)
+	// 2..: saved values of the deferred call
+	procLink = iota
+	procThunk
+	procArgs
+)
+
 func (b Builder) getDefer(kind DoAction) *aDefer {
 	self := b.Func
 	if self.defer_ == nil {
 		// TODO(xsw): check if in pkg.init
 		var next, rundBlk BasicBlock
-		if kind != DeferAlways {
+		inPlace := kind == DeferAlways || b.blk.idx == 0 // entry block isn't built yet
+		if !inPlace {
 			b, next = self.deferInitBuilder()
 		}
 		prog := b.Prog
-		key := b.deferKey()
 		zero := prog.Val(uintptr(0))
-		link := Expr{b.pthreadGetspecific(key).impl, prog.DeferPtr()}
+		link := b.loadDefer()
 		jb := b.AllocaSigjmpBuf()
-		ptr := b.aggregateAlloca(prog.Defer(), jb.impl, zero.impl, link.impl)
+		procs := prog.Nil(prog.VoidPtr())
+		ptr := b.aggregateAlloca(prog.Defer(), jb.impl, zero.impl, link.impl, procs.impl)
 		deferData := Expr{ptr, prog.DeferPtr()}
-		b.pthreadSetspecific(key, deferData)
+		b.storeDefer(deferData)
 		blks := self.MakeBlocks(2)
 		procBlk, rethrowBlk := blks[0], blks[1]
 		bitsPtr := b.FieldAddr(deferData, deferBits)
 		rundPtr := b.FieldAddr(deferData, deferRund)
+		procsPtr := b.FieldAddr(deferData, deferProcs)
 		self.defer_ = &aDefer{
-			key:      key,
 			data:     deferData,
 			bitsPtr:  bitsPtr,
 			rundPtr:  rundPtr,
+			procsPtr: procsPtr,
 			procBlk:  procBlk,
 			runsNext: []BasicBlock{rethrowBlk},
 		}
+		var head Expr
+		if prog.stackMaps {
+			head = b.saveRootChain()
+		}
 		czero := prog.IntVal(0, prog.CInt())
 		retval := b.Sigsetjmp(jb, czero)
-		if kind != DeferAlways {
+		if !inPlace {
 			rundBlk = self.MakeBlock()
 		} else {
 			blks = self.MakeBlocks(2)
@@ -169,14 +179,17 @@ func (b Builder) getDefer(kind DoAction) *aDefer {
 		}
 		b.If(b.BinOp(token.EQL, retval, czero), next, rundBlk)
 		b.SetBlockEx(rundBlk, AtEnd, false) // exec runDefers and rethrow
+		if prog.stackMaps {
+			b.restoreRootChain(head)
+		}
 		b.Store(rundPtr, rethrowBlk.Addr())
 		b.Jump(procBlk)
 
 		b.SetBlockEx(rethrowBlk, AtEnd, false) // rethrow
-		b.Call(b.Pkg.rtFunc("Rethrow"), link)
+		b.Call(b.Pkg.rtFunc("Rethrow"), deferData)
 		b.Jump(self.recov)
 
-		if kind == DeferAlways {
+		if inPlace {
 			b.SetBlockEx(next, AtEnd, false)
 			b.blk.last = next.last
 		}
@@ -186,43 +199,281 @@ func (b Builder) getDefer(kind DoAction) *aDefer {
 
 // DeferData returns the defer data (*runtime.Defer).
 func (b Builder) DeferData() Expr {
-	key := b.deferKey()
-	return Expr{b.pthreadGetspecific(key).impl, b.Prog.DeferPtr()}
+	return b.loadDefer()
+}
+
+// deferCall presents a deferred call. Its fn and args which aren't constants
+// are saved when the defer statement executes, and loaded when the deferred
+// call runs: so they needn't dominate the blocks running deferred calls.
+type deferCall struct {
+	fn    Expr
+	args  []Expr
+	saved []int // 0 for fn, i+1 for args[i]
+}
+
+func newDeferCall(fn Expr, args []Expr) *deferCall {
+	p := &deferCall{fn: fn, args: args}
+	if fn.kind != vkBuiltin && !fn.impl.IsConstant() {
+		p.saved = append(p.saved, 0)
+	}
+	for i, arg := range args {
+		if !arg.impl.IsConstant() {
+			p.saved = append(p.saved, i+1)
+		}
+	}
+	return p
+}
+
+func (p *deferCall) value(i int) *Expr {
+	if i == 0 {
+		return &p.fn
+	}
+	return &p.args[i-1]
+}
+
+// values returns types and values to be saved.
+func (p *deferCall) values() (typs []Type, flds []llvm.Value) {
+	for _, i := range p.saved {
+		v := p.value(i)
+		typs = append(typs, v.Type)
+		flds = append(flds, v.impl)
+	}
+	return
+}
+
+// emit emits the deferred call, loading saved values from fields of data
+// starting at index off.
+func (p *deferCall) emit(b Builder, data Expr, off int) {
+	call := *p
+	call.args = append([]Expr(nil), p.args...)
+	for k, i := range p.saved {
+		*call.value(i) = b.getField(data, off+k)
+	}
+	b.armRecover(call.fn)
+	b.Call(call.fn, call.args...)
+}
+
+// newDeferCall returns the deferred call of fn. A deferred recover is called
+// with the frame of the function deferring it, which is invoked by a deferred
+// call itself if the recover stops a panic: see InitRecover.
+func (b Builder) newDeferCall(fn Expr, args []Expr) *deferCall {
+	if fn.kind == vkBuiltin && fn.raw.Type.(*builtinTy).name == "recover" {
+		fn, args = b.Pkg.rtFunc("Recover"), []Expr{b.recoverFrame()}
+	}
+	return newDeferCall(fn, args)
 }
 
 // Defer emits a defer instruction.
+//
+// A deferred call is marked as done before it's called, so it isn't called
+// again when the remaining deferred calls run after it panics. Defer
+// statements in loops (DeferInLoop) are pushed on a list, which is run as a
+// whole: so a function having any of them should use DeferInLoop for all its
+// defer statements to keep the calls in LIFO order. Once the defer bits of
+// the function are all used, the next defer statements are pushed on the
+// list too: they all follow the ones using bits, so the list runs first.
 func (b Builder) Defer(kind DoAction, fn Expr, args ...Expr) {
 	if debugInstr {
 		logCall("Defer", fn, args)
 	}
-	var prog Program
-	var nextbit Expr
-	var self = b.getDefer(kind)
-	switch kind {
-	case DeferInCond:
-		prog = b.Prog
-		next := self.nextBit
-		self.nextBit++
+	self := b.getDefer(kind)
+	call := b.newDeferCall(fn, args)
+	if kind == DeferInLoop {
+		b.deferInLoop(self, call)
+		return
+	}
+	prog := b.Prog
+	next := self.nextBit
+	if next >= int(prog.SizeOf(prog.Uintptr()))*8 {
+		b.deferInLoop(self, call)
+		return
+	}
+	self.nextBit++
+	var slot Expr
+	if len(call.saved) > 0 {
+		typs, flds := call.values()
+		t := prog.Struct(typs...)
+		slot = b.AllocaInEntry(t)
+		aggregateInit(b.impl, slot.impl, t.ll, flds...)
+	}
+	bits := b.Load(self.bitsPtr)
+	nextbit := prog.Val(uintptr(1 << next))
+	b.Store(self.bitsPtr, b.BinOp(token.OR, bits, nextbit))
+	self.stmts = append(self.stmts, func() {
+		zero := prog.Val(uintptr(0))
 		bits := b.Load(self.bitsPtr)
-		nextbit = prog.Val(uintptr(1 << next))
-		b.Store(self.bitsPtr, b.BinOp(token.OR, bits, nextbit))
-	case DeferAlways:
-		// nothing to do
-	default:
-		panic("todo: DeferInLoop is not supported")
+		has := b.BinOp(token.NEQ, b.BinOp(token.AND, bits, nextbit), zero)
+		b.IfThen(has, func() {
+			b.Store(self.bitsPtr, b.BinOp(token.AND_NOT, bits, nextbit))
+			b.setDeferFrame(self)
+			if slot.impl.IsNil() {
+				call.emit(b, Expr{}, 0)
+			} else {
+				call.emit(b, b.Load(slot), 0)
+			}
+		})
+	})
+}
+
+func (b Builder) deferInLoop(self *aDefer, call *deferCall) {
+	b.pushDefer(self, self.procsPtr, call)
+	b.useProcs(self)
+}
+
+// pushDefer pushes a deferred call on the list at procsPtr. A node of the
+// list holds the saved values of the call and a thunk making the call. The
+// node is in the arena of self if it fits, or else on the GC heap, which is
+// scanned for the values saved: self is nil if the list is of another
+// function (see DeferOn).
+func (b Builder) pushDefer(self *aDefer, procsPtr Expr, call *deferCall) {
+	prog := b.Prog
+	voidPtr := prog.VoidPtr()
+	typs, flds := call.values()
+	link := b.Load(procsPtr)
+	typs = append([]Type{voidPtr, prog.rawType(prog.tyDestruct())}, typs...)
+	t := prog.Struct(typs...)
+	node, thunk := b.allocDefer(self, t, call)
+	flds = append([]llvm.Value{link.impl, thunk.impl}, flds...)
+	aggregateInit(b.impl, node.impl, t.ll, flds...)
+	b.Store(procsPtr, node)
+}
+
+// allocDefer allocates a node of type t for call, and returns it with the
+// thunk making the call.
+func (b Builder) allocDefer(self *aDefer, t Type, call *deferCall) (node, thunk Expr) {
+	prog := b.Prog
+	ptrSize := prog.SizeOf(prog.VoidPtr())
+	size := (prog.SizeOf(t) + ptrSize - 1) &^ (ptrSize - 1)
+	align := uint64(prog.td.ABITypeAlignment(t.ll))
+	thunk = b.Pkg.deferThunk(t, call)
+	if self == nil || size > deferArenaSize*ptrSize || align > ptrSize {
+		return b.allocUninited(prog.IntVal(size, prog.Uintptr())), thunk
 	}
-	self.stmts = append(self.stmts, func(bits Expr) {
-		switch kind {
-		case DeferInCond:
-			zero := prog.Val(uintptr(0))
-			has := b.BinOp(token.NEQ, b.BinOp(token.AND, bits, nextbit), zero)
-			b.IfThen(has, func() {
-				b.Call(fn, args...)
-			})
-		case DeferAlways:
-			b.Call(fn, args...)
+	arena, offPtr := b.deferArena(self)
+	off := b.Load(offPtr)
+	end := b.BinOp(token.ADD, off, prog.IntVal(size, prog.Uintptr()))
+	fits := b.BinOp(token.LEQ, end, prog.IntVal(deferArenaSize*ptrSize, prog.Uintptr()))
+	blks := b.Func.MakeBlocks(3)
+	inArena, onHeap, done := blks[0], blks[1], blks[2]
+	b.If(fits, inArena, onHeap)
+
+	b.SetBlockEx(inArena, AtEnd, false)
+	b.Store(offPtr, end)
+	arenaNode := llvm.CreateInBoundsGEP(b.impl, prog.tyInt8(), arena.impl, []llvm.Value{off.impl})
+	b.Jump(done)
+
+	b.SetBlockEx(onHeap, AtEnd, false)
+	heapNode := b.allocUninited(prog.IntVal(size, prog.Uintptr()))
+	b.Jump(done)
+
+	b.SetBlockEx(done, AtEnd, false)
+	b.blk.last = done.last
+	preds := []BasicBlock{inArena, onHeap}
+	nodes := b.Phi(prog.VoidPtr())
+	nodes.AddIncoming(b, preds, func(i int, blk BasicBlock) Expr {
+		if i == 0 {
+			return Expr{arenaNode, prog.VoidPtr()}
 		}
+		return heapNode
 	})
+	return nodes.Expr, thunk
+}
+
+// deferArena returns the arena of the frame for the nodes of defer
+// statements in loops, and the pointer to the offset of its free space. Both
+// are allocated in the entry block, and the offset is zeroed there.
+func (b Builder) deferArena(self *aDefer) (arena, offPtr Expr) {
+	if self.arena.impl.IsNil() {
+		prog := b.Prog
+		self.arena = b.AllocaInEntry(prog.rawType(types.NewArray(types.Typ[types.Uintptr], deferArenaSize)))
+		self.arenaOff = b.AllocaInEntry(prog.Uintptr())
+		zero := prog.Val(uintptr(0))
+		if b.blk.idx == 0 { // entry block isn't built yet
+			b.Store(self.arenaOff, zero)
+		} else {
+			ib, next := b.Func.deferInitBuilder()
+			ib.Store(self.arenaOff, zero)
+			ib.Jump(next)
+		}
+	}
+	return self.arena, self.arenaOff
+}
+
+func (p Package) deferThunkName() string {
+	p.iDefer++
+	return p.Path() + "._llgo_defer$" + strconv.Itoa(p.iDefer)
+}
+
+// deferThunk returns a func(node voidptr) which makes the deferred call by
+// the saved values in node of type t.
+func (p Package) deferThunk(t Type, call *deferCall) Expr {
+	prog := p.Prog
+	thunk := p.NewFunc(p.deferThunkName(), prog.tyDestruct(), InC)
+	thunk.impl.SetLinkage(llvm.InternalLinkage)
+	b := thunk.MakeBody(1)
+	node := thunk.Param(0)
+	data := Expr{llvm.CreateLoad(b.impl, t.ll, node.impl), t}
+	call.emit(b, data, procArgs)
+	b.Return()
+	return thunk.Expr
+}
+
+// useProcs makes the function run the list of defers in loops: it's run as a
+// whole at the position of the first defer statement in loops.
+func (b Builder) useProcs(self *aDefer) {
+	if self.procs {
+		return
+	}
+	self.procs = true
+	self.stmts = append(self.stmts, func() {
+		b.runProcs(self)
+	})
+}
+
+// runProcs pops the deferred calls from the list of defers in loops and runs
+// them until the list is empty.
+func (b Builder) runProcs(self *aDefer) {
+	prog := b.Prog
+	blks := b.Func.MakeBlocks(3)
+	loop, body, done := blks[0], blks[1], blks[2]
+	b.Jump(loop)
+	b.SetBlockEx(loop, AtEnd, false)
+	node := b.Load(self.procsPtr)
+	b.If(b.BinOp(token.NEQ, node, prog.Nil(node.Type)), body, done)
+
+	b.SetBlockEx(body, AtEnd, false)
+	thdr := prog.Struct(node.Type, prog.rawType(prog.tyDestruct()))
+	hdr := b.Load(Expr{node.impl, prog.Pointer(thdr)})
+	b.Store(self.procsPtr, b.getField(hdr, procLink))
+	b.setDeferFrame(self)
+	b.Call(b.getField(hdr, procThunk), node)
+	b.Jump(loop)
+
+	b.SetBlockEx(done, AtEnd, false)
+	b.blk.last = done.last
+}
+
+// DeferStack returns the defer stack of the current function: deferred calls
+// pushed on it by DeferOn run when the function returns or panics.
+func (b Builder) DeferStack() Expr {
+	if debugInstr {
+		log.Println("DeferStack")
+	}
+	self := b.getDefer(DeferInLoop)
+	b.useProcs(self)
+	return self.data
+}
+
+// DeferOn emits a defer instruction which pushes the deferred call on the
+// defer stack of another function (see DeferStack), e.g. for a defer
+// statement in the body of a range-over-func loop, which is compiled to a
+// yield closure.
+func (b Builder) DeferOn(stack, fn Expr, args ...Expr) {
+	if debugInstr {
+		logCall("DeferOn "+stack.impl.Name(), fn, args)
+	}
+	stack = Expr{stack.impl, b.Prog.DeferPtr()}
+	b.pushDefer(nil, b.FieldAddr(stack, deferProcs), b.newDeferCall(fn, args))
 }
 
 // RunDefers emits instructions to run deferred instructions.
@@ -248,15 +499,14 @@ func (p Function) endDefer(b Builder) {
 		return
 	}
 	b.SetBlockEx(self.procBlk, AtEnd, true)
-	bits := b.Load(self.bitsPtr)
 	stmts := self.stmts
 	for i := len(stmts) - 1; i >= 0; i-- {
-		stmts[i](bits)
+		stmts[i]()
 	}
 
 	link := b.getField(b.Load(self.data), deferLink)
-	b.pthreadSetspecific(self.key, link)
-	b.IndirectJump(b.Load(self.rundPtr), nexts)
+	b.storeDefer(link)
+	b.IndirectBr(b.Load(self.rundPtr), nexts...)
 }
 
 // -----------------------------------------------------------------------------
@@ -266,13 +516,91 @@ func (b Builder) Unreachable() {
 	b.impl.CreateUnreachable()
 }
 
-// Recover emits a recover instruction.
+// Recover emits a recover instruction. It stops a panic only if the function
+// is invoked by a deferred call of the panicking frame (see InitRecover).
 func (b Builder) Recover() Expr {
 	if debugInstr {
 		log.Println("Recover")
 	}
-	// TODO(xsw): recover can't be a function call in Go
-	return b.Call(b.Pkg.rtFunc("Recover"))
+	return b.Call(b.Pkg.rtFunc("Recover"), b.recoverFrame())
+}
+
+// recoverFrame returns the frame whose panic recover stops in the function,
+// or nil if the function isn't invoked by a deferred call.
+func (b Builder) recoverFrame() Expr {
+	t := b.Prog.DeferPtr()
+	if frame := b.Func.recovFrame; !frame.IsNil() {
+		return Expr{frame, t}
+	}
+	return b.Prog.Nil(t)
+}
+
+// InitRecover emits the start of a function calling recover, which must be
+// at the beginning of its entry block. A deferred call records its code and
+// frame in the goroutine before it calls (see setDeferFrame and armRecover):
+// if the code is the function, or its closure stub, the function is invoked
+// by the deferred call directly, and recover stops the panic running the
+// deferred calls of the frame as the Go spec requires. The code is cleared,
+// so the functions it calls don't match it.
+func (b Builder) InitRecover() {
+	if debugInstr {
+		log.Println("InitRecover")
+	}
+	prog := b.Prog
+	t := prog.DeferPtr()
+	fnPtr := b.FieldAddr(b.G(), gDeferFn)
+	fn := llvm.CreateLoad(b.impl, prog.tyVoidPtr(), fnPtr.impl)
+	frame := llvm.CreateLoad(b.impl, t.ll, b.FieldAddr(b.G(), gDeferFrame).impl)
+	self := b.Func
+	called := llvm.CreateICmp(b.impl, llvm.IntEQ, fn, self.impl)
+	if sig, ok := self.raw.Type.(*types.Signature); ok && sig.Recv() == nil && self.base == 0 {
+		stub := b.Pkg.stubOf(self.Expr) // closures of the function call it by the stub
+		called = b.impl.CreateOr(called, llvm.CreateICmp(b.impl, llvm.IntEQ, fn, stub.impl), "")
+	}
+	self.recovFrame = llvm.CreateSelect(b.impl, called, frame, llvm.ConstNull(t.ll))
+	b.impl.CreateStore(llvm.ConstNull(prog.tyVoidPtr()), fnPtr.impl)
+}
+
+// ForwardRecover emits the forwarding of a deferred call to fn, which glue
+// code such as method wrappers calls: if the function is invoked by a
+// deferred call, fn is taken as invoked by it, so recover in fn behaves as if
+// the glue wasn't there.
+func (b Builder) ForwardRecover(fn Expr) {
+	code := b.deferCode(fn)
+	if code.IsNil() {
+		return
+	}
+	prog := b.Prog
+	fnPtr := b.FieldAddr(b.G(), gDeferFn).impl
+	cur := llvm.CreateLoad(b.impl, prog.tyVoidPtr(), fnPtr)
+	called := llvm.CreateICmp(b.impl, llvm.IntEQ, cur, b.Func.impl)
+	b.impl.CreateStore(llvm.CreateSelect(b.impl, called, code, cur), fnPtr)
+}
+
+// armRecover records the code of fn in the goroutine before a deferred call
+// to fn (see InitRecover).
+func (b Builder) armRecover(fn Expr) {
+	if code := b.deferCode(fn); !code.IsNil() {
+		b.impl.CreateStore(code, b.FieldAddr(b.G(), gDeferFn).impl)
+	}
+}
+
+// setDeferFrame records self in the goroutine as the frame making the next
+// deferred call (see InitRecover).
+func (b Builder) setDeferFrame(self *aDefer) {
+	b.impl.CreateStore(self.data.impl, b.FieldAddr(b.G(), gDeferFrame).impl)
+}
+
+// deferCode returns the code called by a call to fn, or a nil value if fn
+// isn't a Go function.
+func (b Builder) deferCode(fn Expr) llvm.Value {
+	switch fn.kind {
+	case vkClosure:
+		return b.Field(fn, 0).impl
+	case vkFuncDecl, vkFuncPtr:
+		return fn.impl
+	}
+	return llvm.Value{}
 }
 
 // Panic emits a panic instruction.
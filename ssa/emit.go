@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// EmitObject compiles the package to object code for the target of its
+// program, entirely in memory. It fails if the target triple isn't supported
+// by LLVM.
+func (p Package) EmitObject() ([]byte, error) {
+	tm, err := p.Prog.targetMachine()
+	if err != nil {
+		return nil, err
+	}
+	p.finalizeDebugInfo()
+	if p.mod.Target() == "" {
+		p.mod.SetTarget(tm.Triple())
+		p.mod.SetDataLayout(p.Prog.td.String())
+	}
+	buf, err := tm.EmitToMemoryBuffer(p.mod, llvm.ObjectFile)
+	if err != nil {
+		return nil, err
+	}
+	ret := buf.Bytes()
+	buf.Dispose()
+	return ret, nil
+}
+
+// LinkOpts represents the options of Program.EmitExecutable.
+type LinkOpts struct {
+	Packages []Package // packages to link, including the main package
+	Objects  [][]byte  // additional object files, e.g. compiled C sources
+	Linker   string    // linker driver, clang (with -target set) by default
+	LDFlags  []string  // extra flags passed to the linker
+	TempDir  string    // where the scratch directory is created, required
+}
+
+// EmitExecutable compiles opts.Packages in memory, links them with
+// opts.Objects by running the linker driver, and writes the executable to w.
+//
+// Unlike EmitObject, EmitExecutable touches the file system: the linker driver
+// only works on files, so the objects and the executable are written to a
+// scratch directory, created in opts.TempDir and removed before
+// EmitExecutable returns. It fails if opts.TempDir is empty rather than write
+// to the default temporary directory. Embedders which must not touch the file
+// system link the objects of EmitObject by themselves.
+func (p Program) EmitExecutable(w io.Writer, opts LinkOpts) (err error) {
+	if opts.TempDir == "" {
+		return errors.New("ssa: EmitExecutable needs LinkOpts.TempDir, as the linker only works on files")
+	}
+	tm, err := p.targetMachine()
+	if err != nil {
+		return
+	}
+	objs := make([][]byte, 0, len(opts.Packages)+len(opts.Objects))
+	for _, pkg := range opts.Packages {
+		obj, err := pkg.EmitObject()
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg.Path(), err)
+		}
+		objs = append(objs, obj)
+	}
+	objs = append(objs, opts.Objects...)
+
+	dir, err := os.MkdirTemp(opts.TempDir, "llgo-link")
+	if err != nil {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	linker := opts.Linker
+	out := filepath.Join(dir, "a.out")
+	args := make([]string, 0, len(objs)+len(opts.LDFlags)+4)
+	if linker == "" {
+		linker = "clang"
+		args = append(args, "-target", tm.Triple())
+	}
+	args = append(args, "-o", out)
+	for i, obj := range objs {
+		file := filepath.Join(dir, strconv.Itoa(i)+".o")
+		if err = os.WriteFile(file, obj, 0600); err != nil {
+			return
+		}
+		args = append(args, file)
+	}
+	args = append(args, opts.LDFlags...)
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(linker, args...)
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w\n%s", linker, err, stderr.Bytes())
+	}
+	f, err := os.Open(out)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return
+}
+
+// -----------------------------------------------------------------------------
@@ -23,6 +23,8 @@ import (
 	"go/token"
 	"go/types"
 	"log"
+	"math"
+	"strconv"
 
 	"github.com/goplus/llvm"
 )
@@ -220,29 +222,40 @@ func (b Builder) Const(v constant.Value, typ Type) Expr {
 		switch {
 		case kind == types.Bool:
 			return Expr{prog.BoolVal(constant.BoolVal(v)).impl, typ}
-		case kind >= types.Int && kind <= types.Int64:
-			if v, exact := constant.Int64Val(v); exact {
-				return prog.IntVal(uint64(v), typ)
-			}
-		case kind >= types.Uint && kind <= types.Uintptr:
-			if v, exact := constant.Uint64Val(v); exact {
-				return prog.IntVal(v, typ)
-			}
-		case kind == types.Float64 || kind == types.Float32:
+		case kind >= types.Int && kind <= types.Uintptr:
+			return prog.IntVal(constBits(v), typ)
+		case kind == types.Float64:
 			v, _ := constant.Float64Val(v)
 			return prog.FloatVal(v, typ)
+		case kind == types.Float32:
+			v, _ := constant.Float32Val(v)
+			return prog.FloatVal(float64(v), typ)
 		case kind == types.String:
 			return Expr{b.Str(constant.StringVal(v)).impl, typ}
-		case kind == types.Complex128 || kind == types.Complex64:
+		case kind == types.Complex128:
 			v = constant.ToComplex(v)
 			re, _ := constant.Float64Val(constant.Real(v))
 			im, _ := constant.Float64Val(constant.Imag(v))
 			return prog.ComplexVal(complex(re, im), typ)
+		case kind == types.Complex64:
+			v = constant.ToComplex(v)
+			re, _ := constant.Float32Val(constant.Real(v))
+			im, _ := constant.Float32Val(constant.Imag(v))
+			return prog.ComplexVal(complex(float64(re), float64(im)), typ)
 		}
 	}
 	panic(fmt.Sprintf("unsupported Const: %v, %v", v, raw))
 }
 
+// constBits returns the low 64 bits of the integer constant v in two's
+// complement. Constants are exact at any precision (see go/constant), and are
+// only truncated here, to the size of their type.
+func constBits(v constant.Value) uint64 {
+	v = constant.BinaryOp(constant.ToInt(v), token.AND, constant.MakeUint64(math.MaxUint64))
+	ret, _ := constant.Uint64Val(v)
+	return ret
+}
+
 // CStr returns a c-style string constant expression.
 func (b Builder) CStr(v string) Expr {
 	return Expr{llvm.CreateGlobalStringPtr(b.impl, v), b.Prog.CStr()}
@@ -257,14 +270,18 @@ func (b Builder) Str(v string) Expr {
 }
 
 func (b Builder) createGlobalStr(v string) (ret llvm.Value) {
-	if ret, ok := b.Pkg.strs[v]; ok {
+	return b.Pkg.createGlobalStr(v)
+}
+
+func (p Package) createGlobalStr(v string) (ret llvm.Value) {
+	if ret, ok := p.strs[v]; ok {
 		return ret
 	}
-	prog := b.Prog
+	prog := p.Prog
 	if v != "" {
 		typ := llvm.ArrayType(prog.tyInt8(), len(v))
-		global := llvm.AddGlobal(b.Pkg.mod, typ, "")
-		global.SetInitializer(b.Prog.ctx.ConstString(v, false))
+		global := llvm.AddGlobal(p.mod, typ, "")
+		global.SetInitializer(prog.ctx.ConstString(v, false))
 		global.SetLinkage(llvm.PrivateLinkage)
 		global.SetGlobalConstant(true)
 		global.SetUnnamedAddr(true)
@@ -273,10 +290,96 @@ func (b Builder) createGlobalStr(v string) (ret llvm.Value) {
 	} else {
 		ret = llvm.ConstNull(prog.CStr().ll)
 	}
-	b.Pkg.strs[v] = ret
+	p.strs[v] = ret
 	return
 }
 
+// -----------------------------------------------------------------------------
+
+// ConstStr returns a constant string, eg. to initialize a global variable.
+// The string data is read-only.
+func (p Package) ConstStr(v string) Expr {
+	prog := p.Prog
+	data := p.createGlobalStr(v)
+	size := llvm.ConstInt(prog.tyInt(), uint64(len(v)), false)
+	return Expr{constStruct(prog.rtString(), data, size), prog.String()}
+}
+
+// ConstBytes returns a constant []byte of the content v. Unlike ConstStr, the
+// slice data is writable.
+func (p Package) ConstBytes(v string) Expr {
+	prog := p.Prog
+	var data llvm.Value
+	if v != "" {
+		typ := llvm.ArrayType(prog.tyInt8(), len(v))
+		global := llvm.AddGlobal(p.mod, typ, "")
+		global.SetInitializer(prog.ctx.ConstString(v, false))
+		global.SetLinkage(llvm.PrivateLinkage)
+		global.SetAlignment(1)
+		data = llvm.ConstInBoundsGEP(typ, global, []llvm.Value{prog.Val(0).impl})
+	} else {
+		data = llvm.ConstNull(prog.tyVoidPtr())
+	}
+	size := llvm.ConstInt(prog.tyInt(), uint64(len(v)), false)
+	return Expr{constStruct(prog.rtSlice(), data, size, size), prog.Slice(prog.Byte())}
+}
+
+// ConstSlice returns a constant slice of type t with the constant elements
+// elts, which are stored in a private global array.
+func (p Package) ConstSlice(t Type, elts ...Expr) Expr {
+	prog := p.Prog
+	n := len(elts)
+	if n == 0 {
+		return Expr{llvm.ConstNull(t.ll), t}
+	}
+	telem := prog.Index(t)
+	vals := make([]llvm.Value, n)
+	for i, elt := range elts {
+		vals[i] = elt.impl
+	}
+	typ := llvm.ArrayType(telem.ll, n)
+	global := llvm.AddGlobal(p.mod, typ, "")
+	global.SetInitializer(llvm.ConstArray(telem.ll, vals))
+	global.SetLinkage(llvm.PrivateLinkage)
+	data := llvm.ConstInBoundsGEP(typ, global, []llvm.Value{prog.Val(0).impl})
+	size := llvm.ConstInt(prog.tyInt(), uint64(n), false)
+	return Expr{constStruct(prog.rtSlice(), data, size, size), t}
+}
+
+// ConstStruct returns a constant struct of type t with the constant fields
+// flds.
+func (p Program) ConstStruct(t Type, flds ...Expr) Expr {
+	vals := make([]llvm.Value, len(flds))
+	for i, fld := range flds {
+		vals[i] = fld.impl
+	}
+	return Expr{constStruct(t.ll, vals...), t}
+}
+
+// ConstAddr returns the address of a private global variable initialized by
+// the constant v.
+func (p Package) ConstAddr(v Expr) Expr {
+	global := llvm.AddGlobal(p.mod, v.impl.Type(), "")
+	global.SetInitializer(v.impl)
+	global.SetLinkage(llvm.PrivateLinkage)
+	return Expr{global, p.Prog.Pointer(v.Type)}
+}
+
+// ConstFuncAddr returns the address of the C function name as a constant
+// uintptr.
+func (p Package) ConstFuncAddr(name string) Expr {
+	prog := p.Prog
+	fn := p.cFunc(name, NoArgsNoRet)
+	return Expr{llvm.ConstPtrToInt(fn.impl, prog.Uintptr().ll), prog.Uintptr()}
+}
+
+func constStruct(t llvm.Type, flds ...llvm.Value) llvm.Value {
+	if t.StructName() != "" {
+		return llvm.ConstNamedStruct(t, flds)
+	}
+	return llvm.ConstStruct(flds, false)
+}
+
 // unsafeString(data *byte, size int) string
 func (b Builder) unsafeString(data, size llvm.Value) Expr {
 	prog := b.Prog
@@ -290,6 +393,40 @@ func (b Builder) unsafeSlice(data Expr, size, cap llvm.Value) Expr {
 	return Expr{aggregateValue(b.impl, prog.rtSlice(), data.impl, size, cap), tslice}
 }
 
+// unsafeLen converts the len argument of unsafe.String or unsafe.Slice to int.
+// The result is negative if len doesn't fit in an int, so that the runtime
+// check panics.
+func (b Builder) unsafeLen(n Expr) Expr {
+	prog := b.Prog
+	tint := prog.Int()
+	ret := n.impl
+	switch nsize, size := prog.SizeOf(n.Type), prog.SizeOf(tint); {
+	case nsize < size && n.kind == vkUnsigned:
+		ret = llvm.CreateZExt(b.impl, ret, tint.ll)
+	case nsize < size:
+		ret = llvm.CreateSExt(b.impl, ret, tint.ll)
+	case nsize > size:
+		ret = llvm.CreateTrunc(b.impl, ret, tint.ll)
+		fits := llvm.CreateICmp(b.impl, llvm.IntEQ, llvm.CreateSExt(b.impl, ret, n.ll), n.impl)
+		ret = llvm.CreateSelect(b.impl, fits, ret, llvm.ConstInt(tint.ll, ^uint64(0), true))
+	}
+	return Expr{ret, tint}
+}
+
+// intptr converts the integer x to a pointer sized one, by sign extension if
+// x is signed, by zero extension if not.
+func (b Builder) intptr(x Expr) Expr {
+	prog := b.Prog
+	typ := prog.Int()
+	if x.kind == vkUnsigned {
+		typ = prog.Uintptr()
+	}
+	if prog.SizeOf(x.Type) >= prog.SizeOf(typ) {
+		return x
+	}
+	return Expr{castInt(b, x.impl, typ), typ}
+}
+
 // -----------------------------------------------------------------------------
 
 const (
@@ -406,6 +543,9 @@ func (b Builder) BinOp(op token.Token, x, y Expr) Expr {
 				return Expr{b.InlineCall(b.Pkg.rtFunc("StringCat"), x, y).impl, x.Type}
 			}
 		case vkComplex:
+			if op == token.QUO {
+				return b.complexDiv(x, y)
+			}
 			xr, xi := b.impl.CreateExtractValue(x.impl, 0, ""), b.impl.CreateExtractValue(x.impl, 1, "")
 			yr, yi := b.impl.CreateExtractValue(y.impl, 0, ""), b.impl.CreateExtractValue(y.impl, 1, "")
 			switch op {
@@ -427,32 +567,15 @@ func (b Builder) BinOp(op token.Token, x, y Expr) Expr {
 					llvm.CreateBinOp(b.impl, llvm.FMul, xi, yr),
 				)
 				return b.aggregateValue(x.Type, r, i)
-			case token.QUO:
-				d := llvm.CreateBinOp(b.impl, llvm.FAdd, llvm.CreateBinOp(b.impl, llvm.FMul, yr, yr), llvm.CreateBinOp(b.impl, llvm.FMul, yi, yi))
-				zero := llvm.CreateFCmp(b.impl, llvm.FloatOEQ, d, llvm.ConstNull(d.Type()))
-				r := llvm.CreateSelect(b.impl, zero,
-					llvm.CreateBinOp(b.impl, llvm.FDiv, xr, d),
-					llvm.CreateBinOp(b.impl, llvm.FDiv,
-						llvm.CreateBinOp(b.impl, llvm.FAdd,
-							llvm.CreateBinOp(b.impl, llvm.FMul, xr, yr),
-							llvm.CreateBinOp(b.impl, llvm.FMul, xi, yi),
-						),
-						d,
-					),
-				)
-				i := llvm.CreateSelect(b.impl, zero,
-					llvm.CreateBinOp(b.impl, llvm.FDiv, xi, d),
-					llvm.CreateBinOp(b.impl, llvm.FDiv,
-						llvm.CreateBinOp(b.impl, llvm.FSub,
-							llvm.CreateBinOp(b.impl, llvm.FMul, xr, yi),
-							llvm.CreateBinOp(b.impl, llvm.FMul, xi, yr),
-						),
-						d,
-					),
-				)
-				return b.aggregateValue(x.Type, r, i)
 			}
 		default:
+			if (op == token.QUO || op == token.REM) && (kind == vkSigned || kind == vkUnsigned) {
+				if c := y.impl.IsAConstantInt(); c.IsNil() || c.ZExtValue() == 0 {
+					zero := llvm.ConstInt(y.ll, 0, false)
+					check := Expr{llvm.CreateICmp(b.impl, llvm.IntEQ, y.impl, zero), b.Prog.Bool()}
+					b.InlineCall(b.Pkg.rtFunc("AssertDivideByZero"), check)
+				}
+			}
 			idx := mathOpIdx(op, kind)
 			if llop := mathOpToLLVM[idx]; llop != 0 {
 				return Expr{llvm.CreateBinOp(b.impl, llop, x.impl, y.impl), x.Type}
@@ -468,11 +591,29 @@ func (b Builder) BinOp(op token.Token, x, y Expr) Expr {
 				check := Expr{llvm.CreateICmp(b.impl, llvm.IntSLT, y.impl, zero), b.Prog.Bool()}
 				b.InlineCall(b.Pkg.rtFunc("AssertNegativeShift"), check)
 			}
+			// shifting by the width of x or more is defined in Go, but poison
+			// in LLVM: check it before y is truncated to the type of x, unless
+			// y is a constant smaller than the width.
 			xsize, ysize := b.Prog.SizeOf(x.Type), b.Prog.SizeOf(y.Type)
+			var overflows llvm.Value
+			if c := y.impl.IsAConstantInt(); c.IsNil() || c.ZExtValue() >= xsize*8 {
+				overflows = llvm.CreateICmp(b.impl, llvm.IntUGE, y.impl, llvm.ConstInt(y.ll, xsize*8, false))
+			}
 			if xsize != ysize {
 				y = b.Convert(x.Type, y)
 			}
-			overflows := llvm.CreateICmp(b.impl, llvm.IntUGE, y.impl, llvm.ConstInt(y.ll, xsize*8, false))
+			if overflows.IsNil() {
+				var llop llvm.Opcode
+				switch {
+				case op == token.SHL:
+					llop = llvm.Shl
+				case x.kind == vkSigned:
+					llop = llvm.AShr
+				default:
+					llop = llvm.LShr
+				}
+				return Expr{llvm.CreateBinOp(b.impl, llop, x.impl, y.impl), x.Type}
+			}
 			xzero := llvm.ConstInt(x.ll, 0, false)
 			if op == token.SHL {
 				rhs := llvm.CreateShl(b.impl, x.impl, y.impl)
@@ -550,19 +691,22 @@ func (b Builder) BinOp(op token.Token, x, y Expr) Expr {
 			x = b.Field(x, 0)
 			y = b.Field(y, 0)
 			fallthrough
-		case vkFuncPtr, vkFuncDecl:
+		case vkFuncPtr, vkMap, vkChan:
+			// funcs and maps can only be compared to nil: compare the pointers
+			// rather than checking if they are constant null, as x or y may be
+			// a nil variable.
 			switch op {
 			case token.EQL:
-				return b.Prog.BoolVal(x.impl.IsNull() == y.impl.IsNull())
+				return Expr{llvm.CreateICmp(b.impl, llvm.IntEQ, x.impl, y.impl), tret}
 			case token.NEQ:
-				return b.Prog.BoolVal(x.impl.IsNull() != y.impl.IsNull())
+				return Expr{llvm.CreateICmp(b.impl, llvm.IntNE, x.impl, y.impl), tret}
 			}
-		case vkChan:
+		case vkFuncDecl: // a declared function is never nil
 			switch op {
 			case token.EQL:
-				return Expr{llvm.CreateICmp(b.impl, llvm.IntEQ, x.impl, y.impl), tret}
+				return b.Prog.BoolVal(x.impl.IsNull() == y.impl.IsNull())
 			case token.NEQ:
-				return Expr{llvm.CreateICmp(b.impl, llvm.IntNE, x.impl, y.impl), tret}
+				return b.Prog.BoolVal(x.impl.IsNull() != y.impl.IsNull())
 			}
 		case vkArray:
 			typ := x.raw.Type.(*types.Array)
@@ -584,6 +728,9 @@ func (b Builder) BinOp(op token.Token, x, y Expr) Expr {
 			typ := x.raw.Type.Underlying().(*types.Struct)
 			ret := prog.BoolVal(true)
 			for i, n := 0, typ.NumFields(); i < n; i++ {
+				if typ.Field(i).Name() == "_" {
+					continue // blank fields are not compared
+				}
 				ft := prog.Type(typ.Field(i).Type(), InGo)
 				fx := b.impl.CreateExtractValue(x.impl, i, "")
 				fy := b.impl.CreateExtractValue(y.impl, i, "")
@@ -605,13 +752,6 @@ func (b Builder) BinOp(op token.Token, x, y Expr) Expr {
 			case token.NEQ:
 				return Expr{b.impl.CreateICmp(llvm.IntNE, dx, dy, ""), tret}
 			}
-		case vkMap:
-			switch op {
-			case token.EQL:
-				return b.Prog.BoolVal(x.impl.IsNull() == y.impl.IsNull())
-			case token.NEQ:
-				return b.Prog.BoolVal(x.impl.IsNull() != y.impl.IsNull())
-			}
 		case vkIface, vkEface:
 			toEface := func(x Expr, emtpy bool) Expr {
 				if emtpy {
@@ -632,6 +772,19 @@ func (b Builder) BinOp(op token.Token, x, y Expr) Expr {
 	panic("todo")
 }
 
+// complexDiv returns x/y. Like gc, it's done in complex128 by the runtime,
+// whose results for infinities and NaNs follow C99 Annex G.
+func (b Builder) complexDiv(x, y Expr) Expr {
+	prog := b.Prog
+	fn := b.Pkg.rtFunc("Complex128Div")
+	t := prog.Complex128()
+	if x.ll == t.ll {
+		return Expr{b.InlineCall(fn, x, y).impl, x.Type}
+	}
+	ret := b.InlineCall(fn, b.Convert(t, x), b.Convert(t, y))
+	return Expr{b.Convert(x.Type, ret).impl, x.Type}
+}
+
 // The UnOp instruction yields the result of (op x).
 // ARROW is channel receive.
 // MUL is pointer indirection (load).
@@ -796,11 +949,7 @@ func (b Builder) Convert(t Type, x Expr) (ret Expr) {
 					ret.impl = castInt(b, x.impl, t)
 					return
 				} else if xtyp.Info()&types.IsFloat != 0 {
-					if typ.Info()&types.IsUnsigned != 0 {
-						ret.impl = llvm.CreateFPToUI(b.impl, x.impl, t.ll)
-					} else {
-						ret.impl = llvm.CreateFPToSI(b.impl, x.impl, t.ll)
-					}
+					ret.impl = b.fpToInt(x, t)
 					return
 				}
 			} else if typ.Info()&types.IsFloat != 0 {
@@ -867,6 +1016,38 @@ func castInt(b Builder, x llvm.Value, typ Type) llvm.Value {
 	}
 }
 
+// fpToInt converts float x to integer type t. Out of range conversions are
+// implementation-defined in Go, but poison in LLVM: like gc, x is converted to
+// int64 (uint64 if it's too large) and truncated to t, with a saturating
+// conversion, so that results are the same on all targets. NaNs convert to 0.
+func (b Builder) fpToInt(x Expr, t Type) llvm.Value {
+	prog := b.Prog
+	ret := b.fpToInt64(x, true)
+	if prog.SizeOf(t) < 8 {
+		return llvm.CreateTrunc(b.impl, ret, t.ll)
+	}
+	if t.kind == vkUnsigned {
+		max := llvm.ConstFloat(x.ll, 1<<63)
+		small := llvm.CreateFCmp(b.impl, llvm.FloatOLT, x.impl, max)
+		ret = llvm.CreateSelect(b.impl, small, ret, b.fpToInt64(x, false))
+	}
+	return ret
+}
+
+func (b Builder) fpToInt64(x Expr, signed bool) llvm.Value {
+	prog := b.Prog
+	name, kind := "llvm.fptosi.sat.i64.f", types.Int64
+	if !signed {
+		name, kind = "llvm.fptoui.sat.i64.f", types.Uint64
+	}
+	xtyp := x.raw.Type.Underlying().(*types.Basic)
+	name += strconv.FormatUint(prog.SizeOf(x.Type)*8, 10)
+	params := types.NewTuple(types.NewParam(token.NoPos, nil, "", types.Typ[xtyp.Kind()]))
+	results := types.NewTuple(types.NewParam(token.NoPos, nil, "", types.Typ[kind]))
+	fn := b.Pkg.cFunc(name, types.NewSignatureType(nil, nil, nil, params, results, false))
+	return llvm.CreateCall(b.impl, fn.ll, fn.impl, []llvm.Value{x.impl})
+}
+
 func castFloat(b Builder, x llvm.Value, typ Type) llvm.Value {
 	xsize := b.Prog.td.TypeAllocSize(x.Type())
 	size := b.Prog.td.TypeAllocSize(typ.ll)
@@ -896,18 +1077,46 @@ func castPtr(b llvm.Builder, x llvm.Value, t llvm.Type) llvm.Value {
 //	t0 = make closure anon@1.2 [x y z]
 //	t1 = make closure bound$(main.I).add [i]
 func (b Builder) MakeClosure(fn Expr, bindings []Expr) Expr {
+	return b.MakeClosureEx(fn, bindings, true)
+}
+
+// MakeClosureEx is like MakeClosure, but the context of the closure is
+// allocated on the stack if heap is false. It isn't allocated if it's a
+// single pointer (see ctxWord).
+func (b Builder) MakeClosureEx(fn Expr, bindings []Expr, heap bool) Expr {
 	if debugInstr {
-		log.Printf("MakeClosure %v, %v\n", fn, bindings)
+		log.Printf("MakeClosure %v, %v, %v\n", fn, bindings, heap)
 	}
 	prog := b.Prog
 	tfn := fn.Type
 	sig := tfn.raw.Type.(*types.Signature)
 	tctx := sig.Params().At(0).Type().Underlying().(*types.Pointer).Elem().(*types.Struct)
 	flds := llvmFields(bindings, tctx, b)
-	data := b.aggregateAllocU(prog.rawType(tctx), flds...)
+	var data llvm.Value
+	if _, ok := prog.ctxWord(tctx); ok {
+		data = flds[0]
+	} else if heap {
+		data = b.aggregateAllocU(prog.rawType(tctx), flds...)
+	} else {
+		t := prog.rawType(tctx)
+		data = b.AllocaInEntry(t).impl
+		aggregateInit(b.impl, data, t.ll, flds...)
+	}
 	return b.aggregateValue(prog.Closure(tfn), fn.impl, data)
 }
 
+// ctxWord reports whether the context of closures whose free variables are
+// the fields of tctx is its only field, a pointer, instead of a pointer to
+// the fields: such closures, like the method values of pointer receivers,
+// don't allocate their context. It returns the type of the field.
+func (p Program) ctxWord(tctx *types.Struct) (Type, bool) {
+	if tctx.NumFields() != 1 {
+		return nil, false
+	}
+	t := p.Field(p.rawType(tctx), 0)
+	return t, t.ll.TypeKind() == llvm.PointerTypeKind
+}
+
 // -----------------------------------------------------------------------------
 
 // TODO(xsw): make inline call
@@ -949,6 +1158,9 @@ func (b Builder) Call(fn Expr, args ...Expr) (ret Expr) {
 	case vkFuncDecl:
 		sig = raw.(*types.Signature)
 		ll = fn.ll
+		if isSRet(ll, sig) {
+			return b.callSRet(fn, sig, args)
+		}
 	case vkBuiltin:
 		bi := raw.(*builtinTy)
 		return b.BuiltinCall(bi.name, args...)
@@ -960,6 +1172,19 @@ func (b Builder) Call(fn Expr, args ...Expr) (ret Expr) {
 	return
 }
 
+// callSRet calls a function that returns its results through a hidden
+// out-pointer parameter (see Package.NewFuncSRet).
+func (b Builder) callSRet(fn Expr, sig *types.Signature, args []Expr) (ret Expr) {
+	prog := b.Prog
+	ret.Type = prog.retType(sig)
+	ptr := b.allocaInEntry(ret.ll)
+	params := append([]llvm.Value{ptr}, llvmParams(0, args, sig.Params(), b)...)
+	call := llvm.CreateCall(b.impl, fn.ll, fn.impl, params)
+	call.AddCallSiteAttribute(1, prog.sretAttr(ret.Type))
+	ret.impl = llvm.CreateLoad(b.impl, ret.ll, ptr)
+	return
+}
+
 func logCall(da string, fn Expr, args []Expr) {
 	if fn.kind == vkBuiltin {
 		return
@@ -1040,9 +1265,14 @@ func (b Builder) BuiltinCall(fn string, args ...Expr) (ret Expr) {
 				elem := args[1]
 				switch elem.kind {
 				case vkSlice:
-					etSize := b.Prog.SizeOf(b.Prog.Elem(elem.Type))
+					telem := b.Prog.Elem(elem.Type)
+					etSize := b.Prog.SizeOf(telem)
+					fn := b.Pkg.rtFunc("SliceAppend")
+					if b.bulkBarrier(telem) {
+						fn = b.Pkg.rtFunc("SliceAppendWB")
+					}
 					ret.Type = src.Type
-					ret.impl = b.InlineCall(b.Pkg.rtFunc("SliceAppend"),
+					ret.impl = b.InlineCall(fn,
 						src, b.SliceData(elem), b.SliceLen(elem), b.Prog.Val(int(etSize))).impl
 					return
 				case vkString:
@@ -1060,10 +1290,15 @@ func (b Builder) BuiltinCall(fn string, args ...Expr) (ret Expr) {
 			if dst.kind == vkSlice {
 				src := args[1]
 				prog := b.Prog
-				etSize := prog.Val(int(prog.SizeOf(prog.Elem(dst.Type))))
+				telem := prog.Elem(dst.Type)
+				etSize := prog.Val(int(prog.SizeOf(telem)))
 				switch src.kind {
 				case vkSlice:
-					return b.InlineCall(b.Pkg.rtFunc("SliceCopy"), dst, b.SliceData(src), b.SliceLen(src), etSize)
+					fn := b.Pkg.rtFunc("SliceCopy")
+					if b.bulkBarrier(telem) {
+						fn = b.Pkg.rtFunc("SliceCopyWB")
+					}
+					return b.InlineCall(fn, dst, b.SliceData(src), b.SliceLen(src), etSize)
 				case vkString:
 					return b.InlineCall(b.Pkg.rtFunc("SliceCopy"), dst, b.StringData(src), b.StringLen(src), etSize)
 				}
@@ -1088,14 +1323,28 @@ func (b Builder) BuiltinCall(fn string, args ...Expr) (ret Expr) {
 	case "imag":
 		return b.getField(args[0], 1)
 	case "String": // unsafe.String
-		return b.unsafeString(args[0].impl, args[1].impl)
+		prog := b.Prog
+		ptr := args[0]
+		size := b.unsafeLen(args[1])
+		b.Call(b.Pkg.rtFunc("CheckUnsafeString"), Expr{ptr.impl, prog.VoidPtr()}, size)
+		return b.unsafeString(ptr.impl, size.impl)
 	case "Slice": // unsafe.Slice
-		size := args[1].impl
-		return b.unsafeSlice(args[0], size, size)
-	case "StringData":
-		return b.StringData(args[0]) // TODO(xsw): check return type
-	case "SliceData":
-		return b.SliceData(args[0]) // TODO(xsw): check return type
+		prog := b.Prog
+		ptr := args[0]
+		size := b.unsafeLen(args[1])
+		etSize := prog.Val(int(prog.SizeOf(prog.Elem(ptr.Type))))
+		b.Call(b.Pkg.rtFunc("CheckUnsafeSlice"), Expr{ptr.impl, prog.VoidPtr()}, size, etSize)
+		return b.unsafeSlice(ptr, size.impl, size.impl)
+	case "StringData": // unsafe.StringData
+		ret = b.StringData(args[0])
+		ret.Type = b.Prog.Pointer(b.Prog.Byte())
+		return
+	case "SliceData": // unsafe.SliceData
+		ret = b.SliceData(args[0])
+		ret.Type = b.Prog.Pointer(b.Prog.Elem(args[0].Type))
+		return
+	case "Add": // unsafe.Add
+		return b.Advance(args[0], b.intptr(args[1]))
 	case "delete":
 		if len(args) == 2 && args[0].kind == vkMap {
 			m := args[0]
@@ -1105,16 +1354,76 @@ func (b Builder) BuiltinCall(fn string, args ...Expr) (ret Expr) {
 			return
 		}
 	case "clear":
-		if len(args) == 1 && args[0].kind == vkMap {
-			m := args[0]
-			t := b.abiType(m.raw.Type)
-			b.Call(b.Pkg.rtFunc("MapClear"), t, m)
+		if len(args) == 1 {
+			arg := args[0]
+			switch arg.kind {
+			case vkMap:
+				t := b.abiType(arg.raw.Type)
+				b.Call(b.Pkg.rtFunc("MapClear"), t, arg)
+				return
+			case vkSlice:
+				prog := b.Prog
+				etSize := prog.Val(int(prog.SizeOf(prog.Elem(arg.Type))))
+				b.Call(b.Pkg.rtFunc("SliceClear"), arg, etSize)
+				return
+			}
+		}
+	case "min", "max":
+		if len(args) > 0 {
+			ret = args[0]
+			for _, arg := range args[1:] {
+				ret = b.minMax(fn == "max", ret, arg)
+			}
 			return
 		}
 	}
 	panic("todo: " + fn)
 }
 
+// minMax returns min(x, y), or max(x, y) if max is true. x and y are of the
+// same ordered type: untyped constant arguments are already converted to it.
+func (b Builder) minMax(max bool, x, y Expr) Expr {
+	if x.kind == vkFloat {
+		return b.fminMax(max, x, y)
+	}
+	op := token.LSS
+	if max {
+		op = token.GTR
+	}
+	cond := b.BinOp(op, y, x)
+	return Expr{llvm.CreateSelect(b.impl, cond.impl, y.impl, x.impl), x.Type}
+}
+
+// fminMax is minMax of floats: if x or y is a NaN, so is the result, and
+// -0.0 is less than 0.0.
+func (b Builder) fminMax(max bool, x, y Expr) Expr {
+	prog := b.Prog
+	xv, yv := x.impl, y.impl
+	pred := llvm.FloatOLT
+	if max {
+		pred = llvm.FloatOGT
+	}
+	ret := llvm.CreateSelect(b.impl, llvm.CreateFCmp(b.impl, pred, yv, xv), yv, xv)
+
+	// x == y, but they may be 0.0 and -0.0: the result is -0.0 if either is
+	// for min, or if both are for max.
+	tbits := prog.ctx.IntType(int(prog.SizeOf(x.Type) * 8))
+	xbits := llvm.CreateBitCast(b.impl, xv, tbits)
+	ybits := llvm.CreateBitCast(b.impl, yv, tbits)
+	var bits llvm.Value
+	if max {
+		bits = llvm.CreateAnd(b.impl, xbits, ybits)
+	} else {
+		bits = b.impl.CreateOr(xbits, ybits, "")
+	}
+	eq := llvm.CreateFCmp(b.impl, llvm.FloatOEQ, xv, yv)
+	ret = llvm.CreateSelect(b.impl, eq, llvm.CreateBitCast(b.impl, bits, x.ll), ret)
+
+	nan := llvm.CreateFCmp(b.impl, llvm.FloatUNO, xv, yv)
+	ret = llvm.CreateSelect(b.impl, nan, b.impl.CreateFAdd(xv, yv, ""), ret)
+	return Expr{ret, x.Type}
+}
+
 // Println prints the arguments to stderr, followed by a newline.
 func (b Builder) Println(args ...Expr) (ret Expr) {
 	return b.PrintEx(true, args...)
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// FrameSlotKind is the kind of a stack slot, as named by the code generator.
+type FrameSlotKind string
+
+const (
+	FrameFixed     FrameSlotKind = "Fixed"     // at a fixed offset, like stack arguments
+	FrameSpill     FrameSlotKind = "Spill"     // spill slot of the register allocator
+	FrameVariable  FrameSlotKind = "Variable"  // alloca, not promoted to registers
+	FrameProtector FrameSlotKind = "Protector" // stack protector canary
+)
+
+// FrameSlot describes a stack slot of a function frame.
+type FrameSlot struct {
+	Kind   FrameSlotKind
+	Names  []string // variables in the slot, if the function has debug info
+	Offset int64    // offset from the stack pointer on entry to the function
+	Size   uint64   // size in bytes
+	Align  int      // alignment in bytes
+	HasPtr bool     // slot may contain gc pointers (see Program.ReadFrameLayouts)
+}
+
+// FrameLayout describes the frame layout of a function, as the code generator
+// finally lays it out: slots merged, reordered or promoted to registers by it
+// aren't there, and spill slots are.
+type FrameLayout struct {
+	Slots []FrameSlot
+	Size  uint64 // size of the slots below the stack pointer on entry
+	Align int    // max alignment of all slots
+}
+
+// PtrSlots returns indexes of the slots that may contain gc pointers.
+func (p *FrameLayout) PtrSlots() (ret []int) {
+	for i, slot := range p.Slots {
+		if slot.HasPtr {
+			ret = append(ret, i)
+		}
+	}
+	return
+}
+
+// String returns a human readable representation of the frame layout.
+func (p *FrameLayout) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "frame size=%d align=%d\n", p.Size, p.Align)
+	for _, slot := range p.Slots {
+		fmt.Fprintf(&sb, "  [SP%+d] %s size=%d align=%d", slot.Offset, slot.Kind, slot.Size, slot.Align)
+		if len(slot.Names) != 0 {
+			fmt.Fprintf(&sb, " name=%s", strings.Join(slot.Names, ","))
+		}
+		if slot.HasPtr {
+			sb.WriteString(" ptr")
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// FrameLayouts are the frame layouts of functions, by name.
+type FrameLayouts map[string]*FrameLayout
+
+var (
+	remarkFunc = regexp.MustCompile(`^Function: (\S+)`)
+	remarkSlot = regexp.MustCompile(`^Offset: \[SP\+?(-?\d+)\], Type: (\w+), Align: (\d+), Size: (\d+)`)
+	remarkVar  = regexp.MustCompile(`^\s+(\S+) @ `)
+)
+
+// ParseFrameLayouts parses the frame layouts that the code generator reports
+// by its stack-frame-layout remarks (LLVM 16 or later), like clang prints them
+// with -Rpass-analysis=stack-frame-layout. Other lines are skipped.
+//
+// The code generator doesn't know the types of slots: HasPtr of slots isn't
+// set, see Program.ReadFrameLayouts.
+func ParseFrameLayouts(r io.Reader) (FrameLayouts, error) {
+	ret := make(FrameLayouts)
+	var cur *FrameLayout
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), " [-Rpass-analysis=")
+		if m := remarkFunc.FindStringSubmatch(line); m != nil {
+			cur = &FrameLayout{Align: 1}
+			ret[m[1]] = cur
+			continue
+		}
+		if cur == nil {
+			continue
+		}
+		if m := remarkSlot.FindStringSubmatch(line); m != nil {
+			slot := FrameSlot{Kind: FrameSlotKind(m[2])}
+			slot.Offset, _ = strconv.ParseInt(m[1], 10, 64)
+			slot.Align, _ = strconv.Atoi(m[3])
+			slot.Size, _ = strconv.ParseUint(m[4], 10, 64)
+			if slot.Offset < 0 && uint64(-slot.Offset) > cur.Size {
+				cur.Size = uint64(-slot.Offset)
+			}
+			if slot.Align > cur.Align {
+				cur.Align = slot.Align
+			}
+			cur.Slots = append(cur.Slots, slot)
+		} else if m := remarkVar.FindStringSubmatch(line); m != nil && len(cur.Slots) != 0 {
+			slot := &cur.Slots[len(cur.Slots)-1]
+			slot.Names = append(slot.Names, m[1])
+		}
+	}
+	return ret, s.Err()
+}
+
+// ReadFrameLayouts parses the frame layouts of stack-frame-layout remarks, like
+// ParseFrameLayouts, and sets the slots which may contain gc pointers of
+// ptrSize bytes.
+//
+// Deprecated: Use Program.ReadFrameLayouts instead.
+func ReadFrameLayouts(r io.Reader, ptrSize int) (FrameLayouts, error) {
+	ret, err := ParseFrameLayouts(r)
+	for _, layout := range ret {
+		layout.markPointers(ptrSize)
+	}
+	return ret, err
+}
+
+// Write writes the frame layouts, sorted by function name.
+func (p FrameLayouts) Write(w io.Writer) (err error) {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err = fmt.Fprintf(w, "%s: %v", name, p[name]); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// ErrNoFrameLayouts is returned when the code generator reports no frame
+// layouts for a module defining functions: LLVM before 16 has no
+// stack-frame-layout remarks.
+var ErrNoFrameLayouts = errors.New("no stack-frame-layout remarks from the code generator (LLVM 16 or later is needed)")
+
+// ReadFrameLayouts reads the frame layouts of the functions of the module in
+// the LLVM IR file llFile, from the stack-frame-layout remarks of the code
+// generator compiling it, and sets the slots which may contain gc pointers
+// (see markPointers). It returns ErrNoFrameLayouts if there are none.
+func (p Program) ReadFrameLayouts(r io.Reader, llFile string) (FrameLayouts, error) {
+	buf, err := llvm.NewMemoryBufferFromFile(llFile)
+	if err != nil {
+		return nil, err
+	}
+	mod, err := p.ctx.ParseIR(buf)
+	if err != nil {
+		return nil, err
+	}
+	defer mod.Dispose()
+	return p.readFrameLayouts(r, mod)
+}
+
+// ReadFrameLayouts reads the frame layouts of the functions of the package,
+// from the stack-frame-layout remarks of the code generator compiling its
+// module (see Program.ReadFrameLayouts).
+func (p Package) ReadFrameLayouts(r io.Reader) error {
+	layouts, err := p.Prog.readFrameLayouts(r, p.mod)
+	for name, layout := range layouts {
+		if fn, ok := p.fns[name]; ok {
+			fn.frame = layout
+		}
+	}
+	return err
+}
+
+func (p Program) readFrameLayouts(r io.Reader, mod llvm.Module) (FrameLayouts, error) {
+	layouts, err := ParseFrameLayouts(r)
+	if err != nil {
+		return layouts, err
+	}
+	for _, layout := range layouts {
+		layout.markPointers(p.ptrSize)
+	}
+	for fn := mod.FirstFunction(); !fn.IsNil(); fn = llvm.NextFunction(fn) {
+		if fn.BasicBlocksCount() != 0 && len(layouts) == 0 {
+			return layouts, ErrNoFrameLayouts
+		}
+	}
+	return layouts, nil
+}
+
+// markPointers sets the slots which may contain gc pointers of word bytes,
+// conservatively: the code generator merges the slots of values whose
+// lifetimes don't overlap, and spills values of any type, so the kind or size
+// of a slot doesn't tell what it holds. Any slot but the stack protector may,
+// if it's large and aligned enough for a pointer.
+func (p *FrameLayout) markPointers(word int) {
+	for i := range p.Slots {
+		slot := &p.Slots[i]
+		slot.HasPtr = slot.Kind != FrameProtector && slot.Size >= uint64(word) && slot.Align >= word
+	}
+}
+
+// FrameLayout returns the frame layout of the function read by
+// Package.ReadFrameLayouts. It returns nil if none is read, like if the
+// function has no body.
+func (p Function) FrameLayout() *FrameLayout {
+	return p.frame
+}
+
+// WriteFrameLayouts writes the frame layouts of the functions of the package
+// read by ReadFrameLayouts.
+func (p Package) WriteFrameLayouts(w io.Writer) error {
+	layouts := make(FrameLayouts)
+	for name, fn := range p.fns {
+		if fn.frame != nil {
+			layouts[name] = fn.frame
+		}
+	}
+	return layouts.Write(w)
+}
+
+// -----------------------------------------------------------------------------
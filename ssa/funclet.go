@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+/*
+typedef struct LLVMOpaqueBuilder *LLVMBuilderRef;
+typedef struct LLVMOpaqueValue *LLVMValueRef;
+typedef struct LLVMOpaqueBasicBlock *LLVMBasicBlockRef;
+
+LLVMValueRef LLVMBuildCatchPad(LLVMBuilderRef B, LLVMValueRef ParentPad, LLVMValueRef *Args, unsigned NumArgs, const char *Name);
+LLVMValueRef LLVMBuildCleanupPad(LLVMBuilderRef B, LLVMValueRef ParentPad, LLVMValueRef *Args, unsigned NumArgs, const char *Name);
+LLVMValueRef LLVMBuildCatchSwitch(LLVMBuilderRef B, LLVMValueRef ParentPad, LLVMBasicBlockRef UnwindBB, unsigned NumHandlers, const char *Name);
+void LLVMAddHandler(LLVMValueRef CatchSwitch, LLVMBasicBlockRef Dest);
+LLVMValueRef LLVMBuildCatchRet(LLVMBuilderRef B, LLVMValueRef CatchPad, LLVMBasicBlockRef BB);
+LLVMValueRef LLVMBuildCleanupRet(LLVMBuilderRef B, LLVMValueRef CatchPad, LLVMBasicBlockRef BB);
+*/
+import "C"
+
+import (
+	"go/types"
+	"log"
+	"runtime"
+	"unsafe"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// Funclet-based exception handling is used by the MSVC ABI (Windows). Instead
+// of landing pads, unwinding enters cleanuppad/catchpad blocks, which are
+// grouped by catchswitch and left by cleanupret/catchret.
+
+const msvcPersonality = "__CxxFrameHandler3"
+
+// UsesFunclets reports whether the target uses funclet-based exception
+// handling.
+func (p Program) UsesFunclets() bool {
+	goos := p.target.GOOS
+	if goos == "" {
+		goos = runtime.GOOS
+	}
+	return goos == "windows"
+}
+
+func (p Program) checkFunclets(op string) {
+	if !p.UsesFunclets() {
+		panic(op + ": funclets are only supported by Windows targets")
+	}
+}
+
+type tokenTy struct{}
+
+func (p tokenTy) Underlying() types.Type {
+	panic("don't call")
+}
+
+func (p tokenTy) String() string {
+	return "token"
+}
+
+// Token returns the token type (type of EH pads).
+func (p Program) Token() Type {
+	if p.tokenTy == nil {
+		p.tokenTy = &aType{p.ctx.TokenType(), rawType{tokenTy{}}, vkInvalid}
+	}
+	return p.tokenTy
+}
+
+// NonePad returns the `none` token, which is the parent pad of top-level EH
+// pads.
+func (p Program) NonePad() Expr {
+	t := p.Token()
+	return Expr{llvm.ConstNull(t.ll), t}
+}
+
+// SetMSVCPersonality sets the personality function of the function to the
+// MSVC C++ frame handler, which is required by functions having EH pads.
+func (p Function) SetMSVCPersonality() {
+	prog := p.Prog
+	prog.checkFunclets("SetMSVCPersonality")
+	mod := p.Pkg.mod
+	fn := mod.NamedFunction(msvcPersonality)
+	if fn.IsNil() {
+		ft := llvm.FunctionType(prog.ctx.Int32Type(), nil, true)
+		fn = llvm.AddFunction(mod, msvcPersonality, ft)
+	}
+	p.impl.SetPersonality(fn)
+}
+
+// -----------------------------------------------------------------------------
+
+// CatchSwitch emits a catchswitch instruction, which dispatches an exception
+// to handlers (blocks starting with CatchPad). parent is the enclosing pad
+// (NonePad if not nested). If unwind is nil, an exception not caught by the
+// handlers unwinds to the caller.
+func (b Builder) CatchSwitch(parent Expr, unwind BasicBlock, handlers ...BasicBlock) Expr {
+	prog := b.Prog
+	prog.checkFunclets("CatchSwitch")
+	if debugInstr {
+		log.Printf("CatchSwitch %v\n", parent.impl)
+	}
+	var unwindBB C.LLVMBasicBlockRef
+	if unwind != nil {
+		unwindBB = cBasicBlock(unwind.first)
+	}
+	cs := C.LLVMBuildCatchSwitch(cBuilder(b.impl), cValue(parent.impl), unwindBB, C.unsigned(len(handlers)), emptyCStr())
+	for _, h := range handlers {
+		C.LLVMAddHandler(cs, cBasicBlock(h.first))
+	}
+	return Expr{llvmValue(cs), prog.Token()}
+}
+
+// CatchPad emits a catchpad instruction, which must be the first instruction
+// of a handler of catchSwitch. args are passed to the personality function
+// (e.g. type descriptor, flags and the exception object slot for MSVC C++).
+func (b Builder) CatchPad(catchSwitch Expr, args ...Expr) Expr {
+	prog := b.Prog
+	prog.checkFunclets("CatchPad")
+	if debugInstr {
+		log.Printf("CatchPad %v\n", catchSwitch.impl)
+	}
+	cargs := cValues(args)
+	pad := C.LLVMBuildCatchPad(cBuilder(b.impl), cValue(catchSwitch.impl), cValuesPtr(cargs), C.unsigned(len(cargs)), emptyCStr())
+	return Expr{llvmValue(pad), prog.Token()}
+}
+
+// CleanupPad emits a cleanuppad instruction, which must be the first
+// instruction of a cleanup block (e.g. running deferred calls). parent is the
+// enclosing pad (NonePad if not nested).
+func (b Builder) CleanupPad(parent Expr, args ...Expr) Expr {
+	prog := b.Prog
+	prog.checkFunclets("CleanupPad")
+	if debugInstr {
+		log.Printf("CleanupPad %v\n", parent.impl)
+	}
+	cargs := cValues(args)
+	pad := C.LLVMBuildCleanupPad(cBuilder(b.impl), cValue(parent.impl), cValuesPtr(cargs), C.unsigned(len(cargs)), emptyCStr())
+	return Expr{llvmValue(pad), prog.Token()}
+}
+
+// CatchRet emits a catchret instruction, which leaves the handler of pad and
+// continues normal execution at succ.
+func (b Builder) CatchRet(pad Expr, succ BasicBlock) {
+	if b.Func != succ.fn {
+		panic("mismatched function")
+	}
+	if debugInstr {
+		log.Printf("CatchRet %v, _llgo_%v\n", pad.impl, succ.idx)
+	}
+	C.LLVMBuildCatchRet(cBuilder(b.impl), cValue(pad.impl), cBasicBlock(succ.first))
+}
+
+// CleanupRet emits a cleanupret instruction, which leaves the cleanup of pad
+// and continues unwinding to unwind (or to the caller if unwind is nil).
+func (b Builder) CleanupRet(pad Expr, unwind BasicBlock) {
+	if debugInstr {
+		log.Printf("CleanupRet %v\n", pad.impl)
+	}
+	var unwindBB C.LLVMBasicBlockRef
+	if unwind != nil {
+		unwindBB = cBasicBlock(unwind.first)
+	}
+	C.LLVMBuildCleanupRet(cBuilder(b.impl), cValue(pad.impl), unwindBB)
+}
+
+// -----------------------------------------------------------------------------
+
+// The LLVM C API of funclets is not exported by package llvm, so it's called
+// directly. Handles of package llvm are converted as they wrap the same C
+// pointers.
+
+func cBuilder(b llvm.Builder) C.LLVMBuilderRef {
+	return C.LLVMBuilderRef(unsafe.Pointer(b.C))
+}
+
+func cValue(v llvm.Value) C.LLVMValueRef {
+	return C.LLVMValueRef(unsafe.Pointer(v.C))
+}
+
+func cBasicBlock(bb llvm.BasicBlock) C.LLVMBasicBlockRef {
+	return C.LLVMBasicBlockRef(unsafe.Pointer(bb.C))
+}
+
+func llvmValue(v C.LLVMValueRef) llvm.Value {
+	return *(*llvm.Value)(unsafe.Pointer(&v))
+}
+
+func cValues(vals []Expr) []C.LLVMValueRef {
+	ret := make([]C.LLVMValueRef, len(vals))
+	for i, v := range vals {
+		ret[i] = cValue(v.impl)
+	}
+	return ret
+}
+
+func cValuesPtr(vals []C.LLVMValueRef) *C.LLVMValueRef {
+	if len(vals) == 0 {
+		return nil
+	}
+	return &vals[0]
+}
+
+var emptyName = [1]C.char{}
+
+func emptyCStr() *C.char {
+	return &emptyName[0]
+}
+
+// -----------------------------------------------------------------------------
@@ -36,23 +36,11 @@ func (p Program) tyRoutine() *types.Signature {
 	return p.routineTy
 }
 
-// func(pthread *Thread, attr *Attr, routine func(c.Pointer) c.Pointer, arg c.Pointer) c.Int
-func (p Program) tyPthreadCreate() *types.Signature {
-	if p.createThdTy == nil {
-		paramPPtr := types.NewParam(token.NoPos, nil, "", p.VoidPtrPtr().raw.Type)
-		paramPtr := types.NewParam(token.NoPos, nil, "", p.VoidPtr().raw.Type)
-		paramRoutine := types.NewParam(token.NoPos, nil, "", p.tyRoutine())
-		paramCInt := types.NewParam(token.NoPos, nil, "", p.CInt().raw.Type)
-		params := types.NewTuple(paramPPtr, paramPtr, paramRoutine, paramPtr)
-		results := types.NewTuple(paramCInt)
-		p.createThdTy = types.NewSignatureType(nil, nil, nil, params, results, false)
-	}
-	return p.createThdTy
-}
-
-func (b Builder) pthreadCreate(pp, attr, routine, arg Expr) Expr {
-	fn := b.Pkg.cFunc("pthread_create", b.Prog.tyPthreadCreate())
-	return b.Call(fn, pp, attr, routine, arg)
+// createThread starts a new thread through the runtime, which enforces the
+// thread and stack limits of runtime/debug.
+func (b Builder) createThread(pp, routine, arg Expr) Expr {
+	fn := b.Pkg.rtFunc("CreateThread")
+	return b.Call(fn, pp, routine, arg)
 }
 
 // -----------------------------------------------------------------------------
@@ -86,7 +74,7 @@ func (b Builder) Go(fn Expr, args ...Expr) {
 	data := Expr{b.aggregateMalloc(t, flds...), voidPtr}
 	size := prog.SizeOf(voidPtr)
 	pthd := b.Alloca(prog.IntVal(uint64(size), prog.Uintptr()))
-	b.pthreadCreate(pthd, prog.Nil(voidPtr), pkg.routine(t, len(args)), data)
+	b.createThread(pthd, pkg.routine(t, len(args)), data)
 }
 
 func (p Package) routineName() string {
@@ -123,60 +111,26 @@ func (p Program) tyDestruct() *types.Signature {
 	return p.destructTy
 }
 
-// func(*c.Int, func(c.Pointer)) c.Int
-func (p Program) tyPthreadKeyCreate() *types.Signature {
-	if p.createKeyTy == nil {
-		cint := p.CInt()
-		cintPtr := p.Pointer(cint)
-		paramCintPtr := types.NewParam(token.NoPos, nil, "", cintPtr.raw.Type)
-		paramDestruct := types.NewParam(token.NoPos, nil, "", p.tyDestruct())
-		paramCInt := types.NewParam(token.NoPos, nil, "", cint.raw.Type)
-		params := types.NewTuple(paramCintPtr, paramDestruct)
-		results := types.NewTuple(paramCInt)
-		p.createKeyTy = types.NewSignatureType(nil, nil, nil, params, results, false)
-	}
-	return p.createKeyTy
-}
-
-func (b Builder) pthreadKeyCreate(key, destruct Expr) Expr {
-	fn := b.Pkg.cFunc("pthread_key_create", b.Prog.tyPthreadKeyCreate())
-	return b.Call(fn, key, destruct)
-}
-
 // -----------------------------------------------------------------------------
 
-// func(c.Int) c.Pointer
-func (p Program) tyPthreadGetspecific() *types.Signature {
-	if p.getSpecTy == nil {
-		paramCInt := types.NewParam(token.NoPos, nil, "", p.CInt().raw.Type)
-		paramPtr := types.NewParam(token.NoPos, nil, "", p.VoidPtr().raw.Type)
-		params := types.NewTuple(paramCInt)
-		results := types.NewTuple(paramPtr)
-		p.getSpecTy = types.NewSignatureType(nil, nil, nil, params, results, false)
-	}
-	return p.getSpecTy
-}
-
-// func(c.Int, c.Pointer) c.Int
-func (p Program) tyPthreadSetspecific() *types.Signature {
-	if p.setSpecTy == nil {
-		paramCInt := types.NewParam(token.NoPos, nil, "", p.CInt().raw.Type)
-		paramPtr := types.NewParam(token.NoPos, nil, "", p.VoidPtr().raw.Type)
-		params := types.NewTuple(paramCInt, paramPtr)
-		results := types.NewTuple(paramCInt)
-		p.setSpecTy = types.NewSignatureType(nil, nil, nil, params, results, false)
-	}
-	return p.setSpecTy
-}
-
-func (b Builder) pthreadGetspecific(key Expr) Expr {
-	fn := b.Pkg.cFunc("pthread_getspecific", b.Prog.tyPthreadGetspecific())
-	return b.Call(fn, key)
-}
+// NameG is the runtime.G of the goroutine running on a thread. It's a
+// thread-local global rather than a pthread key, so that getting it is not a
+// call but an offset from the thread pointer register (see initThreadLocal).
+const NameG = "__llgo_g"
+
+const (
+	// 0: Defer *Defer
+	// 1: deferFn voidptr
+	// 2: deferFrame *Defer
+	gDefer = iota
+	gDeferFn
+	gDeferFrame
+)
 
-func (b Builder) pthreadSetspecific(key, val Expr) Expr {
-	fn := b.Pkg.cFunc("pthread_setspecific", b.Prog.tyPthreadSetspecific())
-	return b.Call(fn, key, val)
+// G returns the address of the runtime.G of the current goroutine.
+func (b Builder) G() Expr {
+	prog := b.Prog
+	return b.Pkg.NewVarEx(NameG, prog.Pointer(prog.G())).Expr
 }
 
 // -----------------------------------------------------------------------------
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// Hardening is a set of security hardening options of generated code, like
+// the -fstack-protector-strong, -fstack-clash-protection and -fcf-protection
+// options of clang.
+type Hardening int
+
+const (
+	// HardenStackProtector adds stack protectors (sspstrong) to functions
+	// with local arrays or address-taken locals.
+	HardenStackProtector Hardening = 1 << iota
+	// HardenStackClash probes stack allocations page by page, so the stack
+	// can't jump over its guard page.
+	HardenStackClash
+	// HardenCFProtection enables Intel CET (indirect branch tracking and
+	// shadow stack). It is a module flag, so it applies to whole packages.
+	HardenCFProtection
+
+	HardenAll = HardenStackProtector | HardenStackClash | HardenCFProtection
+)
+
+var hardenNames = []struct {
+	name string
+	h    Hardening
+}{
+	{"sspstrong", HardenStackProtector},
+	{"stackclash", HardenStackClash},
+	{"cfprotection", HardenCFProtection},
+	{"all", HardenAll},
+	{"none", 0},
+}
+
+// ParseHardening parses a comma or space separated list of hardening options:
+// sspstrong, stackclash, cfprotection, all or none.
+func ParseHardening(s string) (ret Hardening, err error) {
+	opts := strings.FieldsFunc(s, func(c rune) bool {
+		return c == ',' || c == ' ' || c == '\t'
+	})
+next:
+	for _, opt := range opts {
+		for _, v := range hardenNames {
+			if v.name == opt {
+				ret |= v.h
+				continue next
+			}
+		}
+		return 0, errors.New("unknown hardening option: " + opt)
+	}
+	return
+}
+
+func (h Hardening) String() string {
+	if h == HardenAll {
+		return "all"
+	}
+	var opts []string
+	for _, v := range hardenNames[:3] {
+		if h&v.h != 0 {
+			opts = append(opts, v.name)
+		}
+	}
+	return strings.Join(opts, ",")
+}
+
+// SetHardening sets the default hardening options of packages created after.
+func (p Program) SetHardening(h Hardening) {
+	p.harden = h
+}
+
+// Hardening returns the hardening options of the package.
+func (p Package) Hardening() Hardening {
+	return p.harden
+}
+
+// SetHardening sets the hardening options of the package. They apply to
+// functions whose bodies are made after (see Function.SetHardening). Once CF
+// protection is enabled, it can't be disabled.
+func (p Package) SetHardening(h Hardening) {
+	p.harden = h
+	if h&HardenCFProtection != 0 && !p.cfProt {
+		p.cfProt = true
+		p.addModuleFlag("cf-protection-branch", 1)
+		p.addModuleFlag("cf-protection-return", 1)
+	}
+}
+
+const (
+	moduleFlagWarning  = 2 // llvm::Module::Warning
+	moduleFlagOverride = 4 // llvm::Module::Override
+	moduleFlagMax      = 7 // llvm::Module::Max
+)
+
+func (p Package) addModuleFlag(name string, val uint64) {
+	p.addModuleFlagEx(moduleFlagOverride, name, val)
+}
+
+func (p Package) addModuleFlagEx(behavior uint64, name string, val uint64) {
+	prog := p.Prog
+	ctx := prog.ctx
+	i32 := ctx.Int32Type()
+	p.mod.AddNamedMetadataOperand("llvm.module.flags", ctx.MDNode([]llvm.Metadata{
+		llvm.ConstInt(i32, behavior, false).ConstantAsMetadata(),
+		ctx.MDString(name),
+		llvm.ConstInt(i32, val, false).ConstantAsMetadata(),
+	}))
+}
+
+// SetHardening sets the hardening options of the function, overriding those
+// of its package. CF protection is per package and is ignored here.
+func (p Function) SetHardening(h Hardening) {
+	p.hardenSet = true
+	p.setHardening(h)
+}
+
+func (p Function) setHardening(h Hardening) {
+	const attrIndexFunc = -1 // LLVMAttributeFunctionIndex
+	if h&HardenStackProtector != 0 {
+		p.addFnAttr("sspstrong")
+	} else if kind := llvm.AttributeKindID("sspstrong"); kind != 0 {
+		p.impl.RemoveEnumFunctionAttribute(kind)
+	}
+	if h&HardenStackClash != 0 {
+		p.impl.AddFunctionAttr(p.Prog.ctx.CreateStringAttribute("probe-stack", "inline-asm"))
+	} else {
+		p.impl.RemoveStringAttributeAtIndex(attrIndexFunc, "probe-stack")
+	}
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+// -----------------------------------------------------------------------------
+
+// An Instrumenter instruments the memory accesses emitted by builders, e.g.
+// with address sanitizer shadow checks or race detector calls. Load and Store
+// are called with the builder positioned right before the access, Alloca
+// right after the stack allocation. Accesses emitted by the callbacks
+// themselves are not instrumented, nor are the ones of functions excluded
+// from sanitizers (see Function.SetNoSanitize).
+type Instrumenter interface {
+	// Load is called before a load from ptr.
+	Load(b Builder, ptr Expr)
+
+	// Store is called before val is stored at ptr.
+	Store(b Builder, ptr, val Expr)
+
+	// Alloca is called after ptr is allocated on the stack for n elements of
+	// type elem. n is a nil Expr for a single element.
+	Alloca(b Builder, ptr Expr, elem Type, n Expr)
+}
+
+// An AtomicInstrumenter also instruments atomic operations. Their accesses
+// are then not passed to Load and Store, as they are synchronization rather
+// than data accesses for a race detector, which only records the edges they
+// order.
+type AtomicInstrumenter interface {
+	Instrumenter
+
+	// Release is called before an atomic operation storing at ptr.
+	Release(b Builder, ptr Expr)
+
+	// Acquire is called after an atomic operation loading from ptr.
+	Acquire(b Builder, ptr Expr)
+}
+
+// SetInstrumenter sets the instrumenter of the memory accesses emitted by
+// builders of the program. A nil instrumenter disables instrumentation.
+func (p Program) SetInstrumenter(instr Instrumenter) {
+	p.instr = instr
+}
+
+func (b Builder) instrumenter() Instrumenter {
+	if b.instrumenting || b.Func.noSanitize {
+		return nil
+	}
+	return b.Prog.instr
+}
+
+func (b Builder) instrLoad(ptr Expr) {
+	if instr := b.instrumenter(); instr != nil {
+		b.instrumenting = true
+		defer func() { b.instrumenting = false }()
+		instr.Load(b, ptr)
+	}
+}
+
+func (b Builder) instrStore(ptr, val Expr) {
+	if instr := b.instrumenter(); instr != nil {
+		b.instrumenting = true
+		defer func() { b.instrumenting = false }()
+		instr.Store(b, ptr, val)
+	}
+}
+
+func (b Builder) atomicInstrumenter() AtomicInstrumenter {
+	instr, _ := b.instrumenter().(AtomicInstrumenter)
+	return instr
+}
+
+func (b Builder) instrRelease(ptr Expr) {
+	if instr := b.atomicInstrumenter(); instr != nil {
+		b.instrumenting = true
+		defer func() { b.instrumenting = false }()
+		instr.Release(b, ptr)
+	}
+}
+
+func (b Builder) instrAcquire(ptr Expr) {
+	if instr := b.atomicInstrumenter(); instr != nil {
+		b.instrumenting = true
+		defer func() { b.instrumenting = false }()
+		instr.Acquire(b, ptr)
+	}
+}
+
+func (b Builder) instrAlloca(ptr Expr, elem Type, n Expr) {
+	if instr := b.instrumenter(); instr != nil {
+		b.instrumenting = true
+		defer func() { b.instrumenting = false }()
+		instr.Alloca(b, ptr, elem, n)
+	}
+}
+
+// -----------------------------------------------------------------------------
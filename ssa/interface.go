@@ -51,6 +51,16 @@ func (b Builder) unsafeInterface(rawIntf *types.Interface, t Expr, data llvm.Val
 	return b.unsafeIface(itab.impl, data)
 }
 
+// staticInterface is like unsafeInterface, but the concrete type t of the
+// interface is known statically, so the itab is built only once (see loadItab).
+func (b Builder) staticInterface(rawIntf *types.Interface, t types.Type, tabi Expr, data llvm.Value) llvm.Value {
+	if rawIntf.Empty() {
+		return b.unsafeEface(tabi.impl, data)
+	}
+	itab := b.Load(b.loadItab(rawIntf, t).Expr)
+	return b.unsafeIface(itab.impl, data)
+}
+
 func iMethodOf(rawIntf *types.Interface, name string) int {
 	n := rawIntf.NumMethods()
 	for i := 0; i < n; i++ {
@@ -73,7 +83,7 @@ func (b Builder) Imethod(intf Expr, method *types.Func) Expr {
 	impl := intf.impl
 	itab := Expr{b.faceItab(impl), prog.VoidPtrPtr()}
 	pfn := b.Advance(itab, prog.IntVal(uint64(i+3), prog.Int()))
-	fn := b.Load(pfn)
+	fn := b.LoadNonNil(pfn)
 	ret := b.aggregateValue(tclosure, fn.impl, data.impl)
 	return ret
 }
@@ -107,7 +117,7 @@ func (b Builder) MakeInterface(tinter Type, x Expr) (ret Expr) {
 	case abi.Indirect:
 		vptr := b.AllocU(typ)
 		b.Store(vptr, x)
-		return Expr{b.unsafeInterface(rawIntf, tabi, vptr.impl), tinter}
+		return Expr{b.staticInterface(rawIntf, typ.raw.Type, tabi, vptr.impl), tinter}
 	}
 	ximpl := x.impl
 	if lvl > 0 {
@@ -116,7 +126,7 @@ func (b Builder) MakeInterface(tinter Type, x Expr) (ret Expr) {
 	var u llvm.Value
 	switch kind {
 	case abi.Pointer:
-		return Expr{b.unsafeInterface(rawIntf, tabi, ximpl), tinter}
+		return Expr{b.staticInterface(rawIntf, typ.raw.Type, tabi, ximpl), tinter}
 	case abi.Integer:
 		tu := prog.Uintptr()
 		u = llvm.CreateIntCast(b.impl, ximpl, tu.ll)
@@ -127,7 +137,7 @@ func (b Builder) MakeInterface(tinter Type, x Expr) (ret Expr) {
 		panic("todo")
 	}
 	data := llvm.CreateIntToPtr(b.impl, u, prog.tyVoidPtr())
-	return Expr{b.unsafeInterface(rawIntf, tabi, data), tinter}
+	return Expr{b.staticInterface(rawIntf, typ.raw.Type, tabi, data), tinter}
 }
 
 func (b Builder) valFromData(typ Type, data llvm.Value) Expr {
@@ -270,7 +280,8 @@ func (b Builder) TypeAssert(x Expr, assertedTyp Type, commaOk bool) Expr {
 	blks := b.Func.MakeBlocks(2)
 	b.If(eq, blks[0], blks[1])
 	b.SetBlockEx(blks[1], AtEnd, false)
-	b.Panic(b.MakeInterface(b.Prog.Any(), b.Str("type assertion failed")))
+	b.Call(b.Pkg.rtFunc("PanicTypeAssert"), tx, tabi)
+	b.Unreachable()
 	b.SetBlockEx(blks[0], AtEnd, false)
 	b.blk.last = blks[0].last
 	return val()
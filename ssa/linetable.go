@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// SetLineTables enables line tables: the debug info of the functions whose
+// bodies are made after records the source positions of their instructions
+// (see Function.SetPos and Builder.SetPos), and is emitted as DWARF line
+// tables, by which the runtime symbolizes the stacks of panics. Neither
+// types nor variables are described.
+func (p Program) SetLineTables(b bool) {
+	p.lineTables = b
+}
+
+// SetPos sets the source position of the function. It must be called before
+// the body of the function is made, and has no effect without line tables.
+func (p Function) SetPos(file string, line int) {
+	p.posFile, p.posLine = file, line
+}
+
+// SetPos sets the source position of the instructions built after, in the
+// file of the function or in another one, like for package initializers. It
+// has no effect if the function has no position.
+func (b Builder) SetPos(file string, line, col int) {
+	fn := b.Func
+	if fn.diSP.C == nil {
+		return
+	}
+	scope := fn.diSP
+	if file != fn.posFile {
+		di := b.Pkg.di
+		scope = di.CreateLexicalBlockFile(scope, b.Pkg.diFile(file), 0)
+	}
+	b.impl.SetCurrentDebugLocation(uint(line), uint(col), scope, llvm.Metadata{})
+}
+
+// setSubprogram describes the function by a subprogram, when its body is
+// first made.
+func (p Function) setSubprogram() {
+	pkg := p.Pkg
+	if pkg.di == nil {
+		pkg.di = llvm.NewDIBuilder(pkg.mod)
+		pkg.diFiles = make(map[string]llvm.Metadata)
+		pkg.di.CreateCompileUnit(llvm.DICompileUnit{
+			Language: llvm.DW_LANG_Go,
+			File:     p.posFile,
+			Producer: "llgo",
+		})
+		pkg.addModuleFlagEx(moduleFlagWarning, "Debug Info Version", 3)
+		pkg.addModuleFlagEx(moduleFlagMax, "Dwarf Version", 4)
+	}
+	di := pkg.di
+	file := pkg.diFile(p.posFile)
+	name := p.Name()
+	p.diSP = di.CreateFunction(file, llvm.DIFunction{
+		Name:         name,
+		LinkageName:  name,
+		File:         file,
+		Line:         p.posLine,
+		Type:         di.CreateSubroutineType(llvm.DISubroutineType{File: file}),
+		IsDefinition: true,
+		ScopeLine:    p.posLine,
+	})
+	p.impl.SetSubprogram(p.diSP)
+}
+
+// diFile returns the debug info of a source file. Files are named by their
+// full paths, with no directory, so that the runtime reads them from the
+// line tables alone.
+func (p Package) diFile(file string) llvm.Metadata {
+	md, ok := p.diFiles[file]
+	if !ok {
+		md = p.di.CreateFile(file, "")
+		p.diFiles[file] = md
+	}
+	return md
+}
+
+// setDebugLoc sets the position of the instructions built by b to the
+// function itself, until a position is set by Builder.SetPos: each call in a
+// function with debug info needs a location.
+func (p Function) setDebugLoc(b llvm.Builder) {
+	if p.diSP.C != nil {
+		b.SetCurrentDebugLocation(uint(p.posLine), 0, p.diSP, llvm.Metadata{})
+	}
+}
+
+// finalizeDebugInfo completes the debug info of the package, once its
+// functions are built. It may be called again after more are.
+func (p Package) finalizeDebugInfo() {
+	if p.di != nil {
+		p.di.Finalize()
+	}
+}
+
+// -----------------------------------------------------------------------------
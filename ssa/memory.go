@@ -16,6 +16,14 @@
 
 package ssa
 
+/*
+typedef struct LLVMOpaqueBuilder *LLVMBuilderRef;
+typedef struct LLVMOpaqueValue *LLVMValueRef;
+
+LLVMValueRef LLVMBuildFence(LLVMBuilderRef B, int Ordering, int SingleThread, const char *Name);
+*/
+import "C"
+
 import (
 	"go/token"
 	"go/types"
@@ -29,7 +37,7 @@ import (
 func (b Builder) aggregateAllocU(t Type, flds ...llvm.Value) llvm.Value {
 	prog := b.Prog
 	size := prog.SizeOf(t)
-	ptr := b.allocUninited(prog.IntVal(size, prog.Uintptr())).impl
+	ptr := b.InlineCall(b.allocFunc(t, false), prog.IntVal(size, prog.Uintptr())).impl
 	aggregateInit(b.impl, ptr, t.ll, flds...)
 	return ptr
 }
@@ -85,7 +93,9 @@ func (b Builder) dupMalloc(v Expr) Expr {
 //
 // If heap is false, Alloc zero-initializes the same local variable in
 // the call frame and returns its address; in this case the Alloc must
-// be present in Function.Locals. We call this a "local" alloc.
+// be present in Function.Locals. We call this a "local" alloc. The
+// stack slot of a local alloc is hoisted into the entry block (see
+// AllocaInEntry).
 //
 // If heap is true, Alloc allocates a new zero-initialized variable
 // each time the instruction is executed. We call this a "new" alloc.
@@ -107,9 +117,9 @@ func (b Builder) Alloc(elem Type, heap bool) (ret Expr) {
 	pkg := b.Pkg
 	size := SizeOf(prog, elem)
 	if heap {
-		ret = b.InlineCall(pkg.rtFunc("AllocZ"), size)
+		ret = b.InlineCall(b.allocFunc(elem, true), size)
 	} else {
-		ret = Expr{llvm.CreateAlloca(b.impl, elem.ll), prog.VoidPtr()}
+		ret = Expr{b.AllocaInEntry(elem).impl, prog.VoidPtr()}
 		ret.impl = b.InlineCall(pkg.rtFunc("Zeroinit"), ret, size).impl
 	}
 	ret.Type = prog.Pointer(elem)
@@ -120,7 +130,54 @@ func (b Builder) Alloc(elem Type, heap bool) (ret Expr) {
 func (b Builder) AllocU(elem Type, n ...int64) (ret Expr) {
 	prog := b.Prog
 	size := SizeOf(prog, elem, n...)
-	return Expr{b.allocUninited(size).impl, prog.Pointer(elem)}
+	return Expr{b.InlineCall(b.allocFunc(elem, false), size).impl, prog.Pointer(elem)}
+}
+
+// SetNoscanAlloc enables or disables noscan allocations: heap objects of types
+// without pointers are allocated by runtime.AllocUNoscan and AllocZNoscan,
+// and slices of such elements by MakeSliceNoscan, so that the collector
+// doesn't scan them. It's the first step towards type-informed allocation
+// for a precise collector, and is disabled by default.
+func (p Program) SetNoscanAlloc(b bool) {
+	p.noscan = b
+}
+
+// NoscanAlloc reports whether noscan allocations are enabled.
+func (p Program) NoscanAlloc() bool {
+	return p.noscan
+}
+
+// allocFunc returns the runtime function allocating an object of type t,
+// zero-initialized if zero.
+func (b Builder) allocFunc(t Type, zero bool) Expr {
+	pkg := b.Pkg
+	noscan := b.Prog.noscan && !llvmHasPointers(t.ll)
+	switch {
+	case zero && noscan:
+		return pkg.rtFunc("AllocZNoscan")
+	case zero:
+		return pkg.rtFunc("AllocZ")
+	case noscan:
+		return pkg.rtFunc("AllocUNoscan")
+	}
+	return pkg.rtFunc("AllocU")
+}
+
+// llvmHasPointers reports whether a value of type t may contain pointers.
+func llvmHasPointers(t llvm.Type) bool {
+	switch t.TypeKind() {
+	case llvm.PointerTypeKind:
+		return true
+	case llvm.StructTypeKind:
+		for _, elem := range t.StructElementTypes() {
+			if llvmHasPointers(elem) {
+				return true
+			}
+		}
+	case llvm.ArrayTypeKind:
+		return t.ArrayLength() > 0 && llvmHasPointers(t.ElementType())
+	}
+	return false
 }
 
 func (b Builder) allocUninited(size Expr) (ret Expr) {
@@ -141,6 +198,45 @@ func (b Builder) Alloca(n Expr) (ret Expr) {
 	telem := prog.tyInt8()
 	ret.impl = llvm.CreateArrayAlloca(b.impl, telem, n.impl)
 	ret.Type = prog.VoidPtr()
+	b.instrAlloca(ret, prog.Byte(), n)
+	return
+}
+
+// AllocaInEntry allocates uninitialized space for a value of type elem in the
+// entry block of the current function, and returns its address. Allocas in
+// the entry block are static: they are promoted by mem2reg and don't grow the
+// stack when executed in a loop.
+//
+// If the builder is in the entry block, the alloca is created at the current
+// insertion point. Otherwise it is created after the leading allocas of the
+// entry block.
+func (b Builder) AllocaInEntry(elem Type) (ret Expr) {
+	if debugInstr {
+		log.Printf("AllocaInEntry %v\n", elem.RawType())
+	}
+	ret.impl = b.allocaInEntry(elem.ll)
+	ret.Type = b.Prog.Pointer(elem)
+	b.instrAlloca(ret, elem, Expr{})
+	return
+}
+
+func (b Builder) allocaInEntry(t llvm.Type) (ret llvm.Value) {
+	entry := b.Func.impl.EntryBasicBlock()
+	if b.impl.GetInsertBlock() == entry {
+		return llvm.CreateAlloca(b.impl, t)
+	}
+	eb := b.Prog.ctx.NewBuilder()
+	pos := entry.FirstInstruction()
+	for !pos.IsNil() && !pos.IsAAllocaInst().IsNil() {
+		pos = llvm.NextInstruction(pos)
+	}
+	if pos.IsNil() {
+		eb.SetInsertPointAtEnd(entry)
+	} else {
+		eb.SetInsertPointBefore(pos)
+	}
+	ret = llvm.CreateAlloca(eb, t)
+	eb.Dispose()
 	return
 }
 
@@ -210,6 +306,18 @@ func (p Program) tyFree() *types.Signature {
 	return p.freeTy
 }
 
+func (p Program) tyMemcmp() *types.Signature {
+	if p.memcmpTy == nil {
+		paramPtr := types.NewParam(token.NoPos, nil, "", p.VoidPtr().raw.Type)
+		paramSize := types.NewParam(token.NoPos, nil, "", p.Uintptr().raw.Type)
+		paramInt := types.NewParam(token.NoPos, nil, "", p.CInt().raw.Type)
+		params := types.NewTuple(paramPtr, paramPtr, paramSize)
+		results := types.NewTuple(paramInt)
+		p.memcmpTy = types.NewSignatureType(nil, nil, nil, params, results, false)
+	}
+	return p.memcmpTy
+}
+
 func (b Builder) malloc(size Expr) Expr {
 	fn := b.Pkg.cFunc("malloc", b.Prog.tyMalloc())
 	return b.Call(fn, size)
@@ -220,6 +328,11 @@ func (b Builder) free(ptr Expr) Expr {
 	return b.Call(fn, ptr)
 }
 
+func (b Builder) memcmp(x, y, n Expr) Expr {
+	fn := b.Pkg.cFunc("memcmp", b.Prog.tyMemcmp())
+	return b.Call(fn, x, y, n)
+}
+
 // -----------------------------------------------------------------------------
 
 // ArrayAlloca reserves space for an array of n elements of type telem.
@@ -229,6 +342,7 @@ func (b Builder) ArrayAlloca(telem Type, n Expr) (ret Expr) {
 	}
 	ret.impl = llvm.CreateArrayAlloca(b.impl, telem.ll, n.impl)
 	ret.Type = b.Prog.Pointer(telem)
+	b.instrAlloca(ret, telem, n)
 	return
 }
 
@@ -270,7 +384,10 @@ func (b Builder) Atomic(op AtomicOp, ptr, val Expr) Expr {
 	}
 	t := b.Prog.Elem(ptr.Type)
 	val = b.ChangeType(t, val)
+	b.writeBarrier(ptr, val) // OpXchg may store a pointer
+	b.instrRelease(ptr)
 	ret := b.impl.CreateAtomicRMW(op, ptr.impl, val.impl, llvm.AtomicOrderingSequentiallyConsistent, false)
+	b.instrAcquire(ptr)
 	return Expr{ret, t}
 }
 
@@ -283,12 +400,73 @@ func (b Builder) AtomicCmpXchg(ptr, old, new Expr) Expr {
 	t := prog.Elem(ptr.Type)
 	old = b.ChangeType(t, old)
 	new = b.ChangeType(t, new)
+	b.writeBarrier(ptr, new) // whether or not new is stored, like in Go
+	b.instrRelease(ptr)
 	ret := b.impl.CreateAtomicCmpXchg(
 		ptr.impl, old.impl, new.impl,
 		llvm.AtomicOrderingSequentiallyConsistent, llvm.AtomicOrderingSequentiallyConsistent, false)
+	b.instrAcquire(ptr)
 	return Expr{ret, prog.Struct(t, prog.Bool())}
 }
 
+// AtomicLoad returns the value at the pointer ptr by a sequentially
+// consistent atomic load.
+func (b Builder) AtomicLoad(ptr Expr) Expr {
+	if debugInstr {
+		log.Printf("AtomicLoad %v\n", ptr.impl)
+	}
+	telem := b.Prog.Elem(ptr.Type)
+	if b.atomicInstrumenter() == nil {
+		b.instrLoad(ptr)
+	}
+	ret := llvm.CreateLoad(b.impl, telem.ll, ptr.impl)
+	ret.SetOrdering(llvm.AtomicOrderingSequentiallyConsistent)
+	b.instrAcquire(ptr)
+	return Expr{ret, telem}
+}
+
+// AtomicStore stores val at the pointer ptr by a sequentially consistent
+// atomic store.
+func (b Builder) AtomicStore(ptr, val Expr) Expr {
+	raw := ptr.raw.Type
+	if debugInstr {
+		log.Printf("AtomicStore %v, %v, %v\n", raw, ptr.impl, val.impl)
+	}
+	val = checkExpr(val, raw.(*types.Pointer).Elem(), b)
+	if b.atomicInstrumenter() == nil {
+		b.instrStore(ptr, val)
+	} else {
+		b.instrRelease(ptr)
+	}
+	b.writeBarrier(ptr, val)
+	ret := b.impl.CreateStore(val.impl, ptr.impl)
+	ret.SetOrdering(llvm.AtomicOrderingSequentiallyConsistent)
+	return Expr{ret, b.Prog.Void()}
+}
+
+// Fence emits a memory barrier with the specified ordering, which must be
+// acquire, release, acquire-release or sequentially consistent. If
+// singleThread is true, it only synchronizes with signal handlers running in
+// the same thread, that is, it is a compiler barrier.
+//
+// The barrier is a fence instruction, lowered to the barrier instruction of
+// the target, and known as such to the optimizer and ThreadSanitizer.
+func (b Builder) Fence(ordering AtomicOrdering, singleThread bool) {
+	if debugInstr {
+		log.Printf("Fence %v, %v\n", ordering, singleThread)
+	}
+	switch ordering {
+	case OrderingAcquire, OrderingRelease, OrderingAcquireRelease, OrderingSeqConsistent:
+	default:
+		panic("Fence: invalid ordering")
+	}
+	single := C.int(0)
+	if singleThread {
+		single = 1
+	}
+	C.LLVMBuildFence(cBuilder(b.impl), C.int(ordering), single, emptyCStr())
+}
+
 // Load returns the value at the pointer ptr.
 func (b Builder) Load(ptr Expr) Expr {
 	if debugInstr {
@@ -298,9 +476,74 @@ func (b Builder) Load(ptr Expr) Expr {
 		return b.pyLoad(ptr)
 	}
 	telem := b.Prog.Elem(ptr.Type)
+	b.instrLoad(ptr)
 	return Expr{llvm.CreateLoad(b.impl, telem.ll, ptr.impl), telem}
 }
 
+// LoadNonNil is like Load, but the loaded pointer is known to be non-nil, which
+// is told to LLVM by !nonnull metadata.
+func (b Builder) LoadNonNil(ptr Expr) Expr {
+	if debugInstr {
+		log.Printf("LoadNonNil %v\n", ptr.impl)
+	}
+	ret := b.Load(ptr)
+	ctx := b.Prog.ctx
+	ret.impl.SetMetadata(ctx.MDKindID("nonnull"), ctx.MDNode(nil))
+	return ret
+}
+
+// IsNonNil reports whether the pointer x is known to be non-nil: the address
+// of a global, a function or a stack slot, a new allocation, an inbounds field
+// or element address of a non-nil pointer, or a pointer loaded by LoadNonNil.
+func (b Builder) IsNonNil(x Expr) bool {
+	prog := b.Prog
+	v := x.impl
+	for {
+		switch {
+		case !v.IsAGlobalVariable().IsNil(), !v.IsAFunction().IsNil(), !v.IsAAllocaInst().IsNil():
+			return true
+		case !v.IsAGetElementPtrInst().IsNil(), !v.IsABitCastInst().IsNil():
+			v = v.Operand(0)
+		case !v.IsAConstantExpr().IsNil():
+			switch v.Opcode() {
+			case llvm.GetElementPtr, llvm.BitCast:
+				v = v.Operand(0)
+			default:
+				return false
+			}
+		case !v.IsALoadInst().IsNil():
+			return !v.Metadata(prog.ctx.MDKindID("nonnull")).IsNil()
+		case !v.IsACallInst().IsNil():
+			switch v.CalledValue().Name() {
+			case PkgRuntime + ".AllocU", PkgRuntime + ".AllocZ",
+				PkgRuntime + ".AllocUNoscan", PkgRuntime + ".AllocZNoscan":
+				return true
+			case PkgRuntime + ".Zeroinit": // Zeroinit returns its first argument
+				v = v.Operand(0)
+			default:
+				return false
+			}
+		default:
+			return false
+		}
+	}
+}
+
+// WrapNilCheck checks the receiver x of a wrapper of the value method
+// typ.method in the method set of *typ, and panics if x is nil. The check is
+// dropped if x is known to be non-nil (see IsNonNil).
+func (b Builder) WrapNilCheck(x Expr, typ, method string) Expr {
+	if debugInstr {
+		log.Printf("WrapNilCheck %v, %s, %s\n", x.impl, typ, method)
+	}
+	if !b.IsNonNil(x) {
+		prog := b.Prog
+		isNil := Expr{b.impl.CreateIsNull(x.impl, ""), prog.Bool()}
+		b.InlineCall(b.Pkg.rtFunc("AssertNilWrap"), isNil, b.Str(typ), b.Str(method))
+	}
+	return x
+}
+
 // Store stores val at the pointer ptr.
 func (b Builder) Store(ptr, val Expr) Expr {
 	raw := ptr.raw.Type
@@ -308,9 +551,38 @@ func (b Builder) Store(ptr, val Expr) Expr {
 		log.Printf("Store %v, %v, %v\n", raw, ptr.impl, val.impl)
 	}
 	val = checkExpr(val, raw.(*types.Pointer).Elem(), b)
+	b.instrStore(ptr, val)
+	b.writeBarrier(ptr, val)
 	return Expr{b.impl.CreateStore(val.impl, ptr.impl), b.Prog.Void()}
 }
 
+// LoadVolatile returns the value at the pointer ptr by a volatile load, which
+// is never removed, merged or reordered with other volatile operations.
+func (b Builder) LoadVolatile(ptr Expr) Expr {
+	if debugInstr {
+		log.Printf("LoadVolatile %v\n", ptr.impl)
+	}
+	telem := b.Prog.Elem(ptr.Type)
+	b.instrLoad(ptr)
+	ret := llvm.CreateLoad(b.impl, telem.ll, ptr.impl)
+	ret.SetVolatile(true)
+	return Expr{ret, telem}
+}
+
+// StoreVolatile stores val at the pointer ptr by a volatile store.
+func (b Builder) StoreVolatile(ptr, val Expr) Expr {
+	raw := ptr.raw.Type
+	if debugInstr {
+		log.Printf("StoreVolatile %v, %v, %v\n", raw, ptr.impl, val.impl)
+	}
+	val = checkExpr(val, raw.(*types.Pointer).Elem(), b)
+	b.instrStore(ptr, val)
+	b.writeBarrier(ptr, val)
+	ret := b.impl.CreateStore(val.impl, ptr.impl)
+	ret.SetVolatile(true)
+	return Expr{ret, b.Prog.Void()}
+}
+
 // Advance returns the pointer ptr advanced by offset.
 func (b Builder) Advance(ptr Expr, offset Expr) Expr {
 	if debugInstr {
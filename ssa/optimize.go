@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// OptLevel is an optimization level, like the -O options of clang: the
+// default pipeline of the LLVM new pass manager run over a module. The zero
+// value is -O0, like for clang. -Os and -Oz optimize for size, for embedded
+// targets: -Oz doesn't even unroll or vectorize loops.
+type OptLevel int
+
+const (
+	OptO0 OptLevel = iota // no optimization (default)
+	OptO1
+	OptO2
+	OptO3
+	OptOs
+	OptOz
+)
+
+var optLevelNames = [...]string{"0", "1", "2", "3", "s", "z"}
+
+// ParseOptLevel parses an optimization level: 0, 1, 2, 3, s or z, as in -O2.
+func ParseOptLevel(s string) (OptLevel, error) {
+	for i, name := range optLevelNames {
+		if name == s {
+			return OptLevel(i), nil
+		}
+	}
+	return OptO0, errors.New("unknown optimization level: -O" + s)
+}
+
+// String returns the option of clang for l, eg. -O2.
+func (l OptLevel) String() string {
+	return "-O" + optLevelNames[l]
+}
+
+// SetSampleProfile sets the sample profile of the program, in the text
+// format of LLVM, which Package.Optimize loads before the pass pipeline: it
+// annotates the functions with their entry counts and their branches with
+// weights, by which passes tell hot code from cold code, and inline, lay out
+// blocks or split functions accordingly. Samples are matched to instructions
+// by the line tables, relative to the lines of the functions (see
+// SetLineTables). The file is an option of LLVM, so a process has a single
+// one.
+func (p Program) SetSampleProfile(file string) {
+	p.sampleProf = file
+}
+
+var sampleProfile struct {
+	mu   sync.Mutex
+	file string
+}
+
+// useSampleProfile sets the option of LLVM naming the file of the sample
+// profile loaded by the sample-profile pass, once per process.
+func useSampleProfile(file string) error {
+	sp := &sampleProfile
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if sp.file == "" {
+		llvm.ParseCommandLineOptions([]string{"llgo", "-sample-profile-file=" + file}, "")
+		sp.file = file
+	} else if sp.file != file {
+		return errors.New("cannot use sample profile " + file + ": " + sp.file + " is in use")
+	}
+	return nil
+}
+
+// returnsTwiceFuncs are the C functions returning twice called by Go code:
+// by defer (see Builder.Sigsetjmp) and through package c/setjmp.
+var returnsTwiceFuncs = []string{"setjmp", "sigsetjmp"}
+
+// Optimize runs the pass pipeline of level over the package, for the target
+// of its program. With OptO0, the package is left as generated.
+func (p Package) Optimize(level OptLevel) error {
+	if level == OptO0 {
+		return nil
+	}
+	// values live across setjmp must stay in memory, which passes only know
+	// from its returns_twice attribute, set by clang for C code
+	if kind := llvm.AttributeKindID("returns_twice"); kind != 0 {
+		for _, name := range returnsTwiceFuncs {
+			if fn := p.mod.NamedFunction(name); !fn.IsNil() {
+				fn.AddFunctionAttr(p.Prog.ctx.CreateEnumAttribute(kind, 0))
+			}
+		}
+	}
+	passes := "default<O" + optLevelNames[level] + ">"
+	if file := p.Prog.sampleProf; file != "" {
+		if err := useSampleProfile(file); err != nil {
+			return err
+		}
+		// the profile is loaded for the functions marked, as clang does
+		attr := p.Prog.ctx.CreateStringAttribute("use-sample-profile", "")
+		for fn := p.mod.FirstFunction(); !fn.IsNil(); fn = llvm.NextFunction(fn) {
+			if !fn.IsDeclaration() {
+				fn.AddFunctionAttr(attr)
+			}
+		}
+		passes = "sample-profile," + passes
+	}
+	tm, err := p.Prog.targetMachine()
+	if err != nil {
+		return err
+	}
+	p.finalizeDebugInfo()
+	opts := llvm.NewPassBuilderOptions()
+	defer opts.Dispose()
+	return p.mod.RunPasses(passes, tm, opts)
+}
+
+// -----------------------------------------------------------------------------
@@ -110,12 +110,24 @@ type aProgram struct {
 	py    *types.Package
 	pyget func() *types.Package
 
-	target *Target
-	td     llvm.TargetData
-	// tm  llvm.TargetMachine
+	target  *Target
+	td      llvm.TargetData
+	tm      llvm.TargetMachine
+	triple  string // set by SetTargetTriple
+	layout  string // set by SetTargetTriple or SetDataLayout
+	instr   Instrumenter
+	harden  Hardening // default hardening options of packages
+	live    func(recv types.Type, id string) bool
 	named   map[string]llvm.Type
 	fnnamed map[string]int
 
+	wbarrier   bool       // see SetWriteBarrier
+	stackMaps  bool       // see SetStackMaps
+	noscan     bool       // see SetNoscanAlloc
+	sanitizers Sanitizers // see SetSanitizers
+	lineTables bool       // see SetLineTables
+	sampleProf string     // see SetSampleProfile
+
 	intType   llvm.Type
 	int1Type  llvm.Type
 	int8Type  llvm.Type
@@ -156,6 +168,7 @@ type aProgram struct {
 	u32Ty     Type
 	i64Ty     Type
 	u64Ty     Type
+	tokenTy   Type
 
 	pyObjPtr  Type
 	pyObjPPtr Type
@@ -164,6 +177,7 @@ type aProgram struct {
 	abiTyPPtr Type
 	deferTy   Type
 	deferPtr  Type
+	gTy       Type
 
 	pyImpTy      *types.Signature
 	pyNewList    *types.Signature
@@ -178,15 +192,13 @@ type aProgram struct {
 
 	mallocTy *types.Signature
 	freeTy   *types.Signature
+	memcmpTy *types.Signature
 
-	createKeyTy *types.Signature
-	createThdTy *types.Signature
-	getSpecTy   *types.Signature
-	setSpecTy   *types.Signature
 	routineTy   *types.Signature
 	destructTy  *types.Signature
 	sigsetjmpTy *types.Signature
 	sigljmpTy   *types.Signature
+	gcrootTy    *types.Signature
 
 	paramObjPtr_ *types.Var
 
@@ -236,6 +248,14 @@ func (p Program) SetRuntime(runtime any) {
 	}
 }
 
+// SetLiveMethod sets the function reporting whether method id (see types.Id)
+// of type recv may be called, through interfaces or reflection. Method tables
+// of type descriptors don't refer to the functions of dead methods, so that
+// the linker can drop them. By default all methods are live.
+func (p Program) SetLiveMethod(live func(recv types.Type, id string) bool) {
+	p.live = live
+}
+
 func (p Program) runtime() *types.Package {
 	if p.rt == nil {
 		p.rt = p.rtget()
@@ -317,6 +337,12 @@ func (p Program) tyComplex128() llvm.Type {
 // NewPackage creates a new package.
 func (p Program) NewPackage(name, pkgPath string) Package {
 	mod := p.ctx.NewModule(pkgPath)
+	if p.triple != "" {
+		mod.SetTarget(p.triple)
+	}
+	if p.layout != "" {
+		mod.SetDataLayout(p.layout)
+	}
 	// TODO(xsw): Finalize may cause panic, so comment it.
 	// mod.Finalize()
 	gbls := make(map[string]Global)
@@ -333,6 +359,7 @@ func (p Program) NewPackage(name, pkgPath string) Package {
 		mod: mod, vars: gbls, fns: fns, stubs: stubs,
 		pyobjs: pyobjs, pymods: pymods, strs: strs, named: named, Prog: p}
 	ret.abi.Init(pkgPath)
+	ret.SetHardening(p.harden)
 	return ret
 }
 
@@ -353,6 +380,14 @@ func (p Program) Defer() Type {
 	return p.deferTy
 }
 
+// G returns runtime.G type.
+func (p Program) G() Type {
+	if p.gTy == nil {
+		p.gTy = p.rtType("G")
+	}
+	return p.gTy
+}
+
 // DeferPtr returns *runtime.Defer type.
 func (p Program) DeferPtr() Type {
 	if p.deferPtr == nil {
@@ -579,7 +614,18 @@ type aPackage struct {
 	afterb unsafe.Pointer
 	patch  func(types.Type) types.Type
 
+	ctors    []ctorEntry
+	dtors    []ctorEntry
+	exported bool // has exports, see markExports
+
+	harden Hardening // see SetHardening
+	cfProt bool      // CF protection module flags are added
+
+	di      *llvm.DIBuilder          // debug info of line tables (see SetLineTables)
+	diFiles map[string]llvm.Metadata // source file => debug info
+
 	iRoutine int
+	iDefer   int
 }
 
 type Package = *aPackage
@@ -598,12 +644,21 @@ func (p Package) cFunc(fullName string, sig *types.Signature) Expr {
 const (
 	closureCtx  = "__llgo_ctx"
 	closureStub = "__llgo_stub."
+	closureCall = "__llgo_call."
+	closureMake = "__llgo_makefunc."
 )
 
 func (p Package) closureStub(b Builder, t *types.Struct, v Expr) Expr {
-	name := v.impl.Name()
 	prog := b.Prog
 	nilVal := prog.Nil(prog.VoidPtr()).impl
+	v = p.stubOf(v)
+	return b.aggregateValue(prog.rawType(t), v.impl, nilVal)
+}
+
+// stubOf returns the stub of the function v, which is the code of closures of
+// v: it takes the closure context and calls v.
+func (p Package) stubOf(v Expr) Expr {
+	name := v.impl.Name()
 	if fn, ok := p.stubs[name]; ok {
 		v = fn.Expr
 	} else {
@@ -630,7 +685,112 @@ func (p Package) closureStub(b Builder, t *types.Struct, v Expr) Expr {
 		p.stubs[name] = fn
 		v = fn.Expr
 	}
-	return b.aggregateValue(prog.rawType(t), v.impl, nilVal)
+	return v
+}
+
+// closureSig returns the Go signature of the closure type t, that is the
+// signature of its function without the context parameter.
+func closureSig(t *types.Struct) *types.Signature {
+	sig := t.Field(0).Type().(*types.Signature)
+	params := sig.Params()
+	n := params.Len()
+	in := make([]*types.Var, n-1)
+	for i := 1; i < n; i++ {
+		in[i-1] = params.At(i)
+	}
+	variadic := t.Tag(0) == closureVariadic
+	return types.NewSignatureType(nil, nil, nil, types.NewTuple(in...), sig.Results(), variadic)
+}
+
+// closureFrame returns the struct type laying out the variables of tuple in
+// memory, which is the frame of the arguments or the results of the reflect
+// call thunks.
+func (p Program) closureFrame(tuple *types.Tuple) Type {
+	n := tuple.Len()
+	typs := make([]Type, n)
+	for i := 0; i < n; i++ {
+		typs[i] = p.rawType(tuple.At(i).Type())
+	}
+	return p.Struct(typs...)
+}
+
+// closureThunks returns the thunks of the function type sig used by package
+// reflect, which calls Go functions of any type through them:
+//
+//	func __llgo_call.T(ctx, fn, args, results unsafe.Pointer)
+//	func __llgo_makefunc.T(ctx unsafe.Pointer, params...) (results...)
+//
+// The call thunk calls the closure *fn with the arguments read from the frame
+// args, and stores its results into the frame results. The makefunc thunk is
+// the function of closures made by reflect.MakeFunc: it stores its parameters
+// into a frame and calls the Go func(args, results unsafe.Pointer) at ctx.
+//
+// sig is a raw signature: its parameters and results are already converted.
+func (p Package) closureThunks(sig *types.Signature) (call, stub Function) {
+	name, _ := p.abi.TypeName(sig)
+	if fn, ok := p.fns[closureCall+name]; ok {
+		return fn, p.fns[closureMake+name]
+	}
+	prog := p.Prog
+	params, results := sig.Params(), sig.Results()
+	n, nret := params.Len(), results.Len()
+	sig = FuncAddCtx(newClosureCtx(), types.NewSignatureType(nil, nil, nil, params, results, false))
+	tclosure := prog.rawType(closureOf(sig))
+	targs := prog.closureFrame(params)
+	tres := prog.closureFrame(results)
+
+	call = p.NewFunc(closureCall+name, reflectCallSig, InC)
+	call.impl.SetLinkage(llvm.LinkOnceAnyLinkage)
+	b := call.MakeBody(1)
+	fn := b.Load(Expr{call.Param(1).impl, prog.Pointer(tclosure)})
+	args := Expr{call.Param(2).impl, prog.Pointer(targs)}
+	vals := make([]Expr, n)
+	for i := range vals {
+		vals[i] = b.Load(b.FieldAddr(args, i))
+	}
+	ret := b.Call(fn, vals...)
+	rets := Expr{call.Param(3).impl, prog.Pointer(tres)}
+	switch nret {
+	case 0:
+	case 1:
+		b.Store(b.FieldAddr(rets, 0), ret)
+	default:
+		for i := 0; i < nret; i++ {
+			b.Store(b.FieldAddr(rets, i), b.Extract(ret, i))
+		}
+	}
+	b.Return()
+
+	stub = p.NewFunc(closureMake+name, sig, InC)
+	stub.impl.SetLinkage(llvm.LinkOnceAnyLinkage)
+	b = stub.MakeBody(1)
+	impl := b.Load(Expr{stub.Param(0).impl, prog.Pointer(prog.Type(reflectImplSig, InGo))})
+	args = b.AllocaInEntry(targs)
+	for i := 0; i < n; i++ {
+		b.Store(b.FieldAddr(args, i), stub.Param(i+1))
+	}
+	rets = b.AllocaInEntry(tres)
+	b.Call(impl, Expr{args.impl, prog.VoidPtr()}, Expr{rets.impl, prog.VoidPtr()})
+	vals = make([]Expr, nret)
+	for i := range vals {
+		vals[i] = b.Load(b.FieldAddr(rets, i))
+	}
+	b.Return(vals...)
+	return
+}
+
+var (
+	// func(ctx, fn, args, results unsafe.Pointer)
+	reflectCallSig = types.NewSignatureType(nil, nil, nil, types.NewTuple(
+		newVoidPtrParam(closureCtx), newVoidPtrParam("fn"),
+		newVoidPtrParam("args"), newVoidPtrParam("results")), nil, false)
+	// func(args, results unsafe.Pointer)
+	reflectImplSig = types.NewSignatureType(nil, nil, nil, types.NewTuple(
+		newVoidPtrParam("args"), newVoidPtrParam("results")), nil, false)
+)
+
+func newVoidPtrParam(name string) *types.Var {
+	return types.NewParam(token.NoPos, nil, name, types.Typ[types.UnsafePointer])
 }
 
 // -----------------------------------------------------------------------------
@@ -642,6 +802,7 @@ func (p Package) Path() string {
 
 // String returns a string representation of the package.
 func (p Package) String() string {
+	p.finalizeDebugInfo()
 	return p.mod.String()
 }
 
@@ -663,7 +824,6 @@ func (p Package) afterBuilder() Builder {
 
 // AfterInit is called after the package is initialized (init all packages that depends on).
 func (p Package) AfterInit(b Builder, ret BasicBlock) {
-	p.keyInit(deferKey)
 	doAfterb := p.afterb != nil
 	doPyLoadModSyms := p.pyHasModSyms()
 	if doAfterb || doPyLoadModSyms {
@@ -682,23 +842,6 @@ func (p Package) AfterInit(b Builder, ret BasicBlock) {
 // -----------------------------------------------------------------------------
 
 /*
-type CodeGenFileType = llvm.CodeGenFileType
-
-const (
-	AssemblyFile = llvm.AssemblyFile
-	ObjectFile   = llvm.ObjectFile
-)
-
-func (p *Package) CodeGen(ft CodeGenFileType) (ret []byte, err error) {
-	buf, err := p.prog.targetMachine().EmitToMemoryBuffer(p.mod, ft)
-	if err != nil {
-		return
-	}
-	ret = buf.Bytes()
-	buf.Dispose()
-	return
-}
-
 func (p *Package) Bitcode() []byte {
 	buf := llvm.WriteBitcodeToMemoryBuffer(p.mod)
 	ret := buf.Bytes()
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"go/types"
+	"strconv"
+)
+
+// -----------------------------------------------------------------------------
+
+// RaceDetector instruments the memory accesses for the race detector of
+// ThreadSanitizer, whose runtime is linked with -fsanitize=thread: a call of
+// __tsan_readN or __tsan_writeN before each access of N bytes, or of
+// __tsan_read_range or __tsan_write_range for other sizes. Accesses of stack
+// slots are not checked: variables whose address escapes are allocated in
+// the heap. Atomic operations are annotated by __tsan_release before and
+// __tsan_acquire after them, so that the accesses they order don't race.
+//
+// Goroutines are threads, so the synchronization of the runtime, which uses
+// pthread locks, is seen by ThreadSanitizer. Functions don't report their
+// entries and exits: reports only show the accesses themselves.
+var RaceDetector AtomicInstrumenter = raceDetector{}
+
+type raceDetector struct{}
+
+var (
+	raceAccessSig = types.NewSignatureType(nil, nil, nil, types.NewTuple(
+		types.NewVar(0, nil, "addr", types.Typ[types.UnsafePointer])), nil, false)
+	raceRangeSig = types.NewSignatureType(nil, nil, nil, types.NewTuple(
+		types.NewVar(0, nil, "addr", types.Typ[types.UnsafePointer]),
+		types.NewVar(0, nil, "size", types.Typ[types.Uintptr])), nil, false)
+)
+
+func (raceDetector) Load(b Builder, ptr Expr) {
+	b.raceAccess("__tsan_read", ptr)
+}
+
+func (raceDetector) Store(b Builder, ptr, val Expr) {
+	b.raceAccess("__tsan_write", ptr)
+}
+
+func (raceDetector) Alloca(b Builder, ptr Expr, elem Type, n Expr) {}
+
+func (raceDetector) Release(b Builder, ptr Expr) {
+	b.Call(b.Pkg.cFunc("__tsan_release", raceAccessSig), Expr{ptr.impl, b.Prog.VoidPtr()})
+}
+
+func (raceDetector) Acquire(b Builder, ptr Expr) {
+	b.Call(b.Pkg.cFunc("__tsan_acquire", raceAccessSig), Expr{ptr.impl, b.Prog.VoidPtr()})
+}
+
+func (b Builder) raceAccess(fn string, ptr Expr) {
+	if b.Func.noRace || isStackAddr(ptr.impl) {
+		return
+	}
+	prog := b.Prog
+	size := prog.SizeOf(prog.Elem(ptr.Type))
+	addr := Expr{ptr.impl, prog.VoidPtr()}
+	switch size {
+	case 0:
+	case 1, 2, 4, 8, 16:
+		b.Call(b.Pkg.cFunc(fn+strconv.FormatUint(size, 10), raceAccessSig), addr)
+	default:
+		b.Call(b.Pkg.cFunc(fn+"_range", raceRangeSig), addr, prog.Val(uintptr(size)))
+	}
+}
+
+// SetNoRace excludes the plain memory accesses of the function from the race
+// detector, but not its atomic operations, like in Go for package sync: its
+// plain accesses are ordered by its atomics and by the semaphores of the
+// runtime, not by the locks it implements (see llgo:build norace).
+func (p Function) SetNoRace() {
+	p.noRace = true
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"go/types"
+)
+
+// -----------------------------------------------------------------------------
+
+// HookKind is the category of a runtime hook.
+type HookKind int
+
+const (
+	HookAlloc  HookKind = iota // memory allocation
+	HookPanic                  // panic, recover and runtime checks
+	HookChan                   // channel operations and select
+	HookMap                    // map operations
+	HookString                 // string operations
+	HookSlice                  // slice operations
+	HookIface                  // interface operations
+	HookType                   // runtime type information
+	HookPrint                  // builtin print/println
+	HookGo                     // go statement
+	HookMath                   // arithmetic not lowered to instructions
+	HookGC                     // garbage collector (see Program.SetWriteBarrier)
+	HookCgo                    // calls of exported functions from C
+	HookCover                  // coverage counters (see Package.NewCoverCounters)
+)
+
+// RuntimeHook describes a function of the runtime that generated code calls.
+//
+// The runtime is linked as ordinary code, so it can be replaced at link time
+// by an alternative implementation (e.g. a minimal embedded runtime or one
+// with a research GC), as long as it provides all hooks with the signatures
+// declared in package github.com/goplus/llgo/internal/runtime.
+type RuntimeHook struct {
+	Kind HookKind
+	Name string // name of the function in the runtime package
+}
+
+// Symbol returns the link name of the hook.
+func (p RuntimeHook) Symbol() string {
+	return PkgRuntime + "." + p.Name
+}
+
+// RuntimeHooks lists all runtime hooks that generated code may call.
+var RuntimeHooks = []RuntimeHook{
+	{HookAlloc, "AllocU"},
+	{HookAlloc, "AllocZ"},
+	{HookAlloc, "AllocUNoscan"},
+	{HookAlloc, "AllocZNoscan"},
+	{HookAlloc, "Zeroinit"},
+	{HookAlloc, "CStrCopy"},
+
+	{HookPanic, "Panic"},
+	{HookPanic, "Recover"},
+	{HookPanic, "Rethrow"},
+	{HookPanic, "AssertIndexRange"},
+	{HookPanic, "AssertNegativeShift"},
+	{HookPanic, "AssertNilWrap"},
+	{HookPanic, "AssertDivideByZero"},
+	{HookPanic, "PanicTypeAssert"},
+	{HookPanic, "CheckUnsafeSlice"},
+	{HookPanic, "CheckUnsafeString"},
+
+	{HookChan, "NewChan"},
+	{HookChan, "ChanLen"},
+	{HookChan, "ChanCap"},
+	{HookChan, "ChanSend"},
+	{HookChan, "ChanRecv"},
+	{HookChan, "ChanClose"},
+	{HookChan, "TrySelect"},
+	{HookChan, "Select"},
+
+	{HookMap, "MakeMap"},
+	{HookMap, "MapLen"},
+	{HookMap, "MapAccess1"},
+	{HookMap, "MapAccess2"},
+	{HookMap, "MapAssign"},
+	{HookMap, "MapDelete"},
+	{HookMap, "MapClear"},
+	{HookMap, "NewMapIter"},
+	{HookMap, "MapIterNext"},
+
+	{HookString, "StringCat"},
+	{HookString, "StringEqual"},
+	{HookString, "StringLess"},
+	{HookString, "StringHash"},
+	{HookString, "StringSlice"},
+	{HookString, "StringFrom"},
+	{HookString, "StringFromBytes"},
+	{HookString, "StringFromCStr"},
+	{HookString, "StringFromRune"},
+	{HookString, "StringFromRunes"},
+	{HookString, "StringToBytes"},
+	{HookString, "StringToRunes"},
+	{HookString, "NewStringIter"},
+	{HookString, "StringIterNext"},
+
+	{HookSlice, "MakeSlice"},
+	{HookSlice, "MakeSliceNoscan"},
+	{HookSlice, "NewSlice3"},
+	{HookSlice, "SliceAppend"},
+	{HookSlice, "SliceCopy"},
+	{HookSlice, "SliceClear"},
+
+	{HookIface, "NewItab"},
+	{HookIface, "Implements"},
+	{HookIface, "IfaceType"},
+	{HookIface, "IfacePtrData"},
+	{HookIface, "EfaceEqual"},
+
+	{HookType, "Basic"},
+	{HookType, "Struct"},
+	{HookType, "StructField"},
+	{HookType, "PointerTo"},
+	{HookType, "SliceOf"},
+	{HookType, "ArrayOf"},
+	{HookType, "MapOf"},
+	{HookType, "ChanOf"},
+	{HookType, "Func"},
+	{HookType, "Closure"},
+	{HookType, "Interface"},
+	{HookType, "NewNamed"},
+	{HookType, "InitNamed"},
+	{HookType, "SetDirectIface"},
+
+	{HookPrint, "PrintByte"},
+	{HookPrint, "PrintBool"},
+	{HookPrint, "PrintInt"},
+	{HookPrint, "PrintUint"},
+	{HookPrint, "PrintFloat"},
+	{HookPrint, "PrintComplex"},
+	{HookPrint, "PrintString"},
+	{HookPrint, "PrintSlice"},
+	{HookPrint, "PrintPointer"},
+	{HookPrint, "PrintEface"},
+	{HookPrint, "PrintIface"},
+
+	{HookGo, "CreateThread"},
+
+	{HookMath, "Complex128Div"},
+
+	{HookGC, "WriteBarrier"},
+	{HookGC, "SliceAppendWB"},
+	{HookGC, "SliceCopyWB"},
+
+	{HookCgo, "CgocallbackEnter"},
+	{HookCgo, "CgocallbackExit"},
+
+	{HookCover, "CoverRegister"},
+}
+
+// MissingRuntimeHooks returns names of the hooks that rt doesn't provide.
+func MissingRuntimeHooks(rt *types.Package) (missing []string) {
+	scope := rt.Scope()
+	for _, hook := range RuntimeHooks {
+		if _, ok := scope.Lookup(hook.Name).(*types.Func); !ok {
+			missing = append(missing, hook.Name)
+		}
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
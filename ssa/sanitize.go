@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+// -----------------------------------------------------------------------------
+
+// Sanitizers is a set of LLVM sanitizers instrumenting generated code.
+type Sanitizers uint8
+
+const (
+	SanitizeAddress Sanitizers = 1 << iota // AddressSanitizer: out of bounds accesses, uses after free
+	SanitizeMemory                         // MemorySanitizer: uses of uninitialized memory
+)
+
+// SetSanitizers enables LLVM sanitizers for the functions whose bodies are
+// made after. Such functions have the sanitize_address or sanitize_memory
+// attribute, and are instrumented by the passes of the sanitizers, which
+// clang runs when it compiles the module with -fsanitize=address or
+// -fsanitize=memory. Functions excluded from sanitizers (see
+// Function.SetNoSanitize) are not instrumented.
+func (p Program) SetSanitizers(s Sanitizers) {
+	p.sanitizers = s
+}
+
+// Sanitizers returns the LLVM sanitizers enabled by SetSanitizers.
+func (p Program) Sanitizers() Sanitizers {
+	return p.sanitizers
+}
+
+func (p Function) setSanitizers(s Sanitizers) {
+	if s&SanitizeAddress != 0 {
+		p.addFnAttr("sanitize_address")
+	}
+	if s&SanitizeMemory != 0 {
+		p.addFnAttr("sanitize_memory")
+	}
+}
+
+// -----------------------------------------------------------------------------
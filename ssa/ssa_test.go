@@ -17,9 +17,17 @@
 package ssa
 
 import (
+	"bytes"
+	"fmt"
+	"go/ast"
 	"go/constant"
+	"go/parser"
 	"go/token"
 	"go/types"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
 	"testing"
 	"unsafe"
 
@@ -27,6 +35,22 @@ import (
 	"github.com/goplus/llvm"
 )
 
+// newRtProgram creates a program for target whose runtime is loaded from
+// PkgRuntime (see ssatest.NewProgram).
+func newRtProgram(t *testing.T, target *Target) Program {
+	prog := NewProgram(target)
+	prog.SetRuntime(func() *types.Package {
+		fset := token.NewFileSet()
+		imp := packages.NewImporter(fset)
+		rt, err := imp.Import(PkgRuntime)
+		if err != nil {
+			t.Fatal("load runtime failed:", err)
+		}
+		return rt
+	})
+	return prog
+}
+
 func TestEndDefer(t *testing.T) {
 	prog := NewProgram(nil)
 	pkg := prog.NewPackage("foo", "foo")
@@ -36,14 +60,81 @@ func TestEndDefer(t *testing.T) {
 	fn.endDefer(b)
 }
 
-func TestUnsafeString(t *testing.T) {
-	prog := NewProgram(nil)
-	prog.SetRuntime(func() *types.Package {
-		fset := token.NewFileSet()
-		imp := packages.NewImporter(fset)
-		pkg, _ := imp.Import(PkgRuntime)
-		return pkg
+func TestDeferInLoop(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(types.NewVar(0, nil, "n", types.Typ[types.Int]))
+	sig := types.NewSignatureType(nil, nil, nil, params, nil, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(3)
+	fn.SetRecover(fn.Block(2))
+	b.Jump(fn.Block(1))
+	b.SetBlock(fn.Block(1))
+	b.Times(fn.Param(0), func(i Expr) {
+		b.Defer(DeferInLoop, Builtin("println"), i)
 	})
+	b.RunDefers()
+	b.Return()
+	b.SetBlock(fn.Block(2)).Return()
+	b.EndBuild()
+	ir := pkg.String()
+	if !strings.Contains(ir, `@"foo/bar._llgo_defer$1"`) {
+		t.Fatal("DeferInLoop: no list of deferred calls\n" + ir)
+	}
+	if !strings.Contains(ir, "phi ptr") {
+		t.Fatal("DeferInLoop: no nodes in the arena of the frame\n" + ir)
+	}
+	if !strings.Contains(ir, `runtime.AllocU"`) || strings.Contains(ir, "@malloc") || strings.Contains(ir, "@free") {
+		t.Fatal("DeferInLoop: nodes out of the arena aren't on the GC heap\n" + ir)
+	}
+	if !strings.Contains(ir, "@__llgo_g = linkonce hidden thread_local global") ||
+		strings.Contains(ir, "pthread_getspecific") {
+		t.Fatal("DeferInLoop: defer list isn't in the thread-local G\n" + ir)
+	}
+}
+
+func TestDeferOn(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+	b := fn.MakeBody(2)
+	fn.SetRecover(fn.Block(1))
+	stack := b.DeferStack()
+	b.DeferOn(stack, Builtin("println"), prog.Val(1))
+	b.RunDefers()
+	b.Return()
+	b.SetBlock(fn.Block(1)).Return()
+	b.EndBuild()
+	ir := pkg.String()
+	if !strings.Contains(ir, `@"foo/bar._llgo_defer$1"`) {
+		t.Fatal("DeferOn: no deferred call\n" + ir)
+	}
+	if !strings.Contains(ir, `runtime.AllocU"`) || strings.Contains(ir, "@malloc") {
+		t.Fatal("DeferOn: node isn't on the GC heap\n" + ir)
+	}
+}
+
+func TestManyDefers(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+	b := fn.MakeBody(2)
+	fn.SetRecover(fn.Block(1))
+	for i := 0; i < 70; i++ { // more than the defer bits
+		b.Defer(DeferAlways, Builtin("println"), prog.Val(i))
+	}
+	b.RunDefers()
+	b.Return()
+	b.SetBlock(fn.Block(1)).Return()
+	b.EndBuild()
+	ir := pkg.String()
+	if !strings.Contains(ir, `@"foo/bar._llgo_defer$1"`) {
+		t.Fatal("ManyDefers: no list of deferred calls\n" + ir)
+	}
+}
+
+func TestUnsafeString(t *testing.T) {
+	prog := newRtProgram(t, nil)
 	pkg := prog.NewPackage("foo", "foo")
 	b := pkg.NewFunc("main", NoArgsNoRet, InC).MakeBody(1)
 	b.Println(b.BuiltinCall("String", b.CStr("hello"), prog.Val(5)))
@@ -223,6 +314,45 @@ _llgo_0:
 `)
 }
 
+func TestConstExact(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := func(name string, v constant.Value, typ types.Type) {
+		rets := types.NewTuple(types.NewVar(0, nil, "", typ))
+		sig := types.NewSignatureType(nil, nil, nil, nil, rets, false)
+		b := pkg.NewFunc(name, sig, InGo).MakeBody(1)
+		b.Return(b.Const(v, prog.Type(typ, InGo)))
+	}
+	one := constant.MakeInt64(1)
+	// 1 + 2^-24 + 2^-60 rounds to 1 in float64, and to 1 + 2^-23 in float32
+	f := constant.BinaryOp(one, token.QUO, constant.Shift(one, token.SHL, 24))
+	f = constant.BinaryOp(f, token.ADD, constant.BinaryOp(one, token.QUO, constant.Shift(one, token.SHL, 60)))
+	fn("f", constant.BinaryOp(one, token.ADD, f), types.Typ[types.Float32])
+	// (1<<200) / (1<<190) is exact
+	big := constant.BinaryOp(constant.Shift(one, token.SHL, 200), token.QUO, constant.Shift(one, token.SHL, 190))
+	fn("big", big, types.Typ[types.Int])
+	// integers are truncated to the size of their type
+	fn("trunc", constant.MakeInt64(-129), types.Typ[types.Int8])
+	assertPkg(t, pkg, `; ModuleID = 'foo/bar'
+source_filename = "foo/bar"
+
+define float @f() {
+_llgo_0:
+  ret float 0x3FF0000020000000
+}
+
+define i64 @big() {
+_llgo_0:
+  ret i64 1024
+}
+
+define i8 @trunc() {
+_llgo_0:
+  ret i8 127
+}
+`)
+}
+
 func TestStruct(t *testing.T) {
 	empty := types.NewStruct(nil, nil)
 
@@ -521,3 +651,1027 @@ func TestBasicType(t *testing.T) {
 		}
 	}
 }
+
+func TestFrameLayout(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+	fn.MakeBody(1).Return()
+	decl := pkg.NewFunc("decl", NoArgsNoRet, InGo)
+	remarks := `bar.ll:0:0: remark: 
+Function: fn
+Offset: [SP-8], Type: Protector, Align: 16, Size: 8
+Offset: [SP-16], Type: Spill, Align: 8, Size: 8
+Offset: [SP-24], Type: Variable, Align: 8, Size: 8
+Offset: [SP-28], Type: Variable, Align: 4, Size: 4
+    x @ bar.go:3
+    y @ bar.go:4 [-Rpass-analysis=stack-frame-layout]
+other.ll:0:0: remark: 
+Function: other
+Offset: [SP+0], Type: Fixed, Align: 8, Size: 8 [-Rpass-analysis=stack-frame-layout]
+`
+	if err := pkg.ReadFrameLayouts(strings.NewReader(remarks)); err != nil {
+		t.Fatal("ReadFrameLayouts:", err)
+	}
+	if decl.FrameLayout() != nil {
+		t.Fatal("FrameLayout: function without body")
+	}
+	layout := fn.FrameLayout()
+	if layout == nil || len(layout.Slots) != 4 || layout.Size != 28 || layout.Align != 16 {
+		t.Fatal("FrameLayout:", layout)
+	}
+	if s := layout.Slots[0]; s.Kind != FrameProtector || s.Offset != -8 || s.HasPtr {
+		t.Fatal("FrameLayout slot 0:", s)
+	}
+	if s := layout.Slots[3]; s.Kind != FrameVariable || s.Size != 4 || len(s.Names) != 2 || s.Names[1] != "y" {
+		t.Fatal("FrameLayout slot 3:", s)
+	}
+	if ptrs := layout.PtrSlots(); len(ptrs) != 2 || ptrs[0] != 1 || ptrs[1] != 2 {
+		t.Fatal("PtrSlots:", ptrs)
+	}
+
+	var sb strings.Builder
+	if err := pkg.WriteFrameLayouts(&sb); err != nil {
+		t.Fatal("WriteFrameLayouts:", err)
+	}
+	if want := "fn: frame size=28 align=16\n  [SP-8] Protector size=8 align=16\n" +
+		"  [SP-16] Spill size=8 align=8 ptr\n  [SP-24] Variable size=8 align=8 ptr\n" +
+		"  [SP-28] Variable size=4 align=4 name=x,y\n"; sb.String() != want {
+		t.Fatal("WriteFrameLayouts:", sb.String())
+	}
+	layouts, _ := ParseFrameLayouts(strings.NewReader(remarks))
+	if l := layouts["other"]; l == nil || l.Size != 0 || l.Slots[0].Offset != 0 || l.Slots[0].HasPtr {
+		t.Fatal("ParseFrameLayouts:", layouts)
+	}
+	layouts, _ = ReadFrameLayouts(strings.NewReader(remarks), 8)
+	if l := layouts["fn"]; l == nil || len(l.PtrSlots()) != 2 || !layouts["other"].Slots[0].HasPtr {
+		t.Fatal("ReadFrameLayouts:", layouts)
+	}
+	if err := pkg.ReadFrameLayouts(strings.NewReader("")); err != ErrNoFrameLayouts {
+		t.Fatal("ReadFrameLayouts without remarks:", err)
+	}
+}
+
+func TestRuntimeHooks(t *testing.T) {
+	fset := token.NewFileSet()
+	imp := packages.NewImporter(fset)
+	rt, err := imp.Import(PkgRuntime)
+	if err != nil {
+		t.Fatal("Import runtime:", err)
+	}
+	if missing := MissingRuntimeHooks(rt); len(missing) != 0 {
+		t.Fatal("MissingRuntimeHooks:", missing)
+	}
+	if sym := RuntimeHooks[0].Symbol(); sym != PkgRuntime+".AllocU" {
+		t.Fatal("Symbol:", sym)
+	}
+
+	// all runtime functions called by name are hooks
+	hooks := make(map[string]bool)
+	for _, hook := range RuntimeHooks {
+		hooks[hook.Name] = true
+	}
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal("ParseDir:", err)
+	}
+	for _, f := range pkgs["ssa"].Files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+			if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "rtFunc" {
+				return true
+			}
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if name := strings.Trim(lit.Value, "\""); !hooks[name] {
+					t.Errorf("%v: rtFunc(%s) isn't in RuntimeHooks", fset.Position(lit.Pos()), lit.Value)
+				}
+			}
+			return true
+		})
+	}
+}
+
+func TestSwitch(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(types.NewVar(0, nil, "a", types.Typ[types.Int]))
+	rets := types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int]))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	vals := []int{100001, 0, 1, 2, 3, 1000, 1001, 1002, 1003, 100000}
+	b := fn.MakeBody(len(vals) + 2)
+	sw := b.Switch(fn.Param(0), fn.Block(1))
+	for i, v := range vals {
+		sw.Case(prog.Val(v), fn.Block(i+2))
+	}
+	sw.End(b)
+	b.SetBlock(fn.Block(1)).Return(prog.Val(-1))
+	for i, v := range vals {
+		b.SetBlock(fn.Block(i + 2)).Return(prog.Val(v))
+	}
+	ir := pkg.String()
+	if n := strings.Count(ir, "switch i64"); n != 3 {
+		t.Fatal("Switch: expect 3 clusters, got", n, "\n"+ir)
+	}
+	if n := strings.Count(ir, "icmp slt i64"); n != 2 {
+		t.Fatal("Switch: expect 2 compares, got", n, "\n"+ir)
+	}
+}
+
+func TestStringSwitch(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(types.NewVar(0, nil, "s", types.Typ[types.String]))
+	rets := types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int]))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	vals := []string{"", "a", "b", "foo", "bar", "baz", "hello", "world", "a"}
+	b := fn.MakeBody(len(vals) + 2)
+	sw := b.StringSwitch(fn.Param(0), fn.Block(1))
+	for i, v := range vals {
+		sw.Case(v, fn.Block(i+2))
+	}
+	sw.End(b)
+	b.SetBlock(fn.Block(1)).Return(prog.Val(-1))
+	for i := range vals {
+		b.SetBlock(fn.Block(i + 2)).Return(prog.Val(i))
+	}
+	ir := pkg.String()
+	if !strings.Contains(ir, "runtime.StringHash") {
+		t.Fatal("StringSwitch: no StringHash\n" + ir)
+	}
+	if n := strings.Count(ir, "call i32 @memcmp"); n != 7 {
+		t.Fatal("StringSwitch: expect 7 memcmp, got", n, "\n"+ir)
+	}
+	if h := stringHash("hello"); h != 0x4f9f2cab {
+		t.Fatalf("stringHash: got %#x", h)
+	}
+}
+
+func TestSRet(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(types.NewVar(0, nil, "a", types.Typ[types.Int]))
+	rets := types.NewTuple(
+		types.NewVar(0, nil, "", types.Typ[types.Int]),
+		types.NewVar(0, nil, "", types.Typ[types.Int]),
+		types.NewVar(0, nil, "", types.Typ[types.Int]))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFuncSRet("fn", sig, InGo)
+	if fn.sret.IsNil() {
+		t.Fatal("NewFuncSRet: no sret")
+	}
+	b := fn.MakeBody(1)
+	a := fn.Param(0)
+	b.Return(a, a, a)
+
+	small := types.NewSignatureType(nil, nil, nil, params, types.NewTuple(rets.At(0), rets.At(1)), false)
+	if fn2 := pkg.NewFuncSRet("fn2", small, InGo); !fn2.sret.IsNil() {
+		t.Fatal("NewFuncSRet: unexpected sret")
+	}
+
+	caller := pkg.NewFunc("caller", NoArgsNoRet, InGo)
+	b = caller.MakeBody(1)
+	ret := b.Call(fn.Expr, prog.Val(1))
+	b.Extract(ret, 2)
+	b.Return()
+
+	ir := pkg.String()
+	if !strings.Contains(ir, "define void @fn(ptr sret(") {
+		t.Fatal("NewFuncSRet:\n" + ir)
+	}
+	if !strings.Contains(ir, "call void @fn(ptr sret(") {
+		t.Fatal("callSRet:\n" + ir)
+	}
+}
+
+func TestNewExport(t *testing.T) {
+	prog := newRtProgram(t, &Target{GOOS: "linux", GOARCH: "amd64"})
+	pkg := prog.NewPackage("bar", "foo/bar")
+	n := types.NewParam(0, nil, "n", types.Typ[types.Int])
+	big := types.NewParam(0, nil, "s", types.NewArray(types.Typ[types.Int], 3))
+	sig := types.NewSignatureType(nil, nil, nil, types.NewTuple(big), types.NewTuple(big), false)
+	fn := pkg.NewFunc("bar.Fn", sig, InGo)
+	b := fn.MakeBody(1)
+	b.Return(fn.Param(0))
+	if ex := pkg.NewExport("Fn", fn); ex.sret.IsNil() {
+		t.Fatal("NewExport: no sret")
+	}
+	small := types.NewSignatureType(nil, nil, nil, types.NewTuple(n), types.NewTuple(n, n), false)
+	fn2 := pkg.NewFunc("bar.Fn2", small, InGo)
+	if ex := pkg.NewExport("Fn2", fn2); !ex.sret.IsNil() {
+		t.Fatal("NewExport: unexpected sret")
+	}
+	ir := pkg.String()
+	if !strings.Contains(ir, "@Fn(") || !strings.Contains(ir, " sret(") || !strings.Contains(ir, " byval(") {
+		t.Fatal("NewExport:\n" + ir)
+	}
+	if !strings.Contains(ir, "CgocallbackEnter()") || !strings.Contains(ir, "CgocallbackExit()") {
+		t.Fatal("NewExport: no callback enter/exit\n" + ir)
+	}
+	if !strings.Contains(ir, "@llgoEnableCallbacks, ptr null }]") {
+		t.Fatal("NewExport: no llgoEnableCallbacks constructor\n" + ir)
+	}
+}
+
+func TestFence(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	b := pkg.NewFunc("fn", NoArgsNoRet, InGo).MakeBody(1)
+	b.Fence(OrderingSeqConsistent, false)
+	b.Fence(OrderingAcquire, true)
+	b.Return()
+	ir := pkg.String()
+	if !strings.Contains(ir, "fence seq_cst") || !strings.Contains(ir, `fence syncscope("singlethread") acquire`) {
+		t.Fatal("Fence:\n" + ir)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Fence: no panic")
+		}
+	}()
+	b.Fence(OrderingMonotonic, false)
+}
+
+func TestVolatile(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	reg := pkg.NewVar("reg", types.NewPointer(types.Typ[types.Uint32]), InGo)
+	b := pkg.NewFunc("fn", NoArgsNoRet, InGo).MakeBody(1)
+	v := b.LoadVolatile(reg.Expr)
+	b.StoreVolatile(reg.Expr, b.BinOp(token.OR, v, prog.IntVal(1, prog.Uint32())))
+	b.Return()
+	ir := pkg.String()
+	if !strings.Contains(ir, "load volatile i32, ptr @reg") || !strings.Contains(ir, "store volatile i32 %1, ptr @reg") {
+		t.Fatal("Volatile:\n" + ir)
+	}
+}
+
+func TestEmitObject(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("main", "main")
+	rets := types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int32]))
+	sig := types.NewSignatureType(nil, nil, nil, nil, rets, false)
+	b := pkg.NewFunc("main", sig, InC).MakeBody(1)
+	b.Return(prog.IntVal(0, prog.Int32()))
+	obj, err := pkg.EmitObject()
+	if err != nil || len(obj) == 0 {
+		t.Fatal("EmitObject:", len(obj), err)
+	}
+	var exe bytes.Buffer
+	if err = prog.EmitExecutable(&exe, LinkOpts{Packages: []Package{pkg}}); err == nil || exe.Len() != 0 {
+		t.Fatal("EmitExecutable: linked without a scratch directory")
+	}
+	if _, err := exec.LookPath("clang"); err != nil {
+		t.Skip("clang not found")
+	}
+	tmp := t.TempDir()
+	if err = prog.EmitExecutable(&exe, LinkOpts{Packages: []Package{pkg}, TempDir: tmp}); err != nil || exe.Len() == 0 {
+		t.Fatal("EmitExecutable:", exe.Len(), err)
+	}
+	if ents, err := os.ReadDir(tmp); err != nil || len(ents) != 0 {
+		t.Fatal("EmitExecutable: scratch directory left", ents, err)
+	}
+}
+
+func TestSetTargetTriple(t *testing.T) {
+	prog := NewProgram(nil)
+	prog.SetTargetTriple("i386-unknown-linux-gnu")
+	st := prog.Struct(prog.Byte(), prog.Int64())
+	if prog.PointerSize() != 4 || prog.SizeOf(prog.Int()) != 4 ||
+		prog.OffsetOf(st, 1) != 4 || prog.SizeOf(st) != 12 || prog.AlignOf(st) != 4 {
+		t.Fatal("i386:", prog.PointerSize(), prog.SizeOf(prog.Int()), prog.OffsetOf(st, 1), prog.SizeOf(st))
+	}
+	pkg := prog.NewPackage("bar", "foo/bar")
+	if ir := pkg.String(); !strings.Contains(ir, `target triple = "i386-unknown-linux-gnu"`) {
+		t.Fatal("triple:\n" + ir)
+	}
+
+	prog = NewProgram(nil)
+	prog.SetDataLayout("e-p:32:32-i64:64")
+	st = prog.Struct(prog.Byte(), prog.Int64())
+	if prog.PointerSize() != 4 || prog.OffsetOf(st, 1) != 8 || prog.SizeOf(st) != 16 {
+		t.Fatal("SetDataLayout:", prog.PointerSize(), prog.OffsetOf(st, 1), prog.SizeOf(st))
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SetDataLayout: no panic")
+		}
+	}()
+	prog.SetDataLayout("e")
+}
+
+func TestBitField(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(types.NewVar(0, nil, "p", types.NewPointer(types.Typ[types.Uint32])))
+	rets := types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int]))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	p := fn.Param(0)
+	// struct { unsigned a: 3; int b: 5; }
+	fa := BitField{Offset: 4, Unit: prog.Uint32(), Bit: 0, Width: 3}
+	fb := BitField{Offset: 4, Unit: prog.Uint32(), Bit: 3, Width: 5, Signed: true}
+	b.InsertBitField(p, fa, prog.Val(5))
+	v := b.ExtractBitField(p, fb, prog.Int())
+	b.Return(v)
+	ir := pkg.String()
+	for _, s := range []string{
+		"getelementptr inbounds i8", ", i32 4",
+		"= and i32 %2, -8", "= or i32 %3, 5", // clear and set bits 0-2
+		"= shl i32 %6, 24", "= ashr i32 %7, 27", "= sext i32 %8 to i64",
+	} {
+		if !strings.Contains(ir, s) {
+			t.Fatal("BitField: no "+s+"\n", ir)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("BitField: no panic")
+		}
+	}()
+	b.ExtractBitField(p, BitField{Unit: prog.Byte(), Bit: 4, Width: 5}, prog.Int())
+}
+
+func TestFieldAddrPath(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	inner := types.NewStruct([]*types.Var{
+		types.NewField(0, nil, "x", types.Typ[types.Int], false),
+		types.NewField(0, nil, "y", types.NewArray(types.Typ[types.Int32], 4), false),
+	}, nil)
+	outer := types.NewStruct([]*types.Var{
+		types.NewField(0, nil, "a", types.Typ[types.Int8], false),
+		types.NewField(0, nil, "b", inner, false),
+	}, nil)
+	params := types.NewTuple(types.NewVar(0, nil, "p", types.NewPointer(outer)))
+	sig := types.NewSignatureType(nil, nil, nil, params, nil, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	addr := b.FieldAddrPath(fn.Param(0), 1, 1, 2)
+	if addr.raw.Type.String() != "*int32" {
+		t.Fatal("FieldAddrPath:", addr.raw.Type)
+	}
+	b.Return()
+	if ir := pkg.String(); !strings.Contains(ir, "i32 0, i32 1, i32 1, i32 2") {
+		t.Fatal("FieldAddrPath:\n" + ir)
+	}
+	for _, path := range [][]int{{1, 2}, {1, 1, 4}, {0, 1}} {
+		func() {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatal("FieldAddrPath: no panic", path)
+				}
+				t.Log(r)
+			}()
+			b.FieldAddrPath(fn.Param(0), path...)
+		}()
+	}
+}
+
+type testInstr struct {
+	check Function
+}
+
+func (p *testInstr) Load(b Builder, ptr Expr) {
+	b.Call(p.check.Expr, b.Convert(b.Prog.VoidPtr(), ptr), b.Prog.Val(0))
+	b.Load(ptr) // not instrumented
+}
+
+func (p *testInstr) Store(b Builder, ptr, val Expr) {
+	b.Call(p.check.Expr, b.Convert(b.Prog.VoidPtr(), ptr), b.Prog.Val(1))
+}
+
+func (p *testInstr) Alloca(b Builder, ptr Expr, elem Type, n Expr) {
+	b.Call(p.check.Expr, b.Convert(b.Prog.VoidPtr(), ptr), b.Prog.Val(2))
+}
+
+func TestInstrumenter(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(
+		types.NewVar(0, nil, "p", types.Typ[types.UnsafePointer]),
+		types.NewVar(0, nil, "op", types.Typ[types.Int]))
+	check := pkg.NewFunc("check", types.NewSignatureType(nil, nil, nil, params, nil, false), InC)
+	prog.SetInstrumenter(&testInstr{check})
+
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+	b := fn.MakeBody(1)
+	p := b.AllocaInEntry(prog.Int())
+	b.Store(p, prog.Val(1))
+	b.Load(p)
+	b.Return()
+
+	nosan := pkg.NewFunc("nosan", NoArgsNoRet, InGo)
+	nosan.SetNoSanitize()
+	b = nosan.MakeBody(1)
+	b.Load(b.AllocaInEntry(prog.Int()))
+	b.Return()
+
+	ir := pkg.String()
+	if strings.Count(ir, "call void @check") != 3 ||
+		!strings.Contains(ir, ", i64 2)") || !strings.Contains(ir, ", i64 1)") || !strings.Contains(ir, ", i64 0)") {
+		t.Fatal("Instrumenter:\n" + ir)
+	}
+}
+
+func TestSanitizers(t *testing.T) {
+	for _, s := range []Sanitizers{SanitizeAddress, SanitizeMemory} {
+		prog := NewProgram(nil)
+		prog.SetSanitizers(s)
+		pkg := prog.NewPackage("bar", "foo/bar")
+		pkg.NewFunc("fn", NoArgsNoRet, InGo).MakeBody(1).Return()
+		nosan := pkg.NewFunc("nosan", NoArgsNoRet, InGo)
+		nosan.SetNoSanitize()
+		nosan.MakeBody(1).Return()
+
+		attr, disabled := "sanitize_address", true
+		if s == SanitizeMemory {
+			attr, disabled = "sanitize_memory", false
+		}
+		ir := pkg.String()
+		if strings.Count(ir, attr) != 1 || strings.Contains(ir, "disable_sanitizer_instrumentation") != disabled {
+			t.Fatal("Sanitizers:\n" + ir)
+		}
+	}
+}
+
+func TestLineTables(t *testing.T) {
+	prog := NewProgram(nil)
+	prog.SetLineTables(true)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	callee := pkg.NewFunc("callee", NoArgsNoRet, InGo)
+	callee.SetPos("/src/bar/a.go", 3)
+	callee.MakeBody(1).Return()
+
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+	fn.SetPos("/src/bar/a.go", 10)
+	b := fn.MakeBody(1)
+	b.Call(callee.Expr) // at the line of fn
+	b.SetPos("/src/bar/a.go", 11, 2)
+	b.Call(callee.Expr)
+	b.SetPos("/src/bar/b.go", 5, 7) // package initializers span files
+	b.Call(callee.Expr)
+	b.Return()
+
+	nopos := pkg.NewFunc("nopos", NoArgsNoRet, InGo)
+	b = nopos.MakeBody(1)
+	b.SetPos("/src/bar/a.go", 20, 1) // ignored
+	b.Call(callee.Expr)
+	b.Return()
+
+	ir := pkg.String()
+	for _, want := range []string{
+		`!DISubprogram(name: "callee"`, `!DISubprogram(name: "fn"`,
+		`!DIFile(filename: "/src/bar/a.go", directory: "")`, `!DILexicalBlockFile(`,
+		"!DILocation(line: 10,", "!DILocation(line: 11, column: 2,", "!DILocation(line: 5, column: 7,",
+		`!"Debug Info Version", i32 3}`, `!{i32 7, !"Dwarf Version", i32 4}`,
+	} {
+		if !strings.Contains(ir, want) {
+			t.Fatalf("LineTables: no %s:\n%s", want, ir)
+		}
+	}
+	if strings.Count(ir, "!DISubprogram(") != 2 || strings.Contains(ir, "line: 20") {
+		t.Fatal("LineTables:\n" + ir)
+	}
+}
+
+func TestRaceDetector(t *testing.T) {
+	prog := NewProgram(nil)
+	prog.SetInstrumenter(RaceDetector)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(
+		types.NewVar(0, nil, "p", types.NewPointer(types.Typ[types.Int])),
+		types.NewVar(0, nil, "a", types.NewPointer(types.NewArray(types.Typ[types.Int], 2))))
+	sig := types.NewSignatureType(nil, nil, nil, params, nil, false)
+
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	p, a := fn.Param(0), fn.Param(1)
+	b.Store(p, prog.Val(1))
+	b.Load(a)
+	b.Load(b.AllocaInEntry(prog.Int())) // stack slot: not checked
+	b.AtomicLoad(p)
+	b.Atomic(OpAdd, p, prog.Val(1))
+	b.Return()
+
+	norace := pkg.NewFunc("norace", sig, InGo)
+	norace.SetNoRace()
+	b = norace.MakeBody(1)
+	b.Store(norace.Param(0), b.AtomicLoad(norace.Param(0)))
+	b.Return()
+
+	ir := pkg.String()
+	if strings.Count(ir, "call void @__tsan_write8") != 1 ||
+		strings.Count(ir, "call void @__tsan_read16") != 1 ||
+		strings.Contains(ir, "@__tsan_read8") ||
+		strings.Count(ir, "call void @__tsan_acquire") != 3 ||
+		strings.Count(ir, "call void @__tsan_release") != 1 {
+		t.Fatal("RaceDetector:\n" + ir)
+	}
+}
+
+func TestWriteBarrier(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	prog.SetWriteBarrier(true)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	tptr := types.NewPointer(types.Typ[types.Int])
+	params := types.NewTuple(
+		types.NewVar(0, nil, "p", types.NewPointer(tptr)),
+		types.NewVar(0, nil, "s", types.NewPointer(types.Typ[types.String])),
+		types.NewVar(0, nil, "q", tptr))
+	sig := types.NewSignatureType(nil, nil, nil, params, nil, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	q := fn.Param(2)
+	b.Store(fn.Param(0), q)                           // heap store: barrier
+	b.Store(fn.Param(1), b.Str("hi"))                 // one barrier for the string data
+	b.Store(b.Alloc(prog.Type(tptr, InGo), false), q) // stack slot: no barrier
+	b.Store(b.Alloc(prog.Type(tptr, InGo), true), q)  // initializing store: no barrier
+	b.Store(fn.Param(0), prog.Nil(prog.Type(tptr, InGo)))
+	b.Return()
+
+	nowb := pkg.NewFunc("nowb", sig, InGo)
+	nowb.SetNoWriteBarrier()
+	b = nowb.MakeBody(1)
+	b.Store(nowb.Param(0), nowb.Param(2))
+	b.Return()
+
+	ptrs := pkg.NewFunc("ptrs", sig, InGo)
+	b = ptrs.MakeBody(1)
+	q = ptrs.Param(2)
+	b.Atomic(OpXchg, ptrs.Param(0), q)   // barrier
+	b.AtomicCmpXchg(ptrs.Param(0), q, q) // barrier
+	s := b.MakeSlice(prog.Slice(prog.Type(tptr, InGo)), prog.Val(1), prog.Val(1))
+	b.BuiltinCall("copy", s, s)   // bulk barrier
+	b.BuiltinCall("append", s, s) // bulk barrier
+	bs := b.MakeSlice(prog.Slice(prog.Byte()), prog.Val(1), prog.Val(1))
+	b.BuiltinCall("copy", bs, bs) // no pointers: no barrier
+	b.Return()
+
+	ir := pkg.String()
+	for name, n := range map[string]int{
+		"WriteBarrier": 5, "SliceCopyWB": 1, "SliceAppendWB": 1, "SliceCopy": 1,
+	} {
+		if got := countCalls(ir, name); got != n {
+			t.Fatal("WriteBarrier:", name, "expect", n, "calls, got", got, "\n"+ir)
+		}
+	}
+}
+
+func TestNoscanAlloc(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	prog.SetNoscanAlloc(true)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	tint := prog.Type(types.Typ[types.Int], InGo)
+	tptr := prog.Type(types.NewPointer(types.Typ[types.Int]), InGo)
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+	b := fn.MakeBody(1)
+	b.Alloc(tint, true)                                     // noscan
+	b.Alloc(tptr, true)                                     // scanned
+	b.AllocU(tint)                                          // noscan
+	b.MakeSlice(prog.Slice(tint), prog.Val(1), prog.Val(2)) // noscan
+	b.MakeSlice(prog.Slice(tptr), prog.Val(1), prog.Val(2)) // scanned
+	b.Alloc(prog.Type(types.Typ[types.String], InGo), true) // scanned
+	b.Return()
+
+	ir := pkg.String()
+	for name, n := range map[string]int{
+		"AllocZNoscan": 1, "AllocZ": 2, "AllocUNoscan": 1,
+		"MakeSliceNoscan": 1, "MakeSlice": 1,
+	} {
+		if got := countCalls(ir, name); got != n {
+			t.Fatal("NoscanAlloc:", name, "expect", n, "calls, got", got, "\n"+ir)
+		}
+	}
+}
+
+// countCalls returns the number of calls of the runtime function name in ir,
+// not counting its declaration.
+func countCalls(ir, name string) (n int) {
+	call := "@\"github.com/goplus/llgo/internal/runtime." + name + "\"("
+	for _, line := range strings.Split(ir, "\n") {
+		if strings.Contains(line, call) && !strings.HasPrefix(line, "declare ") {
+			n++
+		}
+	}
+	return
+}
+
+func TestStackMaps(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	prog.SetStackMaps(true)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	tptr := types.NewPointer(types.Typ[types.Int])
+	params := types.NewTuple(types.NewVar(0, nil, "n", types.Typ[types.Int]))
+	g := pkg.NewFunc("g", types.NewSignatureType(nil, nil, nil, params, nil, false), InGo)
+	params = types.NewTuple(
+		types.NewVar(0, nil, "p", tptr),
+		types.NewVar(0, nil, "n", types.Typ[types.Int]))
+	rets := types.NewTuple(types.NewVar(0, nil, "", tptr))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	slot := b.Alloc(prog.Type(tptr, InGo), false) // stack object: a root
+	b.Call(g.Expr, fn.Param(1))
+	b.Store(slot, fn.Param(0)) // p is live across calls: a root
+	b.Return(b.Load(slot))     // defined after calls: not a root
+	b.EndBuild()
+
+	ir := pkg.String()
+	if !strings.Contains(ir, `gc "shadow-stack"`) {
+		t.Fatal("StackMaps: no gc strategy\n" + ir)
+	}
+	if n := strings.Count(ir, "call void @llvm.gcroot"); n != 2 {
+		t.Fatal("StackMaps: expect 2 roots, got", n, "\n"+ir)
+	}
+	if !strings.Contains(ir, "@llvm_gc_root_chain = linkonce thread_local global ptr null") {
+		t.Fatal("StackMaps: no root chain\n" + ir)
+	}
+	if !strings.Contains(ir, "@\"__llgo_ptrmap$101\"") {
+		t.Fatal("StackMaps: no pointer map\n" + ir)
+	}
+}
+
+func TestLoop(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(types.NewVar(0, nil, "n", types.Typ[types.Int]))
+	rets := types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int]))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	n := fn.Param(0)
+	l := b.Loop(prog.Val(10), func(i Expr) Expr {
+		return b.BinOp(token.GTR, i, n)
+	}, func(i Expr) Expr {
+		return b.BinOp(token.SUB, i, prog.Val(2))
+	})
+	odd := b.BinOp(token.EQL, b.BinOp(token.AND, l.Index, prog.Val(1)), prog.Val(1))
+	b.If(odd, l.Exit, l.Continue())
+	l.End(b)
+	b.Return(n)
+	ir := pkg.String()
+	if !strings.Contains(ir, "phi i64 [ 10, %_llgo_0 ], [ %5, %_llgo_4 ]") ||
+		!strings.Contains(ir, "icmp sgt i64 %1, %0") || !strings.Contains(ir, "%5 = sub i64 %1, 2") {
+		t.Fatal("Loop:\n" + ir)
+	}
+}
+
+func TestAllocInLoop(t *testing.T) {
+	prog := newRtProgram(t, nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	params := types.NewTuple(types.NewVar(0, nil, "n", types.Typ[types.Int]))
+	fn := pkg.NewFunc("fn", types.NewSignatureType(nil, nil, nil, params, nil, false), InGo)
+	b := fn.MakeBody(1)
+	first := b.AllocaInEntry(prog.Int())
+	b.Store(first, fn.Param(0))
+	l := b.Loop(prog.Val(0), func(i Expr) Expr {
+		return b.BinOp(token.LSS, i, fn.Param(0))
+	}, func(i Expr) Expr {
+		return b.BinOp(token.ADD, i, prog.Val(1))
+	})
+	if b.impl.GetInsertBlock() == fn.impl.EntryBasicBlock() {
+		t.Fatal("Loop: body in the entry block")
+	}
+	b.Alloc(prog.Int(), false)
+	l.End(b)
+	b.Return()
+
+	var allocas []llvm.Value
+	for bb := fn.impl.FirstBasicBlock(); !bb.IsNil(); bb = llvm.NextBasicBlock(bb) {
+		for instr := bb.FirstInstruction(); !instr.IsNil(); instr = llvm.NextInstruction(instr) {
+			if !instr.IsAAllocaInst().IsNil() {
+				if bb != fn.impl.EntryBasicBlock() {
+					t.Fatal("Alloc: alloca in a loop block\n" + pkg.String())
+				}
+				allocas = append(allocas, instr)
+			}
+		}
+	}
+	entry := fn.impl.EntryBasicBlock().FirstInstruction()
+	if len(allocas) != 2 || allocas[0] != first.impl || entry != allocas[0] || llvm.NextInstruction(entry) != allocas[1] {
+		t.Fatal("Alloc: alloca not after the entry allocas\n" + pkg.String())
+	}
+}
+
+func TestCtors(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	newFn := func(name string) Function {
+		fn := pkg.NewFunc(name, NoArgsNoRet, InC)
+		fn.MakeBody(1).Return()
+		return fn
+	}
+	pkg.AddCtor(newFn("a"), PriorityDefault)
+	pkg.AddCtor(newFn("b"), PriorityRuntime)
+	pkg.AddDtor(newFn("c"), PriorityDefault)
+	ir := pkg.String()
+	want := `@llvm.global_ctors = appending global [2 x { i32, ptr, ptr }] [{ i32, ptr, ptr } { i32 101, ptr @b, ptr null }, { i32, ptr, ptr } { i32 65535, ptr @a, ptr null }]`
+	if !strings.Contains(ir, want) {
+		t.Fatal("AddCtor:\n" + ir)
+	}
+	if !strings.Contains(ir, `@llvm.global_dtors = appending global [1 x { i32, ptr, ptr }]`) {
+		t.Fatal("AddDtor:\n" + ir)
+	}
+}
+
+func TestIndirectBr(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.NewFunc("dispatch", NoArgsNoRet, InGo)
+	b := fn.MakeBody(3)
+	ops := []BasicBlock{fn.Block(1), fn.Block(2)}
+	tbl := fn.BlockTable(ops...)
+	addr := b.Load(b.IndexAddr(tbl, prog.Val(1)))
+	b.IndirectBr(addr, ops...)
+	for _, op := range ops {
+		b.SetBlock(op)
+		b.Return()
+	}
+	ir := pkg.String()
+	if !strings.Contains(ir, `private unnamed_addr constant [2 x ptr] [ptr blockaddress(@dispatch, %_llgo_1), ptr blockaddress(@dispatch, %_llgo_2)]`) ||
+		!strings.Contains(ir, `indirectbr ptr`) {
+		t.Fatal("IndirectBr:\n" + ir)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Addr: no panic")
+		}
+	}()
+	fn.Block(0).Addr()
+}
+
+func TestFuncString(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	g := pkg.NewVar("a", types.NewPointer(types.Typ[types.Int]), InGo)
+	if s := g.Expr.String(); s != "@a ; *int" {
+		t.Fatal("Expr.String:", s)
+	}
+	params := types.NewTuple(types.NewVar(0, nil, "x", types.Typ[types.Int]))
+	rets := types.NewTuple(types.NewVar(0, nil, "", types.Typ[types.Int]))
+	sig := types.NewSignatureType(nil, nil, nil, params, rets, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	b.Note("t0 = x + 1:int")
+	v := b.BinOp(token.ADD, fn.Param(0), prog.Val(1))
+	b.Note("return t0")
+	b.Return(v)
+	if s := v.String(); s != "%1 = add i64 %0, 1 ; int" {
+		t.Fatal("Expr.String:", s)
+	}
+	if s := fn.String(); !strings.Contains(s, "_llgo_0:\n  ; t0 = x + 1:int\n  %1 = add i64 %0, 1\n  ; return t0\n  ret i64 %1\n}") {
+		t.Fatal("Function.String:\n" + s)
+	}
+}
+
+func TestFunclets(t *testing.T) {
+	prog := NewProgram(&Target{GOOS: "windows", GOARCH: "amd64"})
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InC)
+	fn.SetMSVCPersonality()
+	b := fn.MakeBody(5)
+	dispatch, handler, cleanup, done := fn.Block(1), fn.Block(2), fn.Block(3), fn.Block(4)
+	b.Jump(done)
+	b.SetBlock(dispatch)
+	cs := b.CatchSwitch(prog.NonePad(), cleanup, handler)
+	b.SetBlock(handler)
+	pad := b.CatchPad(cs, prog.Nil(prog.VoidPtr()), prog.IntVal(64, prog.Int32()), prog.Nil(prog.VoidPtr()))
+	b.CatchRet(pad, done)
+	b.SetBlock(cleanup)
+	cpad := b.CleanupPad(prog.NonePad())
+	b.CleanupRet(cpad, nil)
+	b.SetBlock(done)
+	b.Return()
+	ir := pkg.String()
+	for _, want := range []string{
+		"personality ptr @__CxxFrameHandler3",
+		"catchswitch within none [label %_llgo_2] unwind label %_llgo_3",
+		"catchpad within %",
+		"catchret from %",
+		"cleanuppad within none []",
+		"cleanupret from %",
+	} {
+		if !strings.Contains(ir, want) {
+			t.Fatalf("Funclets: %s not found\n%s", want, ir)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("SetMSVCPersonality: no panic")
+		}
+	}()
+	NewProgram(&Target{GOOS: "linux", GOARCH: "amd64"}).NewPackage("bar", "foo/bar").NewFunc("fn", NoArgsNoRet, InC).SetMSVCPersonality()
+}
+
+var stableTypes = map[string]bool{
+	"Program": true, "Package": true, "Builder": true, "Function": true,
+	"BasicBlock": true, "Global": true, "Type": true, "Expr": true,
+}
+
+func apiSig(ft *ast.FuncType) string {
+	list := func(fl *ast.FieldList) (ret []string) {
+		if fl == nil {
+			return
+		}
+		for _, f := range fl.List {
+			typ := types.ExprString(f.Type)
+			ret = append(ret, typ)
+			for i := 1; i < len(f.Names); i++ {
+				ret = append(ret, typ)
+			}
+		}
+		return
+	}
+	sig := "(" + strings.Join(list(ft.Params), ", ") + ")"
+	switch results := list(ft.Results); len(results) {
+	case 0:
+	case 1:
+		sig += " " + results[0]
+	default:
+		sig += " (" + strings.Join(results, ", ") + ")"
+	}
+	return sig
+}
+
+// apiOf returns the stable API of package ssa, one signature per line.
+func apiOf(t *testing.T) map[string]bool {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal("apiOf:", err)
+	}
+	api := make(map[string]bool)
+	for _, f := range pkgs["ssa"].Files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() || strings.HasPrefix(fn.Name.Name, "Py") {
+				continue
+			}
+			recv := ""
+			if fn.Recv != nil {
+				typ := types.ExprString(fn.Recv.List[0].Type)
+				if !stableTypes[typ] {
+					continue
+				}
+				recv = "(" + typ + ") "
+			}
+			api["func "+recv+fn.Name.Name+apiSig(fn.Type)] = true
+		}
+	}
+	return api
+}
+
+func TestAPICompat(t *testing.T) {
+	data, err := os.ReadFile(fmt.Sprintf("api/v%d.txt", APIVersion))
+	if err != nil {
+		t.Fatal("TestAPICompat:", err)
+	}
+	api := apiOf(t)
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !api[line] {
+			t.Errorf("TestAPICompat: %s is changed or removed", line)
+		}
+		delete(api, line)
+	}
+	for line := range api {
+		t.Logf("TestAPICompat: %s is not listed", line)
+	}
+}
+
+func TestHardening(t *testing.T) {
+	if h, err := ParseHardening("sspstrong, stackclash"); err != nil || h != HardenStackProtector|HardenStackClash {
+		t.Fatal("ParseHardening:", h, err)
+	}
+	if _, err := ParseHardening("ssp"); err == nil {
+		t.Fatal("ParseHardening: no error")
+	}
+	if s := (HardenStackProtector | HardenCFProtection).String(); s != "sspstrong,cfprotection" {
+		t.Fatal("Hardening.String:", s)
+	}
+	prog := NewProgram(nil)
+	prog.SetHardening(HardenAll)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	pkg.NewFunc("fn", NoArgsNoRet, InGo).MakeBody(1).Return()
+	fn2 := pkg.NewFunc("fn2", NoArgsNoRet, InGo)
+	fn2.SetHardening(HardenStackClash)
+	fn2.MakeBody(1).Return()
+	pkg.NewFunc("decl", NoArgsNoRet, InC)
+	ir := pkg.String()
+	for _, want := range []string{
+		"sspstrong", `"probe-stack"="inline-asm"`,
+		`!{i32 4, !"cf-protection-branch", i32 1}`, `!{i32 4, !"cf-protection-return", i32 1}`,
+	} {
+		if !strings.Contains(ir, want) {
+			t.Fatalf("Hardening: %s not found\n%s", want, ir)
+		}
+	}
+	if n := strings.Count(ir, "attributes #"); n != 2 {
+		t.Fatal("Hardening: expect 2 attribute groups, got", n, "\n"+ir)
+	}
+}
+
+func TestCover(t *testing.T) {
+	if m, err := ParseCoverMode("atomic"); err != nil || m != CoverAtomic || m.String() != "atomic" {
+		t.Fatal("ParseCoverMode:", m, err)
+	}
+	if _, err := ParseCoverMode(""); err == nil {
+		t.Fatal("ParseCoverMode: no error")
+	}
+	prog := newRtProgram(t, nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	counters := pkg.NewCoverCounters(CoverCount, "foo/bar/bar.go:3.2,3.10 1\nfoo/bar/bar.go:5.2,6.10 2\n", 2)
+	b := pkg.NewFunc("fn", NoArgsNoRet, InGo).MakeBody(1)
+	b.CoverCount(counters, 1, CoverCount)
+	b.CoverCount(counters, 0, CoverAtomic)
+	b.Return()
+	ir := pkg.String()
+	for _, want := range []string{
+		`@"foo/bar.cover$counters" = internal global [2 x i32] zeroinitializer`,
+		`{ i32 200, ptr @"foo/bar.cover$init", ptr null }`,
+		`[2 x i32], ptr @"foo/bar.cover$counters", i64 0, i64 1`,
+		"atomicrmw add ptr", "i32 1 monotonic",
+	} {
+		if !strings.Contains(ir, want) {
+			t.Fatalf("Cover: %s not found\n%s", want, ir)
+		}
+	}
+}
+
+func TestOptimize(t *testing.T) {
+	if l, err := ParseOptLevel("z"); err != nil || l != OptOz || l.String() != "-Oz" {
+		t.Fatal("ParseOptLevel:", l, err)
+	}
+	if _, err := ParseOptLevel("4"); err == nil {
+		t.Fatal("ParseOptLevel: no error")
+	}
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	b := pkg.NewFunc("fn", NoArgsNoRet, InGo).MakeBody(1)
+	b.Sigsetjmp(b.AllocaSigjmpBuf(), prog.IntVal(0, prog.CInt()))
+	b.Return()
+	if err := pkg.Optimize(OptO0); err != nil || strings.Contains(pkg.String(), "returns_twice") {
+		t.Fatal("Optimize -O0:", err)
+	}
+	if err := pkg.Optimize(OptO2); err != nil {
+		t.Fatal("Optimize:", err)
+	}
+	if ir := pkg.String(); !strings.Contains(ir, "returns_twice") {
+		t.Fatal("Optimize: sigsetjmp doesn't return twice\n" + ir)
+	}
+}
+
+func TestPragmas(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	fn := pkg.NewFunc("fn", NoArgsNoRet, InGo)
+	fn.SetNoInline()
+	fn.SetNoSplit()
+	fn.MakeBody(1).Return()
+	fn2 := pkg.NewFunc("fn2", NoArgsNoRet, InGo)
+	fn2.SetAlwaysInline()
+	fn2.MakeBody(1).Return()
+	if !fn.NoSplit() || fn2.NoSplit() {
+		t.Fatal("NoSplit")
+	}
+	ir := pkg.String()
+	if !strings.Contains(ir, "{ noinline }") || !strings.Contains(ir, "{ alwaysinline }") {
+		t.Fatal("Pragmas: no inline attributes\n" + ir)
+	}
+}
+
+func TestIsNonNil(t *testing.T) {
+	prog := NewProgram(nil)
+	pkg := prog.NewPackage("bar", "foo/bar")
+	st := types.NewStruct([]*types.Var{types.NewField(0, nil, "p", types.NewPointer(types.Typ[types.Int]), false)}, nil)
+	pp := types.NewParam(0, nil, "pp", types.NewPointer(st))
+	sig := types.NewSignatureType(nil, nil, nil, types.NewTuple(pp), nil, false)
+	fn := pkg.NewFunc("fn", sig, InGo)
+	b := fn.MakeBody(1)
+	x := fn.Param(0)
+	fld := b.FieldAddr(x, 0)
+	local := b.AllocaInEntry(prog.Type(st, InGo))
+	if b.IsNonNil(x) || b.IsNonNil(fld) || b.IsNonNil(b.Load(fld)) {
+		t.Fatal("IsNonNil: unexpected true")
+	}
+	if !b.IsNonNil(local) || !b.IsNonNil(b.FieldAddr(local, 0)) || !b.IsNonNil(b.LoadNonNil(fld)) {
+		t.Fatal("IsNonNil: unexpected false")
+	}
+	b.Return()
+	if ir := pkg.String(); !strings.Contains(ir, "!nonnull") {
+		t.Fatal("LoadNonNil:\n" + ir)
+	}
+}
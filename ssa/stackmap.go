@@ -0,0 +1,417 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ssa
+
+import (
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llvm"
+)
+
+// -----------------------------------------------------------------------------
+
+// NameRootChain is the head of the shadow stack of a thread: the list of the
+// frames of functions with stack maps (see SetStackMaps). It's thread-local,
+// and defined by every module using it.
+const NameRootChain = "llvm_gc_root_chain"
+
+// SetStackMaps enables or disables stack maps, for a GC scanning goroutine
+// stacks precisely instead of conservatively. Functions with stack maps use
+// the shadow stack GC strategy of LLVM: they push their frame on the shadow
+// stack of the thread (see NameRootChain), and the frame map, emitted by the
+// code generator, locates the roots of the frame:
+//   - a slot for each pointer in a value live across a call (a safepoint),
+//     holding a copy of the pointer;
+//   - a slot for each stack object which may contain pointers, holding its
+//     address, with the pointer map of its words as meta data (see ptrMap).
+//
+// Roots of values aren't cleared when values die, so they are precise about
+// types, not about liveness.
+func (p Program) SetStackMaps(b bool) {
+	p.stackMaps = b
+}
+
+// StackMaps reports whether stack maps are enabled.
+func (p Program) StackMaps() bool {
+	return p.stackMaps
+}
+
+// initThreadLocal makes g a thread-local global, defined null in every module
+// using it (see NameRootChain and NameG). It's hidden, so that a program
+// computes its address as an offset from the thread pointer (the local-exec
+// TLS model), and a library calls __tls_get_addr once per function at most.
+func initThreadLocal(g llvm.Value) {
+	g.SetThreadLocal(true)
+	g.SetVisibility(llvm.HiddenVisibility)
+	g.SetInitializer(llvm.ConstNull(g.GlobalValueType()))
+	g.SetLinkage(llvm.LinkOnceAnyLinkage)
+}
+
+// rootChain returns the address of the shadow stack head.
+func (p Package) rootChain() Expr {
+	return p.NewVarEx(NameRootChain, p.Prog.VoidPtrPtr()).Expr
+}
+
+// saveRootChain returns the shadow stack head, to be restored by
+// restoreRootChain when a panic recovers in the function: the frames of the
+// functions it unwinds are still on the shadow stack.
+func (b Builder) saveRootChain() Expr {
+	return b.Load(b.Pkg.rootChain())
+}
+
+func (b Builder) restoreRootChain(head Expr) {
+	b.Store(b.Pkg.rootChain(), head)
+}
+
+// emitStackMap emits the stack map of the function (see SetStackMaps).
+func (b Builder) emitStackMap() {
+	fn := b.Func.impl
+	if fn.BasicBlocksCount() == 0 {
+		return
+	}
+	b.Pkg.rootChain() // the lowering pass uses the thread-local definition
+	fn.SetGC("shadow-stack")
+
+	prog := b.Prog
+	tptr := prog.tyVoidPtr()
+	gcroot := b.Pkg.cFunc("llvm.gcroot", prog.tyGCRoot()).impl
+	gcrootTy := gcroot.GlobalValueType()
+	null := llvm.ConstNull(tptr)
+
+	entry := fn.EntryBasicBlock()
+	pos := entry.FirstInstruction()
+	for !pos.IsNil() && !pos.IsAAllocaInst().IsNil() {
+		pos = llvm.NextInstruction(pos)
+	}
+	eb := prog.ctx.NewBuilder()
+	defer eb.Dispose()
+	setPos := func(pos llvm.Value) {
+		if pos.IsNil() {
+			eb.SetInsertPointAtEnd(entry)
+		} else {
+			eb.SetInsertPointBefore(pos)
+		}
+	}
+	newRoot := func(meta llvm.Value) llvm.Value {
+		setPos(pos)
+		slot := llvm.CreateAlloca(eb, tptr)
+		eb.CreateCall(gcrootTy, gcroot, []llvm.Value{slot, meta}, "")
+		return slot
+	}
+
+	// stack objects
+	var objs []llvm.Value
+	for instr := entry.FirstInstruction(); instr != pos; instr = llvm.NextInstruction(instr) {
+		t := instr.AllocatedType()
+		if n := instr.Operand(0); n.IsAConstantInt().IsNil() || n.ZExtValue() != 1 {
+			continue
+		}
+		if llvmHasPointers(t) || isByteBuffer(t) {
+			objs = append(objs, instr)
+		}
+	}
+	for _, obj := range objs {
+		slot := newRoot(b.Pkg.ptrMap(obj.AllocatedType()))
+		eb.CreateStore(obj, slot)
+	}
+
+	// values live across calls
+	for _, v := range liveAcrossCalls(fn) {
+		var slots []llvm.Value
+		var paths [][]int
+		ptrLeaves(v.Type(), nil, func(path []int) {
+			slots = append(slots, newRoot(null))
+			paths = append(paths, append([]int(nil), path...))
+		})
+		switch {
+		case !v.IsAArgument().IsNil():
+			setPos(pos)
+		case !v.IsAPHINode().IsNil():
+			at := v.InstructionParent().FirstInstruction()
+			for !at.IsAPHINode().IsNil() {
+				at = llvm.NextInstruction(at)
+			}
+			eb.SetInsertPointBefore(at)
+		default:
+			eb.SetInsertPointBefore(llvm.NextInstruction(v))
+		}
+		for i, slot := range slots {
+			leaf := v
+			for _, idx := range paths[i] {
+				leaf = llvm.CreateExtractValue(eb, leaf, idx)
+			}
+			eb.CreateStore(leaf, slot)
+		}
+	}
+}
+
+// isByteBuffer reports whether t is the element type of an untyped buffer
+// allocated by Builder.Alloca. Its content is unknown, so it is treated
+// conservatively as a pointer slot.
+func isByteBuffer(t llvm.Type) bool {
+	return t.TypeKind() == llvm.IntegerTypeKind && t.IntTypeWidth() == 8
+}
+
+func (p Program) tyGCRoot() *types.Signature {
+	if p.gcrootTy == nil {
+		paramPtr := types.NewParam(0, nil, "", types.Typ[types.UnsafePointer])
+		params := types.NewTuple(paramPtr, paramPtr)
+		p.gcrootTy = types.NewSignatureType(nil, nil, nil, params, nil, false)
+	}
+	return p.gcrootTy
+}
+
+// ptrLeaves calls f with the extractvalue indexes of each pointer in values
+// of type t.
+func ptrLeaves(t llvm.Type, path []int, f func(path []int)) {
+	switch t.TypeKind() {
+	case llvm.PointerTypeKind:
+		f(path)
+	case llvm.StructTypeKind:
+		for i, ft := range t.StructElementTypes() {
+			ptrLeaves(ft, append(path, i), f)
+		}
+	case llvm.ArrayTypeKind:
+		et := t.ElementType()
+		if !llvmHasPointers(et) {
+			return
+		}
+		for i, n := 0, t.ArrayLength(); i < n; i++ {
+			ptrLeaves(et, append(path, i), f)
+		}
+	}
+}
+
+// ptrMap returns the pointer map of stack objects of type t: a constant
+// {i32 nwords, [n x i8] bits}, bit i being set if word i may be a pointer.
+// All words of byte buffers may be pointers.
+func (p Package) ptrMap(t llvm.Type) llvm.Value {
+	prog := p.Prog
+	td := prog.td
+	word := uint64(prog.PointerSize())
+	nwords := (td.TypeAllocSize(t) + word - 1) / word
+	bitmap := make([]byte, (nwords+7)/8)
+	if isByteBuffer(t) {
+		for i := uint64(0); i < nwords; i++ {
+			bitmap[i/8] |= 1 << (i % 8)
+		}
+	} else {
+		ptrOffsets(td, t, 0, func(off uint64) {
+			i := off / word
+			bitmap[i/8] |= 1 << (i % 8)
+		})
+	}
+	var sb strings.Builder
+	sb.WriteString("__llgo_ptrmap$")
+	sb.WriteString(strconv.FormatUint(nwords, 10))
+	for _, c := range bitmap {
+		sb.WriteByte(hexDigits[c>>4])
+		sb.WriteByte(hexDigits[c&15])
+	}
+	name := sb.String()
+	if g := p.mod.NamedGlobal(name); !g.IsNil() {
+		return g
+	}
+	ctx := prog.ctx
+	init := ctx.ConstStruct([]llvm.Value{
+		llvm.ConstInt(prog.tyInt32(), nwords, false),
+		ctx.ConstString(string(bitmap), false),
+	}, false)
+	g := llvm.AddGlobal(p.mod, init.Type(), name)
+	g.SetInitializer(init)
+	g.SetGlobalConstant(true)
+	g.SetLinkage(llvm.LinkOnceODRLinkage)
+	return g
+}
+
+const hexDigits = "0123456789abcdef"
+
+// ptrOffsets calls f with the offset of each pointer in values of type t.
+func ptrOffsets(td llvm.TargetData, t llvm.Type, off uint64, f func(off uint64)) {
+	switch t.TypeKind() {
+	case llvm.PointerTypeKind:
+		f(off)
+	case llvm.StructTypeKind:
+		for i, ft := range t.StructElementTypes() {
+			if llvmHasPointers(ft) {
+				ptrOffsets(td, ft, off+td.ElementOffset(t, i), f)
+			}
+		}
+	case llvm.ArrayTypeKind:
+		et := t.ElementType()
+		if !llvmHasPointers(et) {
+			return
+		}
+		size := td.TypeAllocSize(et)
+		for i, n := uint64(0), uint64(t.ArrayLength()); i < n; i++ {
+			ptrOffsets(td, et, off+i*size, f)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// liveAcrossCalls returns the values of fn which may contain pointers, and
+// are live across a call: they must be roots when the GC runs in the callee.
+// Addresses of stack slots aren't, their content is (see emitStackMap).
+func liveAcrossCalls(fn llvm.Value) (ret []llvm.Value) {
+	var vals []llvm.Value
+	index := make(map[llvm.Value]int)
+	track := func(v llvm.Value) {
+		if llvmHasPointers(v.Type()) && !isStackAddr(v) {
+			index[v] = len(vals)
+			vals = append(vals, v)
+		}
+	}
+	for _, param := range fn.Params() {
+		track(param)
+	}
+	var blks []llvm.BasicBlock
+	bindex := make(map[llvm.BasicBlock]int)
+	for bb := fn.FirstBasicBlock(); !bb.IsNil(); bb = llvm.NextBasicBlock(bb) {
+		bindex[bb] = len(blks)
+		blks = append(blks, bb)
+		for instr := bb.FirstInstruction(); !instr.IsNil(); instr = llvm.NextInstruction(instr) {
+			track(instr)
+		}
+	}
+	if len(vals) == 0 {
+		return
+	}
+
+	n := len(vals)
+	newSet := func() bitSet { return make(bitSet, (n+63)/64) }
+	uses := make([]bitSet, len(blks))   // used before defined in the block
+	defs := make([]bitSet, len(blks))   // defined in the block
+	phiIn := make([]bitSet, len(blks))  // used by phis of successors
+	succs := make([][]int, len(blks))   // successors
+	liveIn := make([]bitSet, len(blks)) // live at the block start
+	liveOut := make([]bitSet, len(blks))
+	for i := range blks {
+		uses[i], defs[i], phiIn[i] = newSet(), newSet(), newSet()
+		liveIn[i], liveOut[i] = newSet(), newSet()
+	}
+	for i, bb := range blks {
+		for instr := bb.FirstInstruction(); !instr.IsNil(); instr = llvm.NextInstruction(instr) {
+			if instr.IsAPHINode().IsNil() {
+				for k, nop := 0, instr.OperandsCount(); k < nop; k++ {
+					if j, ok := index[instr.Operand(k)]; ok && !defs[i].has(j) {
+						uses[i].add(j)
+					}
+				}
+			} else {
+				for k, nin := 0, instr.IncomingCount(); k < nin; k++ {
+					if j, ok := index[instr.IncomingValue(k)]; ok {
+						phiIn[bindex[instr.IncomingBlock(k)]].add(j)
+					}
+				}
+			}
+			if j, ok := index[instr]; ok {
+				defs[i].add(j)
+			}
+		}
+		term := bb.LastInstruction()
+		for k, nop := 0, term.OperandsCount(); k < nop; k++ {
+			if op := term.Operand(k); op.IsBasicBlock() {
+				succs[i] = append(succs[i], bindex[op.AsBasicBlock()])
+			}
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := len(blks) - 1; i >= 0; i-- {
+			out := liveOut[i]
+			out.or(phiIn[i])
+			for _, s := range succs[i] {
+				out.or(liveIn[s])
+			}
+			in := newSet()
+			in.or(out)
+			in.andNot(defs[i])
+			in.or(uses[i])
+			if !in.equal(liveIn[i]) {
+				liveIn[i] = in
+				changed = true
+			}
+		}
+	}
+
+	across := newSet()
+	for i, bb := range blks {
+		live := newSet()
+		live.or(liveOut[i])
+		for instr := bb.LastInstruction(); !instr.IsNil() && instr.IsAPHINode().IsNil(); instr = llvm.PrevInstruction(instr) {
+			if j, ok := index[instr]; ok {
+				live.del(j)
+			}
+			if isSafepoint(instr) {
+				across.or(live)
+			}
+			for k, nop := 0, instr.OperandsCount(); k < nop; k++ {
+				if j, ok := index[instr.Operand(k)]; ok {
+					live.add(j)
+				}
+			}
+		}
+	}
+	for j, v := range vals {
+		if across.has(j) {
+			ret = append(ret, v)
+		}
+	}
+	return
+}
+
+// isSafepoint reports whether the GC may run during instr: it's a call of a
+// function, not of an intrinsic.
+func isSafepoint(instr llvm.Value) bool {
+	if instr.IsACallInst().IsNil() || !instr.IsAIntrinsicInst().IsNil() {
+		return false
+	}
+	return true
+}
+
+type bitSet []uint64
+
+func (s bitSet) has(i int) bool { return s[i/64]&(1<<(i%64)) != 0 }
+func (s bitSet) add(i int)      { s[i/64] |= 1 << (i % 64) }
+func (s bitSet) del(i int)      { s[i/64] &^= 1 << (i % 64) }
+
+func (s bitSet) or(t bitSet) {
+	for i, w := range t {
+		s[i] |= w
+	}
+}
+
+func (s bitSet) andNot(t bitSet) {
+	for i, w := range t {
+		s[i] &^= w
+	}
+}
+
+func (s bitSet) equal(t bitSet) bool {
+	for i, w := range t {
+		if s[i] != w {
+			return false
+		}
+	}
+	return true
+}
+
+// -----------------------------------------------------------------------------
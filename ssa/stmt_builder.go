@@ -22,6 +22,7 @@ import (
 	"go/token"
 	"go/types"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/goplus/llvm"
@@ -49,12 +50,40 @@ func (p BasicBlock) Index() int {
 	return p.idx
 }
 
-// Addr returns the address of the basic block.
+// Addr returns the address of the basic block. It is a constant that can be
+// stored anywhere and used as a target of Builder.IndirectBr. The entry block
+// of a function has no address.
 func (p BasicBlock) Addr() Expr {
 	fn := p.fn
+	if p.first == fn.impl.EntryBasicBlock() {
+		panic("Addr: the entry block has no address")
+	}
 	return Expr{llvm.BlockAddress(fn.impl, p.first), fn.Prog.VoidPtr()}
 }
 
+// BlockTable creates a constant table of addresses of blks and returns a
+// pointer to it (of type *[len(blks)]unsafe.Pointer). Indexing the table
+// gives the target of Builder.IndirectBr, e.g. to dispatch opcodes of a
+// bytecode interpreter by computed gotos.
+func (p Function) BlockTable(blks ...BasicBlock) Expr {
+	prog := p.Prog
+	voidPtr := prog.VoidPtr()
+	addrs := make([]llvm.Value, len(blks))
+	for i, blk := range blks {
+		if blk.fn != p {
+			panic("mismatched function")
+		}
+		addrs[i] = blk.Addr().impl
+	}
+	t := prog.rawType(types.NewArray(voidPtr.raw.Type, int64(len(blks))))
+	tbl := llvm.AddGlobal(p.Pkg.mod, t.ll, "")
+	tbl.SetInitializer(llvm.ConstArray(voidPtr.ll, addrs))
+	tbl.SetLinkage(llvm.PrivateLinkage)
+	tbl.SetGlobalConstant(true)
+	tbl.SetUnnamedAddr(true)
+	return Expr{tbl, prog.Pointer(t)}
+}
+
 // -----------------------------------------------------------------------------
 
 type aBuilder struct {
@@ -63,6 +92,8 @@ type aBuilder struct {
 	Func Function
 	Pkg  Package
 	Prog Program
+
+	instrumenting bool // in an Instrumenter callback
 }
 
 // Builder represents a builder for creating instructions in a function.
@@ -71,6 +102,9 @@ type Builder = *aBuilder
 // EndBuild ends the build process of a function.
 func (b Builder) EndBuild() {
 	b.Func.endDefer(b)
+	if b.Prog.stackMaps {
+		b.emitStackMap()
+	}
 }
 
 // Dispose disposes of the builder.
@@ -97,6 +131,7 @@ func (b Builder) setBlockMoveLast(blk BasicBlock) (next BasicBlock) {
 	next = b.Func.MakeBlock()
 	impl.SetInsertPointAtEnd(next.last)
 	impl.Insert(last)
+	blk.last = next.last // blk ends in next now, e.g. for phi nodes of its succs
 
 	impl.SetInsertPointAtEnd(blkLast)
 	return
@@ -185,7 +220,12 @@ func (b Builder) Return(results ...Expr) {
 		}
 		typ := b.Prog.Struct(typs...)
 		expr := b.aggregateValue(typ, llvmParams(0, results, tret, b)...)
-		b.impl.CreateRet(expr.impl)
+		if sret := b.Func.sret; !sret.IsNil() {
+			b.impl.CreateStore(expr.impl, sret.impl)
+			b.impl.CreateRetVoid()
+		} else {
+			b.impl.CreateRet(expr.impl)
+		}
 	}
 }
 
@@ -216,10 +256,24 @@ func (b Builder) Jump(jmpb BasicBlock) {
 	b.impl.CreateBr(jmpb.first)
 }
 
-// IndirectJump emits an indirect jump instruction.
+// IndirectJump means IndirectBr(addr, dests...).
+//
+// Deprecated: Use IndirectBr instead.
 func (b Builder) IndirectJump(addr Expr, dests []BasicBlock) {
+	b.IndirectBr(addr, dests...)
+}
+
+// IndirectBr emits an indirectbr instruction, which jumps to the block at
+// addr (see BasicBlock.Addr and Function.BlockTable). dests lists all blocks
+// that addr may refer to.
+func (b Builder) IndirectBr(addr Expr, dests ...BasicBlock) {
+	for _, dest := range dests {
+		if b.Func != dest.fn {
+			panic("mismatched function")
+		}
+	}
 	if debugInstr {
-		log.Printf("IndirectJump %v\n", addr.impl)
+		log.Printf("IndirectBr %v\n", addr.impl)
 	}
 	ibr := b.impl.CreateIndirectBr(addr.impl, len(dests))
 	for _, dest := range dests {
@@ -266,37 +320,101 @@ func (b Builder) For(cond func() Expr, loop func()) {
 
 // Times emits a times-loop instruction.
 func (b Builder) Times(n Expr, loop func(i Expr)) {
-	at := b.blk
-	blks := b.Func.MakeBlocks(3)
-	b.Jump(blks[0])
-	b.SetBlockEx(blks[0], AtEnd, false)
 	typ := n.Type
-	phi := b.Phi(typ)
-	b.If(b.BinOp(token.LSS, phi.Expr, n), blks[1], blks[2])
-	b.SetBlockEx(blks[1], AtEnd, false)
-	loop(phi.Expr)
-	post := b.BinOp(token.ADD, phi.Expr, b.Prog.IntVal(1, typ))
-	b.Jump(blks[0])
-	phi.AddIncoming(b, []BasicBlock{at, blks[1]}, func(i int, blk BasicBlock) Expr {
-		if i == 0 {
-			return b.Prog.IntVal(0, typ)
-		}
-		return post
+	l := b.Loop(b.Prog.IntVal(0, typ), func(i Expr) Expr {
+		return b.BinOp(token.LSS, i, n)
+	}, func(i Expr) Expr {
+		return b.BinOp(token.ADD, i, b.Prog.IntVal(1, typ))
 	})
-	b.SetBlockEx(blks[2], AtEnd, false)
-	b.blk.last = blks[2].last
+	loop(l.Index)
+	l.End(b)
+}
+
+// A Loop is a loop with an induction variable, see Builder.Loop.
+type Loop = *aLoop
+
+type aLoop struct {
+	Index Expr       // the induction variable
+	Exit  BasicBlock // jump to it to break out of the loop
+
+	header BasicBlock
+	latch  BasicBlock // see Continue
+	phi    Phi
+	init   Expr
+	pre    llvm.BasicBlock
+	step   func(i Expr) Expr
+}
+
+// Loop starts a loop with an induction variable i, like:
+//
+//	for i := init; cond(i); i = step(i) {
+//		...
+//	}
+//
+// It creates the header, body and exit blocks of the loop and the phi node of
+// i, and leaves the builder in the body. The body is closed by Loop.End.
+func (b Builder) Loop(init Expr, cond, step func(i Expr) Expr) Loop {
+	if debugInstr {
+		log.Printf("Loop %v\n", init.impl)
+	}
+	blks := b.Func.MakeBlocks(3)
+	header, body, exit := blks[0], blks[1], blks[2]
+	pre := b.impl.GetInsertBlock()
+	b.Jump(header)
+	b.SetBlockEx(header, AtEnd, false)
+	phi := b.Phi(init.Type)
+	b.If(cond(phi.Expr), body, exit)
+	b.SetBlockEx(body, AtEnd, false)
+	return &aLoop{
+		Index: phi.Expr, Exit: exit,
+		header: header, phi: phi, init: init, pre: pre, step: step,
+	}
+}
+
+// Continue returns the block to jump to for starting the next iteration of
+// the loop.
+func (l Loop) Continue() BasicBlock {
+	if l.latch == nil {
+		l.latch = l.header.fn.MakeBlock()
+	}
+	return l.latch
+}
+
+// End closes the body of the loop: it steps the induction variable, jumps
+// back to the header and leaves the builder in the exit block.
+func (l Loop) End(b Builder) {
+	if debugInstr {
+		log.Printf("EndLoop _llgo_%v\n", l.header.idx)
+	}
+	if l.latch != nil {
+		b.Jump(l.latch)
+		b.SetBlockEx(l.latch, AtEnd, false)
+	}
+	next := checkExpr(l.step(l.Index), l.Index.raw.Type, b)
+	latch := b.impl.GetInsertBlock()
+	b.Jump(l.header)
+	vals := []llvm.Value{l.init.impl, next.impl}
+	l.phi.impl.AddIncoming(vals, []llvm.BasicBlock{l.pre, latch})
+	b.SetBlockEx(l.Exit, AtEnd, false)
+	b.blk.last = l.Exit.last
 }
 
 // -----------------------------------------------------------------------------
-/*
+
+const (
+	switchMinCases = 8  // min number of cases to lower a switch by a search tree
+	switchMaxGap   = 16 // max gap between adjacent case values of a cluster
+)
+
 type caseStmt struct {
 	v   llvm.Value
 	blk llvm.BasicBlock
+	key uint64 // order-preserving key of a constant case value
 }
 
 type aSwitch struct {
-	v     llvm.Value
-	def   llvm.BasicBlock
+	v     Expr
+	def   BasicBlock
 	cases []caseStmt
 }
 
@@ -308,25 +426,198 @@ func (p Switch) Case(v Expr, blk BasicBlock) {
 	if debugInstr {
 		log.Printf("Case %v, _llgo_%v\n", v.impl, blk.idx)
 	}
-	p.cases = append(p.cases, caseStmt{v.impl, blk.first})
+	p.cases = append(p.cases, caseStmt{v: v.impl, blk: blk.first})
 }
 
 // End ends a switch statement.
+//
+// A switch with sparse, wide-range case values (e.g. unicode tables) is
+// lowered to a binary search over clusters of dense case values, each of
+// which is a small llvm switch. So it neither becomes an enormous jump table
+// nor a long compare chain. In this case, the case blocks are reached from
+// new blocks rather than the current one, so they can't have phi nodes.
 func (p Switch) End(b Builder) {
-	sw := b.impl.CreateSwitch(p.v, p.def, len(p.cases))
-	for _, c := range p.cases {
+	cases := p.cases
+	if len(cases) < switchMinCases || !p.initKeys() {
+		p.emit(b, cases)
+		return
+	}
+	sort.Slice(cases, func(i, j int) bool {
+		return cases[i].key < cases[j].key
+	})
+	clusters := splitCases(cases)
+	if len(clusters) == 1 {
+		p.emit(b, cases)
+		return
+	}
+	blk := b.blk
+	p.searchTree(b, clusters)
+	b.SetBlockEx(blk, AtEnd, false)
+}
+
+// initKeys initializes keys of case values. It returns false if some case
+// value isn't a constant.
+func (p Switch) initKeys() bool {
+	signed := p.v.kind == vkSigned
+	for i := range p.cases {
+		c := &p.cases[i]
+		if c.v.IsAConstantInt().IsNil() {
+			return false
+		}
+		if signed {
+			c.key = uint64(c.v.SExtValue()) ^ (1 << 63)
+		} else {
+			c.key = c.v.ZExtValue()
+		}
+	}
+	return true
+}
+
+func (p Switch) emit(b Builder, cases []caseStmt) {
+	sw := b.impl.CreateSwitch(p.v.impl, p.def.first, len(cases))
+	for _, c := range cases {
 		sw.AddCase(c.v, c.blk)
 	}
 }
 
+func (p Switch) searchTree(b Builder, clusters [][]caseStmt) {
+	if len(clusters) == 1 {
+		p.emit(b, clusters[0])
+		return
+	}
+	pred := llvm.IntULT
+	if p.v.kind == vkSigned {
+		pred = llvm.IntSLT
+	}
+	mid := len(clusters) >> 1
+	cond := b.impl.CreateICmp(pred, p.v.impl, clusters[mid][0].v, "")
+	fn := b.Func
+	left, right := fn.MakeBlock(), fn.MakeBlock()
+	b.impl.CreateCondBr(cond, left.first, right.first)
+	b.SetBlockEx(left, AtEnd, false)
+	p.searchTree(b, clusters[:mid])
+	b.SetBlockEx(right, AtEnd, false)
+	p.searchTree(b, clusters[mid:])
+}
+
+// splitCases splits sorted cases into clusters of dense case values.
+func splitCases(cases []caseStmt) (clusters [][]caseStmt) {
+	start := 0
+	for i := 1; i < len(cases); i++ {
+		if cases[i].key-cases[i-1].key > switchMaxGap {
+			clusters = append(clusters, cases[start:i])
+			start = i
+		}
+	}
+	return append(clusters, cases[start:])
+}
+
 // Switch starts a switch statement.
 func (b Builder) Switch(v Expr, defb BasicBlock) Switch {
 	if debugInstr {
 		log.Printf("Switch %v, _llgo_%v\n", v.impl, defb.idx)
 	}
-	return &aSwitch{v.impl, defb.first, nil}
+	return &aSwitch{v, defb, nil}
 }
-*/
+
+// -----------------------------------------------------------------------------
+
+type strCase struct {
+	s   string
+	blk BasicBlock
+}
+
+type aStringSwitch struct {
+	v     Expr
+	def   BasicBlock
+	cases []strCase
+}
+
+// StringSwitch represents a switch statement on a string value with constant
+// case values.
+type StringSwitch = *aStringSwitch
+
+// Case adds a case of a string switch. A duplicate case value is ignored, as
+// an earlier case with the same value always matches first.
+func (p StringSwitch) Case(s string, blk BasicBlock) {
+	if debugInstr {
+		log.Printf("Case %q, _llgo_%v\n", s, blk.idx)
+	}
+	for _, c := range p.cases {
+		if c.s == s {
+			return
+		}
+	}
+	p.cases = append(p.cases, strCase{s, blk})
+}
+
+// End ends a string switch statement.
+//
+// The string is hashed (see runtime.StringHash) and an integer switch on the
+// hash selects a bucket of cases, whose values are then verified by comparing
+// lengths and memcmp. As with Switch, the case blocks are reached from new
+// blocks, so they can't have phi nodes.
+func (p StringSwitch) End(b Builder) {
+	prog := b.Prog
+	fn := b.Func
+	var hashes []uint32
+	buckets := make(map[uint32][]strCase)
+	for _, c := range p.cases {
+		h := stringHash(c.s)
+		if _, ok := buckets[h]; !ok {
+			hashes = append(hashes, h)
+		}
+		buckets[h] = append(buckets[h], c)
+	}
+	blk := b.blk
+	n := b.StringLen(p.v)
+	data := b.StringData(p.v)
+	hash := b.InlineCall(b.Pkg.rtFunc("StringHash"), p.v)
+	sw := b.Switch(hash, p.def)
+	blks := fn.MakeBlocks(len(hashes))
+	for i, h := range hashes {
+		sw.Case(prog.IntVal(uint64(h), prog.Uint32()), blks[i])
+	}
+	sw.End(b)
+	for i, h := range hashes {
+		b.SetBlockEx(blks[i], AtEnd, false)
+		for _, c := range buckets[h] {
+			next := fn.MakeBlock()
+			sameLen := b.BinOp(token.EQL, n, prog.Val(len(c.s)))
+			if c.s == "" {
+				b.If(sameLen, c.blk, next)
+			} else {
+				cmp := fn.MakeBlock()
+				b.If(sameLen, cmp, next)
+				b.SetBlockEx(cmp, AtEnd, false)
+				ret := b.memcmp(data, b.StringData(b.Str(c.s)), prog.IntVal(uint64(len(c.s)), prog.Uintptr()))
+				b.If(b.BinOp(token.EQL, ret, prog.IntVal(0, prog.CInt())), c.blk, next)
+			}
+			b.SetBlockEx(next, AtEnd, false)
+		}
+		b.Jump(p.def)
+	}
+	b.SetBlockEx(blk, AtEnd, false)
+}
+
+// stringHash returns the FNV-1a hash of s, the same as runtime.StringHash.
+func stringHash(s string) uint32 {
+	h := uint32(2166136261)
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
+
+// StringSwitch starts a switch statement on a string value v.
+func (b Builder) StringSwitch(v Expr, defb BasicBlock) StringSwitch {
+	if debugInstr {
+		log.Printf("StringSwitch %v, _llgo_%v\n", v.impl, defb.idx)
+	}
+	return &aStringSwitch{v, defb, nil}
+}
+
 // -----------------------------------------------------------------------------
 
 // Phi represents a phi node.
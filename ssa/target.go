@@ -41,13 +41,54 @@ func (p *Target) targetData() llvm.TargetData {
 	return machine.CreateTargetData()
 }
 
-/*
-func (p *Program) targetMachine() llvm.TargetMachine {
+// SetTargetTriple sets the target triple of the program, e.g.
+// "aarch64-unknown-linux-gnu". The data layout, and so the pointer size, the
+// size of int and the layout of structs, is derived from the target rather
+// than from the host. It must be called before any type is used.
+func (p Program) SetTargetTriple(triple string) {
+	target, err := llvm.GetTargetFromTriple(triple)
+	if err != nil {
+		panic(err)
+	}
+	spec := p.target.toSpec()
+	p.tm = target.CreateTargetMachine(
+		triple,
+		spec.cpu,
+		spec.features,
+		llvm.CodeGenLevelDefault,
+		llvm.RelocDefault,
+		llvm.CodeModelDefault,
+	)
+	p.triple = triple
+	p.setDataLayout(p.tm.CreateTargetData())
+}
+
+// SetDataLayout sets the data layout of the program, e.g.
+// "e-m:e-p:32:32-i64:64-n32-S128", overriding the one of the target. It must
+// be called before any type is used.
+func (p Program) SetDataLayout(layout string) {
+	p.setDataLayout(llvm.NewTargetData(layout))
+}
+
+func (p Program) setDataLayout(td llvm.TargetData) {
+	if p.typs.Len() != 0 || !p.intType.IsNil() {
+		panic("data layout must be set before any type is used")
+	}
+	p.td = td
+	p.layout = td.String()
+	p.ptrSize = td.PointerSize()
+	p.is32Bits = p.ptrSize == 4 || p.target.GOARCH == "x86"
+}
+
+func (p Program) targetMachine() (llvm.TargetMachine, error) {
 	if p.tm.C == nil {
 		spec := p.target.toSpec()
+		if spec.triple == "" {
+			spec.triple = llvm.DefaultTargetTriple()
+		}
 		target, err := llvm.GetTargetFromTriple(spec.triple)
 		if err != nil {
-			panic(err)
+			return p.tm, err
 		}
 		p.tm = target.CreateTargetMachine(
 			spec.triple,
@@ -58,9 +99,8 @@ func (p *Program) targetMachine() llvm.TargetMachine {
 			llvm.CodeModelDefault,
 		)
 	}
-	return p.tm
+	return p.tm, nil
 }
-*/
 
 type targetSpec struct {
 	triple   string
@@ -182,6 +182,11 @@ func (p Program) OffsetOf(typ Type, i int) uint64 {
 	return p.td.ElementOffset(typ.ll, i)
 }
 
+// AlignOf returns the ABI alignment of a type.
+func (p Program) AlignOf(typ Type) uint64 {
+	return uint64(p.td.ABITypeAlignment(typ.ll))
+}
+
 // SizeOf returns the size of a type.
 func SizeOf(prog Program, t Type, n ...int64) Expr {
 	size := prog.SizeOf(t, n...)
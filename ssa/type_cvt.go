@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"go/token"
 	"go/types"
+	"strings"
 	"unsafe"
 )
 
@@ -64,10 +65,11 @@ func (p Program) FuncDecl(sig *types.Signature, bg Background) Type {
 	return &aType{p.toLLVMFunc(sig), rawType{sig}, vkFuncDecl}
 }
 
-// Closure creates a closture type for a function.
+// Closure creates a closture type for a function. The type of fn is a raw
+// function type, so it isn't converted again.
 func (p Program) Closure(fn Type) Type {
 	sig := fn.raw.Type.(*types.Signature)
-	closure := p.gocvt.cvtClosure(sig)
+	closure := closureOf(FuncAddCtx(newClosureCtx(), sig))
 	return p.rawType(closure)
 }
 
@@ -105,11 +107,19 @@ func (p goTypes) cvtType(typ types.Type) (raw types.Type, cvt bool) {
 			return types.NewChan(t.Dir(), elem), true
 		}
 	default:
+		if isOpaque(typ) { // eg. the deferStack type of go/ssa's ssa:deferstack()
+			return types.NewStruct(nil, nil), true
+		}
 		panic(fmt.Sprintf("cvtType: unexpected type - %T", typ))
 	}
 	return typ, false
 }
 
+// isOpaque reports whether typ is an opaque type of go/ssa.
+func isOpaque(typ types.Type) bool {
+	return typ.Underlying() == typ && strings.HasPrefix(fmt.Sprintf("%T", typ), "*ssa.")
+}
+
 func (p goTypes) cvtNamed(t *types.Named) (raw *types.Named, cvt bool) {
 	if v, ok := p.typs[unsafe.Pointer(t)]; ok {
 		raw = (*types.Named)(v)
@@ -133,13 +143,34 @@ func (p goTypes) cvtNamed(t *types.Named) (raw *types.Named, cvt bool) {
 }
 
 func (p goTypes) cvtClosure(sig *types.Signature) *types.Struct {
-	ctx := types.NewParam(token.NoPos, nil, closureCtx, types.Typ[types.UnsafePointer])
-	raw := p.cvtFunc(sig, ctx)
-	flds := []*types.Var{
+	raw := p.cvtFunc(sig, newClosureCtx())
+	if sig.Variadic() {
+		return variadicClosureOf(raw)
+	}
+	return closureOf(raw)
+}
+
+func newClosureCtx() *types.Var {
+	return types.NewParam(token.NoPos, nil, closureCtx, types.Typ[types.UnsafePointer])
+}
+
+func closureOf(raw *types.Signature) *types.Struct {
+	return types.NewStruct(closureFields(raw), nil)
+}
+
+// closureVariadic is the tag of the function field of the closures of
+// variadic Go functions, whose raw functions aren't variadic (see cvtFunc).
+const closureVariadic = "variadic"
+
+func variadicClosureOf(raw *types.Signature) *types.Struct {
+	return types.NewStruct(closureFields(raw), []string{closureVariadic})
+}
+
+func closureFields(raw *types.Signature) []*types.Var {
+	return []*types.Var{
 		types.NewField(token.NoPos, nil, "f", raw, false),
 		types.NewField(token.NoPos, nil, "data", types.Typ[types.UnsafePointer], false),
 	}
-	return types.NewStruct(flds, nil)
 }
 
 func (p goTypes) cvtFunc(sig *types.Signature, recv *types.Var) (raw *types.Signature) {
@@ -0,0 +1,500 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cconst converts C enum values and simple #define constants into
+// typed Go constants.
+package cconst
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/constant"
+	"go/format"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/goplus/llgo/xtool/clang/ast"
+)
+
+// -----------------------------------------------------------------------------
+
+// Kind is the origin of a constant.
+type Kind int
+
+const (
+	Enum  Kind = iota // value of a C enum
+	Macro             // #define constant
+)
+
+// Const represents a Go constant converted from C.
+type Const struct {
+	Name  string
+	Type  string // Go type of the constant (empty if untyped)
+	Value string // Go expression of the value
+	Kind  Kind
+}
+
+// TypeDecl represents a Go type converted from a named C enum.
+type TypeDecl struct {
+	Name       string
+	Underlying string
+}
+
+// Package collects Go constants converted from C.
+type Package struct {
+	Types  []*TypeDecl
+	Consts []*Const
+
+	scope *types.Package
+	flags map[string]litFlags
+}
+
+// NewPackage creates an empty Package.
+func NewPackage() *Package {
+	return &Package{
+		scope: types.NewPackage("C", "C"),
+		flags: make(map[string]litFlags),
+	}
+}
+
+// Lookup returns the constant named name, or nil if not found.
+func (p *Package) Lookup(name string) *Const {
+	for _, c := range p.Consts {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (p *Package) exists(name string) bool {
+	return p.scope.Scope().Lookup(name) != nil
+}
+
+func (p *Package) define(c *Const, v constant.Value, flags litFlags) {
+	var typ types.Type
+	switch v.Kind() {
+	case constant.Int:
+		typ = types.Typ[types.UntypedInt]
+	case constant.Float:
+		typ = types.Typ[types.UntypedFloat]
+	default:
+		typ = types.Typ[types.UntypedString]
+	}
+	p.scope.Scope().Insert(types.NewConst(token.NoPos, p.scope, c.Name, typ, v))
+	p.flags[c.Name] = flags
+	p.Consts = append(p.Consts, c)
+}
+
+// -----------------------------------------------------------------------------
+
+// AddEnums adds values of all enums declared in doc (the clang JSON AST of a
+// translation unit). Only enums that have a value accepted by filter are
+// converted. A filter of nil accepts all values.
+//
+// An enum named by a typedef or a tag becomes a Go type, and its values are
+// typed constants of it. Values of anonymous enums are typed as c.Int.
+func (p *Package) AddEnums(doc *ast.Node, filter func(name string) bool) {
+	names := make(map[ast.ID]string)
+	for _, decl := range doc.Inner {
+		if decl.Kind == ast.TypedefDecl && len(decl.Inner) == 1 {
+			if owned := decl.Inner[0].OwnedTagDecl; owned != nil && owned.Kind == ast.EnumDecl {
+				if _, ok := names[owned.ID]; !ok {
+					names[owned.ID] = decl.Name
+				}
+			}
+		}
+	}
+	for _, decl := range doc.Inner {
+		if decl.Kind != ast.EnumDecl || len(decl.Inner) == 0 {
+			continue
+		}
+		name, ok := names[decl.ID]
+		if !ok && decl.Name != "" {
+			name = "Enum_" + decl.Name
+		}
+		p.addEnum(decl, name, filter)
+	}
+}
+
+func (p *Package) addEnum(decl *ast.Node, name string, filter func(name string) bool) {
+	type enumVal struct {
+		name string
+		val  int64
+	}
+	var vals []enumVal
+	var next int64
+	var min, max int64 = 0, 0
+	for _, item := range decl.Inner {
+		if item.Kind != ast.EnumConstantDecl {
+			continue
+		}
+		val := next
+		if v, ok := enumValue(item); ok {
+			val = v
+		}
+		next = val + 1
+		if filter != nil && !filter(item.Name) || p.exists(item.Name) || isGoKeyword(item.Name) {
+			continue
+		}
+		vals = append(vals, enumVal{item.Name, val})
+		if val < min {
+			min = val
+		}
+		if val > max {
+			max = val
+		}
+	}
+	if len(vals) == 0 {
+		return
+	}
+	underlying := "c.Int"
+	switch {
+	case min >= math.MinInt32 && max <= math.MaxInt32:
+	case min >= 0 && max <= math.MaxUint32:
+		underlying = "c.Uint"
+	default:
+		underlying = "c.LongLong"
+	}
+	typ := underlying
+	if name != "" && !p.exists(name) {
+		p.Types = append(p.Types, &TypeDecl{name, underlying})
+		typ = name
+	}
+	for _, v := range vals {
+		c := &Const{Name: v.name, Type: typ, Value: strconv.FormatInt(v.val, 10), Kind: Enum}
+		p.define(c, constant.MakeInt64(v.val), 0)
+	}
+}
+
+// enumValue returns the value of an EnumConstantDecl with an initializer.
+func enumValue(item *ast.Node) (int64, bool) {
+	for _, expr := range item.Inner {
+		if expr.Kind == ast.ConstantExpr {
+			if s, ok := expr.Value.(string); ok {
+				if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+					return v, true
+				}
+				if v, err := strconv.ParseUint(s, 10, 64); err == nil {
+					return int64(v), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// -----------------------------------------------------------------------------
+
+type litFlags int
+
+const (
+	flagUnsigned litFlags = 1 << iota // literal with U suffix
+	flagLong                          // literal with L or LL suffix
+	flagFloat32                       // float literal with F suffix
+	flagChar                          // char literal
+)
+
+// AddMacros adds object-like macros read from r, which is the output of
+// `clang -E -dM`. Only macros accepted by filter whose body is a literal or
+// a constant expression of literals and known constants are converted, other
+// macros are ignored silently. A filter of nil accepts all macros.
+func (p *Package) AddMacros(r io.Reader, filter func(name string) bool) error {
+	scan := bufio.NewScanner(r)
+	scan.Buffer(nil, 1<<20)
+	for scan.Scan() {
+		line := strings.TrimSpace(scan.Text())
+		if !strings.HasPrefix(line, "#define ") {
+			continue
+		}
+		line = strings.TrimSpace(line[8:])
+		pos := strings.IndexAny(line, " \t(")
+		if pos <= 0 || line[pos] == '(' { // empty or function-like macro
+			continue
+		}
+		name, body := line[:pos], strings.TrimSpace(line[pos:])
+		if filter != nil && !filter(name) || p.exists(name) || isGoKeyword(name) {
+			continue
+		}
+		p.AddMacro(name, body)
+	}
+	return scan.Err()
+}
+
+// AddMacro adds a macro `#define name body`. It reports whether the macro is
+// converted.
+func (p *Package) AddMacro(name, body string) bool {
+	expr, lit, flags, ok := p.translate(body)
+	if !ok {
+		return false
+	}
+	if _, ok := p.flags[expr]; ok { // alias of a known constant
+		p.define(&Const{Name: name, Value: expr, Kind: Macro}, p.valueOf(expr), flags)
+		return true
+	}
+	tv, err := types.Eval(token.NewFileSet(), p.scope, token.NoPos, expr)
+	if err != nil || tv.Value == nil {
+		return false
+	}
+	v := tv.Value
+	typ, ok := goType(v, flags)
+	if !ok {
+		return false
+	}
+	val := lit
+	if val == "" {
+		val = valueString(v)
+	}
+	p.define(&Const{Name: name, Type: typ, Value: val, Kind: Macro}, v, flags)
+	return true
+}
+
+func (p *Package) valueOf(name string) constant.Value {
+	return p.scope.Scope().Lookup(name).(*types.Const).Val()
+}
+
+// translate converts a C macro body into a Go expression. If the body is a
+// single (maybe negative or parenthesized) literal, lit is the Go form of it.
+func (p *Package) translate(body string) (expr, lit string, flags litFlags, ok bool) {
+	if body == "" {
+		return
+	}
+	src := []byte(body)
+	fset := token.NewFileSet()
+	file := fset.AddFile("", -1, len(src))
+	var s scanner.Scanner
+	var failed bool
+	s.Init(file, src, func(token.Position, string) { failed = true }, 0)
+
+	var b strings.Builder
+	var nlit, nother int
+	var last token.Token
+	var lastEnd token.Pos
+	for {
+		pos, tok, text := s.Scan()
+		if tok == token.EOF || failed {
+			break
+		}
+		if tok == token.SEMICOLON && text == "\n" { // automatically inserted
+			continue
+		}
+		switch tok {
+		case token.IDENT:
+			if pos == lastEnd && (last == token.INT || last == token.FLOAT) {
+				f, valid := suffixFlags(text, last == token.FLOAT)
+				if !valid {
+					return
+				}
+				flags |= f
+				continue
+			}
+			if _, known := p.flags[text]; !known {
+				return
+			}
+			flags |= p.flags[text]
+			nother++
+		case token.INT, token.FLOAT, token.STRING:
+			nlit++
+		case token.CHAR:
+			nlit++
+			flags |= flagChar
+		case token.TILDE:
+			text = "^"
+			nother++
+		case token.ADD, token.SUB, token.MUL, token.QUO, token.REM,
+			token.AND, token.OR, token.XOR, token.SHL, token.SHR:
+			if tok != token.SUB {
+				nother++
+			}
+			text = tok.String()
+		case token.LPAREN, token.RPAREN:
+			text = tok.String()
+		default:
+			return
+		}
+		b.WriteString(text)
+		b.WriteByte(' ')
+		last, lastEnd = tok, pos+token.Pos(len(text))
+	}
+	if failed || b.Len() == 0 {
+		return
+	}
+	expr = strings.TrimSpace(b.String())
+	if nlit == 1 && nother == 0 {
+		lit = strings.NewReplacer("( ", "(", " )", ")", "- ", "-").Replace(expr)
+		lit = strings.TrimSuffix(strings.TrimPrefix(lit, "("), ")")
+		if strings.ContainsAny(lit, "()") {
+			lit = ""
+		}
+	}
+	return expr, lit, flags, true
+}
+
+// suffixFlags parses the suffix of a C integer or float literal.
+func suffixFlags(suffix string, isFloat bool) (flags litFlags, ok bool) {
+	if isFloat {
+		switch suffix {
+		case "f", "F":
+			return flagFloat32, true
+		case "l", "L":
+			return 0, true
+		}
+		return
+	}
+	for _, c := range suffix {
+		switch c {
+		case 'u', 'U':
+			flags |= flagUnsigned
+		case 'l', 'L':
+			flags |= flagLong
+		default:
+			return 0, false
+		}
+	}
+	return flags, true
+}
+
+// goType returns the Go type of a constant value v.
+func goType(v constant.Value, flags litFlags) (string, bool) {
+	switch v.Kind() {
+	case constant.String:
+		return "", true
+	case constant.Float:
+		if flags&flagFloat32 != 0 {
+			return "c.Float", true
+		}
+		return "c.Double", true
+	case constant.Int:
+		if flags == flagChar {
+			return "c.Char", fits(v, math.MinInt8, math.MaxInt8)
+		}
+		unsigned, long := flags&flagUnsigned != 0, flags&flagLong != 0
+		if unsigned && constant.Sign(v) < 0 {
+			return "", false
+		}
+		switch {
+		case !unsigned && !long && fits(v, math.MinInt32, math.MaxInt32):
+			return "c.Int", true
+		case !long && fits(v, 0, math.MaxUint32):
+			return "c.Uint", true
+		case !unsigned && fits(v, math.MinInt64, math.MaxInt64):
+			if long {
+				return "c.Long", true
+			}
+			return "c.LongLong", true
+		case constant.Sign(v) >= 0:
+			if _, exact := constant.Uint64Val(v); exact {
+				if long {
+					return "c.Ulong", true
+				}
+				return "c.UlongLong", true
+			}
+		}
+	}
+	return "", false
+}
+
+func fits(v constant.Value, min, max int64) bool {
+	n, exact := constant.Int64Val(v)
+	return exact && n >= min && n <= max
+}
+
+func valueString(v constant.Value) string {
+	if v.Kind() == constant.Float {
+		f, _ := constant.Float64Val(v)
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return v.ExactString()
+}
+
+func isGoKeyword(name string) bool {
+	return token.Lookup(name).IsKeyword() || name == "_"
+}
+
+// -----------------------------------------------------------------------------
+
+// WriteFile writes the collected types and constants as a Go source file of
+// package pkgName.
+func (p *Package) WriteFile(w io.Writer, pkgName string) error {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by llcconst; DO NOT EDIT.\n\npackage %s\n\n", pkgName)
+	if p.UsesC() {
+		b.WriteString("import \"github.com/goplus/llgo/c\"\n\n")
+	}
+	p.WriteDecls(&b)
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// WriteDecls writes the collected types and constants as unformatted Go
+// declarations.
+func (p *Package) WriteDecls(b *bytes.Buffer) {
+	if len(p.Types) > 0 {
+		decls := append([]*TypeDecl(nil), p.Types...)
+		sort.Slice(decls, func(i, j int) bool { return decls[i].Name < decls[j].Name })
+		for _, t := range decls {
+			fmt.Fprintf(b, "type %s %s\n", t.Name, t.Underlying)
+		}
+		b.WriteByte('\n')
+	}
+	for _, kind := range []Kind{Enum, Macro} {
+		n := 0
+		for _, c := range p.Consts {
+			if c.Kind != kind {
+				continue
+			}
+			if n++; n == 1 {
+				b.WriteString("const (\n")
+			}
+			if c.Type != "" {
+				fmt.Fprintf(b, "\t%s %s = %s\n", c.Name, c.Type, c.Value)
+			} else {
+				fmt.Fprintf(b, "\t%s = %s\n", c.Name, c.Value)
+			}
+		}
+		if n > 0 {
+			b.WriteString(")\n\n")
+		}
+	}
+}
+
+// UsesC reports whether the declarations refer to package c.
+func (p *Package) UsesC() bool {
+	for _, t := range p.Types {
+		if strings.HasPrefix(t.Underlying, "c.") {
+			return true
+		}
+	}
+	for _, c := range p.Consts {
+		if strings.HasPrefix(c.Type, "c.") {
+			return true
+		}
+	}
+	return false
+}
+
+// -----------------------------------------------------------------------------
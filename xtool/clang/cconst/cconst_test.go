@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cconst
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/goplus/llgo/xtool/clang/ast"
+)
+
+func TestMacros(t *testing.T) {
+	defs := `#define __GNUC__ 4
+#define FOO_A 10
+#define FOO_B 0x1fU
+#define FOO_C (-1)
+#define FOO_D (FOO_A << 2 | FOO_B)
+#define FOO_E 1.5f
+#define FOO_F "hello"
+#define FOO_G FOO_A
+#define FOO_H 'x'
+#define FOO_I ~0UL
+#define FOO_J 1L
+#define FOO_K(x) (x)
+#define FOO_L
+#define FOO_M ((int)1)
+#define FOO_N -1U
+`
+	p := NewPackage()
+	err := p.AddMacros(strings.NewReader(defs), func(name string) bool {
+		return strings.HasPrefix(name, "FOO_")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name, typ, val string
+	}{
+		{"FOO_A", "c.Int", "10"},
+		{"FOO_B", "c.Uint", "0x1f"},
+		{"FOO_C", "c.Int", "-1"},
+		{"FOO_D", "c.Uint", "63"},
+		{"FOO_E", "c.Float", "1.5"},
+		{"FOO_F", "", `"hello"`},
+		{"FOO_G", "", "FOO_A"},
+		{"FOO_H", "c.Char", "'x'"},
+		{"FOO_J", "c.Long", "1"},
+	}
+	for _, c := range cases {
+		v := p.Lookup(c.name)
+		if v == nil {
+			t.Fatal("macro not found:", c.name)
+		}
+		if v.Type != c.typ || v.Value != c.val {
+			t.Fatalf("%s: got %s = %s, want %s = %s", c.name, v.Type, v.Value, c.typ, c.val)
+		}
+	}
+	for _, name := range []string{"__GNUC__", "FOO_I", "FOO_K", "FOO_L", "FOO_M", "FOO_N"} {
+		if p.Lookup(name) != nil {
+			t.Fatal("unexpected macro:", name)
+		}
+	}
+}
+
+func TestEnums(t *testing.T) {
+	src := `{"kind": "TranslationUnitDecl", "inner": [
+	{"id": "0x1", "kind": "EnumDecl", "inner": [
+		{"kind": "EnumConstantDecl", "name": "RED"},
+		{"kind": "EnumConstantDecl", "name": "GREEN", "inner": [
+			{"kind": "ConstantExpr", "value": "5"}
+		]},
+		{"kind": "EnumConstantDecl", "name": "BLUE"}
+	]},
+	{"kind": "TypedefDecl", "name": "Color", "inner": [
+		{"kind": "ElaboratedType", "ownedTagDecl": {"id": "0x1", "kind": "EnumDecl"}}
+	]},
+	{"id": "0x2", "kind": "EnumDecl", "inner": [
+		{"kind": "EnumConstantDecl", "name": "NONE"}
+	]}
+]}`
+	var doc ast.Node
+	if err := json.Unmarshal([]byte(src), &doc); err != nil {
+		t.Fatal(err)
+	}
+	p := NewPackage()
+	p.AddEnums(&doc, nil)
+	p.AddMacro("LAST", "(BLUE + 1)")
+
+	var b bytes.Buffer
+	if err := p.WriteFile(&b, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	want := `// Code generated by llcconst; DO NOT EDIT.
+
+package foo
+
+import "github.com/goplus/llgo/c"
+
+type Color c.Int
+
+const (
+	RED   Color = 0
+	GREEN Color = 5
+	BLUE  Color = 6
+	NONE  c.Int = 0
+)
+
+const (
+	LAST c.Int = 7
+)
+`
+	if b.String() != want {
+		t.Fatalf("WriteFile:\n%s", b.String())
+	}
+}
@@ -0,0 +1,337 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package layout parses record layouts dumped by clang and converts them into
+// Go struct declarations.
+package layout
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// -----------------------------------------------------------------------------
+
+// Field is a top-level field of a record.
+type Field struct {
+	Decl     string // declaration of the field, e.g. "char d_name[256]"
+	Offset   int64  // offset in bytes
+	Bitfield bool   // field is a bitfield
+}
+
+// Record is the layout of a C struct or union.
+type Record struct {
+	Name   string // e.g. "struct stat"
+	Fields []Field
+	Size   int64
+	Align  int64
+}
+
+// Parse parses the output of
+//
+//	clang -x c++ -Xclang -fdump-record-layouts -Xclang -fdump-record-layouts-canonical
+//
+// and returns all dumped records.
+func Parse(r io.Reader) (recs []*Record, err error) {
+	const header = "*** Dumping AST Record Layout"
+	var rec *Record
+	scan := bufio.NewScanner(r)
+	scan.Buffer(nil, 1<<20)
+	for scan.Scan() {
+		line := scan.Text()
+		if strings.HasPrefix(line, header) {
+			rec = new(Record)
+			recs = append(recs, rec)
+			continue
+		}
+		if rec == nil {
+			continue
+		}
+		pos := strings.Index(line, " | ")
+		if pos < 0 {
+			rec = nil
+			continue
+		}
+		offset, body := strings.TrimSpace(line[:pos]), line[pos+3:]
+		if offset == "" { // [sizeof=16, dsize=16, align=8, ...]
+			parseSizes(rec, body)
+			continue
+		}
+		depth := 0
+		for len(body) >= depth*2+2 && body[depth*2:depth*2+2] == "  " {
+			depth++
+		}
+		decl := strings.TrimSpace(body)
+		switch depth {
+		case 0:
+			if rec.Name == "" {
+				rec.Name = decl
+			}
+		case 1:
+			f := Field{Decl: decl}
+			if bit := strings.IndexByte(offset, ':'); bit >= 0 {
+				offset, f.Bitfield = offset[:bit], true
+			}
+			if f.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid layout line: %s", line)
+			}
+			rec.Fields = append(rec.Fields, f)
+		}
+	}
+	return recs, scan.Err()
+}
+
+func parseSizes(rec *Record, body string) {
+	body = strings.Trim(strings.TrimSpace(body), "[]")
+	for _, item := range strings.Split(body, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSuffix(v, "]"), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch k {
+		case "sizeof":
+			rec.Size = n
+		case "align":
+			rec.Align = n
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+
+// Converter converts records into Go struct declarations.
+type Converter struct {
+	PtrSize int64             // size of a pointer of the target
+	Names   map[string]string // C record name => Go type name
+}
+
+type goType struct {
+	name  string
+	size  int64
+	align int64
+}
+
+func (p *Converter) basic(ctype string) (t goType, ok bool) {
+	ptr := p.PtrSize
+	switch ctype {
+	case "char":
+		return goType{"c.Char", 1, 1}, true
+	case "signed char":
+		return goType{"int8", 1, 1}, true
+	case "unsigned char":
+		return goType{"uint8", 1, 1}, true
+	case "bool", "_Bool":
+		return goType{"bool", 1, 1}, true
+	case "short":
+		return goType{"int16", 2, 2}, true
+	case "unsigned short":
+		return goType{"uint16", 2, 2}, true
+	case "int":
+		return goType{"c.Int", 4, 4}, true
+	case "unsigned int":
+		return goType{"c.Uint", 4, 4}, true
+	case "long":
+		return goType{"c.Long", ptr, ptr}, true
+	case "unsigned long":
+		return goType{"c.Ulong", ptr, ptr}, true
+	case "long long":
+		return goType{"c.LongLong", 8, 8}, true
+	case "unsigned long long":
+		return goType{"c.UlongLong", 8, 8}, true
+	case "float":
+		return goType{"c.Float", 4, 4}, true
+	case "double":
+		return goType{"c.Double", 8, 8}, true
+	}
+	return
+}
+
+// fieldType converts a field declaration into its name and Go type.
+func (p *Converter) fieldType(decl string, recs map[string]*Record) (name string, t goType, ok bool) {
+	if pos := strings.Index(decl, "(*"); pos >= 0 { // function pointer
+		end := strings.IndexByte(decl[pos:], ')')
+		if end < 0 {
+			return
+		}
+		name = decl[pos+2 : pos+end]
+		return name, goType{"c.Pointer", p.PtrSize, p.PtrSize}, name != ""
+	}
+	var dims []string
+	for strings.HasSuffix(decl, "]") {
+		pos := strings.LastIndexByte(decl, '[')
+		if pos < 0 {
+			return
+		}
+		dims = append(dims, decl[pos+1:len(decl)-1])
+		decl = decl[:pos]
+	}
+	pos := strings.LastIndexAny(decl, " *")
+	if pos < 0 {
+		return
+	}
+	name, decl = decl[pos+1:], strings.TrimSpace(decl[:pos+1])
+	decl = strings.TrimPrefix(strings.TrimPrefix(decl, "const "), "volatile ")
+	switch {
+	case strings.HasSuffix(decl, "*"):
+		t = goType{"c.Pointer", p.PtrSize, p.PtrSize}
+		if strings.TrimSpace(strings.TrimSuffix(decl, "*")) == "char" {
+			t.name = "*c.Char"
+		}
+		ok = true
+	case strings.HasPrefix(decl, "struct ") || strings.HasPrefix(decl, "union "):
+		if goName, has := p.Names[decl]; has {
+			if rec, has := recs[decl]; has {
+				t, ok = goType{goName, rec.Size, rec.Align}, true
+			}
+		}
+	default:
+		t, ok = p.basic(decl)
+	}
+	if !ok {
+		return
+	}
+	for i := len(dims) - 1; i >= 0; i-- {
+		n, err := strconv.ParseInt(dims[i], 10, 64)
+		if err != nil {
+			return name, t, false
+		}
+		t.name = "[" + dims[i] + "]" + t.name
+		t.size *= n
+	}
+	return name, t, name != ""
+}
+
+// Struct converts rec into a Go struct declaration named name. Fields that
+// can't be converted (bitfields, unions, unknown records, ...) become blank
+// byte arrays of the same size, and padding between fields is explicit, so
+// the Go struct always has the size of rec. recs provides layouts of records
+// that rec may contain.
+func (p *Converter) Struct(name string, rec *Record, recs map[string]*Record) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	names := make([]string, len(rec.Fields))
+	types := make([]goType, len(rec.Fields))
+	oks := make([]bool, len(rec.Fields))
+	for i, f := range rec.Fields {
+		if !f.Bitfield {
+			names[i], types[i], oks[i] = p.fieldType(f.Decl, recs)
+		}
+	}
+	prefix := commonPrefix(names, oks)
+	isUnion := strings.HasPrefix(rec.Name, "union ")
+	var end, align int64 = 0, 1
+	for i, f := range rec.Fields {
+		if isUnion {
+			break
+		}
+		if f.Offset < end { // bitfields sharing the same storage
+			continue
+		}
+		next := rec.Size
+		for j := i + 1; j < len(rec.Fields); j++ {
+			if rec.Fields[j].Offset > f.Offset {
+				next = rec.Fields[j].Offset
+				break
+			}
+		}
+		t := types[i]
+		if !oks[i] || t.size > next-f.Offset || f.Offset%t.align != 0 {
+			fmt.Fprintf(&b, "\t_ [%d]byte // %s\n", next-f.Offset, f.Decl)
+			end = next
+			continue
+		}
+		if f.Offset > end {
+			fmt.Fprintf(&b, "\t_ [%d]byte\n", f.Offset-end)
+		}
+		fmt.Fprintf(&b, "\t%s %s\n", exportName(strings.TrimPrefix(names[i], prefix)), t.name)
+		end = f.Offset + t.size
+		if t.align > align {
+			align = t.align
+		}
+	}
+	if rec.Size > end {
+		fmt.Fprintf(&b, "\t_ [%d]byte\n", rec.Size-end)
+	}
+	if rec.Align > align {
+		if t, ok := p.alignType(rec.Align); ok {
+			fmt.Fprintf(&b, "\t_ [0]%s\n", t)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func (p *Converter) alignType(align int64) (string, bool) {
+	switch align {
+	case 2:
+		return "int16", true
+	case 4:
+		return "c.Int", true
+	case 8:
+		return "c.LongLong", true
+	}
+	return "", false
+}
+
+// commonPrefix returns the "xx_" prefix shared by all converted field names,
+// e.g. "st_" of struct stat.
+func commonPrefix(names []string, oks []bool) string {
+	prefix := ""
+	for i, name := range names {
+		if !oks[i] {
+			continue
+		}
+		pos := strings.IndexByte(name, '_')
+		if pos <= 0 || pos == len(name)-1 {
+			return ""
+		}
+		if prefix == "" {
+			prefix = name[:pos+1]
+		} else if name[:pos+1] != prefix {
+			return ""
+		}
+	}
+	return prefix
+}
+
+// GoName converts a C record name into a Go type name, e.g. "struct sockaddr_in6"
+// into "SockaddrIn6".
+func GoName(cname string) string {
+	cname = strings.TrimPrefix(strings.TrimPrefix(cname, "struct "), "union ")
+	var b strings.Builder
+	for _, part := range strings.Split(cname, "_") {
+		if part != "" {
+			b.WriteString(exportName(part))
+		}
+	}
+	return b.String()
+}
+
+func exportName(name string) string {
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		return name
+	}
+	return string(name[0]-'a'+'A') + name[1:]
+}
+
+// -----------------------------------------------------------------------------
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2024 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package layout
+
+import (
+	"strings"
+	"testing"
+)
+
+const dump = `
+*** Dumping AST Record Layout
+         0 | struct timespec
+         0 |   long tv_sec
+         8 |   long tv_nsec
+           | [sizeof=16, dsize=16, align=8,
+           |  nvsize=16, nvalign=8]
+
+*** Dumping AST Record Layout
+         0 | struct foo
+         0 |   unsigned long f_dev
+         8 |   int f_mode
+        16 |   struct timespec f_time
+        16 |     long tv_sec
+        24 |     long tv_nsec
+        32 |   char f_name[5]
+     37:0-2 |   unsigned int f_a
+     37:3-7 |   unsigned int f_b
+        40 |   void (*f_fn)(int)
+        48 |   union bar f_u
+           | [sizeof=56, dsize=56, align=8,
+           |  nvsize=56, nvalign=8]
+`
+
+func TestStruct(t *testing.T) {
+	recs, err := Parse(strings.NewReader(dump))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recs) != 2 {
+		t.Fatal("Parse:", len(recs))
+	}
+	foo := recs[1]
+	if foo.Name != "struct foo" || foo.Size != 56 || foo.Align != 8 || len(foo.Fields) != 8 {
+		t.Fatalf("Parse: %+v", foo)
+	}
+	byName := map[string]*Record{recs[0].Name: recs[0], foo.Name: foo}
+	conv := &Converter{PtrSize: 8, Names: map[string]string{"struct timespec": "Timespec"}}
+	got := conv.Struct(GoName(foo.Name), foo, byName)
+	want := `type Foo struct {
+	Dev c.Ulong
+	Mode c.Int
+	_ [4]byte
+	Time Timespec
+	Name [5]c.Char
+	_ [3]byte // unsigned int f_a
+	Fn c.Pointer
+	_ [8]byte // union bar f_u
+}
+`
+	if got != want {
+		t.Fatalf("Struct:\n%s", got)
+	}
+	if name := GoName("struct sockaddr_in6"); name != "SockaddrIn6" {
+		t.Fatal("GoName:", name)
+	}
+}